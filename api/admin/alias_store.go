@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var (
+	chainAliasPrefix = []byte("chain")
+	vmAliasPrefix    = []byte("vm")
+)
+
+// AliasStore persists chain and VM aliases so that they can be re-applied when
+// the node restarts.
+type AliasStore struct {
+	// Alias --> Chain ID
+	chainAliases database.Database
+	// Alias --> VM ID
+	vmAliases database.Database
+}
+
+func NewAliasStore(db database.Database) *AliasStore {
+	return &AliasStore{
+		chainAliases: prefixdb.New(chainAliasPrefix, db),
+		vmAliases:    prefixdb.New(vmAliasPrefix, db),
+	}
+}
+
+// PutChainAlias persists that [alias] refers to the chain [chainID]
+func (s *AliasStore) PutChainAlias(alias string, chainID ids.ID) error {
+	return database.PutID(s.chainAliases, []byte(alias), chainID)
+}
+
+// DeleteChainAlias removes the persisted chain alias [alias], if it exists
+func (s *AliasStore) DeleteChainAlias(alias string) error {
+	return s.chainAliases.Delete([]byte(alias))
+}
+
+// ChainAliases returns the persisted chain aliases, grouped by chain ID
+func (s *AliasStore) ChainAliases() (map[ids.ID][]string, error) {
+	return getAliases(s.chainAliases)
+}
+
+// PutVMAlias persists that [alias] refers to the VM [vmID]
+func (s *AliasStore) PutVMAlias(alias string, vmID ids.ID) error {
+	return database.PutID(s.vmAliases, []byte(alias), vmID)
+}
+
+// DeleteVMAlias removes the persisted VM alias [alias], if it exists
+func (s *AliasStore) DeleteVMAlias(alias string) error {
+	return s.vmAliases.Delete([]byte(alias))
+}
+
+// VMAliases returns the persisted VM aliases, grouped by VM ID
+func (s *AliasStore) VMAliases() (map[ids.ID][]string, error) {
+	return getAliases(s.vmAliases)
+}
+
+func getAliases(db database.Iteratee) (map[ids.ID][]string, error) {
+	it := db.NewIterator()
+	defer it.Release()
+
+	aliases := make(map[ids.ID][]string)
+	for it.Next() {
+		id, err := ids.ToID(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		aliases[id] = append(aliases[id], string(it.Key()))
+	}
+	return aliases, it.Error()
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestAliasStore(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	s := NewAliasStore(db)
+
+	chainID := ids.GenerateTestID()
+	vmID := ids.GenerateTestID()
+	require.NoError(s.PutChainAlias("chain1", chainID))
+	require.NoError(s.PutChainAlias("chain2", chainID))
+	require.NoError(s.PutVMAlias("vm", vmID))
+
+	// Aliases should be readable from a new store over the same database
+	s = NewAliasStore(db)
+	chainAliases, err := s.ChainAliases()
+	require.NoError(err)
+	require.Equal(map[ids.ID][]string{chainID: {"chain1", "chain2"}}, chainAliases)
+
+	vmAliases, err := s.VMAliases()
+	require.NoError(err)
+	require.Equal(map[ids.ID][]string{vmID: {"vm"}}, vmAliases)
+
+	require.NoError(s.DeleteChainAlias("chain1"))
+	require.NoError(s.DeleteVMAlias("vm"))
+
+	chainAliases, err = s.ChainAliases()
+	require.NoError(err)
+	require.Equal(map[ids.ID][]string{chainID: {"chain2"}}, chainAliases)
+
+	vmAliases, err = s.VMAliases()
+	require.NoError(err)
+	require.Empty(vmAliases)
+}
@@ -7,9 +7,12 @@ import (
 	"context"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/api/audit"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
@@ -25,12 +28,22 @@ type Client interface {
 	Alias(ctx context.Context, endpoint string, alias string, options ...rpc.Option) error
 	AliasChain(ctx context.Context, chainID string, alias string, options ...rpc.Option) error
 	GetChainAliases(ctx context.Context, chainID string, options ...rpc.Option) ([]string, error)
+	PersistChainAlias(ctx context.Context, chainID string, alias string, options ...rpc.Option) error
+	RemovePersistedChainAlias(ctx context.Context, alias string, options ...rpc.Option) error
+	PersistVMAlias(ctx context.Context, vmID string, alias string, options ...rpc.Option) error
+	RemovePersistedVMAlias(ctx context.Context, alias string, options ...rpc.Option) error
+	GetPersistedAliases(context.Context, ...rpc.Option) (map[ids.ID][]string, map[ids.ID][]string, error)
 	Stacktrace(context.Context, ...rpc.Option) error
 	LoadVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, map[ids.ID]string, error)
 	SetLoggerLevel(ctx context.Context, loggerName, logLevel, displayLevel string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetLoggerLevel(ctx context.Context, loggerName string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetConfig(ctx context.Context, options ...rpc.Option) (interface{}, error)
+	UpdateChainConfig(ctx context.Context, chain string, config []byte, options ...rpc.Option) error
+	TrackSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) ([]ids.ID, error)
+	UntrackSubnet(ctx context.Context, subnetID ids.ID, prune bool, options ...rpc.Option) ([]ids.ID, error)
 	DBGet(ctx context.Context, key []byte, options ...rpc.Option) ([]byte, error)
+	GetPeerEvents(ctx context.Context, startIndex uint64, limit uint32, options ...rpc.Option) ([]events.Event, uint64, error)
+	GetAuditLog(ctx context.Context, startIndex uint64, limit uint32, options ...rpc.Option) ([]audit.Entry, error)
 }
 
 // Client implementation for the Avalanche Platform Info API Endpoint
@@ -83,6 +96,38 @@ func (c *client) GetChainAliases(ctx context.Context, chain string, options ...r
 	return res.Aliases, err
 }
 
+func (c *client) PersistChainAlias(ctx context.Context, chain, alias string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.persistChainAlias", &AliasChainArgs{
+		Chain: chain,
+		Alias: alias,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) RemovePersistedChainAlias(ctx context.Context, alias string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.removePersistedChainAlias", &RemovePersistedAliasArgs{
+		Alias: alias,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) PersistVMAlias(ctx context.Context, vm, alias string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.persistVMAlias", &PersistVMAliasArgs{
+		VM:    vm,
+		Alias: alias,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) RemovePersistedVMAlias(ctx context.Context, alias string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.removePersistedVMAlias", &RemovePersistedAliasArgs{
+		Alias: alias,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) GetPersistedAliases(ctx context.Context, options ...rpc.Option) (map[ids.ID][]string, map[ids.ID][]string, error) {
+	res := &GetPersistedAliasesReply{}
+	err := c.requester.SendRequest(ctx, "admin.getPersistedAliases", struct{}{}, res, options...)
+	return res.ChainAliases, res.VMAliases, err
+}
+
 func (c *client) Stacktrace(ctx context.Context, options ...rpc.Option) error {
 	return c.requester.SendRequest(ctx, "admin.stacktrace", struct{}{}, &api.EmptyReply{}, options...)
 }
@@ -144,6 +189,30 @@ func (c *client) GetConfig(ctx context.Context, options ...rpc.Option) (interfac
 	return res, err
 }
 
+func (c *client) UpdateChainConfig(ctx context.Context, chain string, config []byte, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.updateChainConfig", &UpdateChainConfigArgs{
+		Chain:  chain,
+		Config: string(config),
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) TrackSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) ([]ids.ID, error) {
+	res := &TrackSubnetReply{}
+	err := c.requester.SendRequest(ctx, "admin.trackSubnet", &TrackSubnetArgs{
+		SubnetID: subnetID,
+	}, res, options...)
+	return res.ChainIDs, err
+}
+
+func (c *client) UntrackSubnet(ctx context.Context, subnetID ids.ID, prune bool, options ...rpc.Option) ([]ids.ID, error) {
+	res := &UntrackSubnetReply{}
+	err := c.requester.SendRequest(ctx, "admin.untrackSubnet", &UntrackSubnetArgs{
+		SubnetID: subnetID,
+		Prune:    prune,
+	}, res, options...)
+	return res.ChainIDs, err
+}
+
 func (c *client) DBGet(ctx context.Context, key []byte, options ...rpc.Option) ([]byte, error) {
 	keyStr, err := formatting.Encode(formatting.HexNC, key)
 	if err != nil {
@@ -163,3 +232,31 @@ func (c *client) DBGet(ctx context.Context, key []byte, options ...rpc.Option) (
 	}
 	return formatting.Decode(formatting.HexNC, res.Value)
 }
+
+func (c *client) GetPeerEvents(
+	ctx context.Context,
+	startIndex uint64,
+	limit uint32,
+	options ...rpc.Option,
+) ([]events.Event, uint64, error) {
+	res := &GetPeerEventsReply{}
+	err := c.requester.SendRequest(ctx, "admin.getPeerEvents", &GetPeerEventsArgs{
+		StartIndex: json.Uint64(startIndex),
+		Limit:      json.Uint32(limit),
+	}, res, options...)
+	return res.Events, uint64(res.NextIndex), err
+}
+
+func (c *client) GetAuditLog(
+	ctx context.Context,
+	startIndex uint64,
+	limit uint32,
+	options ...rpc.Option,
+) ([]audit.Entry, error) {
+	res := &GetAuditLogReply{}
+	err := c.requester.SendRequest(ctx, "admin.getAuditLog", &GetAuditLogArgs{
+		StartIndex: json.Uint64(startIndex),
+		Limit:      json.Uint32(limit),
+	}, res, options...)
+	return res.Entries, err
+}
@@ -65,6 +65,15 @@ func (mc *mockClient) SendRequest(_ context.Context, _ string, _ interface{}, re
 	case *LoggerLevelReply:
 		response := mc.response.(*LoggerLevelReply)
 		*p = *response
+	case *GetPersistedAliasesReply:
+		response := mc.response.(*GetPersistedAliasesReply)
+		*p = *response
+	case *TrackSubnetReply:
+		response := mc.response.(*TrackSubnetReply)
+		*p = *response
+	case *UntrackSubnetReply:
+		response := mc.response.(*UntrackSubnetReply)
+		*p = *response
 	case *interface{}:
 		response := mc.response.(*interface{})
 		*p = *response
@@ -155,6 +164,54 @@ func TestGetChainAliases(t *testing.T) {
 	})
 }
 
+func TestPersistChainAlias(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.PersistChainAlias(context.Background(), "chain", "chain-alias")
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestPersistVMAlias(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.PersistVMAlias(context.Background(), "vm", "vm-alias")
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestGetPersistedAliases(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedChainAliases := map[ids.ID][]string{
+			ids.GenerateTestID(): {"chain-alias"},
+		}
+		expectedVMAliases := map[ids.ID][]string{
+			ids.GenerateTestID(): {"vm-alias"},
+		}
+		mockClient := client{requester: NewMockClient(&GetPersistedAliasesReply{
+			ChainAliases: expectedChainAliases,
+			VMAliases:    expectedVMAliases,
+		}, nil)}
+
+		chainAliases, vmAliases, err := mockClient.GetPersistedAliases(context.Background())
+		require.NoError(err)
+		require.Equal(expectedChainAliases, chainAliases)
+		require.Equal(expectedVMAliases, vmAliases)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&GetPersistedAliasesReply{}, errTest)}
+		_, _, err := mockClient.GetPersistedAliases(context.Background())
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
 func TestStacktrace(t *testing.T) {
 	for _, test := range SuccessResponseTests {
 		t.Run(test.name, func(t *testing.T) {
@@ -356,3 +413,55 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateChainConfig(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.UpdateChainConfig(context.Background(), "chain", []byte(`{"pruning-enabled":true}`))
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestTrackSubnet(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := []ids.ID{ids.GenerateTestID()}
+		mockClient := client{requester: NewMockClient(&TrackSubnetReply{
+			ChainIDs: expectedReply,
+		}, nil)}
+
+		reply, err := mockClient.TrackSubnet(context.Background(), ids.GenerateTestID())
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&TrackSubnetReply{}, errTest)}
+		_, err := mockClient.TrackSubnet(context.Background(), ids.GenerateTestID())
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestUntrackSubnet(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := []ids.ID{ids.GenerateTestID()}
+		mockClient := client{requester: NewMockClient(&UntrackSubnetReply{
+			ChainIDs: expectedReply,
+		}, nil)}
+
+		reply, err := mockClient.UntrackSubnet(context.Background(), ids.GenerateTestID(), true)
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&UntrackSubnetReply{}, errTest)}
+		_, err := mockClient.UntrackSubnet(context.Background(), ids.GenerateTestID(), false)
+		require.ErrorIs(t, err, errTest)
+	})
+}
@@ -4,20 +4,24 @@
 package admin
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/api/audit"
 	"github.com/ava-labs/avalanchego/api/server"
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -34,6 +38,17 @@ import (
 const (
 	maxAliasLength = 512
 
+	// maxPeerEvents is the maximum number of events returned by a single
+	// getPeerEvents call.
+	maxPeerEvents = 1024
+	// maxPeerEventsWait is the maximum duration getPeerEvents waits for a new
+	// event.
+	maxPeerEventsWait = 30 * time.Second
+
+	// maxAuditLogEntries is the maximum number of entries returned by a single
+	// getAuditLog call.
+	maxAuditLogEntries = 1024
+
 	// Name of file that stacktraces are written to
 	stacktraceFile = "stacktrace.txt"
 )
@@ -41,6 +56,9 @@ const (
 var (
 	errAliasTooLong = errors.New("alias length is too long")
 	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+
+	errUntrackPrimaryNetwork = errors.New("can't stop tracking the primary network")
+	errAuditLogDisabled      = errors.New("audit log is disabled")
 )
 
 type Config struct {
@@ -53,6 +71,10 @@ type Config struct {
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	AliasStore   *AliasStore
+	PeerEvents   *events.Log
+	// AuditLog is nil if the audit log is disabled.
+	AuditLog *audit.Log
 }
 
 // Admin is the API service for node admin management
@@ -214,6 +236,140 @@ func (a *Admin) GetChainAliases(_ *http.Request, args *GetChainAliasesArgs, repl
 	return err
 }
 
+// PersistChainAlias aliases a chain to a new name and persists the alias so
+// that it is re-applied when the node restarts.
+func (a *Admin) PersistChainAlias(_ *http.Request, args *AliasChainArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "persistChainAlias"),
+		logging.UserString("chain", args.Chain),
+		logging.UserString("alias", args.Alias),
+	)
+
+	if len(args.Alias) > maxAliasLength {
+		return errAliasTooLong
+	}
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	// The alias may have already been applied, for example by a previous call
+	// to aliasChain. In that case only the persistence is missing.
+	if aliasedID, err := a.ChainManager.Lookup(args.Alias); err != nil || aliasedID != chainID {
+		if err := a.ChainManager.Alias(chainID, args.Alias); err != nil {
+			return err
+		}
+
+		endpoint := path.Join(constants.ChainAliasPrefix, chainID.String())
+		alias := path.Join(constants.ChainAliasPrefix, args.Alias)
+		if err := a.HTTPServer.AddAliasesWithReadLock(endpoint, alias); err != nil {
+			return err
+		}
+	}
+	return a.AliasStore.PutChainAlias(args.Alias, chainID)
+}
+
+// RemovePersistedAliasArgs are the arguments for calling
+// RemovePersistedChainAlias and RemovePersistedVMAlias
+type RemovePersistedAliasArgs struct {
+	Alias string `json:"alias"`
+}
+
+// RemovePersistedChainAlias stops a persisted chain alias from being applied
+// when the node restarts. The alias remains usable until then.
+func (a *Admin) RemovePersistedChainAlias(_ *http.Request, args *RemovePersistedAliasArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "removePersistedChainAlias"),
+		logging.UserString("alias", args.Alias),
+	)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.AliasStore.DeleteChainAlias(args.Alias)
+}
+
+// PersistVMAliasArgs are the arguments for calling PersistVMAlias
+type PersistVMAliasArgs struct {
+	VM    string `json:"vm"`
+	Alias string `json:"alias"`
+}
+
+// PersistVMAlias aliases a VM to a new name and persists the alias so that it
+// is re-applied when the node restarts.
+func (a *Admin) PersistVMAlias(_ *http.Request, args *PersistVMAliasArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "persistVMAlias"),
+		logging.UserString("vm", args.VM),
+		logging.UserString("alias", args.Alias),
+	)
+
+	if len(args.Alias) > maxAliasLength {
+		return errAliasTooLong
+	}
+	vmID, err := a.VMManager.Lookup(args.VM)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if aliasedID, err := a.VMManager.Lookup(args.Alias); err != nil || aliasedID != vmID {
+		if err := a.VMManager.Alias(vmID, args.Alias); err != nil {
+			return err
+		}
+	}
+	return a.AliasStore.PutVMAlias(args.Alias, vmID)
+}
+
+// RemovePersistedVMAlias stops a persisted VM alias from being applied when
+// the node restarts. The alias remains usable until then.
+func (a *Admin) RemovePersistedVMAlias(_ *http.Request, args *RemovePersistedAliasArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "removePersistedVMAlias"),
+		logging.UserString("alias", args.Alias),
+	)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.AliasStore.DeleteVMAlias(args.Alias)
+}
+
+// GetPersistedAliasesReply are the persisted chain and VM aliases
+type GetPersistedAliasesReply struct {
+	ChainAliases map[ids.ID][]string `json:"chainAliases"`
+	VMAliases    map[ids.ID][]string `json:"vmAliases"`
+}
+
+// GetPersistedAliases returns the chain and VM aliases that are applied when
+// the node starts
+func (a *Admin) GetPersistedAliases(_ *http.Request, _ *struct{}, reply *GetPersistedAliasesReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getPersistedAliases"),
+	)
+
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	var err error
+	reply.ChainAliases, err = a.AliasStore.ChainAliases()
+	if err != nil {
+		return err
+	}
+	reply.VMAliases, err = a.AliasStore.VMAliases()
+	return err
+}
+
 // Stacktrace returns the current global stacktrace
 func (a *Admin) Stacktrace(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
 	a.Log.Debug("API called",
@@ -320,6 +476,98 @@ func (a *Admin) GetConfig(_ *http.Request, _ *struct{}, reply *interface{}) erro
 	return nil
 }
 
+// UpdateChainConfigArgs are the arguments for calling UpdateChainConfig
+type UpdateChainConfigArgs struct {
+	Chain  string `json:"chain"`
+	Config string `json:"config"`
+}
+
+// UpdateChainConfig replaces the config of a running chain. The chain's VM
+// validates the new config and applies it between blocks. The update isn't
+// persisted across restarts.
+func (a *Admin) UpdateChainConfig(r *http.Request, args *UpdateChainConfigArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "updateChainConfig"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.ChainManager.UpdateChainConfig(r.Context(), chainID, []byte(args.Config))
+}
+
+// TrackSubnetArgs are the arguments for calling TrackSubnet
+type TrackSubnetArgs struct {
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// TrackSubnetReply are the results from calling TrackSubnet
+type TrackSubnetReply struct {
+	// IDs of the chains of the subnet that were queued to be created
+	ChainIDs []ids.ID `json:"chainIDs"`
+}
+
+// TrackSubnet instantiates the chains of a subnet that this node didn't track,
+// along with the chains created on the subnet afterwards, and advertises the
+// subnet to peers that connect afterwards. The subnet is only tracked until the
+// node restarts.
+func (a *Admin) TrackSubnet(_ *http.Request, args *TrackSubnetArgs, reply *TrackSubnetReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "trackSubnet"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	reply.ChainIDs = a.ChainManager.TrackSubnet(args.SubnetID)
+	return nil
+}
+
+// UntrackSubnetArgs are the arguments for calling UntrackSubnet
+type UntrackSubnetArgs struct {
+	SubnetID ids.ID `json:"subnetID"`
+	// If true, the databases and data directories of the subnet's chains are
+	// deleted
+	Prune bool `json:"prune"`
+}
+
+// UntrackSubnetReply are the results from calling UntrackSubnet
+type UntrackSubnetReply struct {
+	// IDs of the chains of the subnet that were stopped
+	ChainIDs []ids.ID `json:"chainIDs"`
+}
+
+// UntrackSubnet stops the chains of a subnet that this node tracks. The chains
+// created on the subnet afterwards aren't instantiated. The subnet is only
+// untracked until the node restarts.
+func (a *Admin) UntrackSubnet(_ *http.Request, args *UntrackSubnetArgs, reply *UntrackSubnetReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "untrackSubnet"),
+		zap.Stringer("subnetID", args.SubnetID),
+		zap.Bool("prune", args.Prune),
+	)
+
+	if args.SubnetID == constants.PrimaryNetworkID {
+		return errUntrackPrimaryNetwork
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	reply.ChainIDs = a.ChainManager.UntrackSubnet(args.SubnetID, args.Prune)
+	return nil
+}
+
 // LoadVMsReply contains the response metadata for LoadVMs
 type LoadVMsReply struct {
 	// VMs and their aliases which were successfully loaded
@@ -413,3 +661,78 @@ func (a *Admin) DbGet(_ *http.Request, args *DBGetArgs, reply *DBGetReply) error
 	reply.Value, err = formatting.Encode(formatting.HexNC, value)
 	return err
 }
+
+type GetPeerEventsArgs struct {
+	// StartIndex is the index of the first event to return.
+	StartIndex json.Uint64 `json:"startIndex"`
+	// Limit is the maximum number of events to return. If 0, or larger than
+	// [maxPeerEvents], [maxPeerEvents] is used.
+	Limit json.Uint32 `json:"limit"`
+}
+
+type GetPeerEventsReply struct {
+	Events []events.Event `json:"events"`
+	// NextIndex is the StartIndex to provide to receive the following events.
+	NextIndex json.Uint64 `json:"nextIndex"`
+}
+
+// GetPeerEvents returns the peer lifecycle events recorded since [StartIndex].
+// If no such events have been recorded yet, it waits for one to be recorded,
+// so that repeatedly calling it with the returned [NextIndex] streams events
+// as they happen.
+func (a *Admin) GetPeerEvents(r *http.Request, args *GetPeerEventsArgs, reply *GetPeerEventsReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getPeerEvents"),
+		zap.Uint64("startIndex", uint64(args.StartIndex)),
+		zap.Uint32("limit", uint32(args.Limit)),
+	)
+
+	limit := int(args.Limit)
+	if limit == 0 || limit > maxPeerEvents {
+		limit = maxPeerEvents
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), maxPeerEventsWait)
+	defer cancel()
+
+	peerEvents, nextIndex := a.PeerEvents.Wait(ctx, uint64(args.StartIndex), limit)
+	reply.Events = peerEvents
+	reply.NextIndex = json.Uint64(nextIndex)
+	return nil
+}
+
+type GetAuditLogArgs struct {
+	// StartIndex is the index of the first entry to return.
+	StartIndex json.Uint64 `json:"startIndex"`
+	// Limit is the maximum number of entries to return. If 0, or larger than
+	// [maxAuditLogEntries], [maxAuditLogEntries] is used.
+	Limit json.Uint32 `json:"limit"`
+}
+
+type GetAuditLogReply struct {
+	Entries []audit.Entry `json:"entries"`
+}
+
+// GetAuditLog returns the entries of the audit log starting at [StartIndex].
+func (a *Admin) GetAuditLog(_ *http.Request, args *GetAuditLogArgs, reply *GetAuditLogReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getAuditLog"),
+		zap.Uint64("startIndex", uint64(args.StartIndex)),
+		zap.Uint32("limit", uint32(args.Limit)),
+	)
+
+	if a.AuditLog == nil {
+		return errAuditLogDisabled
+	}
+
+	limit := int(args.Limit)
+	if limit == 0 || limit > maxAuditLogEntries {
+		limit = maxAuditLogEntries
+	}
+
+	var err error
+	reply.Entries, err = a.AuditLog.Entries(uint64(args.StartIndex), limit)
+	return err
+}
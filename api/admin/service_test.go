@@ -5,14 +5,20 @@ package admin
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/ava-labs/avalanchego/api/audit"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/events"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/registry/registrymock"
 	"github.com/ava-labs/avalanchego/vms/vmsmock"
@@ -167,3 +173,116 @@ func TestServiceDBGet(t *testing.T) {
 		})
 	}
 }
+
+func TestServicePersistVMAlias(t *testing.T) {
+	require := require.New(t)
+
+	resources := initLoadVMsTest(t)
+	resources.admin.AliasStore = NewAliasStore(memdb.New())
+
+	vmID := ids.GenerateTestID()
+	resources.mockVMManager.EXPECT().Lookup(vmID.String()).Return(vmID, nil).Times(2)
+	resources.mockVMManager.EXPECT().Lookup("vm-alias").Return(ids.Empty, errTest)
+	resources.mockVMManager.EXPECT().Alias(vmID, "vm-alias").Return(nil)
+
+	require.NoError(resources.admin.PersistVMAlias(nil, &PersistVMAliasArgs{
+		VM:    vmID.String(),
+		Alias: "vm-alias",
+	}, nil))
+
+	// Persisting an alias that is already applied should only persist it
+	resources.mockVMManager.EXPECT().Lookup("vm-alias").Return(vmID, nil)
+	require.NoError(resources.admin.PersistVMAlias(nil, &PersistVMAliasArgs{
+		VM:    vmID.String(),
+		Alias: "vm-alias",
+	}, nil))
+
+	reply := &GetPersistedAliasesReply{}
+	require.NoError(resources.admin.GetPersistedAliases(nil, nil, reply))
+	require.Empty(reply.ChainAliases)
+	require.Equal(map[ids.ID][]string{vmID: {"vm-alias"}}, reply.VMAliases)
+
+	require.NoError(resources.admin.RemovePersistedVMAlias(nil, &RemovePersistedAliasArgs{
+		Alias: "vm-alias",
+	}, nil))
+	require.NoError(resources.admin.GetPersistedAliases(nil, nil, reply))
+	require.Empty(reply.VMAliases)
+}
+
+func TestServiceGetPeerEvents(t *testing.T) {
+	require := require.New(t)
+
+	peerEvents := events.NewLog(events.DefaultCapacity)
+	a := &Admin{Config: Config{
+		Log:        logging.NoLog{},
+		PeerEvents: peerEvents,
+	}}
+
+	nodeID := ids.GenerateTestNodeID()
+	peerEvents.Record(events.Event{
+		Type:   events.Connected,
+		NodeID: nodeID,
+	})
+	peerEvents.Record(events.Event{
+		Type:   events.Disconnected,
+		NodeID: nodeID,
+		Reason: events.IncompatibleVersion,
+	})
+
+	reply := &GetPeerEventsReply{}
+	require.NoError(a.GetPeerEvents(
+		httptest.NewRequest(http.MethodPost, "/ext/admin", nil),
+		&GetPeerEventsArgs{
+			StartIndex: 1,
+		},
+		reply,
+	))
+	require.Len(reply.Events, 1)
+	require.Equal(events.Disconnected, reply.Events[0].Type)
+	require.Equal(events.IncompatibleVersion, reply.Events[0].Reason)
+	require.Equal(json.Uint64(2), reply.NextIndex)
+}
+
+func TestServiceUntrackPrimaryNetwork(t *testing.T) {
+	a := &Admin{Config: Config{
+		Log: logging.NoLog{},
+	}}
+
+	err := a.UntrackSubnet(nil, &UntrackSubnetArgs{
+		SubnetID: constants.PrimaryNetworkID,
+	}, &UntrackSubnetReply{})
+	require.ErrorIs(t, err, errUntrackPrimaryNetwork)
+}
+
+func TestServiceGetAuditLog(t *testing.T) {
+	require := require.New(t)
+
+	a := &Admin{Config: Config{
+		Log: logging.NoLog{},
+	}}
+	err := a.GetAuditLog(nil, &GetAuditLogArgs{}, &GetAuditLogReply{})
+	require.ErrorIs(err, errAuditLogDisabled)
+
+	auditLog, err := audit.New(audit.Config{
+		Path: filepath.Join(t.TempDir(), "audit.log"),
+	})
+	require.NoError(err)
+	defer func() {
+		require.NoError(auditLog.Close())
+	}()
+	for i := 0; i < 3; i++ {
+		_, err := auditLog.Append(audit.Entry{
+			Method: "admin.lockProfile",
+		})
+		require.NoError(err)
+	}
+	a.AuditLog = auditLog
+
+	reply := GetAuditLogReply{}
+	require.NoError(a.GetAuditLog(nil, &GetAuditLogArgs{
+		StartIndex: 1,
+	}, &reply))
+	require.Len(reply.Entries, 2)
+	require.Equal(json.Uint64(1), reply.Entries[0].Index)
+	require.NoError(audit.Verify(reply.Entries[0].PrevHash, reply.Entries))
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	bearerPrefix = "Bearer "
+
+	// Number of bytes of the hash of a bearer token that identify it
+	identityLen = 8
+)
+
+var _ http.Handler = (*handler)(nil)
+
+// NewHandler returns a handler that serves requests with [h] and records each
+// call in [auditLog]. Failing to record a call is logged, but doesn't fail the
+// call, as it has already been served.
+func NewHandler(h http.Handler, auditLog *Log, log logging.Logger) http.Handler {
+	return &handler{
+		handler:  h,
+		auditLog: auditLog,
+		log:      log,
+	}
+}
+
+type handler struct {
+	handler  http.Handler
+	auditLog *Log
+	log      logging.Logger
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	recorder := &responseRecorder{
+		ResponseWriter: w,
+		status:         http.StatusOK,
+	}
+	h.handler.ServeHTTP(recorder, r)
+
+	var request struct {
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	// Requests that can't be parsed are recorded without a method and with the
+	// hash of their body.
+	params := body
+	if err := json.Unmarshal(body, &request); err == nil {
+		params = request.Params
+	}
+
+	entry, err := h.auditLog.Append(Entry{
+		Timestamp:  time.Now().UTC(),
+		Method:     request.Method,
+		Identity:   identity(r),
+		RemoteAddr: r.RemoteAddr,
+		ParamsHash: sha256.Sum256(params),
+		Error:      recorder.error(),
+	})
+	if err != nil {
+		h.log.Error("failed to record API call in audit log",
+			zap.String("method", request.Method),
+			zap.Error(err),
+		)
+		return
+	}
+	h.log.Debug("recorded API call in audit log",
+		zap.Uint64("index", uint64(entry.Index)),
+		zap.String("method", entry.Method),
+	)
+}
+
+// identity returns the identity of the bearer token that [r] is authenticated
+// with, or the empty string if it doesn't have one.
+func identity(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(strings.TrimPrefix(header, bearerPrefix)))
+	return "token:" + hex.EncodeToString(hash[:identityLen])
+}
+
+// responseRecorder records the status and body of a response as it is
+// written.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	_, _ = r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// error returns the error of the recorded response, or the empty string if
+// the call succeeded.
+func (r *responseRecorder) error() string {
+	var response struct {
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(r.body.Bytes(), &response); err == nil && response.Error != nil {
+		return response.Error.Message
+	}
+	if r.status >= http.StatusBadRequest {
+		return http.StatusText(r.status)
+	}
+	return ""
+}
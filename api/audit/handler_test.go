@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func TestHandler(t *testing.T) {
+	require := require.New(t)
+
+	l := newTestLog(t, filepath.Join(t.TempDir(), "audit.log"))
+	h := NewHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32000,"message":"failed"},"id":1}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","result":{},"id":1}`))
+		}),
+		l,
+		logging.NoLog{},
+	)
+
+	const params = `{"subnetID":"2bRCr6B4MiEfSjidDwxDpdCyviwnfUVqB2HGwhm947w9YYqb7r"}`
+	body := `{"jsonrpc":"2.0","method":"admin.trackSubnet","params":` + params + `,"id":1}`
+
+	req := httptest.NewRequest(http.MethodPost, "/ext/admin", strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	req = httptest.NewRequest(http.MethodPost, "/ext/admin", strings.NewReader(body))
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries, err := l.Entries(0, 10)
+	require.NoError(err)
+	require.Len(entries, 2)
+	require.NoError(Verify(ids.Empty, entries))
+
+	expectedParamsHash := ids.ID(sha256.Sum256([]byte(params)))
+	require.Equal("admin.trackSubnet", entries[0].Method)
+	require.Equal(expectedParamsHash, entries[0].ParamsHash)
+	require.NotEmpty(entries[0].Identity)
+	require.NotContains(entries[0].Identity, "token:token")
+	require.Empty(entries[0].Error)
+
+	require.Empty(entries[1].Identity)
+	require.Equal("failed", entries[1].Error)
+}
@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package audit records the calls made to sensitive APIs in an append-only log
+// whose entries are hash chained, so that modifying or removing an entry
+// invalidates every later entry.
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/perms"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+// Max size, in bytes, of a single encoded entry
+const maxEntrySize = 64 * 1024
+
+var (
+	ErrInvalidHash     = errors.New("invalid hash")
+	ErrInvalidPrevHash = errors.New("invalid previous hash")
+	ErrInvalidIndex    = errors.New("invalid index")
+)
+
+// Entry records a single API call.
+type Entry struct {
+	// Position of the entry in the log, starting at 0
+	Index     avajson.Uint64 `json:"index"`
+	Timestamp time.Time      `json:"timestamp"`
+	// Called API method, such as "admin.trackSubnet"
+	Method string `json:"method"`
+	// Identifies the bearer token the call was authenticated with, if any,
+	// without revealing it
+	Identity   string `json:"identity,omitempty"`
+	RemoteAddr string `json:"remoteAddr"`
+	// Hash of the parameters of the call. The parameters themselves aren't
+	// recorded, as they may be sensitive.
+	ParamsHash ids.ID `json:"paramsHash"`
+	// Error returned by the call, or empty if it succeeded
+	Error string `json:"error,omitempty"`
+	// Hash of the previous entry, or empty for the first entry
+	PrevHash ids.ID `json:"prevHash"`
+	// Hash of this entry, with an empty Hash
+	Hash ids.ID `json:"hash"`
+}
+
+// computeHash returns the hash of [e] with an empty Hash.
+func (e Entry) computeHash() (ids.ID, error) {
+	e.Hash = ids.Empty
+	entryBytes, err := json.Marshal(e)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return sha256.Sum256(entryBytes), nil
+}
+
+// Verify returns nil if [entries] are consecutive entries of a log and each
+// entry is chained to the previous one. The first entry must be chained to
+// [prevHash].
+func Verify(prevHash ids.ID, entries []Entry) error {
+	for i, e := range entries {
+		if i > 0 && e.Index != entries[i-1].Index+1 {
+			return fmt.Errorf("%w: %d follows %d", ErrInvalidIndex, e.Index, entries[i-1].Index)
+		}
+		if e.PrevHash != prevHash {
+			return fmt.Errorf("%w at index %d: expected %s but got %s", ErrInvalidPrevHash, e.Index, prevHash, e.PrevHash)
+		}
+		hash, err := e.computeHash()
+		if err != nil {
+			return err
+		}
+		if e.Hash != hash {
+			return fmt.Errorf("%w at index %d: expected %s but got %s", ErrInvalidHash, e.Index, hash, e.Hash)
+		}
+		prevHash = e.Hash
+	}
+	return nil
+}
+
+type Config struct {
+	// Path of the file that entries are appended to. Rotated files are stored
+	// in the same directory.
+	Path string
+	// Max size, in megabytes, of the file before it is rotated
+	MaxSize int
+	// Max number of rotated files that are kept. If 0, all of them are kept.
+	MaxFiles int
+}
+
+// Log is an append-only log of API calls that is stored in rotated files.
+type Log struct {
+	lock   sync.Mutex
+	config Config
+	writer *lumberjack.Logger
+	// Index of the next entry
+	nextIndex uint64
+	// Hash of the last entry
+	lastHash ids.ID
+}
+
+// New returns the log stored at [config.Path], which continues the hash chain
+// of the entries already stored there.
+func New(config Config) (*Log, error) {
+	if err := os.MkdirAll(filepath.Dir(config.Path), perms.ReadWriteExecute); err != nil {
+		return nil, fmt.Errorf("couldn't create audit log directory: %w", err)
+	}
+
+	l := &Log{
+		config: config,
+		writer: &lumberjack.Logger{
+			Filename:   config.Path,
+			MaxSize:    config.MaxSize,
+			MaxBackups: config.MaxFiles,
+		},
+	}
+
+	// The last entry is in the newest file that isn't empty. The current file
+	// may be empty if the node stopped right after rotating it.
+	paths, err := l.files()
+	if err != nil {
+		return nil, err
+	}
+	for i := len(paths) - 1; i >= 0; i-- {
+		entries, err := readFile(paths[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(entries) > 0 {
+			last := entries[len(entries)-1]
+			l.nextIndex = uint64(last.Index) + 1
+			l.lastHash = last.Hash
+			break
+		}
+	}
+	return l, nil
+}
+
+// Append adds [e] to the log, after setting its index and hashes.
+func (l *Log) Append(e Entry) (Entry, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	e.Index = avajson.Uint64(l.nextIndex)
+	e.PrevHash = l.lastHash
+	hash, err := e.computeHash()
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Hash = hash
+
+	entryBytes, err := json.Marshal(e)
+	if err != nil {
+		return Entry{}, err
+	}
+	entryBytes = append(entryBytes, '\n')
+	if _, err := l.writer.Write(entryBytes); err != nil {
+		return Entry{}, fmt.Errorf("couldn't write audit log entry: %w", err)
+	}
+
+	l.nextIndex++
+	l.lastHash = e.Hash
+	return e, nil
+}
+
+// Entries returns at most [limit] of the stored entries, starting at index
+// [start]. Entries that were removed by rotation are skipped.
+func (l *Log) Entries(start uint64, limit int) ([]Entry, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	paths, err := l.files()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, path := range paths {
+		fileEntries, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range fileEntries {
+			if uint64(e.Index) < start {
+				continue
+			}
+			entries = append(entries, e)
+			if len(entries) >= limit {
+				return entries, nil
+			}
+		}
+	}
+	return entries, nil
+}
+
+func (l *Log) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.writer.Close()
+}
+
+// files returns the paths of the rotated files, from oldest to newest,
+// followed by the path of the current file.
+func (l *Log) files() ([]string, error) {
+	ext := filepath.Ext(l.config.Path)
+	prefix := l.config.Path[:len(l.config.Path)-len(ext)]
+	// Rotated files are named after the UTC time they were rotated at, which
+	// sorts chronologically.
+	paths, err := filepath.Glob(prefix + "-*" + ext)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return append(paths, l.config.Path), nil
+}
+
+// readFile returns the entries stored in the file at [path], or nothing if the
+// file doesn't exist.
+func readFile(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open audit log: %w", err)
+	}
+	defer f.Close()
+
+	var (
+		entries []Entry
+		scanner = bufio.NewScanner(f)
+	)
+	scanner.Buffer(nil, maxEntrySize)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("couldn't parse audit log entry in %s: %w", path, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("couldn't read audit log: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+func newTestLog(t *testing.T, path string) *Log {
+	l, err := New(Config{
+		Path: path,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, l.Close())
+	})
+	return l
+}
+
+func appendEntries(t *testing.T, l *Log, n int) {
+	for i := 0; i < n; i++ {
+		_, err := l.Append(Entry{
+			Timestamp: time.Now().UTC(),
+			Method:    "admin.lockProfile",
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestLogEntries(t *testing.T) {
+	require := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l := newTestLog(t, path)
+	appendEntries(t, l, 3)
+
+	// Rotated files are read before the current file.
+	require.NoError(l.writer.Rotate())
+	appendEntries(t, l, 2)
+
+	entries, err := l.Entries(0, 10)
+	require.NoError(err)
+	require.Len(entries, 5)
+	require.NoError(Verify(ids.Empty, entries))
+
+	entries, err = l.Entries(2, 2)
+	require.NoError(err)
+	require.Len(entries, 2)
+	require.Equal(json.Uint64(2), entries[0].Index)
+	require.Equal(json.Uint64(3), entries[1].Index)
+
+	// Reopening the log continues its hash chain, even if the current file is
+	// empty. Rotated files are named with millisecond precision, so the file
+	// isn't rotated within the same millisecond.
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(l.writer.Rotate())
+	require.NoError(l.Close())
+
+	l = newTestLog(t, path)
+	appendEntries(t, l, 1)
+
+	entries, err = l.Entries(0, 10)
+	require.NoError(err)
+	require.Len(entries, 6)
+	require.NoError(Verify(ids.Empty, entries))
+}
+
+func TestVerify(t *testing.T) {
+	l := newTestLog(t, filepath.Join(t.TempDir(), "audit.log"))
+	appendEntries(t, l, 3)
+	entries, err := l.Entries(0, 3)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		modify      func([]Entry) []Entry
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			modify: func(entries []Entry) []Entry {
+				return entries
+			},
+		},
+		{
+			name: "modified entry",
+			modify: func(entries []Entry) []Entry {
+				entries[1].Method = "admin.trackSubnet"
+				return entries
+			},
+			expectedErr: ErrInvalidHash,
+		},
+		{
+			name: "removed entry",
+			modify: func(entries []Entry) []Entry {
+				return []Entry{entries[0], entries[2]}
+			},
+			expectedErr: ErrInvalidIndex,
+		},
+		{
+			name: "replaced entry",
+			modify: func(entries []Entry) []Entry {
+				entries[1].PrevHash = ids.GenerateTestID()
+				hash, err := entries[1].computeHash()
+				require.NoError(t, err)
+				entries[1].Hash = hash
+				return entries
+			},
+			expectedErr: ErrInvalidPrevHash,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			modified := test.modify(append([]Entry(nil), entries...))
+			err := Verify(ids.Empty, modified)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
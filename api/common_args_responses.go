@@ -126,3 +126,53 @@ type GetUTXOsReply struct {
 	// Encoding specifies the encoding format the UTXOs are returned in
 	Encoding formatting.Encoding `json:"encoding"`
 }
+
+// GetUTXODiffArgs are arguments for passing into GetUTXODiff.
+// Gets the changes made to the UTXOs that reference at least one address in
+// [Addresses] after [Height].
+type GetUTXODiffArgs struct {
+	Addresses []string            `json:"addresses"`
+	Height    avajson.Uint64      `json:"height"`
+	Encoding  formatting.Encoding `json:"encoding"`
+}
+
+// GetUTXODiffReply defines the GetUTXODiff replies returned from the API
+type GetUTXODiffReply struct {
+	// The UTXOs that were added and are still unspent
+	Added []string `json:"added"`
+	// The IDs of the UTXOs that were removed. The removed UTXOs aren't
+	// filtered by address.
+	Removed []ids.ID `json:"removed"`
+	// The height the changes were returned up to. To get the rest of the
+	// changes, call GetUTXODiff again and set [Height] to this value.
+	Height avajson.Uint64 `json:"height"`
+	// Encoding specifies the encoding format the UTXOs are returned in
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetUTXOSnapshotArgs are arguments for passing into GetUTXOSnapshot.
+// Gets at most [Limit] UTXOs of the UTXO set at [Height], starting at
+// [StartUTXOID].
+type GetUTXOSnapshotArgs struct {
+	Height      avajson.Uint64      `json:"height"`
+	StartUTXOID ids.ID              `json:"startUTXOID"`
+	Limit       avajson.Uint32      `json:"limit"`
+	Encoding    formatting.Encoding `json:"encoding"`
+}
+
+// GetUTXOSnapshotReply defines the GetUTXOSnapshot replies returned from the
+// API
+type GetUTXOSnapshotReply struct {
+	// The IDs of the returned UTXOs, in increasing order
+	UTXOIDs []ids.ID `json:"utxoIDs"`
+	// UTXOs[i] is the UTXO with ID UTXOIDs[i]
+	UTXOs []string `json:"utxos"`
+	// The digest of the whole UTXO set at the requested height
+	Digest ids.ID `json:"digest"`
+	// If true, the UTXO set has more UTXOs. To get them, call
+	// GetUTXOSnapshot again and set [StartUTXOID] to [NextUTXOID].
+	More       bool   `json:"more"`
+	NextUTXOID ids.ID `json:"nextUTXOID"`
+	// Encoding specifies the encoding format the UTXOs are returned in
+	Encoding formatting.Encoding `json:"encoding"`
+}
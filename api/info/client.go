@@ -10,7 +10,9 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/version/advisory"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 )
 
@@ -27,9 +29,16 @@ type Client interface {
 	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
 	Peers(context.Context, []ids.NodeID, ...rpc.Option) ([]Peer, error)
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
+	GetChainDiskUsage(context.Context, string, ...rpc.Option) (*GetChainDiskUsageReply, error)
+	GetChainAcceptedLatency(context.Context, string, ...rpc.Option) (*GetChainAcceptedLatencyReply, error)
+	GetChainConsensusParameters(context.Context, string, ...rpc.Option) (*GetChainConsensusParametersReply, error)
+	GetTxParameters(context.Context, string, ...rpc.Option) (*GetTxParametersReply, error)
+	GetSubnetMessageUsage(context.Context, uint32, ...rpc.Option) (*GetSubnetMessageUsageReply, error)
 	Upgrades(context.Context, ...rpc.Option) (*upgrade.Config, error)
 	Uptime(context.Context, ...rpc.Option) (*UptimeResponse, error)
 	GetVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, error)
+	GetLoadedVMs(context.Context, ...rpc.Option) (*GetLoadedVMsReply, error)
+	VerifyBuild(context.Context, *advisory.SignedManifest, ...rpc.Option) (*VerifyBuildReply, error)
 }
 
 // Client implementation for an Info API Client
@@ -98,6 +107,46 @@ func (c *client) IsBootstrapped(ctx context.Context, chainID string, options ...
 	return res.IsBootstrapped, err
 }
 
+func (c *client) GetChainDiskUsage(ctx context.Context, chainID string, options ...rpc.Option) (*GetChainDiskUsageReply, error) {
+	res := &GetChainDiskUsageReply{}
+	err := c.requester.SendRequest(ctx, "info.getChainDiskUsage", &GetChainDiskUsageArgs{
+		Chain: chainID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetChainAcceptedLatency(ctx context.Context, chainID string, options ...rpc.Option) (*GetChainAcceptedLatencyReply, error) {
+	res := &GetChainAcceptedLatencyReply{}
+	err := c.requester.SendRequest(ctx, "info.getChainAcceptedLatency", &GetChainAcceptedLatencyArgs{
+		Chain: chainID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetChainConsensusParameters(ctx context.Context, chainID string, options ...rpc.Option) (*GetChainConsensusParametersReply, error) {
+	res := &GetChainConsensusParametersReply{}
+	err := c.requester.SendRequest(ctx, "info.getChainConsensusParameters", &GetChainConsensusParametersArgs{
+		Chain: chainID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetTxParameters(ctx context.Context, chainID string, options ...rpc.Option) (*GetTxParametersReply, error) {
+	res := &GetTxParametersReply{}
+	err := c.requester.SendRequest(ctx, "info.getTxParameters", &GetTxParametersArgs{
+		Chain: chainID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetSubnetMessageUsage(ctx context.Context, limit uint32, options ...rpc.Option) (*GetSubnetMessageUsageReply, error) {
+	res := &GetSubnetMessageUsageReply{}
+	err := c.requester.SendRequest(ctx, "info.getSubnetMessageUsage", &GetSubnetMessageUsageArgs{
+		Limit: json.Uint32(limit),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) Upgrades(ctx context.Context, options ...rpc.Option) (*upgrade.Config, error) {
 	res := &upgrade.Config{}
 	err := c.requester.SendRequest(ctx, "info.upgrades", struct{}{}, res, options...)
@@ -116,6 +165,20 @@ func (c *client) GetVMs(ctx context.Context, options ...rpc.Option) (map[ids.ID]
 	return res.VMs, err
 }
 
+func (c *client) GetLoadedVMs(ctx context.Context, options ...rpc.Option) (*GetLoadedVMsReply, error) {
+	res := &GetLoadedVMsReply{}
+	err := c.requester.SendRequest(ctx, "info.getLoadedVMs", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) VerifyBuild(ctx context.Context, signedManifest *advisory.SignedManifest, options ...rpc.Option) (*VerifyBuildReply, error) {
+	res := &VerifyBuildReply{}
+	err := c.requester.SendRequest(ctx, "info.verifyBuild", &VerifyBuildArgs{
+		SignedManifest: *signedManifest,
+	}, res, options...)
+	return res, err
+}
+
 // AwaitBootstrapped polls the node every [freq] to check if [chainID] has
 // finished bootstrapping. Returns true once [chainID] reports that it has
 // finished bootstrapping.
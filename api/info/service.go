@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade"
@@ -26,15 +27,19 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/version/advisory"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/nftfx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 )
 
 var (
-	errNoChainProvided = errors.New("argument 'chain' not given")
+	errNoChainProvided     = errors.New("argument 'chain' not given")
+	errNoTrustedPublishers = errors.New("no trusted release publishers are configured")
 
 	mainnetGetTxFeeResponse = GetTxFeeResponse{
 		CreateSubnetTxFee:             json.Uint64(1 * units.Avax),
@@ -84,6 +89,9 @@ type Parameters struct {
 	NetworkID uint32
 	VMManager vms.Manager
 	Upgrades  upgrade.Config
+	// TrustedReleasePublishers are the addresses of the keys trusted to sign
+	// release manifests.
+	TrustedReleasePublishers set.Set[ids.ShortID]
 
 	TxFee            uint64
 	CreateAssetTxFee uint64
@@ -125,6 +133,8 @@ type GetNodeVersionReply struct {
 	RPCProtocolVersion json.Uint32       `json:"rpcProtocolVersion"`
 	GitCommit          string            `json:"gitCommit"`
 	VMVersions         map[string]string `json:"vmVersions"`
+	// BuildInfo describes how the node's binary was built.
+	BuildInfo *version.BuildInfo `json:"buildInfo"`
 }
 
 // GetNodeVersion returns the version this node is running
@@ -144,6 +154,60 @@ func (i *Info) GetNodeVersion(_ *http.Request, _ *struct{}, reply *GetNodeVersio
 	reply.RPCProtocolVersion = json.Uint32(version.RPCChainVMProtocol)
 	reply.GitCommit = version.GitCommit
 	reply.VMVersions = vmVersions
+	reply.BuildInfo, err = version.GetBuildInfo()
+	if err != nil {
+		i.log.Warn("failed to hash the node's binary",
+			zap.Error(err),
+		)
+	}
+	return nil
+}
+
+type VerifyBuildArgs struct {
+	// SignedManifest is a release manifest signed by a trusted publisher.
+	SignedManifest advisory.SignedManifest `json:"signedManifest"`
+}
+
+type VerifyBuildReply struct {
+	// Verified is true if the node's binary is the published binary of the
+	// release the node is running.
+	Verified bool `json:"verified"`
+	// Release is the release the node is running, if it is listed in the
+	// manifest.
+	Release   *advisory.Release  `json:"release,omitempty"`
+	BuildInfo *version.BuildInfo `json:"buildInfo"`
+	// Error is why the node's binary couldn't be verified.
+	Error string `json:"error,omitempty"`
+}
+
+// VerifyBuild compares the node's binary against the binaries listed by a
+// signed release manifest for this node's network.
+func (i *Info) VerifyBuild(_ *http.Request, args *VerifyBuildArgs, reply *VerifyBuildReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "verifyBuild"),
+	)
+
+	if i.TrustedReleasePublishers.Len() == 0 {
+		return errNoTrustedPublishers
+	}
+	manifest, err := advisory.ParseReleaseManifest(&args.SignedManifest, i.TrustedReleasePublishers)
+	if err != nil {
+		return err
+	}
+	if err := manifest.Verify(i.NetworkID); err != nil {
+		return err
+	}
+
+	reply.BuildInfo, err = version.GetBuildInfo()
+	if err != nil {
+		return fmt.Errorf("couldn't get build info: %w", err)
+	}
+	reply.Release, err = manifest.VerifyBuild(version.Current, reply.BuildInfo)
+	reply.Verified = err == nil
+	if err != nil {
+		reply.Error = err.Error()
+	}
 	return nil
 }
 
@@ -317,6 +381,263 @@ func (i *Info) IsBootstrapped(_ *http.Request, args *IsBootstrappedArgs, reply *
 	return nil
 }
 
+// GetChainDiskUsageArgs are the arguments for calling GetChainDiskUsage
+type GetChainDiskUsageArgs struct {
+	// Alias of the chain
+	// Can also be the string representation of the chain's ID
+	Chain string `json:"chain"`
+}
+
+// GetChainDiskUsageReply are the results from calling GetChainDiskUsage
+type GetChainDiskUsageReply struct {
+	ChainID ids.ID `json:"chainID"`
+	// Approximate number of bytes used on disk by the chain
+	TotalBytes json.Uint64 `json:"totalBytes"`
+	// Approximate number of bytes used in the node's database by each of the
+	// chain's prefixed databases, keyed by the path of their prefixes
+	DatabaseBytes map[string]json.Uint64 `json:"databaseBytes"`
+	// Number of bytes in the chain's data directory
+	ChainDataDirBytes json.Uint64 `json:"chainDataDirBytes"`
+}
+
+// GetChainDiskUsage returns the approximate disk usage of [args.Chain]
+func (i *Info) GetChainDiskUsage(_ *http.Request, args *GetChainDiskUsageArgs, reply *GetChainDiskUsageReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getChainDiskUsage"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := i.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+	usage, err := i.chainManager.GetChainDiskUsage(chainID)
+	if err != nil {
+		return err
+	}
+
+	reply.ChainID = chainID
+	reply.TotalBytes = json.Uint64(usage.Total())
+	reply.DatabaseBytes = make(map[string]json.Uint64, len(usage.Database))
+	for path, size := range usage.Database {
+		reply.DatabaseBytes[path] = json.Uint64(size)
+	}
+	reply.ChainDataDirBytes = json.Uint64(usage.ChainDataDir)
+	return nil
+}
+
+// GetChainAcceptedLatencyArgs are the arguments for calling
+// GetChainAcceptedLatency
+type GetChainAcceptedLatencyArgs struct {
+	// Alias of the chain
+	// Can also be the string representation of the chain's ID
+	Chain string `json:"chain"`
+}
+
+// GetChainAcceptedLatencyReply are the results from calling
+// GetChainAcceptedLatency
+type GetChainAcceptedLatencyReply struct {
+	ChainID ids.ID `json:"chainID"`
+	// Number of blocks accepted since the node started
+	Count json.Uint64 `json:"count"`
+	// Total number of seconds from the issuance of the accepted blocks to
+	// their acceptance
+	Sum json.Float64 `json:"sum"`
+	// Cumulative number of accepted blocks within each upper bound
+	Buckets []LatencyBucket `json:"buckets"`
+}
+
+type LatencyBucket struct {
+	// Upper bound of the bucket, in seconds
+	UpperBound json.Float64 `json:"upperBound"`
+	Count      json.Uint64  `json:"count"`
+}
+
+// GetChainAcceptedLatency returns the distribution of the time from the
+// issuance of the blocks of [args.Chain] to their acceptance
+func (i *Info) GetChainAcceptedLatency(_ *http.Request, args *GetChainAcceptedLatencyArgs, reply *GetChainAcceptedLatencyReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getChainAcceptedLatency"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := i.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+	latency, err := i.chainManager.GetChainAcceptedLatency(chainID)
+	if err != nil {
+		return err
+	}
+
+	reply.ChainID = chainID
+	reply.Count = json.Uint64(latency.Count)
+	reply.Sum = json.Float64(latency.Sum.Seconds())
+	reply.Buckets = make([]LatencyBucket, len(latency.Buckets))
+	for i, bucket := range latency.Buckets {
+		reply.Buckets[i] = LatencyBucket{
+			UpperBound: json.Float64(bucket.UpperBound.Seconds()),
+			Count:      json.Uint64(bucket.Count),
+		}
+	}
+	return nil
+}
+
+// GetChainConsensusParametersArgs are the arguments for calling
+// GetChainConsensusParameters
+type GetChainConsensusParametersArgs struct {
+	// Alias of the chain
+	// Can also be the string representation of the chain's ID
+	Chain string `json:"chain"`
+}
+
+// GetChainConsensusParametersReply are the results from calling
+// GetChainConsensusParameters
+type GetChainConsensusParametersReply struct {
+	ChainID    ids.ID              `json:"chainID"`
+	Parameters snowball.Parameters `json:"parameters"`
+}
+
+// GetChainConsensusParameters returns the consensus parameters that
+// [args.Chain] runs with, after the overrides of its chain config are applied
+func (i *Info) GetChainConsensusParameters(_ *http.Request, args *GetChainConsensusParametersArgs, reply *GetChainConsensusParametersReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getChainConsensusParameters"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := i.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+	params, err := i.chainManager.GetChainConsensusParameters(chainID)
+	if err != nil {
+		return err
+	}
+
+	reply.ChainID = chainID
+	reply.Parameters = params
+	return nil
+}
+
+// GetTxParametersArgs are the arguments for calling GetTxParameters
+type GetTxParametersArgs struct {
+	// Alias of the chain
+	// Can also be the string representation of the chain's ID
+	Chain string `json:"chain"`
+}
+
+// GetTxParametersReply are the results from calling GetTxParameters
+type GetTxParametersReply struct {
+	ChainID ids.ID        `json:"chainID"`
+	Limits  limits.Limits `json:"limits"`
+}
+
+// GetTxParameters returns the limits that [args.Chain] enforces on the size
+// and complexity of its txs and blocks
+func (i *Info) GetTxParameters(_ *http.Request, args *GetTxParametersArgs, reply *GetTxParametersReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getTxParameters"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := i.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+	txLimits, err := i.chainManager.GetChainTxLimits(chainID)
+	if err != nil {
+		return err
+	}
+
+	reply.ChainID = chainID
+	reply.Limits = txLimits
+	return nil
+}
+
+// GetSubnetMessageUsageArgs are the arguments for calling
+// GetSubnetMessageUsage
+type GetSubnetMessageUsageArgs struct {
+	// Maximum number of subnets to return
+	// If 0, all subnets are returned
+	Limit json.Uint32 `json:"limit"`
+}
+
+// GetSubnetMessageUsageReply are the results from calling
+// GetSubnetMessageUsage
+type GetSubnetMessageUsageReply struct {
+	// Subnets sorted by decreasing number of bytes sent and received
+	Subnets []SubnetMessageUsage `json:"subnets"`
+}
+
+type SubnetMessageUsage struct {
+	SubnetID ids.ID `json:"subnetID"`
+	// Number of bytes sent and received
+	TotalBytes json.Uint64 `json:"totalBytes"`
+	MessageUsage
+	// Usage of each message op
+	Ops map[string]MessageUsage `json:"ops"`
+}
+
+type MessageUsage struct {
+	SentMessages     json.Uint64 `json:"sentMessages"`
+	SentBytes        json.Uint64 `json:"sentBytes"`
+	ReceivedMessages json.Uint64 `json:"receivedMessages"`
+	ReceivedBytes    json.Uint64 `json:"receivedBytes"`
+}
+
+func newMessageUsage(usage chains.MessageUsage) MessageUsage {
+	return MessageUsage{
+		SentMessages:     json.Uint64(usage.SentMessages),
+		SentBytes:        json.Uint64(usage.SentBytes),
+		ReceivedMessages: json.Uint64(usage.ReceivedMessages),
+		ReceivedBytes:    json.Uint64(usage.ReceivedBytes),
+	}
+}
+
+// GetSubnetMessageUsage returns the number of messages, and their bytes, sent
+// and received by the chains of the subnets that used the most bandwidth since
+// the node started
+func (i *Info) GetSubnetMessageUsage(_ *http.Request, args *GetSubnetMessageUsageArgs, reply *GetSubnetMessageUsageReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getSubnetMessageUsage"),
+		zap.Uint32("limit", uint32(args.Limit)),
+	)
+
+	usages := i.chainManager.GetSubnetMessageUsage(int(args.Limit))
+	reply.Subnets = make([]SubnetMessageUsage, len(usages))
+	for i, usage := range usages {
+		ops := make(map[string]MessageUsage, len(usage.Ops))
+		for op, opUsage := range usage.Ops {
+			ops[op.String()] = newMessageUsage(opUsage)
+		}
+		reply.Subnets[i] = SubnetMessageUsage{
+			SubnetID:     usage.SubnetID,
+			TotalBytes:   json.Uint64(usage.TotalBytes()),
+			MessageUsage: newMessageUsage(usage.MessageUsage),
+			Ops:          ops,
+		}
+	}
+	return nil
+}
+
 // Upgrades returns the upgrade schedule this node is running.
 func (i *Info) Upgrades(_ *http.Request, _ *struct{}, reply *upgrade.Config) error {
 	i.log.Debug("API called",
@@ -477,3 +798,88 @@ func (i *Info) GetVMs(_ *http.Request, _ *struct{}, reply *GetVMsReply) error {
 	}
 	return err
 }
+
+// PluginVM describes the plugin binary a VM runs as
+type PluginVM struct {
+	Path string `json:"path"`
+	// RPCChainVM protocol version implemented by the plugin. 0 if the plugin
+	// never completed a handshake.
+	RPCProtocolVersion json.Uint32 `json:"rpcProtocolVersion"`
+	// False if this node doesn't support the plugin's protocol version
+	Compatible bool `json:"compatible"`
+	// Why the last handshake with the plugin failed, if it did
+	Error string `json:"error,omitempty"`
+}
+
+// LoadedVM describes a virtual machine installed on the node
+type LoadedVM struct {
+	Aliases []string `json:"aliases"`
+	// Version reported by the VM, if it reported one
+	Version string `json:"version,omitempty"`
+	// Only set if the VM runs as a plugin
+	Plugin *PluginVM `json:"plugin,omitempty"`
+}
+
+// GetLoadedVMsReply contains the response metadata for GetLoadedVMs
+type GetLoadedVMsReply struct {
+	VMs map[ids.ID]LoadedVM `json:"vms"`
+	// RPCChainVM protocol versions that plugins must implement to be run
+	SupportedRPCProtocolVersions []json.Uint32 `json:"supportedRPCProtocolVersions"`
+}
+
+// GetLoadedVMs lists the virtual machines installed on the node along with
+// their versions and, for plugins, the RPCChainVM protocol version they
+// implement
+func (i *Info) GetLoadedVMs(_ *http.Request, _ *struct{}, reply *GetLoadedVMsReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getLoadedVMs"),
+	)
+
+	vmIDs, err := i.VMManager.ListFactories()
+	if err != nil {
+		return err
+	}
+	aliases, err := ids.GetRelevantAliases(i.VMManager, vmIDs)
+	if err != nil {
+		return err
+	}
+	versions, err := i.VMManager.Versions()
+	if err != nil {
+		return err
+	}
+	plugins, err := rpcchainvm.GetPlugins(i.VMManager)
+	if err != nil {
+		return err
+	}
+
+	reply.VMs = make(map[ids.ID]LoadedVM, len(vmIDs))
+	for _, vmID := range vmIDs {
+		primaryAlias, err := i.VMManager.PrimaryAlias(vmID)
+		if err != nil {
+			return err
+		}
+
+		vm := LoadedVM{
+			Aliases: aliases[vmID],
+			Version: versions[primaryAlias],
+		}
+		if plugin, ok := plugins[vmID]; ok {
+			vm.Plugin = &PluginVM{
+				Path:               plugin.Path,
+				RPCProtocolVersion: json.Uint32(plugin.ProtocolVersion),
+				Compatible:         plugin.Compatible(),
+			}
+			if plugin.Err != nil {
+				vm.Plugin.Error = plugin.Err.Error()
+			}
+		}
+		reply.VMs[vmID] = vm
+	}
+
+	reply.SupportedRPCProtocolVersions = make([]json.Uint32, len(version.SupportedRPCChainVMProtocols))
+	for j, protocolVersion := range version.SupportedRPCChainVMProtocols {
+		reply.SupportedRPCProtocolVersions[j] = json.Uint32(protocolVersion)
+	}
+	return nil
+}
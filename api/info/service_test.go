@@ -11,7 +11,15 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/version/advisory"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
 	"github.com/ava-labs/avalanchego/vms/vmsmock"
 )
 
@@ -94,3 +102,124 @@ func TestGetVMsGetAliasesFails(t *testing.T) {
 	err := resources.info.GetVMs(nil, nil, &reply)
 	require.ErrorIs(t, err, errTest)
 }
+
+type testPluginFactory struct {
+	plugin rpcchainvm.Plugin
+}
+
+func (*testPluginFactory) New(logging.Logger) (interface{}, error) {
+	return nil, nil
+}
+
+func (f *testPluginFactory) Plugin() rpcchainvm.Plugin {
+	return f.plugin
+}
+
+func TestGetLoadedVMs(t *testing.T) {
+	require := require.New(t)
+
+	resources := initGetVMsTest(t)
+
+	var (
+		builtinID = ids.GenerateTestID()
+		pluginID  = ids.GenerateTestID()
+		vmIDs     = []ids.ID{builtinID, pluginID}
+		factory   = &testPluginFactory{
+			plugin: rpcchainvm.Plugin{
+				Path:            "plugins/vm",
+				ProtocolVersion: version.RPCChainVMProtocol + 1,
+				Err: &runtime.ProtocolVersionError{
+					Path:            "plugins/vm",
+					ProtocolVersion: version.RPCChainVMProtocol + 1,
+				},
+			},
+		}
+	)
+
+	resources.mockVMManager.EXPECT().ListFactories().Return(vmIDs, nil).Times(2)
+	resources.mockVMManager.EXPECT().Aliases(builtinID).Return([]string{"builtin", builtinID.String()}, nil)
+	resources.mockVMManager.EXPECT().Aliases(pluginID).Return([]string{pluginID.String()}, nil)
+	resources.mockVMManager.EXPECT().Versions().Return(map[string]string{"builtin": "v1.0.0"}, nil)
+	resources.mockVMManager.EXPECT().GetFactory(builtinID).Return(nil, nil)
+	resources.mockVMManager.EXPECT().GetFactory(pluginID).Return(factory, nil)
+	resources.mockVMManager.EXPECT().PrimaryAlias(builtinID).Return("builtin", nil)
+	resources.mockVMManager.EXPECT().PrimaryAlias(pluginID).Return(pluginID.String(), nil)
+
+	reply := GetLoadedVMsReply{}
+	require.NoError(resources.info.GetLoadedVMs(nil, nil, &reply))
+	require.Equal(
+		map[ids.ID]LoadedVM{
+			builtinID: {
+				Aliases: []string{"builtin"},
+				Version: "v1.0.0",
+			},
+			pluginID: {
+				Aliases: []string{},
+				Plugin: &PluginVM{
+					Path:               "plugins/vm",
+					RPCProtocolVersion: json.Uint32(version.RPCChainVMProtocol + 1),
+					Compatible:         false,
+					Error:              factory.plugin.Err.Error(),
+				},
+			},
+		},
+		reply.VMs,
+	)
+	require.Equal([]json.Uint32{json.Uint32(version.RPCChainVMProtocol), json.Uint32(version.RPCChainVMProtocol - 1)}, reply.SupportedRPCProtocolVersions)
+}
+
+func TestVerifyBuild(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	build, err := version.GetBuildInfo()
+	require.NoError(err)
+
+	signManifest := func(networkID uint32, binaryHash string) *advisory.SignedManifest {
+		signed, err := advisory.SignReleaseManifest(&advisory.ReleaseManifest{
+			NetworkID: networkID,
+			Releases: []advisory.Release{{
+				Version: version.Current.String(),
+				Commit:  build.Commit,
+				Binaries: []advisory.Binary{{
+					Platform: build.Platform,
+					SHA256:   binaryHash,
+				}},
+			}},
+		}, key)
+		require.NoError(err)
+		return signed
+	}
+
+	info := &Info{
+		Parameters: Parameters{
+			NetworkID: constants.FujiID,
+		},
+		log: logging.NoLog{},
+	}
+	args := &VerifyBuildArgs{
+		SignedManifest: *signManifest(constants.FujiID, build.BinaryHash),
+	}
+	err = info.VerifyBuild(nil, args, &VerifyBuildReply{})
+	require.ErrorIs(err, errNoTrustedPublishers)
+
+	info.TrustedReleasePublishers = set.Of(key.Address())
+	reply := &VerifyBuildReply{}
+	require.NoError(info.VerifyBuild(nil, args, reply))
+	require.True(reply.Verified)
+	require.Empty(reply.Error)
+	require.Equal(build.Commit, reply.Release.Commit)
+	require.Equal(build.BinaryHash, reply.BuildInfo.BinaryHash)
+
+	args.SignedManifest = *signManifest(constants.FujiID, ids.Empty.Hex())
+	reply = &VerifyBuildReply{}
+	require.NoError(info.VerifyBuild(nil, args, reply))
+	require.False(reply.Verified)
+	require.NotEmpty(reply.Error)
+
+	args.SignedManifest = *signManifest(constants.MainnetID, build.BinaryHash)
+	err = info.VerifyBuild(nil, args, &VerifyBuildReply{})
+	require.ErrorIs(err, advisory.ErrWrongNetworkID)
+}
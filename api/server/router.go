@@ -70,6 +70,37 @@ func (r *router) AddRouter(base, endpoint string, handler http.Handler) error {
 	return r.addRouter(base, endpoint, handler)
 }
 
+// SetRouter adds [handler] at [base]+[endpoint], replacing the handler
+// previously added there, if any.
+func (r *router) SetRouter(base, endpoint string, handler http.Handler) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.routeLock.Lock()
+	defer r.routeLock.Unlock()
+
+	if _, exists := r.routes[base][endpoint]; !exists {
+		return r.addRouter(base, endpoint, handler)
+	}
+	r.replaceRouter(base, endpoint, handler)
+	return nil
+}
+
+func (r *router) replaceRouter(base, endpoint string, handler http.Handler) {
+	if _, exists := r.routes[base][endpoint]; !exists {
+		return
+	}
+
+	url := base + endpoint
+	r.routes[base][endpoint] = handler
+	if route := r.router.Get(url); route != nil {
+		route.Handler(handler)
+	}
+
+	for _, alias := range r.aliases[base] {
+		r.replaceRouter(alias, endpoint, handler)
+	}
+}
+
 func (r *router) addRouter(base, endpoint string, handler http.Handler) error {
 	if r.reservedRoutes.Contains(base) {
 		return fmt.Errorf("%w: %s", errAlreadyReserved, base)
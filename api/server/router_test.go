@@ -58,3 +58,30 @@ func TestBlock(t *testing.T) {
 	err := r.AddRouter("1", "", handler1)
 	require.ErrorIs(err, errAlreadyReserved)
 }
+
+func TestSetRouter(t *testing.T) {
+	require := require.New(t)
+	r := newRouter()
+
+	require.NoError(r.AddAlias("/1", "/2"))
+
+	handler1 := &testHandler{}
+	require.NoError(r.SetRouter("/1", "", handler1))
+
+	handler2 := &testHandler{}
+	require.NoError(r.SetRouter("/1", "", handler2))
+
+	handler, err := r.GetHandler("/1", "")
+	require.NoError(err)
+	require.Equal(handler2, handler)
+
+	handler, err = r.GetHandler("/2", "")
+	require.NoError(err)
+	require.Equal(handler2, handler)
+
+	request, err := http.NewRequest(http.MethodGet, "/2", nil)
+	require.NoError(err)
+	r.ServeHTTP(nil, request)
+	require.False(handler1.called)
+	require.True(handler2.called)
+}
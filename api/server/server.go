@@ -22,6 +22,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -92,6 +93,9 @@ type server struct {
 	// Maps endpoints to handlers
 	router *router
 
+	// API restrictions of the chains of each Subnet
+	subnetConfigs map[ids.ID]subnets.Config
+
 	srv *http.Server
 
 	// Listener used to serve traffic
@@ -111,6 +115,7 @@ func New(
 	registerer prometheus.Registerer,
 	httpConfig HTTPConfig,
 	allowedHosts []string,
+	subnetConfigs map[ids.ID]subnets.Config,
 ) (Server, error) {
 	m, err := newMetrics(registerer)
 	if err != nil {
@@ -158,6 +163,7 @@ func New(
 		tracer:          tracer,
 		metrics:         m,
 		router:          router,
+		subnetConfigs:   subnetConfigs,
 		srv:             httpServer,
 		listener:        listener,
 	}, nil
@@ -216,8 +222,12 @@ func (s *server) addChainRoute(chainName string, handler http.Handler, ctx *snow
 	}
 	// Apply middleware to reject calls to the handler before the chain finishes bootstrapping
 	handler = rejectMiddleware(handler, ctx)
+	// Apply the API restrictions of the chain's Subnet
+	handler = filterSubnetAPI(handler, s.subnetConfigs[ctx.SubnetID])
 	handler = s.metrics.wrapHandler(chainName, handler)
-	return s.router.AddRouter(url, endpoint, handler)
+	// The routes of a restarted chain replace the routes of its previous
+	// instance.
+	return s.router.SetRouter(url, endpoint, handler)
 }
 
 func (s *server) AddRoute(handler http.Handler, base, endpoint string) error {
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const bearerPrefix = "Bearer "
+
+var (
+	_ http.Handler = (*subnetAPIHandler)(nil)
+
+	errNotJSONRPCRequest = errors.New("only JSON-RPC POST requests are allowed")
+)
+
+// filterSubnetAPI wraps the handler of a chain to enforce the API restrictions
+// of the chain's Subnet.
+func filterSubnetAPI(handler http.Handler, config subnets.Config) http.Handler {
+	if config.APIAllowedMethods.Len() == 0 && !config.PrivateAPI {
+		return handler
+	}
+
+	h := &subnetAPIHandler{
+		handler:        handler,
+		allowedMethods: config.APIAllowedMethods,
+	}
+	if config.PrivateAPI {
+		h.authTokens = make([][]byte, 0, config.APIAuthTokens.Len())
+		for token := range config.APIAuthTokens {
+			h.authTokens = append(h.authTokens, []byte(token))
+		}
+	}
+	return h
+}
+
+// subnetAPIHandler is an implementation of http.Handler that only serves
+// authenticated requests to the allowed methods.
+type subnetAPIHandler struct {
+	handler http.Handler
+	// If non-empty, only calls to these methods are served
+	allowedMethods set.Set[string]
+	// If non-nil, requests must provide one of these bearer tokens
+	authTokens [][]byte
+}
+
+func (s *subnetAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.authTokens != nil && !s.authorized(r) {
+		http.Error(w, "invalid or missing API auth token", http.StatusUnauthorized)
+		return
+	}
+
+	if s.allowedMethods.Len() == 0 {
+		s.handler.ServeHTTP(w, r)
+		return
+	}
+
+	methods, err := readMethods(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	for _, method := range methods {
+		if !s.allowedMethods.Contains(method) {
+			http.Error(w, "method "+method+" is not allowed", http.StatusForbidden)
+			return
+		}
+	}
+	s.handler.ServeHTTP(w, r)
+}
+
+func (s *subnetAPIHandler) authorized(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return false
+	}
+	token := []byte(strings.TrimPrefix(header, bearerPrefix))
+
+	// Compare against every token so the time taken doesn't leak which token
+	// was matched.
+	var authorized bool
+	for _, authToken := range s.authTokens {
+		if subtle.ConstantTimeCompare(token, authToken) == 1 {
+			authorized = true
+		}
+	}
+	return authorized
+}
+
+// readMethods returns the methods called by the JSON-RPC request, or batch of
+// requests, in [r]. The body of [r] is restored so that it can be read again.
+//
+// Requests that aren't JSON-RPC requests, such as websocket upgrades, are
+// rejected as their calls can't be inspected.
+func readMethods(r *http.Request) ([]string, error) {
+	if r.Method != http.MethodPost {
+		return nil, errNotJSONRPCRequest
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	type call struct {
+		Method string `json:"method"`
+	}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var calls []call
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil, errNotJSONRPCRequest
+		}
+		methods := make([]string, len(calls))
+		for i, c := range calls {
+			methods[i] = c.Method
+		}
+		return methods, nil
+	}
+
+	var c call
+	if err := json.Unmarshal(body, &c); err != nil {
+		return nil, errNotJSONRPCRequest
+	}
+	return []string{c.Method}, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func TestSubnetAPIHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       subnets.Config
+		httpMethod   string
+		body         string
+		authHeader   string
+		expectedCode int
+	}{
+		{
+			name:         "no restrictions",
+			httpMethod:   http.MethodGet,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "allowed method",
+			config: subnets.Config{
+				APIAllowedMethods: set.Of("eth_call"),
+			},
+			httpMethod:   http.MethodPost,
+			body:         `{"jsonrpc":"2.0","id":1,"method":"eth_call"}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "disallowed method",
+			config: subnets.Config{
+				APIAllowedMethods: set.Of("eth_call"),
+			},
+			httpMethod:   http.MethodPost,
+			body:         `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "batch with disallowed method",
+			config: subnets.Config{
+				APIAllowedMethods: set.Of("eth_call"),
+			},
+			httpMethod:   http.MethodPost,
+			body:         `[{"method":"eth_call"},{"method":"debug_traceTransaction"}]`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "batch with allowed methods",
+			config: subnets.Config{
+				APIAllowedMethods: set.Of("eth_call", "eth_chainId"),
+			},
+			httpMethod:   http.MethodPost,
+			body:         ` [{"method":"eth_call"},{"method":"eth_chainId"}]`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "non JSON-RPC request with allowed methods",
+			config: subnets.Config{
+				APIAllowedMethods: set.Of("eth_call"),
+			},
+			httpMethod:   http.MethodGet,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "missing auth token",
+			config: subnets.Config{
+				PrivateAPI:    true,
+				APIAuthTokens: set.Of("secret"),
+			},
+			httpMethod:   http.MethodPost,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong auth token",
+			config: subnets.Config{
+				PrivateAPI:    true,
+				APIAuthTokens: set.Of("secret"),
+			},
+			httpMethod:   http.MethodPost,
+			authHeader:   "Bearer wrong",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "valid auth token",
+			config: subnets.Config{
+				PrivateAPI:    true,
+				APIAuthTokens: set.Of("secret", "other"),
+			},
+			httpMethod:   http.MethodPost,
+			authHeader:   "Bearer secret",
+			expectedCode: http.StatusOK,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var servedBody string
+			baseHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(err)
+				servedBody = string(body)
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := filterSubnetAPI(baseHandler, test.config)
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(test.httpMethod, "/", strings.NewReader(test.body))
+			if test.authHeader != "" {
+				r.Header.Set("Authorization", test.authHeader)
+			}
+			handler.ServeHTTP(w, r)
+
+			require.Equal(test.expectedCode, w.Code)
+			if test.expectedCode == http.StatusOK {
+				// The handler must still be able to read the request.
+				require.Equal(test.body, servedBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	pvmtxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+)
+
+var (
+	feeCalculator = txfee.NewDynamicCalculator(
+		gas.Dimensions{
+			gas.Bandwidth: 1,
+			gas.DBRead:    1_000,
+			gas.DBWrite:   1_000,
+			gas.Compute:   4,
+		},
+		gas.Price(units.NanoAvax),
+	)
+
+	// pChainAllocThresholds and xChainAllocThresholds are the maximum number
+	// of allocations per operation allowed for each transaction in the
+	// corpora. An increase beyond these thresholds fails
+	// TestAllocationRegressions and should be justified before updating the
+	// threshold.
+	//
+	// Operation -> Tx name -> Max allocs per op
+	pChainAllocThresholds = map[string]map[string]float64{
+		"CalculateFee": {
+			"BaseTx":                1,
+			"BaseTx with 16 inputs": 1,
+			"CreateSubnetTx":        1,
+			"ImportTx":              1,
+			"ExportTx":              1,
+		},
+		"CodecSize": {
+			"BaseTx":                4,
+			"BaseTx with 16 inputs": 4,
+			"CreateSubnetTx":        4,
+			"ImportTx":              4,
+			"ExportTx":              4,
+		},
+		"Sign": {
+			"BaseTx":                53,
+			"BaseTx with 16 inputs": 591,
+			"CreateSubnetTx":        53,
+			"ImportTx":              89,
+			"ExportTx":              53,
+		},
+	}
+	xChainAllocThresholds = map[string]map[string]float64{
+		"CodecSize": {
+			"BaseTx":                4,
+			"BaseTx with 16 inputs": 4,
+			"CreateAssetTx":         4,
+			"ExportTx":              4,
+		},
+		"Sign": {
+			"BaseTx":                54,
+			"BaseTx with 16 inputs": 607,
+			"CreateAssetTx":         54,
+			"ExportTx":              54,
+		},
+	}
+)
+
+func BenchmarkPChainCalculateFee(b *testing.B) {
+	corpus, err := PChainCorpus()
+	require.NoError(b, err)
+
+	for _, tx := range corpus {
+		b.Run(tx.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = feeCalculator.CalculateFee(tx.Tx)
+			}
+		})
+	}
+}
+
+func BenchmarkPChainCodecSize(b *testing.B) {
+	corpus, err := PChainCorpus()
+	require.NoError(b, err)
+
+	for _, tx := range corpus {
+		b.Run(tx.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = pvmtxs.Codec.Size(pvmtxs.CodecVersion, &tx.Tx)
+			}
+		})
+	}
+}
+
+func BenchmarkPChainSign(b *testing.B) {
+	corpus, err := PChainCorpus()
+	require.NoError(b, err)
+
+	for _, tx := range corpus {
+		b.Run(tx.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = tx.Sign()
+			}
+		})
+	}
+}
+
+func BenchmarkXChainCodecSize(b *testing.B) {
+	parser, err := NewXChainParser()
+	require.NoError(b, err)
+	corpus, err := XChainCorpus()
+	require.NoError(b, err)
+
+	codec := parser.Codec()
+	for _, tx := range corpus {
+		b.Run(tx.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = codec.Size(avmtxs.CodecVersion, &tx.Tx)
+			}
+		})
+	}
+}
+
+func BenchmarkXChainSign(b *testing.B) {
+	parser, err := NewXChainParser()
+	require.NoError(b, err)
+	corpus, err := XChainCorpus()
+	require.NoError(b, err)
+
+	for _, tx := range corpus {
+		b.Run(tx.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = tx.Sign(parser)
+			}
+		})
+	}
+}
+
+// TestCorpora ensures that every transaction in the corpora is well formed, so
+// that benchmarks never measure an early error return.
+func TestCorpora(t *testing.T) {
+	require := require.New(t)
+
+	pCorpus, err := PChainCorpus()
+	require.NoError(err)
+	for _, tx := range pCorpus {
+		_, err := feeCalculator.CalculateFee(tx.Tx)
+		require.NoError(err, tx.Name)
+
+		_, err = tx.Sign()
+		require.NoError(err, tx.Name)
+	}
+
+	parser, err := NewXChainParser()
+	require.NoError(err)
+	xCorpus, err := XChainCorpus()
+	require.NoError(err)
+	for _, tx := range xCorpus {
+		_, err := tx.Sign(parser)
+		require.NoError(err, tx.Name)
+	}
+}
+
+// TestAllocationRegressions fails if any benchmarked operation allocates more
+// than its threshold. Unlike timings, allocation counts are stable across
+// machines, which makes them suitable for enforcement in CI.
+func TestAllocationRegressions(t *testing.T) {
+	pCorpus, err := PChainCorpus()
+	require.NoError(t, err)
+
+	parser, err := NewXChainParser()
+	require.NoError(t, err)
+	xCodec := parser.Codec()
+	xCorpus, err := XChainCorpus()
+	require.NoError(t, err)
+
+	for _, tx := range pCorpus {
+		t.Run("P/CalculateFee/"+tx.Name, func(t *testing.T) {
+			requireAllocsWithin(t, pChainAllocThresholds["CalculateFee"][tx.Name], func() {
+				_, _ = feeCalculator.CalculateFee(tx.Tx)
+			})
+		})
+		t.Run("P/CodecSize/"+tx.Name, func(t *testing.T) {
+			requireAllocsWithin(t, pChainAllocThresholds["CodecSize"][tx.Name], func() {
+				_, _ = pvmtxs.Codec.Size(pvmtxs.CodecVersion, &tx.Tx)
+			})
+		})
+		t.Run("P/Sign/"+tx.Name, func(t *testing.T) {
+			requireAllocsWithin(t, pChainAllocThresholds["Sign"][tx.Name], func() {
+				_, _ = tx.Sign()
+			})
+		})
+	}
+	for _, tx := range xCorpus {
+		t.Run("X/CodecSize/"+tx.Name, func(t *testing.T) {
+			requireAllocsWithin(t, xChainAllocThresholds["CodecSize"][tx.Name], func() {
+				_, _ = xCodec.Size(avmtxs.CodecVersion, &tx.Tx)
+			})
+		})
+		t.Run("X/Sign/"+tx.Name, func(t *testing.T) {
+			requireAllocsWithin(t, xChainAllocThresholds["Sign"][tx.Name], func() {
+				_, _ = tx.Sign(parser)
+			})
+		})
+	}
+}
+
+func requireAllocsWithin(t *testing.T, threshold float64, f func()) {
+	allocs := testing.AllocsPerRun(100, f)
+	require.LessOrEqual(t, allocs, threshold, "allocations regressed")
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package benchmarks provides reproducible corpora of representative
+// transactions for benchmarking fee calculation, codec sizing and signing.
+package benchmarks
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	avmtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	pvmtxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+// corpusSeed seeds all of the IDs and keys included in the corpora. Changing
+// it changes the bytes of every transaction, which invalidates comparisons
+// against previous benchmark results.
+const corpusSeed = "avalanchego-benchmarks"
+
+var (
+	networkID = constants.UnitTestID
+	xChainID  = deriveID("x-chain")
+	cChainID  = deriveID("c-chain")
+	avaxID    = deriveID("avax")
+)
+
+// PChainTx is a named, unsigned P-chain transaction along with the keys
+// required to sign it.
+type PChainTx struct {
+	Name    string
+	Tx      pvmtxs.UnsignedTx
+	Signers [][]*secp256k1.PrivateKey
+}
+
+// Sign returns a signed copy of the transaction.
+func (t *PChainTx) Sign() (*pvmtxs.Tx, error) {
+	return pvmtxs.NewSigned(t.Tx, pvmtxs.Codec, t.Signers)
+}
+
+// XChainTx is a named, unsigned X-chain transaction along with the keys
+// required to sign it.
+type XChainTx struct {
+	Name    string
+	Tx      avmtxs.UnsignedTx
+	Signers [][]*secp256k1.PrivateKey
+}
+
+// Sign returns a signed copy of the transaction using [parser]'s codec.
+func (t *XChainTx) Sign(parser avmtxs.Parser) (*avmtxs.Tx, error) {
+	tx := &avmtxs.Tx{Unsigned: t.Tx}
+	return tx, tx.SignSECP256K1Fx(parser.Codec(), t.Signers)
+}
+
+// NewXChainParser returns the parser used to encode the X-chain corpus.
+func NewXChainParser() (avmtxs.Parser, error) {
+	return avmtxs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+	})
+}
+
+// PChainCorpus returns the P-chain transactions to benchmark. The returned
+// transactions are identical across invocations.
+func PChainCorpus() ([]*PChainTx, error) {
+	keys, err := deriveKeys(16)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*PChainTx{
+		{
+			Name:    "BaseTx",
+			Tx:      &pvmtxs.BaseTx{BaseTx: newBaseTx(constants.PlatformChainID, keys[:1], keys[:2])},
+			Signers: signersFor(keys[:1]),
+		},
+		{
+			Name:    "BaseTx with 16 inputs",
+			Tx:      &pvmtxs.BaseTx{BaseTx: newBaseTx(constants.PlatformChainID, keys, keys)},
+			Signers: signersFor(keys),
+		},
+		{
+			Name: "CreateSubnetTx",
+			Tx: &pvmtxs.CreateSubnetTx{
+				BaseTx: pvmtxs.BaseTx{BaseTx: newBaseTx(constants.PlatformChainID, keys[:1], keys[:1])},
+				Owner:  newOwner(keys[0]),
+			},
+			Signers: signersFor(keys[:1]),
+		},
+		{
+			Name: "ImportTx",
+			Tx: &pvmtxs.ImportTx{
+				BaseTx:         pvmtxs.BaseTx{BaseTx: newBaseTx(constants.PlatformChainID, nil, keys[:1])},
+				SourceChain:    xChainID,
+				ImportedInputs: newInputs(keys[:2]),
+			},
+			Signers: signersFor(keys[:2]),
+		},
+		{
+			Name: "ExportTx",
+			Tx: &pvmtxs.ExportTx{
+				BaseTx:           pvmtxs.BaseTx{BaseTx: newBaseTx(constants.PlatformChainID, keys[:1], keys[:1])},
+				DestinationChain: cChainID,
+				ExportedOutputs:  newOutputs(keys[:1]),
+			},
+			Signers: signersFor(keys[:1]),
+		},
+	}, nil
+}
+
+// XChainCorpus returns the X-chain transactions to benchmark. The returned
+// transactions are identical across invocations.
+func XChainCorpus() ([]*XChainTx, error) {
+	keys, err := deriveKeys(16)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*XChainTx{
+		{
+			Name:    "BaseTx",
+			Tx:      &avmtxs.BaseTx{BaseTx: newBaseTx(xChainID, keys[:1], keys[:2])},
+			Signers: signersFor(keys[:1]),
+		},
+		{
+			Name:    "BaseTx with 16 inputs",
+			Tx:      &avmtxs.BaseTx{BaseTx: newBaseTx(xChainID, keys, keys)},
+			Signers: signersFor(keys),
+		},
+		{
+			Name: "CreateAssetTx",
+			Tx: &avmtxs.CreateAssetTx{
+				BaseTx:       avmtxs.BaseTx{BaseTx: newBaseTx(xChainID, keys[:1], keys[:1])},
+				Name:         "Benchmark Asset",
+				Symbol:       "BENCH",
+				Denomination: 9,
+				States: []*avmtxs.InitialState{{
+					FxIndex: 0,
+					Outs: []verify.State{
+						&secp256k1fx.TransferOutput{
+							Amt:          units.MegaAvax,
+							OutputOwners: *newOwner(keys[1]),
+						},
+					},
+				}},
+			},
+			Signers: signersFor(keys[:1]),
+		},
+		{
+			Name: "ExportTx",
+			Tx: &avmtxs.ExportTx{
+				BaseTx:           avmtxs.BaseTx{BaseTx: newBaseTx(xChainID, keys[:1], keys[:1])},
+				DestinationChain: constants.PlatformChainID,
+				ExportedOuts:     newOutputs(keys[:1]),
+			},
+			Signers: signersFor(keys[:1]),
+		},
+	}, nil
+}
+
+func newBaseTx(chainID ids.ID, inputKeys []*secp256k1.PrivateKey, outputKeys []*secp256k1.PrivateKey) avax.BaseTx {
+	return avax.BaseTx{
+		NetworkID:    networkID,
+		BlockchainID: chainID,
+		Ins:          newInputs(inputKeys),
+		Outs:         newOutputs(outputKeys),
+	}
+}
+
+func newInputs(keys []*secp256k1.PrivateKey) []*avax.TransferableInput {
+	ins := make([]*avax.TransferableInput, len(keys))
+	for i := range keys {
+		ins[i] = &avax.TransferableInput{
+			UTXOID: avax.UTXOID{
+				TxID:        deriveID(fmt.Sprintf("utxo-%d", i)),
+				OutputIndex: uint32(i),
+			},
+			Asset: avax.Asset{ID: avaxID},
+			In: &secp256k1fx.TransferInput{
+				Amt: units.KiloAvax,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+		}
+	}
+	utils.Sort(ins)
+	return ins
+}
+
+func newOutputs(keys []*secp256k1.PrivateKey) []*avax.TransferableOutput {
+	outs := make([]*avax.TransferableOutput, len(keys))
+	for i, key := range keys {
+		outs[i] = &avax.TransferableOutput{
+			Asset: avax.Asset{ID: avaxID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          units.Avax + uint64(i),
+				OutputOwners: *newOwner(key),
+			},
+		}
+	}
+	return outs
+}
+
+func newOwner(key *secp256k1.PrivateKey) *secp256k1fx.OutputOwners {
+	return &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs: []ids.ShortID{
+			key.Address(),
+		},
+	}
+}
+
+// signersFor returns one signer per input spent by [keys]. Signatures are not
+// verified by the benchmarks, so only the number of signers matters.
+func signersFor(keys []*secp256k1.PrivateKey) [][]*secp256k1.PrivateKey {
+	signers := make([][]*secp256k1.PrivateKey, len(keys))
+	for i, key := range keys {
+		signers[i] = []*secp256k1.PrivateKey{key}
+	}
+	return signers
+}
+
+func deriveKeys(n int) ([]*secp256k1.PrivateKey, error) {
+	keys := make([]*secp256k1.PrivateKey, n)
+	for i := range keys {
+		key, err := secp256k1.ToPrivateKey(hashing.ComputeHash256([]byte(fmt.Sprintf("%s/key-%d", corpusSeed, i))))
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+func deriveID(name string) ids.ID {
+	return ids.ID(hashing.ComputeHash256Array([]byte(corpusSeed + "/" + name)))
+}
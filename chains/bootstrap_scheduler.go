@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+// Chains with a lower priority value are bootstrapped first.
+const (
+	platformChainBootstrapPriority = iota
+	primaryNetworkBootstrapPriority
+	subnetBootstrapPriority
+)
+
+var _ subnets.Subnet = (*scheduledSubnet)(nil)
+
+func bootstrapPriority(chainParams ChainParameters) int {
+	switch {
+	case chainParams.ID == constants.PlatformChainID:
+		return platformChainBootstrapPriority
+	case chainParams.SubnetID == constants.PrimaryNetworkID:
+		return primaryNetworkBootstrapPriority
+	default:
+		return subnetBootstrapPriority
+	}
+}
+
+type scheduledChain struct {
+	chainID  ids.ID
+	priority int
+	start    func()
+}
+
+// bootstrapScheduler limits the number of chains that are bootstrapping
+// concurrently.
+//
+// Chains waiting to bootstrap are started in order of priority. A chain is
+// only started once every chain with a higher priority has finished
+// bootstrapping.
+type bootstrapScheduler struct {
+	// If 0, chains are started immediately.
+	maxConcurrent int
+
+	lock sync.Mutex
+	// Chain ID -> priority of the chains that were started but haven't
+	// finished bootstrapping.
+	bootstrapping map[ids.ID]int
+	// Chains waiting to be started, sorted by priority.
+	pending []scheduledChain
+}
+
+func newBootstrapScheduler(maxConcurrent int) *bootstrapScheduler {
+	return &bootstrapScheduler{
+		maxConcurrent: maxConcurrent,
+		bootstrapping: make(map[ids.ID]int),
+	}
+}
+
+// Schedule calls [start] once the chain is allowed to start bootstrapping.
+// [start] may be called before Schedule returns.
+func (s *bootstrapScheduler) Schedule(chainID ids.ID, priority int, start func()) {
+	if s.maxConcurrent == 0 {
+		start()
+		return
+	}
+
+	s.lock.Lock()
+	// Chains with the same priority are started in the order they were
+	// scheduled.
+	i := sort.Search(len(s.pending), func(i int) bool {
+		return s.pending[i].priority > priority
+	})
+	s.pending = slices.Insert(s.pending, i, scheduledChain{
+		chainID:  chainID,
+		priority: priority,
+		start:    start,
+	})
+	toStart := s.dequeue()
+	s.lock.Unlock()
+
+	for _, chain := range toStart {
+		chain.start()
+	}
+}
+
+// Bootstrapped marks the chain as having finished bootstrapping, which may
+// allow pending chains to start.
+func (s *bootstrapScheduler) Bootstrapped(chainID ids.ID) {
+	if s.maxConcurrent == 0 {
+		return
+	}
+
+	s.lock.Lock()
+	delete(s.bootstrapping, chainID)
+	toStart := s.dequeue()
+	s.lock.Unlock()
+
+	// Bootstrapped is called while holding the lock of the bootstrapped chain,
+	// so the pending chains must be started asynchronously.
+	for _, chain := range toStart {
+		go chain.start()
+	}
+}
+
+// Remove stops tracking the chain, which may allow pending chains to start. If
+// the chain is still pending, it is started so that it can be stopped.
+func (s *bootstrapScheduler) Remove(chainID ids.ID) {
+	s.lock.Lock()
+	delete(s.bootstrapping, chainID)
+	var removed []scheduledChain
+	s.pending = slices.DeleteFunc(s.pending, func(chain scheduledChain) bool {
+		if chain.chainID != chainID {
+			return false
+		}
+		removed = append(removed, chain)
+		return true
+	})
+	toStart := s.dequeue()
+	s.lock.Unlock()
+
+	for _, chain := range removed {
+		chain.start()
+	}
+	// Remove may be called while holding the lock of another chain, so the
+	// pending chains must be started asynchronously.
+	for _, chain := range toStart {
+		go chain.start()
+	}
+}
+
+// Shutdown starts all the pending chains so that they can be stopped.
+func (s *bootstrapScheduler) Shutdown() {
+	s.lock.Lock()
+	toStart := s.pending
+	s.pending = nil
+	s.lock.Unlock()
+
+	for _, chain := range toStart {
+		chain.start()
+	}
+}
+
+// dequeue removes the chains that are allowed to start from the pending
+// chains and marks them as bootstrapping.
+//
+// Assumes [s.lock] is held.
+func (s *bootstrapScheduler) dequeue() []scheduledChain {
+	var toStart []scheduledChain
+	for len(s.pending) > 0 && len(s.bootstrapping) < s.maxConcurrent {
+		next := s.pending[0]
+		for _, priority := range s.bootstrapping {
+			if priority < next.priority {
+				return toStart
+			}
+		}
+
+		s.pending = s.pending[1:]
+		s.bootstrapping[next.chainID] = next.priority
+		toStart = append(toStart, next)
+	}
+	return toStart
+}
+
+// scheduledSubnet notifies the scheduler when a chain of the subnet finishes
+// bootstrapping.
+type scheduledSubnet struct {
+	subnets.Subnet
+	scheduler *bootstrapScheduler
+}
+
+func (s *scheduledSubnet) Bootstrapped(chainID ids.ID) {
+	s.Subnet.Bootstrapped(chainID)
+	s.scheduler.Bootstrapped(chainID)
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+func TestBootstrapPriority(t *testing.T) {
+	tests := []struct {
+		name             string
+		chainParams      ChainParameters
+		expectedPriority int
+	}{
+		{
+			name: "P-chain",
+			chainParams: ChainParameters{
+				ID:       constants.PlatformChainID,
+				SubnetID: constants.PrimaryNetworkID,
+			},
+			expectedPriority: platformChainBootstrapPriority,
+		},
+		{
+			name: "primary network chain",
+			chainParams: ChainParameters{
+				ID:       ids.GenerateTestID(),
+				SubnetID: constants.PrimaryNetworkID,
+			},
+			expectedPriority: primaryNetworkBootstrapPriority,
+		},
+		{
+			name: "subnet chain",
+			chainParams: ChainParameters{
+				ID:       ids.GenerateTestID(),
+				SubnetID: ids.GenerateTestID(),
+			},
+			expectedPriority: subnetBootstrapPriority,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expectedPriority, bootstrapPriority(test.chainParams))
+		})
+	}
+}
+
+func TestBootstrapSchedulerUnlimited(t *testing.T) {
+	require := require.New(t)
+
+	s := newBootstrapScheduler(0)
+
+	var started []ids.ID
+	for _, priority := range []int{subnetBootstrapPriority, platformChainBootstrapPriority} {
+		chainID := ids.GenerateTestID()
+		s.Schedule(chainID, priority, func() {
+			started = append(started, chainID)
+		})
+		require.Equal(chainID, started[len(started)-1])
+	}
+}
+
+func TestBootstrapSchedulerOrdering(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		s       = newBootstrapScheduler(2)
+		started = make(chan ids.ID, 5)
+
+		pChainID  = ids.GenerateTestID()
+		xChainID  = ids.GenerateTestID()
+		cChainID  = ids.GenerateTestID()
+		subnetID0 = ids.GenerateTestID()
+		subnetID1 = ids.GenerateTestID()
+	)
+	schedule := func(chainID ids.ID, priority int) {
+		s.Schedule(chainID, priority, func() {
+			started <- chainID
+		})
+	}
+	requireStarted := func(expected ...ids.ID) {
+		var actual []ids.ID
+		for range expected {
+			actual = append(actual, <-started)
+		}
+		require.ElementsMatch(expected, actual)
+		require.Empty(started)
+	}
+
+	schedule(pChainID, platformChainBootstrapPriority)
+	requireStarted(pChainID)
+
+	// Chains of lower priority must wait for the P-chain, even if there is an
+	// available slot.
+	schedule(subnetID0, subnetBootstrapPriority)
+	schedule(xChainID, primaryNetworkBootstrapPriority)
+	schedule(subnetID1, subnetBootstrapPriority)
+	schedule(cChainID, primaryNetworkBootstrapPriority)
+	requireStarted()
+
+	s.Bootstrapped(pChainID)
+	requireStarted(xChainID, cChainID)
+
+	// Subnet chains must wait for all the primary network chains.
+	s.Bootstrapped(xChainID)
+	requireStarted()
+
+	s.Bootstrapped(cChainID)
+	requireStarted(subnetID0, subnetID1)
+
+	// Marking an unknown chain as bootstrapped is a noop.
+	s.Bootstrapped(ids.GenerateTestID())
+	requireStarted()
+}
+
+func TestBootstrapSchedulerMaxConcurrent(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		s        = newBootstrapScheduler(1)
+		started  = make(chan ids.ID, 3)
+		chainIDs = []ids.ID{
+			ids.GenerateTestID(),
+			ids.GenerateTestID(),
+			ids.GenerateTestID(),
+		}
+	)
+	for _, chainID := range chainIDs {
+		s.Schedule(chainID, subnetBootstrapPriority, func() {
+			started <- chainID
+		})
+	}
+
+	// Chains of the same priority are started in the order they were
+	// scheduled.
+	require.Equal(chainIDs[0], <-started)
+	require.Empty(started)
+
+	s.Bootstrapped(chainIDs[0])
+	require.Equal(chainIDs[1], <-started)
+	require.Empty(started)
+
+	// Shutdown starts all the pending chains.
+	s.Shutdown()
+	require.Equal(chainIDs[2], <-started)
+	require.Empty(started)
+}
+
+func TestBootstrapSchedulerRemove(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		s        = newBootstrapScheduler(1)
+		started  = make(chan ids.ID, 3)
+		chainIDs = []ids.ID{
+			ids.GenerateTestID(),
+			ids.GenerateTestID(),
+			ids.GenerateTestID(),
+		}
+	)
+	for _, chainID := range chainIDs {
+		s.Schedule(chainID, subnetBootstrapPriority, func() {
+			started <- chainID
+		})
+	}
+	require.Equal(chainIDs[0], <-started)
+	require.Empty(started)
+
+	// Removing a pending chain starts it without using a slot.
+	s.Remove(chainIDs[2])
+	require.Equal(chainIDs[2], <-started)
+	require.Empty(started)
+
+	// Removing a bootstrapping chain frees its slot.
+	s.Remove(chainIDs[0])
+	require.Equal(chainIDs[1], <-started)
+	require.Empty(started)
+
+	// Removing an unknown chain is a noop.
+	s.Remove(ids.GenerateTestID())
+	require.Empty(started)
+}
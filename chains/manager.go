@@ -6,10 +6,13 @@ package chains
 import (
 	"context"
 	"crypto"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sync"
 	"time"
 
@@ -35,6 +38,8 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/checkpoint"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/replica"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/syncer"
 	"github.com/ava-labs/avalanchego/snow/networking/handler"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
@@ -52,6 +57,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/metric"
 	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/storage"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/fx"
 	"github.com/ava-labs/avalanchego/vms/metervm"
@@ -61,6 +68,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/proposervm"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/tracedvm"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 
 	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
 	smcon "github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -74,12 +82,16 @@ import (
 )
 
 const (
-	ChainLabel = "chain"
+	ChainLabel  = "chain"
+	SubnetLabel = "subnet"
 
 	defaultChannelSize = 1
 	initialQueueSize   = 3
 
+	pruneRetiredChainWriteSize = units.MiB
+
 	avalancheNamespace    = constants.PlatformName + metric.NamespaceSeparator + "avalanche"
+	gossipNamespace       = constants.PlatformName + metric.NamespaceSeparator + "gossip"
 	handlerNamespace      = constants.PlatformName + metric.NamespaceSeparator + "handler"
 	meterchainvmNamespace = constants.PlatformName + metric.NamespaceSeparator + "meterchainvm"
 	meterdagvmNamespace   = constants.PlatformName + metric.NamespaceSeparator + "meterdagvm"
@@ -87,6 +99,8 @@ const (
 	p2pNamespace          = constants.PlatformName + metric.NamespaceSeparator + "p2p"
 	snowmanNamespace      = constants.PlatformName + metric.NamespaceSeparator + "snowman"
 	stakeNamespace        = constants.PlatformName + metric.NamespaceSeparator + "stake"
+
+	subnetMessagesNamespace = constants.PlatformName + metric.NamespaceSeparator + "subnet_msgs"
 )
 
 var (
@@ -106,6 +120,11 @@ var (
 	errCreatePlatformVM        = errors.New("attempted to create a chain running the PlatformVM")
 	errNotBootstrapped         = errors.New("subnets not bootstrapped")
 	errPartialSyncAsAValidator = errors.New("partial sync should not be configured for a validator")
+	errUnknownChain            = errors.New("unknown chain")
+	errConfigUpdateUnsupported = errors.New("vm doesn't support config updates")
+	errTxLimitsUnsupported     = errors.New("vm doesn't report tx limits")
+	errDiskUsageUnsupported    = errors.New("database doesn't support size estimation")
+	errVMExited                = errors.New("vm exited")
 
 	fxs = map[ids.ID]fx.Factory{
 		secp256k1fx.ID: &secp256k1fx.Factory{},
@@ -131,6 +150,30 @@ type Manager interface {
 	// This assumes only chains in tracked subnets are queued.
 	QueueChainCreation(ChainParameters)
 
+	// Records the parameters of a chain of a subnet that this node doesn't
+	// track, without instantiating the chain. The chain is only created if
+	// the subnet is later tracked with TrackSubnet.
+	// This is only called from the P-chain thread.
+	RegisterChain(ChainParameters)
+
+	// Starts tracking the subnet with the given ID. The subnet trackers are
+	// notified, then the chains registered for the subnet are queued to be
+	// created, as are the chains registered for the subnet afterwards.
+	// Returns the IDs of the queued chains.
+	TrackSubnet(subnetID ids.ID) []ids.ID
+
+	// Stops tracking the subnet with the given ID. The running chains of the
+	// subnet are stopped and registered, so that they are created again if
+	// the subnet is tracked again, and the subnet trackers are notified. If
+	// [prune] is true, the databases and data directories of the subnet's
+	// stopped and registered chains are deleted. Returns the IDs of the
+	// stopped chains.
+	UntrackSubnet(subnetID ids.ID, prune bool) []ids.ID
+
+	// Add a subnet tracker [t]. Every time a subnet starts or stops being
+	// tracked with TrackSubnet or UntrackSubnet, [t] is notified.
+	AddSubnetTracker(SubnetTracker)
+
 	// Add a registrant [r]. Every time a chain is
 	// created, [r].RegisterChain([new chain]) is called.
 	AddRegistrant(Registrant)
@@ -144,6 +187,45 @@ type Manager interface {
 	// Returns true iff the chain with the given ID exists and is finished bootstrapping
 	IsBootstrapped(ids.ID) bool
 
+	// Returns the user-provided config of the chain with the given ID, looked
+	// up by the chain ID and then by each of the chain's aliases.
+	GetChainConfig(ids.ID) (ChainConfig, error)
+
+	// Replaces the user-provided config of a running chain. The chain's VM
+	// must implement common.ConfigUpdater and is notified between blocks. If
+	// the VM rejects the config, the previous config remains in effect.
+	// The update isn't persisted across restarts.
+	UpdateChainConfig(ctx context.Context, chainID ids.ID, config []byte) error
+
+	// Returns the approximate number of bytes used on disk by the chain with
+	// the given ID.
+	GetChainDiskUsage(chainID ids.ID) (DiskUsage, error)
+
+	// Returns the distribution of the time from the issuance of the blocks of
+	// the chain with the given ID to their acceptance, since the node started.
+	GetChainAcceptedLatency(chainID ids.ID) (AcceptedLatency, error)
+
+	// Returns the consensus parameters the chain with the given ID runs with,
+	// including the overrides of its chain config.
+	GetChainConsensusParameters(chainID ids.ID) (snowball.Parameters, error)
+
+	// Returns the limits enforced on the txs and blocks of the chain with the
+	// given ID. The chain's VM must implement limits.Provider.
+	GetChainTxLimits(chainID ids.ID) (limits.Limits, error)
+
+	// Returns the number of messages, and their bytes, sent and received by
+	// the chains of the [limit] subnets that used the most bandwidth since the
+	// node started, sorted by decreasing bandwidth. If [limit] is 0, all the
+	// subnets are returned.
+	GetSubnetMessageUsage(limit int) []SubnetMessageUsage
+
+	// Stops the chain with the given ID if it is running and prevents it from
+	// being created in the future. If [ManagerConfig.PruneRetiredChains] is
+	// set, the chain's database and data directory are deleted once it has
+	// stopped.
+	// This is only called from the P-chain thread.
+	RetireChain(subnetID ids.ID, chainID ids.ID)
+
 	// Starts the chain creator with the initial platform chain parameters, must
 	// be called once.
 	StartChainCreator(platformChain ChainParameters) error
@@ -172,14 +254,59 @@ type chain struct {
 	Context *snow.ConsensusContext
 	VM      common.VM
 	Handler handler.Handler
+	// The VM as created by the VM factory, before it was wrapped by the node
+	UnwrappedVM interface{}
+	// Tracks the key ranges of the chain's databases
+	DBTracker *prefixdb.Tracker
+	// The consensus parameters the chain's engine runs with
+	ConsensusParameters snowball.Parameters
+}
+
+// DiskUsage is the approximate number of bytes used on disk by a chain.
+type DiskUsage struct {
+	// Database maps the path of each of the chain's prefixed databases to the
+	// number of bytes stored under it in the node's database.
+	Database map[string]uint64
+	// ChainDataDir is the number of bytes in the chain's data directory.
+	ChainDataDir uint64
+}
+
+// Total returns the number of bytes used by the chain.
+func (d DiskUsage) Total() uint64 {
+	total := d.ChainDataDir
+	for _, size := range d.Database {
+		total += size
+	}
+	return total
+}
+
+// AcceptedLatency is the distribution of the time from the issuance of a
+// chain's blocks to their acceptance.
+type AcceptedLatency struct {
+	// Count is the number of accepted blocks.
+	Count uint64
+	// Sum is the total latency of the accepted blocks.
+	Sum time.Duration
+	// Buckets are sorted by increasing upper bound. The count of each bucket
+	// includes the blocks counted by the previous buckets.
+	Buckets []LatencyBucket
+}
+
+// LatencyBucket is the number of blocks that were accepted within UpperBound
+// of their issuance.
+type LatencyBucket struct {
+	UpperBound time.Duration
+	Count      uint64
 }
 
 // ChainConfig is configuration settings for the current execution.
 // [Config] is the user-provided config blob for the chain.
 // [Upgrade] is a chain-specific blob for coordinating upgrades.
+// [Consensus] is the JSON encoded snowball.ParameterOverrides of the chain.
 type ChainConfig struct {
-	Config  []byte
-	Upgrade []byte
+	Config    []byte
+	Upgrade   []byte
+	Consensus []byte
 }
 
 type ManagerConfig struct {
@@ -197,6 +324,7 @@ type ManagerConfig struct {
 	TxAcceptorGroup           snow.AcceptorGroup
 	VertexAcceptorGroup       snow.AcceptorGroup
 	DB                        database.Database
+	DBSizeEstimator           database.SizeEstimator     // Estimates the disk usage of DB, may be nil
 	MsgCreator                message.OutboundMsgBuilder // message creator, shared with network
 	Router                    router.Router              // Routes incoming messages to the appropriate chain
 	Net                       network.Network            // Sends consensus messages to other validators
@@ -223,6 +351,19 @@ type ManagerConfig struct {
 
 	FrontierPollFrequency   time.Duration
 	ConsensusAppConcurrency int
+	// Once a chain has [ConsensusMaxUnprocessedMsgs] unprocessed messages,
+	// bulk messages are dropped. If 0, no messages are dropped.
+	ConsensusMaxUnprocessedMsgs int
+
+	// AppGossip messages aren't sent to a peer that was sent the same message
+	// within [AppGossipDedupWindow]. If 0, AppGossip messages aren't
+	// deduplicated.
+	AppGossipDedupWindow time.Duration
+	// Number of recently gossiped peer and message pairs remembered per subnet.
+	AppGossipDedupSize int
+	// Maximum duration of the verification of a block by the snowman engines.
+	// If 0, the verification of a block isn't bounded.
+	BlockVerificationTimeout time.Duration
 
 	// Max Time to spend fetching a container and its
 	// ancestors when responding to a GetAncestors
@@ -232,6 +373,8 @@ type ManagerConfig struct {
 	// This node will only consider the first [AncestorsMaxContainersReceived]
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int
+	// Max number of chains to bootstrap concurrently. If 0, there is no limit.
+	BootstrapMaxConcurrentChains int
 
 	Upgrades upgrade.Config
 
@@ -240,7 +383,28 @@ type ManagerConfig struct {
 
 	StateSyncBeacons []ids.NodeID
 
+	// ReadReplicaChainIDs are the Snowman chains that accept the blocks
+	// accepted by their read replica sources rather than running consensus.
+	ReadReplicaChainIDs set.Set[ids.ID]
+	// ReadReplicaSources returns the read replica sources of the chain with
+	// the provided primary alias.
+	ReadReplicaSources func(chainAlias string) []replica.Source
+	// ReadReplicaPollFrequency is how often read replica sources are polled
+	// for newly accepted blocks.
+	ReadReplicaPollFrequency time.Duration
+
 	ChainDataDir string
+	// If true, the database and chain data directory of retired chains are
+	// deleted.
+	PruneRetiredChains bool
+
+	// Number of times a chain is restarted after its VM process exits
+	// unexpectedly. If 0, chains aren't restarted.
+	VMMaxRestarts int
+	// Time to wait before restarting a chain, doubled after each restart of
+	// the chain up to [VMRestartMaxBackoff].
+	VMRestartInitialBackoff time.Duration
+	VMRestartMaxBackoff     time.Duration
 
 	Subnets *Subnets
 }
@@ -263,15 +427,79 @@ type manager struct {
 	chainCreatorShutdownCh chan struct{}
 	chainCreatorExited     sync.WaitGroup
 
+	// limits the number of chains that are bootstrapping concurrently
+	bootstrapScheduler *bootstrapScheduler
+
 	chainsLock sync.Mutex
 	// Key: Chain's ID
 	// Value: The chain
 	chains map[ids.ID]handler.Handler
+	// Key: Chain's ID
+	// Value: The chain's VM, as created by the VM factory
+	chainVMs map[ids.ID]interface{}
+	// Key: Chain's ID
+	// Value: The key ranges of the chain's databases
+	chainDBTrackers map[ids.ID]*prefixdb.Tracker
+	// Key: Chain's ID
+	// Value: The key ranges of the databases of the chain, which was stopped
+	// because its subnet stopped being tracked
+	untrackedDBTrackers map[ids.ID]*prefixdb.Tracker
+	// Key: Chain's ID
+	// Value: The consensus parameters the chain's engine runs with
+	chainConsensusParams map[ids.ID]snowball.Parameters
+	// Chains that were retired and must not be created
+	retiredChains set.Set[ids.ID]
+	// Key: Subnet's ID
+	// Value: The parameters of the chains of the subnet that were registered
+	// without being created
+	registeredChains map[ids.ID][]ChainParameters
+	// Subnets that started being tracked with TrackSubnet
+	trackedSubnets set.Set[ids.ID]
+	// Subnets that stopped being tracked with UntrackSubnet
+	untrackedSubnets set.Set[ids.ID]
+	// Chains that were stopped because their subnet stopped being tracked.
+	// They are created again, as if they were restarted, if their subnet is
+	// tracked again.
+	untrackedChains set.Set[ids.ID]
+	// Key: Chain's ID
+	// Value: The parameters the running chain was created with
+	chainParams map[ids.ID]ChainParameters
+	// Key: Chain's ID
+	// Value: Closed once the chain's database and data directory, which are
+	// deleted after its subnet stopped being tracked, have been deleted
+	prunedChains map[ids.ID]chan struct{}
+	// Those notified when a subnet starts or stops being tracked
+	subnetTrackers []SubnetTracker
+	// Key: Chain's ID
+	// Value: The restarts of the chain after its VM process exited
+	chainRestarts map[ids.ID]*chainRestart
+
+	// Chains whose health check is registered. Only accessed by the chain
+	// creator.
+	healthCheckedChains set.Set[ids.ID]
+	// Key: Chain's ID
+	// Value: The chain's log, which is reused if the chain is restarted. Only
+	// accessed by the chain creator.
+	chainLogs map[ids.ID]logging.Logger
+
+	// Key: Subnet's ID
+	// Value: The sender shared by the subnet's chains to deduplicate gossip
+	gossipSenders map[ids.ID]sender.ExternalSender
+
+	// Records the messages sent and received by the chains of each subnet
+	subnetMessages *subnetMessageMetrics
+	// Sends messages over the network, attributing them to their subnet
+	messagesSender sender.ExternalSender
+
+	// Protects [ManagerConfig.ChainConfigs], which can be modified by
+	// UpdateChainConfig
+	chainConfigsLock sync.RWMutex
 
 	// snowman++ related interface to allow validators retrieval
 	validatorState validators.State
 
 	avalancheGatherer    metrics.MultiGatherer            // chainID
+	gossipGatherer       metrics.MultiGatherer            // subnetID
 	handlerGatherer      metrics.MultiGatherer            // chainID
 	meterChainVMGatherer metrics.MultiGatherer            // chainID
 	meterDAGVMGatherer   metrics.MultiGatherer            // chainID
@@ -289,6 +517,11 @@ func New(config *ManagerConfig) (Manager, error) {
 		return nil, err
 	}
 
+	gossipGatherer := metrics.NewLabelGatherer(SubnetLabel)
+	if err := config.Metrics.Register(gossipNamespace, gossipGatherer); err != nil {
+		return nil, err
+	}
+
 	handlerGatherer := metrics.NewLabelGatherer(ChainLabel)
 	if err := config.Metrics.Register(handlerNamespace, handlerGatherer); err != nil {
 		return nil, err
@@ -324,15 +557,51 @@ func New(config *ManagerConfig) (Manager, error) {
 		return nil, err
 	}
 
+	subnetMessagesReg, err := metrics.MakeAndRegister(
+		config.Metrics,
+		subnetMessagesNamespace,
+	)
+	if err != nil {
+		return nil, err
+	}
+	subnetMessages, err := newSubnetMessageMetrics(subnetMessagesReg)
+	if err != nil {
+		return nil, err
+	}
+	messagesSender := &subnetMessagesSender{
+		sender:  config.Net,
+		metrics: subnetMessages,
+	}
+
+	// The chain configs are copied because they can be modified by
+	// UpdateChainConfig.
+	managerConfig := *config
+	managerConfig.ChainConfigs = make(map[string]ChainConfig, len(config.ChainConfigs))
+	maps.Copy(managerConfig.ChainConfigs, config.ChainConfigs)
+
 	return &manager{
 		Aliaser:                ids.NewAliaser(),
-		ManagerConfig:          *config,
+		ManagerConfig:          managerConfig,
 		chains:                 make(map[ids.ID]handler.Handler),
+		chainVMs:               make(map[ids.ID]interface{}),
+		chainDBTrackers:        make(map[ids.ID]*prefixdb.Tracker),
+		untrackedDBTrackers:    make(map[ids.ID]*prefixdb.Tracker),
+		chainConsensusParams:   make(map[ids.ID]snowball.Parameters),
+		registeredChains:       make(map[ids.ID][]ChainParameters),
+		chainParams:            make(map[ids.ID]ChainParameters),
+		prunedChains:           make(map[ids.ID]chan struct{}),
+		chainRestarts:          make(map[ids.ID]*chainRestart),
+		chainLogs:              make(map[ids.ID]logging.Logger),
+		gossipSenders:          make(map[ids.ID]sender.ExternalSender),
+		subnetMessages:         subnetMessages,
+		messagesSender:         messagesSender,
 		chainsQueue:            buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
 		unblockChainCreatorCh:  make(chan struct{}),
 		chainCreatorShutdownCh: make(chan struct{}),
+		bootstrapScheduler:     newBootstrapScheduler(managerConfig.BootstrapMaxConcurrentChains),
 
 		avalancheGatherer:    avalancheGatherer,
+		gossipGatherer:       gossipGatherer,
 		handlerGatherer:      handlerGatherer,
 		meterChainVMGatherer: meterChainVMGatherer,
 		meterDAGVMGatherer:   meterDAGVMGatherer,
@@ -367,6 +636,157 @@ func (m *manager) QueueChainCreation(chainParams ChainParameters) {
 	}
 }
 
+func (m *manager) RegisterChain(chainParams ChainParameters) {
+	m.chainsLock.Lock()
+	if m.trackedSubnets.Contains(chainParams.SubnetID) {
+		m.chainsLock.Unlock()
+		m.QueueChainCreation(chainParams)
+		return
+	}
+	m.registeredChains[chainParams.SubnetID] = append(m.registeredChains[chainParams.SubnetID], chainParams)
+	m.chainsLock.Unlock()
+
+	m.Log.Debug("registered chain",
+		zap.Stringer("subnetID", chainParams.SubnetID),
+		zap.Stringer("chainID", chainParams.ID),
+		zap.Stringer("vmID", chainParams.VMID),
+	)
+}
+
+func (m *manager) TrackSubnet(subnetID ids.ID) []ids.ID {
+	m.chainsLock.Lock()
+	m.trackedSubnets.Add(subnetID)
+	m.untrackedSubnets.Remove(subnetID)
+	registeredChains := m.registeredChains[subnetID]
+	delete(m.registeredChains, subnetID)
+	subnetTrackers := m.subnetTrackers
+	m.chainsLock.Unlock()
+
+	m.Log.Info("tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+		zap.Int("numChains", len(registeredChains)),
+	)
+
+	// The subnet is tracked before its chains are created so that they can
+	// reach the subnet's validators.
+	for _, tracker := range subnetTrackers {
+		tracker.TrackSubnet(subnetID)
+	}
+
+	chainIDs := make([]ids.ID, len(registeredChains))
+	for i, chainParams := range registeredChains {
+		m.QueueChainCreation(chainParams)
+		chainIDs[i] = chainParams.ID
+	}
+	return chainIDs
+}
+
+func (m *manager) UntrackSubnet(subnetID ids.ID, prune bool) []ids.ID {
+	m.chainsLock.Lock()
+	m.trackedSubnets.Remove(subnetID)
+	m.untrackedSubnets.Add(subnetID)
+
+	var stoppedChains []ChainParameters
+	for _, chainParams := range m.chainParams {
+		if chainParams.SubnetID == subnetID {
+			stoppedChains = append(stoppedChains, chainParams)
+		}
+	}
+	slices.SortFunc(stoppedChains, func(a, b ChainParameters) int {
+		return a.ID.Compare(b.ID)
+	})
+
+	handlers := make(map[ids.ID]handler.Handler, len(stoppedChains))
+	for _, chainParams := range stoppedChains {
+		handlers[chainParams.ID] = m.chains[chainParams.ID]
+		if dbTracker, ok := m.chainDBTrackers[chainParams.ID]; ok {
+			m.untrackedDBTrackers[chainParams.ID] = dbTracker
+		}
+		delete(m.chains, chainParams.ID)
+		delete(m.chainVMs, chainParams.ID)
+		delete(m.chainDBTrackers, chainParams.ID)
+		delete(m.chainConsensusParams, chainParams.ID)
+		delete(m.chainParams, chainParams.ID)
+		m.untrackedChains.Add(chainParams.ID)
+	}
+	m.registeredChains[subnetID] = append(m.registeredChains[subnetID], stoppedChains...)
+	if len(m.registeredChains[subnetID]) == 0 {
+		delete(m.registeredChains, subnetID)
+	}
+
+	// The registered chains aren't running, so they can be pruned
+	// immediately. The stopped chains are pruned once they have stopped.
+	var (
+		prunedChainIDs []ids.ID
+		dbTrackers     = make(map[ids.ID]*prefixdb.Tracker)
+	)
+	if prune {
+		for _, chainParams := range m.registeredChains[subnetID] {
+			if _, isPruning := m.prunedChains[chainParams.ID]; isPruning {
+				continue
+			}
+			m.prunedChains[chainParams.ID] = make(chan struct{})
+			prunedChainIDs = append(prunedChainIDs, chainParams.ID)
+			dbTrackers[chainParams.ID] = m.untrackedDBTrackers[chainParams.ID]
+			delete(m.untrackedDBTrackers, chainParams.ID)
+		}
+	}
+	subnetTrackers := m.subnetTrackers
+	m.chainsLock.Unlock()
+
+	m.Log.Info("untracking subnet",
+		zap.Stringer("subnetID", subnetID),
+		zap.Int("numStoppedChains", len(stoppedChains)),
+		zap.Int("numPrunedChains", len(prunedChainIDs)),
+	)
+
+	chainIDs := make([]ids.ID, len(stoppedChains))
+	sb, _ := m.Subnets.GetOrCreate(subnetID)
+	for i, chainParams := range stoppedChains {
+		// The router removes the chain once its handler has stopped.
+		handlers[chainParams.ID].Stop(context.TODO())
+		m.bootstrapScheduler.Remove(chainParams.ID)
+		sb.RemoveChain(chainParams.ID)
+		chainIDs[i] = chainParams.ID
+	}
+
+	for _, chainID := range prunedChainIDs {
+		go func(chainID ids.ID, h handler.Handler, dbTracker *prefixdb.Tracker) {
+			m.pruneChain(chainID, h, dbTracker)
+
+			m.chainsLock.Lock()
+			close(m.prunedChains[chainID])
+			delete(m.prunedChains, chainID)
+			m.chainsLock.Unlock()
+		}(chainID, handlers[chainID], dbTrackers[chainID])
+	}
+
+	for _, tracker := range subnetTrackers {
+		tracker.UntrackSubnet(subnetID)
+	}
+	return chainIDs
+}
+
+func (m *manager) AddSubnetTracker(t SubnetTracker) {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	m.subnetTrackers = append(m.subnetTrackers, t)
+}
+
+// registerUntrackedChain registers the chain, which was queued to be created
+// before its subnet stopped being tracked, so that it is created once the
+// subnet is tracked again. Assumes [m.chainsLock] is held.
+func (m *manager) registerUntrackedChain(chainParams ChainParameters) {
+	registeredChains := m.registeredChains[chainParams.SubnetID]
+	isRegistered := slices.ContainsFunc(registeredChains, func(registered ChainParameters) bool {
+		return registered.ID == chainParams.ID
+	})
+	if !isRegistered {
+		m.registeredChains[chainParams.SubnetID] = append(registeredChains, chainParams)
+	}
+}
+
 // createChain creates and starts the chain
 //
 // Note: it is expected for the subnet to already have the chain registered as
@@ -380,12 +800,64 @@ func (m *manager) createChain(chainParams ChainParameters) {
 
 	sb, _ := m.Subnets.GetOrCreate(chainParams.SubnetID)
 
+	m.chainsLock.Lock()
+	isRetired := m.retiredChains.Contains(chainParams.ID)
+	isUntracked := !isRetired && m.untrackedSubnets.Contains(chainParams.SubnetID)
+	if isUntracked {
+		m.registerUntrackedChain(chainParams)
+	}
+	_, isRestart := m.chainRestarts[chainParams.ID]
+	// Chains that were stopped because their subnet stopped being tracked are
+	// created again as if they were restarted.
+	isRecreation := isRestart || m.untrackedChains.Contains(chainParams.ID)
+	pruned, isPruning := m.prunedChains[chainParams.ID]
+	m.chainsLock.Unlock()
+	if isRetired {
+		m.Log.Info("skipping chain creation",
+			zap.String("reason", "chain was retired"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.Stringer("vmID", chainParams.VMID),
+		)
+		sb.RemoveChain(chainParams.ID)
+		return
+	}
+	if isUntracked {
+		m.Log.Info("skipping chain creation",
+			zap.String("reason", "subnet isn't tracked"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.Stringer("vmID", chainParams.VMID),
+		)
+		sb.RemoveChain(chainParams.ID)
+		return
+	}
+
+	// The chain's data may still be deleted after its subnet was previously
+	// untracked.
+	if isPruning {
+		m.Log.Info("waiting for chain to be pruned",
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+		)
+		<-pruned
+	}
+
+	// The metrics of the previous instance of the chain must be removed to be
+	// registered again.
+	if isRecreation {
+		m.deregisterChainMetrics(chainParams)
+	}
+
 	// Note: buildChain builds all chain's relevant objects (notably engine and handler)
 	// but does not start their operations. Starting of the handler (which could potentially
 	// issue some internal messages), is delayed until chain dispatching is started and
 	// the chain is registered in the manager. This ensures that no message generated by handler
 	// upon start is dropped.
-	chain, err := m.buildChain(chainParams, sb)
+	chain, err := m.buildChain(chainParams, &scheduledSubnet{
+		Subnet:    sb,
+		scheduler: m.bootstrapScheduler,
+	})
 	if err != nil {
 		if m.CriticalChains.Contains(chainParams.ID) {
 			// Shut down if we fail to create a required chain (i.e. X, P or C)
@@ -399,6 +871,11 @@ func (m *manager) createChain(chainParams ChainParameters) {
 			return
 		}
 
+		if isRestart {
+			go m.restartChain(chainParams, nil, err)
+			return
+		}
+
 		chainAlias := m.PrimaryAliasOrDefault(chainParams.ID)
 		m.Log.Error("error creating chain",
 			zap.Stringer("subnetID", chainParams.SubnetID),
@@ -433,26 +910,85 @@ func (m *manager) createChain(chainParams ChainParameters) {
 	}
 
 	m.chainsLock.Lock()
-	m.chains[chainParams.ID] = chain.Handler
+	isRetired = m.retiredChains.Contains(chainParams.ID)
+	isUntracked = !isRetired && m.untrackedSubnets.Contains(chainParams.SubnetID)
+	switch {
+	case isUntracked:
+		m.registerUntrackedChain(chainParams)
+		m.untrackedDBTrackers[chainParams.ID] = chain.DBTracker
+	case !isRetired:
+		m.chains[chainParams.ID] = chain.Handler
+		m.chainVMs[chainParams.ID] = chain.UnwrappedVM
+		m.chainDBTrackers[chainParams.ID] = chain.DBTracker
+		delete(m.untrackedDBTrackers, chainParams.ID)
+		m.chainConsensusParams[chainParams.ID] = chain.ConsensusParameters
+		m.chainParams[chainParams.ID] = chainParams
+		m.untrackedChains.Remove(chainParams.ID)
+		if restart, ok := m.chainRestarts[chainParams.ID]; ok {
+			restart.err = nil
+		}
+	}
 	m.chainsLock.Unlock()
 
-	// Associate the newly created chain with its default alias
-	if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
-		m.Log.Error("failed to alias the new chain with itself",
+	// The chain was retired while it was being built, so it is started only to
+	// be shut down.
+	if isRetired {
+		m.Log.Info("stopping chain",
+			zap.String("reason", "chain was retired"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.Stringer("vmID", chainParams.VMID),
+		)
+		sb.RemoveChain(chainParams.ID)
+		chain.Handler.Stop(context.TODO())
+		chain.Handler.Start(context.TODO(), true)
+		if m.PruneRetiredChains {
+			go m.pruneChain(chainParams.ID, chain.Handler, chain.DBTracker)
+		}
+		return
+	}
+
+	// The chain's subnet stopped being tracked while it was being built, so
+	// it is started only to be shut down. Its metrics are removed so that it
+	// can be created again.
+	if isUntracked {
+		m.Log.Info("stopping chain",
+			zap.String("reason", "subnet isn't tracked"),
 			zap.Stringer("subnetID", chainParams.SubnetID),
 			zap.Stringer("chainID", chainParams.ID),
 			zap.Stringer("vmID", chainParams.VMID),
-			zap.Error(err),
 		)
+		sb.RemoveChain(chainParams.ID)
+		chain.Handler.Stop(context.TODO())
+		chain.Handler.Start(context.TODO(), true)
+		m.deregisterChainMetrics(chainParams)
+		return
+	}
+
+	// Associate the newly created chain with its default alias, unless it was
+	// already associated before the chain was restarted
+	if !isRecreation {
+		if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
+			m.Log.Error("failed to alias the new chain with itself",
+				zap.Stringer("subnetID", chainParams.SubnetID),
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Stringer("vmID", chainParams.VMID),
+				zap.Error(err),
+			)
+		}
 	}
 
 	// Notify those who registered to be notified when a new chain is created
-	m.notifyRegistrants(chain.Name, chain.Context, chain.VM)
+	m.notifyRegistrants(chain.Name, chainParams, chain.Context, chain.VM)
 
 	// Allows messages to be routed to the new chain. If the handler hasn't been
 	// started and a message is forwarded, then the message will block until the
 	// handler is started.
-	m.ManagerConfig.Router.AddChain(context.TODO(), chain.Handler)
+	m.ManagerConfig.Router.AddChain(context.TODO(), &subnetMessagesHandler{
+		Handler:  chain.Handler,
+		subnetID: chainParams.SubnetID,
+		metrics:  m.subnetMessages,
+	})
 
 	// Register bootstrapped health checks after P chain has been added to
 	// chains.
@@ -466,9 +1002,19 @@ func (m *manager) createChain(chainParams ChainParameters) {
 		}
 	}
 
-	// Tell the chain to start processing messages.
+	// Tell the chain to start processing messages once it is allowed to start
+	// bootstrapping.
 	// If the X, P, or C Chain panics, do not attempt to recover
-	chain.Handler.Start(context.TODO(), !m.CriticalChains.Contains(chainParams.ID))
+	recoverPanic := !m.CriticalChains.Contains(chainParams.ID)
+	m.bootstrapScheduler.Schedule(chainParams.ID, bootstrapPriority(chainParams), func() {
+		chain.Handler.Start(context.TODO(), recoverPanic)
+	})
+
+	// Restart the chain if its VM process exits unexpectedly. The node shuts
+	// down instead if a critical chain stops.
+	if vm, ok := chain.UnwrappedVM.(exitReporter); ok && recoverPanic {
+		go m.superviseChain(chainParams, chain.Handler, vm)
+	}
 }
 
 // Create a chain
@@ -485,7 +1031,7 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 	}
 
 	// Create the log and context of the chain
-	chainLog, err := m.LogFactory.MakeChain(primaryAlias)
+	chainLog, err := m.getOrMakeChainLog(chainParams.ID, primaryAlias)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating chain's log %w", err)
 	}
@@ -510,8 +1056,7 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 
 			WarpSigner: warp.NewSigner(m.StakingBLSKey, m.NetworkID, chainParams.ID),
 
-			ValidatorState: m.validatorState,
-			ChainDataDir:   chainDataDir,
+			ChainDataDir: chainDataDir,
 		},
 		PrimaryAlias:   primaryAlias,
 		Registerer:     prometheus.NewRegistry(),
@@ -520,6 +1065,12 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 		VertexAcceptor: m.VertexAcceptorGroup,
 	}
 
+	// The validator sets requested by the chain are attributed to it. The
+	// P-chain's validator state is set once its VM is created.
+	if m.validatorState != nil {
+		ctx.ValidatorState = validators.NewCallerState(m.validatorState, primaryAlias)
+	}
+
 	// Get a factory for the vm we want to use on our chain
 	vmFactory, err := m.VMManager.GetFactory(chainParams.VMID)
 	if err != nil {
@@ -582,6 +1133,8 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 		return nil, errUnknownVMType
 	}
 
+	chain.UnwrappedVM = vm
+
 	// Register the chain with the timeout manager
 	if err := m.TimeoutManager.RegisterChain(ctx); err != nil {
 		return nil, err
@@ -633,7 +1186,8 @@ func (m *manager) createAvalancheChain(
 		return nil, err
 	}
 
-	prefixDB := prefixdb.New(ctx.ChainID[:], meterDB)
+	dbTracker := prefixdb.NewTracker()
+	prefixDB := prefixdb.NewTracked(ctx.ChainID[:], meterDB, dbTracker)
 	vmDB := prefixdb.New(VMDBPrefix, prefixDB)
 	vertexDB := prefixdb.New(VertexDBPrefix, prefixDB)
 	vertexBootstrappingDB := prefixdb.New(VertexBootstrappingDBPrefix, prefixDB)
@@ -657,11 +1211,16 @@ func (m *manager) createAvalancheChain(
 		return nil, err
 	}
 
+	externalSender, err := m.getOrMakeGossipSender(ctx.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Passes messages from the avalanche engines to the network
 	avalancheMessageSender, err := sender.New(
 		ctx,
 		m.MsgCreator,
-		m.Net,
+		externalSender,
 		m.ManagerConfig.Router,
 		m.TimeoutManager,
 		p2ppb.EngineType_ENGINE_TYPE_AVALANCHE,
@@ -680,7 +1239,7 @@ func (m *manager) createAvalancheChain(
 	snowmanMessageSender, err := sender.New(
 		ctx,
 		m.MsgCreator,
-		m.Net,
+		externalSender,
 		m.ManagerConfig.Router,
 		m.TimeoutManager,
 		p2ppb.EngineType_ENGINE_TYPE_SNOWMAN,
@@ -695,7 +1254,7 @@ func (m *manager) createAvalancheChain(
 		snowmanMessageSender = sender.Trace(snowmanMessageSender, m.Tracer)
 	}
 
-	chainConfig, err := m.getChainConfig(ctx.ChainID)
+	chainConfig, err := m.GetChainConfig(ctx.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching chain config: %w", err)
 	}
@@ -755,12 +1314,18 @@ func (m *manager) createAvalancheChain(
 		// A default subnet configuration will be present if explicit configuration is not provided
 		subnetCfg           = m.SubnetConfigs[ctx.SubnetID]
 		minBlockDelay       = subnetCfg.ProposerMinBlockDelay
+		maxBlockDelay       = subnetCfg.ProposerMaxBlockDelay
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
+		checkpoints         *checkpoint.Tracker
 	)
+	if !subnetCfg.DisableCheckpoints {
+		checkpoints = checkpoint.NewTracker(ctx.NetworkID, ctx.SubnetID, ctx.ChainID, vdrs)
+	}
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.Upgrades.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.Upgrades.ApricotPhase4MinPChainHeight),
 		zap.Duration("minBlockDelay", minBlockDelay),
+		zap.Duration("maxBlockDelay", maxBlockDelay),
 		zap.Uint64("numHistoricalBlocks", numHistoricalBlocks),
 	)
 
@@ -785,10 +1350,12 @@ func (m *manager) createAvalancheChain(
 		proposervm.Config{
 			Upgrades:            m.Upgrades,
 			MinBlkDelay:         minBlockDelay,
+			MaxBlkDelay:         maxBlockDelay,
 			NumHistoricalBlocks: numHistoricalBlocks,
 			StakingLeafSigner:   m.StakingTLSSigner,
 			StakingCertLeaf:     m.StakingTLSCert,
 			Registerer:          proposervmReg,
+			Checkpoints:         checkpoints,
 		},
 	)
 
@@ -833,7 +1400,19 @@ func (m *manager) createAvalancheChain(
 		return nil, fmt.Errorf("error while fetching weight for subnet %s: %w", ctx.SubnetID, err)
 	}
 
-	consensusParams := sb.Config().ConsensusParameters
+	consensusParams, err := getConsensusParameters(sb.Config().ConsensusParameters, chainConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consensus parameters: %w", err)
+	}
+	if consensusParams != sb.Config().ConsensusParameters {
+		m.Log.Info("overriding consensus parameters",
+			zap.Stringer("chainID", ctx.ChainID),
+			zap.Int("k", consensusParams.K),
+			zap.Int("alphaPreference", consensusParams.AlphaPreference),
+			zap.Int("alphaConfidence", consensusParams.AlphaConfidence),
+			zap.Int("beta", consensusParams.Beta),
+		)
+	}
 	sampleK := consensusParams.K
 	if uint64(sampleK) > bootstrapWeight {
 		sampleK = int(bootstrapWeight)
@@ -889,6 +1468,7 @@ func (m *manager) createAvalancheChain(
 		msgChan,
 		m.FrontierPollFrequency,
 		m.ConsensusAppConcurrency,
+		m.ConsensusMaxUnprocessedMsgs,
 		m.ResourceTracker,
 		sb,
 		connectedValidators,
@@ -932,6 +1512,8 @@ func (m *manager) createAvalancheChain(
 		ConnectedValidators: connectedValidators,
 		Params:              consensusParams,
 		Consensus:           snowmanConsensus,
+
+		BlockVerificationTimeout: m.BlockVerificationTimeout,
 	}
 	var snowmanEngine common.Engine
 	snowmanEngine, err = smeng.New(snowmanEngineConfig)
@@ -958,6 +1540,9 @@ func (m *manager) createAvalancheChain(
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
 		DB:                             blockBootstrappingDB,
 		VM:                             vmWrappingProposerVM,
+		Checkpoints:                    checkpoints,
+		RequireCheckpoints:             subnetCfg.RequireCheckpoints,
+		BlockVerificationTimeout:       m.BlockVerificationTimeout,
 	}
 	var snowmanBootstrapper common.BootstrapableEngine
 	snowmanBootstrapper, err = smbootstrap.New(
@@ -1036,15 +1621,17 @@ func (m *manager) createAvalancheChain(
 	})
 
 	// Register health check for this chain
-	if err := m.Health.RegisterHealthCheck(primaryAlias, h, ctx.SubnetID.String()); err != nil {
+	if err := m.registerChainHealthCheck(ctx, h); err != nil {
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", primaryAlias, err)
 	}
 
 	return &chain{
-		Name:    primaryAlias,
-		Context: ctx,
-		VM:      dagVM,
-		Handler: h,
+		Name:                primaryAlias,
+		Context:             ctx,
+		VM:                  dagVM,
+		Handler:             h,
+		DBTracker:           dbTracker,
+		ConsensusParameters: consensusParams,
 	}, nil
 }
 
@@ -1080,15 +1667,21 @@ func (m *manager) createSnowmanChain(
 		return nil, err
 	}
 
-	prefixDB := prefixdb.New(ctx.ChainID[:], meterDB)
+	dbTracker := prefixdb.NewTracker()
+	prefixDB := prefixdb.NewTracked(ctx.ChainID[:], meterDB, dbTracker)
 	vmDB := prefixdb.New(VMDBPrefix, prefixDB)
 	bootstrappingDB := prefixdb.New(ChainBootstrappingDBPrefix, prefixDB)
 
+	externalSender, err := m.getOrMakeGossipSender(ctx.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Passes messages from the consensus engine to the network
 	messageSender, err := sender.New(
 		ctx,
 		m.MsgCreator,
-		m.Net,
+		externalSender,
 		m.ManagerConfig.Router,
 		m.TimeoutManager,
 		p2ppb.EngineType_ENGINE_TYPE_SNOWMAN,
@@ -1120,7 +1713,7 @@ func (m *manager) createSnowmanChain(
 		// Notice that this context is left unlocked. This is because the
 		// lock will already be held when accessing these values on the
 		// P-chain.
-		ctx.ValidatorState = valState
+		ctx.ValidatorState = validators.NewCallerState(valState, ctx.PrimaryAlias)
 
 		// Initialize the validator state for future chains.
 		m.validatorState = validators.NewLockedState(&ctx.Lock, valState)
@@ -1143,7 +1736,7 @@ func (m *manager) createSnowmanChain(
 	}
 
 	// Initialize the ProposerVM and the vm wrapped inside it
-	chainConfig, err := m.getChainConfig(ctx.ChainID)
+	chainConfig, err := m.GetChainConfig(ctx.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching chain config: %w", err)
 	}
@@ -1152,12 +1745,18 @@ func (m *manager) createSnowmanChain(
 		// A default subnet configuration will be present if explicit configuration is not provided
 		subnetCfg           = m.SubnetConfigs[ctx.SubnetID]
 		minBlockDelay       = subnetCfg.ProposerMinBlockDelay
+		maxBlockDelay       = subnetCfg.ProposerMaxBlockDelay
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
+		checkpoints         *checkpoint.Tracker
 	)
+	if !subnetCfg.DisableCheckpoints {
+		checkpoints = checkpoint.NewTracker(ctx.NetworkID, ctx.SubnetID, ctx.ChainID, vdrs)
+	}
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.Upgrades.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.Upgrades.ApricotPhase4MinPChainHeight),
 		zap.Duration("minBlockDelay", minBlockDelay),
+		zap.Duration("maxBlockDelay", maxBlockDelay),
 		zap.Uint64("numHistoricalBlocks", numHistoricalBlocks),
 	)
 
@@ -1178,10 +1777,12 @@ func (m *manager) createSnowmanChain(
 		proposervm.Config{
 			Upgrades:            m.Upgrades,
 			MinBlkDelay:         minBlockDelay,
+			MaxBlkDelay:         maxBlockDelay,
 			NumHistoricalBlocks: numHistoricalBlocks,
 			StakingLeafSigner:   m.StakingTLSSigner,
 			StakingCertLeaf:     m.StakingTLSCert,
 			Registerer:          proposervmReg,
+			Checkpoints:         checkpoints,
 		},
 	)
 
@@ -1225,7 +1826,19 @@ func (m *manager) createSnowmanChain(
 		return nil, fmt.Errorf("error while fetching weight for subnet %s: %w", ctx.SubnetID, err)
 	}
 
-	consensusParams := sb.Config().ConsensusParameters
+	consensusParams, err := getConsensusParameters(sb.Config().ConsensusParameters, chainConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid consensus parameters: %w", err)
+	}
+	if consensusParams != sb.Config().ConsensusParameters {
+		m.Log.Info("overriding consensus parameters",
+			zap.Stringer("chainID", ctx.ChainID),
+			zap.Int("k", consensusParams.K),
+			zap.Int("alphaPreference", consensusParams.AlphaPreference),
+			zap.Int("alphaConfidence", consensusParams.AlphaConfidence),
+			zap.Int("beta", consensusParams.Beta),
+		)
+	}
 	sampleK := consensusParams.K
 	if uint64(sampleK) > bootstrapWeight {
 		sampleK = int(bootstrapWeight)
@@ -1281,6 +1894,7 @@ func (m *manager) createSnowmanChain(
 		msgChan,
 		m.FrontierPollFrequency,
 		m.ConsensusAppConcurrency,
+		m.ConsensusMaxUnprocessedMsgs,
 		m.ResourceTracker,
 		sb,
 		connectedValidators,
@@ -1325,9 +1939,22 @@ func (m *manager) createSnowmanChain(
 		Params:              consensusParams,
 		Consensus:           consensus,
 		PartialSync:         m.PartialSyncPrimaryNetwork && ctx.ChainID == constants.PlatformChainID,
+
+		BlockVerificationTimeout: m.BlockVerificationTimeout,
 	}
 	var engine common.Engine
-	engine, err = smeng.New(engineConfig)
+	if m.ReadReplicaChainIDs.Contains(ctx.ChainID) {
+		engine, err = replica.New(replica.Config{
+			AllGetsServer:            snowGetHandler,
+			Ctx:                      ctx,
+			VM:                       vm,
+			Sources:                  m.ReadReplicaSources(primaryAlias),
+			PollFrequency:            m.ReadReplicaPollFrequency,
+			BlockVerificationTimeout: m.BlockVerificationTimeout,
+		})
+	} else {
+		engine, err = smeng.New(engineConfig)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error initializing snowman engine: %w", err)
 	}
@@ -1351,7 +1978,10 @@ func (m *manager) createSnowmanChain(
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
 		DB:                             bootstrappingDB,
 		VM:                             vm,
+		Checkpoints:                    checkpoints,
+		RequireCheckpoints:             subnetCfg.RequireCheckpoints,
 		Bootstrapped:                   bootstrapFunc,
+		BlockVerificationTimeout:       m.BlockVerificationTimeout,
 	}
 	var bootstrapper common.BootstrapableEngine
 	bootstrapper, err = smbootstrap.New(
@@ -1400,15 +2030,17 @@ func (m *manager) createSnowmanChain(
 	})
 
 	// Register health checks
-	if err := m.Health.RegisterHealthCheck(primaryAlias, h, ctx.SubnetID.String()); err != nil {
+	if err := m.registerChainHealthCheck(ctx, h); err != nil {
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", primaryAlias, err)
 	}
 
 	return &chain{
-		Name:    primaryAlias,
-		Context: ctx,
-		VM:      vm,
-		Handler: h,
+		Name:                primaryAlias,
+		Context:             ctx,
+		VM:                  vm,
+		Handler:             h,
+		DBTracker:           dbTracker,
+		ConsensusParameters: consensusParams,
 	}, nil
 }
 
@@ -1506,12 +2138,184 @@ func (m *manager) dispatchChainCreator() {
 	}
 }
 
+func (m *manager) RetireChain(subnetID ids.ID, chainID ids.ID) {
+	m.chainsLock.Lock()
+	if m.retiredChains.Contains(chainID) {
+		m.chainsLock.Unlock()
+		return
+	}
+	m.retiredChains.Add(chainID)
+	m.registeredChains[subnetID] = slices.DeleteFunc(
+		m.registeredChains[subnetID],
+		func(chainParams ChainParameters) bool {
+			return chainParams.ID == chainID
+		},
+	)
+	if len(m.registeredChains[subnetID]) == 0 {
+		delete(m.registeredChains, subnetID)
+	}
+	h, isRunning := m.chains[chainID]
+	dbTracker, ok := m.chainDBTrackers[chainID]
+	if !ok {
+		dbTracker = m.untrackedDBTrackers[chainID]
+	}
+	delete(m.chains, chainID)
+	delete(m.chainVMs, chainID)
+	delete(m.chainDBTrackers, chainID)
+	delete(m.untrackedDBTrackers, chainID)
+	delete(m.chainConsensusParams, chainID)
+	delete(m.chainParams, chainID)
+	m.chainsLock.Unlock()
+
+	m.Log.Info("retiring chain",
+		zap.Stringer("subnetID", subnetID),
+		zap.Stringer("chainID", chainID),
+		zap.Bool("isRunning", isRunning),
+	)
+
+	if isRunning {
+		// The router removes the chain once its handler has stopped.
+		h.Stop(context.TODO())
+		m.bootstrapScheduler.Remove(chainID)
+		if sb, _ := m.Subnets.GetOrCreate(subnetID); !sb.RemoveChain(chainID) {
+			m.Log.Warn("retired chain wasn't tracked by its subnet",
+				zap.Stringer("subnetID", subnetID),
+				zap.Stringer("chainID", chainID),
+			)
+		}
+		m.RemoveAliases(chainID)
+	}
+
+	if m.PruneRetiredChains {
+		go m.pruneChain(chainID, h, dbTracker)
+	}
+}
+
+// pruneChain deletes the database and chain data directory of the retired or
+// untracked chain once its handler, if any, has stopped.
+//
+// [dbTracker] records the databases that the chain created. If the chain
+// hasn't run since the node started, it is nil and only the databases that
+// the manager creates for every chain are deleted.
+func (m *manager) pruneChain(chainID ids.ID, h handler.Handler, dbTracker *prefixdb.Tracker) {
+	if h != nil {
+		if _, err := h.AwaitStopped(context.TODO()); err != nil {
+			m.Log.Error("failed to wait for chain to stop",
+				zap.Stringer("chainID", chainID),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
+	if dbTracker == nil {
+		dbTracker = m.newChainDBTracker(chainID)
+	}
+	for path, r := range dbTracker.Ranges() {
+		if err := database.ClearPrefix(m.DB, r.Start, pruneRetiredChainWriteSize); err != nil {
+			m.Log.Error("failed to prune database of chain",
+				zap.Stringer("chainID", chainID),
+				zap.String("path", path),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
+	chainDataDir := filepath.Join(m.ChainDataDir, chainID.String())
+	if err := os.RemoveAll(chainDataDir); err != nil {
+		m.Log.Error("failed to prune chain data directory",
+			zap.Stringer("chainID", chainID),
+			zap.String("path", chainDataDir),
+			zap.Error(err),
+		)
+		return
+	}
+
+	m.Log.Info("pruned chain",
+		zap.Stringer("chainID", chainID),
+	)
+}
+
+// newChainDBTracker returns a tracker of the databases that the manager creates
+// for the chain. VMs that run as plugins store all of their data in these
+// databases.
+func (m *manager) newChainDBTracker(chainID ids.ID) *prefixdb.Tracker {
+	dbTracker := prefixdb.NewTracker()
+	prefixDB := prefixdb.NewTracked(chainID[:], m.DB, dbTracker)
+	vmDB := prefixdb.New(VMDBPrefix, prefixDB)
+	prefixdb.New(proposervm.DBPrefix, vmDB)
+	for _, prefix := range [][]byte{
+		VertexDBPrefix,
+		VertexBootstrappingDBPrefix,
+		TxBootstrappingDBPrefix,
+		BlockBootstrappingDBPrefix,
+		ChainBootstrappingDBPrefix,
+	} {
+		prefixdb.New(prefix, prefixDB)
+	}
+	return dbTracker
+}
+
+// registerChainHealthCheck registers the health check of the chain, unless it
+// was registered before the chain was restarted.
+func (m *manager) registerChainHealthCheck(ctx *snow.ConsensusContext, h handler.Handler) error {
+	if m.healthCheckedChains.Contains(ctx.ChainID) {
+		return nil
+	}
+	if err := m.Health.RegisterHealthCheck(ctx.PrimaryAlias, m.chainHealthCheck(ctx.SubnetID, ctx.ChainID, h), ctx.SubnetID.String()); err != nil {
+		return err
+	}
+	m.healthCheckedChains.Add(ctx.ChainID)
+	return nil
+}
+
+// chainHealthCheck reports the health of the chain's handler until the chain
+// is retired or its subnet stops being tracked. [h] is the handler of the chain
+// before it is added to the manager. If the chain's VM process exited, the
+// chain is reported as unhealthy until it is restarted.
+func (m *manager) chainHealthCheck(subnetID ids.ID, chainID ids.ID, h handler.Handler) health.Checker {
+	return health.CheckerFunc(func(ctx context.Context) (interface{}, error) {
+		m.chainsLock.Lock()
+		isRetired := m.retiredChains.Contains(chainID)
+		isUntracked := m.untrackedSubnets.Contains(subnetID)
+		running, isRunning := m.chains[chainID]
+		var (
+			restarts int
+			exitErr  error
+		)
+		if restart, ok := m.chainRestarts[chainID]; ok {
+			restarts = restart.restarts
+			exitErr = restart.err
+		}
+		m.chainsLock.Unlock()
+
+		switch {
+		case isRetired:
+			return "retired", nil
+		case isUntracked:
+			return "untracked", nil
+		case exitErr != nil:
+			details := map[string]int{
+				"restarts":    restarts,
+				"maxRestarts": m.VMMaxRestarts,
+			}
+			return details, fmt.Errorf("%w: %w", errVMExited, exitErr)
+		case isRunning:
+			return running.HealthCheck(ctx)
+		default:
+			return h.HealthCheck(ctx)
+		}
+	})
+}
+
 // Shutdown stops all the chains
 func (m *manager) Shutdown() {
 	m.Log.Info("shutting down chain manager")
 	m.chainsQueue.Close()
 	close(m.chainCreatorShutdownCh)
 	m.chainCreatorExited.Wait()
+	m.bootstrapScheduler.Shutdown()
 	m.ManagerConfig.Router.Shutdown(context.TODO())
 }
 
@@ -1522,15 +2326,22 @@ func (m *manager) LookupVM(alias string) (ids.ID, error) {
 
 // Notify registrants [those who want to know about the creation of chains]
 // that the specified chain has been created
-func (m *manager) notifyRegistrants(name string, ctx *snow.ConsensusContext, vm common.VM) {
+func (m *manager) notifyRegistrants(name string, params ChainParameters, ctx *snow.ConsensusContext, vm common.VM) {
 	for _, registrant := range m.registrants {
+		if registrant, ok := registrant.(ParametersRegistrant); ok {
+			registrant.RegisterChainWithParameters(name, params, ctx, vm)
+			continue
+		}
 		registrant.RegisterChain(name, ctx, vm)
 	}
 }
 
-// getChainConfig returns value of a entry by looking at ID key and alias key
+// GetChainConfig returns value of a entry by looking at ID key and alias key
 // it first searches ID key, then falls back to it's corresponding primary alias
-func (m *manager) getChainConfig(id ids.ID) (ChainConfig, error) {
+func (m *manager) GetChainConfig(id ids.ID) (ChainConfig, error) {
+	m.chainConfigsLock.RLock()
+	defer m.chainConfigsLock.RUnlock()
+
 	if val, ok := m.ManagerConfig.ChainConfigs[id.String()]; ok {
 		return val, nil
 	}
@@ -1547,6 +2358,175 @@ func (m *manager) getChainConfig(id ids.ID) (ChainConfig, error) {
 	return ChainConfig{}, nil
 }
 
+func (m *manager) UpdateChainConfig(ctx context.Context, chainID ids.ID, config []byte) error {
+	m.chainsLock.Lock()
+	h, ok := m.chains[chainID]
+	vm := m.chainVMs[chainID]
+	m.chainsLock.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+
+	updater, ok := vm.(common.ConfigUpdater)
+	if !ok {
+		return fmt.Errorf("%w: %s", errConfigUpdateUnsupported, chainID)
+	}
+
+	// Holding the context lock guarantees that the engine isn't in the middle
+	// of verifying or accepting a block.
+	chainCtx := h.Context()
+	chainCtx.Lock.Lock()
+	err := updater.OnConfigUpdated(ctx, config)
+	chainCtx.Lock.Unlock()
+	if errors.Is(err, common.ErrConfigUpdaterNotImplemented) {
+		return fmt.Errorf("%w: %s", errConfigUpdateUnsupported, chainID)
+	}
+	if err != nil {
+		return fmt.Errorf("chain %s rejected config update: %w", chainID, err)
+	}
+
+	oldConfig, err := m.GetChainConfig(chainID)
+	if err != nil {
+		return err
+	}
+
+	m.chainConfigsLock.Lock()
+	defer m.chainConfigsLock.Unlock()
+
+	// Configs keyed by the chain ID take precedence over configs keyed by an
+	// alias.
+	m.ChainConfigs[chainID.String()] = ChainConfig{
+		Config:    config,
+		Upgrade:   oldConfig.Upgrade,
+		Consensus: oldConfig.Consensus,
+	}
+	m.Log.Info("updated chain config",
+		zap.Stringer("chainID", chainID),
+	)
+	return nil
+}
+
+func (m *manager) GetChainDiskUsage(chainID ids.ID) (DiskUsage, error) {
+	m.chainsLock.Lock()
+	dbTracker, ok := m.chainDBTrackers[chainID]
+	m.chainsLock.Unlock()
+	if !ok {
+		return DiskUsage{}, fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+	if m.DBSizeEstimator == nil {
+		return DiskUsage{}, errDiskUsageUnsupported
+	}
+
+	ranges := dbTracker.Ranges()
+	usage := DiskUsage{
+		Database: make(map[string]uint64, len(ranges)),
+	}
+	for path, r := range ranges {
+		size, err := m.DBSizeEstimator.EstimateSize(r.Start, r.Limit)
+		if err != nil {
+			return DiskUsage{}, fmt.Errorf("failed to estimate size of %q: %w", path, err)
+		}
+		usage.Database[path] = size
+	}
+
+	chainDataDir := filepath.Join(m.ChainDataDir, chainID.String())
+	chainDataDirSize, err := storage.DirSize(chainDataDir)
+	if err != nil {
+		return DiskUsage{}, fmt.Errorf("failed to calculate size of %q: %w", chainDataDir, err)
+	}
+	usage.ChainDataDir = chainDataDirSize
+	return usage, nil
+}
+
+func (m *manager) GetSubnetMessageUsage(limit int) []SubnetMessageUsage {
+	return m.subnetMessages.Top(limit)
+}
+
+func (m *manager) GetChainAcceptedLatency(chainID ids.ID) (AcceptedLatency, error) {
+	m.chainsLock.Lock()
+	h, ok := m.chains[chainID]
+	m.chainsLock.Unlock()
+	if !ok {
+		return AcceptedLatency{}, fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+	return gatherAcceptedLatency(h.Context().Registerer)
+}
+
+// gatherAcceptedLatency reads the accepted latency histogram reported by the
+// snowman consensus metrics of [gatherer]. If the consensus engine hasn't
+// started yet, an empty distribution is returned.
+func gatherAcceptedLatency(gatherer prometheus.Gatherer) (AcceptedLatency, error) {
+	families, err := gatherer.Gather()
+	if err != nil {
+		return AcceptedLatency{}, err
+	}
+
+	for _, family := range families {
+		if family.GetName() != smcon.AcceptedLatencyMetricName || len(family.GetMetric()) == 0 {
+			continue
+		}
+
+		histogram := family.GetMetric()[0].GetHistogram()
+		latency := AcceptedLatency{
+			Count:   histogram.GetSampleCount(),
+			Sum:     secondsToDuration(histogram.GetSampleSum()),
+			Buckets: make([]LatencyBucket, len(histogram.GetBucket())),
+		}
+		for i, bucket := range histogram.GetBucket() {
+			latency.Buckets[i] = LatencyBucket{
+				UpperBound: secondsToDuration(bucket.GetUpperBound()),
+				Count:      bucket.GetCumulativeCount(),
+			}
+		}
+		return latency, nil
+	}
+	return AcceptedLatency{}, nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func (m *manager) GetChainConsensusParameters(chainID ids.ID) (snowball.Parameters, error) {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	params, ok := m.chainConsensusParams[chainID]
+	if !ok {
+		return snowball.Parameters{}, fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+	return params, nil
+}
+
+func (m *manager) GetChainTxLimits(chainID ids.ID) (limits.Limits, error) {
+	m.chainsLock.Lock()
+	vm, ok := m.chainVMs[chainID]
+	m.chainsLock.Unlock()
+	if !ok {
+		return limits.Limits{}, fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+
+	provider, ok := vm.(limits.Provider)
+	if !ok {
+		return limits.Limits{}, fmt.Errorf("%w: %s", errTxLimitsUnsupported, chainID)
+	}
+	return provider.TxLimits(), nil
+}
+
+// getConsensusParameters returns the consensus parameters of [subnetParams]
+// with the overrides of [chainConfig] applied.
+func getConsensusParameters(subnetParams snowball.Parameters, chainConfig ChainConfig) (snowball.Parameters, error) {
+	if len(chainConfig.Consensus) == 0 {
+		return subnetParams, nil
+	}
+
+	var overrides snowball.ParameterOverrides
+	if err := json.Unmarshal(chainConfig.Consensus, &overrides); err != nil {
+		return snowball.Parameters{}, fmt.Errorf("failed to parse consensus overrides: %w", err)
+	}
+	return overrides.Apply(subnetParams)
+}
+
 func (m *manager) getOrMakeVMGatherer(vmID ids.ID) (metrics.MultiGatherer, error) {
 	vmGatherer, ok := m.vmGatherer[vmID]
 	if ok {
@@ -1566,3 +2546,52 @@ func (m *manager) getOrMakeVMGatherer(vmID ids.ID) (metrics.MultiGatherer, error
 	m.vmGatherer[vmID] = vmGatherer
 	return vmGatherer, nil
 }
+
+// getOrMakeChainLog returns the log of the chain, which is created the first
+// time the chain is built.
+func (m *manager) getOrMakeChainLog(chainID ids.ID, primaryAlias string) (logging.Logger, error) {
+	chainLog, ok := m.chainLogs[chainID]
+	if ok {
+		return chainLog, nil
+	}
+
+	chainLog, err := m.LogFactory.MakeChain(primaryAlias)
+	if err != nil {
+		return nil, err
+	}
+	m.chainLogs[chainID] = chainLog
+	return chainLog, nil
+}
+
+// getOrMakeGossipSender returns the sender shared by the chains of [subnetID]
+// that avoids gossiping the same message to a peer repeatedly.
+func (m *manager) getOrMakeGossipSender(subnetID ids.ID) (sender.ExternalSender, error) {
+	if m.AppGossipDedupWindow == 0 {
+		return m.messagesSender, nil
+	}
+
+	gossipSender, ok := m.gossipSenders[subnetID]
+	if ok {
+		return gossipSender, nil
+	}
+
+	gossipReg, err := metrics.MakeAndRegister(
+		m.gossipGatherer,
+		subnetID.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gossipSender, err = sender.NewGossipDeduplicator(
+		m.messagesSender,
+		m.AppGossipDedupWindow,
+		m.AppGossipDedupSize,
+		gossipReg,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialize gossip sender: %w", err)
+	}
+	m.gossipSenders[subnetID] = gossipSender
+	return gossipSender, nil
+}
@@ -0,0 +1,647 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
+	"github.com/ava-labs/avalanchego/snow/networking/handler/handlermock"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/buffer"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/vms/proposervm"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
+
+	smcon "github.com/ava-labs/avalanchego/snow/consensus/snowman"
+)
+
+var errInvalidConfig = errors.New("invalid config")
+
+type configUpdaterVM struct {
+	blocktest.VM
+
+	config []byte
+	err    error
+}
+
+func (vm *configUpdaterVM) OnConfigUpdated(_ context.Context, config []byte) error {
+	if vm.err != nil {
+		return vm.err
+	}
+	if len(config) == 0 {
+		return errInvalidConfig
+	}
+	vm.config = config
+	return nil
+}
+
+func TestUpdateChainConfig(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	h := handlermock.NewHandler(ctrl)
+	h.EXPECT().Context().Return(ctx).AnyTimes()
+
+	vm := &configUpdaterVM{}
+	unsupportedChainID := ids.GenerateTestID()
+	unsupportedPluginChainID := ids.GenerateTestID()
+	m := &manager{
+		Aliaser: ids.NewAliaser(),
+		ManagerConfig: ManagerConfig{
+			Log: logging.NoLog{},
+			ChainConfigs: map[string]ChainConfig{
+				"C": {
+					Config:  []byte("old config"),
+					Upgrade: []byte("upgrade"),
+				},
+			},
+		},
+		chains: map[ids.ID]handler.Handler{
+			ctx.ChainID:              h,
+			unsupportedChainID:       h,
+			unsupportedPluginChainID: h,
+		},
+		chainVMs: map[ids.ID]interface{}{
+			ctx.ChainID:        vm,
+			unsupportedChainID: &blocktest.VM{},
+			// Plugin VMs always implement ConfigUpdater but may forward the
+			// update to a VM that doesn't.
+			unsupportedPluginChainID: &configUpdaterVM{
+				err: common.ErrConfigUpdaterNotImplemented,
+			},
+		},
+	}
+	require.NoError(m.Alias(ctx.ChainID, "C"))
+
+	err := m.UpdateChainConfig(context.Background(), ids.GenerateTestID(), []byte("new config"))
+	require.ErrorIs(err, errUnknownChain)
+
+	err = m.UpdateChainConfig(context.Background(), unsupportedChainID, []byte("new config"))
+	require.ErrorIs(err, errConfigUpdateUnsupported)
+
+	err = m.UpdateChainConfig(context.Background(), unsupportedPluginChainID, []byte("new config"))
+	require.ErrorIs(err, errConfigUpdateUnsupported)
+
+	// A rejected config shouldn't replace the previous config
+	err = m.UpdateChainConfig(context.Background(), ctx.ChainID, nil)
+	require.ErrorIs(err, errInvalidConfig)
+	config, err := m.GetChainConfig(ctx.ChainID)
+	require.NoError(err)
+	require.Equal([]byte("old config"), config.Config)
+
+	require.NoError(m.UpdateChainConfig(context.Background(), ctx.ChainID, []byte("new config")))
+	require.Equal([]byte("new config"), vm.config)
+	config, err = m.GetChainConfig(ctx.ChainID)
+	require.NoError(err)
+	require.Equal(ChainConfig{
+		Config:  []byte("new config"),
+		Upgrade: []byte("upgrade"),
+	}, config)
+}
+
+func TestGetChainDiskUsage(t *testing.T) {
+	require := require.New(t)
+
+	db, err := leveldb.New(t.TempDir(), nil, logging.NoLog{}, prometheus.NewRegistry())
+	require.NoError(err)
+	defer db.Close()
+
+	var (
+		chainID      = ids.GenerateTestID()
+		dbTracker    = prefixdb.NewTracker()
+		prefixDB     = prefixdb.NewTracked(chainID[:], db, dbTracker)
+		vmDB         = prefixdb.New(VMDBPrefix, prefixDB)
+		chainDataDir = t.TempDir()
+		value        = make([]byte, 1024)
+	)
+	for i := range 1024 {
+		require.NoError(vmDB.Put([]byte{byte(i >> 8), byte(i)}, value))
+	}
+	// Flush the keys to disk so that they are included in the estimate.
+	require.NoError(db.Compact(nil, nil))
+
+	require.NoError(os.Mkdir(filepath.Join(chainDataDir, chainID.String()), perms.ReadWriteExecute))
+	require.NoError(os.WriteFile(filepath.Join(chainDataDir, chainID.String(), "file"), value, perms.ReadWrite))
+
+	m := &manager{
+		ManagerConfig: ManagerConfig{
+			ChainDataDir: chainDataDir,
+		},
+		chainDBTrackers: map[ids.ID]*prefixdb.Tracker{
+			chainID: dbTracker,
+		},
+	}
+
+	// The database must support size estimation
+	_, err = m.GetChainDiskUsage(chainID)
+	require.ErrorIs(err, errDiskUsageUnsupported)
+
+	m.DBSizeEstimator = db.(database.SizeEstimator)
+
+	_, err = m.GetChainDiskUsage(ids.GenerateTestID())
+	require.ErrorIs(err, errUnknownChain)
+
+	usage, err := m.GetChainDiskUsage(chainID)
+	require.NoError(err)
+	require.Len(usage.Database, 2)
+	require.Zero(usage.Database["/"])
+	require.Positive(usage.Database["/vm"])
+	require.Equal(uint64(len(value)), usage.ChainDataDir)
+	require.Equal(usage.Database["/vm"]+usage.ChainDataDir, usage.Total())
+}
+
+func TestGetChainAcceptedLatency(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	h := handlermock.NewHandler(ctrl)
+	h.EXPECT().Context().Return(ctx).AnyTimes()
+
+	m := &manager{
+		chains: map[ids.ID]handler.Handler{
+			ctx.ChainID: h,
+		},
+	}
+
+	_, err := m.GetChainAcceptedLatency(ids.GenerateTestID())
+	require.ErrorIs(err, errUnknownChain)
+
+	// The latency is empty until the consensus engine registers its metrics.
+	latency, err := m.GetChainAcceptedLatency(ctx.ChainID)
+	require.NoError(err)
+	require.Equal(AcceptedLatency{}, latency)
+
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    smcon.AcceptedLatencyMetricName,
+		Buckets: []float64{.5, 1},
+	})
+	require.NoError(ctx.Registerer.Register(histogram))
+	histogram.Observe(.25)
+	histogram.Observe(.75)
+	histogram.Observe(2)
+
+	latency, err = m.GetChainAcceptedLatency(ctx.ChainID)
+	require.NoError(err)
+	require.Equal(AcceptedLatency{
+		Count: 3,
+		Sum:   3 * time.Second,
+		Buckets: []LatencyBucket{
+			{
+				UpperBound: 500 * time.Millisecond,
+				Count:      1,
+			},
+			{
+				UpperBound: time.Second,
+				Count:      2,
+			},
+		},
+	}, latency)
+}
+
+func TestGetConsensusParameters(t *testing.T) {
+	overridden := snowball.DefaultParameters
+	overridden.K = 30
+	overridden.AlphaPreference = 16
+	overridden.AlphaConfidence = 24
+
+	tests := []struct {
+		name           string
+		consensus      []byte
+		expectedParams snowball.Parameters
+		expectedErr    error
+	}{
+		{
+			name:           "no overrides",
+			expectedParams: snowball.DefaultParameters,
+		},
+		{
+			name:           "overrides",
+			consensus:      []byte(`{"k":30,"alphaPreference":16,"alphaConfidence":24}`),
+			expectedParams: overridden,
+		},
+		{
+			name:        "out of bounds",
+			consensus:   []byte(`{"beta":1000}`),
+			expectedErr: snowball.ErrOverrideOutOfBounds,
+		},
+		{
+			name:        "invalid parameters",
+			consensus:   []byte(`{"alphaConfidence":1}`),
+			expectedErr: snowball.ErrParametersInvalid,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			params, err := getConsensusParameters(
+				snowball.DefaultParameters,
+				ChainConfig{
+					Consensus: test.consensus,
+				},
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedParams, params)
+		})
+	}
+}
+
+func TestGetChainConsensusParameters(t *testing.T) {
+	require := require.New(t)
+
+	chainID := ids.GenerateTestID()
+	m := &manager{
+		chainConsensusParams: map[ids.ID]snowball.Parameters{
+			chainID: snowball.DefaultParameters,
+		},
+	}
+
+	_, err := m.GetChainConsensusParameters(ids.GenerateTestID())
+	require.ErrorIs(err, errUnknownChain)
+
+	params, err := m.GetChainConsensusParameters(chainID)
+	require.NoError(err)
+	require.Equal(snowball.DefaultParameters, params)
+}
+
+type testLimitsVM struct{}
+
+func (testLimitsVM) TxLimits() limits.Limits {
+	return limits.Default
+}
+
+func TestGetChainTxLimits(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		chainID            = ids.GenerateTestID()
+		unsupportedChainID = ids.GenerateTestID()
+		m                  = &manager{
+			chainVMs: map[ids.ID]interface{}{
+				chainID:            testLimitsVM{},
+				unsupportedChainID: struct{}{},
+			},
+		}
+	)
+
+	_, err := m.GetChainTxLimits(ids.GenerateTestID())
+	require.ErrorIs(err, errUnknownChain)
+
+	_, err = m.GetChainTxLimits(unsupportedChainID)
+	require.ErrorIs(err, errTxLimitsUnsupported)
+
+	txLimits, err := m.GetChainTxLimits(chainID)
+	require.NoError(err)
+	require.Equal(limits.Default, txLimits)
+}
+
+type testRegistrant struct {
+	chainNames []string
+}
+
+func (r *testRegistrant) RegisterChain(chainName string, _ *snow.ConsensusContext, _ common.VM) {
+	r.chainNames = append(r.chainNames, chainName)
+}
+
+type testParametersRegistrant struct {
+	testRegistrant
+	params []ChainParameters
+}
+
+func (r *testParametersRegistrant) RegisterChainWithParameters(chainName string, params ChainParameters, _ *snow.ConsensusContext, _ common.VM) {
+	r.chainNames = append(r.chainNames, chainName)
+	r.params = append(r.params, params)
+}
+
+func TestNotifyRegistrants(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		registrant           = &testRegistrant{}
+		parametersRegistrant = &testParametersRegistrant{}
+		m                    = &manager{}
+		params               = ChainParameters{
+			ID:          ids.GenerateTestID(),
+			SubnetID:    ids.GenerateTestID(),
+			GenesisData: []byte("genesis"),
+			VMID:        ids.GenerateTestID(),
+		}
+	)
+	m.AddRegistrant(registrant)
+	m.AddRegistrant(parametersRegistrant)
+
+	m.notifyRegistrants("chain", params, nil, nil)
+	require.Equal([]string{"chain"}, registrant.chainNames)
+
+	// Registrants that want the chain's parameters are only notified once.
+	require.Equal([]string{"chain"}, parametersRegistrant.chainNames)
+	require.Equal([]ChainParameters{params}, parametersRegistrant.params)
+}
+
+func TestTrackSubnet(t *testing.T) {
+	require := require.New(t)
+
+	subnets, err := NewSubnets(ids.EmptyNodeID, map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	})
+	require.NoError(err)
+
+	var (
+		m = &manager{
+			ManagerConfig: ManagerConfig{
+				Log:     logging.NoLog{},
+				Subnets: subnets,
+			},
+			chainsQueue:      buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
+			registeredChains: make(map[ids.ID][]ChainParameters),
+		}
+		subnetID      = ids.GenerateTestID()
+		registered    = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		retired       = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		otherSubnet   = ChainParameters{ID: ids.GenerateTestID(), SubnetID: ids.GenerateTestID()}
+		createdLater  = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		requireQueued = func(expected ...ChainParameters) {
+			require.Equal(len(expected), m.chainsQueue.Len())
+			for _, chainParams := range expected {
+				queued, ok := m.chainsQueue.PopLeft()
+				require.True(ok)
+				require.Equal(chainParams, queued)
+			}
+		}
+	)
+
+	// Registered chains aren't created until their subnet is tracked.
+	m.RegisterChain(registered)
+	m.RegisterChain(retired)
+	m.RegisterChain(otherSubnet)
+	requireQueued()
+
+	// Retired chains aren't created when their subnet is tracked.
+	m.RetireChain(subnetID, retired.ID)
+
+	require.Equal([]ids.ID{registered.ID}, m.TrackSubnet(subnetID))
+	requireQueued(registered)
+
+	// Chains registered after their subnet is tracked are created immediately.
+	m.RegisterChain(createdLater)
+	requireQueued(createdLater)
+
+	require.Equal(
+		map[ids.ID][]ChainParameters{
+			otherSubnet.SubnetID: {otherSubnet},
+		},
+		m.registeredChains,
+	)
+}
+
+type testSubnetTracker struct {
+	tracked   []ids.ID
+	untracked []ids.ID
+}
+
+func (t *testSubnetTracker) TrackSubnet(subnetID ids.ID) {
+	t.tracked = append(t.tracked, subnetID)
+}
+
+func (t *testSubnetTracker) UntrackSubnet(subnetID ids.ID) {
+	t.untracked = append(t.untracked, subnetID)
+}
+
+func TestUntrackSubnet(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	subnets, err := NewSubnets(ids.EmptyNodeID, map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	})
+	require.NoError(err)
+
+	var (
+		db           = memdb.New()
+		chainDataDir = t.TempDir()
+		subnetID     = ids.GenerateTestID()
+		running      = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		registered   = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		otherSubnet  = ChainParameters{ID: ids.GenerateTestID(), SubnetID: ids.GenerateTestID()}
+		createdLater = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		h            = handlermock.NewHandler(ctrl)
+		otherHandler = handlermock.NewHandler(ctrl)
+		tracker      = &testSubnetTracker{}
+		runningDBs   = newTestChainDBs(db, running.ID)
+		m            = &manager{
+			ManagerConfig: ManagerConfig{
+				Log:          logging.NoLog{},
+				DB:           db,
+				ChainDataDir: chainDataDir,
+				Subnets:      subnets,
+			},
+			chainsQueue:        buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
+			bootstrapScheduler: newBootstrapScheduler(0),
+			registeredChains: map[ids.ID][]ChainParameters{
+				subnetID: {registered},
+			},
+			chains: map[ids.ID]handler.Handler{
+				running.ID:     h,
+				otherSubnet.ID: otherHandler,
+			},
+			chainParams: map[ids.ID]ChainParameters{
+				running.ID:     running,
+				otherSubnet.ID: otherSubnet,
+			},
+			chainDBTrackers: map[ids.ID]*prefixdb.Tracker{
+				running.ID: runningDBs.tracker,
+			},
+			untrackedDBTrackers: make(map[ids.ID]*prefixdb.Tracker),
+			prunedChains:        make(map[ids.ID]chan struct{}),
+		}
+		// The registered chain hasn't run since the node started, so only the
+		// databases that the manager creates for it can be located.
+		registeredDBs  = newTestChainDBs(db, registered.ID)
+		otherSubnetDBs = newTestChainDBs(db, otherSubnet.ID)
+	)
+	m.AddSubnetTracker(tracker)
+	runningDBs.put(t, true)
+	registeredDBs.put(t, false)
+	otherSubnetDBs.put(t, true)
+	for _, chainID := range []ids.ID{running.ID, registered.ID, otherSubnet.ID} {
+		require.NoError(os.Mkdir(filepath.Join(chainDataDir, chainID.String()), perms.ReadWriteExecute))
+	}
+
+	// Only the running chains of the subnet are stopped.
+	h.EXPECT().Stop(gomock.Any())
+	h.EXPECT().AwaitStopped(gomock.Any()).Return(time.Duration(0), nil)
+	require.Equal([]ids.ID{running.ID}, m.UntrackSubnet(subnetID, true))
+	require.Equal([]ids.ID{subnetID}, tracker.untracked)
+	require.NotContains(m.chains, running.ID)
+	require.Contains(m.chains, otherSubnet.ID)
+	require.True(m.untrackedChains.Contains(running.ID))
+	require.ElementsMatch(
+		[]ChainParameters{registered, running},
+		m.registeredChains[subnetID],
+	)
+
+	// The data of the subnet's chains is pruned once they have stopped.
+	require.Eventually(func() bool {
+		m.chainsLock.Lock()
+		defer m.chainsLock.Unlock()
+
+		return len(m.prunedChains) == 0
+	}, time.Second, time.Millisecond)
+	require.True(runningDBs.isPruned(t))
+	require.True(registeredDBs.isPruned(t))
+	require.False(otherSubnetDBs.isPruned(t))
+	for _, chainID := range []ids.ID{running.ID, registered.ID} {
+		require.NoDirExists(filepath.Join(chainDataDir, chainID.String()))
+	}
+	require.DirExists(filepath.Join(chainDataDir, otherSubnet.ID.String()))
+
+	// Chains registered while the subnet isn't tracked aren't created.
+	m.RegisterChain(createdLater)
+	require.Zero(m.chainsQueue.Len())
+
+	// Tracking the subnet again creates its chains again.
+	require.ElementsMatch(
+		[]ids.ID{registered.ID, running.ID, createdLater.ID},
+		m.TrackSubnet(subnetID),
+	)
+	require.Equal(3, m.chainsQueue.Len())
+	require.Equal([]ids.ID{subnetID}, tracker.tracked)
+}
+
+func TestRetireChainPrunesData(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	subnets, err := NewSubnets(ids.EmptyNodeID, map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	})
+	require.NoError(err)
+
+	var (
+		db           = memdb.New()
+		chainDataDir = t.TempDir()
+		subnetID     = ids.GenerateTestID()
+		running      = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		registered   = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID}
+		h            = handlermock.NewHandler(ctrl)
+		runningDBs   = newTestChainDBs(db, running.ID)
+		m            = &manager{
+			Aliaser: ids.NewAliaser(),
+			ManagerConfig: ManagerConfig{
+				Log:                logging.NoLog{},
+				DB:                 db,
+				ChainDataDir:       chainDataDir,
+				Subnets:            subnets,
+				PruneRetiredChains: true,
+			},
+			bootstrapScheduler: newBootstrapScheduler(0),
+			registeredChains: map[ids.ID][]ChainParameters{
+				subnetID: {registered},
+			},
+			chains: map[ids.ID]handler.Handler{
+				running.ID: h,
+			},
+			chainDBTrackers: map[ids.ID]*prefixdb.Tracker{
+				running.ID: runningDBs.tracker,
+			},
+			chainParams: map[ids.ID]ChainParameters{
+				running.ID: running,
+			},
+		}
+		registeredDBs = newTestChainDBs(db, registered.ID)
+	)
+	runningDBs.put(t, true)
+	registeredDBs.put(t, false)
+
+	h.EXPECT().Stop(gomock.Any())
+	h.EXPECT().AwaitStopped(gomock.Any()).Return(time.Duration(0), nil)
+	m.RetireChain(subnetID, running.ID)
+	m.RetireChain(subnetID, registered.ID)
+	require.NotContains(m.chainDBTrackers, running.ID)
+
+	require.Eventually(func() bool {
+		return runningDBs.isPruned(t) && registeredDBs.isPruned(t)
+	}, time.Second, time.Millisecond)
+}
+
+// testChainDBs are databases created for a chain in the same way as when the
+// chain is created.
+type testChainDBs struct {
+	tracker *prefixdb.Tracker
+	// Databases that the manager creates for the chain
+	managerDBs []database.Database
+	// Database that a VM, which doesn't run as a plugin, creates from the VM
+	// database
+	vmStateDB database.Database
+}
+
+func newTestChainDBs(db database.Database, chainID ids.ID) *testChainDBs {
+	tracker := prefixdb.NewTracker()
+	prefixDB := prefixdb.NewTracked(chainID[:], db, tracker)
+	vmDB := prefixdb.New(VMDBPrefix, prefixDB)
+	return &testChainDBs{
+		tracker: tracker,
+		managerDBs: []database.Database{
+			prefixDB,
+			vmDB,
+			prefixdb.New(ChainBootstrappingDBPrefix, prefixDB),
+			prefixdb.New(proposervm.DBPrefix, vmDB),
+		},
+		vmStateDB: prefixdb.New([]byte("state"), vmDB),
+	}
+}
+
+func (dbs *testChainDBs) all(includeVMState bool) []database.Database {
+	if includeVMState {
+		return append(slices.Clone(dbs.managerDBs), dbs.vmStateDB)
+	}
+	return dbs.managerDBs
+}
+
+func (dbs *testChainDBs) put(t *testing.T, includeVMState bool) {
+	for _, db := range dbs.all(includeVMState) {
+		require.NoError(t, db.Put([]byte("key"), []byte("value")))
+	}
+}
+
+func (dbs *testChainDBs) isPruned(t *testing.T) bool {
+	for _, db := range dbs.all(true) {
+		has, err := db.Has([]byte("key"))
+		require.NoError(t, err)
+		if has {
+			return false
+		}
+	}
+	return true
+}
@@ -15,3 +15,17 @@ type Registrant interface {
 	// [vm] should be a vertex.DAGVM or block.ChainVM
 	RegisterChain(chainName string, ctx *snow.ConsensusContext, vm common.VM)
 }
+
+// ParametersRegistrant can register the existence of a chain along with the
+// parameters it was created with, such as its VM ID and genesis.
+//
+// If a Registrant implements ParametersRegistrant, RegisterChainWithParameters
+// is called instead of RegisterChain.
+type ParametersRegistrant interface {
+	Registrant
+
+	// Called when a chain is created
+	// This function is called before the chain starts processing messages
+	// [vm] should be a vertex.DAGVM or block.ChainVM
+	RegisterChainWithParameters(chainName string, params ChainParameters, ctx *snow.ConsensusContext, vm common.VM)
+}
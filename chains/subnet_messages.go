@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
+	"github.com/ava-labs/avalanchego/snow/networking/sender"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const (
+	ioLabel = "io"
+	opLabel = "op"
+
+	sentLabel     = "sent"
+	receivedLabel = "received"
+)
+
+var (
+	_ sender.ResultSender = (*subnetMessagesSender)(nil)
+	_ handler.Handler     = (*subnetMessagesHandler)(nil)
+
+	ioSubnetOpLabels = []string{ioLabel, SubnetLabel, opLabel}
+)
+
+// MessageUsage is the number of messages, and their bytes, sent and received
+// by this node.
+type MessageUsage struct {
+	SentMessages     uint64
+	SentBytes        uint64
+	ReceivedMessages uint64
+	ReceivedBytes    uint64
+}
+
+// TotalBytes returns the number of bytes sent and received.
+func (u MessageUsage) TotalBytes() uint64 {
+	return u.SentBytes + u.ReceivedBytes
+}
+
+// SubnetMessageUsage is the network usage of the chains of a subnet since the
+// node started.
+type SubnetMessageUsage struct {
+	SubnetID ids.ID
+	MessageUsage
+	// Ops is the usage of each message op.
+	Ops map[message.Op]MessageUsage
+}
+
+// subnetMessageMetrics attributes the messages sent and received by the chains
+// of this node to their subnet.
+type subnetMessageMetrics struct {
+	messages *prometheus.CounterVec // io + subnet + op
+	bytes    *prometheus.CounterVec // io + subnet + op
+
+	lock  sync.Mutex
+	usage map[ids.ID]*SubnetMessageUsage
+}
+
+func newSubnetMessageMetrics(reg prometheus.Registerer) (*subnetMessageMetrics, error) {
+	m := &subnetMessageMetrics{
+		messages: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "msgs",
+				Help: "number of messages sent and received by the chains of each subnet",
+			},
+			ioSubnetOpLabels,
+		),
+		bytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "msgs_bytes",
+				Help: "number of message bytes sent and received by the chains of each subnet",
+			},
+			ioSubnetOpLabels,
+		),
+		usage: make(map[ids.ID]*SubnetMessageUsage),
+	}
+	return m, errors.Join(
+		reg.Register(m.messages),
+		reg.Register(m.bytes),
+	)
+}
+
+// Sent records that a message of [numBytes] was sent to [numPeers] peers.
+func (m *subnetMessageMetrics) Sent(subnetID ids.ID, op message.Op, numBytes int, numPeers int) {
+	if numPeers == 0 {
+		return
+	}
+
+	var (
+		numMsgs  = uint64(numPeers)
+		totalLen = uint64(numBytes) * numMsgs
+		labels   = prometheus.Labels{
+			ioLabel:     sentLabel,
+			SubnetLabel: subnetID.String(),
+			opLabel:     op.String(),
+		}
+	)
+	m.messages.With(labels).Add(float64(numMsgs))
+	m.bytes.With(labels).Add(float64(totalLen))
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	usage, opUsage := m.getUsage(subnetID, op)
+	usage.SentMessages += numMsgs
+	usage.SentBytes += totalLen
+	opUsage.SentMessages += numMsgs
+	opUsage.SentBytes += totalLen
+	usage.Ops[op] = opUsage
+}
+
+// Received records that a message of [numBytes] was received.
+func (m *subnetMessageMetrics) Received(subnetID ids.ID, op message.Op, numBytes int) {
+	labels := prometheus.Labels{
+		ioLabel:     receivedLabel,
+		SubnetLabel: subnetID.String(),
+		opLabel:     op.String(),
+	}
+	m.messages.With(labels).Inc()
+	m.bytes.With(labels).Add(float64(numBytes))
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	usage, opUsage := m.getUsage(subnetID, op)
+	usage.ReceivedMessages++
+	usage.ReceivedBytes += uint64(numBytes)
+	opUsage.ReceivedMessages++
+	opUsage.ReceivedBytes += uint64(numBytes)
+	usage.Ops[op] = opUsage
+}
+
+// Assumes [m.lock] is held.
+func (m *subnetMessageMetrics) getUsage(subnetID ids.ID, op message.Op) (*SubnetMessageUsage, MessageUsage) {
+	usage, ok := m.usage[subnetID]
+	if !ok {
+		usage = &SubnetMessageUsage{
+			SubnetID: subnetID,
+			Ops:      make(map[message.Op]MessageUsage),
+		}
+		m.usage[subnetID] = usage
+	}
+	return usage, usage.Ops[op]
+}
+
+// Top returns the usage of the [limit] subnets that sent and received the most
+// bytes, sorted by decreasing number of bytes. If [limit] is 0, the usage of
+// all subnets is returned.
+func (m *subnetMessageMetrics) Top(limit int) []SubnetMessageUsage {
+	m.lock.Lock()
+	usages := make([]SubnetMessageUsage, 0, len(m.usage))
+	for _, usage := range m.usage {
+		ops := make(map[message.Op]MessageUsage, len(usage.Ops))
+		for op, opUsage := range usage.Ops {
+			ops[op] = opUsage
+		}
+		usages = append(usages, SubnetMessageUsage{
+			SubnetID:     usage.SubnetID,
+			MessageUsage: usage.MessageUsage,
+			Ops:          ops,
+		})
+	}
+	m.lock.Unlock()
+
+	slices.SortFunc(usages, func(a, b SubnetMessageUsage) int {
+		aBytes, bBytes := a.TotalBytes(), b.TotalBytes()
+		switch {
+		case aBytes > bBytes:
+			return -1
+		case aBytes < bBytes:
+			return 1
+		default:
+			return a.SubnetID.Compare(b.SubnetID)
+		}
+	})
+	if limit > 0 && len(usages) > limit {
+		usages = usages[:limit]
+	}
+	return usages
+}
+
+// subnetMessagesSender records the messages sent over the network by the
+// chains of a subnet.
+type subnetMessagesSender struct {
+	sender  sender.ExternalSender
+	metrics *subnetMessageMetrics
+}
+
+func (s *subnetMessagesSender) Send(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	subnetID ids.ID,
+	allower subnets.Allower,
+) set.Set[ids.NodeID] {
+	sentTo := s.sender.Send(msg, config, subnetID, allower)
+	s.metrics.Sent(subnetID, msg.Op(), len(msg.Bytes()), sentTo.Len())
+	return sentTo
+}
+
+func (s *subnetMessagesSender) SendWithResult(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	subnetID ids.ID,
+	allower subnets.Allower,
+) sender.SendResult {
+	resultSender, ok := s.sender.(sender.ResultSender)
+	if !ok {
+		return sender.SendResult{
+			SentTo: s.Send(msg, config, subnetID, allower),
+		}
+	}
+
+	result := resultSender.SendWithResult(msg, config, subnetID, allower)
+	s.metrics.Sent(subnetID, msg.Op(), len(msg.Bytes()), result.SentTo.Len())
+	return result
+}
+
+// subnetMessagesHandler records the messages received over the network by a
+// chain.
+type subnetMessagesHandler struct {
+	handler.Handler
+	subnetID ids.ID
+	metrics  *subnetMessageMetrics
+}
+
+func (h *subnetMessagesHandler) Push(ctx context.Context, msg handler.Message) {
+	// Messages that weren't received over the network, such as messages sent
+	// to ourself or request failures, don't have any bytes.
+	if numBytes := msg.NumBytes(); numBytes > 0 {
+		h.metrics.Received(h.subnetID, msg.Op(), numBytes)
+	}
+	h.Handler.Push(ctx, msg)
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// sendFunc is an ExternalSender that sends messages by calling itself.
+type sendFunc func(msg message.OutboundMessage, config common.SendConfig) set.Set[ids.NodeID]
+
+func (f sendFunc) Send(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	_ ids.ID,
+	_ subnets.Allower,
+) set.Set[ids.NodeID] {
+	return f(msg, config)
+}
+
+func TestSubnetMessageMetricsTop(t *testing.T) {
+	require := require.New(t)
+
+	m, err := newSubnetMessageMetrics(prometheus.NewRegistry())
+	require.NoError(err)
+
+	var (
+		subnetID0 = ids.GenerateTestID()
+		subnetID1 = ids.GenerateTestID()
+		subnetID2 = ids.GenerateTestID()
+	)
+	m.Sent(subnetID0, message.AppGossipOp, 10, 2)
+	m.Received(subnetID0, message.AppGossipOp, 5)
+	m.Received(subnetID0, message.AppRequestOp, 5)
+	m.Sent(subnetID1, message.PushQueryOp, 100, 1)
+	m.Sent(subnetID2, message.AppGossipOp, 50, 0) // Not sent to any peer
+
+	expected := []SubnetMessageUsage{
+		{
+			SubnetID: subnetID1,
+			MessageUsage: MessageUsage{
+				SentMessages: 1,
+				SentBytes:    100,
+			},
+			Ops: map[message.Op]MessageUsage{
+				message.PushQueryOp: {
+					SentMessages: 1,
+					SentBytes:    100,
+				},
+			},
+		},
+		{
+			SubnetID: subnetID0,
+			MessageUsage: MessageUsage{
+				SentMessages:     2,
+				SentBytes:        20,
+				ReceivedMessages: 2,
+				ReceivedBytes:    10,
+			},
+			Ops: map[message.Op]MessageUsage{
+				message.AppGossipOp: {
+					SentMessages:     2,
+					SentBytes:        20,
+					ReceivedMessages: 1,
+					ReceivedBytes:    5,
+				},
+				message.AppRequestOp: {
+					ReceivedMessages: 1,
+					ReceivedBytes:    5,
+				},
+			},
+		},
+	}
+	require.Equal(expected, m.Top(0))
+	require.Equal(expected[:1], m.Top(1))
+	require.Equal(expected, m.Top(3))
+
+	sentLabels := prometheus.Labels{
+		ioLabel:     sentLabel,
+		SubnetLabel: subnetID0.String(),
+		opLabel:     message.AppGossipOp.String(),
+	}
+	require.InDelta(2, testutil.ToFloat64(m.messages.With(sentLabels)), 0)
+	require.InDelta(20, testutil.ToFloat64(m.bytes.With(sentLabels)), 0)
+}
+
+func TestSubnetMessagesSender(t *testing.T) {
+	require := require.New(t)
+
+	mc, err := message.NewCreator(
+		logging.NoLog{},
+		prometheus.NewRegistry(),
+		constants.DefaultNetworkCompressionType,
+		10*time.Second,
+	)
+	require.NoError(err)
+
+	gossip, err := mc.AppGossip(ids.GenerateTestID(), []byte("container"))
+	require.NoError(err)
+
+	m, err := newSubnetMessageMetrics(prometheus.NewRegistry())
+	require.NoError(err)
+
+	var (
+		subnetID = ids.GenerateTestID()
+		nodeID0  = ids.GenerateTestNodeID()
+		nodeID1  = ids.GenerateTestNodeID()
+		s        = &subnetMessagesSender{
+			// Only sends the message to [nodeID0].
+			sender: sendFunc(func(message.OutboundMessage, common.SendConfig) set.Set[ids.NodeID] {
+				return set.Of(nodeID0)
+			}),
+			metrics: m,
+		}
+	)
+	result := s.SendWithResult(
+		gossip,
+		common.SendConfig{
+			NodeIDs: set.Of(nodeID0, nodeID1),
+		},
+		subnetID,
+		subnets.NoOpAllower,
+	)
+	require.Equal(set.Of(nodeID0), result.SentTo)
+
+	numBytes := uint64(len(gossip.Bytes()))
+	require.Equal(
+		[]SubnetMessageUsage{
+			{
+				SubnetID: subnetID,
+				MessageUsage: MessageUsage{
+					SentMessages: 1,
+					SentBytes:    numBytes,
+				},
+				Ops: map[message.Op]MessageUsage{
+					message.AppGossipOp: {
+						SentMessages: 1,
+						SentBytes:    numBytes,
+					},
+				},
+			},
+		},
+		m.Top(0),
+	)
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import "github.com/ava-labs/avalanchego/ids"
+
+// SubnetTracker is notified when the node starts or stops tracking a subnet
+// while it is running
+type SubnetTracker interface {
+	// Called when the node starts tracking the subnet, before the subnet's
+	// chains are created
+	TrackSubnet(subnetID ids.ID)
+
+	// Called when the node stops tracking the subnet, after the subnet's
+	// chains were stopped
+	UntrackSubnet(subnetID ids.ID)
+}
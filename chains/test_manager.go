@@ -3,7 +3,13 @@
 
 package chains
 
-import "github.com/ava-labs/avalanchego/ids"
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
+)
 
 // TestManager implements Manager but does nothing. Always returns nil error.
 // To be used only in tests
@@ -13,6 +19,18 @@ type testManager struct{}
 
 func (testManager) QueueChainCreation(ChainParameters) {}
 
+func (testManager) RegisterChain(ChainParameters) {}
+
+func (testManager) TrackSubnet(ids.ID) []ids.ID {
+	return nil
+}
+
+func (testManager) UntrackSubnet(ids.ID, bool) []ids.ID {
+	return nil
+}
+
+func (testManager) AddSubnetTracker(SubnetTracker) {}
+
 func (testManager) AddRegistrant(Registrant) {}
 
 func (testManager) Aliases(ids.ID) ([]string, error) {
@@ -33,6 +51,8 @@ func (testManager) Alias(ids.ID, string) error {
 
 func (testManager) RemoveAliases(ids.ID) {}
 
+func (testManager) RetireChain(ids.ID, ids.ID) {}
+
 func (testManager) Shutdown() {}
 
 func (testManager) StartChainCreator(ChainParameters) error {
@@ -43,6 +63,34 @@ func (testManager) IsBootstrapped(ids.ID) bool {
 	return false
 }
 
+func (testManager) GetChainConfig(ids.ID) (ChainConfig, error) {
+	return ChainConfig{}, nil
+}
+
+func (testManager) UpdateChainConfig(context.Context, ids.ID, []byte) error {
+	return nil
+}
+
+func (testManager) GetChainDiskUsage(ids.ID) (DiskUsage, error) {
+	return DiskUsage{}, nil
+}
+
+func (testManager) GetChainAcceptedLatency(ids.ID) (AcceptedLatency, error) {
+	return AcceptedLatency{}, nil
+}
+
+func (testManager) GetChainConsensusParameters(ids.ID) (snowball.Parameters, error) {
+	return snowball.Parameters{}, nil
+}
+
+func (testManager) GetChainTxLimits(ids.ID) (limits.Limits, error) {
+	return limits.Limits{}, nil
+}
+
+func (testManager) GetSubnetMessageUsage(int) []SubnetMessageUsage {
+	return nil
+}
+
 func (testManager) Lookup(s string) (ids.ID, error) {
 	return ids.FromString(s)
 }
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
+)
+
+// exitReporter is implemented by VMs that run in a separate process, which may
+// exit without the VM being shut down.
+type exitReporter interface {
+	// Exited returns a channel that is closed once the VM's process has
+	// exited.
+	Exited() <-chan struct{}
+	// ExitErr returns why the VM's process exited without being shut down, or
+	// nil if it was shut down.
+	ExitErr() error
+}
+
+type chainRestart struct {
+	// Number of times the chain was restarted
+	restarts int
+	// Why the chain's VM last exited, or failed to be created again. Nil once
+	// the chain is running again.
+	err error
+}
+
+// superviseChain restarts the chain if its VM process exits without being
+// shut down.
+func (m *manager) superviseChain(chainParams ChainParameters, h handler.Handler, vm exitReporter) {
+	<-vm.Exited()
+	if err := vm.ExitErr(); err != nil {
+		m.restartChain(chainParams, h, err)
+	}
+}
+
+// restartChain stops the chain, if [h] is not nil, and queues the chain to be
+// created again once its restart backoff has elapsed. The chain isn't
+// restarted more than [ManagerConfig.VMMaxRestarts] times.
+func (m *manager) restartChain(chainParams ChainParameters, h handler.Handler, cause error) {
+	m.chainsLock.Lock()
+	if m.retiredChains.Contains(chainParams.ID) {
+		m.chainsLock.Unlock()
+		return
+	}
+	delete(m.chains, chainParams.ID)
+	delete(m.chainVMs, chainParams.ID)
+	delete(m.chainDBTrackers, chainParams.ID)
+	delete(m.chainConsensusParams, chainParams.ID)
+	delete(m.chainParams, chainParams.ID)
+	restart, ok := m.chainRestarts[chainParams.ID]
+	if !ok {
+		restart = &chainRestart{}
+		m.chainRestarts[chainParams.ID] = restart
+	}
+	restart.err = cause
+	restarts := restart.restarts
+	shouldRestart := restarts < m.VMMaxRestarts
+	if shouldRestart {
+		restart.restarts++
+	}
+	m.chainsLock.Unlock()
+
+	m.Log.Warn("chain's VM exited",
+		zap.Stringer("subnetID", chainParams.SubnetID),
+		zap.Stringer("chainID", chainParams.ID),
+		zap.Stringer("vmID", chainParams.VMID),
+		zap.Int("restarts", restarts),
+		zap.Error(cause),
+	)
+
+	if h != nil {
+		// The router removes the chain once its handler has stopped.
+		h.Stop(context.TODO())
+		m.bootstrapScheduler.Remove(chainParams.ID)
+	}
+	sb, _ := m.Subnets.GetOrCreate(chainParams.SubnetID)
+	sb.RemoveChain(chainParams.ID)
+
+	if !shouldRestart {
+		m.Log.Error("not restarting chain",
+			zap.String("reason", "max restarts reached"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.Stringer("vmID", chainParams.VMID),
+			zap.Int("maxRestarts", m.VMMaxRestarts),
+		)
+		return
+	}
+
+	if h != nil {
+		if _, err := h.AwaitStopped(context.TODO()); err != nil {
+			m.Log.Error("failed to wait for chain to stop",
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
+	backoff := restartBackoff(m.VMRestartInitialBackoff, m.VMRestartMaxBackoff, restarts)
+	m.Log.Info("restarting chain",
+		zap.Stringer("subnetID", chainParams.SubnetID),
+		zap.Stringer("chainID", chainParams.ID),
+		zap.Stringer("vmID", chainParams.VMID),
+		zap.Duration("backoff", backoff),
+	)
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		m.QueueChainCreation(chainParams)
+	case <-m.chainCreatorShutdownCh:
+	}
+}
+
+// restartBackoff returns the time to wait before restarting a chain that was
+// already restarted [restarts] times.
+func restartBackoff(initial time.Duration, maximum time.Duration, restarts int) time.Duration {
+	backoff := initial
+	for i := 0; i < restarts && backoff < maximum; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maximum)
+}
+
+// deregisterChainMetrics removes the metrics registered when the chain was
+// previously built.
+func (m *manager) deregisterChainMetrics(chainParams ChainParameters) {
+	primaryAlias := m.PrimaryAliasOrDefault(chainParams.ID)
+	m.MeterDBMetrics.Deregister(primaryAlias)
+	m.avalancheGatherer.Deregister(primaryAlias)
+	m.meterDAGVMGatherer.Deregister(primaryAlias)
+	m.meterChainVMGatherer.Deregister(primaryAlias)
+	m.proposervmGatherer.Deregister(primaryAlias)
+	m.stakeGatherer.Deregister(primaryAlias)
+	m.p2pGatherer.Deregister(primaryAlias)
+	m.handlerGatherer.Deregister(primaryAlias)
+	m.snowmanGatherer.Deregister(primaryAlias)
+	if vmGatherer, ok := m.vmGatherer[chainParams.VMID]; ok {
+		vmGatherer.Deregister(primaryAlias)
+	}
+}
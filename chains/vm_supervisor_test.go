@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestartBackoff(t *testing.T) {
+	tests := []struct {
+		restarts        int
+		expectedBackoff time.Duration
+	}{
+		{restarts: 0, expectedBackoff: time.Second},
+		{restarts: 1, expectedBackoff: 2 * time.Second},
+		{restarts: 3, expectedBackoff: 8 * time.Second},
+		{restarts: 5, expectedBackoff: 30 * time.Second},
+		{restarts: 1000, expectedBackoff: 30 * time.Second},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expectedBackoff, restartBackoff(time.Second, 30*time.Second, test.restarts), "restarts: %d", test.restarts)
+	}
+}
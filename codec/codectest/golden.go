@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package codectest
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
+)
+
+const (
+	// UpdateGoldensEnvVar is the environment variable that, when set to a
+	// non-empty value, causes golden files to be overwritten with the current
+	// serialization instead of being compared against it.
+	UpdateGoldensEnvVar = "UPDATE_GOLDENS"
+
+	// Number of bytes populated for byte slices. Other slices are populated
+	// with a single element.
+	goldenBytesLen = 4
+
+	goldenFilePerms = 0o644
+	goldenDirPerms  = 0o755
+)
+
+// Populator deterministically populates every serialized field of a value so
+// that its serialization can be compared against a golden file.
+//
+// Populating the same type twice results in identical values, and the values
+// only change if the serialized fields of the type change.
+type Populator struct {
+	// Struct tags of the fields to populate
+	tagNames []string
+	// Interface type -> implementation used to populate fields of that type
+	implementations map[reflect.Type]reflect.Type
+	counter         uint64
+}
+
+// NewPopulator returns a populator that fills interface fields using
+// [implementations], which maps a nil pointer to an interface to a value of
+// the implementation to use. For example:
+//
+//	(*fx.Owner)(nil): &secp256k1fx.OutputOwners{}
+func NewPopulator(implementations map[interface{}]interface{}) *Populator {
+	return NewPopulatorWithTags([]string{reflectcodec.DefaultTagName}, implementations)
+}
+
+// NewPopulatorWithTags returns a populator that only populates fields that
+// are serialized by a codec with [tagNames]. This allows populating exactly
+// the fields serialized by each version of a codec.
+func NewPopulatorWithTags(tagNames []string, implementations map[interface{}]interface{}) *Populator {
+	p := &Populator{
+		tagNames:        tagNames,
+		implementations: make(map[reflect.Type]reflect.Type, len(implementations)),
+	}
+	for iface, impl := range implementations {
+		p.implementations[reflect.TypeOf(iface).Elem()] = reflect.TypeOf(impl)
+	}
+	return p
+}
+
+// Populate fills the value pointed to by [ptr].
+func (p *Populator) Populate(ptr interface{}) error {
+	p.counter = 0
+	return p.populate(reflect.ValueOf(ptr).Elem())
+}
+
+func (p *Populator) populate(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(p.next()%2 == 1)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(p.next())
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(p.next()))
+	case reflect.String:
+		v.SetString(fmt.Sprintf("golden-%d", p.next()))
+	case reflect.Slice:
+		length := 1
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			length = goldenBytesLen
+		}
+		v.Set(reflect.MakeSlice(v.Type(), length, length))
+		for i := 0; i < length; i++ {
+			if err := p.populate(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := p.populate(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		return p.populate(v.Elem())
+	case reflect.Interface:
+		impl, ok := p.implementations[v.Type()]
+		if !ok {
+			return fmt.Errorf("no implementation provided for %s", v.Type())
+		}
+		implValue := reflect.New(impl).Elem()
+		if err := p.populate(implValue); err != nil {
+			return err
+		}
+		v.Set(implValue)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || !p.serialized(field) {
+				continue
+			}
+			if err := p.populate(v.Field(i)); err != nil {
+				return fmt.Errorf("%s.%s: %w", t, field.Name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+	return nil
+}
+
+func (p *Populator) serialized(field reflect.StructField) bool {
+	for _, tagName := range p.tagNames {
+		if field.Tag.Get(tagName) == reflectcodec.TagValue {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Populator) next() uint64 {
+	p.counter++
+	// Keep values small enough to fit in every supported integer width.
+	return p.counter % 128
+}
+
+// RequireGolden requires that [actual] matches the hex-encoded contents of the
+// golden file at [path]. If [UpdateGoldensEnvVar] is set, the golden file is
+// overwritten with [actual] instead.
+func RequireGolden(t testing.TB, path string, actual []byte) {
+	require := require.New(t)
+
+	encoded := hex.EncodeToString(actual) + "\n"
+	if os.Getenv(UpdateGoldensEnvVar) != "" {
+		require.NoError(os.MkdirAll(filepath.Dir(path), goldenDirPerms))
+		require.NoError(os.WriteFile(path, []byte(encoded), goldenFilePerms))
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(err, "missing golden file, run with %s=1 to create it", UpdateGoldensEnvVar)
+	require.Equal(
+		strings.TrimSpace(string(expected)),
+		strings.TrimSpace(encoded),
+		"serialization changed, run with %s=1 if this is intentional",
+		UpdateGoldensEnvVar,
+	)
+}
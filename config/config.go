@@ -4,6 +4,8 @@
 package config
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
@@ -11,18 +13,24 @@ import (
 	"fmt"
 	"io/fs"
 	"math"
+	"net"
+	"net/netip"
+	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
+	"golang.org/x/term"
 
 	"github.com/ava-labs/avalanchego/api/server"
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/config/node"
 	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/dialer"
 	"github.com/ava-labs/avalanchego/network/throttling"
@@ -30,14 +38,19 @@ import (
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/staking/keyfile"
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/dynamicip"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/perms"
@@ -46,16 +59,22 @@ import (
 	"github.com/ava-labs/avalanchego/utils/storage"
 	"github.com/ava-labs/avalanchego/utils/timer"
 	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/version/advisory"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
 	"github.com/ava-labs/avalanchego/vms/proposervm"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime/subprocess"
 )
 
 const (
-	chainConfigFileName  = "config"
-	chainUpgradeFileName = "upgrade"
-	subnetConfigFileExt  = ".json"
+	chainConfigFileName    = "config"
+	chainUpgradeFileName   = "upgrade"
+	chainConsensusFileName = "consensus"
+	subnetConfigFileExt    = ".json"
+
+	// Max time the staking key data key command may run for
+	stakingKeyDataKeyCommandTimeout = time.Minute
 )
 
 var (
@@ -79,11 +98,31 @@ var (
 	errStakingKeyContentUnset                 = fmt.Errorf("%s key not set but %s set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errStakingCertContentUnset                = fmt.Errorf("%s key set but %s not set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errMissingStakingSigningKeyFile           = errors.New("missing staking signing key file")
+	errMultipleStakingKeySecrets              = fmt.Errorf("only one of %s, %s, and %s can be set", StakingKeyEncryptionPassphraseFileKey, StakingKeyEncryptionPassphrasePromptKey, StakingKeyEncryptionDataKeyCommandKey)
+	errStakingKeyEncrypted                    = errors.New("staking key is encrypted but no secret to decrypt it is configured")
+	errNoTerminal                             = errors.New("stdin isn't a terminal")
+	errEmptyDataKeyCommand                    = fmt.Errorf("%s is empty", StakingKeyEncryptionDataKeyCommandKey)
+	errInvalidAuditLogMaxSize                 = fmt.Errorf("%s must be > 0", AdminAPIAuditLogMaxSizeKey)
+	errInvalidAuditLogMaxFiles                = fmt.Errorf("%s must be >= 0", AdminAPIAuditLogMaxFilesKey)
 	errTracingEndpointEmpty                   = fmt.Errorf("%s cannot be empty", TracingEndpointKey)
 	errPluginDirNotADirectory                 = errors.New("plugin dir is not a directory")
 	errCannotReadDirectory                    = errors.New("cannot read directory")
 	errUnmarshalling                          = errors.New("unmarshalling failed")
 	errFileDoesNotExist                       = errors.New("file does not exist")
+	errInvalidPinnedPeer                      = errors.New("pinned peer must be formatted as nodeID@ip:port")
+	errInvalidSTUNServer                      = errors.New("STUN server must be formatted as host:port")
+	errInvalidNTPServer                       = errors.New("NTP server must be formatted as host:port")
+	errNoUpdateAdvisoryPublishers             = fmt.Errorf("%s must be non-empty to use the update advisory", UpdateAdvisoryTrustedPublishersKey)
+	errNoSTUNServers                          = fmt.Errorf("%s must be non-empty to use the %q resolution service", PublicIPResolutionSTUNServersKey, dynamicip.STUNName)
+	errSameAddressFamily                      = errors.New("public IPs must be of different address families")
+	errNotDualStack                           = errors.New("staking host must be unspecified to listen on both IPv4 and IPv6")
+	errNoReadReplicaURIs                      = fmt.Errorf("%s must be non-empty to follow read replica chains", ReadReplicaURIsKey)
+	errInvalidReadReplicaURI                  = errors.New("read replica URI must be an absolute http or https URL")
+	errIndexExportWithoutIndex                = fmt.Errorf("%s must be true to export the index", IndexEnabledKey)
+	errInvalidIndexExportSink                 = fmt.Errorf("%s must be one of {%q, %q}", IndexExportSinkKey, indexer.SinkWebhook, indexer.SinkKafka)
+	errInvalidIndexExportURL                  = fmt.Errorf("%s must be an absolute http or https URL", IndexExportURLKey)
+	errNoIndexExportKafkaTopic                = fmt.Errorf("%s must be non-empty to export the index to kafka", IndexExportKafkaTopicKey)
+	errInvalidIndexExportBatchSize            = fmt.Errorf("%s must be in [1, %d]", IndexExportBatchSizeKey, indexer.MaxFetchedByRange)
 )
 
 func getConsensusConfig(v *viper.Viper) snowball.Parameters {
@@ -166,6 +205,13 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 		}
 	}
 
+	switch {
+	case v.GetInt(AdminAPIAuditLogMaxSizeKey) <= 0:
+		return node.HTTPConfig{}, errInvalidAuditLogMaxSize
+	case v.GetInt(AdminAPIAuditLogMaxFilesKey) < 0:
+		return node.HTTPConfig{}, errInvalidAuditLogMaxFiles
+	}
+
 	return node.HTTPConfig{
 		HTTPConfig: server.HTTPConfig{
 			ReadTimeout:       v.GetDuration(HTTPReadTimeoutKey),
@@ -182,6 +228,10 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 			InfoAPIEnabled:    v.GetBool(InfoAPIEnabledKey),
 			MetricsAPIEnabled: v.GetBool(MetricsAPIEnabledKey),
 			HealthAPIEnabled:  v.GetBool(HealthAPIEnabledKey),
+
+			AdminAPIAuditLogEnabled:  v.GetBool(AdminAPIAuditLogEnabledKey),
+			AdminAPIAuditLogMaxSize:  v.GetInt(AdminAPIAuditLogMaxSizeKey),
+			AdminAPIAuditLogMaxFiles: v.GetInt(AdminAPIAuditLogMaxFilesKey),
 		},
 		HTTPHost:           v.GetString(HTTPHostKey),
 		HTTPPort:           uint16(v.GetUint(HTTPPortKey)),
@@ -292,6 +342,16 @@ func getNetworkConfig(
 	supportedACPs.Difference(constants.ActivatedACPs)
 	objectedACPs.Difference(constants.ActivatedACPs)
 
+	dialPreference, err := network.ParseDialPreference(v.GetString(NetworkDialPreferenceKey))
+	if err != nil {
+		return network.Config{}, err
+	}
+
+	connectionPolicyConfig, err := getConnectionPolicyConfig(v)
+	if err != nil {
+		return network.Config{}, err
+	}
+
 	config := network.Config{
 		ThrottlerConfig: network.ThrottlerConfig{
 			MaxInboundConnsPerSec: maxInboundConnsPerSec,
@@ -345,7 +405,8 @@ func getNetworkConfig(
 			ConnectionTimeout: v.GetDuration(NetworkOutboundConnectionTimeoutKey),
 		},
 
-		TLSKeyLogFile: v.GetString(NetworkTLSKeyLogFileKey),
+		TLSKeyLogFile:                  v.GetString(NetworkTLSKeyLogFileKey),
+		TransportCertRotationFrequency: v.GetDuration(NetworkTLSTransportCertRotationFrequencyKey),
 
 		TimeoutConfig: network.TimeoutConfig{
 			PingPongTimeout:      v.GetDuration(NetworkPingTimeoutKey),
@@ -363,10 +424,13 @@ func getNetworkConfig(
 			InitialReconnectDelay: v.GetDuration(NetworkInitialReconnectDelayKey),
 		},
 
+		ConnectionPolicyConfig: connectionPolicyConfig,
+
 		MaxClockDifference:           v.GetDuration(NetworkMaxClockDifferenceKey),
 		CompressionType:              compressionType,
 		PingFrequency:                v.GetDuration(NetworkPingFrequencyKey),
 		AllowPrivateIPs:              allowPrivateIPs,
+		DialPreference:               dialPreference,
 		UptimeMetricFreq:             v.GetDuration(UptimeMetricFreqKey),
 		MaximumInboundMessageTimeout: v.GetDuration(NetworkMaximumInboundTimeoutKey),
 
@@ -391,6 +455,8 @@ func getNetworkConfig(
 		return network.Config{}, fmt.Errorf("%q must be >= 0", NetworkOutboundConnectionTimeoutKey)
 	case config.PeerListPullGossipFreq < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerListPullGossipFreqKey)
+	case config.TransportCertRotationFrequency < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkTLSTransportCertRotationFrequencyKey)
 	case config.PeerListBloomResetFreq < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerListBloomResetFreqKey)
 	case config.ThrottlerConfig.InboundMsgThrottlerConfig.CPUThrottlerConfig.MaxRecheckDelay < constants.MinInboundThrottlerMaxRecheckDelay:
@@ -417,6 +483,59 @@ func getNetworkConfig(
 	return config, nil
 }
 
+func getConnectionPolicyConfig(v *viper.Viper) (network.ConnectionPolicyConfig, error) {
+	config := network.ConnectionPolicyConfig{
+		PinnedPeers: make(map[ids.NodeID]netip.AddrPort),
+	}
+	for _, pinnedPeer := range strings.Split(v.GetString(NetworkPinnedPeersKey), ",") {
+		pinnedPeer = strings.TrimSpace(pinnedPeer)
+		if pinnedPeer == "" {
+			continue
+		}
+		nodeIDStr, ipStr, ok := strings.Cut(pinnedPeer, "@")
+		if !ok {
+			return network.ConnectionPolicyConfig{}, fmt.Errorf("%w: %s", errInvalidPinnedPeer, pinnedPeer)
+		}
+		nodeID, err := ids.NodeIDFromString(nodeIDStr)
+		if err != nil {
+			return network.ConnectionPolicyConfig{}, fmt.Errorf("couldn't parse pinned peer id %s: %w", nodeIDStr, err)
+		}
+		addr, err := ips.ParseAddrPort(ipStr)
+		if err != nil {
+			return network.ConnectionPolicyConfig{}, fmt.Errorf("couldn't parse pinned peer ip %s: %w", ipStr, err)
+		}
+		config.PinnedPeers[nodeID] = addr
+	}
+
+	var err error
+	config.AllowedNodeIDs, err = getNodeIDs(v, NetworkAllowedNodeIDsKey)
+	if err != nil {
+		return network.ConnectionPolicyConfig{}, err
+	}
+	config.DeniedNodeIDs, err = getNodeIDs(v, NetworkDeniedNodeIDsKey)
+	if err != nil {
+		return network.ConnectionPolicyConfig{}, err
+	}
+	return config, config.Verify()
+}
+
+// getNodeIDs parses the comma separated list of node IDs in [key].
+func getNodeIDs(v *viper.Viper, key string) (set.Set[ids.NodeID], error) {
+	var nodeIDs set.Set[ids.NodeID]
+	for _, id := range strings.Split(v.GetString(key), ",") {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		nodeID, err := ids.NodeIDFromString(id)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse %s node id %s: %w", key, id, err)
+		}
+		nodeIDs.Add(nodeID)
+	}
+	return nodeIDs, nil
+}
+
 func getBenchlistConfig(v *viper.Viper, consensusParameters snowball.Parameters) (benchlist.Config, error) {
 	// AlphaConfidence is used here to ensure that benching can't cause a
 	// liveness failure. If AlphaPreference were used, the benchlist may grow to
@@ -476,12 +595,101 @@ func getStateSyncConfig(v *viper.Viper) (node.StateSyncConfig, error) {
 	return config, nil
 }
 
+func getReadReplicaConfig(v *viper.Viper) (node.ReadReplicaConfig, error) {
+	config := node.ReadReplicaConfig{
+		ReadReplicaChainIDs:      set.Set[ids.ID]{},
+		ReadReplicaPollFrequency: v.GetDuration(ReadReplicaPollFrequencyKey),
+	}
+	for _, chain := range strings.Split(v.GetString(ReadReplicaChainIDsKey), ",") {
+		chain = strings.TrimSpace(chain)
+		if chain == "" {
+			continue
+		}
+		chainID, err := ids.FromString(chain)
+		if err != nil {
+			return node.ReadReplicaConfig{}, fmt.Errorf("couldn't parse read replica chainID %q: %w", chain, err)
+		}
+		config.ReadReplicaChainIDs.Add(chainID)
+	}
+	for _, uri := range strings.Split(v.GetString(ReadReplicaURIsKey), ",") {
+		uri = strings.TrimSpace(uri)
+		if uri == "" {
+			continue
+		}
+		u, err := url.Parse(uri)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return node.ReadReplicaConfig{}, fmt.Errorf("%w: %q", errInvalidReadReplicaURI, uri)
+		}
+		config.ReadReplicaURIs = append(config.ReadReplicaURIs, strings.TrimSuffix(uri, "/"))
+	}
+
+	if config.ReadReplicaChainIDs.Len() == 0 {
+		return config, nil
+	}
+	if len(config.ReadReplicaURIs) == 0 {
+		return node.ReadReplicaConfig{}, errNoReadReplicaURIs
+	}
+	if config.ReadReplicaPollFrequency <= 0 {
+		return node.ReadReplicaConfig{}, fmt.Errorf("%q must be > 0", ReadReplicaPollFrequencyKey)
+	}
+	return config, nil
+}
+
+func getIndexExportConfig(v *viper.Viper) (node.IndexExportConfig, error) {
+	config := node.IndexExportConfig{
+		IndexExportSink:           v.GetString(IndexExportSinkKey),
+		IndexExportURL:            v.GetString(IndexExportURLKey),
+		IndexExportKafkaTopic:     v.GetString(IndexExportKafkaTopicKey),
+		IndexExportBatchSize:      v.GetInt(IndexExportBatchSizeKey),
+		IndexExportRetryFrequency: v.GetDuration(IndexExportRetryFrequencyKey),
+	}
+	switch config.IndexExportSink {
+	case "":
+		return config, nil
+	case indexer.SinkWebhook:
+	case indexer.SinkKafka:
+		if config.IndexExportKafkaTopic == "" {
+			return node.IndexExportConfig{}, errNoIndexExportKafkaTopic
+		}
+	default:
+		return node.IndexExportConfig{}, errInvalidIndexExportSink
+	}
+
+	if !v.GetBool(IndexEnabledKey) {
+		return node.IndexExportConfig{}, errIndexExportWithoutIndex
+	}
+	u, err := url.Parse(config.IndexExportURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return node.IndexExportConfig{}, errInvalidIndexExportURL
+	}
+	if config.IndexExportBatchSize < 1 || config.IndexExportBatchSize > indexer.MaxFetchedByRange {
+		return node.IndexExportConfig{}, errInvalidIndexExportBatchSize
+	}
+	if config.IndexExportRetryFrequency <= 0 {
+		return node.IndexExportConfig{}, fmt.Errorf("%q must be > 0", IndexExportRetryFrequencyKey)
+	}
+	return config, nil
+}
+
 func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig, error) {
 	config := node.BootstrapConfig{
 		BootstrapBeaconConnectionTimeout:        v.GetDuration(BootstrapBeaconConnectionTimeoutKey),
 		BootstrapMaxTimeGetAncestors:            v.GetDuration(BootstrapMaxTimeGetAncestorsKey),
 		BootstrapAncestorsMaxContainersSent:     int(v.GetUint(BootstrapAncestorsMaxContainersSentKey)),
 		BootstrapAncestorsMaxContainersReceived: int(v.GetUint(BootstrapAncestorsMaxContainersReceivedKey)),
+		BootstrapMaxConcurrentChains:            int(v.GetUint(BootstrapMaxConcurrentChainsKey)),
+		BootstrapDNSSeedsRefreshFreq:            v.GetDuration(BootstrapDNSSeedsRefreshFreqKey),
+	}
+
+	for _, seed := range strings.Split(v.GetString(BootstrapDNSSeedsKey), ",") {
+		seed = strings.TrimSpace(seed)
+		if seed == "" {
+			continue
+		}
+		config.BootstrapDNSSeeds = append(config.BootstrapDNSSeeds, seed)
+	}
+	if len(config.BootstrapDNSSeeds) > 0 && config.BootstrapDNSSeedsRefreshFreq <= 0 {
+		return node.BootstrapConfig{}, fmt.Errorf("%q must be > 0", BootstrapDNSSeedsRefreshFreqKey)
 	}
 
 	// TODO: Add a "BootstrappersKey" flag to more clearly enforce ID and IP
@@ -540,9 +748,65 @@ func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig,
 	return config, nil
 }
 
+func getClockSkewConfig(v *viper.Viper) (clockskew.Config, error) {
+	config := clockskew.Config{
+		NTPFrequency: v.GetDuration(ClockSkewNTPFrequencyKey),
+		MaxSkew:      v.GetDuration(ClockSkewMaxKey),
+	}
+	if config.NTPFrequency <= 0 {
+		return clockskew.Config{}, fmt.Errorf("%q must be > 0", ClockSkewNTPFrequencyKey)
+	}
+	if config.MaxSkew <= 0 {
+		return clockskew.Config{}, fmt.Errorf("%q must be > 0", ClockSkewMaxKey)
+	}
+	for _, server := range strings.Split(v.GetString(ClockSkewNTPServersKey), ",") {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			return clockskew.Config{}, fmt.Errorf("%w: %q", errInvalidNTPServer, server)
+		}
+		config.NTPServers = append(config.NTPServers, server)
+	}
+	return config, nil
+}
+
+func getUpdateAdvisoryConfig(v *viper.Viper) (advisory.Config, error) {
+	config := advisory.Config{
+		URL:           v.GetString(UpdateAdvisoryURLKey),
+		Frequency:     v.GetDuration(UpdateAdvisoryFrequencyKey),
+		WarningPeriod: v.GetDuration(UpdateAdvisoryWarningPeriodKey),
+	}
+	// The trusted publishers also sign the release manifests that the node's
+	// binary can be verified against, so they are parsed even if the advisory
+	// is disabled.
+	rawPublishers := v.GetStringSlice(UpdateAdvisoryTrustedPublishersKey)
+	publishers, err := address.ParseToIDs(rawPublishers)
+	if err != nil {
+		return advisory.Config{}, fmt.Errorf("couldn't parse %q: %w", UpdateAdvisoryTrustedPublishersKey, err)
+	}
+	config.TrustedPublishers = set.Of(publishers...)
+
+	if config.URL == "" {
+		return config, nil
+	}
+	if config.Frequency <= 0 {
+		return advisory.Config{}, fmt.Errorf("%q must be > 0", UpdateAdvisoryFrequencyKey)
+	}
+	if config.WarningPeriod < 0 {
+		return advisory.Config{}, fmt.Errorf("%q must be >= 0", UpdateAdvisoryWarningPeriodKey)
+	}
+	if len(rawPublishers) == 0 {
+		return advisory.Config{}, errNoUpdateAdvisoryPublishers
+	}
+	return config, nil
+}
+
 func getIPConfig(v *viper.Viper) (node.IPConfig, error) {
 	ipConfig := node.IPConfig{
 		PublicIP:                  v.GetString(PublicIPKey),
+		PublicIPSecondary:         v.GetString(PublicIPSecondaryKey),
 		PublicIPResolutionService: v.GetString(PublicIPResolutionServiceKey),
 		PublicIPResolutionFreq:    v.GetDuration(PublicIPResolutionFreqKey),
 		ListenHost:                v.GetString(StakingHostKey),
@@ -554,6 +818,41 @@ func getIPConfig(v *viper.Viper) (node.IPConfig, error) {
 	if ipConfig.PublicIP != "" && ipConfig.PublicIPResolutionService != "" {
 		return node.IPConfig{}, fmt.Errorf("only one of --%s and --%s can be given", PublicIPKey, PublicIPResolutionServiceKey)
 	}
+	for _, server := range strings.Split(v.GetString(PublicIPResolutionSTUNServersKey), ",") {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			return node.IPConfig{}, fmt.Errorf("%w: %q", errInvalidSTUNServer, server)
+		}
+		ipConfig.PublicIPResolutionSTUNServers = append(ipConfig.PublicIPResolutionSTUNServers, server)
+	}
+	if strings.EqualFold(ipConfig.PublicIPResolutionService, dynamicip.STUNName) && len(ipConfig.PublicIPResolutionSTUNServers) == 0 {
+		return node.IPConfig{}, errNoSTUNServers
+	}
+	if ipConfig.PublicIPSecondary == "" {
+		return ipConfig, nil
+	}
+
+	secondaryAddr, err := ips.ParseAddr(ipConfig.PublicIPSecondary)
+	if err != nil {
+		return node.IPConfig{}, fmt.Errorf("invalid %s %q: %w", PublicIPSecondaryKey, ipConfig.PublicIPSecondary, err)
+	}
+	if ipConfig.PublicIP != "" {
+		publicAddr, err := ips.ParseAddr(ipConfig.PublicIP)
+		if err != nil {
+			return node.IPConfig{}, fmt.Errorf("invalid %s %q: %w", PublicIPKey, ipConfig.PublicIP, err)
+		}
+		if ips.IsSameFamily(publicAddr, secondaryAddr) {
+			return node.IPConfig{}, fmt.Errorf("%w: --%s and --%s", errSameAddressFamily, PublicIPKey, PublicIPSecondaryKey)
+		}
+	}
+	// Only listening on an unspecified address accepts connections over both
+	// IPv4 and IPv6.
+	if listenAddr, err := netip.ParseAddr(ipConfig.ListenHost); err == nil && !listenAddr.IsUnspecified() {
+		return node.IPConfig{}, fmt.Errorf("%w: --%s %q", errNotDualStack, StakingHostKey, ipConfig.ListenHost)
+	}
 	return ipConfig, nil
 }
 
@@ -570,12 +869,120 @@ func getProfilerConfig(v *viper.Viper) (profiler.Config, error) {
 	return config, nil
 }
 
-func getStakingTLSCertFromFlag(v *viper.Viper) (tls.Certificate, error) {
+// getStakingKeySecret returns the secret that the staking TLS key and signer
+// key are encrypted with, or nil if they aren't encrypted.
+func getStakingKeySecret(v *viper.Viper) (*keyfile.Secret, error) {
+	var (
+		passphraseFile = getExpandedArg(v, StakingKeyEncryptionPassphraseFileKey)
+		prompt         = v.GetBool(StakingKeyEncryptionPassphrasePromptKey)
+		dataKeyCommand = v.GetString(StakingKeyEncryptionDataKeyCommandKey)
+	)
+	numSources := 0
+	for _, isSet := range []bool{passphraseFile != "", prompt, dataKeyCommand != ""} {
+		if isSet {
+			numSources++
+		}
+	}
+	if numSources > 1 {
+		return nil, errMultipleStakingKeySecrets
+	}
+
+	switch {
+	case passphraseFile != "":
+		passphrase, err := os.ReadFile(passphraseFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read staking key passphrase: %w", err)
+		}
+		return keyfile.NewPassphrase(bytes.TrimRight(passphrase, "\r\n"))
+	case prompt:
+		stdin := int(os.Stdin.Fd())
+		if !term.IsTerminal(stdin) {
+			return nil, fmt.Errorf("couldn't prompt for staking key passphrase: %w", errNoTerminal)
+		}
+		fmt.Fprint(os.Stderr, "Enter staking key passphrase: ")
+		passphrase, err := term.ReadPassword(stdin)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read staking key passphrase: %w", err)
+		}
+		return keyfile.NewPassphrase(passphrase)
+	case dataKeyCommand != "":
+		return getStakingKeyDataKey(dataKeyCommand)
+	default:
+		return nil, nil
+	}
+}
+
+// getStakingKeyDataKey runs [command] and returns the data key it prints.
+func getStakingKeyDataKey(command string) (*keyfile.Secret, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, errEmptyDataKeyCommand
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), stakingKeyDataKeyCommandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("couldn't run %s: %w", StakingKeyEncryptionDataKeyCommandKey, err)
+	}
+	dataKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(output)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode base64 data key: %w", err)
+	}
+	return keyfile.NewDataKey(dataKey)
+}
+
+// decryptStakingKey returns the plaintext of [content], which may have been
+// encrypted with [secret].
+func decryptStakingKey(content []byte, secret *keyfile.Secret) ([]byte, error) {
+	if !keyfile.IsEncrypted(content) {
+		return content, nil
+	}
+	if secret == nil {
+		return nil, errStakingKeyEncrypted
+	}
+	plaintext, err := secret.Decrypt(content)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decrypt staking key: %w", err)
+	}
+	return plaintext, nil
+}
+
+// readStakingKeyFile returns the plaintext of the key file at [path]. If
+// [secret] isn't nil and the file isn't encrypted, it is encrypted in place.
+func readStakingKeyFile(path string, secret *keyfile.Secret) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil || keyfile.IsEncrypted(content) {
+		return decryptStakingKey(content, secret)
+	}
+
+	encrypted, err := secret.Encrypt(content)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't encrypt staking key: %w", err)
+	}
+	if err := perms.WriteFile(path, encrypted, perms.ReadOnly); err != nil {
+		return nil, fmt.Errorf("couldn't write encrypted staking key to %s: %w", path, err)
+	}
+	return content, nil
+}
+
+func getStakingTLSCertFromFlag(v *viper.Viper, secret *keyfile.Secret) (tls.Certificate, error) {
 	stakingKeyRawContent := v.GetString(StakingTLSKeyContentKey)
 	stakingKeyContent, err := base64.StdEncoding.DecodeString(stakingKeyRawContent)
 	if err != nil {
 		return tls.Certificate{}, fmt.Errorf("unable to decode base64 content: %w", err)
 	}
+	stakingKeyContent, err = decryptStakingKey(stakingKeyContent, secret)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
 
 	stakingCertRawContent := v.GetString(StakingCertContentKey)
 	stakingCertContent, err := base64.StdEncoding.DecodeString(stakingCertRawContent)
@@ -591,7 +998,7 @@ func getStakingTLSCertFromFlag(v *viper.Viper) (tls.Certificate, error) {
 	return *cert, nil
 }
 
-func getStakingTLSCertFromFile(v *viper.Viper) (tls.Certificate, error) {
+func getStakingTLSCertFromFile(v *viper.Viper, secret *keyfile.Secret) (tls.Certificate, error) {
 	// Parse the staking key/cert paths and expand environment variables
 	stakingKeyPath := getExpandedArg(v, StakingTLSKeyPathKey)
 	stakingCertPath := getExpandedArg(v, StakingCertPathKey)
@@ -605,20 +1012,28 @@ func getStakingTLSCertFromFile(v *viper.Viper) (tls.Certificate, error) {
 		}
 	} else {
 		// Create the staking key/cert if [stakingKeyPath] and [stakingCertPath] don't exist
-		if err := staking.InitNodeStakingKeyPair(stakingKeyPath, stakingCertPath); err != nil {
+		if err := staking.InitNodeStakingKeyPair(stakingKeyPath, stakingCertPath, secret); err != nil {
 			return tls.Certificate{}, fmt.Errorf("couldn't generate staking key/cert: %w", err)
 		}
 	}
 
 	// Load and parse the staking key/cert
-	cert, err := staking.LoadTLSCertFromFiles(stakingKeyPath, stakingCertPath)
+	stakingKey, err := readStakingKeyFile(stakingKeyPath, secret)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("couldn't read staking key: %w", err)
+	}
+	stakingCert, err := os.ReadFile(stakingCertPath)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("couldn't read staking certificate: %w", err)
+	}
+	cert, err := staking.LoadTLSCertFromBytes(stakingKey, stakingCert)
 	if err != nil {
 		return tls.Certificate{}, fmt.Errorf("couldn't read staking certificate: %w", err)
 	}
 	return *cert, nil
 }
 
-func getStakingTLSCert(v *viper.Viper) (tls.Certificate, error) {
+func getStakingTLSCert(v *viper.Viper, secret *keyfile.Secret) (tls.Certificate, error) {
 	if v.GetBool(StakingEphemeralCertEnabledKey) {
 		// Use an ephemeral staking key/cert
 		cert, err := staking.NewTLSCert()
@@ -634,13 +1049,13 @@ func getStakingTLSCert(v *viper.Viper) (tls.Certificate, error) {
 	case !v.IsSet(StakingTLSKeyContentKey) && v.IsSet(StakingCertContentKey):
 		return tls.Certificate{}, errStakingKeyContentUnset
 	case v.IsSet(StakingTLSKeyContentKey) && v.IsSet(StakingCertContentKey):
-		return getStakingTLSCertFromFlag(v)
+		return getStakingTLSCertFromFlag(v, secret)
 	default:
-		return getStakingTLSCertFromFile(v)
+		return getStakingTLSCertFromFile(v, secret)
 	}
 }
 
-func getStakingSigner(v *viper.Viper) (bls.Signer, error) {
+func getStakingSigner(v *viper.Viper, secret *keyfile.Secret) (bls.Signer, error) {
 	if v.GetBool(StakingEphemeralSignerEnabledKey) {
 		key, err := localsigner.New()
 		if err != nil {
@@ -655,6 +1070,10 @@ func getStakingSigner(v *viper.Viper) (bls.Signer, error) {
 		if err != nil {
 			return nil, fmt.Errorf("unable to decode base64 content: %w", err)
 		}
+		signerKeyContent, err = decryptStakingKey(signerKeyContent, secret)
+		if err != nil {
+			return nil, err
+		}
 		key, err := localsigner.FromBytes(signerKeyContent)
 		if err != nil {
 			return nil, fmt.Errorf("couldn't parse signing key: %w", err)
@@ -665,7 +1084,7 @@ func getStakingSigner(v *viper.Viper) (bls.Signer, error) {
 	signingKeyPath := getExpandedArg(v, StakingSignerKeyPathKey)
 	_, err := os.Stat(signingKeyPath)
 	if !errors.Is(err, fs.ErrNotExist) {
-		signingKeyBytes, err := os.ReadFile(signingKeyPath)
+		signingKeyBytes, err := readStakingKeyFile(signingKeyPath, secret)
 		if err != nil {
 			return nil, err
 		}
@@ -690,6 +1109,12 @@ func getStakingSigner(v *viper.Viper) (bls.Signer, error) {
 	}
 
 	keyBytes := key.ToBytes()
+	if secret != nil {
+		keyBytes, err = secret.Encrypt(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't encrypt new signing key: %w", err)
+		}
+	}
 	if err := os.WriteFile(signingKeyPath, keyBytes, perms.ReadWrite); err != nil {
 		return nil, fmt.Errorf("couldn't write new signing key to %s: %w", signingKeyPath, err)
 	}
@@ -699,6 +1124,31 @@ func getStakingSigner(v *viper.Viper) (bls.Signer, error) {
 	return key, nil
 }
 
+func getMaintenanceWindow(v *viper.Viper) (uptime.MaintenanceWindow, error) {
+	duration := v.GetDuration(MaintenanceWindowDurationKey)
+	if duration == 0 {
+		return uptime.MaintenanceWindow{}, nil
+	}
+
+	start, err := time.Parse(time.RFC3339, v.GetString(MaintenanceWindowStartKey))
+	if err != nil {
+		return uptime.MaintenanceWindow{}, fmt.Errorf("couldn't parse %s: %w", MaintenanceWindowStartKey, err)
+	}
+
+	window := uptime.MaintenanceWindow{
+		Start: start,
+		End:   start.Add(duration),
+	}
+	if err := window.Verify(); err != nil {
+		return uptime.MaintenanceWindow{}, fmt.Errorf("invalid maintenance window: %w", err)
+	}
+	// Peers drop announcements of windows that start too far in the future.
+	if maxStart := time.Now().Add(uptime.MaxMaintenanceWindowLeadTime); window.Start.After(maxStart) {
+		return uptime.MaintenanceWindow{}, fmt.Errorf("invalid maintenance window: %w: %s > %s", uptime.ErrMaintenanceWindowTooFar, window.Start, maxStart)
+	}
+	return window, nil
+}
+
 func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, error) {
 	config := node.StakingConfig{
 		SybilProtectionEnabled:        v.GetBool(SybilProtectionEnabledKey),
@@ -716,12 +1166,27 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		return node.StakingConfig{}, errSybilProtectionDisabledOnPublicNetwork
 	}
 
+	// The secret isn't needed, and so isn't prompted for, if both keys are
+	// ephemeral.
+	var secret *keyfile.Secret
+	if !v.GetBool(StakingEphemeralCertEnabledKey) || !v.GetBool(StakingEphemeralSignerEnabledKey) {
+		var err error
+		secret, err = getStakingKeySecret(v)
+		if err != nil {
+			return node.StakingConfig{}, err
+		}
+	}
+
 	var err error
-	config.StakingTLSCert, err = getStakingTLSCert(v)
+	config.StakingTLSCert, err = getStakingTLSCert(v, secret)
 	if err != nil {
 		return node.StakingConfig{}, err
 	}
-	config.StakingSigningKey, err = getStakingSigner(v)
+	config.StakingSigningKey, err = getStakingSigner(v, secret)
+	if err != nil {
+		return node.StakingConfig{}, err
+	}
+	config.MaintenanceWindow, err = getMaintenanceWindow(v)
 	if err != nil {
 		return node.StakingConfig{}, err
 	}
@@ -768,10 +1233,11 @@ func getTxFeeConfig(v *viper.Viper, networkID uint32) genesis.TxFeeConfig {
 			TxFee:            v.GetUint64(TxFeeKey),
 			DynamicFeeConfig: gas.Config{
 				Weights: gas.Dimensions{
-					gas.Bandwidth: v.GetUint64(DynamicFeesBandwidthWeightKey),
-					gas.DBRead:    v.GetUint64(DynamicFeesDBReadWeightKey),
-					gas.DBWrite:   v.GetUint64(DynamicFeesDBWriteWeightKey),
-					gas.Compute:   v.GetUint64(DynamicFeesComputeWeightKey),
+					gas.Bandwidth:   v.GetUint64(DynamicFeesBandwidthWeightKey),
+					gas.DBRead:      v.GetUint64(DynamicFeesDBReadWeightKey),
+					gas.DBWrite:     v.GetUint64(DynamicFeesDBWriteWeightKey),
+					gas.Compute:     v.GetUint64(DynamicFeesComputeWeightKey),
+					gas.StateGrowth: v.GetUint64(DynamicFeesStateGrowthWeightKey),
 				},
 				MaxCapacity:              gas.Gas(v.GetUint64(DynamicFeesMaxGasCapacityKey)),
 				MaxPerSecond:             gas.Gas(v.GetUint64(DynamicFeesMaxGasPerSecondKey)),
@@ -785,6 +1251,13 @@ func getTxFeeConfig(v *viper.Viper, networkID uint32) genesis.TxFeeConfig {
 				MinPrice:                 gas.Price(v.GetUint64(ValidatorFeesMinPriceKey)),
 				ExcessConversionConstant: gas.Gas(v.GetUint64(ValidatorFeesExcessConversionConstantKey)),
 			},
+			SubnetAuthFeeWeights: gas.Dimensions{
+				gas.Bandwidth:   v.GetUint64(DynamicFeesSubnetAuthBandwidthWeightKey),
+				gas.DBRead:      v.GetUint64(DynamicFeesSubnetAuthDBReadWeightKey),
+				gas.DBWrite:     v.GetUint64(DynamicFeesSubnetAuthDBWriteWeightKey),
+				gas.Compute:     v.GetUint64(DynamicFeesSubnetAuthComputeWeightKey),
+				gas.StateGrowth: v.GetUint64(DynamicFeesSubnetAuthStateGrowthWeightKey),
+			},
 		}
 	}
 	return genesis.GetTxFeeConfig(networkID)
@@ -896,6 +1369,17 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 	}, nil
 }
 
+// GetDatabaseConfig returns the network ID and the database config of the node
+// configured by [v].
+func GetDatabaseConfig(v *viper.Viper) (uint32, node.DatabaseConfig, error) {
+	networkID, err := constants.NetworkID(v.GetString(NetworkNameKey))
+	if err != nil {
+		return 0, node.DatabaseConfig{}, err
+	}
+	dbConfig, err := getDatabaseConfig(v, networkID)
+	return networkID, dbConfig, err
+}
+
 func getAliases(v *viper.Viper, name string, contentKey string, fileKey string) (map[ids.ID][]string, error) {
 	var fileBytes []byte
 	if v.IsSet(contentKey) {
@@ -1023,9 +1507,16 @@ func readChainConfigPath(chainConfigPath string) (map[string]chains.ChainConfig,
 			return chainConfigMap, err
 		}
 
+		// chainconfigdir/chainId/consensus.*
+		consensusData, err := storage.ReadFileWithName(chainDir, chainConsensusFileName)
+		if err != nil {
+			return chainConfigMap, err
+		}
+
 		chainConfigMap[dirInfo.Name()] = chains.ChainConfig{
-			Config:  configData,
-			Upgrade: upgradeData,
+			Config:    configData,
+			Upgrade:   upgradeData,
+			Consensus: consensusData,
 		}
 	}
 	return chainConfigMap, nil
@@ -1140,6 +1631,7 @@ func getDefaultSubnetConfig(v *viper.Viper) subnets.Config {
 		ConsensusParameters:         getConsensusConfig(v),
 		ValidatorOnly:               false,
 		ProposerMinBlockDelay:       v.GetDuration(ProposerVMMinBlockDelayKey),
+		ProposerMaxBlockDelay:       v.GetDuration(ProposerVMMaxBlockDelayKey),
 		ProposerNumHistoricalBlocks: proposervm.DefaultNumHistoricalBlocks,
 	}
 }
@@ -1262,6 +1754,22 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, err
 	}
 
+	nodeConfig.PluginVMLimits = subprocess.Limits{
+		MaxRSS:    v.GetUint64(PluginVMMaxRSSKey),
+		CPUShares: v.GetUint64(PluginVMCPUSharesKey),
+	}
+	nodeConfig.PluginVMMaxRestarts = v.GetInt(PluginVMMaxRestartsKey)
+	nodeConfig.PluginVMRestartInitialBackoff = v.GetDuration(PluginVMRestartInitialBackoffKey)
+	nodeConfig.PluginVMRestartMaxBackoff = v.GetDuration(PluginVMRestartMaxBackoffKey)
+	switch {
+	case nodeConfig.PluginVMMaxRestarts < 0:
+		return node.Config{}, fmt.Errorf("%q must be >= 0", PluginVMMaxRestartsKey)
+	case nodeConfig.PluginVMRestartInitialBackoff <= 0:
+		return node.Config{}, fmt.Errorf("%q must be > 0", PluginVMRestartInitialBackoffKey)
+	case nodeConfig.PluginVMRestartMaxBackoff < nodeConfig.PluginVMRestartInitialBackoff:
+		return node.Config{}, fmt.Errorf("%q must be >= %q", PluginVMRestartMaxBackoffKey, PluginVMRestartInitialBackoffKey)
+	}
+
 	nodeConfig.ConsensusShutdownTimeout = v.GetDuration(ConsensusShutdownTimeoutKey)
 	if nodeConfig.ConsensusShutdownTimeout < 0 {
 		return node.Config{}, fmt.Errorf("%q must be >= 0", ConsensusShutdownTimeoutKey)
@@ -1272,12 +1780,26 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	if nodeConfig.FrontierPollFrequency < 0 {
 		return node.Config{}, fmt.Errorf("%s must be >= 0", ConsensusFrontierPollFrequencyKey)
 	}
+	nodeConfig.AppGossipDedupWindow = v.GetDuration(ConsensusAppGossipDedupWindowKey)
+	if nodeConfig.AppGossipDedupWindow < 0 {
+		return node.Config{}, fmt.Errorf("%s must be >= 0", ConsensusAppGossipDedupWindowKey)
+	}
+	nodeConfig.AppGossipDedupSize = int(v.GetUint(ConsensusAppGossipDedupSizeKey))
+	if nodeConfig.AppGossipDedupWindow > 0 && nodeConfig.AppGossipDedupSize <= 0 {
+		return node.Config{}, fmt.Errorf("%s must be > 0", ConsensusAppGossipDedupSizeKey)
+	}
+
+	nodeConfig.BlockVerificationTimeout = v.GetDuration(ConsensusBlockVerificationTimeoutKey)
+	if nodeConfig.BlockVerificationTimeout < 0 {
+		return node.Config{}, fmt.Errorf("%s must be >= 0", ConsensusBlockVerificationTimeoutKey)
+	}
 
 	// App handling
 	nodeConfig.ConsensusAppConcurrency = int(v.GetUint(ConsensusAppConcurrencyKey))
 	if nodeConfig.ConsensusAppConcurrency <= 0 {
 		return node.Config{}, fmt.Errorf("%s must be > 0", ConsensusAppConcurrencyKey)
 	}
+	nodeConfig.ConsensusMaxUnprocessedMsgs = int(v.GetUint(ConsensusMaxUnprocessedMsgsKey))
 
 	nodeConfig.UseCurrentHeight = v.GetBool(ProposerVMUseCurrentHeightKey)
 
@@ -1328,6 +1850,14 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	if nodeConfig.HealthCheckFreq < 0 {
 		return node.Config{}, fmt.Errorf("%s must be positive", HealthCheckFreqKey)
 	}
+	nodeConfig.ClockSkewConfig, err = getClockSkewConfig(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+	nodeConfig.UpdateAdvisoryConfig, err = getUpdateAdvisoryConfig(v)
+	if err != nil {
+		return node.Config{}, err
+	}
 	// Halflife of continuous averager used in health checks
 	healthCheckAveragerHalflife := v.GetDuration(HealthCheckAveragerHalflifeKey)
 	if healthCheckAveragerHalflife <= 0 {
@@ -1378,6 +1908,16 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	}
 	subnetConfigs[constants.PrimaryNetworkID] = primaryNetworkConfig
 
+	nodeConfig.ConsensusGuardrailViolations, err = getConsensusGuardrailViolations(
+		v,
+		nodeConfig.NetworkID,
+		primaryNetworkConfig,
+		nodeConfig.AdaptiveTimeoutConfig,
+	)
+	if err != nil {
+		return node.Config{}, err
+	}
+
 	nodeConfig.SubnetConfigs = subnetConfigs
 
 	// Benchlist
@@ -1398,6 +1938,8 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	if err != nil {
 		return node.Config{}, fmt.Errorf("unable to load genesis file: %w", err)
 	}
+	// The genesis of a custom network may override the staking bounds
+	nodeConfig.StakingConfig.StakingConfig = genesisStakingCfg
 
 	// StateSync Configs
 	nodeConfig.StateSyncConfig, err = getStateSyncConfig(v)
@@ -1411,6 +1953,18 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, err
 	}
 
+	// Read Replica Configs
+	nodeConfig.ReadReplicaConfig, err = getReadReplicaConfig(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+
+	// Index Export Configs
+	nodeConfig.IndexExportConfig, err = getIndexExportConfig(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+
 	// Chain Configs
 	nodeConfig.ChainConfigs, err = getChainConfigs(v)
 	if err != nil {
@@ -1460,6 +2014,7 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	}
 
 	nodeConfig.ChainDataDir = getExpandedArg(v, ChainDataDirKey)
+	nodeConfig.PruneRetiredChains = v.GetBool(PruneRetiredChainsKey)
 
 	nodeConfig.ProcessContextFilePath = getExpandedArg(v, ProcessContextFileKey)
 
@@ -8,27 +8,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/netip"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/config/node"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/staking/keyfile"
 	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/utils/set"
 )
 
 const chainConfigFilenameExtension = ".ex"
 
 func TestGetChainConfigsFromFiles(t *testing.T) {
 	tests := map[string]struct {
-		configs  map[string]string
-		upgrades map[string]string
-		expected map[string]chains.ChainConfig
+		configs   map[string]string
+		upgrades  map[string]string
+		consensus map[string]string
+		expected  map[string]chains.ChainConfig
 	}{
 		"no chain configs": {
 			configs:  map[string]string{},
@@ -52,11 +64,12 @@ func TestGetChainConfigsFromFiles(t *testing.T) {
 			}(),
 		},
 		"valid alias": {
-			configs:  map[string]string{"C": "hello", "X": "world"},
-			upgrades: map[string]string{"C": "upgradess"},
+			configs:   map[string]string{"C": "hello", "X": "world"},
+			upgrades:  map[string]string{"C": "upgradess"},
+			consensus: map[string]string{"C": `{"k":30}`},
 			expected: func() map[string]chains.ChainConfig {
 				m := map[string]chains.ChainConfig{}
-				m["C"] = chains.ChainConfig{Config: []byte("hello"), Upgrade: []byte("upgradess")}
+				m["C"] = chains.ChainConfig{Config: []byte("hello"), Upgrade: []byte("upgradess"), Consensus: []byte(`{"k":30}`)}
 				m["X"] = chains.ChainConfig{Config: []byte("world"), Upgrade: []byte(nil)}
 
 				return m
@@ -80,6 +93,10 @@ func TestGetChainConfigsFromFiles(t *testing.T) {
 				chainDir := filepath.Join(chainsDir, key)
 				setupFile(t, chainDir, chainUpgradeFileName+chainConfigFilenameExtension, value)
 			}
+			for key, value := range test.consensus {
+				chainDir := filepath.Join(chainsDir, key)
+				setupFile(t, chainDir, chainConsensusFileName+chainConfigFilenameExtension, value)
+			}
 
 			v := setupViper(configFile)
 
@@ -583,3 +600,474 @@ func setupViper(configFilePath string) *viper.Viper {
 	}
 	return v
 }
+
+func TestGetConnectionPolicyConfig(t *testing.T) {
+	var (
+		nodeID0 = ids.GenerateTestNodeID()
+		nodeID1 = ids.GenerateTestNodeID()
+		nodeID2 = ids.GenerateTestNodeID()
+	)
+	tests := map[string]struct {
+		pinnedPeers    string
+		allowedNodeIDs string
+		deniedNodeIDs  string
+		expected       network.ConnectionPolicyConfig
+		expectedErr    error
+	}{
+		"empty": {
+			expected: network.ConnectionPolicyConfig{
+				PinnedPeers: map[ids.NodeID]netip.AddrPort{},
+			},
+		},
+		"valid": {
+			pinnedPeers:    nodeID0.String() + "@127.0.0.1:9651, " + nodeID1.String() + "@[::1]:9651",
+			allowedNodeIDs: nodeID1.String(),
+			deniedNodeIDs:  nodeID2.String(),
+			expected: network.ConnectionPolicyConfig{
+				PinnedPeers: map[ids.NodeID]netip.AddrPort{
+					nodeID0: netip.MustParseAddrPort("127.0.0.1:9651"),
+					nodeID1: netip.MustParseAddrPort("[::1]:9651"),
+				},
+				AllowedNodeIDs: set.Of(nodeID1),
+				DeniedNodeIDs:  set.Of(nodeID2),
+			},
+		},
+		"missing ip": {
+			pinnedPeers: nodeID0.String(),
+			expectedErr: errInvalidPinnedPeer,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(NetworkPinnedPeersKey, test.pinnedPeers)
+			v.Set(NetworkAllowedNodeIDsKey, test.allowedNodeIDs)
+			v.Set(NetworkDeniedNodeIDsKey, test.deniedNodeIDs)
+
+			config, err := getConnectionPolicyConfig(v)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.expected, config)
+			}
+		})
+	}
+}
+
+func TestGetIPConfigSecondaryIP(t *testing.T) {
+	tests := map[string]struct {
+		publicIP          string
+		publicIPSecondary string
+		stakingHost       string
+		expectedErr       error
+	}{
+		"no secondary IP": {
+			publicIP:    "1.2.3.4",
+			stakingHost: "127.0.0.1",
+		},
+		"dual-stack": {
+			publicIP:          "1.2.3.4",
+			publicIPSecondary: "2001:db8::1",
+		},
+		"dual-stack unspecified host": {
+			publicIP:          "2001:db8::1",
+			publicIPSecondary: "1.2.3.4",
+			stakingHost:       "::",
+		},
+		"same address family": {
+			publicIP:          "1.2.3.4",
+			publicIPSecondary: "5.6.7.8",
+			expectedErr:       errSameAddressFamily,
+		},
+		"specific staking host": {
+			publicIP:          "1.2.3.4",
+			publicIPSecondary: "2001:db8::1",
+			stakingHost:       "1.2.3.4",
+			expectedErr:       errNotDualStack,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(PublicIPKey, test.publicIP)
+			v.Set(PublicIPSecondaryKey, test.publicIPSecondary)
+			v.Set(StakingHostKey, test.stakingHost)
+
+			config, err := getIPConfig(v)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.publicIPSecondary, config.PublicIPSecondary)
+			}
+		})
+	}
+}
+
+func TestGetIPConfigSTUNServers(t *testing.T) {
+	tests := map[string]struct {
+		service         string
+		servers         string
+		expectedServers []string
+		expectedErr     error
+	}{
+		"multiple servers": {
+			servers:         "stun.example.com:3478, 1.2.3.4:19302",
+			expectedServers: []string{"stun.example.com:3478", "1.2.3.4:19302"},
+		},
+		"no servers": {
+			servers: "",
+		},
+		"missing port": {
+			servers:     "stun.example.com",
+			expectedErr: errInvalidSTUNServer,
+		},
+		"stun service without servers": {
+			service:     "stun",
+			servers:     "",
+			expectedErr: errNoSTUNServers,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(PublicIPResolutionServiceKey, test.service)
+			v.Set(PublicIPResolutionSTUNServersKey, test.servers)
+
+			config, err := getIPConfig(v)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.expectedServers, config.PublicIPResolutionSTUNServers)
+			}
+		})
+	}
+}
+
+func TestGetReadReplicaConfig(t *testing.T) {
+	chainID := ids.GenerateTestID()
+	tests := map[string]struct {
+		chainIDs      string
+		uris          string
+		pollFrequency time.Duration
+		expected      node.ReadReplicaConfig
+		expectedErr   error
+	}{
+		"disabled": {
+			pollFrequency: time.Second,
+			expected: node.ReadReplicaConfig{
+				ReadReplicaChainIDs:      set.Set[ids.ID]{},
+				ReadReplicaPollFrequency: time.Second,
+			},
+		},
+		"valid": {
+			chainIDs:      chainID.String(),
+			uris:          "http://10.0.0.1:9650/, https://rpc.example.com",
+			pollFrequency: time.Second,
+			expected: node.ReadReplicaConfig{
+				ReadReplicaChainIDs:      set.Of(chainID),
+				ReadReplicaURIs:          []string{"http://10.0.0.1:9650", "https://rpc.example.com"},
+				ReadReplicaPollFrequency: time.Second,
+			},
+		},
+		"no uris": {
+			chainIDs:      chainID.String(),
+			pollFrequency: time.Second,
+			expectedErr:   errNoReadReplicaURIs,
+		},
+		"invalid uri": {
+			chainIDs:      chainID.String(),
+			uris:          "10.0.0.1:9650",
+			pollFrequency: time.Second,
+			expectedErr:   errInvalidReadReplicaURI,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(ReadReplicaChainIDsKey, test.chainIDs)
+			v.Set(ReadReplicaURIsKey, test.uris)
+			v.Set(ReadReplicaPollFrequencyKey, test.pollFrequency)
+
+			config, err := getReadReplicaConfig(v)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.expected, config)
+			}
+		})
+	}
+}
+
+func TestGetIndexExportConfig(t *testing.T) {
+	tests := map[string]struct {
+		indexEnabled bool
+		sink         string
+		url          string
+		kafkaTopic   string
+		batchSize    int
+		expected     node.IndexExportConfig
+		expectedErr  error
+	}{
+		"disabled": {
+			batchSize: 64,
+			expected: node.IndexExportConfig{
+				IndexExportBatchSize:      64,
+				IndexExportRetryFrequency: time.Second,
+			},
+		},
+		"kafka": {
+			indexEnabled: true,
+			sink:         indexer.SinkKafka,
+			url:          "http://10.0.0.1:8082",
+			kafkaTopic:   "containers",
+			batchSize:    64,
+			expected: node.IndexExportConfig{
+				IndexExportSink:           indexer.SinkKafka,
+				IndexExportURL:            "http://10.0.0.1:8082",
+				IndexExportKafkaTopic:     "containers",
+				IndexExportBatchSize:      64,
+				IndexExportRetryFrequency: time.Second,
+			},
+		},
+		"index disabled": {
+			sink:        indexer.SinkWebhook,
+			url:         "https://example.com/containers",
+			batchSize:   64,
+			expectedErr: errIndexExportWithoutIndex,
+		},
+		"unknown sink": {
+			indexEnabled: true,
+			sink:         "smtp",
+			url:          "https://example.com/containers",
+			batchSize:    64,
+			expectedErr:  errInvalidIndexExportSink,
+		},
+		"kafka without topic": {
+			indexEnabled: true,
+			sink:         indexer.SinkKafka,
+			url:          "http://10.0.0.1:8082",
+			batchSize:    64,
+			expectedErr:  errNoIndexExportKafkaTopic,
+		},
+		"invalid url": {
+			indexEnabled: true,
+			sink:         indexer.SinkWebhook,
+			url:          "example.com/containers",
+			batchSize:    64,
+			expectedErr:  errInvalidIndexExportURL,
+		},
+		"batch size too large": {
+			indexEnabled: true,
+			sink:         indexer.SinkWebhook,
+			url:          "https://example.com/containers",
+			batchSize:    indexer.MaxFetchedByRange + 1,
+			expectedErr:  errInvalidIndexExportBatchSize,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(IndexEnabledKey, test.indexEnabled)
+			v.Set(IndexExportSinkKey, test.sink)
+			v.Set(IndexExportURLKey, test.url)
+			v.Set(IndexExportKafkaTopicKey, test.kafkaTopic)
+			v.Set(IndexExportBatchSizeKey, test.batchSize)
+			v.Set(IndexExportRetryFrequencyKey, time.Second)
+
+			config, err := getIndexExportConfig(v)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.expected, config)
+			}
+		})
+	}
+}
+
+func TestGetStakingKeySecret(t *testing.T) {
+	dataKey := base64.StdEncoding.EncodeToString(make([]byte, keyfile.MinDataKeyLen))
+	tests := map[string]struct {
+		passphrase     string
+		dataKeyCommand string
+		expectedNil    bool
+		expectedErr    error
+	}{
+		"unencrypted": {
+			expectedNil: true,
+		},
+		"passphrase": {
+			passphrase: "passphrase\n",
+		},
+		"empty passphrase": {
+			passphrase:  "\n",
+			expectedErr: keyfile.ErrEmptyPassphrase,
+		},
+		"data key": {
+			dataKeyCommand: "echo " + dataKey,
+		},
+		"short data key": {
+			dataKeyCommand: "echo " + base64.StdEncoding.EncodeToString([]byte("data key")),
+			expectedErr:    keyfile.ErrDataKeyTooShort,
+		},
+		"multiple secrets": {
+			passphrase:     "passphrase",
+			dataKeyCommand: "echo " + dataKey,
+			expectedErr:    errMultipleStakingKeySecrets,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			if test.passphrase != "" {
+				passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+				require.NoError(os.WriteFile(passphraseFile, []byte(test.passphrase), perms.ReadWrite))
+				v.Set(StakingKeyEncryptionPassphraseFileKey, passphraseFile)
+			}
+			v.Set(StakingKeyEncryptionDataKeyCommandKey, test.dataKeyCommand)
+
+			secret, err := getStakingKeySecret(v)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.expectedNil, secret == nil)
+			}
+		})
+	}
+}
+
+func TestGetStakingSignerEncrypted(t *testing.T) {
+	require := require.New(t)
+
+	secret, err := keyfile.NewPassphrase([]byte("passphrase"))
+	require.NoError(err)
+
+	v := setupViperFlags()
+	signerKeyPath := filepath.Join(t.TempDir(), "signer.key")
+	v.Set(StakingSignerKeyPathKey, signerKeyPath)
+
+	// An unencrypted key is encrypted in place.
+	key, err := localsigner.New()
+	require.NoError(err)
+	require.NoError(os.WriteFile(signerKeyPath, key.ToBytes(), perms.ReadOnly))
+
+	loadedKey, err := getStakingSigner(v, secret)
+	require.NoError(err)
+	require.Equal(key.ToBytes(), loadedKey.(*localsigner.LocalSigner).ToBytes())
+
+	signerKeyBytes, err := os.ReadFile(signerKeyPath)
+	require.NoError(err)
+	require.True(keyfile.IsEncrypted(signerKeyBytes))
+
+	// The encrypted key can only be loaded with the secret.
+	loadedKey, err = getStakingSigner(v, secret)
+	require.NoError(err)
+	require.Equal(key.ToBytes(), loadedKey.(*localsigner.LocalSigner).ToBytes())
+
+	_, err = getStakingSigner(v, nil)
+	require.ErrorIs(err, errStakingKeyEncrypted)
+
+	wrongSecret, err := keyfile.NewPassphrase([]byte("wrong passphrase"))
+	require.NoError(err)
+	_, err = getStakingSigner(v, wrongSecret)
+	require.ErrorIs(err, keyfile.ErrDecryptionFailed)
+}
+
+func TestGetStakingTLSCertEncrypted(t *testing.T) {
+	require := require.New(t)
+
+	secret, err := keyfile.NewPassphrase([]byte("passphrase"))
+	require.NoError(err)
+
+	v := setupViperFlags()
+	dir := t.TempDir()
+	stakingKeyPath := filepath.Join(dir, "staker.key")
+	v.Set(StakingTLSKeyPathKey, stakingKeyPath)
+	v.Set(StakingCertPathKey, filepath.Join(dir, "staker.crt"))
+
+	// Keys that are generated are encrypted.
+	require.NoError(staking.InitNodeStakingKeyPair(stakingKeyPath, filepath.Join(dir, "staker.crt"), secret))
+	stakingKeyBytes, err := os.ReadFile(stakingKeyPath)
+	require.NoError(err)
+	require.True(keyfile.IsEncrypted(stakingKeyBytes))
+
+	cert, err := getStakingTLSCert(v, secret)
+	require.NoError(err)
+	require.NotNil(cert.Leaf)
+
+	_, err = getStakingTLSCert(v, nil)
+	require.ErrorIs(err, errStakingKeyEncrypted)
+}
+
+func TestGetConsensusGuardrailViolations(t *testing.T) {
+	tests := map[string]struct {
+		networkID          uint32
+		beta               int
+		minBlockDelay      time.Duration
+		allowUnsafe        bool
+		expectedViolations []string
+		expectedErr        error
+	}{
+		"mainnet default": {
+			networkID: constants.MainnetID,
+			beta:      20,
+		},
+		"mainnet unsafe": {
+			networkID:   constants.MainnetID,
+			beta:        5,
+			expectedErr: errUnsafeConsensusConfig,
+		},
+		"mainnet allow unsafe": {
+			networkID:   constants.MainnetID,
+			beta:        20,
+			allowUnsafe: true,
+			expectedErr: errUnsafeConsensusOnMainnet,
+		},
+		"fuji allow unsafe": {
+			networkID:          constants.FujiID,
+			beta:               5,
+			allowUnsafe:        true,
+			expectedViolations: []string{"snow-commit-threshold (5) < 20"},
+		},
+		"local low beta": {
+			networkID: constants.LocalID,
+			beta:      5,
+		},
+		"local long min block delay": {
+			networkID:          constants.LocalID,
+			beta:               20,
+			minBlockDelay:      5 * time.Second,
+			expectedViolations: []string{"proposervm-min-block-delay (5s) >= proposer window (5s)"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(SnowCommitThresholdKey, test.beta)
+			v.Set(SnowConcurrentRepollsKey, 1)
+			v.Set(ConsensusAllowUnsafeConfigKey, test.allowUnsafe)
+			if test.minBlockDelay != 0 {
+				v.Set(ProposerVMMinBlockDelayKey, test.minBlockDelay)
+			}
+
+			timeoutConfig, err := getAdaptiveTimeoutConfig(v)
+			require.NoError(err)
+
+			violations, err := getConsensusGuardrailViolations(
+				v,
+				test.networkID,
+				getDefaultSubnetConfig(v),
+				timeoutConfig,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedViolations, violations)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
@@ -17,8 +18,12 @@ import (
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/pebbledb"
 	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/dynamicip"
@@ -27,6 +32,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/proposervm"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime/subprocess"
 )
 
 const (
@@ -83,6 +89,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	// Plugin directory
 	fs.String(PluginDirKey, defaultPluginDir, "Path to the plugin directory")
+	fs.Uint64(PluginVMMaxRSSKey, 0, "Max resident set size, in bytes, of each plugin VM process. A process that exceeds it is killed. If 0, the RSS isn't limited")
+	fs.Uint64(PluginVMCPUSharesKey, 0, fmt.Sprintf("CPU weight of each plugin VM process relative to %d, the weight of the node. Only supported on Linux, and values above %d require CAP_SYS_NICE. If 0, the weight isn't changed", subprocess.DefaultCPUShares, subprocess.DefaultCPUShares))
+	fs.Int(PluginVMMaxRestartsKey, 5, "Number of times a chain is restarted after its plugin VM process exits unexpectedly. If 0, chains aren't restarted")
+	fs.Duration(PluginVMRestartInitialBackoffKey, time.Second, "Time to wait before restarting a chain after its plugin VM process exits unexpectedly. Doubled after each restart")
+	fs.Duration(PluginVMRestartMaxBackoffKey, time.Minute, "Max time to wait before restarting a chain after its plugin VM process exits unexpectedly")
 
 	// Config File
 	fs.String(ConfigFileKey, "", fmt.Sprintf("Specifies a config file. Ignored if %s is specified", ConfigContentKey))
@@ -117,11 +128,17 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Uint64(DynamicFeesDBReadWeightKey, genesis.LocalParams.DynamicFeeConfig.Weights[gas.DBRead], "Complexity multiplier used to convert DB Reads into Gas")
 	fs.Uint64(DynamicFeesDBWriteWeightKey, genesis.LocalParams.DynamicFeeConfig.Weights[gas.DBWrite], "Complexity multiplier used to convert DB Writes into Gas")
 	fs.Uint64(DynamicFeesComputeWeightKey, genesis.LocalParams.DynamicFeeConfig.Weights[gas.Compute], "Complexity multiplier used to convert Compute into Gas")
+	fs.Uint64(DynamicFeesStateGrowthWeightKey, genesis.LocalParams.DynamicFeeConfig.Weights[gas.StateGrowth], "Complexity multiplier used to convert State Growth into Gas. State growth is only charged after Fortuna")
 	fs.Uint64(DynamicFeesMaxGasCapacityKey, uint64(genesis.LocalParams.DynamicFeeConfig.MaxCapacity), "Maximum amount of Gas the chain is allowed to store for future use")
 	fs.Uint64(DynamicFeesMaxGasPerSecondKey, uint64(genesis.LocalParams.DynamicFeeConfig.MaxPerSecond), "Rate at which Gas is stored for future use")
 	fs.Uint64(DynamicFeesTargetGasPerSecondKey, uint64(genesis.LocalParams.DynamicFeeConfig.TargetPerSecond), "Target rate of Gas usage")
 	fs.Uint64(DynamicFeesMinGasPriceKey, uint64(genesis.LocalParams.DynamicFeeConfig.MinPrice), "Minimum Gas price")
 	fs.Uint64(DynamicFeesExcessConversionConstantKey, uint64(genesis.LocalParams.DynamicFeeConfig.ExcessConversionConstant), "Constant to convert excess Gas to the Gas price")
+	fs.Uint64(DynamicFeesSubnetAuthBandwidthWeightKey, 0, "Complexity multiplier used to convert the Bandwidth of subnet governance txs into Gas. If all subnet governance weights are 0, the standard weights are used")
+	fs.Uint64(DynamicFeesSubnetAuthDBReadWeightKey, 0, "Complexity multiplier used to convert the DB Reads of subnet governance txs into Gas. If all subnet governance weights are 0, the standard weights are used")
+	fs.Uint64(DynamicFeesSubnetAuthDBWriteWeightKey, 0, "Complexity multiplier used to convert the DB Writes of subnet governance txs into Gas. If all subnet governance weights are 0, the standard weights are used")
+	fs.Uint64(DynamicFeesSubnetAuthComputeWeightKey, 0, "Complexity multiplier used to convert the Compute of subnet governance txs into Gas. If all subnet governance weights are 0, the standard weights are used")
+	fs.Uint64(DynamicFeesSubnetAuthStateGrowthWeightKey, 0, "Complexity multiplier used to convert the State Growth of subnet governance txs into Gas. If all subnet governance weights are 0, the standard weights are used")
 	// Static fees:
 	fs.Uint64(TxFeeKey, genesis.LocalParams.TxFee, "Transaction fee, in nAVAX")
 	fs.Uint64(CreateAssetTxFeeKey, genesis.LocalParams.CreateAssetTxFee, "Transaction fee, in nAVAX, for transactions that create new assets")
@@ -150,8 +167,10 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	// Public IP Resolution
 	fs.String(PublicIPKey, "", "Public IP of this node for P2P communication")
+	fs.String(PublicIPSecondaryKey, "", "Public IP of this node for P2P communication of the other address family than the public IP. When provided, peers are told about both the IPv4 and the IPv6 address of this node")
 	fs.Duration(PublicIPResolutionFreqKey, 5*time.Minute, "Frequency at which this node resolves/updates its public IP and renew NAT mappings, if applicable")
-	fs.String(PublicIPResolutionServiceKey, "", fmt.Sprintf("Only acceptable values are %q, %q or %q. When provided, the node will use that service to periodically resolve/update its public IP", dynamicip.OpenDNSName, dynamicip.IFConfigCoName, dynamicip.IFConfigMeName))
+	fs.String(PublicIPResolutionServiceKey, "", fmt.Sprintf("Only acceptable values are %q, %q, %q or %q. When provided, the node will use that service to periodically resolve/update its public IP", dynamicip.OpenDNSName, dynamicip.IFConfigCoName, dynamicip.IFConfigMeName, dynamicip.STUNName))
+	fs.String(PublicIPResolutionSTUNServersKey, strings.Join(dynamicip.DefaultSTUNServers, ","), fmt.Sprintf("Comma separated list of STUN servers, as host:port, used to resolve the public IP if --%s is %q, or if neither --%s nor --%s is provided. The IP reported by a majority of the servers is used. If empty, the public IP is only resolved with NAT", PublicIPResolutionServiceKey, dynamicip.STUNName, PublicIPKey, PublicIPResolutionServiceKey))
 
 	// Inbound Connection Throttling
 	fs.Duration(NetworkInboundConnUpgradeThrottlerCooldownKey, constants.DefaultInboundConnUpgradeThrottlerCooldown, "Upgrade an inbound connection from a given IP at most once per this duration. If 0, don't rate-limit inbound connection upgrades")
@@ -178,6 +197,10 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// based on the networkID.
 	fs.Bool(NetworkAllowPrivateIPsKey, false, fmt.Sprintf("Allows the node to initiate outbound connection attempts to peers with private IPs. If the provided --%s is one of [%s, %s] the default is false. Oterhwise, the default is true", NetworkNameKey, constants.MainnetName, constants.FujiName))
 	fs.Bool(NetworkRequireValidatorToConnectKey, constants.DefaultNetworkRequireValidatorToConnect, "If true, this node will only maintain a connection with another node if this node is a validator, the other node is a validator, or the other node is a beacon")
+	fs.String(NetworkPinnedPeersKey, "", "Comma separated list of peers this node always maintains a connection with, as nodeID@ip:port. Example: NodeID-JR4dVmy6ffUGAKCBDkyCbeZbyHQBeDsET@127.0.0.1:9651")
+	fs.String(NetworkAllowedNodeIDsKey, "", "Comma separated list of node IDs. If non-empty, this node will only connect to these nodes and the pinned peers")
+	fs.String(NetworkDeniedNodeIDsKey, "", "Comma separated list of node IDs this node will never connect to")
+	fs.String(NetworkDialPreferenceKey, string(network.DialPreferencePrimary), fmt.Sprintf("IP to dial when a peer advertises both an IPv4 and an IPv6 address. Only acceptable values are %q, %q or %q", network.DialPreferencePrimary, network.DialPreferenceIPv4, network.DialPreferenceIPv6))
 	fs.Uint(NetworkPeerReadBufferSizeKey, constants.DefaultNetworkPeerReadBufferSize, "Size, in bytes, of the buffer that we read peer messages into (there is one buffer per peer)")
 	fs.Uint(NetworkPeerWriteBufferSizeKey, constants.DefaultNetworkPeerWriteBufferSize, "Size, in bytes, of the buffer that we write peer messages into (there is one buffer per peer)")
 
@@ -190,6 +213,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(NetworkTCPProxyReadTimeoutKey, constants.DefaultNetworkTCPProxyReadTimeout, "Maximum duration to wait for a TCP proxy header")
 
 	fs.String(NetworkTLSKeyLogFileKey, "", "TLS key log file path. Should only be specified for debugging")
+	fs.Duration(NetworkTLSTransportCertRotationFrequencyKey, 0, "Frequency at which the certificate presented during TLS handshakes is rotated. The node ID remains derived from the staking certificate. If 0, the staking certificate is presented")
 
 	// Benchlist
 	fs.Int(BenchlistFailThresholdKey, constants.DefaultBenchlistFailThreshold, "Number of consecutive failed queries before benchlisting a node")
@@ -198,8 +222,13 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	// Router
 	fs.Uint(ConsensusAppConcurrencyKey, constants.DefaultConsensusAppConcurrency, "Maximum number of goroutines to use when handling App messages on a chain")
+	fs.Uint(ConsensusMaxUnprocessedMsgsKey, constants.DefaultConsensusMaxUnprocessedMsgs, "Number of unprocessed messages on a chain after which bulk messages (GetAncestors, Ancestors and AppGossip) are dropped. If 0, messages are never dropped")
 	fs.Duration(ConsensusShutdownTimeoutKey, constants.DefaultConsensusShutdownTimeout, "Timeout before killing an unresponsive chain")
 	fs.Duration(ConsensusFrontierPollFrequencyKey, constants.DefaultFrontierPollFrequency, "Frequency of polling for new consensus frontiers")
+	fs.Duration(ConsensusAppGossipDedupWindowKey, constants.DefaultConsensusAppGossipDedupWindow, "Minimum duration between sending the same App gossip message to the same peer. If 0, App gossip isn't deduplicated")
+	fs.Uint(ConsensusAppGossipDedupSizeKey, constants.DefaultConsensusAppGossipDedupSize, "Number of recently gossiped peer and App gossip message pairs remembered per subnet")
+	fs.Duration(ConsensusBlockVerificationTimeoutKey, constants.DefaultConsensusBlockVerificationTimeout, "Maximum duration of the verification of a block. Blocks that time out are retried later. If 0, block verification isn't bounded")
+	fs.Bool(ConsensusAllowUnsafeConfigKey, false, "If true, the node starts even if its consensus config violates the guardrails of its network, and only warns about the violations. Can't be set on mainnet")
 
 	// Inbound Throttling
 	fs.Uint64(InboundThrottlerAtLargeAllocSizeKey, constants.DefaultInboundThrottlerAtLargeAllocSize, "Size, in bytes, of at-large byte allocation in inbound message throttler")
@@ -235,6 +264,9 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	// Enable/Disable APIs
 	fs.Bool(AdminAPIEnabledKey, false, "If true, this node exposes the Admin API")
+	fs.Bool(AdminAPIAuditLogEnabledKey, false, "If true, every call to the Admin API is recorded in a hash chained audit log")
+	fs.Int(AdminAPIAuditLogMaxSizeKey, 8, "Max size, in megabytes, of the audit log file before it is rotated")
+	fs.Int(AdminAPIAuditLogMaxFilesKey, 0, "Max number of rotated audit log files that are kept. If 0, all of them are kept")
 	fs.Bool(InfoAPIEnabledKey, true, "If true, this node exposes the Info API")
 	fs.Bool(MetricsAPIEnabledKey, true, "If true, this node exposes the Metrics API")
 	fs.Bool(HealthAPIEnabledKey, true, "If true, this node exposes the Health API")
@@ -242,6 +274,15 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// Health Checks
 	fs.Duration(HealthCheckFreqKey, 30*time.Second, "Time between health checks")
 	fs.Duration(HealthCheckAveragerHalflifeKey, constants.DefaultHealthCheckAveragerHalflife, "Halflife of averager when calculating a running average in a health check")
+	// Clock Skew Health
+	fs.Duration(ClockSkewMaxKey, 2*time.Second, "Clock skew health check returns unhealthy if the local clock is offset by more than this much time from the NTP servers or, if they are unavailable, from peers")
+	fs.String(ClockSkewNTPServersKey, strings.Join(clockskew.DefaultNTPServers, ","), "Comma separated list of NTP servers, as host:port, used to measure the offset of the local clock. If empty, the offset is only measured against peers")
+	fs.Duration(ClockSkewNTPFrequencyKey, 5*time.Minute, "Frequency at which the NTP servers are queried")
+	// Update Advisory
+	fs.String(UpdateAdvisoryURLKey, "", "URL of the signed update advisory manifest listing the mandatory upgrades of the network. If empty, the update advisory is disabled")
+	fs.StringSlice(UpdateAdvisoryTrustedPublishersKey, nil, fmt.Sprintf("Addresses of the keys trusted to sign the manifest served by --%s and the release manifests passed to info.verifyBuild", UpdateAdvisoryURLKey))
+	fs.Duration(UpdateAdvisoryFrequencyKey, time.Hour, "Frequency at which the update advisory manifest is fetched")
+	fs.Duration(UpdateAdvisoryWarningPeriodKey, 7*24*time.Hour, "Update advisory health check returns unhealthy if a mandatory upgrade that isn't supported by this node activates within this much time")
 	// Network Layer Health
 	fs.Duration(NetworkHealthMaxTimeSinceMsgSentKey, constants.DefaultNetworkHealthMaxTimeSinceMsgSent, "Network layer returns unhealthy if haven't sent a message for at least this much time")
 	fs.Duration(NetworkHealthMaxTimeSinceMsgReceivedKey, constants.DefaultNetworkHealthMaxTimeSinceMsgReceived, "Network layer returns unhealthy if haven't received a message for at least this much time")
@@ -264,8 +305,13 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Bool(StakingEphemeralSignerEnabledKey, false, "If true, the node uses an ephemeral staking signer key")
 	fs.String(StakingSignerKeyPathKey, defaultStakingSignerKeyPath, fmt.Sprintf("Path to the signer private key for staking. Ignored if %s is specified", StakingSignerKeyContentKey))
 	fs.String(StakingSignerKeyContentKey, "", "Specifies base64 encoded signer private key for staking")
+	fs.String(StakingKeyEncryptionPassphraseFileKey, "", "Path to a file containing the passphrase that the staking TLS key and signer key are encrypted with. Unencrypted keys are encrypted in place")
+	fs.Bool(StakingKeyEncryptionPassphrasePromptKey, false, "If true, the passphrase that the staking TLS key and signer key are encrypted with is read from the terminal. Unencrypted keys are encrypted in place")
+	fs.String(StakingKeyEncryptionDataKeyCommandKey, "", "Command, with whitespace separated arguments, that prints the base64 encoded data key that the staking TLS key and signer key are encrypted with, such as a command that decrypts a data key with a KMS. Unencrypted keys are encrypted in place")
 	fs.Bool(SybilProtectionEnabledKey, true, "Enables sybil protection. If enabled, Network TLS is required")
 	fs.Uint64(SybilProtectionDisabledWeightKey, 100, "Weight to provide to each peer when sybil protection is disabled")
+	fs.String(MaintenanceWindowStartKey, "", fmt.Sprintf("RFC3339 start time of a planned maintenance window to announce to the other validators. Ignored if %s is 0", MaintenanceWindowDurationKey))
+	fs.Duration(MaintenanceWindowDurationKey, 0, fmt.Sprintf("Duration of the planned maintenance window starting at %s. Must not exceed %s", MaintenanceWindowStartKey, uptime.MaxMaintenanceWindowDuration))
 	fs.Bool(PartialSyncPrimaryNetworkKey, false, "Only sync the P-chain on the Primary Network. If the node is a Primary Network validator, it will report unhealthy")
 	// Uptime Requirement
 	fs.Float64(UptimeRequirementKey, genesis.LocalParams.UptimeRequirement, "Fraction of time a validator must be online to receive rewards")
@@ -300,6 +346,14 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(BootstrapMaxTimeGetAncestorsKey, 50*time.Millisecond, "Max Time to spend fetching a container and its ancestors when responding to a GetAncestors")
 	fs.Uint(BootstrapAncestorsMaxContainersSentKey, 2000, "Max number of containers in an Ancestors message sent by this node")
 	fs.Uint(BootstrapAncestorsMaxContainersReceivedKey, 2000, "This node reads at most this many containers from an incoming Ancestors message")
+	fs.String(BootstrapDNSSeedsKey, "", "Comma separated list of domain names whose TXT and SRV records list additional bootstrap peers. Example: seeds.example.com")
+	fs.Duration(BootstrapDNSSeedsRefreshFreqKey, 10*time.Minute, "Frequency at which the bootstrap DNS seeds are resolved again to discover new bootstrap peers")
+	fs.Uint(BootstrapMaxConcurrentChainsKey, 0, "Max number of chains to bootstrap concurrently. If 0, all chains bootstrap concurrently. Otherwise, the P-chain is bootstrapped first, then the X-chain and C-chain, then the chains of other subnets")
+
+	// Read replicas
+	fs.String(ReadReplicaChainIDsKey, "", "Comma separated list of Snowman chain IDs whose blocks are accepted from the index API of the read replica URIs, rather than by running consensus, once the chain is bootstrapped")
+	fs.String(ReadReplicaURIsKey, "", "Comma separated list of base URIs of trusted nodes that index the read replica chains. The next URI is used if a URI fails. Example: http://10.0.0.1:9650,http://10.0.0.2:9650")
+	fs.Duration(ReadReplicaPollFrequencyKey, 500*time.Millisecond, "Frequency at which the read replica URIs are polled for newly accepted blocks")
 
 	// Consensus
 	fs.Int(SnowSampleSizeKey, snowball.DefaultParameters.K, "Number of nodes to query for each network poll")
@@ -317,6 +371,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// ProposerVM
 	fs.Bool(ProposerVMUseCurrentHeightKey, false, "Have the ProposerVM always report the last accepted P-chain block height")
 	fs.Duration(ProposerVMMinBlockDelayKey, proposervm.DefaultMinBlockDelay, "Minimum delay to enforce when building a snowman++ block for the primary network chains and the default minimum delay for subnets")
+	fs.Duration(ProposerVMMaxBlockDelayKey, 0, "Maximum delay to enforce when building a snowman++ block while recently accepted blocks are underutilized. Ignored unless larger than the minimum delay. Applies to the primary network chains and is the default maximum delay for subnets")
 
 	// Metrics
 	fs.Bool(MeterVMsEnabledKey, true, "Enable Meter VMs to track VM performance with more granularity")
@@ -325,6 +380,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// Indexer
 	fs.Bool(IndexEnabledKey, false, "If true, index all accepted containers and transactions and expose them via an API")
 	fs.Bool(IndexAllowIncompleteKey, false, "If true, allow running the node in such a way that could cause an index to miss transactions. Ignored if index is disabled")
+	fs.String(IndexExportSinkKey, "", fmt.Sprintf("Sink that accepted containers of the index are exported to. If empty, containers aren't exported. Must be one of {%q, %q}", indexer.SinkWebhook, indexer.SinkKafka))
+	fs.String(IndexExportURLKey, "", fmt.Sprintf("URL of the index export sink. The URL of a webhook receives the containers in POST requests. The URL of %q is the base URL of a Kafka REST proxy", indexer.SinkKafka))
+	fs.String(IndexExportKafkaTopicKey, "", fmt.Sprintf("Kafka topic that accepted containers are produced to. Ignored unless %s is %q", IndexExportSinkKey, indexer.SinkKafka))
+	fs.Int(IndexExportBatchSizeKey, 64, fmt.Sprintf("Maximum number of containers published to the index export sink at a time. Must be in [1, %d]", indexer.MaxFetchedByRange))
+	fs.Duration(IndexExportRetryFrequencyKey, 5*time.Second, "Time to wait before publishing containers to the index export sink again after a failure")
 
 	// Config Directories
 	fs.String(ChainConfigDirKey, defaultChainConfigDir, fmt.Sprintf("Chain specific configurations parent directory. Ignored if %s is specified", ChainConfigContentKey))
@@ -334,6 +394,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	// Chain Data Directory
 	fs.String(ChainDataDirKey, defaultChainDataDir, "Chain specific data directory")
+	fs.Bool(PruneRetiredChainsKey, false, "If true, the database and chain data directory of retired chains are deleted")
 
 	// Profiles
 	fs.String(ProfileDirKey, defaultProfileDir, "Path to the profile directory")
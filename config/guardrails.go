@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/timer"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
+)
+
+var (
+	errUnsafeConsensusConfig    = errors.New("consensus config violates the network's guardrails")
+	errUnsafeConsensusOnMainnet = fmt.Errorf("%s can't be set on mainnet", ConsensusAllowUnsafeConfigKey)
+
+	productionGuardrails = guardrails{
+		minK:                    20,
+		minAlphaConfidenceRatio: .75,
+		minBeta:                 20,
+	}
+
+	// networkGuardrails are the guardrails of the networks that refuse to
+	// start a node that violates them. Nodes of other networks only warn
+	// about violations.
+	networkGuardrails = map[uint32]guardrails{
+		constants.MainnetID: productionGuardrails,
+		constants.FujiID:    productionGuardrails,
+	}
+)
+
+// guardrails bound the consensus config of a node, so that misconfigured nodes
+// don't undermine the safety or liveness assumptions of their network.
+type guardrails struct {
+	minK int
+	// Minimum alphaConfidence / k
+	minAlphaConfidenceRatio float64
+	minBeta                 int
+}
+
+// violations returns a description of each way in which the consensus config
+// of the primary network violates [g].
+//
+// Besides the bounds of [g], the network timeouts must be shorter than the
+// maximum processing time of a block, so that a poll can time out and be
+// retried before the block is reported as unhealthy, and the proposervm must
+// be able to build a block within its proposer window.
+func (g guardrails) violations(
+	primaryNetworkConfig subnets.Config,
+	timeoutConfig timer.AdaptiveTimeoutConfig,
+) []string {
+	var (
+		params     = primaryNetworkConfig.ConsensusParameters
+		violations []string
+	)
+	if params.K < g.minK {
+		violations = append(violations, fmt.Sprintf("%s (%d) < %d", SnowSampleSizeKey, params.K, g.minK))
+	}
+	if ratio := float64(params.AlphaConfidence) / float64(params.K); ratio < g.minAlphaConfidenceRatio {
+		violations = append(violations, fmt.Sprintf("%s / %s (%.2f) < %.2f", SnowConfidenceQuorumSizeKey, SnowSampleSizeKey, ratio, g.minAlphaConfidenceRatio))
+	}
+	if params.Beta < g.minBeta {
+		violations = append(violations, fmt.Sprintf("%s (%d) < %d", SnowCommitThresholdKey, params.Beta, g.minBeta))
+	}
+	if timeoutConfig.MaximumTimeout >= params.MaxItemProcessingTime {
+		violations = append(violations, fmt.Sprintf("%s (%s) >= %s (%s)", NetworkMaximumTimeoutKey, timeoutConfig.MaximumTimeout, SnowMaxTimeProcessingKey, params.MaxItemProcessingTime))
+	}
+	if primaryNetworkConfig.ProposerMinBlockDelay >= proposer.WindowDuration {
+		violations = append(violations, fmt.Sprintf("%s (%s) >= proposer window (%s)", ProposerVMMinBlockDelayKey, primaryNetworkConfig.ProposerMinBlockDelay, proposer.WindowDuration))
+	}
+	return violations
+}
+
+// getConsensusGuardrailViolations returns the guardrails of [networkID] that
+// are violated by the consensus config of the primary network.
+//
+// Violations are returned as an error on networks with guardrails, unless
+// ConsensusAllowUnsafeConfigKey is set, which isn't allowed on mainnet.
+func getConsensusGuardrailViolations(
+	v *viper.Viper,
+	networkID uint32,
+	primaryNetworkConfig subnets.Config,
+	timeoutConfig timer.AdaptiveTimeoutConfig,
+) ([]string, error) {
+	allowUnsafe := v.GetBool(ConsensusAllowUnsafeConfigKey)
+	if allowUnsafe && networkID == constants.MainnetID {
+		return nil, errUnsafeConsensusOnMainnet
+	}
+
+	g, enforced := networkGuardrails[networkID]
+	if !enforced {
+		// Local and custom networks are often run with few validators and
+		// short timeouts, so only the guardrails that aren't specific to a
+		// network are checked.
+		g = guardrails{}
+	}
+
+	violations := g.violations(primaryNetworkConfig, timeoutConfig)
+	if len(violations) > 0 && enforced && !allowUnsafe {
+		return nil, fmt.Errorf("%w: %s", errUnsafeConsensusConfig, strings.Join(violations, ", "))
+	}
+	return violations, nil
+}
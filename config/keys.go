@@ -8,60 +8,68 @@ package config
 const HTTPWriteTimeoutKey = "http-write-timeout" // #nosec G101
 
 const (
-	DataDirKey                               = "data-dir"
-	ConfigFileKey                            = "config-file"
-	ConfigContentKey                         = "config-file-content"
-	ConfigContentTypeKey                     = "config-file-content-type"
-	VersionKey                               = "version"
-	VersionJSONKey                           = "version-json"
-	GenesisFileKey                           = "genesis-file"
-	GenesisFileContentKey                    = "genesis-file-content"
-	UpgradeFileKey                           = "upgrade-file"
-	UpgradeFileContentKey                    = "upgrade-file-content"
-	NetworkNameKey                           = "network-id"
-	ACPSupportKey                            = "acp-support"
-	ACPObjectKey                             = "acp-object"
-	DynamicFeesBandwidthWeightKey            = "dynamic-fees-bandwidth-weight"
-	DynamicFeesDBReadWeightKey               = "dynamic-fees-db-read-weight"
-	DynamicFeesDBWriteWeightKey              = "dynamic-fees-db-write-weight"
-	DynamicFeesComputeWeightKey              = "dynamic-fees-compute-weight"
-	DynamicFeesMaxGasCapacityKey             = "dynamic-fees-max-gas-capacity"
-	DynamicFeesMaxGasPerSecondKey            = "dynamic-fees-max-gas-per-second"
-	DynamicFeesTargetGasPerSecondKey         = "dynamic-fees-target-gas-per-second"
-	DynamicFeesMinGasPriceKey                = "dynamic-fees-min-gas-price"
-	DynamicFeesExcessConversionConstantKey   = "dynamic-fees-excess-conversion-constant"
-	ValidatorFeesCapacityKey                 = "validator-fees-capacity"
-	ValidatorFeesTargetKey                   = "validator-fees-target"
-	ValidatorFeesMinPriceKey                 = "validator-fees-min-price"
-	ValidatorFeesExcessConversionConstantKey = "validator-fees-excess-conversion-constant"
-	TxFeeKey                                 = "tx-fee"
-	CreateAssetTxFeeKey                      = "create-asset-tx-fee"
-	UptimeRequirementKey                     = "uptime-requirement"
-	MinValidatorStakeKey                     = "min-validator-stake"
-	MaxValidatorStakeKey                     = "max-validator-stake"
-	MinDelegatorStakeKey                     = "min-delegator-stake"
-	MinDelegatorFeeKey                       = "min-delegation-fee"
-	MinStakeDurationKey                      = "min-stake-duration"
-	MaxStakeDurationKey                      = "max-stake-duration"
-	StakeMaxConsumptionRateKey               = "stake-max-consumption-rate"
-	StakeMinConsumptionRateKey               = "stake-min-consumption-rate"
-	StakeMintingPeriodKey                    = "stake-minting-period"
-	StakeSupplyCapKey                        = "stake-supply-cap"
-	DBTypeKey                                = "db-type"
-	DBReadOnlyKey                            = "db-read-only"
-	DBPathKey                                = "db-dir"
-	DBConfigFileKey                          = "db-config-file"
-	DBConfigContentKey                       = "db-config-file-content"
-	PublicIPKey                              = "public-ip"
-	PublicIPResolutionFreqKey                = "public-ip-resolution-frequency"
-	PublicIPResolutionServiceKey             = "public-ip-resolution-service"
-	HTTPHostKey                              = "http-host"
-	HTTPPortKey                              = "http-port"
-	HTTPSEnabledKey                          = "http-tls-enabled"
-	HTTPSKeyFileKey                          = "http-tls-key-file"
-	HTTPSKeyContentKey                       = "http-tls-key-file-content"
-	HTTPSCertFileKey                         = "http-tls-cert-file"
-	HTTPSCertContentKey                      = "http-tls-cert-file-content"
+	DataDirKey                                = "data-dir"
+	ConfigFileKey                             = "config-file"
+	ConfigContentKey                          = "config-file-content"
+	ConfigContentTypeKey                      = "config-file-content-type"
+	VersionKey                                = "version"
+	VersionJSONKey                            = "version-json"
+	GenesisFileKey                            = "genesis-file"
+	GenesisFileContentKey                     = "genesis-file-content"
+	UpgradeFileKey                            = "upgrade-file"
+	UpgradeFileContentKey                     = "upgrade-file-content"
+	NetworkNameKey                            = "network-id"
+	ACPSupportKey                             = "acp-support"
+	ACPObjectKey                              = "acp-object"
+	DynamicFeesBandwidthWeightKey             = "dynamic-fees-bandwidth-weight"
+	DynamicFeesDBReadWeightKey                = "dynamic-fees-db-read-weight"
+	DynamicFeesDBWriteWeightKey               = "dynamic-fees-db-write-weight"
+	DynamicFeesComputeWeightKey               = "dynamic-fees-compute-weight"
+	DynamicFeesStateGrowthWeightKey           = "dynamic-fees-state-growth-weight"
+	DynamicFeesMaxGasCapacityKey              = "dynamic-fees-max-gas-capacity"
+	DynamicFeesMaxGasPerSecondKey             = "dynamic-fees-max-gas-per-second"
+	DynamicFeesTargetGasPerSecondKey          = "dynamic-fees-target-gas-per-second"
+	DynamicFeesMinGasPriceKey                 = "dynamic-fees-min-gas-price"
+	DynamicFeesExcessConversionConstantKey    = "dynamic-fees-excess-conversion-constant"
+	DynamicFeesSubnetAuthBandwidthWeightKey   = "dynamic-fees-subnet-auth-bandwidth-weight"
+	DynamicFeesSubnetAuthDBReadWeightKey      = "dynamic-fees-subnet-auth-db-read-weight"
+	DynamicFeesSubnetAuthDBWriteWeightKey     = "dynamic-fees-subnet-auth-db-write-weight"
+	DynamicFeesSubnetAuthComputeWeightKey     = "dynamic-fees-subnet-auth-compute-weight"
+	DynamicFeesSubnetAuthStateGrowthWeightKey = "dynamic-fees-subnet-auth-state-growth-weight"
+	ValidatorFeesCapacityKey                  = "validator-fees-capacity"
+	ValidatorFeesTargetKey                    = "validator-fees-target"
+	ValidatorFeesMinPriceKey                  = "validator-fees-min-price"
+	ValidatorFeesExcessConversionConstantKey  = "validator-fees-excess-conversion-constant"
+	TxFeeKey                                  = "tx-fee"
+	CreateAssetTxFeeKey                       = "create-asset-tx-fee"
+	UptimeRequirementKey                      = "uptime-requirement"
+	MinValidatorStakeKey                      = "min-validator-stake"
+	MaxValidatorStakeKey                      = "max-validator-stake"
+	MinDelegatorStakeKey                      = "min-delegator-stake"
+	MinDelegatorFeeKey                        = "min-delegation-fee"
+	MinStakeDurationKey                       = "min-stake-duration"
+	MaxStakeDurationKey                       = "max-stake-duration"
+	StakeMaxConsumptionRateKey                = "stake-max-consumption-rate"
+	StakeMinConsumptionRateKey                = "stake-min-consumption-rate"
+	StakeMintingPeriodKey                     = "stake-minting-period"
+	StakeSupplyCapKey                         = "stake-supply-cap"
+	DBTypeKey                                 = "db-type"
+	DBReadOnlyKey                             = "db-read-only"
+	DBPathKey                                 = "db-dir"
+	DBConfigFileKey                           = "db-config-file"
+	DBConfigContentKey                        = "db-config-file-content"
+	PublicIPKey                               = "public-ip"
+	PublicIPSecondaryKey                      = "public-ip-secondary"
+	PublicIPResolutionFreqKey                 = "public-ip-resolution-frequency"
+	PublicIPResolutionServiceKey              = "public-ip-resolution-service"
+	PublicIPResolutionSTUNServersKey          = "public-ip-resolution-stun-servers"
+	HTTPHostKey                               = "http-host"
+	HTTPPortKey                               = "http-port"
+	HTTPSEnabledKey                           = "http-tls-enabled"
+	HTTPSKeyFileKey                           = "http-tls-key-file"
+	HTTPSKeyContentKey                        = "http-tls-key-file-content"
+	HTTPSCertFileKey                          = "http-tls-cert-file"
+	HTTPSCertContentKey                       = "http-tls-cert-file-content"
 
 	HTTPAllowedOrigins       = "http-allowed-origins"
 	HTTPAllowedHostsKey      = "http-allowed-hosts"
@@ -85,8 +93,13 @@ const (
 	StakingEphemeralSignerEnabledKey                   = "staking-ephemeral-signer-enabled"
 	StakingSignerKeyPathKey                            = "staking-signer-key-file"
 	StakingSignerKeyContentKey                         = "staking-signer-key-file-content"
+	StakingKeyEncryptionPassphraseFileKey              = "staking-key-encryption-passphrase-file"
+	StakingKeyEncryptionPassphrasePromptKey            = "staking-key-encryption-passphrase-prompt"
+	StakingKeyEncryptionDataKeyCommandKey              = "staking-key-encryption-data-key-command"
 	SybilProtectionEnabledKey                          = "sybil-protection-enabled"
 	SybilProtectionDisabledWeightKey                   = "sybil-protection-disabled-weight"
+	MaintenanceWindowStartKey                          = "maintenance-window-start"
+	MaintenanceWindowDurationKey                       = "maintenance-window-duration"
 	NetworkInitialTimeoutKey                           = "network-initial-timeout"
 	NetworkMinimumTimeoutKey                           = "network-minimum-timeout"
 	NetworkMaximumTimeoutKey                           = "network-maximum-timeout"
@@ -111,11 +124,16 @@ const (
 	NetworkMaxClockDifferenceKey                       = "network-max-clock-difference"
 	NetworkAllowPrivateIPsKey                          = "network-allow-private-ips"
 	NetworkRequireValidatorToConnectKey                = "network-require-validator-to-connect"
+	NetworkPinnedPeersKey                              = "network-pinned-peers"
+	NetworkAllowedNodeIDsKey                           = "network-allowed-node-ids"
+	NetworkDeniedNodeIDsKey                            = "network-denied-node-ids"
+	NetworkDialPreferenceKey                           = "network-dial-preference"
 	NetworkPeerReadBufferSizeKey                       = "network-peer-read-buffer-size"
 	NetworkPeerWriteBufferSizeKey                      = "network-peer-write-buffer-size"
 	NetworkTCPProxyEnabledKey                          = "network-tcp-proxy-enabled"
 	NetworkTCPProxyReadTimeoutKey                      = "network-tcp-proxy-read-timeout"
 	NetworkTLSKeyLogFileKey                            = "network-tls-key-log-file-unsafe"
+	NetworkTLSTransportCertRotationFrequencyKey        = "network-tls-transport-cert-rotation-frequency"
 	NetworkInboundConnUpgradeThrottlerCooldownKey      = "network-inbound-connection-throttling-cooldown"
 	NetworkInboundThrottlerMaxConnsPerSecKey           = "network-inbound-connection-throttling-max-conns-per-sec"
 	NetworkOutboundConnectionThrottlingRpsKey          = "network-outbound-connection-throttling-rps"
@@ -145,28 +163,61 @@ const (
 	PartialSyncPrimaryNetworkKey                       = "partial-sync-primary-network"
 	TrackSubnetsKey                                    = "track-subnets"
 	AdminAPIEnabledKey                                 = "api-admin-enabled"
+	AdminAPIAuditLogEnabledKey                         = "api-admin-audit-log-enabled"
+	AdminAPIAuditLogMaxSizeKey                         = "api-admin-audit-log-max-size"
+	AdminAPIAuditLogMaxFilesKey                        = "api-admin-audit-log-max-files"
 	InfoAPIEnabledKey                                  = "api-info-enabled"
 	MetricsAPIEnabledKey                               = "api-metrics-enabled"
 	HealthAPIEnabledKey                                = "api-health-enabled"
 	MeterVMsEnabledKey                                 = "meter-vms-enabled"
 	ConsensusAppConcurrencyKey                         = "consensus-app-concurrency"
+	ConsensusMaxUnprocessedMsgsKey                     = "consensus-max-unprocessed-msgs"
 	ConsensusShutdownTimeoutKey                        = "consensus-shutdown-timeout"
 	ConsensusFrontierPollFrequencyKey                  = "consensus-frontier-poll-frequency"
+	ConsensusAppGossipDedupWindowKey                   = "consensus-app-gossip-dedup-window"
+	ConsensusAppGossipDedupSizeKey                     = "consensus-app-gossip-dedup-size"
+	ConsensusBlockVerificationTimeoutKey               = "consensus-block-verification-timeout"
+	ConsensusAllowUnsafeConfigKey                      = "consensus-allow-unsafe-config"
 	ProposerVMUseCurrentHeightKey                      = "proposervm-use-current-height"
 	ProposerVMMinBlockDelayKey                         = "proposervm-min-block-delay"
+	ProposerVMMaxBlockDelayKey                         = "proposervm-max-block-delay"
 	FdLimitKey                                         = "fd-limit"
 	IndexEnabledKey                                    = "index-enabled"
 	IndexAllowIncompleteKey                            = "index-allow-incomplete"
+	IndexExportSinkKey                                 = "index-export-sink"
+	IndexExportURLKey                                  = "index-export-url"
+	IndexExportKafkaTopicKey                           = "index-export-kafka-topic"
+	IndexExportBatchSizeKey                            = "index-export-batch-size"
+	IndexExportRetryFrequencyKey                       = "index-export-retry-frequency"
 	RouterHealthMaxDropRateKey                         = "router-health-max-drop-rate"
 	RouterHealthMaxOutstandingRequestsKey              = "router-health-max-outstanding-requests"
 	HealthCheckFreqKey                                 = "health-check-frequency"
 	HealthCheckAveragerHalflifeKey                     = "health-check-averager-halflife"
+	ClockSkewMaxKey                                    = "clock-skew-max"
+	ClockSkewNTPServersKey                             = "clock-skew-ntp-servers"
+	ClockSkewNTPFrequencyKey                           = "clock-skew-ntp-frequency"
+	UpdateAdvisoryURLKey                               = "update-advisory-url"
+	UpdateAdvisoryTrustedPublishersKey                 = "update-advisory-trusted-publishers"
+	UpdateAdvisoryFrequencyKey                         = "update-advisory-frequency"
+	UpdateAdvisoryWarningPeriodKey                     = "update-advisory-warning-period"
 	PluginDirKey                                       = "plugin-dir"
+	PluginVMMaxRSSKey                                  = "plugin-vm-max-rss"
+	PluginVMCPUSharesKey                               = "plugin-vm-cpu-shares"
+	PluginVMMaxRestartsKey                             = "plugin-vm-max-restarts"
+	PluginVMRestartInitialBackoffKey                   = "plugin-vm-restart-initial-backoff"
+	PluginVMRestartMaxBackoffKey                       = "plugin-vm-restart-max-backoff"
 	BootstrapBeaconConnectionTimeoutKey                = "bootstrap-beacon-connection-timeout"
 	BootstrapMaxTimeGetAncestorsKey                    = "bootstrap-max-time-get-ancestors"
 	BootstrapAncestorsMaxContainersSentKey             = "bootstrap-ancestors-max-containers-sent"
 	BootstrapAncestorsMaxContainersReceivedKey         = "bootstrap-ancestors-max-containers-received"
+	BootstrapMaxConcurrentChainsKey                    = "bootstrap-max-concurrent-chains"
+	BootstrapDNSSeedsKey                               = "bootstrap-dns-seeds"
+	BootstrapDNSSeedsRefreshFreqKey                    = "bootstrap-dns-seeds-refresh-frequency"
+	ReadReplicaChainIDsKey                             = "read-replica-chain-ids"
+	ReadReplicaURIsKey                                 = "read-replica-uris"
+	ReadReplicaPollFrequencyKey                        = "read-replica-poll-frequency"
 	ChainDataDirKey                                    = "chain-data-dir"
+	PruneRetiredChainsKey                              = "prune-retired-chains"
 	ChainConfigDirKey                                  = "chain-config-dir"
 	ChainConfigContentKey                              = "chain-config-content"
 	SubnetConfigDirKey                                 = "subnet-config-dir"
@@ -16,14 +16,18 @@ import (
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/profiler"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer"
+	"github.com/ava-labs/avalanchego/version/advisory"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime/subprocess"
 )
 
 type APIIndexerConfig struct {
@@ -56,12 +60,25 @@ type APIConfig struct {
 	InfoAPIEnabled    bool `json:"infoAPIEnabled"`
 	MetricsAPIEnabled bool `json:"metricsAPIEnabled"`
 	HealthAPIEnabled  bool `json:"healthAPIEnabled"`
+
+	// If true, calls to the Admin API are recorded in an audit log
+	AdminAPIAuditLogEnabled bool `json:"adminAPIAuditLogEnabled"`
+	// Max size, in megabytes, of the audit log file before it is rotated
+	AdminAPIAuditLogMaxSize int `json:"adminAPIAuditLogMaxSize"`
+	// Max number of rotated audit log files that are kept, or 0 to keep all
+	// of them
+	AdminAPIAuditLogMaxFiles int `json:"adminAPIAuditLogMaxFiles"`
 }
 
 type IPConfig struct {
 	PublicIP                  string        `json:"publicIP"`
 	PublicIPResolutionService string        `json:"publicIPResolutionService"`
 	PublicIPResolutionFreq    time.Duration `json:"publicIPResolutionFreq"`
+	// STUN servers used to resolve the public IP, formatted as host:port.
+	PublicIPResolutionSTUNServers []string `json:"publicIPResolutionSTUNServers"`
+	// PublicIPSecondary is optionally advertised alongside the public IP. It
+	// must be of the other address family than the public IP.
+	PublicIPSecondary string `json:"publicIPSecondary"`
 	// The host portion of the address to listen on. The port to
 	// listen on will be sourced from IPPort.
 	//
@@ -78,6 +95,8 @@ type StakingConfig struct {
 	StakingTLSCert                tls.Certificate `json:"-"`
 	StakingSigningKey             bls.Signer      `json:"-"`
 	SybilProtectionDisabledWeight uint64          `json:"sybilProtectionDisabledWeight"`
+	// Planned maintenance window announced to the other validators
+	MaintenanceWindow uptime.MaintenanceWindow `json:"maintenanceWindow"`
 	// not accessed but used for logging
 	StakingKeyPath    string `json:"stakingKeyPath"`
 	StakingCertPath   string `json:"stakingCertPath"`
@@ -104,7 +123,47 @@ type BootstrapConfig struct {
 	// ancestors while responding to a GetAncestors message
 	BootstrapMaxTimeGetAncestors time.Duration `json:"bootstrapMaxTimeGetAncestors"`
 
+	// Max number of chains to bootstrap concurrently. If 0, there is no limit.
+	BootstrapMaxConcurrentChains int `json:"bootstrapMaxConcurrentChains"`
+
 	Bootstrappers []genesis.Bootstrapper `json:"bootstrappers"`
+
+	// Domain names whose DNS records list additional bootstrappers
+	BootstrapDNSSeeds []string `json:"bootstrapDNSSeeds"`
+
+	// Frequency at which [BootstrapDNSSeeds] are resolved again
+	BootstrapDNSSeedsRefreshFreq time.Duration `json:"bootstrapDNSSeedsRefreshFreq"`
+}
+
+type ReadReplicaConfig struct {
+	// Snowman chains that accept the blocks accepted by [ReadReplicaURIs]
+	// rather than running consensus
+	ReadReplicaChainIDs set.Set[ids.ID] `json:"readReplicaChainIDs"`
+
+	// Base URIs of the trusted nodes whose index API is followed
+	ReadReplicaURIs []string `json:"readReplicaURIs"`
+
+	// Frequency at which [ReadReplicaURIs] are polled for newly accepted
+	// blocks
+	ReadReplicaPollFrequency time.Duration `json:"readReplicaPollFrequency"`
+}
+
+type IndexExportConfig struct {
+	// Sink that accepted containers are exported to, or empty if containers
+	// aren't exported
+	IndexExportSink string `json:"indexExportSink"`
+
+	// URL of [IndexExportSink]
+	IndexExportURL string `json:"indexExportURL"`
+
+	// Kafka topic that accepted containers are produced to
+	IndexExportKafkaTopic string `json:"indexExportKafkaTopic"`
+
+	// Maximum number of containers published to [IndexExportSink] at a time
+	IndexExportBatchSize int `json:"indexExportBatchSize"`
+
+	// Time to wait before publishing containers again after a failure
+	IndexExportRetryFrequency time.Duration `json:"indexExportRetryFrequency"`
 }
 
 type DatabaseConfig struct {
@@ -129,6 +188,8 @@ type Config struct {
 	genesis.TxFeeConfig `json:"txFeeConfig"`
 	StateSyncConfig     `json:"stateSyncConfig"`
 	BootstrapConfig     `json:"bootstrapConfig"`
+	ReadReplicaConfig   `json:"readReplicaConfig"`
+	IndexExportConfig   `json:"indexExportConfig"`
 	DatabaseConfig      `json:"databaseConfig"`
 
 	UpgradeConfig upgrade.Config `json:"upgradeConfig"`
@@ -141,7 +202,9 @@ type Config struct {
 	NetworkID uint32 `json:"networkID"`
 
 	// Health
-	HealthCheckFreq time.Duration `json:"healthCheckFreq"`
+	HealthCheckFreq      time.Duration    `json:"healthCheckFreq"`
+	ClockSkewConfig      clockskew.Config `json:"clockSkewConfig"`
+	UpdateAdvisoryConfig advisory.Config  `json:"updateAdvisoryConfig"`
 
 	// Network configuration
 	NetworkConfig network.Config `json:"networkConfig"`
@@ -156,6 +219,16 @@ type Config struct {
 
 	PluginDir string `json:"pluginDir"`
 
+	// Resource limits of each plugin VM process
+	PluginVMLimits subprocess.Limits `json:"pluginVMLimits"`
+	// Number of times a chain is restarted after its plugin VM process exits
+	// unexpectedly
+	PluginVMMaxRestarts int `json:"pluginVMMaxRestarts"`
+	// Time to wait before restarting a chain, doubled after each restart up
+	// to [PluginVMRestartMaxBackoff]
+	PluginVMRestartInitialBackoff time.Duration `json:"pluginVMRestartInitialBackoff"`
+	PluginVMRestartMaxBackoff     time.Duration `json:"pluginVMRestartMaxBackoff"`
+
 	// File Descriptor Limit
 	FdLimit uint64 `json:"fdLimit"`
 
@@ -166,9 +239,24 @@ type Config struct {
 	ConsensusShutdownTimeout time.Duration       `json:"consensusShutdownTimeout"`
 	// Poll for new frontiers every [FrontierPollFrequency]
 	FrontierPollFrequency time.Duration `json:"consensusGossipFreq"`
+	// Don't send the same AppGossip message to a peer more than once every
+	// [AppGossipDedupWindow]
+	AppGossipDedupWindow time.Duration `json:"appGossipDedupWindow"`
+	// Number of recently gossiped peer and message pairs remembered per subnet
+	AppGossipDedupSize int `json:"appGossipDedupSize"`
+	// Maximum duration of the verification of a block. If 0, the verification
+	// of a block isn't bounded.
+	BlockVerificationTimeout time.Duration `json:"blockVerificationTimeout"`
+	// ConsensusGuardrailViolations describe the ways in which the consensus
+	// config violates the guardrails of the network, if the node is allowed
+	// to start regardless.
+	ConsensusGuardrailViolations []string `json:"consensusGuardrailViolations"`
 	// ConsensusAppConcurrency defines the maximum number of goroutines to
 	// handle App messages per chain.
 	ConsensusAppConcurrency int `json:"consensusAppConcurrency"`
+	// ConsensusMaxUnprocessedMsgs is the number of unprocessed messages on a
+	// chain after which bulk messages are dropped.
+	ConsensusMaxUnprocessedMsgs int `json:"consensusMaxUnprocessedMsgs"`
 
 	TrackedSubnets set.Set[ids.ID] `json:"trackedSubnets"`
 
@@ -215,6 +303,10 @@ type Config struct {
 	// write arbitrary data.
 	ChainDataDir string `json:"chainDataDir"`
 
+	// PruneRetiredChains deletes the database and chain data directory of
+	// chains once they are retired.
+	PruneRetiredChains bool `json:"pruneRetiredChains"`
+
 	// Path to write process context to (including PID, API URI, and
 	// staking address).
 	ProcessContextFilePath string `json:"processContextFilePath"`
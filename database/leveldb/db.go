@@ -63,9 +63,10 @@ const (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
-	_ database.Batch    = (*batch)(nil)
-	_ database.Iterator = (*iter)(nil)
+	_ database.Database      = (*Database)(nil)
+	_ database.SizeEstimator = (*Database)(nil)
+	_ database.Batch         = (*batch)(nil)
+	_ database.Iterator      = (*iter)(nil)
 
 	ErrInvalidConfig = errors.New("invalid config")
 	ErrCouldNotOpen  = errors.New("could not open")
@@ -352,6 +353,33 @@ func (db *Database) Compact(start []byte, limit []byte) error {
 	return updateError(db.DB.CompactRange(util.Range{Start: start, Limit: limit}))
 }
 
+func (db *Database) EstimateSize(start []byte, limit []byte) (uint64, error) {
+	if limit == nil {
+		// The database.Database spec treats a nil [limit] as a key after all
+		// keys but leveldb treats a nil [limit] as a key before all keys in
+		// SizeOf. Use the successor of the greatest key in the database as the
+		// [limit] to get the desired behavior.
+		it := db.DB.NewIterator(nil, nil)
+		if it.Last() {
+			limit = append(slices.Clone(it.Key()), 0)
+		}
+		it.Release()
+		if err := it.Error(); err != nil {
+			return 0, updateError(err)
+		}
+		if limit == nil {
+			// The database is empty.
+			return 0, nil
+		}
+	}
+
+	sizes, err := db.DB.SizeOf([]util.Range{{Start: start, Limit: limit}})
+	if err != nil {
+		return 0, updateError(err)
+	}
+	return uint64(sizes.Sum()), nil
+}
+
 func (db *Database) Close() error {
 	db.closed.Set(true)
 	db.closeOnce.Do(func() {
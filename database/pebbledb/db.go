@@ -31,7 +31,8 @@ const (
 )
 
 var (
-	_ database.Database = (*Database)(nil)
+	_ database.Database      = (*Database)(nil)
+	_ database.SizeEstimator = (*Database)(nil)
 
 	errInvalidOperation = errors.New("invalid operation")
 
@@ -198,20 +199,12 @@ func (db *Database) Compact(start []byte, end []byte) error {
 		// keys but pebble treats a nil [limit] as a key before all keys in
 		// Compact. Use the greatest key in the database as the [limit] to get
 		// the desired behavior.
-		it, err := db.pebbleDB.NewIter(&pebble.IterOptions{})
-		if err != nil {
-			return updateError(err)
-		}
-
-		if !it.Last() {
-			// The database is empty.
-			return it.Close()
-		}
-
-		end = slices.Clone(it.Key())
-		if err := it.Close(); err != nil {
+		lastKey, ok, err := db.lastKey()
+		if err != nil || !ok {
+			// If the database is empty, there is nothing to compact.
 			return err
 		}
+		end = lastKey
 	}
 
 	if pebble.DefaultComparer.Compare(start, end) >= 1 {
@@ -222,6 +215,52 @@ func (db *Database) Compact(start []byte, end []byte) error {
 	return updateError(db.pebbleDB.Compact(start, end, true /* parallelize */))
 }
 
+func (db *Database) EstimateSize(start []byte, end []byte) (uint64, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return 0, database.ErrClosed
+	}
+
+	if end == nil {
+		// As in Compact, use the greatest key in the database as the [limit]
+		// to include all keys after [start].
+		lastKey, ok, err := db.lastKey()
+		if err != nil || !ok {
+			// If the database is empty, nothing is stored.
+			return 0, err
+		}
+		end = lastKey
+	}
+
+	if pebble.DefaultComparer.Compare(start, end) >= 1 {
+		// pebble requires [start] <= [end]
+		return 0, nil
+	}
+
+	size, err := db.pebbleDB.EstimateDiskUsage(start, end)
+	return size, updateError(err)
+}
+
+// lastKey returns the greatest key in the database. If the database is empty,
+// false is returned.
+//
+// Assumes [db.lock] is held.
+func (db *Database) lastKey() ([]byte, bool, error) {
+	it, err := db.pebbleDB.NewIter(&pebble.IterOptions{})
+	if err != nil {
+		return nil, false, updateError(err)
+	}
+
+	if !it.Last() {
+		return nil, false, it.Close()
+	}
+
+	lastKey := slices.Clone(it.Key())
+	return lastKey, true, it.Close()
+}
+
 func (db *Database) NewIterator() database.Iterator {
 	return db.NewIteratorWithStartAndPrefix(nil, nil)
 }
@@ -154,3 +154,34 @@ func TestKeyRange(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateSize(t *testing.T) {
+	require := require.New(t)
+
+	db := newDB(t)
+	defer db.Close()
+
+	size, err := db.EstimateSize(nil, nil)
+	require.NoError(err)
+	require.Zero(size)
+
+	value := make([]byte, 1024)
+	for i := range 1024 {
+		require.NoError(db.Put([]byte{0x01, byte(i >> 8), byte(i)}, value))
+	}
+	// Flush the keys to disk so that they are included in the estimate.
+	require.NoError(db.Compact(nil, nil))
+
+	size, err = db.EstimateSize(nil, nil)
+	require.NoError(err)
+	require.Positive(size)
+
+	prefixSize, err := db.EstimateSize([]byte{0x01}, []byte{0x02})
+	require.NoError(err)
+	require.Positive(prefixSize)
+	require.LessOrEqual(prefixSize, size)
+
+	emptySize, err := db.EstimateSize([]byte{0x02}, nil)
+	require.NoError(err)
+	require.Zero(emptySize)
+}
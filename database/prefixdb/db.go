@@ -34,6 +34,11 @@ type Database struct {
 	// The underlying storage
 	db     database.Database
 	closed bool
+
+	// If non-nil, the ranges of this db and the prefixed databases created
+	// from it are recorded in tracker under their path.
+	tracker *Tracker
+	path    string
 }
 
 func newDB(prefix []byte, db database.Database) *Database {
@@ -61,10 +66,16 @@ func incrementByteSlice(orig []byte) []byte {
 // New returns a new prefixed database
 func New(prefix []byte, db database.Database) *Database {
 	if prefixDB, ok := db.(*Database); ok {
-		return newDB(
+		newPrefixDB := newDB(
 			JoinPrefixes(prefixDB.dbPrefix, prefix),
 			prefixDB.db,
 		)
+		if prefixDB.tracker != nil {
+			newPrefixDB.tracker = prefixDB.tracker
+			newPrefixDB.path = prefixDB.path + "/" + formatPrefix(prefix)
+			newPrefixDB.tracker.add(newPrefixDB)
+		}
+		return newPrefixDB
 	}
 	return newDB(
 		MakePrefix(prefix),
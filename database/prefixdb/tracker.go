@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package prefixdb
+
+import (
+	"encoding/hex"
+	"maps"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ava-labs/avalanchego/database"
+)
+
+// Range is the key range [Start, Limit) of a prefixed database in the
+// underlying database.
+type Range struct {
+	Start []byte
+	Limit []byte
+}
+
+// Tracker records the key ranges of a prefixed database and of every prefixed
+// database that is created from it.
+//
+// Prefixed databases created from another prefixed database are not stored
+// under the range of their parent, so tracking their ranges is required to
+// account for all the keys that descend from a database.
+type Tracker struct {
+	lock sync.Mutex
+	// Key: Path of the prefixes used to create the database
+	// Value: Range of the database in the underlying database
+	ranges map[string]Range
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		ranges: make(map[string]Range),
+	}
+}
+
+// NewTracked returns a new prefixed database whose range, and the ranges of
+// all prefixed databases created from it, are recorded in [tracker].
+//
+// The returned database is recorded with the path "/". A database created from
+// a tracked database is recorded with the path of its parent followed by its
+// own prefix. For example, the database created with the prefix "vm" is
+// recorded with the path "/vm".
+func NewTracked(prefix []byte, db database.Database, tracker *Tracker) *Database {
+	prefixDB := New(prefix, db)
+	prefixDB.tracker = tracker
+	tracker.add(prefixDB)
+	return prefixDB
+}
+
+// Ranges returns the ranges of the tracked databases, keyed by their path.
+//
+// Because every prefix is hashed, the returned ranges don't overlap.
+func (t *Tracker) Ranges() map[string]Range {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return maps.Clone(t.ranges)
+}
+
+func (t *Tracker) add(db *Database) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	path := db.path
+	if path == "" {
+		path = "/"
+	}
+	t.ranges[path] = Range{
+		Start: db.dbPrefix,
+		Limit: db.dbLimit,
+	}
+}
+
+// formatPrefix returns [prefix] as a string if it is printable and as hex
+// otherwise.
+func formatPrefix(prefix []byte) string {
+	s := string(prefix)
+	isPrintable := utf8.ValidString(s) && strings.IndexFunc(s, func(r rune) bool {
+		return r == '/' || !unicode.IsPrint(r)
+	}) == -1
+	if len(s) > 0 && isPrintable {
+		return s
+	}
+	return "0x" + hex.EncodeToString(prefix)
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package prefixdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+)
+
+func TestTracker(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		baseDB    = memdb.New()
+		tracker   = NewTracker()
+		rootDB    = NewTracked([]byte{0x00, 0x01}, baseDB, tracker)
+		vmDB      = New([]byte("vm"), rootDB)
+		stateDB   = New([]byte{0xff}, vmDB)
+		nestedDB  = NewNested([]byte("nested"), vmDB)
+		untracked = New([]byte("untracked"), baseDB)
+	)
+	require.NoError(stateDB.Put([]byte("key"), []byte("value")))
+	require.NoError(nestedDB.Put([]byte("key"), []byte("value")))
+	require.NoError(untracked.Put([]byte("key"), []byte("value")))
+
+	ranges := tracker.Ranges()
+	require.Equal(
+		map[string]Range{
+			"/": {
+				Start: rootDB.dbPrefix,
+				Limit: rootDB.dbLimit,
+			},
+			"/vm": {
+				Start: vmDB.dbPrefix,
+				Limit: vmDB.dbLimit,
+			},
+			"/vm/0xff": {
+				Start: stateDB.dbPrefix,
+				Limit: stateDB.dbLimit,
+			},
+		},
+		ranges,
+	)
+
+	// Every tracked key must be in exactly one of the ranges and untracked
+	// keys must not be in any of them.
+	it := baseDB.NewIterator()
+	defer it.Release()
+
+	var numTracked int
+	for it.Next() {
+		var numRanges int
+		for _, r := range ranges {
+			if bytes.Compare(r.Start, it.Key()) <= 0 && bytes.Compare(it.Key(), r.Limit) < 0 {
+				numRanges++
+			}
+		}
+		require.LessOrEqual(numRanges, 1)
+		numTracked += numRanges
+	}
+	require.NoError(it.Error())
+	require.Equal(2, numTracked)
+}
+
+func TestFormatPrefix(t *testing.T) {
+	tests := []struct {
+		prefix   []byte
+		expected string
+	}{
+		{
+			prefix:   []byte("vm"),
+			expected: "vm",
+		},
+		{
+			prefix:   nil,
+			expected: "0x",
+		},
+		{
+			prefix:   []byte{0x00, 0x01},
+			expected: "0x0001",
+		},
+		{
+			prefix:   []byte("a/b"),
+			expected: "0x612f62",
+		},
+		{
+			prefix:   []byte{0xff},
+			expected: "0xff",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			require.Equal(t, test.expected, formatPrefix(test.prefix))
+		})
+	}
+}
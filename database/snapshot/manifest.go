@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const (
+	ManifestFileName  = "manifest.json"
+	SignatureFileName = "manifest.sig"
+)
+
+var (
+	ErrUntrustedPublisher = errors.New("manifest was not signed by a trusted publisher")
+	ErrWrongNetworkID     = errors.New("wrong network ID")
+
+	errNoArchives        = errors.New("manifest contains no archives")
+	errNonLocalFileName  = errors.New("archive file name is not local to the snapshot directory")
+	errDuplicateFileName = errors.New("duplicate archive file name")
+)
+
+// Manifest describes a snapshot of a node's database.
+type Manifest struct {
+	NetworkID uint32    `json:"networkID"`
+	Archives  []Archive `json:"archives"`
+}
+
+// Archive is a file containing a contiguous range of the keys in the database.
+type Archive struct {
+	// FileName is the name of the archive inside the snapshot directory.
+	FileName string `json:"fileName"`
+	// Hash is the SHA-256 hash of the archive file.
+	Hash ids.ID `json:"hash"`
+	// NumKeys is the number of key-value pairs in the archive.
+	NumKeys uint64 `json:"numKeys"`
+}
+
+// Verify returns nil if the manifest is for [networkID] and its archives can be
+// safely imported.
+func (m *Manifest) Verify(networkID uint32) error {
+	if m.NetworkID != networkID {
+		return fmt.Errorf("%w: expected %d but got %d", ErrWrongNetworkID, networkID, m.NetworkID)
+	}
+	if len(m.Archives) == 0 {
+		return errNoArchives
+	}
+
+	fileNames := set.NewSet[string](len(m.Archives))
+	for _, archive := range m.Archives {
+		if !filepath.IsLocal(archive.FileName) {
+			return fmt.Errorf("%w: %q", errNonLocalFileName, archive.FileName)
+		}
+		if fileNames.Contains(archive.FileName) {
+			return fmt.Errorf("%w: %q", errDuplicateFileName, archive.FileName)
+		}
+		fileNames.Add(archive.FileName)
+	}
+	return nil
+}
+
+// SignManifest returns the serialized [manifest] and the signature over it by
+// [key].
+func SignManifest(manifest *Manifest, key *secp256k1.PrivateKey) ([]byte, []byte, error) {
+	manifestBytes, err := json.MarshalIndent(manifest, "", "\t")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	signature, err := key.SignHash(hashing.ComputeHash256(manifestBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign manifest: %w", err)
+	}
+	return manifestBytes, signature, nil
+}
+
+// ParseManifest verifies that [manifestBytes] were signed by one of the
+// [trustedPublishers] and returns the parsed manifest.
+func ParseManifest(
+	manifestBytes []byte,
+	signature []byte,
+	trustedPublishers set.Set[ids.ShortID],
+) (*Manifest, error) {
+	publicKey, err := secp256k1.RecoverPublicKeyFromHash(hashing.ComputeHash256(manifestBytes), signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover manifest signer: %w", err)
+	}
+	if publisher := publicKey.Address(); !trustedPublishers.Contains(publisher) {
+		return nil, fmt.Errorf("%w: %s", ErrUntrustedPublisher, publisher)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(manifestBytes, manifest); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,378 @@
+// Package snapshot exports and imports signed snapshots of a node's database.
+//
+// A snapshot is a directory containing a manifest, the manifest's signature and
+// a sequence of gzip compressed archives. Together, the archives contain every
+// key-value pair in the database.
+//
+// The databases of all chains are snapshotted together, as the chains share
+// the database with their shared memory and nested prefixed databases are not
+// stored under the prefix of their parent.
+package snapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	// archiveSize is the number of uncompressed bytes after which a new archive
+	// is started while exporting.
+	archiveSize = units.GiB
+	// writeSize is the size of the batches written while importing.
+	writeSize = 4 * units.MiB
+	// maxRecordLen is the maximum length of a key or value in an archive.
+	maxRecordLen = 256 * units.MiB
+)
+
+var (
+	ErrDatabaseNotEmpty = errors.New("database is not empty")
+	ErrWrongHash        = errors.New("wrong archive hash")
+
+	errRecordTooLarge = errors.New("record too large")
+	errWrongNumKeys   = errors.New("wrong number of keys")
+)
+
+// Export writes a snapshot of [db] into [dir] and signs its manifest with [key].
+//
+// [db] must not be modified while it is being exported.
+func Export(
+	log logging.Logger,
+	db database.Iteratee,
+	dir string,
+	networkID uint32,
+	key *secp256k1.PrivateKey,
+) (*Manifest, error) {
+	if err := os.MkdirAll(dir, perms.ReadWriteExecute); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	var (
+		manifest = &Manifest{
+			NetworkID: networkID,
+		}
+		writer *archiveWriter
+	)
+	// closeArchive finishes the current archive and adds it to the manifest.
+	closeArchive := func() error {
+		archive, err := writer.close()
+		writer = nil
+		if err != nil {
+			return fmt.Errorf("failed to close archive %q: %w", archive.FileName, err)
+		}
+
+		log.Info("exported archive",
+			zap.String("fileName", archive.FileName),
+			zap.Uint64("numKeys", archive.NumKeys),
+		)
+		manifest.Archives = append(manifest.Archives, archive)
+		return nil
+	}
+
+	it := db.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		if writer == nil {
+			fileName := fmt.Sprintf("archive-%d.gz", len(manifest.Archives))
+			var err error
+			writer, err = newArchiveWriter(dir, fileName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create archive %q: %w", fileName, err)
+			}
+		}
+
+		if err := writer.write(it.Key(), it.Value()); err != nil {
+			_ = writer.file.Close()
+			return nil, fmt.Errorf("failed to write archive %q: %w", writer.archive.FileName, err)
+		}
+		if writer.size < archiveSize {
+			continue
+		}
+		if err := closeArchive(); err != nil {
+			return nil, err
+		}
+	}
+	if err := it.Error(); err != nil {
+		if writer != nil {
+			_ = writer.file.Close()
+		}
+		return nil, fmt.Errorf("failed to iterate database: %w", err)
+	}
+	if writer != nil {
+		if err := closeArchive(); err != nil {
+			return nil, err
+		}
+	}
+	if len(manifest.Archives) == 0 {
+		return nil, errNoArchives
+	}
+
+	manifestBytes, signature, err := SignManifest(manifest, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, ManifestFileName), manifestBytes, perms.ReadWrite); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, SignatureFileName), signature, perms.ReadWrite); err != nil {
+		return nil, fmt.Errorf("failed to write manifest signature: %w", err)
+	}
+	return manifest, nil
+}
+
+type archiveWriter struct {
+	archive Archive
+	// size is the number of uncompressed bytes written to the archive.
+	size int
+
+	file       *os.File
+	hasher     hash.Hash
+	fileWriter *bufio.Writer
+	gzipWriter *gzip.Writer
+}
+
+func newArchiveWriter(dir string, fileName string) (*archiveWriter, error) {
+	file, err := os.OpenFile(filepath.Join(dir, fileName), os.O_CREATE|os.O_EXCL|os.O_WRONLY, perms.ReadWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := sha256.New()
+	fileWriter := bufio.NewWriter(io.MultiWriter(file, hasher))
+	return &archiveWriter{
+		archive: Archive{
+			FileName: fileName,
+		},
+		file:       file,
+		hasher:     hasher,
+		fileWriter: fileWriter,
+		gzipWriter: gzip.NewWriter(fileWriter),
+	}, nil
+}
+
+func (w *archiveWriter) write(key, value []byte) error {
+	if err := writeRecord(w.gzipWriter, key); err != nil {
+		return err
+	}
+	if err := writeRecord(w.gzipWriter, value); err != nil {
+		return err
+	}
+	w.archive.NumKeys++
+	w.size += len(key) + len(value)
+	return nil
+}
+
+// close flushes the archive to disk and returns its description.
+func (w *archiveWriter) close() (Archive, error) {
+	defer w.file.Close()
+
+	if err := w.gzipWriter.Close(); err != nil {
+		return w.archive, err
+	}
+	if err := w.fileWriter.Flush(); err != nil {
+		return w.archive, err
+	}
+	if err := w.file.Sync(); err != nil {
+		return w.archive, err
+	}
+	w.archive.Hash = ids.ID(w.hasher.Sum(nil))
+	return w.archive, nil
+}
+
+// Import verifies the snapshot in [dir] and writes it into [db].
+//
+// The manifest must be signed by one of the [trustedPublishers] and every
+// archive must match the hash in the manifest before anything is written. [db]
+// must be empty. If the import fails part way through, [db] must be deleted
+// before the import is retried.
+func Import(
+	log logging.Logger,
+	db database.Database,
+	dir string,
+	networkID uint32,
+	trustedPublishers set.Set[ids.ShortID],
+) (*Manifest, error) {
+	manifestBytes, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	signature, err := os.ReadFile(filepath.Join(dir, SignatureFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest signature: %w", err)
+	}
+	manifest, err := ParseManifest(manifestBytes, signature, trustedPublishers)
+	if err != nil {
+		return nil, err
+	}
+	if err := manifest.Verify(networkID); err != nil {
+		return nil, err
+	}
+
+	for _, archive := range manifest.Archives {
+		hash, err := hashFile(filepath.Join(dir, archive.FileName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash archive %q: %w", archive.FileName, err)
+		}
+		if hash != archive.Hash {
+			return nil, fmt.Errorf("%w for archive %q: expected %s but got %s",
+				ErrWrongHash,
+				archive.FileName,
+				archive.Hash,
+				hash,
+			)
+		}
+	}
+	log.Info("verified snapshot",
+		zap.Uint32("networkID", manifest.NetworkID),
+		zap.Int("numArchives", len(manifest.Archives)),
+	)
+
+	empty, err := isEmpty(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if database is empty: %w", err)
+	}
+	if !empty {
+		return nil, ErrDatabaseNotEmpty
+	}
+
+	for _, archive := range manifest.Archives {
+		err := importArchive(
+			db,
+			filepath.Join(dir, archive.FileName),
+			archive.NumKeys,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to import archive %q: %w", archive.FileName, err)
+		}
+
+		log.Info("imported archive",
+			zap.String("fileName", archive.FileName),
+			zap.Uint64("numKeys", archive.NumKeys),
+		)
+	}
+	return manifest, nil
+}
+
+func importArchive(db database.Database, path string, expectedNumKeys uint64) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	var (
+		reader  = bufio.NewReader(gzipReader)
+		batch   = db.NewBatch()
+		numKeys uint64
+	)
+	for {
+		key, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		value, err := readRecord(reader)
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := batch.Put(key, value); err != nil {
+			return err
+		}
+		numKeys++
+
+		if batch.Size() < writeSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+	}
+	if numKeys != expectedNumKeys {
+		return fmt.Errorf("%w: expected %d but got %d", errWrongNumKeys, expectedNumKeys, numKeys)
+	}
+	return batch.Write()
+}
+
+func isEmpty(db database.Iteratee) (bool, error) {
+	it := db.NewIterator()
+	defer it.Release()
+
+	return !it.Next(), it.Error()
+}
+
+func hashFile(path string) (ids.ID, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ids.Empty, err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return ids.Empty, err
+	}
+	return ids.ID(hasher.Sum(nil)), nil
+}
+
+// writeRecord writes [b] prefixed by its uvarint encoded length.
+func writeRecord(w io.Writer, b []byte) error {
+	var lenBytes [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBytes[:], uint64(len(b)))
+	if _, err := w.Write(lenBytes[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// readRecord reads a record written by writeRecord. If there are no more
+// records, io.EOF is returned.
+func readRecord(r *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxRecordLen {
+		return nil, fmt.Errorf("%w: %d > %d", errRecordTooLarge, length, maxRecordLen)
+	}
+
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return b, nil
+}
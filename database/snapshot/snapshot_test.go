@@ -0,0 +1,200 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// newTestSnapshot exports a snapshot of a database with keys under multiple
+// prefixes and returns the database and the snapshot directory.
+func newTestSnapshot(t *testing.T, key *secp256k1.PrivateKey) (database.Database, string) {
+	require := require.New(t)
+
+	db := memdb.New()
+	for i := range 3 {
+		prefixDB := prefixdb.New([]byte{byte(i)}, db)
+		for j := range 10 {
+			require.NoError(prefixDB.Put([]byte{byte(j)}, []byte{byte(i), byte(j)}))
+		}
+	}
+
+	dir := t.TempDir()
+	manifest, err := Export(logging.NoLog{}, db, dir, constants.UnitTestID, key)
+	require.NoError(err)
+	require.Len(manifest.Archives, 1)
+	require.Equal(uint64(30), manifest.Archives[0].NumKeys)
+	return db, dir
+}
+
+func TestExportImport(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	originalDB, dir := newTestSnapshot(t, key)
+
+	db := memdb.New()
+	_, err = Import(logging.NoLog{}, db, dir, constants.UnitTestID, set.Of(key.Address()))
+	require.NoError(err)
+
+	it := originalDB.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		value, err := db.Get(it.Key())
+		require.NoError(err)
+		require.Equal(it.Value(), value)
+	}
+	require.NoError(it.Error())
+
+	count, err := database.Count(db)
+	require.NoError(err)
+	require.Equal(30, count)
+
+	// Importing into a non-empty database should fail.
+	_, err = Import(logging.NoLog{}, db, dir, constants.UnitTestID, set.Of(key.Address()))
+	require.ErrorIs(err, ErrDatabaseNotEmpty)
+}
+
+func TestExportEmptyDatabase(t *testing.T) {
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(t, err)
+
+	_, err = Export(logging.NoLog{}, memdb.New(), t.TempDir(), constants.UnitTestID, key)
+	require.ErrorIs(t, err, errNoArchives)
+}
+
+func TestImportErrors(t *testing.T) {
+	tests := []struct {
+		name              string
+		networkID         uint32
+		trustedPublishers func(key *secp256k1.PrivateKey) set.Set[ids.ShortID]
+		modify            func(t *testing.T, dir string)
+		expectedErr       error
+	}{
+		{
+			name:      "untrusted publisher",
+			networkID: constants.UnitTestID,
+			trustedPublishers: func(*secp256k1.PrivateKey) set.Set[ids.ShortID] {
+				return set.Of(ids.GenerateTestShortID())
+			},
+			expectedErr: ErrUntrustedPublisher,
+		},
+		{
+			name:              "wrong network ID",
+			networkID:         constants.MainnetID,
+			trustedPublishers: trustKey,
+			expectedErr:       ErrWrongNetworkID,
+		},
+		{
+			name:              "modified archive",
+			networkID:         constants.UnitTestID,
+			trustedPublishers: trustKey,
+			modify: func(t *testing.T, dir string) {
+				path := filepath.Join(dir, "archive-0.gz")
+				archive, err := os.ReadFile(path)
+				require.NoError(t, err)
+				archive[len(archive)-1] ^= 1
+				require.NoError(t, os.WriteFile(path, archive, 0o600))
+			},
+			expectedErr: ErrWrongHash,
+		},
+		{
+			name:              "modified manifest",
+			networkID:         constants.UnitTestID,
+			trustedPublishers: trustKey,
+			modify: func(t *testing.T, dir string) {
+				path := filepath.Join(dir, ManifestFileName)
+				manifest, err := os.ReadFile(path)
+				require.NoError(t, err)
+				manifest = append(manifest, ' ')
+				require.NoError(t, os.WriteFile(path, manifest, 0o600))
+			},
+			expectedErr: ErrUntrustedPublisher,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			key, err := secp256k1.NewPrivateKey()
+			require.NoError(err)
+			_, dir := newTestSnapshot(t, key)
+			if test.modify != nil {
+				test.modify(t, dir)
+			}
+
+			db := memdb.New()
+			_, err = Import(logging.NoLog{}, db, dir, test.networkID, test.trustedPublishers(key))
+			require.ErrorIs(err, test.expectedErr)
+
+			// Nothing should be written if verification fails.
+			empty, err := isEmpty(db)
+			require.NoError(err)
+			require.True(empty)
+		})
+	}
+}
+
+func TestManifestVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		archives    []Archive
+		expectedErr error
+	}{
+		{
+			name:        "no archives",
+			expectedErr: errNoArchives,
+		},
+		{
+			name: "non-local file name",
+			archives: []Archive{
+				{FileName: "../archive-0.gz"},
+			},
+			expectedErr: errNonLocalFileName,
+		},
+		{
+			name: "duplicate file name",
+			archives: []Archive{
+				{FileName: "archive-0.gz"},
+				{FileName: "archive-0.gz"},
+			},
+			expectedErr: errDuplicateFileName,
+		},
+		{
+			name: "valid",
+			archives: []Archive{
+				{FileName: "archive-0.gz"},
+				{FileName: "archive-1.gz"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest := &Manifest{
+				NetworkID: constants.UnitTestID,
+				Archives:  test.archives,
+			}
+			err := manifest.Verify(constants.UnitTestID)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func trustKey(key *secp256k1.PrivateKey) set.Set[ids.ShortID] {
+	return set.Of(key.Address())
+}
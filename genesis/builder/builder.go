@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package builder generates the genesis of custom networks from a declarative
+// Spec.
+//
+// The generated genesis only depends on the Spec. Nothing is randomly generated
+// or read from the clock, so the same Spec always results in the same genesis.
+package builder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/coreth/core"
+	"github.com/ava-labs/coreth/params"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultCChainGasLimit is the gas limit of the C-Chain if the Spec
+	// doesn't specify one.
+	DefaultCChainGasLimit = uint64(100_000_000)
+
+	GenesisFileName  = "genesis.json"
+	UpgradeFileName  = "upgrade.json"
+	ChainConfigsDir  = "chains"
+	ChainConfigFile  = "config.json"
+	configFilePerms  = perms.ReadWrite
+	configFolderPerm = perms.ReadWriteExecute
+)
+
+var (
+	ErrReservedNetworkID = errors.New("network ID can't be mainnet, testnet or local network ID")
+	ErrNoValidators      = errors.New("no initial validators")
+	ErrNoStake           = errors.New("initial validators must have stake")
+	ErrNoStakeDuration   = errors.New("initial validators must have a stake duration")
+)
+
+// Spec describes the genesis of a custom network.
+type Spec struct {
+	NetworkID uint32 `json:"networkID"`
+	// StartTime is the time of the genesis. The initial validators start
+	// validating at this time.
+	StartTime time.Time `json:"startTime"`
+	Message   string    `json:"message"`
+
+	// Allocations fund addresses on the X-Chain and on the P-Chain.
+	Allocations []Allocation `json:"allocations"`
+	// CChainAllocations fund addresses on the C-Chain.
+	CChainAllocations []CChainAllocation `json:"cChainAllocations"`
+	// CChainGasLimit is the gas limit of the C-Chain. If zero,
+	// DefaultCChainGasLimit is used.
+	CChainGasLimit uint64 `json:"cChainGasLimit"`
+
+	// Validators are the initial Primary Network validators.
+	Validators []Validator `json:"validators"`
+	// StakeAddress owns the stake of the initial validators.
+	StakeAddress ids.ShortID `json:"stakeAddress"`
+	// ValidatorStake is the amount of AVAX staked by each initial validator.
+	ValidatorStake uint64 `json:"validatorStake"`
+	// StakeLocktime is the time until which the stake remains locked once it
+	// is returned.
+	StakeLocktime time.Time `json:"stakeLocktime"`
+	// StakeDuration is how long the first initial validator validates for.
+	StakeDuration time.Duration `json:"stakeDuration"`
+	// StakeDurationOffset is how much earlier each subsequent initial
+	// validator stops validating.
+	StakeDurationOffset time.Duration `json:"stakeDurationOffset"`
+
+	// Upgrades, if set, are the upgrade times of the network.
+	Upgrades *upgrade.Config `json:"upgrades,omitempty"`
+	// ChainConfigs maps chain aliases to their chain configs.
+	ChainConfigs map[string]json.RawMessage `json:"chainConfigs,omitempty"`
+}
+
+// Allocation funds an address on the X-Chain and on the P-Chain.
+type Allocation struct {
+	Address ids.ShortID `json:"address"`
+	// XChainAmount is the amount of AVAX available on the X-Chain.
+	XChainAmount uint64 `json:"xChainAmount"`
+	// PChainAmounts are the amounts of AVAX available on the P-Chain. Each
+	// amount is locked until its locktime.
+	PChainAmounts []genesis.LockedAmount `json:"pChainAmounts"`
+}
+
+// CChainAllocation funds an address on the C-Chain.
+type CChainAllocation struct {
+	Address ethcommon.Address `json:"address"`
+	Balance *big.Int          `json:"balance"`
+}
+
+// Validator is an initial Primary Network validator.
+type Validator struct {
+	NodeID        ids.NodeID                `json:"nodeID"`
+	Signer        *signer.ProofOfPossession `json:"signer"`
+	RewardAddress ids.ShortID               `json:"rewardAddress"`
+	// DelegationFee is denominated in reward.PercentDenominator.
+	DelegationFee uint32 `json:"delegationFee"`
+}
+
+// Genesis is the generated genesis of a custom network.
+type Genesis struct {
+	Config       *genesis.UnparsedConfig
+	Upgrades     *upgrade.Config
+	ChainConfigs map[string]json.RawMessage
+}
+
+// New generates the genesis described by [spec].
+func New(spec *Spec) (*Genesis, error) {
+	switch spec.NetworkID {
+	case constants.MainnetID, constants.TestnetID, constants.LocalID:
+		return nil, ErrReservedNetworkID
+	}
+	if len(spec.Validators) == 0 {
+		return nil, ErrNoValidators
+	}
+	if spec.ValidatorStake == 0 {
+		return nil, ErrNoStake
+	}
+	if spec.StakeDuration <= 0 {
+		return nil, ErrNoStakeDuration
+	}
+	totalStake, err := math.Mul(spec.ValidatorStake, uint64(len(spec.Validators)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate total stake: %w", err)
+	}
+
+	config := genesis.Config{
+		NetworkID: spec.NetworkID,
+		Allocations: []genesis.Allocation{
+			{
+				AVAXAddr: spec.StakeAddress,
+				UnlockSchedule: []genesis.LockedAmount{
+					{
+						Amount:   totalStake,
+						Locktime: unixTime(spec.StakeLocktime),
+					},
+				},
+			},
+		},
+		StartTime:                  unixTime(spec.StartTime),
+		InitialStakeDuration:       uint64(spec.StakeDuration / time.Second),
+		InitialStakeDurationOffset: uint64(spec.StakeDurationOffset / time.Second),
+		InitialStakedFunds:         []ids.ShortID{spec.StakeAddress},
+		InitialStakers:             make([]genesis.Staker, len(spec.Validators)),
+		Message:                    spec.Message,
+	}
+	for _, allocation := range spec.Allocations {
+		config.Allocations = append(config.Allocations, genesis.Allocation{
+			AVAXAddr:       allocation.Address,
+			InitialAmount:  allocation.XChainAmount,
+			UnlockSchedule: allocation.PChainAmounts,
+		})
+	}
+	for i, validator := range spec.Validators {
+		config.InitialStakers[i] = genesis.Staker{
+			NodeID:        validator.NodeID,
+			RewardAddress: validator.RewardAddress,
+			DelegationFee: validator.DelegationFee,
+			Signer:        validator.Signer,
+		}
+	}
+
+	cChainGenesis, err := newCChainGenesis(spec)
+	if err != nil {
+		return nil, err
+	}
+	config.CChainGenesis = string(cChainGenesis)
+
+	unparsedConfig, err := config.Unparse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to unparse genesis config: %w", err)
+	}
+	return &Genesis{
+		Config:       &unparsedConfig,
+		Upgrades:     spec.Upgrades,
+		ChainConfigs: spec.ChainConfigs,
+	}, nil
+}
+
+func newCChainGenesis(spec *Spec) ([]byte, error) {
+	gasLimit := spec.CChainGasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultCChainGasLimit
+	}
+
+	alloc := make(core.GenesisAlloc, len(spec.CChainAllocations))
+	for _, allocation := range spec.CChainAllocations {
+		alloc[allocation.Address] = core.GenesisAccount{
+			Balance: allocation.Balance,
+		}
+	}
+
+	cChainGenesis := &core.Genesis{
+		Config:     &params.ChainConfig{ChainID: new(big.Int).SetUint64(uint64(spec.NetworkID))}, // The rest of the config is set in coreth on VM initialization
+		Difficulty: big.NewInt(0),                                                                // Difficulty is a mandatory field
+		Timestamp:  uint64(upgrade.InitiallyActiveTime.Unix()),                                   // This time enables Avalanche upgrades by default
+		GasLimit:   gasLimit,
+		Alloc:      alloc,
+	}
+	bytes, err := json.Marshal(cChainGenesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal C-Chain genesis: %w", err)
+	}
+	return bytes, nil
+}
+
+// Write writes the genesis to [dir]. The node is configured to use the written
+// files with:
+//
+//   - --genesis-file=[dir]/genesis.json
+//   - --upgrade-file=[dir]/upgrade.json, if the upgrade times were specified
+//   - --chain-config-dir=[dir]/chains
+func (g *Genesis) Write(dir string) error {
+	if err := os.MkdirAll(dir, configFolderPerm); err != nil {
+		return fmt.Errorf("failed to create genesis dir: %w", err)
+	}
+	if err := writeJSON(filepath.Join(dir, GenesisFileName), g.Config); err != nil {
+		return fmt.Errorf("failed to write genesis: %w", err)
+	}
+	if g.Upgrades != nil {
+		if err := writeJSON(filepath.Join(dir, UpgradeFileName), g.Upgrades); err != nil {
+			return fmt.Errorf("failed to write upgrades: %w", err)
+		}
+	}
+	for alias, chainConfig := range g.ChainConfigs {
+		chainDir := filepath.Join(dir, ChainConfigsDir, alias)
+		if err := os.MkdirAll(chainDir, configFolderPerm); err != nil {
+			return fmt.Errorf("failed to create chain config dir of %s: %w", alias, err)
+		}
+		if err := os.WriteFile(filepath.Join(chainDir, ChainConfigFile), chainConfig, configFilePerms); err != nil {
+			return fmt.Errorf("failed to write chain config of %s: %w", alias, err)
+		}
+	}
+	return nil
+}
+
+func writeJSON(path string, value any) error {
+	bytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bytes, configFilePerms)
+}
+
+// unixTime returns the unix time of [t], or 0 if [t] is the zero time.
+func unixTime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix())
+}
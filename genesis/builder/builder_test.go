@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package builder
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func newTestSpec(t *testing.T) *Spec {
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(t, err)
+
+	upgrades := upgradetest.GetConfig(upgradetest.Latest)
+	startTime := time.Unix(1_700_000_000, 0)
+	return &Spec{
+		NetworkID: 1337,
+		StartTime: startTime,
+		Message:   "test",
+		Allocations: []Allocation{
+			{
+				Address:      ids.ShortID{1},
+				XChainAmount: units.MegaAvax,
+				PChainAmounts: []genesis.LockedAmount{
+					{
+						Amount: units.MegaAvax,
+					},
+				},
+			},
+		},
+		CChainAllocations: []CChainAllocation{
+			{
+				Address: ethcommon.Address{1},
+				Balance: new(big.Int).SetUint64(units.Avax),
+			},
+		},
+		Validators: []Validator{
+			{
+				NodeID:        ids.BuildTestNodeID([]byte{1}),
+				Signer:        pop,
+				RewardAddress: ids.ShortID{2},
+				DelegationFee: 20_000,
+			},
+		},
+		StakeAddress:        ids.ShortID{3},
+		ValidatorStake:      units.MegaAvax,
+		StakeLocktime:       startTime.Add(time.Hour),
+		StakeDuration:       365 * 24 * time.Hour,
+		StakeDurationOffset: time.Hour,
+		Upgrades:            &upgrades,
+		ChainConfigs: map[string]json.RawMessage{
+			"C": json.RawMessage(`{"log-level":"debug"}`),
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	require := require.New(t)
+
+	spec := newTestSpec(t)
+	g, err := New(spec)
+	require.NoError(err)
+
+	// The same spec must always result in the same genesis.
+	sameG, err := New(spec)
+	require.NoError(err)
+	require.Equal(g, sameG)
+
+	config, err := g.Config.Parse()
+	require.NoError(err)
+	_, _, err = genesis.FromConfig(&config)
+	require.NoError(err)
+}
+
+func TestNewErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(*Spec)
+		expectedErr error
+	}{
+		{
+			name: "mainnet",
+			modify: func(s *Spec) {
+				s.NetworkID = constants.MainnetID
+			},
+			expectedErr: ErrReservedNetworkID,
+		},
+		{
+			name: "local network",
+			modify: func(s *Spec) {
+				s.NetworkID = constants.LocalID
+			},
+			expectedErr: ErrReservedNetworkID,
+		},
+		{
+			name: "no validators",
+			modify: func(s *Spec) {
+				s.Validators = nil
+			},
+			expectedErr: ErrNoValidators,
+		},
+		{
+			name: "no stake",
+			modify: func(s *Spec) {
+				s.ValidatorStake = 0
+			},
+			expectedErr: ErrNoStake,
+		},
+		{
+			name: "no stake duration",
+			modify: func(s *Spec) {
+				s.StakeDuration = 0
+			},
+			expectedErr: ErrNoStakeDuration,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spec := newTestSpec(t)
+			test.modify(spec)
+			_, err := New(spec)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	require := require.New(t)
+
+	g, err := New(newTestSpec(t))
+	require.NoError(err)
+
+	dir := t.TempDir()
+	require.NoError(g.Write(dir))
+
+	for _, path := range []string{
+		GenesisFileName,
+		UpgradeFileName,
+		filepath.Join(ChainConfigsDir, "C", ChainConfigFile),
+	} {
+		_, err := os.Stat(filepath.Join(dir, path))
+		require.NoError(err)
+	}
+
+	chainConfig, err := os.ReadFile(filepath.Join(dir, ChainConfigsDir, "C", ChainConfigFile))
+	require.NoError(err)
+	require.Equal(`{"log-level":"debug"}`, string(chainConfig))
+}
@@ -85,6 +85,16 @@ func (s Staker) Unparse(networkID uint32) (UnparsedStaker, error) {
 	}, err
 }
 
+// StakingParams override the primary network staking bounds of a custom
+// network. Durations are in seconds.
+type StakingParams struct {
+	MinValidatorStake uint64 `json:"minValidatorStake"`
+	MaxValidatorStake uint64 `json:"maxValidatorStake"`
+	MinDelegatorStake uint64 `json:"minDelegatorStake"`
+	MinStakeDuration  uint64 `json:"minStakeDuration"`
+	MaxStakeDuration  uint64 `json:"maxStakeDuration"`
+}
+
 // Config contains the genesis addresses used to construct a genesis
 type Config struct {
 	NetworkID uint32 `json:"networkID"`
@@ -97,6 +107,10 @@ type Config struct {
 	InitialStakedFunds         []ids.ShortID `json:"initialStakedFunds"`
 	InitialStakers             []Staker      `json:"initialStakers"`
 
+	// StakingParams, if set, replace the staking bounds configured on the
+	// node. Only supported by custom networks.
+	StakingParams *StakingParams `json:"stakingParams,omitempty"`
+
 	CChainGenesis string `json:"cChainGenesis"`
 
 	Message string `json:"message"`
@@ -111,6 +125,7 @@ func (c Config) Unparse() (UnparsedConfig, error) {
 		InitialStakeDurationOffset: c.InitialStakeDurationOffset,
 		InitialStakedFunds:         make([]string, len(c.InitialStakedFunds)),
 		InitialStakers:             make([]UnparsedStaker, len(c.InitialStakers)),
+		StakingParams:              c.StakingParams,
 		CChainGenesis:              c.CChainGenesis,
 		Message:                    c.Message,
 	}
@@ -0,0 +1,275 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var errInvalidChainConfig = errors.New("invalid C-chain genesis")
+
+// ConfigDiff describes the differences between two genesis configs. Fields
+// are only populated if they differ.
+type ConfigDiff struct {
+	// Fields are the scalar fields of the config that differ.
+	Fields []FieldDiff `json:"fields,omitempty"`
+	// Allocations are the allocations that differ, grouped by X-chain address.
+	Allocations []AllocationDiff `json:"allocations,omitempty"`
+	// InitialStakedFunds are the addresses whose initial stake was only
+	// allocated by one of the configs.
+	InitialStakedFunds *IDsDiff[ids.ShortID] `json:"initialStakedFunds,omitempty"`
+	// InitialStakers are the initial validators that differ.
+	InitialStakers []StakerDiff `json:"initialStakers,omitempty"`
+	// ChainConfig are the values of the C-chain genesis that differ, keyed by
+	// their JSON path.
+	ChainConfig []FieldDiff `json:"chainConfig,omitempty"`
+}
+
+// FieldDiff is a value that differs between two configs. A nil value is
+// missing from its config.
+type FieldDiff struct {
+	Name string `json:"name"`
+	Old  any    `json:"old"`
+	New  any    `json:"new"`
+}
+
+// AllocationDiff describes the allocations of an X-chain address that differ.
+type AllocationDiff struct {
+	AVAXAddr ids.ShortID  `json:"avaxAddr"`
+	Old      []Allocation `json:"old"`
+	New      []Allocation `json:"new"`
+}
+
+// StakerDiff describes an initial validator that differs. A nil staker is
+// missing from its config.
+type StakerDiff struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	Old    *Staker    `json:"old"`
+	New    *Staker    `json:"new"`
+}
+
+// IDsDiff describes the IDs that are only in one of two sets.
+type IDsDiff[T interface {
+	comparable
+	utils.Sortable[T]
+}] struct {
+	Removed []T `json:"removed,omitempty"`
+	Added   []T `json:"added,omitempty"`
+}
+
+// Equal returns true if the configs don't differ.
+func (d *ConfigDiff) Equal() bool {
+	return len(d.Fields) == 0 &&
+		len(d.Allocations) == 0 &&
+		d.InitialStakedFunds == nil &&
+		len(d.InitialStakers) == 0 &&
+		len(d.ChainConfig) == 0
+}
+
+// Diff returns the differences from [from] to [to].
+//
+// Returns an error if either C-chain genesis isn't valid JSON.
+func Diff(from, to *Config) (*ConfigDiff, error) {
+	d := &ConfigDiff{}
+	d.Fields = diffFields(
+		[]FieldDiff{
+			{Name: "networkID", Old: from.NetworkID, New: to.NetworkID},
+			{Name: "startTime", Old: from.StartTime, New: to.StartTime},
+			{Name: "initialStakeDuration", Old: from.InitialStakeDuration, New: to.InitialStakeDuration},
+			{Name: "initialStakeDurationOffset", Old: from.InitialStakeDurationOffset, New: to.InitialStakeDurationOffset},
+			{Name: "message", Old: from.Message, New: to.Message},
+		},
+	)
+	d.Allocations = diffAllocations(from.Allocations, to.Allocations)
+	d.InitialStakedFunds = diffIDs(from.InitialStakedFunds, to.InitialStakedFunds)
+	d.InitialStakers = diffStakers(from.InitialStakers, to.InitialStakers)
+
+	var err error
+	d.ChainConfig, err = diffJSON(from.CChainGenesis, to.CChainGenesis)
+	return d, err
+}
+
+func diffFields(fields []FieldDiff) []FieldDiff {
+	var diffs []FieldDiff
+	for _, field := range fields {
+		if field.Old != field.New {
+			diffs = append(diffs, field)
+		}
+	}
+	return diffs
+}
+
+func diffAllocations(from, to []Allocation) []AllocationDiff {
+	var (
+		oldByAddr = groupAllocations(from)
+		newByAddr = groupAllocations(to)
+		addrs     set.Set[ids.ShortID]
+	)
+	for addr := range oldByAddr {
+		addrs.Add(addr)
+	}
+	for addr := range newByAddr {
+		addrs.Add(addr)
+	}
+
+	var diffs []AllocationDiff
+	for _, addr := range sortedIDs(addrs) {
+		oldAllocations := oldByAddr[addr]
+		newAllocations := newByAddr[addr]
+		if reflect.DeepEqual(oldAllocations, newAllocations) {
+			continue
+		}
+		diffs = append(diffs, AllocationDiff{
+			AVAXAddr: addr,
+			Old:      oldAllocations,
+			New:      newAllocations,
+		})
+	}
+	return diffs
+}
+
+// groupAllocations returns the allocations of each X-chain address in a
+// deterministic order.
+func groupAllocations(allocations []Allocation) map[ids.ShortID][]Allocation {
+	byAddr := make(map[ids.ShortID][]Allocation)
+	for _, allocation := range allocations {
+		byAddr[allocation.AVAXAddr] = append(byAddr[allocation.AVAXAddr], allocation)
+	}
+	for _, grouped := range byAddr {
+		slices.SortFunc(grouped, func(a, b Allocation) int {
+			if c := a.Compare(b); c != 0 {
+				return c
+			}
+			return a.ETHAddr.Compare(b.ETHAddr)
+		})
+	}
+	return byAddr
+}
+
+func diffIDs[T interface {
+	comparable
+	utils.Sortable[T]
+}](from, to []T) *IDsDiff[T] {
+	var (
+		oldSet = set.Of(from...)
+		newSet = set.Of(to...)
+		diff   = &IDsDiff[T]{}
+	)
+	for id := range oldSet {
+		if !newSet.Contains(id) {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+	for id := range newSet {
+		if !oldSet.Contains(id) {
+			diff.Added = append(diff.Added, id)
+		}
+	}
+	if len(diff.Removed) == 0 && len(diff.Added) == 0 {
+		return nil
+	}
+	utils.Sort(diff.Removed)
+	utils.Sort(diff.Added)
+	return diff
+}
+
+func diffStakers(from, to []Staker) []StakerDiff {
+	var (
+		oldByNodeID = make(map[ids.NodeID]*Staker, len(from))
+		newByNodeID = make(map[ids.NodeID]*Staker, len(to))
+		nodeIDs     set.Set[ids.NodeID]
+	)
+	for i := range from {
+		oldByNodeID[from[i].NodeID] = &from[i]
+		nodeIDs.Add(from[i].NodeID)
+	}
+	for i := range to {
+		newByNodeID[to[i].NodeID] = &to[i]
+		nodeIDs.Add(to[i].NodeID)
+	}
+
+	var diffs []StakerDiff
+	for _, nodeID := range sortedIDs(nodeIDs) {
+		oldStaker := oldByNodeID[nodeID]
+		newStaker := newByNodeID[nodeID]
+		if reflect.DeepEqual(oldStaker, newStaker) {
+			continue
+		}
+		diffs = append(diffs, StakerDiff{
+			NodeID: nodeID,
+			Old:    oldStaker,
+			New:    newStaker,
+		})
+	}
+	return diffs
+}
+
+func sortedIDs[T interface {
+	comparable
+	utils.Sortable[T]
+}](s set.Set[T]) []T {
+	sorted := s.List()
+	utils.Sort(sorted)
+	return sorted
+}
+
+// diffJSON returns the values that differ between the [from] and [to] JSON
+// documents, keyed by their JSON path.
+func diffJSON(from, to string) ([]FieldDiff, error) {
+	if from == to {
+		return nil, nil
+	}
+
+	var oldValue, newValue any
+	if err := json.Unmarshal([]byte(from), &oldValue); err != nil {
+		return nil, fmt.Errorf("%w: old: %w", errInvalidChainConfig, err)
+	}
+	if err := json.Unmarshal([]byte(to), &newValue); err != nil {
+		return nil, fmt.Errorf("%w: new: %w", errInvalidChainConfig, err)
+	}
+
+	var diffs []FieldDiff
+	appendJSONDiffs(&diffs, "", oldValue, newValue)
+	return diffs, nil
+}
+
+func appendJSONDiffs(diffs *[]FieldDiff, path string, from, to any) {
+	oldObject, oldIsObject := from.(map[string]any)
+	newObject, newIsObject := to.(map[string]any)
+	if !oldIsObject || !newIsObject {
+		if !reflect.DeepEqual(from, to) {
+			*diffs = append(*diffs, FieldDiff{
+				Name: path,
+				Old:  from,
+				New:  to,
+			})
+		}
+		return
+	}
+
+	keys := make(set.Set[string], len(oldObject)+len(newObject))
+	for key := range oldObject {
+		keys.Add(key)
+	}
+	for key := range newObject {
+		keys.Add(key)
+	}
+	sortedKeys := keys.List()
+	slices.Sort(sortedKeys)
+	for _, key := range sortedKeys {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		appendJSONDiffs(diffs, keyPath, oldObject[key], newObject[key])
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+// This compares two genesis configs and prints their differences as JSON.
+//
+// Each argument is either the path of a genesis file or the name of a network
+// with a built-in genesis, such as mainnet, fuji, or local. The exit code is 1
+// if the configs differ.
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatalf("usage: %s <genesis file or network name> <genesis file or network name>", os.Args[0])
+	}
+
+	from, err := loadConfig(os.Args[1])
+	if err != nil {
+		log.Fatalf("failed to load %q: %v", os.Args[1], err)
+	}
+	to, err := loadConfig(os.Args[2])
+	if err != nil {
+		log.Fatalf("failed to load %q: %v", os.Args[2], err)
+	}
+
+	diff, err := genesis.Diff(from, to)
+	if err != nil {
+		log.Fatalf("failed to diff genesis configs: %v", err)
+	}
+	diffJSON, err := json.MarshalIndent(diff, "", "\t")
+	if err != nil {
+		log.Fatalf("failed to marshal diff: %v", err)
+	}
+	fmt.Println(string(diffJSON))
+
+	if !diff.Equal() {
+		os.Exit(1)
+	}
+}
+
+// loadConfig returns the built-in genesis config of the network named [arg],
+// or otherwise the genesis config at the path [arg].
+func loadConfig(arg string) (*genesis.Config, error) {
+	if networkID, ok := constants.NetworkNameToNetworkID[strings.ToLower(arg)]; ok {
+		return genesis.GetConfig(networkID), nil
+	}
+	return genesis.GetConfigFile(arg)
+}
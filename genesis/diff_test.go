@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestDiffEqual(t *testing.T) {
+	require := require.New(t)
+
+	diff, err := Diff(&LocalConfig, &LocalConfig)
+	require.NoError(err)
+	require.True(diff.Equal())
+	require.Equal(&ConfigDiff{}, diff)
+}
+
+func TestDiff(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		from = LocalConfig
+		to   = LocalConfig
+
+		addedAddr   = ids.GenerateTestShortID()
+		addedNodeID = ids.GenerateTestNodeID()
+	)
+	to.Message = "rehearsal"
+	to.Allocations = append(slices.Clone(from.Allocations), Allocation{
+		AVAXAddr:      addedAddr,
+		InitialAmount: 1,
+	})
+	to.InitialStakedFunds = []ids.ShortID{addedAddr}
+	to.InitialStakers = slices.Clone(from.InitialStakers[1:])
+	to.InitialStakers = append(to.InitialStakers, Staker{
+		NodeID:        addedNodeID,
+		RewardAddress: addedAddr,
+		DelegationFee: 1,
+	})
+	to.CChainGenesis = `{"config":{"chainId":1},"gasLimit":"0x1"}`
+	from.CChainGenesis = `{"config":{"chainId":1,"removed":true},"gasLimit":"0x2"}`
+
+	diff, err := Diff(&from, &to)
+	require.NoError(err)
+	require.False(diff.Equal())
+
+	require.Equal(
+		[]FieldDiff{
+			{Name: "message", Old: from.Message, New: to.Message},
+		},
+		diff.Fields,
+	)
+	require.Equal(
+		[]AllocationDiff{
+			{
+				AVAXAddr: addedAddr,
+				New:      to.Allocations[len(to.Allocations)-1:],
+			},
+		},
+		diff.Allocations,
+	)
+	require.Equal(
+		&IDsDiff[ids.ShortID]{
+			Removed: from.InitialStakedFunds,
+			Added:   to.InitialStakedFunds,
+		},
+		diff.InitialStakedFunds,
+	)
+	require.ElementsMatch(
+		[]StakerDiff{
+			{
+				NodeID: from.InitialStakers[0].NodeID,
+				Old:    &from.InitialStakers[0],
+			},
+			{
+				NodeID: addedNodeID,
+				New:    &to.InitialStakers[len(to.InitialStakers)-1],
+			},
+		},
+		diff.InitialStakers,
+	)
+	require.Equal(
+		[]FieldDiff{
+			{Name: "config.removed", Old: true},
+			{Name: "gasLimit", Old: "0x2", New: "0x1"},
+		},
+		diff.ChainConfig,
+	)
+}
+
+func TestDiffInvalidChainConfig(t *testing.T) {
+	to := LocalConfig
+	to.CChainGenesis = "{"
+
+	_, err := Diff(&LocalConfig, &to)
+	require.ErrorIs(t, err, errInvalidChainConfig)
+}
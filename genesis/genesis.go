@@ -46,6 +46,10 @@ var (
 	errFutureStartTime                 = errors.New("startTime cannot be in the future")
 	errInitialStakeDurationTooLow      = errors.New("initial stake duration is too low")
 	errOverridesStandardNetworkConfig  = errors.New("overrides standard network genesis config")
+	errMinValidatorStakeAboveMax       = errors.New("min validator stake must be <= max validator stake")
+	errNoMinStakeDuration              = errors.New("min stake duration must be > 0")
+	errMinStakeDurationAboveMax        = errors.New("min stake duration must be <= max stake duration")
+	errMaxStakeDurationAboveMinting    = errors.New("max stake duration must be <= minting period")
 )
 
 // validateInitialStakedFunds ensures all staked
@@ -113,6 +117,33 @@ func validateInitialStakedFunds(config *Config) error {
 	return nil
 }
 
+// applyStakingParams replaces the staking bounds of [stakingCfg] with the
+// staking parameters of [config], if it specifies any.
+func applyStakingParams(config *Config, stakingCfg *StakingConfig) error {
+	params := config.StakingParams
+	if params == nil {
+		return nil
+	}
+
+	switch {
+	case params.MinValidatorStake > params.MaxValidatorStake:
+		return errMinValidatorStakeAboveMax
+	case params.MinStakeDuration == 0:
+		return errNoMinStakeDuration
+	case params.MinStakeDuration > params.MaxStakeDuration:
+		return errMinStakeDurationAboveMax
+	case params.MaxStakeDuration > uint64(stakingCfg.RewardConfig.MintingPeriod/time.Second):
+		return errMaxStakeDurationAboveMinting
+	}
+
+	stakingCfg.MinValidatorStake = params.MinValidatorStake
+	stakingCfg.MaxValidatorStake = params.MaxValidatorStake
+	stakingCfg.MinDelegatorStake = params.MinDelegatorStake
+	stakingCfg.MinStakeDuration = time.Duration(params.MinStakeDuration) * time.Second
+	stakingCfg.MaxStakeDuration = time.Duration(params.MaxStakeDuration) * time.Second
+	return nil
+}
+
 // validateConfig returns an error if the provided
 // *Config is not considered valid.
 func validateConfig(networkID uint32, config *Config, stakingCfg *StakingConfig) error {
@@ -195,6 +226,9 @@ func validateConfig(networkID uint32, config *Config, stakingCfg *StakingConfig)
 // If [filepath] is non-empty and networkID isn't Mainnet, Testnet, or Local,
 // loads the network genesis data from the config at [filepath].
 //
+// If the loaded config specifies staking parameters, they replace the staking
+// bounds of [stakingCfg].
+//
 // FromFile returns:
 //
 //  1. The byte representation of the genesis state of the platform chain
@@ -215,6 +249,10 @@ func FromFile(networkID uint32, filepath string, stakingCfg *StakingConfig) ([]b
 		return nil, ids.Empty, fmt.Errorf("unable to load provided genesis config at %s: %w", filepath, err)
 	}
 
+	if err := applyStakingParams(config, stakingCfg); err != nil {
+		return nil, ids.Empty, fmt.Errorf("invalid staking params: %w", err)
+	}
+
 	if err := validateConfig(networkID, config, stakingCfg); err != nil {
 		return nil, ids.Empty, fmt.Errorf("genesis config validation failed: %w", err)
 	}
@@ -237,6 +275,9 @@ func FromFile(networkID uint32, filepath string, stakingCfg *StakingConfig) ([]b
 // If [genesisContent] is non-empty and networkID isn't Mainnet, Testnet, or Local,
 // loads the network genesis data from [genesisContent].
 //
+// If the loaded config specifies staking parameters, they replace the staking
+// bounds of [stakingCfg].
+//
 // FromFlag returns:
 //
 //  1. The byte representation of the genesis state of the platform chain
@@ -257,6 +298,10 @@ func FromFlag(networkID uint32, genesisContent string, stakingCfg *StakingConfig
 		return nil, ids.Empty, fmt.Errorf("unable to load genesis content from flag: %w", err)
 	}
 
+	if err := applyStakingParams(customConfig, stakingCfg); err != nil {
+		return nil, ids.Empty, fmt.Errorf("invalid staking params: %w", err)
+	}
+
 	if err := validateConfig(networkID, customConfig, stakingCfg); err != nil {
 		return nil, ids.Empty, fmt.Errorf("genesis config validation failed: %w", err)
 	}
@@ -353,6 +398,15 @@ func FromConfig(config *Config) ([]byte, ids.ID, error) {
 		Message:       config.Message,
 		Encoding:      defaultEncoding,
 	}
+	if params := config.StakingParams; params != nil {
+		platformvmArgs.StakingParams = &genesis.StakingParams{
+			MinValidatorStake: params.MinValidatorStake,
+			MaxValidatorStake: params.MaxValidatorStake,
+			MinDelegatorStake: params.MinDelegatorStake,
+			MinStakeDuration:  params.MinStakeDuration,
+			MaxStakeDuration:  params.MaxStakeDuration,
+		}
+	}
 	for _, allocation := range config.Allocations {
 		if initiallyStaked.Contains(allocation.AVAXAddr) {
 			skippedAllocations = append(skippedAllocations, allocation)
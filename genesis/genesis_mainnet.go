@@ -25,10 +25,11 @@ var (
 			TxFee:            units.MilliAvax,
 			DynamicFeeConfig: gas.Config{
 				Weights: gas.Dimensions{
-					gas.Bandwidth: 1,     // Max block size ~1MB
-					gas.DBRead:    1_000, // Max reads per block 1,000
-					gas.DBWrite:   1_000, // Max writes per block 1,000
-					gas.Compute:   4,     // Max compute time per block ~250ms
+					gas.Bandwidth:   1,     // Max block size ~1MB
+					gas.DBRead:      1_000, // Max reads per block 1,000
+					gas.DBWrite:     1_000, // Max writes per block 1,000
+					gas.Compute:     4,     // Max compute time per block ~250ms
+					gas.StateGrowth: 4,     // Max state growth per block ~250KB
 				},
 				MaxCapacity:     1_000_000,
 				MaxPerSecond:    100_000, // Refill time 10s
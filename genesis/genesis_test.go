@@ -24,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 )
 
 var (
@@ -173,6 +174,84 @@ func TestValidateConfig(t *testing.T) {
 	}
 }
 
+func TestApplyStakingParams(t *testing.T) {
+	validParams := StakingParams{
+		MinValidatorStake: 1,
+		MaxValidatorStake: 2,
+		MinDelegatorStake: 3,
+		MinStakeDuration:  60,
+		MaxStakeDuration:  120,
+	}
+	tests := []struct {
+		name        string
+		params      func(*StakingParams)
+		expectedErr error
+	}{
+		{
+			name:   "valid",
+			params: func(*StakingParams) {},
+		},
+		{
+			name: "min validator stake above max",
+			params: func(p *StakingParams) {
+				p.MinValidatorStake = p.MaxValidatorStake + 1
+			},
+			expectedErr: errMinValidatorStakeAboveMax,
+		},
+		{
+			name: "no min stake duration",
+			params: func(p *StakingParams) {
+				p.MinStakeDuration = 0
+			},
+			expectedErr: errNoMinStakeDuration,
+		},
+		{
+			name: "min stake duration above max",
+			params: func(p *StakingParams) {
+				p.MinStakeDuration = p.MaxStakeDuration + 1
+			},
+			expectedErr: errMinStakeDurationAboveMax,
+		},
+		{
+			name: "max stake duration above minting period",
+			params: func(p *StakingParams) {
+				p.MaxStakeDuration = 3601
+			},
+			expectedErr: errMaxStakeDurationAboveMinting,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			params := validParams
+			test.params(&params)
+
+			stakingCfg := StakingConfig{
+				MinValidatorStake: 10,
+				MaxValidatorStake: 20,
+				MinDelegatorStake: 30,
+				MinStakeDuration:  time.Second,
+				MaxStakeDuration:  time.Minute,
+				RewardConfig: reward.Config{
+					MintingPeriod: time.Hour,
+				},
+			}
+			err := applyStakingParams(&Config{StakingParams: &params}, &stakingCfg)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Equal(params.MinValidatorStake, stakingCfg.MinValidatorStake)
+			require.Equal(params.MaxValidatorStake, stakingCfg.MaxValidatorStake)
+			require.Equal(params.MinDelegatorStake, stakingCfg.MinDelegatorStake)
+			require.Equal(time.Minute, stakingCfg.MinStakeDuration)
+			require.Equal(2*time.Minute, stakingCfg.MaxStakeDuration)
+		})
+	}
+}
+
 func TestGenesisFromFile(t *testing.T) {
 	tests := map[string]struct {
 		networkID       uint32
@@ -374,6 +453,34 @@ func TestGenesis(t *testing.T) {
 	}
 }
 
+func TestGenesisStakingParams(t *testing.T) {
+	require := require.New(t)
+
+	config := unmodifiedLocalConfig
+	config.StakingParams = &StakingParams{
+		MinValidatorStake: 1,
+		MaxValidatorStake: 2,
+		MinDelegatorStake: 3,
+		MinStakeDuration:  60,
+		MaxStakeDuration:  120,
+	}
+	genesisBytes, _, err := FromConfig(&config)
+	require.NoError(err)
+
+	platformvmGenesis, err := genesis.Parse(genesisBytes)
+	require.NoError(err)
+	require.Equal(
+		&genesis.StakingParams{
+			MinValidatorStake: 1,
+			MaxValidatorStake: 2,
+			MinDelegatorStake: 3,
+			MinStakeDuration:  60,
+			MaxStakeDuration:  120,
+		},
+		platformvmGenesis.StakingParams,
+	)
+}
+
 func TestVMGenesis(t *testing.T) {
 	type vmTest struct {
 		vmID       ids.ID
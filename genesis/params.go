@@ -40,6 +40,11 @@ type TxFeeConfig struct {
 	TxFee              uint64     `json:"txFee"`
 	DynamicFeeConfig   gas.Config `json:"dynamicFeeConfig"`
 	ValidatorFeeConfig fee.Config `json:"validatorFeeConfig"`
+	// SubnetAuthFeeWeights, if non-zero, replace the weights of
+	// [DynamicFeeConfig] when calculating the fee of the P-chain txs whose only
+	// stateful effect is subnet governance. Only configurable on non-production
+	// networks.
+	SubnetAuthFeeWeights gas.Dimensions `json:"subnetAuthFeeWeights"`
 }
 
 type Params struct {
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// ExportConfig configures the export of accepted containers to a sink.
+type ExportConfig struct {
+	Sink Sink
+	// Maximum number of containers published to [Sink] at a time. Must be in
+	// [1, MaxFetchedByRange].
+	BatchSize int
+	// Time to wait before publishing containers to [Sink] again after a
+	// failure
+	RetryFrequency time.Duration
+}
+
+// exporter publishes the containers of an index to a sink, in the order they
+// were accepted.
+//
+// The index of the next container to publish is persisted after the sink
+// acknowledges each batch, so the export resumes where it left off when the
+// node restarts. Containers are delivered at least once: a batch is published
+// again if the node stops after the batch was acknowledged but before the
+// cursor was persisted.
+type exporter struct {
+	log     logging.Logger
+	config  ExportConfig
+	chainID ids.ID
+	// Endpoint of [index]: "block", "tx" or "vtx"
+	containerType string
+	index         *index
+
+	db database.KeyValueReaderWriter
+	// Key of the index of the next container to publish in [db]
+	cursorKey []byte
+}
+
+// run publishes containers until [ctx] is cancelled.
+func (e *exporter) run(ctx context.Context) {
+	for {
+		numExported, err := e.export(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		switch {
+		case err != nil:
+			e.log.Warn("failed to export containers",
+				zap.Stringer("chainID", e.chainID),
+				zap.String("type", e.containerType),
+				zap.Duration("retryIn", e.config.RetryFrequency),
+				zap.Error(err),
+			)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(e.config.RetryFrequency):
+			}
+		case numExported == 0:
+			// All indexed containers have been exported
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.index.accepted:
+			}
+		}
+	}
+}
+
+// export publishes the next batch of containers to the sink and returns the
+// number of published containers.
+func (e *exporter) export(ctx context.Context) (int, error) {
+	cursor, err := database.WithDefault(database.GetUInt64, e.db, e.cursorKey, 0)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get export cursor: %w", err)
+	}
+	if cursor >= e.index.NumAccepted() {
+		return 0, nil
+	}
+
+	containers, err := e.index.GetContainerRange(cursor, uint64(e.config.BatchSize))
+	if err != nil {
+		return 0, err
+	}
+
+	exported := make([]ExportedContainer, len(containers))
+	for i, container := range containers {
+		formatted, err := newFormattedContainer(container, cursor+uint64(i), formatting.Hex)
+		if err != nil {
+			return 0, err
+		}
+		exported[i] = ExportedContainer{
+			ChainID:            e.chainID,
+			Type:               e.containerType,
+			FormattedContainer: formatted,
+		}
+	}
+
+	if err := e.config.Sink.Publish(ctx, exported); err != nil {
+		return 0, err
+	}
+	if err := database.PutUInt64(e.db, e.cursorKey, cursor+uint64(len(exported))); err != nil {
+		return 0, fmt.Errorf("couldn't put export cursor: %w", err)
+	}
+
+	e.log.Debug("exported containers",
+		zap.Stringer("chainID", e.chainID),
+		zap.String("type", e.containerType),
+		zap.Uint64("startIndex", cursor),
+		zap.Int("numContainers", len(exported)),
+	)
+	return len(exported), nil
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// webhookServer records the containers published to it and rejects them while
+// [reject] is true.
+type webhookServer struct {
+	reject    bool
+	published []ExportedContainer
+}
+
+func (s *webhookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.reject {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	var containers []ExportedContainer
+	if err := json.NewDecoder(r.Body).Decode(&containers); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.published = append(s.published, containers...)
+}
+
+func TestExporterExport(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	idx, err := newIndex(memdb.New(), logging.NoLog{}, mockable.Clock{})
+	require.NoError(err)
+
+	containerIDs := make([]ids.ID, 3)
+	for i := range containerIDs {
+		containerIDs[i] = ids.GenerateTestID()
+		require.NoError(idx.Accept(ctx, containerIDs[i], utils.RandomBytes(32)))
+	}
+
+	server := &webhookServer{
+		reject: true,
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	sink, err := NewSink(SinkWebhook, httpServer.URL, "")
+	require.NoError(err)
+
+	db := memdb.New()
+	cursorKey := []byte{exportCursorPrefix}
+	e := &exporter{
+		log: logging.NoLog{},
+		config: ExportConfig{
+			Sink:           sink,
+			BatchSize:      2,
+			RetryFrequency: time.Second,
+		},
+		chainID:       snowCtx.ChainID,
+		containerType: "block",
+		index:         idx,
+		db:            db,
+		cursorKey:     cursorKey,
+	}
+
+	// A rejected batch must not advance the cursor
+	_, err = e.export(context.Background())
+	require.ErrorIs(err, errPublishRejected)
+	has, err := db.Has(cursorKey)
+	require.NoError(err)
+	require.False(has)
+
+	server.reject = false
+	numExported, err := e.export(context.Background())
+	require.NoError(err)
+	require.Equal(2, numExported)
+
+	numExported, err = e.export(context.Background())
+	require.NoError(err)
+	require.Equal(1, numExported)
+
+	numExported, err = e.export(context.Background())
+	require.NoError(err)
+	require.Zero(numExported)
+
+	cursor, err := database.GetUInt64(db, cursorKey)
+	require.NoError(err)
+	require.Equal(uint64(3), cursor)
+
+	require.Len(server.published, 3)
+	for i, container := range server.published {
+		require.Equal(snowCtx.ChainID, container.ChainID)
+		require.Equal("block", container.Type)
+		require.Equal(containerIDs[i], container.ID)
+		require.Equal(uint64(i), uint64(container.Index))
+	}
+
+	// Containers accepted after the export are exported from the cursor
+	containerID := ids.GenerateTestID()
+	require.NoError(idx.Accept(ctx, containerID, utils.RandomBytes(32)))
+
+	numExported, err = e.export(context.Background())
+	require.NoError(err)
+	require.Equal(1, numExported)
+	require.Len(server.published, 4)
+	require.Equal(containerID, server.published[3].ID)
+}
@@ -54,6 +54,8 @@ type index struct {
 	// Container ID --> Index
 	containerToIndex database.Database
 	log              logging.Logger
+	// Signaled, without blocking, after a container is indexed
+	accepted chan struct{}
 }
 
 // Create a new thread-safe index.
@@ -75,6 +77,7 @@ func newIndex(
 		indexToContainer: indexToContainer,
 		containerToIndex: containerToIndex,
 		log:              log,
+		accepted:         make(chan struct{}, 1),
 	}
 
 	// Get next accepted index from db
@@ -157,7 +160,15 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 	}
 
 	// Atomically commit [i.vDB], [i.indexToContainer], [i.containerToIndex] to [i.baseDB]
-	return i.vDB.Commit()
+	if err := i.vDB.Commit(); err != nil {
+		return err
+	}
+
+	select {
+	case i.accepted <- struct{}{}:
+	default:
+	}
+	return nil
 }
 
 // Returns the ID of the [index]th accepted container and the container itself.
@@ -269,6 +280,14 @@ func (i *index) GetLastAccepted() (Container, error) {
 	return i.getContainerByIndex(lastAcceptedIndex)
 }
 
+// NumAccepted returns the number of indexed containers.
+func (i *index) NumAccepted() uint64 {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return i.nextAcceptedIndex
+}
+
 // Assumes i.lock is held
 // Returns:
 //
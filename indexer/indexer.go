@@ -4,6 +4,8 @@
 package indexer
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sync"
@@ -21,7 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/utils/constants"
-	"github.com/ava-labs/avalanchego/utils/json"
+	avajson "github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
@@ -34,6 +36,7 @@ const (
 	blockPrefix             = 0x03
 	isIncompletePrefix      = 0x04
 	previouslyIndexedPrefix = 0x05
+	exportCursorPrefix      = 0x06
 )
 
 var (
@@ -53,6 +56,22 @@ type Config struct {
 	VertexAcceptorGroup  snow.AcceptorGroup
 	APIServer            server.PathAdder
 	ShutdownF            func()
+	// Returns the user-provided config of a chain. Chains outside of the
+	// primary network are only indexed if their config enables indexing.
+	// If nil, chains outside of the primary network are never indexed.
+	GetChainConfig func(ids.ID) (chains.ChainConfig, error)
+	// If non-nil, accepted containers are exported to a sink.
+	Export *ExportConfig
+}
+
+// chainIndexConfig is the portion of a chain's user-provided config that is
+// read by the indexer. Unknown fields are ignored so that the indexer's
+// options can be set alongside the VM's own options.
+type chainIndexConfig struct {
+	// If true, the accepted blocks of a chain outside of the primary network
+	// are indexed. Blocks accepted before the index was created are backfilled
+	// from the chain's state.
+	IndexEnabled bool `json:"index-enabled"`
 }
 
 // Indexer causes accepted containers for a given chain
@@ -67,6 +86,7 @@ type Indexer interface {
 
 // NewIndexer returns a new Indexer and registers a new endpoint on the given API server.
 func NewIndexer(config Config) (Indexer, error) {
+	exportCtx, exportCancel := context.WithCancel(context.Background())
 	indexer := &indexer{
 		log:                  config.Log,
 		db:                   config.DB,
@@ -80,6 +100,10 @@ func NewIndexer(config Config) (Indexer, error) {
 		blockIndices:         map[ids.ID]*index{},
 		pathAdder:            config.APIServer,
 		shutdownF:            config.ShutdownF,
+		getChainConfig:       config.GetChainConfig,
+		exportConfig:         config.Export,
+		exportCtx:            exportCtx,
+		exportCancel:         exportCancel,
 	}
 
 	hasRun, err := indexer.hasRun()
@@ -113,6 +137,9 @@ type indexer struct {
 	// If false, don't create index for a chain when RegisterChain is called
 	indexingEnabled bool
 
+	// Returns the user-provided config of a chain. May be nil.
+	getChainConfig func(ids.ID) (chains.ChainConfig, error)
+
 	// Chain ID --> index of blocks of that chain (if applicable)
 	blockIndices map[ids.ID]*index
 	// Chain ID --> index of vertices of that chain (if applicable)
@@ -126,10 +153,22 @@ type indexer struct {
 	txAcceptorGroup snow.AcceptorGroup
 	// Notifies of newly accepted vertices
 	vertexAcceptorGroup snow.AcceptorGroup
+
+	// If non-nil, the containers of each index are exported to a sink
+	exportConfig *ExportConfig
+	// Cancelled on close to stop the exporters
+	exportCtx    context.Context
+	exportCancel context.CancelFunc
+	exporters    sync.WaitGroup
 }
 
 // Assumes [ctx.Lock] is not held
 func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm common.VM) {
+	if ctx.SubnetID != constants.PrimaryNetworkID {
+		i.registerSubnetChain(chainName, ctx, vm)
+		return
+	}
+
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
@@ -139,12 +178,6 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 			zap.String("chainName", chainName),
 		)
 		return
-	} else if ctx.SubnetID != constants.PrimaryNetworkID {
-		i.log.Debug("not registering chain to indexer",
-			zap.String("reason", "not in the primary network"),
-			zap.String("chainName", chainName),
-		)
-		return
 	}
 
 	chainID := ctx.ChainID
@@ -306,6 +339,207 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 	}
 }
 
+// registerSubnetChain creates a block index for a chain outside of the primary
+// network if indexing is enabled and the chain's config enables it. Because
+// such chains may be created at any time, blocks that were accepted before the
+// index existed are backfilled from the chain's state rather than marking the
+// index incomplete. If the backfill fails, only the index of this chain is
+// marked incomplete; it keeps indexing newly accepted blocks.
+//
+// Assumes [i.lock] and [ctx.Lock] are not held.
+func (i *indexer) registerSubnetChain(chainName string, ctx *snow.ConsensusContext, vm common.VM) {
+	chainVM, index := i.createSubnetChainIndex(chainName, ctx, vm)
+	if index == nil {
+		return
+	}
+
+	// The chain doesn't process any messages until all registrants have been
+	// notified, so no blocks can be accepted while the backfill is running.
+	// [i.lock] isn't held so that other chains can be registered, and the
+	// indexer closed, in the meantime.
+	err := backfill(ctx, chainVM, index)
+	if err == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.closed {
+		return
+	}
+
+	i.log.Error("failed to backfill index",
+		zap.String("chainName", chainName),
+		zap.Error(err),
+	)
+	if err := i.markIncomplete(ctx.ChainID); err != nil {
+		i.log.Fatal("couldn't mark chain as incomplete",
+			zap.String("chainName", chainName),
+			zap.Error(err),
+		)
+		if err := i.close(); err != nil {
+			i.log.Error("failed to close indexer",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// createSubnetChainIndex returns the block index of a chain outside of the
+// primary network, or nil if the chain shouldn't be indexed.
+//
+// Assumes [i.lock] is not held.
+func (i *indexer) createSubnetChainIndex(chainName string, ctx *snow.ConsensusContext, vm common.VM) (block.ChainVM, *index) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.closed {
+		i.log.Debug("not registering chain to indexer",
+			zap.String("reason", "indexer is closed"),
+			zap.String("chainName", chainName),
+		)
+		return nil, nil
+	}
+	if !i.indexingEnabled {
+		i.log.Debug("not registering chain to indexer",
+			zap.String("reason", "indexing is disabled"),
+			zap.String("chainName", chainName),
+		)
+		return nil, nil
+	}
+
+	enabled, err := i.subnetChainIndexEnabled(ctx.ChainID)
+	if err != nil {
+		i.log.Error("couldn't get whether chain has indexing enabled",
+			zap.String("chainName", chainName),
+			zap.Error(err),
+		)
+		if err := i.close(); err != nil {
+			i.log.Error("failed to close indexer",
+				zap.Error(err),
+			)
+		}
+		return nil, nil
+	}
+	if !enabled {
+		i.log.Debug("not registering chain to indexer",
+			zap.String("reason", "not in the primary network and indexing is not enabled in the chain config"),
+			zap.String("chainName", chainName),
+		)
+		return nil, nil
+	}
+
+	chainVM, ok := vm.(block.ChainVM)
+	if !ok {
+		i.log.Warn("not registering chain to indexer",
+			zap.String("reason", "only linear chains outside of the primary network can be indexed"),
+			zap.String("chainName", chainName),
+			zap.String("vmType", fmt.Sprintf("%T", vm)),
+		)
+		return nil, nil
+	}
+
+	chainID := ctx.ChainID
+	if i.blockIndices[chainID] != nil {
+		i.log.Warn("chain is already being indexed",
+			zap.Stringer("chainID", chainID),
+		)
+		return nil, nil
+	}
+
+	index, err := i.registerChainHelper(chainID, blockPrefix, chainName, "block", i.blockAcceptorGroup)
+	if err != nil {
+		i.log.Fatal("failed to create index",
+			zap.String("chainName", chainName),
+			zap.String("endpoint", "block"),
+			zap.Error(err),
+		)
+		if err := i.close(); err != nil {
+			i.log.Error("failed to close indexer",
+				zap.Error(err),
+			)
+		}
+		return nil, nil
+	}
+	i.blockIndices[chainID] = index
+	return chainVM, index
+}
+
+// Returns true if the config of the chain enables indexing
+func (i *indexer) subnetChainIndexEnabled(chainID ids.ID) (bool, error) {
+	if i.getChainConfig == nil {
+		return false, nil
+	}
+	chainConfig, err := i.getChainConfig(chainID)
+	if err != nil {
+		return false, err
+	}
+	if len(chainConfig.Config) == 0 {
+		return false, nil
+	}
+	var config chainIndexConfig
+	if err := json.Unmarshal(chainConfig.Config, &config); err != nil {
+		return false, fmt.Errorf("couldn't parse chain config: %w", err)
+	}
+	return config.IndexEnabled, nil
+}
+
+// backfill indexes, in the order they were accepted, the accepted blocks of
+// [vm] that aren't in [index]. The chain is walked backwards from its last
+// accepted block until a block that is already indexed, or the genesis block,
+// is reached.
+//
+// Assumes [ctx.Lock] is not held.
+func backfill(ctx *snow.ConsensusContext, vm block.ChainVM, index *index) error {
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	blkID, err := vm.LastAccepted(context.TODO())
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted block: %w", err)
+	}
+
+	var missing []ids.ID
+	for {
+		_, err := index.GetIndex(blkID)
+		if err == nil {
+			break
+		}
+		if err != database.ErrNotFound {
+			return fmt.Errorf("couldn't get whether %s is indexed: %w", blkID, err)
+		}
+
+		blk, err := vm.GetBlock(context.TODO(), blkID)
+		if err != nil {
+			return fmt.Errorf("couldn't get block %s: %w", blkID, err)
+		}
+		missing = append(missing, blkID)
+		if blk.Height() == 0 {
+			break
+		}
+		blkID = blk.Parent()
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	ctx.Log.Info("backfilling index",
+		zap.Int("numBlocks", len(missing)),
+	)
+	for j := len(missing) - 1; j >= 0; j-- {
+		blk, err := vm.GetBlock(context.TODO(), missing[j])
+		if err != nil {
+			return fmt.Errorf("couldn't get block %s: %w", missing[j], err)
+		}
+		if err := index.Accept(ctx, blk.ID(), blk.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (i *indexer) registerChainHelper(
 	chainID ids.ID,
 	prefixEnd byte,
@@ -330,7 +564,7 @@ func (i *indexer) registerChainHelper(
 
 	// Create an API endpoint for this index
 	apiServer := rpc.NewServer()
-	codec := json.NewCodec()
+	codec := avajson.NewCodec()
 	apiServer.RegisterCodec(codec, "application/json")
 	apiServer.RegisterCodec(codec, "application/json;charset=UTF-8")
 	if err := apiServer.RegisterService(&service{index: index}, "index"); err != nil {
@@ -341,6 +575,28 @@ func (i *indexer) registerChainHelper(
 		_ = index.Close()
 		return nil, err
 	}
+
+	if i.exportConfig != nil {
+		cursorKey := make([]byte, ids.IDLen+2*wrappers.ByteLen)
+		copy(cursorKey, chainID[:])
+		cursorKey[ids.IDLen] = exportCursorPrefix
+		cursorKey[ids.IDLen+wrappers.ByteLen] = prefixEnd
+
+		exporter := &exporter{
+			log:           i.log,
+			config:        *i.exportConfig,
+			chainID:       chainID,
+			containerType: endpoint,
+			index:         index,
+			db:            i.db,
+			cursorKey:     cursorKey,
+		}
+		i.exporters.Add(1)
+		go func() {
+			defer i.exporters.Done()
+			exporter.run(i.exportCtx)
+		}()
+	}
 	return index, nil
 }
 
@@ -361,6 +617,11 @@ func (i *indexer) close() error {
 	}
 	i.closed = true
 
+	// The exporters read from the indices, so they must be stopped before the
+	// indices are closed.
+	i.exportCancel()
+	i.exporters.Wait()
+
 	errs := &wrappers.Errs{}
 	for chainID, txIndex := range i.txIndices {
 		errs.Add(
@@ -4,6 +4,7 @@
 package indexer
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"sync"
@@ -14,12 +15,17 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/ava-labs/avalanchego/api/server"
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/vertex/vertexmock"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blockmock"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -510,3 +516,209 @@ func TestIgnoreNonDefaultChains(t *testing.T) {
 	idxr.RegisterChain("chain1", chain1Ctx, chainVM)
 	require.Empty(idxr.blockIndices)
 }
+
+func TestIndexSubnetChainWithBackfill(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, ids.GenerateTestID())
+	snowCtx.SubnetID = ids.GenerateTestID()
+	chainCtx := snowtest.ConsensusContext(snowCtx)
+
+	blks := snowmantest.BuildChain(4)
+	for _, blk := range blks {
+		blk.Status = snowtest.Accepted
+	}
+	chainVM := &blocktest.VM{
+		LastAcceptedF: snowmantest.MakeLastAcceptedBlockF(blks),
+		GetBlockF: func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+			for _, blk := range blks {
+				if blk.ID() == blkID {
+					return blk, nil
+				}
+			}
+			return nil, database.ErrNotFound
+		},
+	}
+
+	server := &apiServerMock{}
+	config := Config{
+		IndexingEnabled:      true,
+		AllowIncompleteIndex: false,
+		Log:                  logging.NoLog{},
+		DB:                   memdb.New(),
+		BlockAcceptorGroup:   snow.NewAcceptorGroup(logging.NoLog{}),
+		TxAcceptorGroup:      snow.NewAcceptorGroup(logging.NoLog{}),
+		VertexAcceptorGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+		APIServer:            server,
+		ShutdownF:            func() {},
+		GetChainConfig: func(chainID ids.ID) (chains.ChainConfig, error) {
+			require.Equal(chainCtx.ChainID, chainID)
+			return chains.ChainConfig{
+				Config: []byte(`{"index-enabled":true,"vm-option":1}`),
+			}, nil
+		},
+	}
+
+	idxrIntf, err := NewIndexer(config)
+	require.NoError(err)
+	idxr := idxrIntf.(*indexer)
+
+	// Blocks accepted before the chain was registered should be backfilled
+	idxr.RegisterChain("chain1", chainCtx, chainVM)
+	require.Equal([]string{"index/chain1"}, server.bases)
+	require.Equal([]string{"/block"}, server.endpoints)
+
+	blkIdx := idxr.blockIndices[chainCtx.ChainID]
+	require.NotNil(blkIdx)
+	for i, blk := range blks {
+		container, err := blkIdx.GetContainerByIndex(uint64(i))
+		require.NoError(err)
+		require.Equal(blk.ID(), container.ID)
+		require.Equal(blk.Bytes(), container.Bytes)
+	}
+
+	// Blocks accepted after the chain was registered should be indexed
+	// through the acceptor group
+	newBlk := snowmantest.BuildChild(blks[len(blks)-1])
+	require.NoError(config.BlockAcceptorGroup.Accept(chainCtx, newBlk.ID(), newBlk.Bytes()))
+	lastAccepted, err := blkIdx.GetLastAccepted()
+	require.NoError(err)
+	require.Equal(newBlk.ID(), lastAccepted.ID)
+	require.NoError(idxr.Close())
+}
+
+func TestIndexSubnetChainWithFailedBackfill(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, ids.GenerateTestID())
+	snowCtx.SubnetID = ids.GenerateTestID()
+	chainCtx := snowtest.ConsensusContext(snowCtx)
+
+	blks := snowmantest.BuildChain(4)
+	for _, blk := range blks {
+		blk.Status = snowtest.Accepted
+	}
+	// The VM is missing one of its historical blocks
+	missingBlkID := blks[1].ID()
+	chainVM := &blocktest.VM{
+		LastAcceptedF: snowmantest.MakeLastAcceptedBlockF(blks),
+		GetBlockF: func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+			for _, blk := range blks {
+				if blk.ID() == blkID && blkID != missingBlkID {
+					return blk, nil
+				}
+			}
+			return nil, database.ErrNotFound
+		},
+	}
+
+	config := Config{
+		IndexingEnabled:      true,
+		AllowIncompleteIndex: false,
+		Log:                  logging.NoLog{},
+		DB:                   memdb.New(),
+		BlockAcceptorGroup:   snow.NewAcceptorGroup(logging.NoLog{}),
+		TxAcceptorGroup:      snow.NewAcceptorGroup(logging.NoLog{}),
+		VertexAcceptorGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+		APIServer:            &apiServerMock{},
+		ShutdownF:            func() {},
+		GetChainConfig: func(ids.ID) (chains.ChainConfig, error) {
+			return chains.ChainConfig{
+				Config: []byte(`{"index-enabled":true}`),
+			}, nil
+		},
+	}
+
+	idxrIntf, err := NewIndexer(config)
+	require.NoError(err)
+	idxr := idxrIntf.(*indexer)
+
+	// The failed backfill should only mark this chain's index as incomplete
+	idxr.RegisterChain("chain1", chainCtx, chainVM)
+	require.False(idxr.closed)
+	isIncomplete, err := idxr.isIncomplete(chainCtx.ChainID)
+	require.NoError(err)
+	require.True(isIncomplete)
+
+	blkIdx := idxr.blockIndices[chainCtx.ChainID]
+	require.NotNil(blkIdx)
+	_, err = blkIdx.GetLastAccepted()
+	require.ErrorIs(err, errNoneAccepted)
+
+	// Blocks accepted after the chain was registered should still be indexed
+	newBlk := snowmantest.BuildChild(blks[len(blks)-1])
+	require.NoError(config.BlockAcceptorGroup.Accept(chainCtx, newBlk.ID(), newBlk.Bytes()))
+	lastAccepted, err := blkIdx.GetLastAccepted()
+	require.NoError(err)
+	require.Equal(newBlk.ID(), lastAccepted.ID)
+
+	// Other chains should still be indexed
+	otherSnowCtx := snowtest.Context(t, ids.GenerateTestID())
+	otherSnowCtx.SubnetID = ids.GenerateTestID()
+	otherChainCtx := snowtest.ConsensusContext(otherSnowCtx)
+	otherBlks := snowmantest.BuildChain(1)
+	otherChainVM := &blocktest.VM{
+		LastAcceptedF: snowmantest.MakeLastAcceptedBlockF(otherBlks),
+		GetBlockF: func(context.Context, ids.ID) (snowman.Block, error) {
+			return otherBlks[0], nil
+		},
+	}
+	idxr.RegisterChain("chain2", otherChainCtx, otherChainVM)
+	require.NotNil(idxr.blockIndices[otherChainCtx.ChainID])
+	isIncomplete, err = idxr.isIncomplete(otherChainCtx.ChainID)
+	require.NoError(err)
+	require.False(isIncomplete)
+	require.NoError(idxr.Close())
+}
+
+func TestIgnoreSubnetChain(t *testing.T) {
+	tests := []struct {
+		name            string
+		indexingEnabled bool
+		chainConfig     []byte
+	}{
+		{
+			name:            "index not enabled in chain config",
+			indexingEnabled: true,
+			chainConfig:     []byte(`{"index-enabled":false}`),
+		},
+		{
+			name:            "indexing disabled",
+			indexingEnabled: false,
+			chainConfig:     []byte(`{"index-enabled":true}`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := Config{
+				IndexingEnabled:      test.indexingEnabled,
+				AllowIncompleteIndex: false,
+				Log:                  logging.NoLog{},
+				DB:                   memdb.New(),
+				BlockAcceptorGroup:   snow.NewAcceptorGroup(logging.NoLog{}),
+				TxAcceptorGroup:      snow.NewAcceptorGroup(logging.NoLog{}),
+				VertexAcceptorGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+				APIServer:            &apiServerMock{},
+				ShutdownF:            func() {},
+				GetChainConfig: func(ids.ID) (chains.ChainConfig, error) {
+					return chains.ChainConfig{
+						Config: test.chainConfig,
+					}, nil
+				},
+			}
+
+			idxrIntf, err := NewIndexer(config)
+			require.NoError(err)
+			idxr := idxrIntf.(*indexer)
+
+			chainCtx := snowtest.ConsensusContext(&snow.Context{
+				ChainID:  ids.GenerateTestID(),
+				SubnetID: ids.GenerateTestID(),
+			})
+			idxr.RegisterChain("chain1", chainCtx, &blocktest.VM{})
+			require.Empty(idxr.blockIndices)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+const (
+	// SinkWebhook publishes containers in the body of HTTP POST requests
+	SinkWebhook = "webhook"
+	// SinkKafka publishes containers to a Kafka topic through a Kafka REST
+	// proxy
+	SinkKafka = "kafka"
+
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+	// Maximum time a sink waits for a batch to be acknowledged
+	sinkTimeout = 30 * time.Second
+
+	// Maximum number of bytes of a rejection that are reported
+	maxRejectionBodyLen = 1024
+)
+
+var (
+	_ Sink = (*webhookSink)(nil)
+	_ Sink = (*kafkaSink)(nil)
+
+	errUnknownSink     = errors.New("unknown sink")
+	errNoKafkaTopic    = errors.New("kafka topic not provided")
+	errPublishRejected = errors.New("sink rejected published containers")
+	errInvalidSinkURL  = errors.New("sink URL must be an absolute http or https URL")
+)
+
+// ExportedContainer is the message published to a sink for each accepted
+// container.
+type ExportedContainer struct {
+	ChainID ids.ID `json:"chainID"`
+	// Endpoint of the index the container was accepted in: "block", "tx" or
+	// "vtx"
+	Type string `json:"type"`
+	FormattedContainer
+}
+
+// Sink receives the containers exported by the indexer.
+type Sink interface {
+	// Publish returns nil once [containers] have been durably received.
+	// Containers whose publication returned an error are published again.
+	Publish(ctx context.Context, containers []ExportedContainer) error
+}
+
+// NewSink returns the sink named [name] that publishes containers to [sinkURL].
+// [kafkaTopic] is only used by SinkKafka.
+func NewSink(name string, sinkURL string, kafkaTopic string) (Sink, error) {
+	u, err := url.Parse(sinkURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: %q", errInvalidSinkURL, sinkURL)
+	}
+
+	client := &http.Client{
+		Timeout: sinkTimeout,
+	}
+	switch name {
+	case SinkWebhook:
+		return &webhookSink{
+			client: client,
+			url:    sinkURL,
+		}, nil
+	case SinkKafka:
+		if kafkaTopic == "" {
+			return nil, errNoKafkaTopic
+		}
+		return &kafkaSink{
+			client: client,
+			url:    u.JoinPath("topics", kafkaTopic).String(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownSink, name)
+	}
+}
+
+// webhookSink POSTs each batch of containers to a URL as a JSON array.
+type webhookSink struct {
+	client *http.Client
+	url    string
+}
+
+func (s *webhookSink) Publish(ctx context.Context, containers []ExportedContainer) error {
+	return post(ctx, s.client, s.url, "application/json", containers)
+}
+
+// kafkaSink produces each container as a record of a Kafka topic through the
+// v2 API of a Kafka REST proxy.
+//
+// Records are keyed by chain ID, so that the containers of a chain are
+// produced to the same partition in the order they were accepted.
+type kafkaSink struct {
+	client *http.Client
+	url    string
+}
+
+type kafkaRecord struct {
+	Key   ids.ID            `json:"key"`
+	Value ExportedContainer `json:"value"`
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+func (s *kafkaSink) Publish(ctx context.Context, containers []ExportedContainer) error {
+	records := kafkaRecords{
+		Records: make([]kafkaRecord, len(containers)),
+	}
+	for i, container := range containers {
+		records.Records[i] = kafkaRecord{
+			Key:   container.ChainID,
+			Value: container,
+		}
+	}
+	return post(ctx, s.client, s.url, kafkaContentType, records)
+}
+
+// post sends [body] as JSON to [endpoint] and returns an error unless the
+// response has a 2xx status code.
+func post(ctx context.Context, client *http.Client, endpoint string, contentType string, body any) error {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal containers: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxRejectionBodyLen))
+		return fmt.Errorf("%w with status %d: %s", errPublishRejected, resp.StatusCode, respBody)
+	}
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
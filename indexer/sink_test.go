@@ -0,0 +1,98 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestNewSink(t *testing.T) {
+	tests := []struct {
+		name        string
+		sink        string
+		url         string
+		kafkaTopic  string
+		expectedErr error
+	}{
+		{
+			name: "webhook",
+			sink: SinkWebhook,
+			url:  "https://example.com/containers",
+		},
+		{
+			name:       "kafka",
+			sink:       SinkKafka,
+			url:        "http://10.0.0.1:8082",
+			kafkaTopic: "containers",
+		},
+		{
+			name:        "kafka without topic",
+			sink:        SinkKafka,
+			url:         "http://10.0.0.1:8082",
+			expectedErr: errNoKafkaTopic,
+		},
+		{
+			name:        "unknown sink",
+			sink:        "smtp",
+			url:         "http://10.0.0.1:8082",
+			expectedErr: errUnknownSink,
+		},
+		{
+			name:        "relative url",
+			sink:        SinkWebhook,
+			url:         "10.0.0.1:8082",
+			expectedErr: errInvalidSinkURL,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewSink(test.sink, test.url, test.kafkaTopic)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestKafkaSinkPublish(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		path        string
+		contentType string
+		records     kafkaRecords
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		contentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&records); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewSink(SinkKafka, server.URL, "containers")
+	require.NoError(err)
+
+	container := ExportedContainer{
+		ChainID: ids.GenerateTestID(),
+		Type:    "block",
+		FormattedContainer: FormattedContainer{
+			ID: ids.GenerateTestID(),
+		},
+	}
+	require.NoError(sink.Publish(context.Background(), []ExportedContainer{container}))
+
+	require.Equal("/topics/containers", path)
+	require.Equal(kafkaContentType, contentType)
+	require.Len(records.Records, 1)
+	require.Equal(container.ChainID, records.Records[0].Key)
+	require.Equal(container.ID, records.Records[0].Value.ID)
+}
@@ -0,0 +1,321 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api/metrics"
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/database/snapshot"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/node"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+
+	platformconfig "github.com/ava-labs/avalanchego/vms/platformvm/config"
+)
+
+const (
+	dbCommandName                    = "db"
+	bootstrapFromSnapshotCommandName = "bootstrap-from-snapshot"
+	createSnapshotCommandName        = "create-snapshot"
+	replayPChainCommandName          = "replay-p-chain"
+
+	snapshotDirKey               = "snapshot-dir"
+	snapshotTrustedPublishersKey = "snapshot-trusted-publishers"
+	snapshotPrivateKeyFileKey    = "snapshot-private-key-file"
+	replayStartHeightKey         = "replay-start-height"
+	replayEndHeightKey           = "replay-end-height"
+)
+
+var (
+	errMissingSnapshotDir    = fmt.Errorf("%q must be provided", snapshotDirKey)
+	errNoTrustedPublishers   = fmt.Errorf("%q must be provided", snapshotTrustedPublishersKey)
+	errMissingPrivateKeyFile = fmt.Errorf("%q must be provided", snapshotPrivateKeyFileKey)
+	errMemoryDatabase        = errors.New("db commands can't be used with an in-memory database")
+)
+
+// runDBCommand runs the database subcommand specified by [args] and returns the
+// process exit code.
+func runDBCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Printf("usage: %s %s {%s, %s, %s} [flags]\n",
+			constants.AppName,
+			dbCommandName,
+			bootstrapFromSnapshotCommandName,
+			createSnapshotCommandName,
+			replayPChainCommandName,
+		)
+		return 1
+	}
+
+	var err error
+	switch args[0] {
+	case bootstrapFromSnapshotCommandName:
+		err = bootstrapFromSnapshot(args[1:])
+	case createSnapshotCommandName:
+		err = createSnapshot(args[1:])
+	case replayPChainCommandName:
+		err = replayPChain(args[1:])
+	default:
+		err = fmt.Errorf("unknown %s command %q", dbCommandName, args[0])
+	}
+	if errors.Is(err, pflag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Printf("%s %s failed: %s\n", dbCommandName, args[0], err)
+		return 1
+	}
+	return 0
+}
+
+// bootstrapFromSnapshot initializes the node's database from a signed snapshot.
+// The node bootstraps any blocks accepted after the snapshot was taken from the
+// network as usual once it is started.
+func bootstrapFromSnapshot(args []string) error {
+	fs := config.BuildFlagSet()
+	fs.String(snapshotDirKey, "", "Path to the snapshot directory")
+	fs.StringSlice(snapshotTrustedPublishersKey, nil, "Addresses of the keys trusted to sign snapshot manifests")
+	v, err := config.BuildViper(fs, args)
+	if err != nil {
+		return err
+	}
+
+	snapshotDir := v.GetString(snapshotDirKey)
+	if snapshotDir == "" {
+		return errMissingSnapshotDir
+	}
+	rawPublishers := v.GetStringSlice(snapshotTrustedPublishersKey)
+	if len(rawPublishers) == 0 {
+		return errNoTrustedPublishers
+	}
+	publishers, err := address.ParseToIDs(rawPublishers)
+	if err != nil {
+		return fmt.Errorf("couldn't parse %q: %w", snapshotTrustedPublishersKey, err)
+	}
+
+	log, err := newDBLogger()
+	if err != nil {
+		return err
+	}
+	networkID, db, err := openDatabase(v, log)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = snapshot.Import(log, db, snapshotDir, networkID, set.Of(publishers...))
+	return err
+}
+
+// createSnapshot exports a signed snapshot of the node's database.
+// The node must not be running.
+func createSnapshot(args []string) error {
+	fs := config.BuildFlagSet()
+	fs.String(snapshotDirKey, "", "Path to the directory the snapshot is written to")
+	fs.String(snapshotPrivateKeyFileKey, "", "Path to the file containing the secp256k1 private key that signs the snapshot manifest")
+	v, err := config.BuildViper(fs, args)
+	if err != nil {
+		return err
+	}
+
+	snapshotDir := v.GetString(snapshotDirKey)
+	if snapshotDir == "" {
+		return errMissingSnapshotDir
+	}
+	privateKeyFile := v.GetString(snapshotPrivateKeyFileKey)
+	if privateKeyFile == "" {
+		return errMissingPrivateKeyFile
+	}
+	privateKeyBytes, err := os.ReadFile(privateKeyFile)
+	if err != nil {
+		return fmt.Errorf("couldn't read %q: %w", snapshotPrivateKeyFileKey, err)
+	}
+	privateKeyStr := strconv.Quote(strings.TrimSpace(string(privateKeyBytes)))
+	privateKey := &secp256k1.PrivateKey{}
+	if err := privateKey.UnmarshalJSON([]byte(privateKeyStr)); err != nil {
+		return fmt.Errorf("couldn't parse %q: %w", snapshotPrivateKeyFileKey, err)
+	}
+
+	log, err := newDBLogger()
+	if err != nil {
+		return err
+	}
+	networkID, db, err := openDatabase(v, log)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, err = snapshot.Export(log, db, snapshotDir, networkID, privateKey)
+	return err
+}
+
+// replayPChain re-executes the accepted P-chain blocks of the node's database
+// and reports how long each block took to verify and accept. If the blocks are
+// replayed up to the last accepted block, the replayed state is compared
+// against the state of the node. The node must not be running.
+func replayPChain(args []string) error {
+	fs := config.BuildFlagSet()
+	fs.Uint64(replayStartHeightKey, 1, "Height of the first P-chain block whose replay is reported")
+	fs.Uint64(replayEndHeightKey, 0, "Height of the last P-chain block to replay. If 0, blocks are replayed up to the last accepted block")
+	v, err := config.BuildViper(fs, args)
+	if err != nil {
+		return err
+	}
+
+	nodeConfig, err := config.GetNodeConfig(v)
+	if err != nil {
+		return err
+	}
+	if nodeConfig.DatabaseConfig.Name == memdb.Name {
+		return errMemoryDatabase
+	}
+
+	log, err := newDBLogger()
+	if err != nil {
+		return err
+	}
+	db, err := node.NewDatabase(nodeConfig.DatabaseConfig, log, prometheus.NewRegistry())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	xChainID, err := chainIDOf(nodeConfig.GenesisBytes, constants.AVMID)
+	if err != nil {
+		return err
+	}
+	cChainID, err := chainIDOf(nodeConfig.GenesisBytes, constants.EVMID)
+	if err != nil {
+		return err
+	}
+	sk, err := localsigner.New()
+	if err != nil {
+		return err
+	}
+
+	var configBytes []byte
+	if chainConfig, ok := nodeConfig.ChainConfigs[constants.PlatformChainID.String()]; ok {
+		configBytes = chainConfig.Config
+	} else if chainConfig, ok := nodeConfig.ChainConfigs["P"]; ok {
+		configBytes = chainConfig.Config
+	}
+
+	result, err := platformvm.Replay(context.Background(), platformvm.ReplayConfig{
+		Internal: platformconfig.Internal{
+			SybilProtectionEnabled:    nodeConfig.SybilProtectionEnabled,
+			PartialSyncPrimaryNetwork: nodeConfig.PartialSyncPrimaryNetwork,
+			TrackedSubnets:            nodeConfig.TrackedSubnets,
+			DynamicFeeConfig:          nodeConfig.DynamicFeeConfig,
+			ValidatorFeeConfig:        nodeConfig.ValidatorFeeConfig,
+			UptimePercentage:          nodeConfig.UptimeRequirement,
+			MinValidatorStake:         nodeConfig.MinValidatorStake,
+			MaxValidatorStake:         nodeConfig.MaxValidatorStake,
+			MinDelegatorStake:         nodeConfig.MinDelegatorStake,
+			MinDelegationFee:          nodeConfig.MinDelegationFee,
+			MinStakeDuration:          nodeConfig.MinStakeDuration,
+			MaxStakeDuration:          nodeConfig.MaxStakeDuration,
+			RewardConfig:              nodeConfig.RewardConfig,
+			UpgradeConfig:             nodeConfig.UpgradeConfig,
+			UseCurrentHeight:          nodeConfig.UseCurrentHeight,
+			MaintenanceWindow:         nodeConfig.MaintenanceWindow,
+		},
+		Ctx: &snow.Context{
+			NetworkID:       nodeConfig.NetworkID,
+			SubnetID:        constants.PrimaryNetworkID,
+			ChainID:         constants.PlatformChainID,
+			PublicKey:       sk.PublicKey(),
+			NetworkUpgrades: nodeConfig.UpgradeConfig,
+
+			XChainID:    xChainID,
+			CChainID:    cChainID,
+			AVAXAssetID: nodeConfig.AvaxAssetID,
+
+			Log:      log,
+			BCLookup: ids.NewAliaser(),
+			Metrics:  metrics.NewPrefixGatherer(),
+
+			WarpSigner: warp.NewSigner(sk, nodeConfig.NetworkID, constants.PlatformChainID),
+		},
+		GenesisBytes: nodeConfig.GenesisBytes,
+		ConfigBytes:  configBytes,
+		Source: prefixdb.New(
+			chains.VMDBPrefix,
+			prefixdb.New(constants.PlatformChainID[:], db),
+		),
+		StartHeight: v.GetUint64(replayStartHeightKey),
+		EndHeight:   v.GetUint64(replayEndHeightKey),
+	})
+	if result != nil {
+		var verify, accept time.Duration
+		for _, blk := range result.Blocks {
+			verify += blk.Verify
+			accept += blk.Accept
+		}
+		log.Info("replayed P-chain blocks",
+			zap.Int("numBlocks", len(result.Blocks)),
+			zap.Duration("verify", verify),
+			zap.Duration("accept", accept),
+			zap.Bool("compared", result.Compared),
+			zap.Stringer("stateRoot", result.StateRoot),
+			zap.Stringer("utxoChecksum", result.UTXOChecksum),
+		)
+	}
+	return err
+}
+
+func chainIDOf(genesisBytes []byte, vmID ids.ID) (ids.ID, error) {
+	tx, err := genesis.VMGenesis(genesisBytes, vmID)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return tx.ID(), nil
+}
+
+func openDatabase(v *viper.Viper, log logging.Logger) (uint32, database.Database, error) {
+	networkID, dbConfig, err := config.GetDatabaseConfig(v)
+	if err != nil {
+		return 0, nil, err
+	}
+	if dbConfig.Name == memdb.Name {
+		return 0, nil, errMemoryDatabase
+	}
+	db, err := node.NewDatabase(dbConfig, log, prometheus.NewRegistry())
+	return networkID, db, err
+}
+
+func newDBLogger() (logging.Logger, error) {
+	writeCloser := os.Stdout
+	logFormat, err := logging.ToFormat(logging.AutoString, writeCloser.Fd())
+	if err != nil {
+		return nil, err
+	}
+	return logging.NewLogger("", logging.NewWrappedCore(logging.Info, writeCloser, logFormat.ConsoleEncoder())), nil
+}
@@ -18,6 +18,15 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case dbCommandName:
+			os.Exit(runDBCommand(os.Args[2:]))
+		case validatorCommandName:
+			os.Exit(runValidatorCommand(os.Args[2:]))
+		}
+	}
+
 	fs := config.BuildFlagSet()
 	v, err := config.BuildViper(fs, os.Args[1:])
 
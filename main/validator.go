@@ -0,0 +1,327 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/dynamicip"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	nodeconfig "github.com/ava-labs/avalanchego/config/node"
+)
+
+const (
+	validatorCommandName = "validator"
+	initCommandName      = "init"
+
+	validatorStakeKey                 = "validator-stake"
+	validatorDurationKey              = "validator-duration"
+	validatorDelegationFeeKey         = "validator-delegation-fee"
+	validatorRewardsAddressKey        = "validator-rewards-address"
+	validatorSkipReachabilityCheckKey = "validator-skip-reachability-check"
+
+	// validatorEndTimeBuffer is added to the end time of the printed
+	// transaction so that it still satisfies the minimum staking duration if
+	// it is issued shortly after being printed.
+	validatorEndTimeBuffer = 10 * time.Minute
+	reachabilityTimeout    = 10 * time.Second
+)
+
+var errUnreachable = errors.New("couldn't connect to the staking port through the public IP")
+
+// validatorInitReply is printed by the validator init command.
+type validatorInitReply struct {
+	NodeID               ids.NodeID                `json:"nodeID"`
+	NodePOP              *signer.ProofOfPossession `json:"nodePOP"`
+	StakingKeyPath       string                    `json:"stakingKeyPath"`
+	StakingCertPath      string                    `json:"stakingCertPath"`
+	StakingSignerKeyPath string                    `json:"stakingSignerKeyPath"`
+	// PublicIP is the IP, and staking port, that peers will connect to.
+	PublicIP          string `json:"publicIP,omitempty"`
+	Reachable         bool   `json:"reachable"`
+	ReachabilityError string `json:"reachabilityError,omitempty"`
+	// AddPermissionlessValidatorTx registers the node as a validator of the
+	// primary network. Its inputs and fee are added by the wallet issuing it.
+	AddPermissionlessValidatorTx *txs.AddPermissionlessValidatorTx `json:"addPermissionlessValidatorTx,omitempty"`
+}
+
+// runValidatorCommand runs the validator subcommand specified by [args] and
+// returns the process exit code.
+func runValidatorCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Printf("usage: %s %s {%s} [flags]\n",
+			constants.AppName,
+			validatorCommandName,
+			initCommandName,
+		)
+		return 1
+	}
+
+	var err error
+	switch args[0] {
+	case initCommandName:
+		err = validatorInit(args[1:])
+	default:
+		err = fmt.Errorf("unknown %s command %q", validatorCommandName, args[0])
+	}
+	if errors.Is(err, pflag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Printf("%s %s failed: %s\n", validatorCommandName, args[0], err)
+		return 1
+	}
+	return 0
+}
+
+// validatorInit prepares this node to become a validator of the primary
+// network. It generates the staking and BLS keys of the node if they don't
+// exist, prints its proof of possession along with the transaction that
+// registers it, and verifies that its staking port is reachable.
+func validatorInit(args []string) error {
+	fs := config.BuildFlagSet()
+	fs.Uint64(validatorStakeKey, 0, "Amount of nAVAX staked by the validator. If 0, the minimum validator stake is used")
+	fs.Duration(validatorDurationKey, 0, "Duration of the validation period. If 0, the minimum staking duration is used")
+	fs.Uint32(validatorDelegationFeeKey, 0, "Delegation fee, in parts per million, charged to delegators. If 0, the minimum delegation fee is used")
+	fs.String(validatorRewardsAddressKey, "", "P-chain address that receives the validation and delegation rewards. If empty, the registration transaction isn't printed")
+	fs.Bool(validatorSkipReachabilityCheckKey, false, "If true, the staking port isn't checked for reachability")
+	v, err := config.BuildViper(fs, args)
+	if err != nil {
+		return err
+	}
+
+	// The staking and BLS keys are generated, as they would be by the node,
+	// while the config is loaded.
+	nodeConfig, err := config.GetNodeConfig(v)
+	if err != nil {
+		return err
+	}
+	log, err := newDBLogger()
+	if err != nil {
+		return err
+	}
+
+	stakingCert, err := staking.ParseCertificate(nodeConfig.StakingTLSCert.Leaf.Raw)
+	if err != nil {
+		return fmt.Errorf("invalid staking certificate: %w", err)
+	}
+	pop, err := signer.NewProofOfPossession(nodeConfig.StakingSigningKey)
+	if err != nil {
+		return fmt.Errorf("couldn't create proof of possession: %w", err)
+	}
+	reply := validatorInitReply{
+		NodeID:               ids.NodeIDFromCert(stakingCert),
+		NodePOP:              pop,
+		StakingKeyPath:       nodeConfig.StakingKeyPath,
+		StakingCertPath:      nodeConfig.StakingCertPath,
+		StakingSignerKeyPath: nodeConfig.StakingSignerPath,
+	}
+
+	if rewardsAddress := v.GetString(validatorRewardsAddressKey); rewardsAddress != "" {
+		rewardsAddr, err := address.ParseToID(rewardsAddress)
+		if err != nil {
+			return fmt.Errorf("couldn't parse %q: %w", validatorRewardsAddressKey, err)
+		}
+		reply.AddPermissionlessValidatorTx, err = newAddValidatorTx(
+			&nodeConfig,
+			reply.NodeID,
+			pop,
+			rewardsAddr,
+			v.GetUint64(validatorStakeKey),
+			v.GetDuration(validatorDurationKey),
+			v.GetUint32(validatorDelegationFeeKey),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !v.GetBool(validatorSkipReachabilityCheckKey) {
+		publicIP, err := checkReachability(&nodeConfig)
+		if publicIP.IsValid() {
+			reply.PublicIP = publicIP.String()
+		}
+		reply.Reachable = err == nil
+		if err != nil {
+			reply.ReachabilityError = err.Error()
+			log.Warn("staking port may not be reachable by peers. Make sure it is open to inbound TCP connections",
+				zap.Uint16("port", nodeConfig.ListenPort),
+				zap.Error(err),
+			)
+		}
+	}
+
+	replyBytes, err := json.MarshalIndent(reply, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(replyBytes))
+
+	if reply.AddPermissionlessValidatorTx == nil {
+		log.Info("provide --" + validatorRewardsAddressKey + " to print the transaction that registers this node as a validator")
+		return nil
+	}
+	log.Info("issue addPermissionlessValidatorTx with a P-chain wallet, such as wallet.P().IssueAddPermissionlessValidatorTx, to register this node as a validator. The wallet adds the inputs and fee",
+		zap.Stringer("nodeID", reply.NodeID),
+		zap.Time("endTime", reply.AddPermissionlessValidatorTx.EndTime()),
+	)
+	return nil
+}
+
+// newAddValidatorTx returns the unsigned transaction, without inputs, that
+// registers [nodeID] as a validator of the primary network. Zero values are
+// replaced by the minimums of the network.
+func newAddValidatorTx(
+	nodeConfig *nodeconfig.Config,
+	nodeID ids.NodeID,
+	pop *signer.ProofOfPossession,
+	rewardsAddr ids.ShortID,
+	stake uint64,
+	duration time.Duration,
+	delegationFee uint32,
+) (*txs.AddPermissionlessValidatorTx, error) {
+	if stake == 0 {
+		stake = nodeConfig.MinValidatorStake
+	}
+	if duration == 0 {
+		duration = nodeConfig.MinStakeDuration
+	}
+	if delegationFee == 0 {
+		delegationFee = nodeConfig.MinDelegationFee
+	}
+
+	var (
+		startTime    = time.Now()
+		endTime      = startTime.Add(duration + validatorEndTimeBuffer)
+		rewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{rewardsAddr},
+		}
+	)
+	tx := &txs.AddPermissionlessValidatorTx{
+		BaseTx: txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    nodeConfig.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+			},
+		},
+		Validator: txs.Validator{
+			NodeID: nodeID,
+			Start:  uint64(startTime.Unix()),
+			End:    uint64(endTime.Unix()),
+			Wght:   stake,
+		},
+		Subnet: constants.PrimaryNetworkID,
+		Signer: pop,
+		StakeOuts: []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: nodeConfig.AvaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          stake,
+				OutputOwners: *rewardsOwner,
+			},
+		}},
+		ValidatorRewardsOwner: rewardsOwner,
+		DelegatorRewardsOwner: rewardsOwner,
+		DelegationShares:      delegationFee,
+	}
+
+	// Format the addresses of the transaction as P-chain addresses.
+	aliaser := ids.NewAliaser()
+	if err := aliaser.Alias(constants.PlatformChainID, "P"); err != nil {
+		return nil, err
+	}
+	tx.InitCtx(&snow.Context{
+		NetworkID: nodeConfig.NetworkID,
+		ChainID:   constants.PlatformChainID,
+		BCLookup:  aliaser,
+		Log:       logging.NoLog{},
+	})
+	return tx, nil
+}
+
+// checkReachability listens on the staking port and connects to it through
+// the public IP of the node. The public IP is the configured one if provided,
+// and is otherwise resolved with STUN.
+//
+// Routers that don't support hairpinning drop connections from the local
+// network to its own public IP, so an error doesn't guarantee that the port
+// is unreachable from other networks.
+func checkReachability(nodeConfig *nodeconfig.Config) (netip.AddrPort, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), reachabilityTimeout)
+	defer cancel()
+
+	var (
+		publicAddr netip.Addr
+		err        error
+	)
+	if nodeConfig.PublicIP != "" {
+		publicAddr, err = ips.ParseAddr(nodeConfig.PublicIP)
+	} else {
+		var resolver dynamicip.Resolver
+		resolver, err = dynamicip.NewSTUNResolver(nodeConfig.PublicIPResolutionSTUNServers)
+		if err == nil {
+			publicAddr, err = resolver.Resolve(ctx)
+		}
+	}
+	if err != nil {
+		return netip.AddrPort{}, fmt.Errorf("couldn't resolve public IP: %w", err)
+	}
+	publicIP := netip.AddrPortFrom(publicAddr, nodeConfig.ListenPort)
+
+	var listenConfig net.ListenConfig
+	listener, err := listenConfig.Listen(
+		ctx,
+		constants.NetworkType,
+		net.JoinHostPort(nodeConfig.ListenHost, fmt.Sprint(nodeConfig.ListenPort)),
+	)
+	if err != nil {
+		return publicIP, fmt.Errorf("couldn't listen on the staking port. Is the node already running? %w", err)
+	}
+	defer listener.Close()
+
+	accepted := make(chan struct{})
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		_ = conn.Close()
+		close(accepted)
+	}()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, constants.NetworkType, publicIP.String())
+	if err != nil {
+		return publicIP, fmt.Errorf("%w: %w", errUnreachable, err)
+	}
+	_ = conn.Close()
+
+	select {
+	case <-accepted:
+		return publicIP, nil
+	case <-ctx.Done():
+		return publicIP, fmt.Errorf("%w: connection wasn't accepted by the staking port", errUnreachable)
+	}
+}
@@ -256,6 +256,24 @@ func InboundChits(
 	}
 }
 
+func InboundAncestors(
+	chainID ids.ID,
+	requestID uint32,
+	containers [][]byte,
+	nodeID ids.NodeID,
+) InboundMessage {
+	return &inboundMessage{
+		nodeID: nodeID,
+		op:     AncestorsOp,
+		message: &p2p.Ancestors{
+			ChainId:    chainID[:],
+			RequestId:  requestID,
+			Containers: containers,
+		},
+		expiration: mockable.MaxTime,
+	}
+}
+
 func InboundAppRequest(
 	chainID ids.ID,
 	requestID uint32,
@@ -314,6 +332,22 @@ func InboundAppResponse(
 	}
 }
 
+func InboundAppGossip(
+	chainID ids.ID,
+	msg []byte,
+	nodeID ids.NodeID,
+) InboundMessage {
+	return &inboundMessage{
+		nodeID: nodeID,
+		op:     AppGossipOp,
+		message: &p2p.AppGossip{
+			ChainId:  chainID[:],
+			AppBytes: msg,
+		},
+		expiration: mockable.MaxTime,
+	}
+}
+
 func encodeIDs(ids []ids.ID, result [][]byte) {
 	for i, id := range ids {
 		result[i] = id[:]
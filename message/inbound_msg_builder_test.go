@@ -334,6 +334,29 @@ func TestInboundMsgBuilder(t *testing.T) {
 		},
 	)
 
+	t.Run(
+		"InboundAncestors",
+		func(t *testing.T) {
+			require := require.New(t)
+
+			msg := InboundAncestors(
+				chainID,
+				requestID,
+				[][]byte{container},
+				nodeID,
+			)
+
+			require.Equal(AncestorsOp, msg.Op())
+			require.Equal(nodeID, msg.NodeID())
+			require.Equal(mockable.MaxTime, msg.Expiration())
+			require.IsType(&p2p.Ancestors{}, msg.Message())
+			innerMsg := msg.Message().(*p2p.Ancestors)
+			require.Equal(chainID[:], innerMsg.ChainId)
+			require.Equal(requestID, innerMsg.RequestId)
+			require.Equal([][]byte{container}, innerMsg.Containers)
+		},
+	)
+
 	t.Run(
 		"InboundAppRequest",
 		func(t *testing.T) {
@@ -383,6 +406,27 @@ func TestInboundMsgBuilder(t *testing.T) {
 			require.Equal(appBytes, innerMsg.AppBytes)
 		},
 	)
+
+	t.Run(
+		"InboundAppGossip",
+		func(t *testing.T) {
+			require := require.New(t)
+
+			msg := InboundAppGossip(
+				chainID,
+				appBytes,
+				nodeID,
+			)
+
+			require.Equal(AppGossipOp, msg.Op())
+			require.Equal(nodeID, msg.NodeID())
+			require.Equal(mockable.MaxTime, msg.Expiration())
+			require.IsType(&p2p.AppGossip{}, msg.Message())
+			innerMsg := msg.Message().(*p2p.AppGossip)
+			require.Equal(chainID[:], innerMsg.ChainId)
+			require.Equal(appBytes, innerMsg.AppBytes)
+		},
+	)
 }
 
 func TestAppError(t *testing.T) {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -55,6 +56,9 @@ type InboundMessage interface {
 	// BytesSavedCompression returns the number of bytes that this message saved
 	// due to being compressed
 	BytesSavedCompression() int
+	// NumBytes returns the number of bytes this message was received as. If the
+	// message wasn't received over the network, 0 is returned.
+	NumBytes() int
 }
 
 type inboundMessage struct {
@@ -64,6 +68,7 @@ type inboundMessage struct {
 	expiration            time.Time
 	onFinishedHandling    func()
 	bytesSavedCompression int
+	numBytes              int
 }
 
 func (m *inboundMessage) NodeID() ids.NodeID {
@@ -92,6 +97,10 @@ func (m *inboundMessage) BytesSavedCompression() int {
 	return m.bytesSavedCompression
 }
 
+func (m *inboundMessage) NumBytes() int {
+	return m.numBytes
+}
+
 func (m *inboundMessage) String() string {
 	return fmt.Sprintf("%s Op: %s Message: %s",
 		m.nodeID, m.op, m.message)
@@ -143,6 +152,11 @@ type msgBuilder struct {
 	count          *prometheus.CounterVec // type + op + direction
 	duration       *prometheus.GaugeVec   // type + op + direction
 
+	// Buffers that messages are encoded and compressed into before the
+	// compressed message is encoded. They are reused across messages, so that
+	// compressing a large message only allocates the bytes that are sent.
+	bufferPool *utils.BytesPool
+
 	maxMessageTimeout time.Duration
 }
 
@@ -174,6 +188,7 @@ func newMsgBuilder(
 			},
 			metricLabels,
 		),
+		bufferPool: utils.NewBytesPool(),
 
 		maxMessageTimeout: maxMessageTimeout,
 	}
@@ -183,15 +198,13 @@ func newMsgBuilder(
 	)
 }
 
+// marshal encodes the message, which references its payloads rather than
+// copying them. If the message isn't compressed, the payloads are only copied
+// into the returned bytes.
 func (mb *msgBuilder) marshal(
 	uncompressedMsg *p2p.Message,
 	compressionType compression.Type,
 ) ([]byte, int, Op, error) {
-	uncompressedMsgBytes, err := proto.Marshal(uncompressedMsg)
-	if err != nil {
-		return nil, 0, 0, err
-	}
-
 	op, err := ToOp(uncompressedMsg)
 	if err != nil {
 		return nil, 0, 0, err
@@ -205,23 +218,48 @@ func (mb *msgBuilder) marshal(
 	// field in the message.
 	var (
 		startTime     = time.Now()
+		compressor    compression.Compressor
 		compressedMsg p2p.Message
 	)
 	switch compressionType {
 	case compression.TypeNone:
-		return uncompressedMsgBytes, 0, op, nil
+		uncompressedMsgBytes, err := proto.Marshal(uncompressedMsg)
+		return uncompressedMsgBytes, 0, op, err
+	case compression.TypeZstd:
+		compressor = mb.zstdCompressor
+	default:
+		return nil, 0, 0, errUnknownCompressionType
+	}
+
+	// The original message and its compressed bytes are only needed until
+	// the message with compressed bytes is encoded, so they are encoded into
+	// pooled buffers.
+	uncompressedMsgBuffer := mb.bufferPool.Get(proto.Size(uncompressedMsg))
+	defer mb.bufferPool.Put(uncompressedMsgBuffer)
+
+	uncompressedMsgBytes, err := proto.MarshalOptions{}.MarshalAppend((*uncompressedMsgBuffer)[:0], uncompressedMsg)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	*uncompressedMsgBuffer = uncompressedMsgBytes
+
+	compressedBuffer := mb.bufferPool.Get(len(uncompressedMsgBytes))
+	defer mb.bufferPool.Put(compressedBuffer)
+
+	compressedBytes, err := compressor.CompressInto(*compressedBuffer, uncompressedMsgBytes)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	// If the pooled buffer was too small, the larger buffer is pooled instead.
+	*compressedBuffer = compressedBytes
+
+	switch compressionType {
 	case compression.TypeZstd:
-		compressedBytes, err := mb.zstdCompressor.Compress(uncompressedMsgBytes)
-		if err != nil {
-			return nil, 0, 0, err
-		}
 		compressedMsg = p2p.Message{
 			Message: &p2p.Message_CompressedZstd{
 				CompressedZstd: compressedBytes,
 			},
 		}
-	default:
-		return nil, 0, 0, errUnknownCompressionType
 	}
 
 	compressedMsgBytes, err := proto.Marshal(&compressedMsg)
@@ -336,5 +374,6 @@ func (mb *msgBuilder) parseInbound(
 		expiration:            expiration,
 		onFinishedHandling:    onFinishedHandling,
 		bytesSavedCompression: bytesSavedCompression,
+		numBytes:              len(bytes),
 	}, nil
 }
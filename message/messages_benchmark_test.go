@@ -15,8 +15,10 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
 )
 
 var (
@@ -133,3 +135,63 @@ func BenchmarkUnmarshalHandshake(b *testing.B) {
 		}
 	}
 }
+
+// Size of the payloads of the benchmarked messages, which leaves room for the
+// rest of the message within the max message size.
+const benchmarkPayloadSize = 2*units.MiB - units.KiB
+
+// Benchmarks creating a "Put" message with a ~2 MiB container. The message is
+// created once and then shared by every peer it is sent to.
+//
+// e.g.,
+//
+//	$ go test -run=NONE -bench=BenchmarkCreatePut -benchmem
+func BenchmarkCreatePut(b *testing.B) {
+	container := utils.RandomBytes(benchmarkPayloadSize)
+	for _, compressionType := range []compression.Type{compression.TypeNone, compression.TypeZstd} {
+		b.Run(compressionType.String(), func(b *testing.B) {
+			require := require.New(b)
+
+			mc, err := NewCreator(logging.NoLog{}, prometheus.NewRegistry(), compressionType, 10*time.Second)
+			require.NoError(err)
+
+			chainID := ids.GenerateTestID()
+			b.SetBytes(int64(len(container)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := mc.Put(chainID, uint32(i), container)
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+// Benchmarks creating an "Ancestors" message with ~2 MiB of containers.
+//
+// e.g.,
+//
+//	$ go test -run=NONE -bench=BenchmarkCreateAncestors -benchmem
+func BenchmarkCreateAncestors(b *testing.B) {
+	containers := make([][]byte, 32)
+	for i := range containers {
+		containers[i] = utils.RandomBytes(benchmarkPayloadSize / len(containers))
+	}
+	for _, compressionType := range []compression.Type{compression.TypeNone, compression.TypeZstd} {
+		b.Run(compressionType.String(), func(b *testing.B) {
+			require := require.New(b)
+
+			mc, err := NewCreator(logging.NoLog{}, prometheus.NewRegistry(), compressionType, 10*time.Second)
+			require.NoError(err)
+
+			chainID := ids.GenerateTestID()
+			b.SetBytes(benchmarkPayloadSize)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := mc.Ancestors(chainID, uint32(i), containers)
+				require.NoError(err)
+			}
+		})
+	}
+}
@@ -15,7 +15,10 @@ import (
 )
 
 const (
-	mapTimeout        = 30 * time.Minute
+	mapTimeout = 30 * time.Minute
+	// Mappings are renewed at least this often so that they are renewed
+	// before they expire, even if a renewal fails.
+	maxRenewalPeriod  = mapTimeout / 3
 	maxRefreshRetries = 3
 )
 
@@ -46,8 +49,14 @@ func GetRouter() Router {
 
 // Mapper attempts to open a set of ports on a router
 type Mapper struct {
-	log    logging.Logger
-	r      Router
+	log logging.Logger
+	// Used to find the router again if renewing a mapping fails.
+	getRouter func() Router
+
+	// Must be held while accessing [r]
+	lock sync.RWMutex
+	r    Router
+
 	closer chan struct{}
 	wg     sync.WaitGroup
 }
@@ -55,12 +64,20 @@ type Mapper struct {
 // NewPortMapper returns an initialized mapper
 func NewPortMapper(log logging.Logger, r Router) *Mapper {
 	return &Mapper{
-		log:    log,
-		r:      r,
-		closer: make(chan struct{}),
+		log:       log,
+		getRouter: GetRouter,
+		r:         r,
+		closer:    make(chan struct{}),
 	}
 }
 
+func (m *Mapper) router() Router {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.r
+}
+
 // Map external port [extPort] (exposed to the internet) to internal port [intPort] (where our process is listening)
 // and set [ip]. Does this every [updateTime]. [ip] may be nil.
 func (m *Mapper) Map(
@@ -70,7 +87,7 @@ func (m *Mapper) Map(
 	ip *utils.Atomic[netip.AddrPort],
 	updateTime time.Duration,
 ) {
-	if !m.r.SupportsNAT() {
+	if !m.router().SupportsNAT() {
 		return
 	}
 
@@ -97,7 +114,7 @@ func (m *Mapper) Map(
 func (m *Mapper) retryMapPort(intPort, extPort uint16, desc string, timeout time.Duration) error {
 	var err error
 	for retryCnt := 0; retryCnt < maxRefreshRetries; retryCnt++ {
-		err = m.r.MapPort(intPort, extPort, desc, timeout)
+		err = m.router().MapPort(intPort, extPort, desc, timeout)
 		if err == nil {
 			return nil
 		}
@@ -115,7 +132,8 @@ func (m *Mapper) retryMapPort(intPort, extPort uint16, desc string, timeout time
 }
 
 // keepPortMapping runs in the background to keep a port mapped. It renews the mapping from [extPort]
-// to [intPort]] every [updateTime]. Updates [ip] every [updateTime].
+// to [intPort]] every [updateTime], or more often if the mapping would expire before then. Updates
+// [ip] on every renewal.
 func (m *Mapper) keepPortMapping(
 	intPort uint16,
 	extPort uint16,
@@ -123,6 +141,7 @@ func (m *Mapper) keepPortMapping(
 	ip *utils.Atomic[netip.AddrPort],
 	updateTime time.Duration,
 ) {
+	updateTime = min(updateTime, maxRenewalPeriod)
 	updateTimer := time.NewTimer(updateTime)
 
 	defer func(extPort uint16) {
@@ -132,7 +151,7 @@ func (m *Mapper) keepPortMapping(
 			zap.Uint16("externalPort", extPort),
 		)
 
-		if err := m.r.UnmapPort(intPort, extPort); err != nil {
+		if err := m.router().UnmapPort(intPort, extPort); err != nil {
 			m.log.Debug("error unmapping port",
 				zap.Uint16("externalPort", extPort),
 				zap.Uint16("internalPort", intPort),
@@ -147,6 +166,11 @@ func (m *Mapper) keepPortMapping(
 		select {
 		case <-updateTimer.C:
 			err := m.retryMapPort(intPort, extPort, desc, mapTimeout)
+			if err != nil && m.rediscoverRouter() {
+				// The router may have restarted and be reachable at a new
+				// address.
+				err = m.retryMapPort(intPort, extPort, desc, mapTimeout)
+			}
 			if err != nil {
 				m.log.Warn("renew NAT traversal failed",
 					zap.Uint16("externalPort", extPort),
@@ -162,11 +186,27 @@ func (m *Mapper) keepPortMapping(
 	}
 }
 
+// rediscoverRouter looks for a router on the current network and replaces the
+// current router with it. Returns true if a router supporting NAT was found.
+func (m *Mapper) rediscoverRouter() bool {
+	r := m.getRouter()
+	if !r.SupportsNAT() {
+		return false
+	}
+
+	m.lock.Lock()
+	m.r = r
+	m.lock.Unlock()
+
+	m.log.Info("rediscovered NAT router")
+	return true
+}
+
 func (m *Mapper) updateIP(ip *utils.Atomic[netip.AddrPort]) {
 	if ip == nil {
 		return
 	}
-	newAddr, err := m.r.ExternalIP()
+	newAddr, err := m.router().ExternalIP()
 	if err != nil {
 		m.log.Error("failed to get external IP",
 			zap.Error(err),
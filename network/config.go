@@ -11,11 +11,13 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/set"
@@ -101,11 +103,12 @@ type ThrottlerConfig struct {
 }
 
 type Config struct {
-	HealthConfig         `json:"healthConfig"`
-	PeerListGossipConfig `json:"peerListGossipConfig"`
-	TimeoutConfig        `json:"timeoutConfigs"`
-	DelayConfig          `json:"delayConfig"`
-	ThrottlerConfig      ThrottlerConfig `json:"throttlerConfig"`
+	HealthConfig           `json:"healthConfig"`
+	PeerListGossipConfig   `json:"peerListGossipConfig"`
+	TimeoutConfig          `json:"timeoutConfigs"`
+	DelayConfig            `json:"delayConfig"`
+	ConnectionPolicyConfig `json:"connectionPolicyConfig"`
+	ThrottlerConfig        ThrottlerConfig `json:"throttlerConfig"`
 
 	ProxyEnabled           bool          `json:"proxyEnabled"`
 	ProxyReadHeaderTimeout time.Duration `json:"proxyReadHeaderTimeout"`
@@ -115,6 +118,11 @@ type Config struct {
 
 	TLSKeyLogFile string `json:"tlsKeyLogFile"`
 
+	// TransportCertRotationFrequency is how often the certificate presented
+	// during TLS handshakes is replaced. The node ID remains derived from the
+	// staking certificate. If 0, the staking certificate is presented.
+	TransportCertRotationFrequency time.Duration `json:"transportCertRotationFrequency"`
+
 	MyNodeID           ids.NodeID                    `json:"myNodeID"`
 	MyIPPort           *utils.Atomic[netip.AddrPort] `json:"myIP"`
 	NetworkID          uint32                        `json:"networkID"`
@@ -122,6 +130,13 @@ type Config struct {
 	PingFrequency      time.Duration                 `json:"pingFrequency"`
 	AllowPrivateIPs    bool                          `json:"allowPrivateIPs"`
 
+	// MySecondaryIPPort is optionally advertised to peers alongside
+	// [MyIPPort]. It must be of the other address family than [MyIPPort].
+	MySecondaryIPPort netip.AddrPort `json:"mySecondaryIP"`
+	// DialPreference selects which IP is dialed when a peer advertises both
+	// an IPv4 and an IPv6 address.
+	DialPreference DialPreference `json:"dialPreference"`
+
 	SupportedACPs set.Set[uint32] `json:"supportedACPs"`
 	ObjectedACPs  set.Set[uint32] `json:"objectedACPs"`
 
@@ -134,6 +149,12 @@ type Config struct {
 	// BLSKey is this node's BLS key that is used to sign IPs.
 	BLSKey bls.Signer `json:"-"`
 
+	// PeerEvents records the lifecycle of peers.
+	PeerEvents *events.Log `json:"-"`
+
+	// ClockSkew is notified of the clock offsets reported by peers.
+	ClockSkew *clockskew.Monitor `json:"-"`
+
 	// TrackedSubnets of the node.
 	// It must not include the primary network ID.
 	TrackedSubnets set.Set[ids.ID]    `json:"-"`
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var errPinnedPeerDenied = errors.New("pinned peer is denied")
+
+// ConnectionPolicyConfig restricts the peers this node connects to.
+type ConnectionPolicyConfig struct {
+	// PinnedPeers are always connected to at the provided IPs. The network
+	// reports itself as unhealthy when it isn't connected to a pinned peer.
+	PinnedPeers map[ids.NodeID]netip.AddrPort `json:"pinnedPeers"`
+
+	// AllowedNodeIDs, if non-empty, are the only peers that this node connects
+	// to, in addition to the pinned peers.
+	AllowedNodeIDs set.Set[ids.NodeID] `json:"allowedNodeIDs"`
+
+	// DeniedNodeIDs are never connected to.
+	DeniedNodeIDs set.Set[ids.NodeID] `json:"deniedNodeIDs"`
+}
+
+// Verify returns an error if the policy contradicts itself.
+func (c *ConnectionPolicyConfig) Verify() error {
+	for nodeID := range c.PinnedPeers {
+		if c.DeniedNodeIDs.Contains(nodeID) {
+			return fmt.Errorf("%w: %s", errPinnedPeerDenied, nodeID)
+		}
+	}
+	return nil
+}
+
+// Allows returns true if the policy permits a connection to [nodeID].
+func (c *ConnectionPolicyConfig) Allows(nodeID ids.NodeID) bool {
+	switch {
+	case c.DeniedNodeIDs.Contains(nodeID):
+		return false
+	case c.AllowedNodeIDs.Len() == 0:
+		return true
+	default:
+		return c.IsPinned(nodeID) || c.AllowedNodeIDs.Contains(nodeID)
+	}
+}
+
+// IsPinned returns true if [nodeID] is a pinned peer.
+func (c *ConnectionPolicyConfig) IsPinned(nodeID ids.NodeID) bool {
+	_, pinned := c.PinnedPeers[nodeID]
+	return pinned
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func TestConnectionPolicyVerify(t *testing.T) {
+	nodeID := ids.GenerateTestNodeID()
+	tests := []struct {
+		name        string
+		policy      ConnectionPolicyConfig
+		expectedErr error
+	}{
+		{
+			name:   "empty",
+			policy: ConnectionPolicyConfig{},
+		},
+		{
+			name: "pinned and allowed",
+			policy: ConnectionPolicyConfig{
+				PinnedPeers: map[ids.NodeID]netip.AddrPort{
+					nodeID: netip.AddrPortFrom(netip.IPv6Loopback(), 9651),
+				},
+				AllowedNodeIDs: set.Of(nodeID),
+			},
+		},
+		{
+			name: "pinned and denied",
+			policy: ConnectionPolicyConfig{
+				PinnedPeers: map[ids.NodeID]netip.AddrPort{
+					nodeID: netip.AddrPortFrom(netip.IPv6Loopback(), 9651),
+				},
+				DeniedNodeIDs: set.Of(nodeID),
+			},
+			expectedErr: errPinnedPeerDenied,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.policy.Verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestConnectionPolicyAllows(t *testing.T) {
+	var (
+		pinnedNodeID  = ids.GenerateTestNodeID()
+		allowedNodeID = ids.GenerateTestNodeID()
+		deniedNodeID  = ids.GenerateTestNodeID()
+		otherNodeID   = ids.GenerateTestNodeID()
+		pinnedPeers   = map[ids.NodeID]netip.AddrPort{
+			pinnedNodeID: netip.AddrPortFrom(netip.IPv6Loopback(), 9651),
+		}
+	)
+	tests := []struct {
+		name     string
+		policy   ConnectionPolicyConfig
+		expected map[ids.NodeID]bool
+	}{
+		{
+			name:   "no restrictions",
+			policy: ConnectionPolicyConfig{},
+			expected: map[ids.NodeID]bool{
+				pinnedNodeID:  true,
+				allowedNodeID: true,
+				deniedNodeID:  true,
+				otherNodeID:   true,
+			},
+		},
+		{
+			name: "deny list",
+			policy: ConnectionPolicyConfig{
+				PinnedPeers:   pinnedPeers,
+				DeniedNodeIDs: set.Of(deniedNodeID),
+			},
+			expected: map[ids.NodeID]bool{
+				pinnedNodeID:  true,
+				allowedNodeID: true,
+				deniedNodeID:  false,
+				otherNodeID:   true,
+			},
+		},
+		{
+			name: "allow list",
+			policy: ConnectionPolicyConfig{
+				PinnedPeers:    pinnedPeers,
+				AllowedNodeIDs: set.Of(allowedNodeID),
+			},
+			expected: map[ids.NodeID]bool{
+				pinnedNodeID:  true,
+				allowedNodeID: true,
+				deniedNodeID:  false,
+				otherNodeID:   false,
+			},
+		},
+		{
+			name: "deny list takes precedence",
+			policy: ConnectionPolicyConfig{
+				AllowedNodeIDs: set.Of(allowedNodeID, deniedNodeID),
+				DeniedNodeIDs:  set.Of(deniedNodeID),
+			},
+			expected: map[ids.NodeID]bool{
+				pinnedNodeID:  false,
+				allowedNodeID: true,
+				deniedNodeID:  false,
+				otherNodeID:   false,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for nodeID, expected := range test.expected {
+				require.Equal(t, expected, test.policy.Allows(nodeID), nodeID)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+const (
+	// DialPreferencePrimary dials the primary IP advertised by a peer.
+	DialPreferencePrimary DialPreference = "primary"
+	// DialPreferenceIPv4 dials the IPv4 address of a peer, if advertised.
+	DialPreferenceIPv4 DialPreference = "ipv4"
+	// DialPreferenceIPv6 dials the IPv6 address of a peer, if advertised.
+	DialPreferenceIPv6 DialPreference = "ipv6"
+)
+
+var errUnknownDialPreference = errors.New("unknown dial preference")
+
+// DialPreference selects which IP is dialed when a peer advertises both an
+// IPv4 and an IPv6 address.
+type DialPreference string
+
+func ParseDialPreference(s string) (DialPreference, error) {
+	switch p := DialPreference(s); p {
+	case DialPreferencePrimary, DialPreferenceIPv4, DialPreferenceIPv6:
+		return p, nil
+	default:
+		return "", fmt.Errorf("%w: %q", errUnknownDialPreference, s)
+	}
+}
+
+// Choose returns the IP to dial out of the [primary] and the optional
+// [secondary] IP of a peer.
+func (p DialPreference) Choose(primary, secondary netip.AddrPort) netip.AddrPort {
+	if !secondary.IsValid() {
+		return primary
+	}
+
+	switch p {
+	case DialPreferenceIPv4:
+		if secondary.Addr().Unmap().Is4() {
+			return secondary
+		}
+	case DialPreferenceIPv6:
+		if !secondary.Addr().Unmap().Is4() {
+			return secondary
+		}
+	}
+	return primary
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDialPreference(t *testing.T) {
+	tests := []struct {
+		str         string
+		expected    DialPreference
+		expectedErr error
+	}{
+		{
+			str:      "primary",
+			expected: DialPreferencePrimary,
+		},
+		{
+			str:      "ipv4",
+			expected: DialPreferenceIPv4,
+		},
+		{
+			str:      "ipv6",
+			expected: DialPreferenceIPv6,
+		},
+		{
+			str:         "",
+			expectedErr: errUnknownDialPreference,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.str, func(t *testing.T) {
+			require := require.New(t)
+
+			preference, err := ParseDialPreference(test.str)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, preference)
+		})
+	}
+}
+
+func TestDialPreferenceChoose(t *testing.T) {
+	var (
+		ipv4 = netip.AddrPortFrom(netip.AddrFrom4([4]byte{1, 2, 3, 4}), 9651)
+		ipv6 = netip.AddrPortFrom(netip.IPv6Loopback(), 9651)
+	)
+	tests := []struct {
+		name       string
+		preference DialPreference
+		primary    netip.AddrPort
+		secondary  netip.AddrPort
+		expected   netip.AddrPort
+	}{
+		{
+			name:       "no secondary",
+			preference: DialPreferenceIPv6,
+			primary:    ipv4,
+			expected:   ipv4,
+		},
+		{
+			name:       "primary",
+			preference: DialPreferencePrimary,
+			primary:    ipv4,
+			secondary:  ipv6,
+			expected:   ipv4,
+		},
+		{
+			name:       "unset",
+			preference: "",
+			primary:    ipv6,
+			secondary:  ipv4,
+			expected:   ipv6,
+		},
+		{
+			name:       "prefer ipv6 secondary",
+			preference: DialPreferenceIPv6,
+			primary:    ipv4,
+			secondary:  ipv6,
+			expected:   ipv6,
+		},
+		{
+			name:       "prefer ipv6 primary",
+			preference: DialPreferenceIPv6,
+			primary:    ipv6,
+			secondary:  ipv4,
+			expected:   ipv6,
+		},
+		{
+			name:       "prefer ipv4 secondary",
+			preference: DialPreferenceIPv4,
+			primary:    ipv6,
+			secondary:  ipv4,
+			expected:   ipv4,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.preference.Choose(test.primary, test.secondary))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dnsseed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var (
+	_ Resolver = (*net.Resolver)(nil)
+
+	errInvalidBootstrapper = errors.New("bootstrapper must be formatted as nodeID@ip:port")
+	errNoNodeID            = errors.New("no node ID")
+	errNoIP                = errors.New("no IP")
+)
+
+// Resolver performs the DNS queries used to discover bootstrappers.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// ParseBootstrapper parses a bootstrapper formatted as nodeID@ip:port.
+func ParseBootstrapper(str string) (genesis.Bootstrapper, error) {
+	nodeIDStr, ipStr, ok := strings.Cut(str, "@")
+	if !ok {
+		return genesis.Bootstrapper{}, fmt.Errorf("%w: %q", errInvalidBootstrapper, str)
+	}
+	nodeID, err := ids.NodeIDFromString(nodeIDStr)
+	if err != nil {
+		return genesis.Bootstrapper{}, fmt.Errorf("couldn't parse bootstrapper id %s: %w", nodeIDStr, err)
+	}
+	ip, err := ips.ParseAddrPort(ipStr)
+	if err != nil {
+		return genesis.Bootstrapper{}, fmt.Errorf("couldn't parse bootstrapper ip %s: %w", ipStr, err)
+	}
+	return genesis.Bootstrapper{
+		ID: nodeID,
+		IP: ip,
+	}, nil
+}
+
+// Seeder discovers bootstrappers from the DNS records of domain names, called
+// seeds.
+//
+// The TXT records of a seed list bootstrappers formatted as nodeID@ip:port,
+// separated by whitespace or commas.
+//
+// The SRV records of a seed point to the hosts and ports of bootstrappers. The
+// TXT record of each host contains the node ID of the bootstrapper.
+type Seeder struct {
+	log      logging.Logger
+	resolver Resolver
+	seeds    []string
+}
+
+func NewSeeder(log logging.Logger, resolver Resolver, seeds []string) *Seeder {
+	return &Seeder{
+		log:      log,
+		resolver: resolver,
+		seeds:    seeds,
+	}
+}
+
+// Lookup returns the bootstrappers listed by all the seeds. Seeds that can't
+// be resolved, and records that can't be parsed, are logged and skipped.
+func (s *Seeder) Lookup(ctx context.Context) []genesis.Bootstrapper {
+	var (
+		nodeIDs       set.Set[ids.NodeID]
+		bootstrappers []genesis.Bootstrapper
+	)
+	for _, seed := range s.seeds {
+		seedBootstrappers, err := s.lookupSeed(ctx, seed)
+		if err != nil {
+			s.log.Warn("couldn't resolve DNS seed",
+				zap.String("seed", seed),
+				zap.Error(err),
+			)
+		}
+		for _, bootstrapper := range seedBootstrappers {
+			if nodeIDs.Contains(bootstrapper.ID) {
+				continue
+			}
+			nodeIDs.Add(bootstrapper.ID)
+			bootstrappers = append(bootstrappers, bootstrapper)
+		}
+	}
+	return bootstrappers
+}
+
+// lookupSeed returns the bootstrappers listed by [seed]. An error is only
+// returned if neither the TXT nor the SRV records of [seed] could be resolved.
+func (s *Seeder) lookupSeed(ctx context.Context, seed string) ([]genesis.Bootstrapper, error) {
+	records, txtErr := s.lookupTXT(ctx, seed)
+	var bootstrappers []genesis.Bootstrapper
+	for _, record := range records {
+		for _, entry := range splitRecord(record) {
+			bootstrapper, err := ParseBootstrapper(entry)
+			if err != nil {
+				s.log.Warn("skipping invalid DNS seed record",
+					zap.String("seed", seed),
+					zap.Error(err),
+				)
+				continue
+			}
+			bootstrappers = append(bootstrappers, bootstrapper)
+		}
+	}
+
+	_, srvs, srvErr := s.resolver.LookupSRV(ctx, "", "", seed)
+	if isNotFound(srvErr) {
+		srvErr = nil
+	}
+	for _, srv := range srvs {
+		bootstrapper, err := s.lookupSRVTarget(ctx, srv)
+		if err != nil {
+			s.log.Warn("skipping invalid DNS seed SRV record",
+				zap.String("seed", seed),
+				zap.String("target", srv.Target),
+				zap.Error(err),
+			)
+			continue
+		}
+		bootstrappers = append(bootstrappers, bootstrapper)
+	}
+
+	if txtErr != nil && srvErr != nil {
+		return nil, errors.Join(txtErr, srvErr)
+	}
+	return bootstrappers, nil
+}
+
+func (s *Seeder) lookupSRVTarget(ctx context.Context, srv *net.SRV) (genesis.Bootstrapper, error) {
+	records, err := s.lookupTXT(ctx, srv.Target)
+	if err != nil {
+		return genesis.Bootstrapper{}, err
+	}
+	var entries []string
+	for _, record := range records {
+		entries = append(entries, splitRecord(record)...)
+	}
+	if len(entries) == 0 {
+		return genesis.Bootstrapper{}, errNoNodeID
+	}
+	nodeID, err := ids.NodeIDFromString(entries[0])
+	if err != nil {
+		return genesis.Bootstrapper{}, err
+	}
+
+	addrs, err := s.resolver.LookupNetIP(ctx, "ip", srv.Target)
+	if err != nil {
+		return genesis.Bootstrapper{}, err
+	}
+	if len(addrs) == 0 {
+		return genesis.Bootstrapper{}, errNoIP
+	}
+	return genesis.Bootstrapper{
+		ID: nodeID,
+		IP: netip.AddrPortFrom(addrs[0].Unmap(), srv.Port),
+	}, nil
+}
+
+func (s *Seeder) lookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, err := s.resolver.LookupTXT(ctx, name)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	return records, err
+}
+
+func splitRecord(record string) []string {
+	return strings.FieldsFunc(record, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
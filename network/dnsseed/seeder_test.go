@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dnsseed
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var errTest = errors.New("non-nil error")
+
+type testResolver struct {
+	txt   map[string][]string
+	srv   map[string][]*net.SRV
+	addrs map[string][]netip.Addr
+	err   error
+}
+
+func (r *testResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, ok := r.txt[name]
+	if !ok {
+		return nil, r.notFound(name)
+	}
+	return records, nil
+}
+
+func (r *testResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	records, ok := r.srv[name]
+	if !ok {
+		return "", nil, r.notFound(name)
+	}
+	return name, records, nil
+}
+
+func (r *testResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	addrs, ok := r.addrs[host]
+	if !ok {
+		return nil, r.notFound(host)
+	}
+	return addrs, nil
+}
+
+func (r *testResolver) notFound(name string) error {
+	if r.err != nil {
+		return r.err
+	}
+	return &net.DNSError{
+		Err:        "no such host",
+		Name:       name,
+		IsNotFound: true,
+	}
+}
+
+func TestParseBootstrapper(t *testing.T) {
+	nodeID := ids.GenerateTestNodeID()
+	tests := []struct {
+		name        string
+		str         string
+		expected    genesis.Bootstrapper
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			str:  nodeID.String() + "@127.0.0.1:9651",
+			expected: genesis.Bootstrapper{
+				ID: nodeID,
+				IP: netip.MustParseAddrPort("127.0.0.1:9651"),
+			},
+		},
+		{
+			name:        "missing ip",
+			str:         nodeID.String(),
+			expectedErr: errInvalidBootstrapper,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			bootstrapper, err := ParseBootstrapper(test.str)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, bootstrapper)
+		})
+	}
+}
+
+func TestSeederLookup(t *testing.T) {
+	var (
+		nodeID0 = ids.GenerateTestNodeID()
+		nodeID1 = ids.GenerateTestNodeID()
+		nodeID2 = ids.GenerateTestNodeID()
+
+		bootstrapper0 = genesis.Bootstrapper{
+			ID: nodeID0,
+			IP: netip.MustParseAddrPort("10.0.0.1:9651"),
+		}
+		bootstrapper1 = genesis.Bootstrapper{
+			ID: nodeID1,
+			IP: netip.MustParseAddrPort("10.0.0.2:9651"),
+		}
+		bootstrapper2 = genesis.Bootstrapper{
+			ID: nodeID2,
+			IP: netip.MustParseAddrPort("10.0.0.3:9650"),
+		}
+	)
+	tests := []struct {
+		name     string
+		resolver *testResolver
+		seeds    []string
+		expected []genesis.Bootstrapper
+	}{
+		{
+			name: "txt records",
+			resolver: &testResolver{
+				txt: map[string][]string{
+					"seeds.example.com": {
+						nodeID0.String() + "@10.0.0.1:9651, " + nodeID1.String() + "@10.0.0.2:9651",
+						"invalid",
+					},
+				},
+			},
+			seeds:    []string{"seeds.example.com"},
+			expected: []genesis.Bootstrapper{bootstrapper0, bootstrapper1},
+		},
+		{
+			name: "srv records",
+			resolver: &testResolver{
+				srv: map[string][]*net.SRV{
+					"seeds.example.com": {
+						{Target: "node2.example.com", Port: 9650},
+						{Target: "unknown.example.com", Port: 9650},
+					},
+				},
+				txt: map[string][]string{
+					"node2.example.com": {nodeID2.String()},
+				},
+				addrs: map[string][]netip.Addr{
+					"node2.example.com": {netip.MustParseAddr("10.0.0.3")},
+				},
+			},
+			seeds:    []string{"seeds.example.com"},
+			expected: []genesis.Bootstrapper{bootstrapper2},
+		},
+		{
+			name: "duplicates across seeds",
+			resolver: &testResolver{
+				txt: map[string][]string{
+					"a.example.com": {nodeID0.String() + "@10.0.0.1:9651"},
+					"b.example.com": {nodeID0.String() + "@10.0.0.1:9651 " + nodeID1.String() + "@10.0.0.2:9651"},
+				},
+			},
+			seeds:    []string{"a.example.com", "b.example.com"},
+			expected: []genesis.Bootstrapper{bootstrapper0, bootstrapper1},
+		},
+		{
+			name: "resolution failure",
+			resolver: &testResolver{
+				err: errTest,
+			},
+			seeds: []string{"seeds.example.com"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := NewSeeder(logging.NoLog{}, test.resolver, test.seeds)
+			require.Equal(t, test.expected, s.Lookup(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dnsseed
+
+import (
+	"context"
+	"time"
+
+	"github.com/ava-labs/avalanchego/genesis"
+)
+
+const lookupTimeout = 30 * time.Second
+
+// Updater periodically refreshes the bootstrappers listed by DNS seeds.
+// Dispatch() and Stop() should only be called once.
+type Updater struct {
+	seeder *Seeder
+	// Called with the bootstrappers listed by the seeds after every refresh.
+	onRefresh func([]genesis.Bootstrapper)
+	// How often the seeds are resolved.
+	refreshFreq time.Duration
+
+	// Cancelling causes Dispatch() to eventually return.
+	// All in-flight lookups will be cancelled.
+	rootCtx       context.Context
+	rootCtxCancel context.CancelFunc
+	// Closed when Dispatch() has returned.
+	doneChan chan struct{}
+}
+
+func NewUpdater(
+	seeder *Seeder,
+	refreshFreq time.Duration,
+	onRefresh func([]genesis.Bootstrapper),
+) *Updater {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Updater{
+		seeder:        seeder,
+		onRefresh:     onRefresh,
+		refreshFreq:   refreshFreq,
+		rootCtx:       ctx,
+		rootCtxCancel: cancel,
+		doneChan:      make(chan struct{}),
+	}
+}
+
+// Lookup resolves the seeds once, bounded by a timeout.
+func (u *Updater) Lookup() []genesis.Bootstrapper {
+	ctx, cancel := context.WithTimeout(u.rootCtx, lookupTimeout)
+	defer cancel()
+	return u.seeder.Lookup(ctx)
+}
+
+// Dispatch refreshes the bootstrappers every [u.refreshFreq] until Stop() is
+// called. Should be called in a goroutine.
+func (u *Updater) Dispatch() {
+	ticker := time.NewTicker(u.refreshFreq)
+	defer func() {
+		ticker.Stop()
+		close(u.doneChan)
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			bootstrappers := u.Lookup()
+			if u.rootCtx.Err() != nil {
+				return
+			}
+			u.onRefresh(bootstrappers)
+		case <-u.rootCtx.Done():
+			return
+		}
+	}
+}
+
+// Stop causes Dispatch() to return and waits until it has returned. Must only
+// be called after Dispatch() was started.
+func (u *Updater) Stop() {
+	u.rootCtxCancel()
+	<-u.doneChan
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package events records peer lifecycle events so that operators can correlate
+// peer flakiness with network events.
+package events
+
+import (
+	"context"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// DefaultCapacity is the number of events the node keeps in memory.
+const DefaultCapacity = 1024
+
+const (
+	// Connected is recorded once the handshake with a peer has finished.
+	Connected Type = "connected"
+	// Disconnected is recorded when the connection to a connected peer is
+	// closed.
+	Disconnected Type = "disconnected"
+	// HandshakeFailed is recorded when a connection is closed before the
+	// handshake with the peer finished.
+	HandshakeFailed Type = "handshakeFailed"
+	// Benched is recorded when a peer is benched on a chain.
+	Benched Type = "benched"
+	// Unbenched is recorded when a peer is removed from the benchlist of a
+	// chain.
+	Unbenched Type = "unbenched"
+	// InboundThrottled is recorded when an inbound connection is rejected
+	// before the TLS handshake. The node ID of the peer is unknown.
+	InboundThrottled Type = "inboundThrottled"
+)
+
+const (
+	// ConnectionClosed is reported if the connection was closed without a
+	// more specific reason, such as a read or write error.
+	ConnectionClosed         Reason = "connectionClosed"
+	InvalidHandshake         Reason = "invalidHandshake"
+	NetworkIDMismatch        Reason = "networkIDMismatch"
+	ClockDifference          Reason = "clockDifference"
+	IncompatibleVersion      Reason = "incompatibleVersion"
+	InvalidBLSSignature      Reason = "invalidBLSSignature"
+	ConnectionNoLongerWanted Reason = "connectionNoLongerWanted"
+)
+
+var _ benchlist.Benchable = (*benchable)(nil)
+
+type (
+	Type   string
+	Reason string
+)
+
+type Event struct {
+	// Index is unique and increases with every recorded event.
+	Index json.Uint64 `json:"index"`
+	Time  time.Time   `json:"time"`
+	Type  Type        `json:"type"`
+	// NodeID is empty for [InboundThrottled] events.
+	NodeID ids.NodeID     `json:"nodeID"`
+	IP     netip.AddrPort `json:"ip,omitempty"`
+	// ChainID is only set for [Benched] and [Unbenched] events.
+	ChainID ids.ID `json:"chainID"`
+	Reason  Reason `json:"reason,omitempty"`
+}
+
+// Log keeps the most recent events in memory.
+type Log struct {
+	clock mockable.Clock
+
+	lock sync.Mutex
+	// events is a ring buffer of the most recent events. The event with index
+	// i is stored at events[i % len(events)].
+	events    []Event
+	nextIndex uint64
+	// newEvent is closed, and replaced, when an event is recorded.
+	newEvent chan struct{}
+}
+
+// NewLog returns a log that keeps the [capacity] most recent events.
+func NewLog(capacity int) *Log {
+	return &Log{
+		events:   make([]Event, max(capacity, 1)),
+		newEvent: make(chan struct{}),
+	}
+}
+
+// Record assigns the next index and the current time to [event] and adds it to
+// the log, evicting the oldest event if the log is full.
+func (l *Log) Record(event Event) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	event.Index = json.Uint64(l.nextIndex)
+	event.Time = l.clock.Time()
+	l.events[l.nextIndex%uint64(len(l.events))] = event
+	l.nextIndex++
+
+	close(l.newEvent)
+	l.newEvent = make(chan struct{})
+}
+
+// Wait returns up to [limit] events starting at [startIndex], blocking until
+// at least one such event has been recorded or [ctx] is done. If events
+// starting at [startIndex] were already evicted, the oldest remaining events
+// are returned. The index to continue from is returned alongside the events.
+func (l *Log) Wait(ctx context.Context, startIndex uint64, limit int) ([]Event, uint64) {
+	for {
+		events, nextIndex, newEvent := l.get(startIndex, limit)
+		if len(events) > 0 {
+			return events, nextIndex
+		}
+
+		select {
+		case <-newEvent:
+		case <-ctx.Done():
+			return nil, nextIndex
+		}
+	}
+}
+
+func (l *Log) get(startIndex uint64, limit int) ([]Event, uint64, <-chan struct{}) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var oldestIndex uint64
+	if numEvicted := int64(l.nextIndex) - int64(len(l.events)); numEvicted > 0 {
+		oldestIndex = uint64(numEvicted)
+	}
+	startIndex = min(max(startIndex, oldestIndex), l.nextIndex)
+	endIndex := min(l.nextIndex, startIndex+uint64(max(limit, 0)))
+
+	events := make([]Event, 0, endIndex-startIndex)
+	for i := startIndex; i < endIndex; i++ {
+		events = append(events, l.events[i%uint64(len(l.events))])
+	}
+	return events, endIndex, l.newEvent
+}
+
+// WrapBenchable returns a [benchlist.Benchable] that records bench events
+// before notifying [b].
+func (l *Log) WrapBenchable(b benchlist.Benchable) benchlist.Benchable {
+	return &benchable{
+		log:       l,
+		benchable: b,
+	}
+}
+
+type benchable struct {
+	log       *Log
+	benchable benchlist.Benchable
+}
+
+func (b *benchable) Benched(chainID ids.ID, nodeID ids.NodeID) {
+	b.log.Record(Event{
+		Type:    Benched,
+		NodeID:  nodeID,
+		ChainID: chainID,
+	})
+	b.benchable.Benched(chainID, nodeID)
+}
+
+func (b *benchable) Unbenched(chainID ids.ID, nodeID ids.NodeID) {
+	b.log.Record(Event{
+		Type:    Unbenched,
+		NodeID:  nodeID,
+		ChainID: chainID,
+	})
+	b.benchable.Unbenched(chainID, nodeID)
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+func TestLogWait(t *testing.T) {
+	l := NewLog(3)
+	nodeIDs := make([]ids.NodeID, 5)
+	for i := range nodeIDs {
+		nodeIDs[i] = ids.GenerateTestNodeID()
+		l.Record(Event{
+			Type:   Connected,
+			NodeID: nodeIDs[i],
+		})
+	}
+
+	tests := []struct {
+		name              string
+		startIndex        uint64
+		limit             int
+		expectedIndex     uint64
+		expectedNextIndex uint64
+	}{
+		{
+			name:              "evicted events are skipped",
+			startIndex:        0,
+			limit:             10,
+			expectedIndex:     2,
+			expectedNextIndex: 5,
+		},
+		{
+			name:              "limited",
+			startIndex:        2,
+			limit:             2,
+			expectedIndex:     2,
+			expectedNextIndex: 4,
+		},
+		{
+			name:              "latest event",
+			startIndex:        4,
+			limit:             10,
+			expectedIndex:     4,
+			expectedNextIndex: 5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			events, nextIndex := l.Wait(context.Background(), test.startIndex, test.limit)
+			require.Equal(test.expectedNextIndex, nextIndex)
+			require.Len(events, int(test.expectedNextIndex-test.expectedIndex))
+			for i, event := range events {
+				index := test.expectedIndex + uint64(i)
+				require.Equal(json.Uint64(index), event.Index)
+				require.Equal(nodeIDs[index], event.NodeID)
+			}
+		})
+	}
+}
+
+func TestLogWaitBlocksUntilRecorded(t *testing.T) {
+	require := require.New(t)
+
+	l := NewLog(DefaultCapacity)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	events, nextIndex := l.Wait(ctx, 0, 1)
+	require.Empty(events)
+	require.Zero(nextIndex)
+
+	nodeID := ids.GenerateTestNodeID()
+	go l.Record(Event{
+		Type:   Disconnected,
+		NodeID: nodeID,
+		Reason: ConnectionClosed,
+	})
+
+	events, nextIndex = l.Wait(context.Background(), 0, 1)
+	require.Len(events, 1)
+	require.Equal(nodeID, events[0].NodeID)
+	require.Equal(ConnectionClosed, events[0].Reason)
+	require.Equal(uint64(1), nextIndex)
+}
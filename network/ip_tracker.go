@@ -6,6 +6,7 @@ package network
 import (
 	"crypto/rand"
 	"errors"
+	"maps"
 	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -50,7 +51,7 @@ func newIPTracker(
 		return nil, err
 	}
 	tracker := &ipTracker{
-		trackedSubnets: trackedSubnets,
+		trackedSubnets: maps.Clone(trackedSubnets),
 		log:            log,
 		numTrackedPeers: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "tracked_peers",
@@ -263,6 +264,49 @@ func (i *ipTracker) ManuallyGossip(subnetID ids.ID, nodeID ids.NodeID) {
 	i.addGossipableID(nodeID, subnetID, true)
 }
 
+// TrackSubnet marks [subnetID] as tracked, so that connections to the
+// subnet's validators are desired. Returns the most recent IPs of the
+// validators whose connection wasn't previously desired.
+func (i *ipTracker) TrackSubnet(subnetID ids.ID) []*ips.ClaimedIPPort {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.trackedSubnets.Contains(subnetID) {
+		return nil
+	}
+	i.trackedSubnets.Add(subnetID)
+
+	var newIPs []*ips.ClaimedIPPort
+	for _, node := range i.tracked {
+		if !node.validatedSubnets.Contains(subnetID) {
+			continue
+		}
+
+		wantedConnection := node.wantsConnection()
+		node.trackedSubnets.Add(subnetID)
+		if !wantedConnection && node.ip != nil {
+			newIPs = append(newIPs, node.ip)
+		}
+	}
+	return newIPs
+}
+
+// UntrackSubnet marks [subnetID] as no longer tracked. Connections to the
+// subnet's validators are only desired if they are desired for another reason.
+func (i *ipTracker) UntrackSubnet(subnetID ids.ID) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if !i.trackedSubnets.Contains(subnetID) {
+		return
+	}
+	i.trackedSubnets.Remove(subnetID)
+
+	for _, node := range i.tracked {
+		node.trackedSubnets.Remove(subnetID)
+	}
+}
+
 // WantsConnection returns true if any of the following conditions are met:
 //  1. The node has been manually tracked.
 //  2. The node has been manually gossiped on a tracked subnet.
@@ -1170,6 +1170,29 @@ func TestIPTracker_OnValidatorRemoved(t *testing.T) {
 	}
 }
 
+func TestIPTracker_TrackSubnet(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	tracker := newTestIPTracker(t)
+	tracker.OnValidatorAdded(subnetID, ip.NodeID, nil, ids.Empty, 0)
+	require.False(tracker.AddIP(ip))
+	require.False(tracker.WantsConnection(ip.NodeID))
+
+	require.Equal([]*ips.ClaimedIPPort{ip}, tracker.TrackSubnet(subnetID))
+	require.True(tracker.WantsConnection(ip.NodeID))
+	require.True(tracker.tracked[ip.NodeID].trackedSubnets.Contains(subnetID))
+
+	// Tracking the subnet again doesn't report the IP again
+	require.Empty(tracker.TrackSubnet(subnetID))
+
+	tracker.UntrackSubnet(subnetID)
+	require.False(tracker.WantsConnection(ip.NodeID))
+	require.False(tracker.tracked[ip.NodeID].trackedSubnets.Contains(subnetID))
+	require.True(tracker.tracked[ip.NodeID].validatedSubnets.Contains(subnetID))
+	requireMetricsConsistent(t, tracker)
+}
+
 func TestIPTracker_BloomGrows(t *testing.T) {
 	tests := []struct {
 		name string
@@ -5,6 +5,7 @@ package network
 
 import (
 	"errors"
+	"maps"
 	"sync"
 	"time"
 
@@ -16,7 +17,8 @@ import (
 )
 
 type metrics struct {
-	// trackedSubnets does not include the primary network ID
+	// trackedSubnets does not include the primary network ID. It is only
+	// accessed with [lock] held.
 	trackedSubnets set.Set[ids.ID]
 
 	numTracked                   prometheus.Gauge
@@ -45,7 +47,7 @@ func newMetrics(
 	trackedSubnets set.Set[ids.ID],
 ) (*metrics, error) {
 	m := &metrics{
-		trackedSubnets: trackedSubnets,
+		trackedSubnets: maps.Clone(trackedSubnets),
 		numPeers: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "peers",
 			Help: "Number of network peers",
@@ -151,6 +153,9 @@ func (m *metrics) markConnected(peer peer.Peer) {
 	m.numPeers.Inc()
 	m.connected.Inc()
 
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	trackedSubnets := peer.TrackedSubnets()
 	for subnetID := range m.trackedSubnets {
 		if trackedSubnets.Contains(subnetID) {
@@ -158,9 +163,6 @@ func (m *metrics) markConnected(peer peer.Peer) {
 		}
 	}
 
-	m.lock.Lock()
-	defer m.lock.Unlock()
-
 	now := float64(time.Now().UnixNano())
 	m.peerConnectedStartTimes[peer.ID()] = now
 	m.peerConnectedStartTimesSum += now
@@ -170,6 +172,9 @@ func (m *metrics) markDisconnected(peer peer.Peer) {
 	m.numPeers.Dec()
 	m.disconnected.Inc()
 
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
 	trackedSubnets := peer.TrackedSubnets()
 	for subnetID := range m.trackedSubnets {
 		if trackedSubnets.Contains(subnetID) {
@@ -177,9 +182,6 @@ func (m *metrics) markDisconnected(peer peer.Peer) {
 		}
 	}
 
-	m.lock.Lock()
-	defer m.lock.Unlock()
-
 	peerID := peer.ID()
 	start := m.peerConnectedStartTimes[peerID]
 	m.peerConnectedStartTimesSum -= start
@@ -187,6 +189,25 @@ func (m *metrics) markDisconnected(peer peer.Peer) {
 	delete(m.peerConnectedStartTimes, peerID)
 }
 
+// trackSubnet starts reporting the number of peers tracking [subnetID], which
+// is initially [numPeers].
+func (m *metrics) trackSubnet(subnetID ids.ID, numPeers int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.trackedSubnets.Add(subnetID)
+	m.numSubnetPeers.WithLabelValues(subnetID.String()).Set(float64(numPeers))
+}
+
+// untrackSubnet stops reporting the number of peers tracking [subnetID].
+func (m *metrics) untrackSubnet(subnetID ids.ID) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.trackedSubnets.Remove(subnetID)
+	m.numSubnetPeers.DeleteLabelValues(subnetID.String())
+}
+
 func (m *metrics) updatePeerConnectionLifetimeMetrics() {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
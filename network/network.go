@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"net"
 	"net/netip"
@@ -24,12 +25,15 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
+	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bloom"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -47,6 +51,7 @@ const (
 	TimeSinceLastMsgReceivedKey      = "timeSinceLastMsgReceived"
 	TimeSinceLastMsgSentKey          = "timeSinceLastMsgSent"
 	SendFailRateKey                  = "sendFailRate"
+	DisconnectedPinnedPeersKey       = "disconnectedPinnedPeers"
 )
 
 var (
@@ -56,13 +61,14 @@ var (
 	errExpectedProxy          = errors.New("expected proxy")
 	errExpectedTCPProtocol    = errors.New("expected TCP protocol")
 	errTrackingPrimaryNetwork = errors.New("cannot track primary network")
+	errMissingTLSCertificate  = errors.New("missing TLS certificate")
 )
 
 // Network defines the functionality of the networking library.
 type Network interface {
 	// All consensus messages can be sent through this interface. Thread safety
 	// must be managed internally in the network.
-	sender.ExternalSender
+	sender.ResultSender
 
 	// Has a health check
 	health.Checker
@@ -89,6 +95,17 @@ type Network interface {
 	// NodeUptime returns given node's primary network UptimeResults in the view of
 	// this node's peer validators.
 	NodeUptime() (UptimeResult, error)
+
+	// TrackSubnet starts tracking [subnetID]. The subnet is advertised to
+	// peers that connect afterwards, connections to the subnet's validators
+	// are desired, and the connected peers that track the subnet are reported
+	// to the router as connected to it.
+	TrackSubnet(subnetID ids.ID)
+
+	// UntrackSubnet stops tracking [subnetID]. The subnet is no longer
+	// advertised to peers that connect afterwards, and connections to the
+	// subnet's validators are no longer desired.
+	UntrackSubnet(subnetID ids.ID)
 }
 
 type UptimeResult struct {
@@ -110,8 +127,9 @@ type UptimeResult struct {
 // To avoid potential deadlocks, we maintain that locks must be grabbed in the
 // following order:
 //
-// 1. peersLock
-// 2. manuallyTrackedIDsLock
+// 1. peerConfig.MySubnetsLock
+// 2. peersLock
+// 3. manuallyTrackedIDsLock
 //
 // If a higher lock (e.g. manuallyTrackedIDsLock) is held when trying to grab a
 // lower lock (e.g. peersLock) a deadlock could occur.
@@ -142,6 +160,14 @@ type network struct {
 
 	sendFailRateCalculator safemath.Averager
 
+	// myCert is used to claim this node's IP in the PeerList messages sent
+	// when our IP changes.
+	myCert *staking.Certificate
+	// announcedIP is the most recent IP of this node that connected peers
+	// were told about, either during the handshake or by [announceIPChange].
+	// Only accessed by [runTimers] after initialization.
+	announcedIP *peer.SignedIP
+
 	// Tracks which peers know about which peers
 	ipTracker *ipTracker
 	peersLock sync.RWMutex
@@ -209,6 +235,23 @@ func NewNetwork(
 		return nil, errTrackingPrimaryNetwork
 	}
 
+	if len(config.TLSConfig.Certificates) == 0 || len(config.TLSConfig.Certificates[0].Certificate) == 0 {
+		return nil, errMissingTLSCertificate
+	}
+	myCert, err := staking.ParseCertificate(config.TLSConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing TLS certificate failed with: %w", err)
+	}
+
+	var transportCerts *peer.TransportCertRotator
+	if config.TransportCertRotationFrequency > 0 {
+		transportCerts = peer.NewTransportCertRotator(
+			myCert,
+			config.TLSKey,
+			config.TransportCertRotationFrequency,
+		)
+	}
+
 	inboundMsgThrottler, err := throttling.NewInboundMsgThrottler(
 		log,
 		metricsRegisterer,
@@ -270,7 +313,7 @@ func NewNetwork(
 		Router:               router,
 		VersionCompatibility: version.GetCompatibility(minCompatibleTime),
 		MyNodeID:             config.MyNodeID,
-		MySubnets:            config.TrackedSubnets,
+		MySubnets:            maps.Clone(config.TrackedSubnets),
 		Beacons:              config.Beacons,
 		Validators:           config.Validators,
 		NetworkID:            config.NetworkID,
@@ -281,7 +324,13 @@ func NewNetwork(
 		ObjectedACPs:         config.ObjectedACPs.List(),
 		ResourceTracker:      config.ResourceTracker,
 		UptimeCalculator:     config.UptimeCalculator,
-		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey),
+		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.MySecondaryIPPort, config.TLSKey, config.BLSKey),
+		Events:               config.PeerEvents,
+		ClockSkew:            config.ClockSkew,
+	}
+	announcedIP, err := peerConfig.IPSigner.GetSignedIP()
+	if err != nil {
+		return nil, fmt.Errorf("signing IP failed with: %w", err)
 	}
 
 	onCloseCtx, cancel := context.WithCancel(context.Background())
@@ -295,8 +344,8 @@ func NewNetwork(
 		inboundConnUpgradeThrottler: throttling.NewInboundConnUpgradeThrottler(log, config.ThrottlerConfig.InboundConnUpgradeThrottlerConfig),
 		listener:                    listener,
 		dialer:                      dialer,
-		serverUpgrader:              peer.NewTLSServerUpgrader(config.TLSConfig, metrics.tlsConnRejected),
-		clientUpgrader:              peer.NewTLSClientUpgrader(config.TLSConfig, metrics.tlsConnRejected),
+		serverUpgrader:              peer.NewTLSServerUpgrader(config.TLSConfig, transportCerts, metrics.tlsConnRejected),
+		clientUpgrader:              peer.NewTLSClientUpgrader(config.TLSConfig, transportCerts, metrics.tlsConnRejected),
 
 		onCloseCtx:       onCloseCtx,
 		onCloseCtxCancel: cancel,
@@ -307,6 +356,9 @@ func NewNetwork(
 			time.Now(),
 		)),
 
+		myCert:      myCert,
+		announcedIP: announcedIP,
+
 		trackedIPs:      make(map[ids.NodeID]*trackedIP),
 		ipTracker:       ipTracker,
 		connectingPeers: peer.NewSet(),
@@ -323,7 +375,30 @@ func (n *network) Send(
 	subnetID ids.ID,
 	allower subnets.Allower,
 ) set.Set[ids.NodeID] {
-	namedPeers := n.getPeers(config.NodeIDs, subnetID, allower)
+	return n.SendWithResult(msg, config, subnetID, allower).SentTo
+}
+
+func (n *network) SendWithResult(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	subnetID ids.ID,
+	allower subnets.Allower,
+) sender.SendResult {
+	result := sender.SendResult{
+		Failed: make(map[ids.NodeID]sender.SendFailure),
+	}
+
+	// A message larger than the maximum message size would be dropped when
+	// it is written to the connection, so don't queue it for any peer.
+	if len(msg.Bytes()) > constants.DefaultMaxMessageSize {
+		n.peerConfig.Metrics.MultipleSendsFailed(msg.Op(), config.NodeIDs.Len())
+		for nodeID := range config.NodeIDs {
+			result.Failed[nodeID] = sender.MessageTooLarge
+		}
+		return result
+	}
+
+	namedPeers := n.getPeers(config.NodeIDs, subnetID, allower, result.Failed)
 	n.peerConfig.Metrics.MultipleSendsFailed(
 		msg.Op(),
 		config.NodeIDs.Len()-len(namedPeers),
@@ -331,9 +406,9 @@ func (n *network) Send(
 
 	var (
 		sampledPeers = n.samplePeers(config, subnetID, allower)
-		sentTo       = set.NewSet[ids.NodeID](len(namedPeers) + len(sampledPeers))
 		now          = n.peerConfig.Clock.Time()
 	)
+	result.SentTo = set.NewSet[ids.NodeID](len(namedPeers) + len(sampledPeers))
 
 	// send to peers and update metrics
 	//
@@ -341,18 +416,24 @@ func (n *network) Send(
 	for _, peers := range [][]peer.Peer{namedPeers, sampledPeers} {
 		for _, peer := range peers {
 			if peer.Send(n.onCloseCtx, msg) {
-				sentTo.Add(peer.ID())
+				result.SentTo.Add(peer.ID())
 
 				// TODO: move send fail rate calculations into the peer metrics
 				// record metrics for success
 				n.sendFailRateCalculator.Observe(0, now)
 			} else {
+				// Only peers that were explicitly requested are reported as
+				// failed.
+				if config.NodeIDs.Contains(peer.ID()) {
+					result.Failed[peer.ID()] = sender.QueueFull
+				}
+
 				// record metrics for failure
 				n.sendFailRateCalculator.Observe(1, now)
 			}
 		}
 	}
-	return sentTo
+	return result
 }
 
 // HealthCheck returns information about several network layer health checks.
@@ -361,6 +442,12 @@ func (n *network) Send(
 func (n *network) HealthCheck(context.Context) (interface{}, error) {
 	n.peersLock.RLock()
 	connectedTo := n.connectedPeers.Len()
+	var disconnectedPinnedPeers []ids.NodeID
+	for nodeID := range n.config.PinnedPeers {
+		if _, ok := n.connectedPeers.GetByID(nodeID); !ok {
+			disconnectedPinnedPeers = append(disconnectedPinnedPeers, nodeID)
+		}
+	}
 	n.peersLock.RUnlock()
 
 	sendFailRate := n.sendFailRateCalculator.Read()
@@ -413,6 +500,14 @@ func (n *network) HealthCheck(context.Context) (interface{}, error) {
 	}
 	healthy = healthy && reachablePrimaryNetworkValidator
 
+	// Make sure we're connected to all the pinned peers
+	connectedToPinnedPeers := len(disconnectedPinnedPeers) == 0
+	if len(n.config.PinnedPeers) > 0 {
+		utils.Sort(disconnectedPinnedPeers)
+		details[DisconnectedPinnedPeersKey] = disconnectedPinnedPeers
+	}
+	healthy = healthy && connectedToPinnedPeers
+
 	// emit metrics about the lifetime of peer connections
 	n.metrics.updatePeerConnectionLifetimeMetrics()
 
@@ -444,6 +539,10 @@ func (n *network) HealthCheck(context.Context) (interface{}, error) {
 		errorReasons = append(errorReasons, ErrNoIngressConnections.Error())
 	}
 
+	if !connectedToPinnedPeers {
+		errorReasons = append(errorReasons, fmt.Sprintf("not connected to pinned peer(s) %v", disconnectedPinnedPeers))
+	}
+
 	return details, fmt.Errorf("network layer is unhealthy reason: %s", strings.Join(errorReasons, ", "))
 }
 
@@ -454,6 +553,11 @@ func (n *network) IngressConnCount() int {
 // Connected is called after the peer finishes the handshake.
 // Will not be called after [Disconnected] is called with this peer.
 func (n *network) Connected(nodeID ids.NodeID) {
+	// Tracked subnets can't be modified until the peer is reported as
+	// connected to them.
+	n.peerConfig.MySubnetsLock.RLock()
+	defer n.peerConfig.MySubnetsLock.RUnlock()
+
 	n.peersLock.Lock()
 	peer, ok := n.connectingPeers.GetByID(nodeID)
 	if !ok {
@@ -480,6 +584,8 @@ func (n *network) Connected(nodeID ids.NodeID) {
 		peerIP.Timestamp,
 		peerIP.TLSSignature,
 	)
+	newIP.SecondaryAddrPort = peerIP.SecondaryAddrPort
+	newIP.SecondarySignature = peerIP.SecondaryTLSSignature
 	trackedSubnets := peer.TrackedSubnets()
 	n.ipTracker.Connected(newIP, trackedSubnets)
 
@@ -494,12 +600,87 @@ func (n *network) Connected(nodeID ids.NodeID) {
 	}
 }
 
+func (n *network) TrackSubnet(subnetID ids.ID) {
+	if subnetID == constants.PrimaryNetworkID {
+		return
+	}
+
+	n.peerConfig.MySubnetsLock.Lock()
+	defer n.peerConfig.MySubnetsLock.Unlock()
+
+	if n.peerConfig.MySubnets.Contains(subnetID) {
+		return
+	}
+	n.peerConfig.MySubnets.Add(subnetID)
+
+	newIPs := n.ipTracker.TrackSubnet(subnetID)
+
+	n.peersLock.Lock()
+	subnetPeers := n.connectedPeers.Sample(n.connectedPeers.Len(), func(p peer.Peer) bool {
+		trackedSubnets := p.TrackedSubnets()
+		return trackedSubnets.Contains(subnetID)
+	})
+	for _, ip := range newIPs {
+		if !n.config.Allows(ip.NodeID) {
+			continue
+		}
+		if _, connected := n.connectedPeers.GetByID(ip.NodeID); connected {
+			continue
+		}
+		if _, isTracked := n.trackedIPs[ip.NodeID]; isTracked {
+			continue
+		}
+
+		tracked := newTrackedIP(n.config.DialPreference.Choose(ip.AddrPort, ip.SecondaryAddrPort))
+		n.trackedIPs[ip.NodeID] = tracked
+		n.dial(ip.NodeID, tracked)
+	}
+	n.peersLock.Unlock()
+
+	n.metrics.trackSubnet(subnetID, len(subnetPeers))
+
+	n.peerConfig.Log.Info("tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+		zap.Int("numConnectedPeers", len(subnetPeers)),
+		zap.Int("numNewIPs", len(newIPs)),
+	)
+
+	// Peers that connected before the subnet was tracked only learn that this
+	// node tracks it once they reconnect. However, this node can already
+	// send requests to them on behalf of the subnet's chains.
+	n.router.Connected(n.config.MyNodeID, version.CurrentApp, subnetID)
+	for _, p := range subnetPeers {
+		n.router.Connected(p.ID(), p.Version(), subnetID)
+	}
+}
+
+func (n *network) UntrackSubnet(subnetID ids.ID) {
+	n.peerConfig.MySubnetsLock.Lock()
+	defer n.peerConfig.MySubnetsLock.Unlock()
+
+	if !n.peerConfig.MySubnets.Contains(subnetID) {
+		return
+	}
+	n.peerConfig.MySubnets.Remove(subnetID)
+
+	n.ipTracker.UntrackSubnet(subnetID)
+	n.metrics.untrackSubnet(subnetID)
+
+	n.peerConfig.Log.Info("stopped tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+	)
+}
+
 // AllowConnection returns true if this node should have a connection to the
-// provided nodeID. If the node is attempting to connect to the minimum number
-// of peers, then it should only connect if this node is a validator, or the
-// peer is a validator/beacon.
+// provided nodeID. Connections are never allowed if the connection policy
+// forbids them. If the node is attempting to connect to the minimum number of
+// peers, then it should only connect if this node is a validator, or the peer
+// is a validator/beacon/pinned peer.
 func (n *network) AllowConnection(nodeID ids.NodeID) bool {
-	if !n.config.RequireValidatorToConnect {
+	if !n.config.Allows(nodeID) {
+		return false
+	}
+	if !n.config.RequireValidatorToConnect || n.config.IsPinned(nodeID) {
 		return true
 	}
 	_, areWeAPrimaryNetworkAValidator := n.config.Validators.GetValidator(constants.PrimaryNetworkID, n.config.MyNodeID)
@@ -641,6 +822,10 @@ func (n *network) Dispatch() error {
 					zap.Stringer("peerIP", ip),
 				)
 				n.metrics.inboundConnRateLimited.Inc()
+				n.config.PeerEvents.Record(events.Event{
+					Type: events.InboundThrottled,
+					IP:   ip,
+				})
 				_ = conn.Close()
 				return
 			}
@@ -675,6 +860,13 @@ func (n *network) Dispatch() error {
 }
 
 func (n *network) ManuallyTrack(nodeID ids.NodeID, ip netip.AddrPort) {
+	if !n.config.Allows(nodeID) {
+		n.peerConfig.Log.Info("not tracking peer forbidden by the connection policy",
+			zap.Stringer("nodeID", nodeID),
+		)
+		return
+	}
+
 	n.ipTracker.ManuallyTrack(nodeID)
 
 	n.peersLock.Lock()
@@ -705,7 +897,7 @@ func (n *network) track(ip *ips.ClaimedIPPort, trackAllSubnets bool) error {
 	//
 	// Note: Avoiding signature verification when the IP isn't needed is a
 	// **significant** performance optimization.
-	if !n.ipTracker.ShouldVerifyIP(ip, trackAllSubnets) {
+	if !n.config.Allows(ip.NodeID) || !n.ipTracker.ShouldVerifyIP(ip, trackAllSubnets) {
 		n.metrics.numUselessPeerListBytes.Add(float64(ip.Size()))
 		return nil
 	}
@@ -717,7 +909,9 @@ func (n *network) track(ip *ips.ClaimedIPPort, trackAllSubnets bool) error {
 			AddrPort:  ip.AddrPort,
 			Timestamp: ip.Timestamp,
 		},
-		TLSSignature: ip.Signature,
+		TLSSignature:          ip.Signature,
+		SecondaryAddrPort:     ip.SecondaryAddrPort,
+		SecondaryTLSSignature: ip.SecondarySignature,
 	}
 	maxTimestamp := n.peerConfig.Clock.Time().Add(n.peerConfig.MaxClockDifference)
 	if err := signedIP.Verify(ip.Cert, maxTimestamp); err != nil {
@@ -737,12 +931,13 @@ func (n *network) track(ip *ips.ClaimedIPPort, trackAllSubnets bool) error {
 		return nil
 	}
 
+	dialIP := n.config.DialPreference.Choose(ip.AddrPort, ip.SecondaryAddrPort)
 	tracked, isTracked := n.trackedIPs[ip.NodeID]
 	if isTracked {
 		// Stop tracking the old IP and start tracking the new one.
-		tracked = tracked.trackNewIP(ip.AddrPort)
+		tracked = tracked.trackNewIP(dialIP)
 	} else {
-		tracked = newTrackedIP(ip.AddrPort)
+		tracked = newTrackedIP(dialIP)
 	}
 	n.trackedIPs[ip.NodeID] = tracked
 	n.dial(ip.NodeID, tracked)
@@ -757,10 +952,12 @@ func (n *network) track(ip *ips.ClaimedIPPort, trackAllSubnets bool) error {
 //     determine if the node is a validator.
 //   - [allower] interface that determines if a node is allowed to connect to
 //     the subnet based on its validator status.
+//   - [failed] records the reason each of the other nodes was excluded.
 func (n *network) getPeers(
 	nodeIDs set.Set[ids.NodeID],
 	subnetID ids.ID,
 	allower subnets.Allower,
+	failed map[ids.NodeID]sender.SendFailure,
 ) []peer.Peer {
 	peers := make([]peer.Peer, 0, nodeIDs.Len())
 
@@ -770,12 +967,14 @@ func (n *network) getPeers(
 	for nodeID := range nodeIDs {
 		peer, ok := n.connectedPeers.GetByID(nodeID)
 		if !ok {
+			failed[nodeID] = sender.NotConnected
 			continue
 		}
 
 		_, areTheyAValidator := n.config.Validators.GetValidator(subnetID, nodeID)
 		// check if the peer is allowed to connect to the subnet
 		if !allower.IsAllowed(nodeID, areTheyAValidator) {
+			failed[nodeID] = sender.NotAllowed
 			continue
 		}
 
@@ -861,6 +1060,12 @@ func (n *network) disconnectedFromConnecting(nodeID ids.NodeID) {
 }
 
 func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
+	// Tracked subnets can't be modified until the peer is no longer
+	// connected, so that the peer isn't reported as connected to a newly
+	// tracked subnet after it was reported as disconnected.
+	n.peerConfig.MySubnetsLock.RLock()
+	defer n.peerConfig.MySubnetsLock.RUnlock()
+
 	n.ipTracker.Disconnected(nodeID)
 	n.router.Disconnected(nodeID)
 
@@ -869,9 +1074,14 @@ func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 
 	n.connectedPeers.Remove(nodeID)
 
-	// The peer that is disconnecting from us finished the handshake
-	if ip, wantsConnection := n.ipTracker.GetIP(nodeID); wantsConnection {
-		tracked := newTrackedIP(ip.AddrPort)
+	// The peer that is disconnecting from us finished the handshake. Pinned
+	// peers are always reconnected to at their configured IP.
+	if ip, pinned := n.config.PinnedPeers[nodeID]; pinned {
+		tracked := newTrackedIP(ip)
+		n.trackedIPs[nodeID] = tracked
+		n.dial(nodeID, tracked)
+	} else if ip, wantsConnection := n.ipTracker.GetIP(nodeID); wantsConnection {
+		tracked := newTrackedIP(n.config.DialPreference.Choose(ip.AddrPort, ip.SecondaryAddrPort))
 		n.trackedIPs[nodeID] = tracked
 		n.dial(nodeID, tracked)
 	}
@@ -1233,6 +1443,7 @@ func (n *network) runTimers() {
 			return
 		case <-pullGossipPeerlists.C:
 			n.pullGossipPeerLists()
+			n.announceIPChange()
 		case <-resetPeerListBloom.C:
 			if err := n.ipTracker.ResetBloom(); err != nil {
 				n.peerConfig.Log.Error("failed to reset ip tracker bloom filter",
@@ -1269,6 +1480,56 @@ func (n *network) pullGossipPeerLists() {
 	}
 }
 
+// announceIPChange pushes this node's IP to all connected peers if it changed
+// since the last announcement. This allows peers to gossip our new IP without
+// having to reconnect to us.
+func (n *network) announceIPChange() {
+	mySignedIP, err := n.peerConfig.IPSigner.GetSignedIP()
+	if err != nil {
+		n.peerConfig.Log.Error("failed to get signed IP",
+			zap.Error(err),
+		)
+		return
+	}
+
+	// The IP signer returns the same signed IP until our IP changes.
+	previousIP := n.announcedIP
+	if previousIP == mySignedIP {
+		return
+	}
+	n.announcedIP = mySignedIP
+
+	myIP := ips.NewClaimedIPPort(
+		n.myCert,
+		mySignedIP.AddrPort,
+		mySignedIP.Timestamp,
+		mySignedIP.TLSSignature,
+	)
+	myIP.SecondaryAddrPort = mySignedIP.SecondaryAddrPort
+	myIP.SecondarySignature = mySignedIP.SecondaryTLSSignature
+	msg, err := n.peerConfig.MessageCreator.PeerList([]*ips.ClaimedIPPort{myIP}, true /*=bypassThrottling*/)
+	if err != nil {
+		n.peerConfig.Log.Error("failed to create message",
+			zap.Stringer("messageOp", message.PeerListOp),
+			zap.Error(err),
+		)
+		return
+	}
+
+	n.peersLock.RLock()
+	peers := n.connectedPeers.Sample(n.connectedPeers.Len(), peer.NoPrecondition)
+	n.peersLock.RUnlock()
+
+	n.peerConfig.Log.Info("announcing updated IP to peers",
+		zap.Stringer("oldIP", previousIP.AddrPort),
+		zap.Stringer("newIP", mySignedIP.AddrPort),
+		zap.Int("numPeers", len(peers)),
+	)
+	for _, p := range peers {
+		p.Send(n.onCloseCtx, msg)
+	}
+}
+
 func (n *network) getLastReceived() (time.Time, bool) {
 	lastReceived := atomic.LoadInt64(&n.peerConfig.LastReceived)
 	if lastReceived == 0 {
@@ -17,10 +17,12 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
+	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -29,6 +31,8 @@ import (
 	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bloom"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -184,6 +188,13 @@ func newTestNetwork(t *testing.T, count int) (*testDialer, []*testListener, []id
 		config.MyIPPort = utils.NewAtomic(ip)
 		config.TLSKey = tlsCert.PrivateKey.(crypto.Signer)
 		config.BLSKey = blsKey
+		config.PeerEvents = events.NewLog(16)
+		config.ClockSkew, err = clockskew.NewMonitor(
+			logging.NoLog{},
+			clockskew.Config{},
+			prometheus.NewRegistry(),
+		)
+		require.NoError(t, err)
 
 		listeners[i] = listener
 		nodeIDs[i] = nodeID
@@ -450,6 +461,93 @@ func TestSendWithFilter(t *testing.T) {
 	wg.Wait()
 }
 
+func TestSendWithResult(t *testing.T) {
+	require := require.New(t)
+
+	received := make(chan message.InboundMessage)
+	nodeIDs, networks, wg := newFullyConnectedTestNetwork(
+		t,
+		[]router.InboundHandler{
+			router.InboundHandlerFunc(func(context.Context, message.InboundMessage) {
+				require.FailNow("unexpected message received")
+			}),
+			router.InboundHandlerFunc(func(_ context.Context, msg message.InboundMessage) {
+				received <- msg
+			}),
+			router.InboundHandlerFunc(func(context.Context, message.InboundMessage) {
+				require.FailNow("unexpected message received")
+			}),
+		},
+	)
+
+	net0 := networks[0]
+
+	mc := newMessageCreator(t)
+	outboundGetMsg, err := mc.Get(ids.Empty, 1, time.Second, ids.Empty)
+	require.NoError(err)
+
+	var (
+		validNodeID        = nodeIDs[1]
+		notAllowedNodeID   = nodeIDs[2]
+		notConnectedNodeID = ids.GenerateTestNodeID()
+		toSend             = set.Of(validNodeID, notAllowedNodeID, notConnectedNodeID)
+	)
+	result := net0.SendWithResult(
+		outboundGetMsg,
+		common.SendConfig{
+			NodeIDs: toSend,
+		},
+		constants.PrimaryNetworkID,
+		newNodeIDConnector(validNodeID),
+	)
+	require.Equal(
+		sender.SendResult{
+			SentTo: set.Of(validNodeID),
+			Failed: map[ids.NodeID]sender.SendFailure{
+				notAllowedNodeID:   sender.NotAllowed,
+				notConnectedNodeID: sender.NotConnected,
+			},
+		},
+		result,
+	)
+
+	inboundGetMsg := <-received
+	require.Equal(message.GetOp, inboundGetMsg.Op())
+
+	// Messages larger than the maximum message size can only be created
+	// without compression.
+	uncompressedMC, err := message.NewCreator(
+		logging.NoLog{},
+		prometheus.NewRegistry(),
+		compression.TypeNone,
+		10*time.Second,
+	)
+	require.NoError(err)
+	outboundPutMsg, err := uncompressedMC.Put(ids.Empty, 1, make([]byte, constants.DefaultMaxMessageSize))
+	require.NoError(err)
+
+	result = net0.SendWithResult(
+		outboundPutMsg,
+		common.SendConfig{
+			NodeIDs: set.Of(validNodeID),
+		},
+		constants.PrimaryNetworkID,
+		subnets.NoOpAllower,
+	)
+	require.Empty(result.SentTo)
+	require.Equal(
+		map[ids.NodeID]sender.SendFailure{
+			validNodeID: sender.MessageTooLarge,
+		},
+		result.Failed,
+	)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
 func TestTrackVerifiesSignatures(t *testing.T) {
 	require := require.New(t)
 
@@ -493,6 +591,28 @@ func TestTrackVerifiesSignatures(t *testing.T) {
 	wg.Wait()
 }
 
+func TestAnnounceIPChange(t *testing.T) {
+	require := require.New(t)
+
+	nodeIDs, networks, wg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil, nil})
+
+	newIP := netip.AddrPortFrom(
+		netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+		9651,
+	)
+	networks[0].config.MyIPPort.Set(newIP)
+
+	require.Eventually(func() bool {
+		ip, _ := networks[1].ipTracker.GetIP(nodeIDs[0])
+		return ip != nil && ip.AddrPort == newIP
+	}, 10*time.Second, time.Millisecond)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
 func TestTrackDoesNotDialPrivateIPs(t *testing.T) {
 	require := require.New(t)
 
@@ -614,7 +734,7 @@ func TestDialDeletesNonValidators(t *testing.T) {
 	}
 
 	config := configs[0]
-	signer := peer.NewIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey)
+	signer := peer.NewIPSigner(config.MyIPPort, config.MySecondaryIPPort, config.TLSKey, config.BLSKey)
 	ip, err := signer.GetSignedIP()
 	require.NoError(err)
 
@@ -872,3 +992,61 @@ func TestGetAllPeers(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestConnectionPolicy(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, _, configs := newTestNetwork(t, 1)
+
+	var (
+		pinnedNodeID = ids.GenerateTestNodeID()
+		deniedNodeID = ids.GenerateTestNodeID()
+		otherNodeID  = ids.GenerateTestNodeID()
+		pinnedIP, _  = dialer.NewListener()
+		vdrs         = validators.NewManager()
+	)
+	// Only the pinned peer is allowed to connect to a non-validator.
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, deniedNodeID, nil, ids.GenerateTestID(), 1))
+
+	config := configs[0]
+	config.Beacons = validators.NewManager()
+	config.Validators = vdrs
+	config.RequireValidatorToConnect = true
+	config.PinnedPeers = map[ids.NodeID]netip.AddrPort{
+		pinnedNodeID: pinnedIP,
+	}
+	config.DeniedNodeIDs = set.Of(deniedNodeID)
+
+	net, err := NewNetwork(
+		config,
+		upgrade.InitiallyActiveTime,
+		newMessageCreator(t),
+		prometheus.NewRegistry(),
+		logging.NoLog{},
+		listeners[0],
+		dialer,
+		&testHandler{},
+	)
+	require.NoError(err)
+	network := net.(*network)
+
+	require.True(network.AllowConnection(pinnedNodeID))
+	require.False(network.AllowConnection(deniedNodeID))
+	require.False(network.AllowConnection(otherNodeID))
+
+	// Denied peers are never dialed.
+	network.ManuallyTrack(deniedNodeID, pinnedIP)
+	network.peersLock.RLock()
+	_, tracked := network.trackedIPs[deniedNodeID]
+	network.peersLock.RUnlock()
+	require.False(tracked)
+
+	// The pinned peer is reported as disconnected.
+	details, _ := network.HealthCheck(context.Background())
+	require.Equal(
+		[]ids.NodeID{pinnedNodeID},
+		details.(map[string]interface{})[DisconnectedPinnedPeersKey],
+	)
+
+	network.StartClose()
+}
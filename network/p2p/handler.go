@@ -21,6 +21,12 @@ const (
 	AtomicTxGossipHandlerID
 	// SignatureRequestHandlerID is specified in ACP-118: https://github.com/avalanche-foundation/ACPs/tree/main/ACPs/118-warp-signature-request
 	SignatureRequestHandlerID
+	// MaintenanceHandlerID is used by validators to announce planned
+	// maintenance windows on the P-chain.
+	MaintenanceHandlerID
+	// CheckpointHandlerID is used by validators to publish the checkpoints
+	// that bootstrapping nodes fetch the ancestry of a chain from.
+	CheckpointHandlerID
 )
 
 var (
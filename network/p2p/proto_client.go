@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p2p
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// ProtoResponseCallback is called upon receiving the response to an
+// AppRequest issued by ProtoClient.
+// Callers should check [err] to see whether the AppRequest failed or not.
+type ProtoResponseCallback[Response proto.Message] func(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	response Response,
+	err error,
+)
+
+// ProtoClient issues AppRequests whose request and response are protobuf
+// messages. It is the client-side counterpart of NewProtoHandler.
+type ProtoClient[Request proto.Message, Response any, PResponse ProtoMessage[Response]] struct {
+	client *Client
+}
+
+func NewProtoClient[Request proto.Message, Response any, PResponse ProtoMessage[Response]](
+	client *Client,
+) *ProtoClient[Request, Response, PResponse] {
+	return &ProtoClient[Request, Response, PResponse]{
+		client: client,
+	}
+}
+
+// AppRequestAny issues an AppRequest to an arbitrary node decided by the
+// underlying Client.
+func (p *ProtoClient[Request, Response, PResponse]) AppRequestAny(
+	ctx context.Context,
+	request Request,
+	onResponse ProtoResponseCallback[PResponse],
+) error {
+	requestBytes, err := proto.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return p.client.AppRequestAny(ctx, requestBytes, p.callback(onResponse))
+}
+
+// AppRequest issues an AppRequest to [nodeIDs].
+// [onResponse] is invoked upon an error or a response.
+func (p *ProtoClient[Request, Response, PResponse]) AppRequest(
+	ctx context.Context,
+	nodeIDs set.Set[ids.NodeID],
+	request Request,
+	onResponse ProtoResponseCallback[PResponse],
+) error {
+	requestBytes, err := proto.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return p.client.AppRequest(ctx, nodeIDs, requestBytes, p.callback(onResponse))
+}
+
+func (*ProtoClient[_, Response, PResponse]) callback(
+	onResponse ProtoResponseCallback[PResponse],
+) AppResponseCallback {
+	return func(ctx context.Context, nodeID ids.NodeID, responseBytes []byte, err error) {
+		if err != nil {
+			onResponse(ctx, nodeID, nil, err)
+			return
+		}
+
+		response := PResponse(new(Response))
+		if err := proto.Unmarshal(responseBytes, response); err != nil {
+			onResponse(ctx, nodeID, nil, fmt.Errorf("failed to unmarshal response: %w", err))
+			return
+		}
+		onResponse(ctx, nodeID, response, nil)
+	}
+}
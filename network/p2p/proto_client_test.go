@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/proto/pb/sdk"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func TestProtoClientAppRequest(t *testing.T) {
+	response := &sdk.SignatureResponse{
+		Signature: []byte("signature"),
+	}
+	responseBytes, err := proto.Marshal(response)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		responseBytes    []byte
+		expectedResponse *sdk.SignatureResponse
+		expectErr        bool
+	}{
+		{
+			name:             "response",
+			responseBytes:    responseBytes,
+			expectedResponse: response,
+		},
+		{
+			name:          "invalid response",
+			responseBytes: []byte{0xff},
+			expectErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+
+			sender := enginetest.SenderStub{
+				SentAppRequest: make(chan []byte, 1),
+			}
+			network, err := NewNetwork(logging.NoLog{}, sender, prometheus.NewRegistry(), "")
+			require.NoError(err)
+			client := NewProtoClient[*sdk.SignatureRequest, sdk.SignatureResponse](network.NewClient(handlerID))
+
+			request := &sdk.SignatureRequest{
+				Message: []byte("message"),
+			}
+			nodeID := ids.GenerateTestNodeID()
+			done := make(chan struct{})
+			onResponse := func(_ context.Context, gotNodeID ids.NodeID, gotResponse *sdk.SignatureResponse, err error) {
+				defer close(done)
+
+				require.Equal(nodeID, gotNodeID)
+				if tt.expectErr {
+					require.Error(err) //nolint:forbidigo // the error is created by proto
+					require.Nil(gotResponse)
+					return
+				}
+				require.NoError(err)
+				require.True(proto.Equal(tt.expectedResponse, gotResponse))
+			}
+			require.NoError(client.AppRequest(ctx, set.Of(nodeID), request, onResponse))
+
+			sent := <-sender.SentAppRequest
+			require.Equal(handlerPrefix, sent[0])
+			gotRequest := &sdk.SignatureRequest{}
+			require.NoError(proto.Unmarshal(sent[1:], gotRequest))
+			require.True(proto.Equal(request, gotRequest))
+
+			require.NoError(network.AppResponse(ctx, nodeID, 1, tt.responseBytes))
+			<-done
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+)
+
+// ProtoMessage constrains a type parameter to be a pointer to T that is a
+// protobuf message.
+type ProtoMessage[T any] interface {
+	*T
+	proto.Message
+}
+
+// ProtoRequestHandler handles an AppRequest whose request and response are
+// protobuf messages.
+type ProtoRequestHandler[Request, Response proto.Message] func(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	deadline time.Time,
+	request Request,
+) (Response, *common.AppError)
+
+// NewProtoHandler returns a Handler that unmarshals AppRequests into protobuf
+// messages, handles them with [handler] and marshals the returned responses.
+// AppGossip messages are dropped.
+//
+// The returned Handler can be wrapped like any other Handler. For example, to
+// throttle requests or to only handle requests from validators.
+func NewProtoHandler[Request any, Response proto.Message, PRequest ProtoMessage[Request]](
+	handler ProtoRequestHandler[PRequest, Response],
+) Handler {
+	return &protoHandler[Request, Response, PRequest]{
+		handler: handler,
+	}
+}
+
+type protoHandler[Request any, Response proto.Message, PRequest ProtoMessage[Request]] struct {
+	handler ProtoRequestHandler[PRequest, Response]
+}
+
+func (*protoHandler[_, _, _]) AppGossip(context.Context, ids.NodeID, []byte) {}
+
+func (p *protoHandler[Request, _, PRequest]) AppRequest(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	deadline time.Time,
+	requestBytes []byte,
+) ([]byte, *common.AppError) {
+	request := PRequest(new(Request))
+	if err := proto.Unmarshal(requestBytes, request); err != nil {
+		return nil, &common.AppError{
+			Code:    ErrUnexpected.Code,
+			Message: fmt.Sprintf("failed to unmarshal request: %s", err),
+		}
+	}
+
+	response, appErr := p.handler(ctx, nodeID, deadline, request)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	responseBytes, err := proto.Marshal(response)
+	if err != nil {
+		return nil, &common.AppError{
+			Code:    ErrUnexpected.Code,
+			Message: fmt.Sprintf("failed to marshal response: %s", err),
+		}
+	}
+	return responseBytes, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/proto/pb/sdk"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+)
+
+func TestProtoHandlerAppRequest(t *testing.T) {
+	request := &sdk.SignatureRequest{
+		Message:       []byte("message"),
+		Justification: []byte("justification"),
+	}
+	requestBytes, err := proto.Marshal(request)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		requestBytes     []byte
+		err              *common.AppError
+		expectedResponse *sdk.SignatureResponse
+		expectedErrCode  int32
+	}{
+		{
+			name:         "response",
+			requestBytes: requestBytes,
+			expectedResponse: &sdk.SignatureResponse{
+				Signature: []byte("message"),
+			},
+		},
+		{
+			name:            "invalid request",
+			requestBytes:    []byte{0xff},
+			expectedErrCode: ErrUnexpected.Code,
+		},
+		{
+			name:            "handler error",
+			requestBytes:    requestBytes,
+			err:             errFoo,
+			expectedErrCode: errFoo.Code,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			handler := NewProtoHandler(func(
+				_ context.Context,
+				_ ids.NodeID,
+				_ time.Time,
+				gotRequest *sdk.SignatureRequest,
+			) (*sdk.SignatureResponse, *common.AppError) {
+				require.True(proto.Equal(request, gotRequest))
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return &sdk.SignatureResponse{
+					Signature: gotRequest.Message,
+				}, nil
+			})
+
+			responseBytes, appErr := handler.AppRequest(
+				context.Background(),
+				ids.GenerateTestNodeID(),
+				time.Time{},
+				tt.requestBytes,
+			)
+			if tt.expectedResponse == nil {
+				require.NotNil(appErr)
+				require.Equal(tt.expectedErrCode, appErr.Code)
+				return
+			}
+			require.Nil(appErr)
+
+			response := &sdk.SignatureResponse{}
+			require.NoError(proto.Unmarshal(responseBytes, response))
+			require.True(proto.Equal(tt.expectedResponse, response))
+		})
+	}
+}
@@ -4,16 +4,19 @@
 package peer
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
@@ -35,8 +38,11 @@ type Config struct {
 	Router               router.InboundHandler
 	VersionCompatibility version.Compatibility
 	MyNodeID             ids.NodeID
-	// MySubnets does not include the primary network ID
+	// MySubnets does not include the primary network ID. It is modified when
+	// the node starts or stops tracking a subnet, so it must only be accessed
+	// with MySubnetsLock held once peers are running.
 	MySubnets          set.Set[ids.ID]
+	MySubnetsLock      sync.RWMutex
 	Beacons            validators.Manager
 	Validators         validators.Manager
 	NetworkID          uint32
@@ -60,6 +66,12 @@ type Config struct {
 	// Signs my IP so I can send my signed IP address in the Handshake message
 	IPSigner *IPSigner
 
+	// Events records the lifecycle of the peer.
+	Events *events.Log
+
+	// ClockSkew is notified of the clock offset reported by the peer.
+	ClockSkew *clockskew.Monitor
+
 	// IngressConnectionCount counts the ingress (to us) connections.
 	IngressConnectionCount atomic.Int64
 }
@@ -15,12 +15,15 @@ import (
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 )
 
 var (
-	errTimestampTooFarInFuture = errors.New("timestamp too far in the future")
-	errInvalidTLSSignature     = errors.New("invalid TLS signature")
+	errTimestampTooFarInFuture      = errors.New("timestamp too far in the future")
+	errInvalidTLSSignature          = errors.New("invalid TLS signature")
+	errSameAddressFamily            = errors.New("secondary IP has the same address family as the primary IP")
+	errInvalidSecondaryTLSSignature = errors.New("invalid secondary TLS signature")
 )
 
 // UnsignedIP is used for a validator to claim an IP. The [Timestamp] is used to
@@ -34,11 +37,7 @@ type UnsignedIP struct {
 // Sign this IP with the provided signer and return the signed IP.
 func (ip *UnsignedIP) Sign(tlsSigner crypto.Signer, blsSigner bls.Signer) (*SignedIP, error) {
 	ipBytes := ip.bytes()
-	tlsSignature, err := tlsSigner.Sign(
-		rand.Reader,
-		hashing.ComputeHash256(ipBytes),
-		crypto.SHA256,
-	)
+	tlsSignature, err := signTLS(tlsSigner, ipBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -73,11 +72,36 @@ type SignedIP struct {
 	TLSSignature      []byte
 	BLSSignature      *bls.Signature
 	BLSSignatureBytes []byte
+
+	// SecondaryAddrPort is an optional IP, of the other address family than
+	// [AddrPort], claimed at the same [Timestamp]. It is only signed with the
+	// TLS key.
+	SecondaryAddrPort     netip.AddrPort
+	SecondaryTLSSignature []byte
+}
+
+// SignSecondary signs [addrPort] at [ip.Timestamp] and adds it to this IP as
+// the secondary IP.
+func (ip *SignedIP) SignSecondary(tlsSigner crypto.Signer, addrPort netip.AddrPort) error {
+	secondaryIP := UnsignedIP{
+		AddrPort:  addrPort,
+		Timestamp: ip.Timestamp,
+	}
+	tlsSignature, err := signTLS(tlsSigner, secondaryIP.bytes())
+	if err != nil {
+		return err
+	}
+
+	ip.SecondaryAddrPort = addrPort
+	ip.SecondaryTLSSignature = tlsSignature
+	return nil
 }
 
 // Returns nil if:
 // * [ip.Timestamp] is not after [maxTimestamp].
 // * [ip.TLSSignature] is a valid signature over [ip.UnsignedIP] from [cert].
+// * [ip.SecondaryAddrPort], if provided, is of the other address family and
+// [ip.SecondaryTLSSignature] is a valid signature over it from [cert].
 func (ip *SignedIP) Verify(
 	cert *staking.Certificate,
 	maxTimestamp time.Time,
@@ -94,5 +118,31 @@ func (ip *SignedIP) Verify(
 	); err != nil {
 		return fmt.Errorf("%w: %w", errInvalidTLSSignature, err)
 	}
+
+	if !ip.SecondaryAddrPort.IsValid() {
+		return nil
+	}
+	if ips.IsSameFamily(ip.AddrPort.Addr(), ip.SecondaryAddrPort.Addr()) {
+		return fmt.Errorf("%w: %s and %s", errSameAddressFamily, ip.AddrPort, ip.SecondaryAddrPort)
+	}
+	secondaryIP := UnsignedIP{
+		AddrPort:  ip.SecondaryAddrPort,
+		Timestamp: ip.Timestamp,
+	}
+	if err := staking.CheckSignature(
+		cert,
+		secondaryIP.bytes(),
+		ip.SecondaryTLSSignature,
+	); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidSecondaryTLSSignature, err)
+	}
 	return nil
 }
+
+func signTLS(tlsSigner crypto.Signer, msg []byte) ([]byte, error) {
+	return tlsSigner.Sign(
+		rand.Reader,
+		hashing.ComputeHash256(msg),
+		crypto.SHA256,
+	)
+}
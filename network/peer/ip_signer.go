@@ -10,15 +10,19 @@ import (
 
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 )
 
 // IPSigner will return a signedIP for the current value of our dynamic IP.
 type IPSigner struct {
-	ip        *utils.Atomic[netip.AddrPort]
-	clock     mockable.Clock
-	tlsSigner crypto.Signer
-	blsSigner bls.Signer
+	ip *utils.Atomic[netip.AddrPort]
+	// secondaryIP is optionally advertised alongside [ip] if it is of the
+	// other address family.
+	secondaryIP netip.AddrPort
+	clock       mockable.Clock
+	tlsSigner   crypto.Signer
+	blsSigner   bls.Signer
 
 	// Must be held while accessing [signedIP]
 	signedIPLock sync.RWMutex
@@ -29,13 +33,15 @@ type IPSigner struct {
 
 func NewIPSigner(
 	ip *utils.Atomic[netip.AddrPort],
+	secondaryIP netip.AddrPort,
 	tlsSigner crypto.Signer,
 	blsSigner bls.Signer,
 ) *IPSigner {
 	return &IPSigner{
-		ip:        ip,
-		tlsSigner: tlsSigner,
-		blsSigner: blsSigner,
+		ip:          ip,
+		secondaryIP: secondaryIP,
+		tlsSigner:   tlsSigner,
+		blsSigner:   blsSigner,
 	}
 }
 
@@ -67,16 +73,30 @@ func (s *IPSigner) GetSignedIP() (*SignedIP, error) {
 		return signedIP, nil
 	}
 
-	// We should now sign our new IP at the current timestamp.
+	// We should now sign our new IP at the current timestamp. Peers ignore
+	// IPs that aren't newer than the IP they know of, so the timestamp must
+	// increase even if our IP changed within the same second.
+	timestamp := s.clock.Unix()
+	if signedIP != nil {
+		timestamp = max(timestamp, signedIP.Timestamp+1)
+	}
 	unsignedIP := UnsignedIP{
 		AddrPort:  ip,
-		Timestamp: s.clock.Unix(),
+		Timestamp: timestamp,
 	}
 	signedIP, err := unsignedIP.Sign(s.tlsSigner, s.blsSigner)
 	if err != nil {
 		return nil, err
 	}
 
+	// If our primary IP changed address family, the secondary IP would no
+	// longer be valid to advertise.
+	if s.secondaryIP.IsValid() && !ips.IsSameFamily(ip.Addr(), s.secondaryIP.Addr()) {
+		if err := signedIP.SignSecondary(s.tlsSigner, s.secondaryIP); err != nil {
+			return nil, err
+		}
+	}
+
 	s.signedIP = signedIP
 	return s.signedIP, nil
 }
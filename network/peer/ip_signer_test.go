@@ -31,7 +31,11 @@ func TestIPSigner(t *testing.T) {
 	blsKey, err := localsigner.New()
 	require.NoError(err)
 
-	s := NewIPSigner(dynIP, tlsKey, blsKey)
+	secondaryIP := netip.AddrPortFrom(
+		netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+		1,
+	)
+	s := NewIPSigner(dynIP, secondaryIP, tlsKey, blsKey)
 
 	s.clock.Set(time.Unix(10, 0))
 
@@ -39,6 +43,7 @@ func TestIPSigner(t *testing.T) {
 	require.NoError(err)
 	require.Equal(dynIP.Get(), signedIP1.AddrPort)
 	require.Equal(uint64(10), signedIP1.Timestamp)
+	require.Equal(secondaryIP, signedIP1.SecondaryAddrPort)
 
 	s.clock.Set(time.Unix(11, 0))
 
@@ -58,4 +63,19 @@ func TestIPSigner(t *testing.T) {
 	require.Equal(dynIP.Get(), signedIP3.AddrPort)
 	require.Equal(uint64(11), signedIP3.Timestamp)
 	require.NotEqual(signedIP2.TLSSignature, signedIP3.TLSSignature)
+	// The primary IP is now of the same address family as the secondary IP,
+	// so the secondary IP is no longer advertised.
+	require.False(signedIP3.SecondaryAddrPort.IsValid())
+
+	dynIP.Set(netip.AddrPortFrom(
+		netip.AddrFrom4([4]byte{5, 6, 7, 8}),
+		dynIP.Get().Port(),
+	))
+
+	// The IP changed within the same second, so the timestamp must still
+	// increase.
+	signedIP4, err := s.GetSignedIP()
+	require.NoError(err)
+	require.Equal(dynIP.Get(), signedIP4.AddrPort)
+	require.Equal(uint64(12), signedIP4.Timestamp)
 }
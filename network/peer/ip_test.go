@@ -106,3 +106,74 @@ func TestSignedIpVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestSignedIpVerifySecondary(t *testing.T) {
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(t, err)
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(t, err)
+	tlsKey := tlsCert.PrivateKey.(crypto.Signer)
+	blsKey, err := localsigner.New()
+	require.NoError(t, err)
+
+	now := time.Now()
+	ip := UnsignedIP{
+		AddrPort: netip.AddrPortFrom(
+			netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+			1,
+		),
+		Timestamp: uint64(now.Unix()),
+	}
+
+	tests := []struct {
+		name        string
+		secondaryIP netip.AddrPort
+		malleate    func(*SignedIP)
+		expectedErr error
+	}{
+		{
+			name:        "no secondary IP",
+			secondaryIP: netip.AddrPort{},
+			malleate:    func(*SignedIP) {},
+			expectedErr: nil,
+		},
+		{
+			name:        "valid",
+			secondaryIP: netip.AddrPortFrom(netip.IPv6Loopback(), 1),
+			malleate:    func(*SignedIP) {},
+			expectedErr: nil,
+		},
+		{
+			name: "same address family",
+			secondaryIP: netip.AddrPortFrom(
+				netip.AddrFrom4([4]byte{5, 6, 7, 8}),
+				1,
+			),
+			malleate:    func(*SignedIP) {},
+			expectedErr: errSameAddressFamily,
+		},
+		{
+			name:        "signature over another IP",
+			secondaryIP: netip.AddrPortFrom(netip.IPv6Loopback(), 1),
+			malleate: func(ip *SignedIP) {
+				ip.SecondaryAddrPort = netip.AddrPortFrom(netip.IPv6Loopback(), 2)
+			},
+			expectedErr: errInvalidSecondaryTLSSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			signedIP, err := ip.Sign(tlsKey, blsKey)
+			require.NoError(err)
+			if tt.secondaryIP.IsValid() {
+				require.NoError(signedIP.SignSecondary(tlsKey, tt.secondaryIP))
+			}
+			tt.malleate(signedIP)
+
+			err = signedIP.Verify(cert, now)
+			require.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
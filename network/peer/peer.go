@@ -19,6 +19,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils"
@@ -179,6 +180,9 @@ type peer struct {
 	// numExecuting is the number of goroutines this peer is currently using
 	numExecuting     int64
 	startClosingOnce sync.Once
+	// closeReason is the reason reported when the peer closes. It is only
+	// written when the peer starts closing.
+	closeReason events.Reason
 	// onClosingCtx is canceled when the peer starts closing
 	onClosingCtx context.Context
 	// onClosingCtxCancel cancels onClosingCtx
@@ -279,21 +283,27 @@ func (p *peer) AwaitReady(ctx context.Context) error {
 func (p *peer) Info() Info {
 	primaryUptime := p.ObservedUptime()
 
-	ip, _ := ips.ParseAddrPort(p.conn.RemoteAddr().String())
 	return Info{
-		IP:             ip,
-		PublicIP:       p.ip.AddrPort,
-		ID:             p.id,
-		Version:        p.version.String(),
-		LastSent:       p.LastSent(),
-		LastReceived:   p.LastReceived(),
-		ObservedUptime: json.Uint32(primaryUptime),
-		TrackedSubnets: p.trackedSubnets,
-		SupportedACPs:  p.supportedACPs,
-		ObjectedACPs:   p.objectedACPs,
+		IP:                p.remoteAddr(),
+		PublicIP:          p.ip.AddrPort,
+		SecondaryPublicIP: p.ip.SecondaryAddrPort,
+		ID:                p.id,
+		Version:           p.version.String(),
+		LastSent:          p.LastSent(),
+		LastReceived:      p.LastReceived(),
+		ObservedUptime:    json.Uint32(primaryUptime),
+		TrackedSubnets:    p.trackedSubnets,
+		SupportedACPs:     p.supportedACPs,
+		ObjectedACPs:      p.objectedACPs,
 	}
 }
 
+// remoteAddr returns the address of the other end of the connection.
+func (p *peer) remoteAddr() netip.AddrPort {
+	addr, _ := ips.ParseAddrPort(p.conn.RemoteAddr().String())
+	return addr
+}
+
 func (p *peer) IP() *SignedIP {
 	return p.ip
 }
@@ -322,7 +332,14 @@ func (p *peer) StartSendGetPeerList() {
 }
 
 func (p *peer) StartClose() {
+	p.startClose(events.ConnectionClosed)
+}
+
+// startClose begins shutting down the peer. If the peer is already closing,
+// [reason] is ignored.
+func (p *peer) startClose(reason events.Reason) {
 	p.startClosingOnce.Do(func() {
+		p.closeReason = reason
 		if err := p.conn.Close(); err != nil {
 			p.Log.Debug("failed to close connection",
 				zap.Stringer("nodeID", p.id),
@@ -364,6 +381,17 @@ func (p *peer) close() {
 		p.IngressConnectionCount.Add(-1)
 	}
 
+	eventType := events.HandshakeFailed
+	if p.finishedHandshake.Get() {
+		eventType = events.Disconnected
+	}
+	p.Events.Record(events.Event{
+		Type:   eventType,
+		NodeID: p.id,
+		IP:     p.remoteAddr(),
+		Reason: p.closeReason,
+	})
+
 	p.Network.Disconnected(p.id)
 	close(p.onClosed)
 }
@@ -528,6 +556,10 @@ func (p *peer) writeMessages() {
 	myVersion := p.VersionCompatibility.Version()
 	knownPeersFilter, knownPeersSalt := p.Network.KnownPeers()
 
+	p.MySubnetsLock.RLock()
+	mySubnets := p.MySubnets.List()
+	p.MySubnetsLock.RUnlock()
+
 	_, areWeAPrimaryNetworkValidator := p.Validators.GetValidator(constants.PrimaryNetworkID, p.MyNodeID)
 	msg, err := p.MessageCreator.Handshake(
 		p.NetworkID,
@@ -540,7 +572,9 @@ func (p *peer) writeMessages() {
 		mySignedIP.Timestamp,
 		mySignedIP.TLSSignature,
 		mySignedIP.BLSSignatureBytes,
-		p.MySubnets.List(),
+		mySignedIP.SecondaryAddrPort,
+		mySignedIP.SecondaryTLSSignature,
+		mySubnets,
 		p.SupportedACPs,
 		p.ObjectedACPs,
 		knownPeersFilter,
@@ -662,14 +696,18 @@ func (p *peer) sendNetworkMessages() {
 					zap.String("reason", "connection is no longer desired"),
 					zap.Stringer("nodeID", p.id),
 				)
+				p.startClose(events.ConnectionNoLongerWanted)
 				return
 			}
 
 			// Only check if we should disconnect after the handshake is
 			// finished to avoid race conditions and accessing uninitialized
 			// values.
-			if p.finishedHandshake.Get() && p.shouldDisconnect() {
-				return
+			if p.finishedHandshake.Get() {
+				if reason, ok := p.shouldDisconnect(); ok {
+					p.startClose(reason)
+					return
+				}
 			}
 
 			primaryUptime := p.getUptime()
@@ -699,7 +737,7 @@ func (p *peer) sendNetworkMessages() {
 // It is called when sending a Ping message to account for validator set
 // changes. It's called when sending a Ping rather than in a validator set
 // callback to avoid signature verification on the P-chain accept path.
-func (p *peer) shouldDisconnect() bool {
+func (p *peer) shouldDisconnect() (events.Reason, bool) {
 	if err := p.VersionCompatibility.Compatible(p.version); err != nil {
 		p.Log.Debug(disconnectingLog,
 			zap.String("reason", "version not compatible"),
@@ -707,14 +745,14 @@ func (p *peer) shouldDisconnect() bool {
 			zap.Stringer("peerVersion", p.version),
 			zap.Error(err),
 		)
-		return true
+		return events.IncompatibleVersion, true
 	}
 
 	// Enforce that all validators that have registered a BLS key are signing
 	// their IP with it after the activation of Durango.
 	vdr, ok := p.Validators.GetValidator(constants.PrimaryNetworkID, p.id)
 	if !ok || vdr.PublicKey == nil || vdr.TxID == p.txIDOfVerifiedBLSKey {
-		return false
+		return "", false
 	}
 
 	validSignature := bls.VerifyProofOfPossession(
@@ -727,13 +765,13 @@ func (p *peer) shouldDisconnect() bool {
 			zap.String("reason", "invalid BLS signature"),
 			zap.Stringer("nodeID", p.id),
 		)
-		return true
+		return events.InvalidBLSSignature, true
 	}
 
 	// Avoid unnecessary signature verifications by only verifying the signature
 	// once per validation period.
 	p.txIDOfVerifiedBLSKey = vdr.TxID
-	return false
+	return "", false
 }
 
 func (p *peer) handle(msg message.InboundMessage) {
@@ -826,7 +864,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Stringer("messageOp", message.HandshakeOp),
 			zap.String("reason", "already received handshake"),
 		)
-		p.StartClose()
+		p.startClose(events.InvalidHandshake)
 		return
 	}
 
@@ -838,7 +876,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Uint32("peerNetworkID", msg.NetworkId),
 			zap.Uint32("ourNetworkID", p.NetworkID),
 		)
-		p.StartClose()
+		p.startClose(events.NetworkIDMismatch)
 		return
 	}
 
@@ -849,6 +887,11 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 	p.Metrics.ClockSkewCount.Inc()
 	p.Metrics.ClockSkewSum.Add(clockDifference)
 
+	// The peer's time is truncated to the second, so on average it is half a
+	// second behind the time it was sent at.
+	peerTime := time.Unix(int64(msg.MyTime), 0).Add(500 * time.Millisecond)
+	p.ClockSkew.ObservePeer(p.id, peerTime.Sub(localTime))
+
 	if clockDifference > p.MaxClockDifference.Seconds() {
 		log := p.Log.Debug
 		if _, ok := p.Beacons.GetValidator(constants.PrimaryNetworkID, p.id); ok {
@@ -861,7 +904,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Uint64("peerTime", msg.MyTime),
 			zap.Uint64("localTime", localUnixTime),
 		)
-		p.StartClose()
+		p.startClose(events.ClockDifference)
 		return
 	}
 
@@ -891,7 +934,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "trackedSubnets"),
 			zap.Int("numTrackedSubnets", numTrackedSubnets),
 		)
-		p.StartClose()
+		p.startClose(events.InvalidHandshake)
 		return
 	}
 
@@ -905,7 +948,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "trackedSubnets"),
 				zap.Error(err),
 			)
-			p.StartClose()
+			p.startClose(events.InvalidHandshake)
 			return
 		}
 		p.trackedSubnets.Add(subnetID)
@@ -930,7 +973,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Reflect("supportedACPs", p.supportedACPs),
 			zap.Reflect("objectedACPs", p.objectedACPs),
 		)
-		p.StartClose()
+		p.startClose(events.InvalidHandshake)
 		return
 	}
 
@@ -948,7 +991,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "knownPeers.filter"),
 				zap.Error(err),
 			)
-			p.StartClose()
+			p.startClose(events.InvalidHandshake)
 			return
 		}
 
@@ -960,7 +1003,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "knownPeers.salt"),
 				zap.Int("saltLen", saltLen),
 			)
-			p.StartClose()
+			p.startClose(events.InvalidHandshake)
 			return
 		}
 	}
@@ -973,7 +1016,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "ip"),
 			zap.Int("ipLen", len(msg.IpAddr)),
 		)
-		p.StartClose()
+		p.startClose(events.InvalidHandshake)
 		return
 	}
 
@@ -985,7 +1028,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "port"),
 			zap.Uint16("port", port),
 		)
-		p.StartClose()
+		p.startClose(events.InvalidHandshake)
 		return
 	}
 
@@ -999,6 +1042,22 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 		},
 		TLSSignature: msg.IpNodeIdSig,
 	}
+
+	secondaryIP, ok := parseSecondaryIP(msg.SecondaryIpAddr, msg.SecondaryIpPort)
+	if !ok {
+		p.Log.Debug(malformedMessageLog,
+			zap.Stringer("nodeID", p.id),
+			zap.Stringer("messageOp", message.HandshakeOp),
+			zap.String("field", "secondaryIP"),
+			zap.Int("ipLen", len(msg.SecondaryIpAddr)),
+			zap.Uint32("port", msg.SecondaryIpPort),
+		)
+		p.startClose(events.InvalidHandshake)
+		return
+	}
+	p.ip.SecondaryAddrPort = secondaryIP
+	p.ip.SecondaryTLSSignature = msg.SecondaryIpNodeIdSig
+
 	maxTimestamp := localTime.Add(p.MaxClockDifference)
 	if err := p.ip.Verify(p.cert, maxTimestamp); err != nil {
 		log := p.Log.Debug
@@ -1014,7 +1073,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Error(err),
 		)
 
-		p.StartClose()
+		p.startClose(events.InvalidHandshake)
 		return
 	}
 
@@ -1026,7 +1085,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "blsSignature"),
 			zap.Error(err),
 		)
-		p.StartClose()
+		p.startClose(events.InvalidHandshake)
 		return
 	}
 
@@ -1036,8 +1095,8 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 	// If the peer is running an incompatible version or has an invalid BLS
 	// signature, disconnect from them prior to marking the handshake as
 	// completed.
-	if p.shouldDisconnect() {
-		p.StartClose()
+	if reason, ok := p.shouldDisconnect(); ok {
+		p.startClose(reason)
 		return
 	}
 
@@ -1134,6 +1193,11 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 			return
 		}
 
+		p.Events.Record(events.Event{
+			Type:   events.Connected,
+			NodeID: p.id,
+			IP:     p.remoteAddr(),
+		})
 		p.Network.Connected(p.id)
 		p.finishedHandshake.Set(true)
 		close(p.onFinishHandshake)
@@ -1177,6 +1241,19 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 			return
 		}
 
+		secondaryIP, ok := parseSecondaryIP(claimedIPPort.SecondaryIpAddr, claimedIPPort.SecondaryIpPort)
+		if !ok {
+			p.Log.Debug(malformedMessageLog,
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", message.PeerListOp),
+				zap.String("field", "secondaryIP"),
+				zap.Int("ipLen", len(claimedIPPort.SecondaryIpAddr)),
+				zap.Uint32("port", claimedIPPort.SecondaryIpPort),
+			)
+			p.StartClose()
+			return
+		}
+
 		discoveredIPs[i] = ips.NewClaimedIPPort(
 			tlsCert,
 			netip.AddrPortFrom(
@@ -1186,6 +1263,8 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 			claimedIPPort.Timestamp,
 			claimedIPPort.Signature,
 		)
+		discoveredIPs[i].SecondaryAddrPort = secondaryIP
+		discoveredIPs[i].SecondarySignature = claimedIPPort.SecondarySignature
 	}
 
 	if err := p.Network.Track(discoveredIPs); err != nil {
@@ -1199,6 +1278,20 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 	}
 }
 
+// parseSecondaryIP returns the optional secondary IP of a peer. If no secondary
+// IP was provided, the zero value is returned. Returns false if a secondary IP
+// was provided but is malformed.
+func parseSecondaryIP(addrBytes []byte, port uint32) (netip.AddrPort, bool) {
+	if len(addrBytes) == 0 && port == 0 {
+		return netip.AddrPort{}, true
+	}
+	addr, ok := ips.AddrFromSlice(addrBytes)
+	if !ok || port == 0 || port > math.MaxUint16 {
+		return netip.AddrPort{}, false
+	}
+	return netip.AddrPortFrom(addr, uint16(port)), true
+}
+
 func (p *peer) nextTimeout() time.Time {
 	return p.Clock.Time().Add(p.PongTimeout)
 }
@@ -16,6 +16,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -24,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
@@ -74,6 +76,13 @@ func newConfig(t *testing.T) *Config {
 	)
 	require.NoError(err)
 
+	clockSkew, err := clockskew.NewMonitor(
+		logging.NoLog{},
+		clockskew.Config{},
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+
 	return &Config{
 		ReadBufferSize:       constants.DefaultNetworkPeerReadBufferSize,
 		WriteBufferSize:      constants.DefaultNetworkPeerWriteBufferSize,
@@ -94,6 +103,8 @@ func newConfig(t *testing.T) *Config {
 		ResourceTracker:      resourceTracker,
 		UptimeCalculator:     uptime.NoOpCalculator,
 		IPSigner:             nil,
+		Events:               events.NewLog(16),
+		ClockSkew:            clockSkew,
 	}
 }
 
@@ -115,7 +126,7 @@ func newRawTestPeer(t *testing.T, config *Config) *rawTestPeer {
 	bls, err := localsigner.New()
 	require.NoError(err)
 
-	config.IPSigner = NewIPSigner(ip, tls, bls)
+	config.IPSigner = NewIPSigner(ip, netip.AddrPort{}, tls, bls)
 
 	inboundMsgChan := make(chan message.InboundMessage)
 	config.Router = router.InboundHandlerFunc(func(_ context.Context, msg message.InboundMessage) {
@@ -194,6 +205,30 @@ func TestReady(t *testing.T) {
 	require.NoError(peer1.AwaitClosed(context.Background()))
 }
 
+func TestSecondaryIP(t *testing.T) {
+	require := require.New(t)
+
+	rawPeer0 := newRawTestPeer(t, newConfig(t))
+	rawPeer1 := newRawTestPeer(t, newConfig(t))
+
+	secondaryIP := netip.AddrPortFrom(
+		netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+		1,
+	)
+	rawPeer0.config.IPSigner.secondaryIP = secondaryIP
+
+	peer0, peer1 := startTestPeers(rawPeer0, rawPeer1)
+	awaitReady(t, peer0, peer1)
+
+	require.Equal(secondaryIP, peer1.IP().SecondaryAddrPort)
+	require.Equal(secondaryIP, peer1.Info().SecondaryPublicIP)
+	require.False(peer0.IP().SecondaryAddrPort.IsValid())
+
+	peer0.StartClose()
+	require.NoError(peer0.AwaitClosed(context.Background()))
+	require.NoError(peer1.AwaitClosed(context.Background()))
+}
+
 func TestSend(t *testing.T) {
 	require := require.New(t)
 
@@ -369,6 +404,7 @@ func TestShouldDisconnect(t *testing.T) {
 		initialPeer              *peer
 		expectedPeer             *peer
 		expectedShouldDisconnect bool
+		expectedReason           events.Reason
 	}{
 		{
 			name: "peer is reporting old version",
@@ -397,6 +433,7 @@ func TestShouldDisconnect(t *testing.T) {
 				},
 			},
 			expectedShouldDisconnect: true,
+			expectedReason:           events.IncompatibleVersion,
 		},
 		{
 			name: "peer is not a validator",
@@ -547,6 +584,7 @@ func TestShouldDisconnect(t *testing.T) {
 				ip:      &SignedIP{},
 			},
 			expectedShouldDisconnect: true,
+			expectedReason:           events.InvalidBLSSignature,
 		},
 		{
 			name: "peer with invalid signature",
@@ -595,6 +633,7 @@ func TestShouldDisconnect(t *testing.T) {
 				},
 			},
 			expectedShouldDisconnect: true,
+			expectedReason:           events.InvalidBLSSignature,
 		},
 		{
 			name: "peer with valid signature",
@@ -651,9 +690,10 @@ func TestShouldDisconnect(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			require := require.New(t)
 
-			shouldDisconnect := test.initialPeer.shouldDisconnect()
+			reason, shouldDisconnect := test.initialPeer.shouldDisconnect()
 			require.Equal(test.expectedPeer, test.initialPeer)
 			require.Equal(test.expectedShouldDisconnect, shouldDisconnect)
+			require.Equal(test.expectedReason, reason)
 		})
 	}
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
@@ -22,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -67,6 +69,7 @@ func StartTestPeer(
 	tlsConfg := TLSConfig(*tlsCert, nil)
 	clientUpgrader := NewTLSClientUpgrader(
 		tlsConfg,
+		nil,
 		prometheus.NewCounter(prometheus.CounterOpts{}),
 	)
 
@@ -90,6 +93,15 @@ func StartTestPeer(
 		return nil, err
 	}
 
+	clockSkew, err := clockskew.NewMonitor(
+		logging.NoLog{},
+		clockskew.Config{},
+		prometheus.NewRegistry(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	resourceTracker, err := tracker.NewResourceTracker(
 		prometheus.NewRegistry(),
 		resource.NoUsage,
@@ -129,9 +141,12 @@ func StartTestPeer(
 					netip.IPv6Loopback(),
 					1,
 				)),
+				netip.AddrPort{},
 				tlsKey,
 				blsKey,
 			),
+			Events:    events.NewLog(16),
+			ClockSkew: clockSkew,
 		},
 		conn,
 		cert,
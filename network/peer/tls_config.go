@@ -41,6 +41,9 @@ func TLSConfig(cert tls.Certificate, keyLogWriter io.Writer) *tls.Config {
 		MinVersion:         tls.VersionTLS13,
 		KeyLogWriter:       keyLogWriter,
 		VerifyConnection:   ValidateCertificate,
+		// Advertise that we accept transport certificates, see
+		// [TransportCertProtocol].
+		NextProtos: []string{TransportCertProtocol},
 	}
 }
 
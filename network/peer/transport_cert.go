@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"crypto"
+	"crypto/tls"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// TransportCertProtocol is offered with ALPN by peers that accept transport
+// certificates. A transport certificate is only presented to a peer that
+// negotiated this protocol, so peers that derive the node ID from the TLS
+// certificate itself keep receiving the staking certificate.
+const TransportCertProtocol = "avalanche-transport-cert/1"
+
+// TransportCertRotator provides the transport certificate presented during TLS
+// handshakes instead of the staking certificate. The transport certificate is
+// replaced once it is older than the rotation period.
+type TransportCertRotator struct {
+	stakingCert    *staking.Certificate
+	stakingSigner  crypto.Signer
+	rotationPeriod time.Duration
+	clock          mockable.Clock
+
+	// Must be held while accessing [cert] and [rotateTime]
+	lock sync.Mutex
+	cert *tls.Certificate
+	// rotateTime is the time after which [cert] is replaced.
+	rotateTime time.Time
+}
+
+func NewTransportCertRotator(
+	stakingCert *staking.Certificate,
+	stakingSigner crypto.Signer,
+	rotationPeriod time.Duration,
+) *TransportCertRotator {
+	return &TransportCertRotator{
+		stakingCert:    stakingCert,
+		stakingSigner:  stakingSigner,
+		rotationPeriod: rotationPeriod,
+	}
+}
+
+// Get returns the current transport certificate, generating a new one if the
+// current certificate is due to be rotated.
+//
+// It's safe for multiple goroutines to concurrently call Get.
+func (r *TransportCertRotator) Get() (*tls.Certificate, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.clock.Time()
+	if r.cert != nil && now.Before(r.rotateTime) {
+		return r.cert, nil
+	}
+
+	// The certificate stays valid for an additional rotation period so that
+	// handshakes started just before the rotation, or with peers whose clocks
+	// are slightly ahead, still succeed.
+	cert, err := staking.NewTransportCert(
+		r.stakingCert,
+		r.stakingSigner,
+		now.Add(2*r.rotationPeriod),
+	)
+	if err != nil {
+		return nil, err
+	}
+	r.cert = cert
+	r.rotateTime = now.Add(r.rotationPeriod)
+	return cert, nil
+}
+
+// serverTLSConfig returns a copy of [config] that presents the transport
+// certificate to clients that negotiate [TransportCertProtocol].
+func serverTLSConfig(config *tls.Config, transportCerts *TransportCertRotator) *tls.Config {
+	config = config.Clone()
+	config.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		if !slices.Contains(hello.SupportedProtos, TransportCertProtocol) {
+			return nil, nil
+		}
+
+		cert, err := transportCerts.Get()
+		if err != nil {
+			return nil, err
+		}
+		connConfig := config.Clone()
+		connConfig.Certificates = []tls.Certificate{*cert}
+		return connConfig, nil
+	}
+	return config
+}
+
+// clientTLSConfig returns a copy of [config] for a single outbound connection
+// that presents the transport certificate if the server negotiated
+// [TransportCertProtocol].
+func clientTLSConfig(config *tls.Config, transportCerts *TransportCertRotator) *tls.Config {
+	var (
+		connConfig       = config.Clone()
+		verifyConnection = config.VerifyConnection
+		negotiated       bool
+	)
+	// The server's certificate, and therefore the negotiated protocol, is
+	// verified before the client certificate is requested.
+	connConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+		negotiated = cs.NegotiatedProtocol == TransportCertProtocol
+		if verifyConnection == nil {
+			return nil
+		}
+		return verifyConnection(cs)
+	}
+	connConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		if !negotiated {
+			return &connConfig.Certificates[0], nil
+		}
+		return transportCerts.Get()
+	}
+	return connConfig
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"crypto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/staking"
+)
+
+func TestTransportCertRotator(t *testing.T) {
+	require := require.New(t)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	stakingCert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(err)
+
+	r := NewTransportCertRotator(stakingCert, tlsCert.PrivateKey.(crypto.Signer), time.Hour)
+	r.clock.Set(time.Unix(1_000_000, 0))
+
+	cert0, err := r.Get()
+	require.NoError(err)
+	parsedCert, err := staking.ParseTLSCertificate(cert0.Leaf, r.clock.Time())
+	require.NoError(err)
+	require.Equal(stakingCert, parsedCert)
+
+	r.clock.Set(r.clock.Time().Add(time.Hour - time.Second))
+	cert1, err := r.Get()
+	require.NoError(err)
+	require.Same(cert0, cert1)
+
+	r.clock.Set(r.clock.Time().Add(time.Second))
+	cert2, err := r.Get()
+	require.NoError(err)
+	require.NotSame(cert0, cert2)
+
+	// The previous certificate remains valid until the next rotation.
+	_, err = staking.ParseTLSCertificate(cert0.Leaf, r.clock.Time().Add(time.Hour-time.Second))
+	require.NoError(err)
+}
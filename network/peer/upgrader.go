@@ -7,6 +7,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"net"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -31,7 +32,17 @@ type tlsServerUpgrader struct {
 	invalidCerts prometheus.Counter
 }
 
-func NewTLSServerUpgrader(config *tls.Config, invalidCerts prometheus.Counter) Upgrader {
+// NewTLSServerUpgrader returns an Upgrader for inbound connections. If
+// [transportCerts] is non-nil, the transport certificate is presented to
+// clients that accept it.
+func NewTLSServerUpgrader(
+	config *tls.Config,
+	transportCerts *TransportCertRotator,
+	invalidCerts prometheus.Counter,
+) Upgrader {
+	if transportCerts != nil {
+		config = serverTLSConfig(config, transportCerts)
+	}
 	return &tlsServerUpgrader{
 		config:       config,
 		invalidCerts: invalidCerts,
@@ -43,19 +54,32 @@ func (t *tlsServerUpgrader) Upgrade(conn net.Conn) (ids.NodeID, net.Conn, *staki
 }
 
 type tlsClientUpgrader struct {
-	config       *tls.Config
-	invalidCerts prometheus.Counter
+	config         *tls.Config
+	transportCerts *TransportCertRotator
+	invalidCerts   prometheus.Counter
 }
 
-func NewTLSClientUpgrader(config *tls.Config, invalidCerts prometheus.Counter) Upgrader {
+// NewTLSClientUpgrader returns an Upgrader for outbound connections. If
+// [transportCerts] is non-nil, the transport certificate is presented to
+// servers that accept it.
+func NewTLSClientUpgrader(
+	config *tls.Config,
+	transportCerts *TransportCertRotator,
+	invalidCerts prometheus.Counter,
+) Upgrader {
 	return &tlsClientUpgrader{
-		config:       config,
-		invalidCerts: invalidCerts,
+		config:         config,
+		transportCerts: transportCerts,
+		invalidCerts:   invalidCerts,
 	}
 }
 
 func (t *tlsClientUpgrader) Upgrade(conn net.Conn) (ids.NodeID, net.Conn, *staking.Certificate, error) {
-	return connToIDAndCert(tls.Client(conn, t.config), t.invalidCerts)
+	config := t.config
+	if t.transportCerts != nil {
+		config = clientTLSConfig(config, t.transportCerts)
+	}
+	return connToIDAndCert(tls.Client(conn, config), t.invalidCerts)
 }
 
 func connToIDAndCert(conn *tls.Conn, invalidCerts prometheus.Counter) (ids.NodeID, net.Conn, *staking.Certificate, error) {
@@ -68,8 +92,10 @@ func connToIDAndCert(conn *tls.Conn, invalidCerts prometheus.Counter) (ids.NodeI
 		return ids.EmptyNodeID, nil, nil, errNoCert
 	}
 
+	// The node ID is derived from the staking certificate, even if the peer
+	// presented a transport certificate.
 	tlsCert := state.PeerCertificates[0]
-	peerCert, err := staking.ParseCertificate(tlsCert.Raw)
+	peerCert, err := staking.ParseTLSCertificate(tlsCert, time.Now())
 	if err != nil {
 		invalidCerts.Inc()
 		return ids.EmptyNodeID, nil, nil, err
@@ -4,6 +4,8 @@
 package peer_test
 
 import (
+	"bytes"
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
@@ -20,6 +22,7 @@ import (
 
 	_ "embed"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/staking"
 )
@@ -95,7 +98,7 @@ func TestBlockClientsWithIncorrectRSAKeys(t *testing.T) {
 					require.FailNow(t, "should not have invoked")
 				},
 			}
-			upgrader := peer.NewTLSServerUpgrader(config, failOnIncrementCounter)
+			upgrader := peer.NewTLSServerUpgrader(config, nil, failOnIncrementCounter)
 
 			clientConfig := tls.Config{
 				ClientAuth:         tls.RequireAnyClientCert,
@@ -131,6 +134,129 @@ func TestBlockClientsWithIncorrectRSAKeys(t *testing.T) {
 	}
 }
 
+func TestTransportCertNegotiation(t *testing.T) {
+	tests := []struct {
+		name                  string
+		serverRotates         bool
+		serverLegacy          bool
+		clientRotates         bool
+		clientLegacy          bool
+		expectServerTransport bool
+		expectClientTransport bool
+	}{
+		{
+			name:                  "both rotate",
+			serverRotates:         true,
+			clientRotates:         true,
+			expectServerTransport: true,
+			expectClientTransport: true,
+		},
+		{
+			name:                  "only server rotates",
+			serverRotates:         true,
+			expectServerTransport: true,
+		},
+		{
+			name:                  "only client rotates",
+			clientRotates:         true,
+			expectClientTransport: true,
+		},
+		{
+			name:          "legacy client",
+			serverRotates: true,
+			clientLegacy:  true,
+		},
+		{
+			name:          "legacy server",
+			serverLegacy:  true,
+			clientRotates: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			serverCert, serverTransportCerts := newTransportCertRotator(t, test.serverRotates)
+			clientCert, clientTransportCerts := newTransportCertRotator(t, test.clientRotates)
+
+			serverConfig := peer.TLSConfig(*serverCert, nil)
+			if test.serverLegacy {
+				serverConfig.NextProtos = nil
+			}
+			clientConfig := peer.TLSConfig(*clientCert, nil)
+			if test.clientLegacy {
+				clientConfig.NextProtos = nil
+			}
+			counter := prometheus.NewCounter(prometheus.CounterOpts{})
+			serverUpgrader := peer.NewTLSServerUpgrader(serverConfig, serverTransportCerts, counter)
+			clientUpgrader := peer.NewTLSClientUpgrader(clientConfig, clientTransportCerts, counter)
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(err)
+			defer listener.Close()
+
+			type result struct {
+				nodeID ids.NodeID
+				conn   net.Conn
+				err    error
+			}
+			serverResult := make(chan result, 1)
+			go func() {
+				conn, err := listener.Accept()
+				if err != nil {
+					serverResult <- result{err: err}
+					return
+				}
+				nodeID, conn, _, err := serverUpgrader.Upgrade(conn)
+				serverResult <- result{
+					nodeID: nodeID,
+					conn:   conn,
+					err:    err,
+				}
+			}()
+
+			conn, err := net.Dial("tcp", listener.Addr().String())
+			require.NoError(err)
+			serverNodeID, clientConn, _, err := clientUpgrader.Upgrade(conn)
+			require.NoError(err)
+			defer clientConn.Close()
+
+			server := <-serverResult
+			require.NoError(server.err)
+			defer server.conn.Close()
+
+			// The node IDs are derived from the staking certificates
+			// regardless of the certificates presented.
+			require.Equal(ids.NodeIDFromCert(stakingCert(t, serverCert)), serverNodeID)
+			require.Equal(ids.NodeIDFromCert(stakingCert(t, clientCert)), server.nodeID)
+
+			presentedServerCert := clientConn.(*tls.Conn).ConnectionState().PeerCertificates[0]
+			require.Equal(test.expectServerTransport, !bytes.Equal(serverCert.Leaf.Raw, presentedServerCert.Raw))
+			presentedClientCert := server.conn.(*tls.Conn).ConnectionState().PeerCertificates[0]
+			require.Equal(test.expectClientTransport, !bytes.Equal(clientCert.Leaf.Raw, presentedClientCert.Raw))
+		})
+	}
+}
+
+func newTransportCertRotator(t *testing.T, rotates bool) (*tls.Certificate, *peer.TransportCertRotator) {
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(t, err)
+	if !rotates {
+		return tlsCert, nil
+	}
+	return tlsCert, peer.NewTransportCertRotator(
+		stakingCert(t, tlsCert),
+		tlsCert.PrivateKey.(crypto.Signer),
+		time.Hour,
+	)
+}
+
+func stakingCert(t *testing.T, tlsCert *tls.Certificate) *staking.Certificate {
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(t, err)
+	return cert
+}
+
 func nonStandardRSAKey(t *testing.T) *rsa.PrivateKey {
 	for {
 		sk, err := rsa.GenerateKey(rand.Reader, 2048)
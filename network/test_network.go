@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/router"
@@ -27,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -101,6 +103,15 @@ func NewTestNetworkConfig(
 	if err != nil {
 		return nil, err
 	}
+
+	clockSkew, err := clockskew.NewMonitor(
+		logging.NoLog{},
+		clockskew.Config{},
+		prometheus.NewRegistry(),
+	)
+	if err != nil {
+		return nil, err
+	}
 	return &Config{
 		HealthConfig: HealthConfig{
 			Enabled:                      true,
@@ -172,6 +183,8 @@ func NewTestNetworkConfig(
 		CompressionType:              constants.DefaultNetworkCompressionType,
 		TLSKey:                       tlsCert.PrivateKey.(crypto.Signer),
 		BLSKey:                       blsKey,
+		PeerEvents:                   events.NewLog(events.DefaultCapacity),
+		ClockSkew:                    clockSkew,
 		TrackedSubnets:               trackedSubnets,
 		Beacons:                      validators.NewManager(),
 		Validators:                   currentValidators,
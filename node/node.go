@@ -12,11 +12,14 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"maps"
 	"net"
 	"net/netip"
 	"os"
+	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/api/admin"
+	"github.com/ava-labs/avalanchego/api/audit"
 	"github.com/ava-labs/avalanchego/api/health"
 	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/api/metrics"
@@ -47,6 +51,8 @@ import (
 	"github.com/ava-labs/avalanchego/nat"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/dnsseed"
+	"github.com/ava-labs/avalanchego/network/events"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow"
@@ -59,6 +65,7 @@ import (
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/clockskew"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/dynamicip"
@@ -73,11 +80,13 @@ import (
 	"github.com/ava-labs/avalanchego/utils/resource"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/version/advisory"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/avm"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/registry"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm"
 	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
 
 	avmconfig "github.com/ava-labs/avalanchego/vms/avm/config"
@@ -91,8 +100,13 @@ const (
 
 	ipResolutionTimeout = 30 * time.Second
 
+	// The audit log is stored in this directory of the log directory.
+	auditLogDir      = "audit"
+	auditLogFileName = "admin.log"
+
 	apiNamespace             = constants.PlatformName + metric.NamespaceSeparator + "api"
 	benchlistNamespace       = constants.PlatformName + metric.NamespaceSeparator + "benchlist"
+	clockSkewNamespace       = constants.PlatformName + metric.NamespaceSeparator + "clock_skew"
 	dbNamespace              = constants.PlatformName + metric.NamespaceSeparator + "db"
 	healthNamespace          = constants.PlatformName + metric.NamespaceSeparator + "health"
 	meterDBNamespace         = constants.PlatformName + metric.NamespaceSeparator + "meterdb"
@@ -110,6 +124,7 @@ var (
 	ungracefulShutdown = []byte("ungracefulShutdown")
 
 	indexerDBPrefix = []byte{0x00}
+	aliasesDBPrefix = []byte{0x01}
 
 	errInvalidTLSKey = errors.New("invalid TLS key")
 	errShuttingDown  = errors.New("server shutting down")
@@ -143,15 +158,31 @@ func New(
 		return nil, fmt.Errorf("problem creating proof of possession: %w", err)
 	}
 
+	// The binary is hashed at startup, so that the reported hash is of the
+	// running binary even if the file is replaced while the node runs.
+	buildInfo, err := version.GetBuildInfo()
+	if err != nil {
+		logger.Warn("failed to hash the node's binary",
+			zap.Error(err),
+		)
+	}
+
 	logger.Info("initializing node",
 		zap.Stringer("version", version.CurrentApp),
-		zap.String("commit", version.GitCommit),
+		zap.String("commit", buildInfo.Commit),
+		zap.Bool("dirty", buildInfo.Dirty),
+		zap.String("binaryHash", buildInfo.BinaryHash),
 		zap.Stringer("nodeID", n.ID),
 		zap.Stringer("stakingKeyType", tlsCert.PublicKeyAlgorithm),
 		zap.Reflect("nodePOP", pop),
 		zap.Reflect("providedFlags", n.Config.ProvidedFlags),
 		zap.Reflect("config", n.Config),
 	)
+	for _, violation := range config.ConsensusGuardrailViolations {
+		logger.Warn("consensus config violates the network's guardrails, which may break the safety or liveness of the node",
+			zap.String("violation", violation),
+		)
+	}
 
 	n.VMFactoryLog, err = logFactory.Make("vm-factory")
 	if err != nil {
@@ -194,6 +225,7 @@ func New(
 	if err := n.initDatabase(); err != nil { // Set up the node's database
 		return nil, fmt.Errorf("problem initializing database: %w", err)
 	}
+	n.aliasStore = admin.NewAliasStore(prefixdb.New(aliasesDBPrefix, n.DB))
 
 	n.initSharedMemory() // Initialize shared memory
 
@@ -242,6 +274,9 @@ func New(
 	if err := n.initHealthAPI(); err != nil {
 		return nil, fmt.Errorf("couldn't initialize health API: %w", err)
 	}
+	if err := n.initUpdateAdvisory(); err != nil {
+		return nil, fmt.Errorf("couldn't initialize update advisory: %w", err)
+	}
 	if err := n.addDefaultVMAliases(); err != nil {
 		return nil, fmt.Errorf("couldn't initialize API aliases: %w", err)
 	}
@@ -260,6 +295,9 @@ func New(
 	if err := n.initChainAliases(n.Config.GenesisBytes); err != nil {
 		return nil, fmt.Errorf("couldn't initialize chain aliases: %w", err)
 	}
+	if err := n.initPersistedAliases(); err != nil {
+		return nil, fmt.Errorf("couldn't initialize persisted aliases: %w", err)
+	}
 	if err := n.initAPIAliases(n.Config.GenesisBytes); err != nil {
 		return nil, fmt.Errorf("couldn't initialize API aliases: %w", err)
 	}
@@ -292,11 +330,18 @@ type Node struct {
 
 	// Storage for this node
 	DB database.Database
+	// Estimates the disk usage of the database. Nil if the database isn't
+	// stored on disk.
+	DBSizeEstimator database.SizeEstimator
 
 	router     nat.Router
 	portMapper *nat.Mapper
 	ipUpdater  dynamicip.Updater
 
+	// Refreshes the bootstrappers listed by DNS seeds. Nil if no DNS seeds
+	// are configured.
+	dnsSeedUpdater *dnsseed.Updater
+
 	chainRouter router.Router
 
 	// Profiles the process. Nil if continuous profiling is disabled.
@@ -305,6 +350,22 @@ type Node struct {
 	// Indexes blocks, transactions and blocks
 	indexer indexer.Indexer
 
+	// Persists chain and VM aliases set through the admin API
+	aliasStore *admin.AliasStore
+
+	// Records calls to the admin API, if enabled
+	auditLog *audit.Log
+
+	// Records peer lifecycle events served by the admin API
+	peerEvents *events.Log
+
+	// Measures the offset of the local clock against peers and NTP servers
+	clockSkew *clockskew.Monitor
+
+	// Reports the mandatory upgrades this node doesn't support. Nil if no
+	// update advisory is configured.
+	updateAdvisory *advisory.Monitor
+
 	// Manages shared memory
 	sharedMemory *atomic.Memory
 
@@ -446,6 +507,10 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		stakingPort = n.stakingAddress.Port()
 		publicAddr  netip.Addr
 		atomicIP    *utils.Atomic[netip.AddrPort]
+		secondaryIP netip.AddrPort
+		// If set, the NAT router doesn't overwrite the public IP with its
+		// external IP, which may be stale or belong to an upstream NAT.
+		resolvedWithSTUN bool
 	)
 	switch {
 	case n.Config.PublicIP != "":
@@ -461,7 +526,12 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		n.ipUpdater = dynamicip.NewNoUpdater()
 	case n.Config.PublicIPResolutionService != "":
 		// Use dynamic IP resolution.
-		resolver, err := dynamicip.NewResolver(n.Config.PublicIPResolutionService)
+		var resolver dynamicip.Resolver
+		if strings.EqualFold(n.Config.PublicIPResolutionService, dynamicip.STUNName) {
+			resolver, err = dynamicip.NewSTUNResolver(n.Config.PublicIPResolutionSTUNServers)
+		} else {
+			resolver, err = dynamicip.NewResolver(n.Config.PublicIPResolutionService)
+		}
 		if err != nil {
 			return fmt.Errorf("couldn't create IP resolver: %w", err)
 		}
@@ -479,9 +549,39 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		))
 		n.ipUpdater = dynamicip.NewUpdater(atomicIP, resolver, n.Config.PublicIPResolutionFreq)
 	default:
+		// Prefer resolving the IP with STUN, falling back to the external IP
+		// reported by the NAT router.
+		var resolver dynamicip.Resolver
+		if len(n.Config.PublicIPResolutionSTUNServers) > 0 {
+			resolver, err = dynamicip.NewSTUNResolver(n.Config.PublicIPResolutionSTUNServers)
+			if err != nil {
+				return fmt.Errorf("couldn't create IP resolver: %w", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), ipResolutionTimeout)
+			publicAddr, err = resolver.Resolve(ctx)
+			cancel()
+			if err != nil {
+				n.Log.Warn("couldn't resolve public IP with STUN, falling back to NAT",
+					zap.Error(err),
+				)
+				resolver = nil
+			}
+		}
+
+		if resolver != nil {
+			atomicIP = utils.NewAtomic(netip.AddrPortFrom(
+				publicAddr,
+				stakingPort,
+			))
+			n.ipUpdater = dynamicip.NewUpdater(atomicIP, resolver, n.Config.PublicIPResolutionFreq)
+			resolvedWithSTUN = true
+			break
+		}
+
 		publicAddr, err = n.router.ExternalIP()
 		if err != nil {
-			return fmt.Errorf("public IP / IP resolution service not given and failed to resolve IP with NAT: %w", err)
+			return fmt.Errorf("public IP / IP resolution service not given and failed to resolve IP with STUN or NAT: %w", err)
 		}
 		atomicIP = utils.NewAtomic(netip.AddrPortFrom(
 			publicAddr,
@@ -496,12 +596,30 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		)
 	}
 
+	if n.Config.PublicIPSecondary != "" {
+		secondaryAddr, err := ips.ParseAddr(n.Config.PublicIPSecondary)
+		if err != nil {
+			return fmt.Errorf("invalid secondary public IP address %q: %w", n.Config.PublicIPSecondary, err)
+		}
+		if ips.IsSameFamily(publicAddr, secondaryAddr) {
+			return fmt.Errorf("secondary public IP %s must be of the other address family than the public IP %s", secondaryAddr, publicAddr)
+		}
+		secondaryIP = netip.AddrPortFrom(
+			secondaryAddr,
+			stakingPort,
+		)
+	}
+
 	// Regularly update our public IP and port mappings.
+	natIP := atomicIP
+	if resolvedWithSTUN {
+		natIP = nil
+	}
 	n.portMapper.Map(
 		stakingPort,
 		stakingPort,
 		stakingPortName,
-		atomicIP,
+		natIP,
 		n.Config.PublicIPResolutionFreq,
 	)
 	go n.ipUpdater.Dispatch(n.Log)
@@ -552,9 +670,23 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		n.chainRouter = router.Trace(n.chainRouter, n.tracer)
 	}
 
+	clockSkewRegisterer, err := metrics.MakeAndRegister(
+		n.MetricsGatherer,
+		clockSkewNamespace,
+	)
+	if err != nil {
+		return err
+	}
+	n.clockSkew, err = clockskew.NewMonitor(n.Log, n.Config.ClockSkewConfig, clockSkewRegisterer)
+	if err != nil {
+		return err
+	}
+	go n.clockSkew.Dispatch()
+
 	// Configure benchlist
+	n.peerEvents = events.NewLog(events.DefaultCapacity)
 	n.Config.BenchlistConfig.Validators = n.vdrs
-	n.Config.BenchlistConfig.Benchable = n.chainRouter
+	n.Config.BenchlistConfig.Benchable = n.peerEvents.WrapBenchable(n.chainRouter)
 	n.Config.BenchlistConfig.BenchlistRegisterer = metrics.NewLabelGatherer(chains.ChainLabel)
 
 	err = n.MetricsGatherer.Register(
@@ -614,6 +746,7 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 	// add node configs to network config
 	n.Config.NetworkConfig.MyNodeID = n.ID
 	n.Config.NetworkConfig.MyIPPort = atomicIP
+	n.Config.NetworkConfig.MySecondaryIPPort = secondaryIP
 	n.Config.NetworkConfig.NetworkID = n.Config.NetworkID
 	n.Config.NetworkConfig.Validators = n.vdrs
 	n.Config.NetworkConfig.Beacons = n.bootstrappers
@@ -626,6 +759,8 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 	n.Config.NetworkConfig.ResourceTracker = n.resourceTracker
 	n.Config.NetworkConfig.CPUTargeter = n.cpuTargeter
 	n.Config.NetworkConfig.DiskTargeter = n.diskTargeter
+	n.Config.NetworkConfig.PeerEvents = n.peerEvents
+	n.Config.NetworkConfig.ClockSkew = n.clockSkew
 
 	n.Net, err = network.NewNetwork(
 		&n.Config.NetworkConfig,
@@ -637,8 +772,14 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		dialer.NewDialer(constants.NetworkType, n.Config.NetworkConfig.DialerConfig, n.Log),
 		consensusRouter,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if n.dnsSeedUpdater != nil {
+		go n.Log.RecoverAndPanic(n.dnsSeedUpdater.Dispatch)
+	}
+	return nil
 }
 
 // Write process context to the configured path. Supports the use of
@@ -717,6 +858,11 @@ func (n *Node) Dispatch() error {
 		n.Net.ManuallyTrack(bootstrapper.ID, bootstrapper.IP)
 	}
 
+	// Add pinned nodes to the peer network
+	for nodeID, peerIP := range n.Config.NetworkConfig.PinnedPeers {
+		n.Net.ManuallyTrack(nodeID, peerIP)
+	}
+
 	// Start P2P connections
 	err := n.Net.Dispatch()
 
@@ -765,32 +911,13 @@ func (n *Node) initDatabase() error {
 	}
 
 	// start the db
-	switch n.Config.DatabaseConfig.Name {
-	case leveldb.Name:
-		// Prior to v1.10.15, the only on-disk database was leveldb, and its
-		// files went to [dbPath]/[networkID]/v1.4.5.
-		dbPath := filepath.Join(n.Config.DatabaseConfig.Path, version.CurrentDatabase.String())
-		n.DB, err = leveldb.New(dbPath, n.Config.DatabaseConfig.Config, n.Log, dbRegisterer)
-		if err != nil {
-			return fmt.Errorf("couldn't create %s at %s: %w", leveldb.Name, dbPath, err)
-		}
-	case memdb.Name:
-		n.DB = memdb.New()
-	case pebbledb.Name:
-		dbPath := filepath.Join(n.Config.DatabaseConfig.Path, "pebble")
-		n.DB, err = pebbledb.New(dbPath, n.Config.DatabaseConfig.Config, n.Log, dbRegisterer)
-		if err != nil {
-			return fmt.Errorf("couldn't create %s at %s: %w", pebbledb.Name, dbPath, err)
-		}
-	default:
-		return fmt.Errorf(
-			"db-type was %q but should have been one of {%s, %s, %s}",
-			n.Config.DatabaseConfig.Name,
-			leveldb.Name,
-			memdb.Name,
-			pebbledb.Name,
-		)
+	n.DB, err = NewDatabase(n.Config.DatabaseConfig, n.Log, dbRegisterer)
+	if err != nil {
+		return err
 	}
+	// The wrappers of the database don't estimate disk usage, so it is
+	// estimated directly by the database.
+	n.DBSizeEstimator, _ = n.DB.(database.SizeEstimator)
 
 	if n.Config.ReadOnly && n.Config.DatabaseConfig.Name != memdb.Name {
 		n.DB = versiondb.New(n.DB)
@@ -856,8 +983,69 @@ func (n *Node) initDatabase() error {
 	return nil
 }
 
+// NewDatabase opens the database described by [config] at the location the
+// node uses for it.
+func NewDatabase(
+	config node.DatabaseConfig,
+	log logging.Logger,
+	reg prometheus.Registerer,
+) (database.Database, error) {
+	switch config.Name {
+	case leveldb.Name:
+		// Prior to v1.10.15, the only on-disk database was leveldb, and its
+		// files went to [dbPath]/[networkID]/v1.4.5.
+		dbPath := filepath.Join(config.Path, version.CurrentDatabase.String())
+		db, err := leveldb.New(dbPath, config.Config, log, reg)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create %s at %s: %w", leveldb.Name, dbPath, err)
+		}
+		return db, nil
+	case memdb.Name:
+		return memdb.New(), nil
+	case pebbledb.Name:
+		dbPath := filepath.Join(config.Path, "pebble")
+		db, err := pebbledb.New(dbPath, config.Config, log, reg)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create %s at %s: %w", pebbledb.Name, dbPath, err)
+		}
+		return db, nil
+	default:
+		return nil, fmt.Errorf(
+			"db-type was %q but should have been one of {%s, %s, %s}",
+			config.Name,
+			leveldb.Name,
+			memdb.Name,
+			pebbledb.Name,
+		)
+	}
+}
+
 // Set the node IDs of the peers this node should first connect to
 func (n *Node) initBootstrappers() error {
+	if len(n.Config.BootstrapDNSSeeds) > 0 {
+		seeder := dnsseed.NewSeeder(n.Log, net.DefaultResolver, n.Config.BootstrapDNSSeeds)
+		n.dnsSeedUpdater = dnsseed.NewUpdater(
+			seeder,
+			n.Config.BootstrapDNSSeedsRefreshFreq,
+			n.addDNSSeedBootstrappers,
+		)
+
+		configured := set.NewSet[ids.NodeID](len(n.Config.Bootstrappers))
+		for _, bootstrapper := range n.Config.Bootstrappers {
+			configured.Add(bootstrapper.ID)
+		}
+		discovered := n.dnsSeedUpdater.Lookup()
+		for _, bootstrapper := range discovered {
+			if !configured.Contains(bootstrapper.ID) {
+				n.Config.Bootstrappers = append(n.Config.Bootstrappers, bootstrapper)
+			}
+		}
+		n.Log.Info("resolved bootstrap DNS seeds",
+			zap.Strings("seeds", n.Config.BootstrapDNSSeeds),
+			zap.Int("numBootstrappers", len(discovered)),
+		)
+	}
+
 	n.bootstrappers = validators.NewManager()
 	for _, bootstrapper := range n.Config.Bootstrappers {
 		// Note: The beacon connection manager will treat all beaconIDs as
@@ -884,6 +1072,24 @@ func (n *Node) initEventDispatchers() {
 // initialized
 func (n *Node) initIndexer() error {
 	txIndexerDB := prefixdb.New(indexerDBPrefix, n.DB)
+
+	var exportConfig *indexer.ExportConfig
+	if n.Config.IndexExportSink != "" {
+		sink, err := indexer.NewSink(
+			n.Config.IndexExportSink,
+			n.Config.IndexExportURL,
+			n.Config.IndexExportKafkaTopic,
+		)
+		if err != nil {
+			return fmt.Errorf("couldn't create index export sink: %w", err)
+		}
+		exportConfig = &indexer.ExportConfig{
+			Sink:           sink,
+			BatchSize:      n.Config.IndexExportBatchSize,
+			RetryFrequency: n.Config.IndexExportRetryFrequency,
+		}
+	}
+
 	var err error
 	n.indexer, err = indexer.NewIndexer(indexer.Config{
 		IndexingEnabled:      n.Config.IndexAPIEnabled,
@@ -897,6 +1103,8 @@ func (n *Node) initIndexer() error {
 		ShutdownF: func() {
 			n.Shutdown(0) // TODO put exit code here
 		},
+		GetChainConfig: n.chainManager.GetChainConfig,
+		Export:         exportConfig,
 	})
 	if err != nil {
 		return fmt.Errorf("couldn't create index for txs: %w", err)
@@ -1040,6 +1248,7 @@ func (n *Node) initAPIServer() error {
 		apiRegisterer,
 		n.Config.HTTPConfig.HTTPConfig,
 		n.Config.HTTPAllowedHosts,
+		n.Config.SubnetConfigs,
 	)
 	return err
 }
@@ -1143,6 +1352,7 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			TxAcceptorGroup:                         n.TxAcceptorGroup,
 			VertexAcceptorGroup:                     n.VertexAcceptorGroup,
 			DB:                                      n.DB,
+			DBSizeEstimator:                         n.DBSizeEstimator,
 			MsgCreator:                              n.msgCreator,
 			Router:                                  n.chainRouter,
 			Net:                                     n.Net,
@@ -1166,15 +1376,27 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			ChainConfigs:                            n.Config.ChainConfigs,
 			FrontierPollFrequency:                   n.Config.FrontierPollFrequency,
 			ConsensusAppConcurrency:                 n.Config.ConsensusAppConcurrency,
+			ConsensusMaxUnprocessedMsgs:             n.Config.ConsensusMaxUnprocessedMsgs,
+			AppGossipDedupWindow:                    n.Config.AppGossipDedupWindow,
+			AppGossipDedupSize:                      n.Config.AppGossipDedupSize,
+			BlockVerificationTimeout:                n.Config.BlockVerificationTimeout,
 			BootstrapMaxTimeGetAncestors:            n.Config.BootstrapMaxTimeGetAncestors,
 			BootstrapAncestorsMaxContainersSent:     n.Config.BootstrapAncestorsMaxContainersSent,
 			BootstrapAncestorsMaxContainersReceived: n.Config.BootstrapAncestorsMaxContainersReceived,
+			BootstrapMaxConcurrentChains:            n.Config.BootstrapMaxConcurrentChains,
 			Upgrades:                                n.Config.UpgradeConfig,
 			ResourceTracker:                         n.resourceTracker,
 			StateSyncBeacons:                        n.Config.StateSyncIDs,
+			ReadReplicaChainIDs:                     n.Config.ReadReplicaChainIDs,
+			ReadReplicaSources:                      newReadReplicaSources(n.Config.ReadReplicaURIs),
+			ReadReplicaPollFrequency:                n.Config.ReadReplicaPollFrequency,
 			TracingEnabled:                          n.Config.TraceConfig.Enabled,
 			Tracer:                                  n.tracer,
 			ChainDataDir:                            n.Config.ChainDataDir,
+			PruneRetiredChains:                      n.Config.PruneRetiredChains,
+			VMMaxRestarts:                           n.Config.PluginVMMaxRestarts,
+			VMRestartInitialBackoff:                 n.Config.PluginVMRestartInitialBackoff,
+			VMRestartMaxBackoff:                     n.Config.PluginVMRestartMaxBackoff,
 			Subnets:                                 subnets,
 		},
 	)
@@ -1184,6 +1406,8 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 
 	// Notify the API server when new chains are created
 	n.chainManager.AddRegistrant(n.APIServer)
+	// Notify the network when subnets start or stop being tracked
+	n.chainManager.AddSubnetTracker(n.Net)
 	return nil
 }
 
@@ -1209,8 +1433,9 @@ func (n *Node) initVMs() error {
 				UptimeLockedCalculator:    n.uptimeCalculator,
 				SybilProtectionEnabled:    n.Config.SybilProtectionEnabled,
 				PartialSyncPrimaryNetwork: n.Config.PartialSyncPrimaryNetwork,
-				TrackedSubnets:            n.Config.TrackedSubnets,
+				TrackedSubnets:            maps.Clone(n.Config.TrackedSubnets),
 				DynamicFeeConfig:          n.Config.DynamicFeeConfig,
+				SubnetAuthFeeWeights:      n.Config.SubnetAuthFeeWeights,
 				ValidatorFeeConfig:        n.Config.ValidatorFeeConfig,
 				UptimePercentage:          n.Config.UptimeRequirement,
 				MinValidatorStake:         n.Config.MinValidatorStake,
@@ -1222,6 +1447,7 @@ func (n *Node) initVMs() error {
 				RewardConfig:              n.Config.RewardConfig,
 				UpgradeConfig:             n.Config.UpgradeConfig,
 				UseCurrentHeight:          n.Config.UseCurrentHeight,
+				MaintenanceWindow:         n.Config.MaintenanceWindow,
 			},
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.AVMID, &avm.Factory{
@@ -1229,6 +1455,7 @@ func (n *Node) initVMs() error {
 				Upgrades:         n.Config.UpgradeConfig,
 				TxFee:            n.Config.TxFee,
 				CreateAssetTxFee: n.Config.CreateAssetTxFee,
+				DynamicFeeConfig: n.Config.DynamicFeeConfig,
 			},
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.EVMID, &coreth.Factory{}),
@@ -1254,6 +1481,7 @@ func (n *Node) initVMs() error {
 			CPUTracker:      n.resourceManager,
 			RuntimeTracker:  n.runtimeManager,
 			MetricsGatherer: rpcchainvmMetricsGatherer,
+			Limits:          n.Config.PluginVMLimits,
 		}),
 		VMManager: n.VMManager,
 	})
@@ -1324,6 +1552,21 @@ func (n *Node) initAdminAPI() error {
 		return nil
 	}
 	n.Log.Info("initializing admin API")
+	if n.Config.AdminAPIAuditLogEnabled {
+		auditLogPath := filepath.Join(n.Config.LoggingConfig.Directory, auditLogDir, auditLogFileName)
+		auditLog, err := audit.New(audit.Config{
+			Path:     auditLogPath,
+			MaxSize:  n.Config.AdminAPIAuditLogMaxSize,
+			MaxFiles: n.Config.AdminAPIAuditLogMaxFiles,
+		})
+		if err != nil {
+			return fmt.Errorf("couldn't initialize audit log: %w", err)
+		}
+		n.auditLog = auditLog
+		n.Log.Info("recording admin API calls in audit log",
+			zap.String("path", auditLogPath),
+		)
+	}
 	service, err := admin.NewService(
 		admin.Config{
 			Log:          n.Log,
@@ -1335,11 +1578,17 @@ func (n *Node) initAdminAPI() error {
 			NodeConfig:   n.Config,
 			VMManager:    n.VMManager,
 			VMRegistry:   n.VMRegistry,
+			AliasStore:   n.aliasStore,
+			PeerEvents:   n.peerEvents,
+			AuditLog:     n.auditLog,
 		},
 	)
 	if err != nil {
 		return err
 	}
+	if n.auditLog != nil {
+		service = audit.NewHandler(service, n.auditLog, n.Log)
+	}
 	return n.APIServer.AddRoute(
 		service,
 		"admin",
@@ -1393,6 +1642,8 @@ func (n *Node) initInfoAPI() error {
 			VMManager: n.VMManager,
 			Upgrades:  n.Config.UpgradeConfig,
 
+			TrustedReleasePublishers: n.Config.UpdateAdvisoryConfig.TrustedPublishers,
+
 			TxFee:            n.Config.TxFee,
 			CreateAssetTxFee: n.Config.CreateAssetTxFee,
 		},
@@ -1446,6 +1697,11 @@ func (n *Node) initHealthAPI() error {
 		return fmt.Errorf("couldn't register router health check: %w", err)
 	}
 
+	err = n.health.RegisterHealthCheck("clockskew", n.clockSkew, health.ApplicationTag)
+	if err != nil {
+		return fmt.Errorf("couldn't register clock skew health check: %w", err)
+	}
+
 	// TODO: add database health to liveness check
 	err = n.health.RegisterHealthCheck("database", n.DB, health.ApplicationTag)
 	if err != nil {
@@ -1505,6 +1761,11 @@ func (n *Node) initHealthAPI() error {
 		return fmt.Errorf("couldn't register bls health check: %w", err)
 	}
 
+	err = n.health.RegisterHealthCheck("vms", rpcchainvm.NewHealthChecker(n.VMManager), health.ApplicationTag)
+	if err != nil {
+		return fmt.Errorf("couldn't register vms health check: %w", err)
+	}
+
 	handler, err := health.NewGetAndPostHandler(n.Log, n.health)
 	if err != nil {
 		return err
@@ -1544,6 +1805,34 @@ func (n *Node) initHealthAPI() error {
 	)
 }
 
+// initUpdateAdvisory periodically checks that this node supports the mandatory
+// upgrades listed by the update advisory, if one is configured.
+func (n *Node) initUpdateAdvisory() error {
+	if n.Config.UpdateAdvisoryConfig.URL == "" {
+		return nil
+	}
+
+	n.Log.Info("initializing update advisory",
+		zap.String("url", n.Config.UpdateAdvisoryConfig.URL),
+	)
+	n.updateAdvisory = advisory.NewMonitor(
+		n.Log,
+		n.Config.UpdateAdvisoryConfig,
+		n.Config.NetworkID,
+		n.Config.UpgradeConfig,
+	)
+	go n.Log.RecoverAndPanic(n.updateAdvisory.Dispatch)
+
+	if !n.Config.HealthAPIEnabled {
+		return nil
+	}
+	err := n.health.RegisterHealthCheck("updateadvisory", n.updateAdvisory, health.ApplicationTag)
+	if err != nil {
+		return fmt.Errorf("couldn't register update advisory health check: %w", err)
+	}
+	return nil
+}
+
 // Give chains aliases as specified by the genesis information
 func (n *Node) initChainAliases(genesisBytes []byte) error {
 	n.Log.Info("initializing chain aliases")
@@ -1571,6 +1860,64 @@ func (n *Node) initChainAliases(genesisBytes []byte) error {
 	return nil
 }
 
+// Give chains and VMs the aliases that were persisted through the admin API.
+// Aliases that are already mapped to the same ID are skipped.
+func (n *Node) initPersistedAliases() error {
+	n.Log.Info("initializing persisted aliases")
+	chainAliases, err := n.aliasStore.ChainAliases()
+	if err != nil {
+		return err
+	}
+	err = applyPersistedAliases(n.Log, n.chainManager, chainAliases, func(chainID ids.ID, alias string) error {
+		endpoint := path.Join(constants.ChainAliasPrefix, chainID.String())
+		return n.APIServer.AddAliases(endpoint, path.Join(constants.ChainAliasPrefix, alias))
+	})
+	if err != nil {
+		return err
+	}
+
+	vmAliases, err := n.aliasStore.VMAliases()
+	if err != nil {
+		return err
+	}
+	return applyPersistedAliases(n.Log, n.VMAliaser, vmAliases, func(ids.ID, string) error {
+		return nil
+	})
+}
+
+// applyPersistedAliases gives each ID in [aliases] its aliases and calls
+// [onAlias] for every alias that was newly applied.
+func applyPersistedAliases(
+	log logging.Logger,
+	aliaser ids.Aliaser,
+	aliases map[ids.ID][]string,
+	onAlias func(id ids.ID, alias string) error,
+) error {
+	for id, idAliases := range aliases {
+		for _, alias := range idAliases {
+			aliasedID, err := aliaser.Lookup(alias)
+			if err == nil {
+				if aliasedID != id {
+					log.Warn("skipping persisted alias",
+						zap.String("reason", "alias is already mapped to a different ID"),
+						zap.String("alias", alias),
+						zap.Stringer("persistedID", id),
+						zap.Stringer("aliasedID", aliasedID),
+					)
+				}
+				continue
+			}
+			if err := aliaser.Alias(id, alias); err != nil {
+				return err
+			}
+			if err := onAlias(id, alias); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // APIs aliases as specified by the genesis information
 func (n *Node) initAPIAliases(genesisBytes []byte) error {
 	n.Log.Info("initializing API aliases")
@@ -1705,11 +2052,27 @@ func (n *Node) shutdown() {
 	}
 	n.portMapper.UnmapAllPorts()
 	n.ipUpdater.Stop()
+	if n.dnsSeedUpdater != nil {
+		n.dnsSeedUpdater.Stop()
+	}
+	if n.clockSkew != nil {
+		n.clockSkew.Stop()
+	}
+	if n.updateAdvisory != nil {
+		n.updateAdvisory.Stop()
+	}
 	if err := n.indexer.Close(); err != nil {
 		n.Log.Debug("error closing tx indexer",
 			zap.Error(err),
 		)
 	}
+	if n.auditLog != nil {
+		if err := n.auditLog.Close(); err != nil {
+			n.Log.Debug("error closing audit log",
+				zap.Error(err),
+			)
+		}
+	}
 
 	// Ensure all runtimes are shutdown
 	n.Log.Info("cleaning up plugin runtimes")
@@ -1744,6 +2107,29 @@ func (n *Node) shutdown() {
 	n.Log.Info("finished node shutdown")
 }
 
+// addDNSSeedBootstrappers starts connecting to the bootstrappers listed by the
+// DNS seeds that aren't already known.
+func (n *Node) addDNSSeedBootstrappers(bootstrappers []genesis.Bootstrapper) {
+	for _, bootstrapper := range bootstrappers {
+		if _, ok := n.bootstrappers.GetValidator(constants.PrimaryNetworkID, bootstrapper.ID); ok {
+			continue
+		}
+		if err := n.bootstrappers.AddStaker(constants.PrimaryNetworkID, bootstrapper.ID, nil, ids.Empty, 1); err != nil {
+			n.Log.Warn("failed to add bootstrapper from DNS seeds",
+				zap.Stringer("nodeID", bootstrapper.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		n.Log.Info("added bootstrapper from DNS seeds",
+			zap.Stringer("nodeID", bootstrapper.ID),
+			zap.Stringer("ip", bootstrapper.IP),
+		)
+		n.Net.ManuallyTrack(bootstrapper.ID, bootstrapper.IP)
+	}
+}
+
 func (n *Node) ExitCode() int {
 	return n.shuttingDownExitCode.Get()
 }
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/replica"
+)
+
+var _ replica.Source = (*indexSource)(nil)
+
+// newReadReplicaSources returns a function that returns the block index of the
+// chain with the provided primary alias on each of [uris] as read replica
+// sources.
+func newReadReplicaSources(uris []string) func(chainAlias string) []replica.Source {
+	return func(chainAlias string) []replica.Source {
+		sources := make([]replica.Source, len(uris))
+		for i, uri := range uris {
+			sources[i] = &indexSource{
+				client: indexer.NewClient(uri + "/ext/index/" + chainAlias + "/block"),
+			}
+		}
+		return sources
+	}
+}
+
+// indexSource provides the blocks accepted by a node through its index API.
+type indexSource struct {
+	client indexer.Client
+}
+
+func (s *indexSource) GetIndex(ctx context.Context, blkID ids.ID) (uint64, error) {
+	return s.client.GetIndex(ctx, blkID)
+}
+
+func (s *indexSource) GetLastAcceptedIndex(ctx context.Context) (uint64, error) {
+	_, index, err := s.client.GetLastAccepted(ctx)
+	return index, err
+}
+
+func (s *indexSource) GetBlocks(ctx context.Context, startIndex uint64, n int) ([][]byte, error) {
+	containers, err := s.client.GetContainerRange(ctx, startIndex, min(n, indexer.MaxFetchedByRange))
+	if err != nil {
+		return nil, err
+	}
+	blks := make([][]byte, len(containers))
+	for i, container := range containers {
+		blks[i] = container.Bytes
+	}
+	return blks, nil
+}
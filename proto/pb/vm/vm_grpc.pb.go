@@ -49,6 +49,7 @@ const (
 	VM_BlockAccept_FullMethodName                = "/vm.VM/BlockAccept"
 	VM_BlockReject_FullMethodName                = "/vm.VM/BlockReject"
 	VM_StateSummaryAccept_FullMethodName         = "/vm.VM/StateSummaryAccept"
+	VM_OnConfigUpdated_FullMethodName            = "/vm.VM/OnConfigUpdated"
 )
 
 // VMClient is the client API for VM service.
@@ -115,6 +116,10 @@ type VMClient interface {
 	BlockReject(ctx context.Context, in *BlockRejectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// StateSummary
 	StateSummaryAccept(ctx context.Context, in *StateSummaryAcceptRequest, opts ...grpc.CallOption) (*StateSummaryAcceptResponse, error)
+	// ConfigUpdater
+	//
+	// OnConfigUpdated replaces the config of the VM while it is running.
+	OnConfigUpdated(ctx context.Context, in *OnConfigUpdatedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type vMClient struct {
@@ -386,6 +391,15 @@ func (c *vMClient) StateSummaryAccept(ctx context.Context, in *StateSummaryAccep
 	return out, nil
 }
 
+func (c *vMClient) OnConfigUpdated(ctx context.Context, in *OnConfigUpdatedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VM_OnConfigUpdated_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VMServer is the server API for VM service.
 // All implementations must embed UnimplementedVMServer
 // for forward compatibility
@@ -450,6 +464,10 @@ type VMServer interface {
 	BlockReject(context.Context, *BlockRejectRequest) (*emptypb.Empty, error)
 	// StateSummary
 	StateSummaryAccept(context.Context, *StateSummaryAcceptRequest) (*StateSummaryAcceptResponse, error)
+	// ConfigUpdater
+	//
+	// OnConfigUpdated replaces the config of the VM while it is running.
+	OnConfigUpdated(context.Context, *OnConfigUpdatedRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedVMServer()
 }
 
@@ -544,6 +562,9 @@ func (UnimplementedVMServer) BlockReject(context.Context, *BlockRejectRequest) (
 func (UnimplementedVMServer) StateSummaryAccept(context.Context, *StateSummaryAcceptRequest) (*StateSummaryAcceptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StateSummaryAccept not implemented")
 }
+func (UnimplementedVMServer) OnConfigUpdated(context.Context, *OnConfigUpdatedRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnConfigUpdated not implemented")
+}
 func (UnimplementedVMServer) mustEmbedUnimplementedVMServer() {}
 
 // UnsafeVMServer may be embedded to opt out of forward compatibility for this service.
@@ -1079,6 +1100,24 @@ func _VM_StateSummaryAccept_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VM_OnConfigUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnConfigUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMServer).OnConfigUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VM_OnConfigUpdated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMServer).OnConfigUpdated(ctx, req.(*OnConfigUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VM_ServiceDesc is the grpc.ServiceDesc for VM service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1202,6 +1241,10 @@ var VM_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StateSummaryAccept",
 			Handler:    _VM_StateSummaryAccept_Handler,
 		},
+		{
+			MethodName: "OnConfigUpdated",
+			Handler:    _VM_OnConfigUpdated_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vm/vm.proto",
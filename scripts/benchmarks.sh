@@ -0,0 +1,18 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+# Runs the fee calculator and codec benchmarks.
+#
+# Additional arguments are passed to `go test`. For example, to collect
+# enough samples for benchstat:
+#
+#   ./scripts/benchmarks.sh -count=10
+
+# Directory above this script
+AVALANCHE_PATH=$( cd "$( dirname "${BASH_SOURCE[0]}" )"; cd .. && pwd )
+# Load the constants
+source "$AVALANCHE_PATH"/scripts/constants.sh
+
+cd "${AVALANCHE_PATH}"
+go test -run='^$' -bench=. -benchmem "$@" ./benchmarks/...
@@ -5,6 +5,12 @@
 #
 # Second argument is the directory to run fuzz tests in.
 # If not provided, defaults to the current directory.
+#
+# Third argument is a regular expression selecting the fuzz tests to run.
+# If not provided, defaults to all fuzz tests. Combined with a long fuzz time,
+# this runs selected harnesses for extended periods, e.g.:
+#
+#   ./scripts/build_fuzz.sh 3600 ./vms/platformvm/txs 'FuzzParse|FuzzDynamicCalculator'
 
 set -euo pipefail
 
@@ -17,18 +23,19 @@ source "$AVALANCHE_PATH"/scripts/constants.sh
 
 fuzzTime=${1:-1}
 fuzzDir=${2:-.}
+fuzzRegex=${3:-.}
 
 files=$(grep -r --include='**_test.go' --files-with-matches 'func Fuzz' "$fuzzDir")
 failed=false
 for file in ${files}
 do
-    funcs=$(grep -oP 'func \K(Fuzz\w*)' "$file")
+    funcs=$(grep -oP 'func \K(Fuzz\w*)' "$file" | grep -E "$fuzzRegex" || true)
     for func in ${funcs}
     do
         echo "Fuzzing $func in $file"
         parentDir=$(dirname "$file")
         # If any of the fuzz tests fail, return exit code 1
-        if ! go test -tags test "$parentDir" -run="$func" -fuzz="$func" -fuzztime="${fuzzTime}"s; then
+        if ! go test -tags test "$parentDir" -run="^$func\$" -fuzz="^$func\$" -fuzztime="${fuzzTime}"s; then
             failed=true
         fi
     done
@@ -0,0 +1,21 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+# Regenerates the golden files used to detect unintended changes to
+# serialization. Only run this after intentionally changing the serialization
+# of a type, and review the resulting diff of the golden files.
+
+# Directory above this script
+AVALANCHE_PATH=$( cd "$( dirname "${BASH_SOURCE[0]}" )"; cd .. && pwd )
+# Load the constants
+source "$AVALANCHE_PATH"/scripts/constants.sh
+
+cd "${AVALANCHE_PATH}"
+
+# Golden tests are defined in golden_test.go files. When UPDATE_GOLDENS is set,
+# they overwrite their golden files instead of comparing against them.
+GOLDEN_PACKAGES="$(find . -name golden_test.go -not -path './.git/*' -exec dirname {} \; | sort)"
+
+# shellcheck disable=SC2086
+UPDATE_GOLDENS=1 go test -run=TestGolden -count=1 ${GOLDEN_PACKAGES}
@@ -17,6 +17,12 @@ const (
 	// 1 means MinPercentConnected = 1 (fully connected).
 	MinPercentConnectedBuffer = .2
 
+	// MaxOverrideK is the largest K that can be set by ParameterOverrides.
+	MaxOverrideK = 100
+	// MaxOverrideBeta is the largest Beta that can be set by
+	// ParameterOverrides.
+	MaxOverrideBeta = 100
+
 	errMsg = `__________                    .___
 \______   \____________     __| _/__.__.
  |    |  _/\_  __ \__  \   / __ <   |  |
@@ -46,7 +52,8 @@ var (
 		MaxItemProcessingTime: 30 * time.Second,
 	}
 
-	ErrParametersInvalid = errors.New("parameters invalid")
+	ErrParametersInvalid   = errors.New("parameters invalid")
+	ErrOverrideOutOfBounds = errors.New("override out of bounds")
 )
 
 // Parameters required for snowball consensus
@@ -123,6 +130,42 @@ func (p Parameters) MinPercentConnectedHealthy() float64 {
 	return alphaRatio*(1-MinPercentConnectedBuffer) + MinPercentConnectedBuffer
 }
 
+// ParameterOverrides replace the set fields of Parameters. They allow
+// experimenting with the sampling parameters of a chain without changing the
+// parameters of its subnet.
+type ParameterOverrides struct {
+	K               *int `json:"k,omitempty"               yaml:"k,omitempty"`
+	AlphaPreference *int `json:"alphaPreference,omitempty" yaml:"alphaPreference,omitempty"`
+	AlphaConfidence *int `json:"alphaConfidence,omitempty" yaml:"alphaConfidence,omitempty"`
+	Beta            *int `json:"beta,omitempty"            yaml:"beta,omitempty"`
+}
+
+// Apply returns [p] with the set overrides applied.
+//
+// The overridden K must be in [1, MaxOverrideK] and the overridden Beta must be
+// in [1, MaxOverrideBeta]. The resulting parameters must be valid.
+func (o ParameterOverrides) Apply(p Parameters) (Parameters, error) {
+	if o.K != nil {
+		if *o.K < 1 || *o.K > MaxOverrideK {
+			return Parameters{}, fmt.Errorf("%w: k = %d: fails the condition that: 1 <= k <= %d", ErrOverrideOutOfBounds, *o.K, MaxOverrideK)
+		}
+		p.K = *o.K
+	}
+	if o.AlphaPreference != nil {
+		p.AlphaPreference = *o.AlphaPreference
+	}
+	if o.AlphaConfidence != nil {
+		p.AlphaConfidence = *o.AlphaConfidence
+	}
+	if o.Beta != nil {
+		if *o.Beta < 1 || *o.Beta > MaxOverrideBeta {
+			return Parameters{}, fmt.Errorf("%w: beta = %d: fails the condition that: 1 <= beta <= %d", ErrOverrideOutOfBounds, *o.Beta, MaxOverrideBeta)
+		}
+		p.Beta = *o.Beta
+	}
+	return p, p.Verify()
+}
+
 type terminationCondition struct {
 	alphaConfidence int
 	beta            int
@@ -256,3 +256,81 @@ func TestParametersMinPercentConnectedHealthy(t *testing.T) {
 		})
 	}
 }
+
+func TestParameterOverridesApply(t *testing.T) {
+	k := 30
+	alpha := 25
+	beta := 40
+	tooLargeK := MaxOverrideK + 1
+	tooLargeBeta := MaxOverrideBeta + 1
+	zero := 0
+
+	tests := []struct {
+		name           string
+		overrides      ParameterOverrides
+		expectedParams Parameters
+		expectedErr    error
+	}{
+		{
+			name:           "no overrides",
+			expectedParams: DefaultParameters,
+		},
+		{
+			name: "all overrides",
+			overrides: ParameterOverrides{
+				K:               &k,
+				AlphaPreference: &alpha,
+				AlphaConfidence: &alpha,
+				Beta:            &beta,
+			},
+			expectedParams: func() Parameters {
+				p := DefaultParameters
+				p.K = k
+				p.AlphaPreference = alpha
+				p.AlphaConfidence = alpha
+				p.Beta = beta
+				return p
+			}(),
+		},
+		{
+			name: "K too large",
+			overrides: ParameterOverrides{
+				K: &tooLargeK,
+			},
+			expectedErr: ErrOverrideOutOfBounds,
+		},
+		{
+			name: "beta too large",
+			overrides: ParameterOverrides{
+				Beta: &tooLargeBeta,
+			},
+			expectedErr: ErrOverrideOutOfBounds,
+		},
+		{
+			name: "beta too small",
+			overrides: ParameterOverrides{
+				Beta: &zero,
+			},
+			expectedErr: ErrOverrideOutOfBounds,
+		},
+		{
+			name: "invalid result",
+			overrides: ParameterOverrides{
+				K: &k,
+			},
+			expectedErr: ErrParametersInvalid,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			params, err := test.overrides.Apply(DefaultParameters)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedParams, params)
+		})
+	}
+}
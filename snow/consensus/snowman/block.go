@@ -35,6 +35,11 @@ type Block interface {
 	//
 	// If nil is returned, it is guaranteed that either Accept or Reject will be
 	// called on this block, unless the VM is shut down.
+	//
+	// The context may be cancelled if the verification takes too long or the
+	// chain is shutting down. Verification may stop early in that case by
+	// returning an error that wraps the context's error. The block must then
+	// remain verifiable, as its verification may be retried.
 	Verify(context.Context) error
 
 	// Bytes returns the binary representation of this block.
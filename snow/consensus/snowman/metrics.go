@@ -16,6 +16,14 @@ import (
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 )
 
+// AcceptedLatencyMetricName is the name of the histogram of the time, in
+// seconds, from the issuance of a block to its acceptance.
+const AcceptedLatencyMetricName = "blks_accepted_latency"
+
+// acceptedLatencyBuckets are the upper bounds, in seconds, of the accepted
+// latency histogram buckets.
+var acceptedLatencyBuckets = []float64{.1, .25, .5, .75, 1, 1.5, 2, 3, 5, 10, 30}
+
 type processingStart struct {
 	time       time.Time
 	pollNumber uint64
@@ -44,7 +52,10 @@ type metrics struct {
 	pollsAccepted metric.Averager
 	// latAccepted tracks the number of nanoseconds that a block was processing
 	// before being accepted
-	latAccepted          metric.Averager
+	latAccepted metric.Averager
+	// latAcceptedHistogram tracks the distribution of the number of seconds
+	// that a block was processing before being accepted
+	latAcceptedHistogram prometheus.Histogram
 	buildLatencyAccepted prometheus.Gauge
 
 	blockSizeRejectedSum prometheus.Gauge
@@ -108,6 +119,11 @@ func newMetrics(
 			reg,
 			&errs,
 		),
+		latAcceptedHistogram: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    AcceptedLatencyMetricName,
+			Help:    "time (in seconds) from the issuance of a block to its acceptance",
+			Buckets: acceptedLatencyBuckets,
+		}),
 		buildLatencyAccepted: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "blks_build_accept_latency",
 			Help: "time (in ns) from the timestamp of a block to the time it was accepted",
@@ -151,6 +167,7 @@ func newMetrics(
 		reg.Register(m.lastAcceptedTimestamp),
 		reg.Register(m.numProcessing),
 		reg.Register(m.blockSizeAcceptedSum),
+		reg.Register(m.latAcceptedHistogram),
 		reg.Register(m.buildLatencyAccepted),
 		reg.Register(m.blockSizeRejectedSum),
 		reg.Register(m.numSuccessfulPolls),
@@ -199,6 +216,7 @@ func (m *metrics) Accepted(
 	now := time.Now()
 	processingDuration := now.Sub(start.time)
 	m.latAccepted.Observe(float64(processingDuration))
+	m.latAcceptedHistogram.Observe(processingDuration.Seconds())
 
 	builtDuration := now.Sub(timestamp)
 	m.buildLatencyAccepted.Add(float64(builtDuration))
@@ -19,6 +19,15 @@ var (
 		Code:    -1,
 		Message: "timed out",
 	}
+
+	// ErrNotSent is used to signal that a request wasn't sent to a peer. The
+	// message of the error describes why the request wasn't sent. Because the
+	// peer never received the request, it can be immediately retried with
+	// another peer.
+	ErrNotSent = &AppError{
+		Code:    -5,
+		Message: "not sent",
+	}
 )
 
 // AppError is an application-defined error
@@ -82,6 +82,11 @@ func TestErrorCode(t *testing.T) {
 			code:     -1,
 			expected: ErrTimeout,
 		},
+		{
+			name:     "not sent",
+			code:     -5,
+			expected: ErrNotSent,
+		},
 	}
 
 	for _, tt := range tests {
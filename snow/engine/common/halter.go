@@ -3,23 +3,59 @@
 
 package common
 
-import "sync/atomic"
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
 
 var _ Haltable = (*Halter)(nil)
 
 type Haltable interface {
 	Halt()
 	Halted() bool
+	// CancelOnHalt returns a context derived from [ctx] that is cancelled once
+	// halted. The returned cancel function must be called to release the
+	// context's resources.
+	CancelOnHalt(ctx context.Context) (context.Context, context.CancelFunc)
 }
 
 type Halter struct {
 	halted uint32
+
+	initOnce sync.Once
+	haltOnce sync.Once
+	// closed once halted
+	haltedChan chan struct{}
 }
 
 func (h *Halter) Halt() {
 	atomic.StoreUint32(&h.halted, 1)
+	h.haltOnce.Do(func() {
+		close(h.done())
+	})
 }
 
 func (h *Halter) Halted() bool {
 	return atomic.LoadUint32(&h.halted) == 1
 }
+
+func (h *Halter) CancelOnHalt(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := h.done()
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+func (h *Halter) done() chan struct{} {
+	h.initOnce.Do(func() {
+		h.haltedChan = make(chan struct{})
+	})
+	return h.haltedChan
+}
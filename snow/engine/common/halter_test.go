@@ -0,0 +1,30 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHalterCancelOnHalt(t *testing.T) {
+	require := require.New(t)
+
+	h := &Halter{}
+	ctx, cancel := h.CancelOnHalt(context.Background())
+	defer cancel()
+	require.NoError(ctx.Err())
+
+	h.Halt()
+	<-ctx.Done()
+	require.ErrorIs(ctx.Err(), context.Canceled)
+
+	// Contexts created after halting are cancelled as well.
+	ctx, cancel = h.CancelOnHalt(context.Background())
+	defer cancel()
+	<-ctx.Done()
+	require.True(h.Halted())
+}
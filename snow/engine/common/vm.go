@@ -5,6 +5,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/ava-labs/avalanchego/api/health"
@@ -78,3 +79,19 @@ type VM interface {
 	// information about their accounts.
 	CreateHandlers(context.Context) (map[string]http.Handler, error)
 }
+
+// ErrConfigUpdaterNotImplemented is returned by a VM that forwards config
+// updates to a VM that doesn't implement ConfigUpdater.
+var ErrConfigUpdaterNotImplemented = errors.New("vm does not implement ConfigUpdater interface")
+
+// ConfigUpdater is an optional interface that a VM can implement to allow its
+// user-provided config to be updated while the chain is running.
+type ConfigUpdater interface {
+	// OnConfigUpdated is called with the chain's new config. It is called
+	// while the chain's context lock is held, so no blocks are being verified
+	// or accepted concurrently.
+	//
+	// If the config is invalid, an error should be returned and the VM should
+	// continue to use its previous config.
+	OnConfigUpdated(ctx context.Context, configBytes []byte) error
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrLocalBlocksNotImplemented = errors.New("vm does not implement LocalBlocksVM interface")
+
+// LocalBlocksVM defines the optional functionality of a VM to expose blocks
+// that it has persisted beyond its last accepted block. This can happen if the
+// VM's last accepted block was rolled back, for example after restoring a
+// partially synced database.
+//
+// Bootstrapping uses these blocks to avoid fetching blocks that are already
+// available on disk.
+type LocalBlocksVM interface {
+	// GetLocalBlocks returns the bytes of up to [maxBlocksNum] consecutive
+	// blocks, starting at height [height]+1, in order of increasing height.
+	//
+	// Only blocks that were previously accepted may be returned. If the VM
+	// doesn't have a block at height [height]+1, no blocks are returned.
+	GetLocalBlocks(
+		ctx context.Context,
+		height uint64,
+		maxBlocksNum int,
+	) ([][]byte, error)
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+// WithUtilization defines the interface a Block can optionally implement to
+// report how much of its capacity it consumed.
+//
+// The proposervm uses the utilization of recently accepted blocks to space out
+// block production when the chain is idle.
+type WithUtilization interface {
+	// Utilization returns, for each resource dimension limited by the VM, the
+	// portion of the block's capacity that this block consumed. Each value is
+	// expected to be in [0, 1].
+	//
+	// If the utilization is unknown, nil should be returned.
+	Utilization() []float64
+}
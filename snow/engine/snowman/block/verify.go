@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+)
+
+var _ error = (*VerifyTimeoutError)(nil)
+
+// VerifyTimeoutError is returned by Verify if the verification of a block was
+// interrupted, either because it took longer than its timeout or because the
+// chain is shutting down.
+//
+// A block that timed out isn't known to be invalid, so its verification may be
+// retried.
+type VerifyTimeoutError struct {
+	BlkID  ids.ID
+	Height uint64
+	// Timeout is the duration the block was allowed to be verified for, or 0
+	// if the verification wasn't bounded.
+	Timeout time.Duration
+	// Err is the error returned by the block's verification.
+	Err error
+}
+
+func (e *VerifyTimeoutError) Error() string {
+	return fmt.Sprintf("verification of block %s (height=%d) was interrupted after at most %s: %v",
+		e.BlkID,
+		e.Height,
+		e.Timeout,
+		e.Err,
+	)
+}
+
+func (e *VerifyTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Verify verifies [blk] with a context that expires after [timeout]. If
+// [timeout] is 0, the verification is only bounded by [ctx].
+//
+// If the verification failed because its context was done, a
+// *VerifyTimeoutError is returned. VMs are expected to return an error wrapping
+// the context's error if they stop verifying a block because of it.
+func Verify(ctx context.Context, blk snowman.Block, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	err := blk.Verify(ctx)
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		return &VerifyTimeoutError{
+			BlkID:   blk.ID(),
+			Height:  blk.Height(),
+			Timeout: timeout,
+			Err:     err,
+		}
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+)
+
+var errTest = errors.New("non-nil error")
+
+// blockingBlock only finishes verification once its context is done.
+type blockingBlock struct {
+	*snowmantest.Block
+}
+
+func (*blockingBlock) Verify(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestVerify(t *testing.T) {
+	invalidBlk := snowmantest.BuildChild(snowmantest.Genesis)
+	invalidBlk.VerifyV = errTest
+
+	tests := []struct {
+		name            string
+		blk             snowman.Block
+		expectedErr     error
+		expectedTimeout bool
+	}{
+		{
+			name: "valid",
+			blk:  snowmantest.BuildChild(snowmantest.Genesis),
+		},
+		{
+			name:        "invalid",
+			blk:         invalidBlk,
+			expectedErr: errTest,
+		},
+		{
+			name: "timed out",
+			blk: &blockingBlock{
+				Block: snowmantest.BuildChild(snowmantest.Genesis),
+			},
+			expectedErr:     context.DeadlineExceeded,
+			expectedTimeout: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			err := Verify(context.Background(), test.blk, time.Millisecond)
+			require.ErrorIs(err, test.expectedErr)
+
+			var timeoutErr *VerifyTimeoutError
+			require.Equal(test.expectedTimeout, errors.As(err, &timeoutErr))
+		})
+	}
+}
+
+func TestVerifyCancelled(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	blk := &blockingBlock{
+		Block: snowmantest.BuildChild(snowmantest.Genesis),
+	}
+	err := Verify(ctx, blk, 0)
+	require.ErrorIs(err, context.Canceled)
+
+	var timeoutErr *VerifyTimeoutError
+	require.ErrorAs(err, &timeoutErr)
+	require.Equal(blk.ID(), timeoutErr.BlkID)
+	require.Equal(blk.Height(), timeoutErr.Height)
+}
@@ -103,6 +103,12 @@ type Bootstrapper struct {
 	// number of state transitions executed
 	executedStateTransitions uint64
 	awaitingTimeout          bool
+	// retryExecution is true if the execution of the blocks was interrupted
+	// by a block verification timeout and must be retried.
+	retryExecution bool
+	// awaitingCheckpoints is true if fetching blocks is delayed until a
+	// majority of the stake has published their checkpoints.
+	awaitingCheckpoints bool
 
 	tree            *interval.Tree
 	missingBlockIDs set.Set[ids.ID]
@@ -193,6 +199,26 @@ func (b *Bootstrapper) Start(ctx context.Context, startReqID uint32) error {
 		return fmt.Errorf("failed to initialize interval tree: %w", err)
 	}
 
+	if err := verifyExecutedHeight(b.DB, lastAcceptedHeight); err != nil {
+		return fmt.Errorf("failed to resume bootstrapping: %w", err)
+	}
+
+	if vm, ok := b.VM.(block.LocalBlocksVM); ok {
+		batch := b.DB.NewBatch()
+		numSeeded, err := seedLocalBlocks(ctx, batch, vm, b.nonVerifyingParser, b.tree, lastAccepted)
+		if err != nil {
+			return fmt.Errorf("failed to seed locally available blocks: %w", err)
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		if numSeeded > 0 {
+			b.Ctx.Log.Info("seeded bootstrapping with locally available blocks",
+				zap.Uint64("numBlocks", numSeeded),
+			)
+		}
+	}
+
 	b.missingBlockIDs, err = getMissingBlockIDs(ctx, b.DB, b.nonVerifyingParser, b.tree, b.startingHeight)
 	if err != nil {
 		return fmt.Errorf("failed to initialize missing block IDs: %w", err)
@@ -375,17 +401,48 @@ func (b *Bootstrapper) GetAcceptedFailed(ctx context.Context, nodeID ids.NodeID,
 }
 
 func (b *Bootstrapper) startSyncing(ctx context.Context, acceptedBlockIDs []ids.ID) error {
+	log := b.Ctx.Log.Info
+	if b.restarted {
+		log = b.Ctx.Log.Debug
+	}
+
+	var checkpointIDs []ids.ID
+	if b.Checkpoints != nil {
+		checkpoints, published, err := b.Checkpoints.Quorum()
+		if err != nil {
+			return err
+		}
+		if b.RequireCheckpoints && !published {
+			log("waiting for a majority of the stake to publish checkpoints")
+			// Restart bootstrapping after [bootstrappingDelay] to check the
+			// published checkpoints again.
+			b.awaitingCheckpoints = true
+			b.awaitingTimeout = true
+			b.TimeoutRegistrar.RegisterTimeout(bootstrappingDelay)
+			return nil
+		}
+
+		lastAccepted, err := b.getLastAccepted(ctx)
+		if err != nil {
+			return err
+		}
+		lastAcceptedHeight := lastAccepted.Height()
+		for _, c := range checkpoints {
+			if c.Height > lastAcceptedHeight {
+				checkpointIDs = append(checkpointIDs, c.BlockID)
+			}
+		}
+	}
+
 	knownBlockIDs := genesis.GetCheckpoints(b.Ctx.NetworkID, b.Ctx.ChainID)
 	b.missingBlockIDs.Union(knownBlockIDs)
+	b.missingBlockIDs.Add(checkpointIDs...)
 	b.missingBlockIDs.Add(acceptedBlockIDs...)
 	numMissingBlockIDs := b.missingBlockIDs.Len()
 
-	log := b.Ctx.Log.Info
-	if b.restarted {
-		log = b.Ctx.Log.Debug
-	}
 	log("starting to fetch blocks",
 		zap.Int("numKnownBlocks", knownBlockIDs.Len()),
+		zap.Int("numCheckpoints", len(checkpointIDs)),
 		zap.Int("numAcceptedBlocks", len(acceptedBlockIDs)),
 		zap.Int("numMissingBlocks", numMissingBlockIDs),
 	)
@@ -661,7 +718,7 @@ func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 	numToExecute := b.tree.Len()
 	err = execute(
 		ctx,
-		b.Halted,
+		b.Haltable,
 		log,
 		b.DB,
 		&parseAcceptor{
@@ -671,7 +728,24 @@ func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 		},
 		b.tree,
 		lastAccepted.Height(),
+		b.BlockVerificationTimeout,
 	)
+	var timeoutErr *block.VerifyTimeoutError
+	if errors.As(err, &timeoutErr) {
+		// The block that timed out is still tracked, so the remaining blocks
+		// are executed again after [bootstrappingDelay].
+		b.Ctx.Log.Warn("block verification timed out during bootstrapping",
+			zap.Stringer("blkID", timeoutErr.BlkID),
+			zap.Uint64("height", timeoutErr.Height),
+			zap.Duration("timeout", timeoutErr.Timeout),
+			zap.Duration("retryDelay", bootstrappingDelay),
+			zap.Error(timeoutErr.Err),
+		)
+		b.retryExecution = true
+		b.awaitingTimeout = true
+		b.TimeoutRegistrar.RegisterTimeout(bootstrappingDelay)
+		return nil
+	}
 	if err != nil {
 		// If a fatal error has occurred, include the last accepted block
 		// information.
@@ -739,7 +813,9 @@ func (b *Bootstrapper) Timeout() error {
 	}
 	b.awaitingTimeout = false
 
-	if !b.Config.BootstrapTracker.IsBootstrapped() {
+	if b.retryExecution || b.awaitingCheckpoints || !b.Config.BootstrapTracker.IsBootstrapped() {
+		b.retryExecution = false
+		b.awaitingCheckpoints = false
 		return b.restartBootstrapping(context.TODO())
 	}
 	return b.onFinished(context.TODO(), b.requestID)
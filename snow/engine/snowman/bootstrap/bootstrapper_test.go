@@ -25,11 +25,14 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap/interval"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/checkpoint"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/getter"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 
 	p2ppb "github.com/ava-labs/avalanchego/proto/pb/p2p"
 )
@@ -788,6 +791,81 @@ func TestBootstrapperRollbackOnSetState(t *testing.T) {
 	require.Equal(blks[0].HeightV, bs.startingHeight)
 }
 
+func TestBootstrapperRequiresCheckpoints(t *testing.T) {
+	require := require.New(t)
+
+	config, _, sender, vm, _ := newConfig(t)
+
+	// The validator that publishes checkpoints holds a majority of the stake.
+	sk, err := localsigner.New()
+	require.NoError(err)
+	publisherID := ids.GenerateTestNodeID()
+	require.NoError(config.Beacons.AddStaker(config.Ctx.SubnetID, publisherID, sk.PublicKey(), ids.Empty, 2))
+
+	config.Checkpoints = checkpoint.NewTracker(
+		config.Ctx.NetworkID,
+		config.Ctx.SubnetID,
+		config.Ctx.ChainID,
+		config.Beacons,
+	)
+	config.RequireCheckpoints = true
+
+	blks := snowmantest.BuildChain(checkpoint.Interval + 2)
+	initializeVMWithBlockchain(vm, blks)
+
+	bs, err := New(
+		config,
+		func(context.Context, uint32) error {
+			config.Ctx.State.Set(snow.EngineState{
+				Type:  p2ppb.EngineType_ENGINE_TYPE_SNOWMAN,
+				State: snow.NormalOp,
+			})
+			return nil
+		},
+	)
+	require.NoError(err)
+
+	var timeoutRegistered bool
+	bs.TimeoutRegistrar = &enginetest.Timer{
+		RegisterTimeoutF: func(time.Duration) {
+			timeoutRegistered = true
+		},
+	}
+
+	require.NoError(bs.Start(context.Background(), 0))
+
+	var requested set.Set[ids.ID]
+	sender.SendGetAncestorsF = func(_ context.Context, _ ids.NodeID, _ uint32, blkID ids.ID) {
+		requested.Add(blkID)
+	}
+
+	// Blocks aren't fetched until a majority of the stake published their
+	// checkpoints.
+	tip := blks[len(blks)-1]
+	require.NoError(bs.startSyncing(context.Background(), []ids.ID{tip.ID()}))
+	require.True(timeoutRegistered)
+	require.Empty(requested)
+
+	checkpointBlk := blks[checkpoint.Interval]
+	msg, err := checkpoint.Sign(
+		warp.NewSigner(sk, config.Ctx.NetworkID, config.Ctx.ChainID),
+		config.Ctx.NetworkID,
+		config.Ctx.ChainID,
+		[]checkpoint.Checkpoint{{
+			Height:  checkpointBlk.Height(),
+			BlockID: checkpointBlk.ID(),
+		}},
+	)
+	require.NoError(err)
+	require.NoError(config.Checkpoints.Add(publisherID, msg))
+
+	// The ancestry of the checkpoint is fetched along with the ancestry of
+	// the tip.
+	require.NoError(bs.Timeout())
+	require.NoError(bs.startSyncing(context.Background(), []ids.ID{tip.ID()}))
+	require.Equal(set.Of(tip.ID(), checkpointBlk.ID()), requested)
+}
+
 func initializeVMWithBlockchain(vm *blocktest.VM, blocks []*snowmantest.Block) {
 	vm.CantSetState = false
 	vm.LastAcceptedF = snowmantest.MakeLastAcceptedBlockF(
@@ -4,12 +4,15 @@
 package bootstrap
 
 import (
+	"time"
+
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/network/p2p"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/checkpoint"
 	"github.com/ava-labs/avalanchego/snow/validators"
 )
 
@@ -40,6 +43,20 @@ type Config struct {
 	// NonVerifyingParse parses blocks without verifying them.
 	NonVerifyingParse block.ParseFunc
 
+	// BlockVerificationTimeout is the maximum duration of the verification of
+	// a block. Blocks that time out are retried later. If 0, the verification
+	// of a block isn't bounded.
+	BlockVerificationTimeout time.Duration
+
+	// Checkpoints records the checkpoints published by the validators. If
+	// non-nil, the checkpoints published by a majority of the stake are
+	// fetched along with the accepted frontier, so that the ancestry of the
+	// chain is fetched from many heights in parallel.
+	Checkpoints *checkpoint.Tracker
+	// RequireCheckpoints delays fetching blocks until validators holding a
+	// majority of the stake have published their checkpoints.
+	RequireCheckpoints bool
+
 	Bootstrapped func()
 
 	common.Haltable
@@ -12,13 +12,15 @@ import (
 const (
 	intervalPrefixByte byte = iota
 	blockPrefixByte
+	executedHeightByte
 
 	prefixLen = 1
 )
 
 var (
-	intervalPrefix = []byte{intervalPrefixByte}
-	blockPrefix    = []byte{blockPrefixByte}
+	intervalPrefix    = []byte{intervalPrefixByte}
+	blockPrefix       = []byte{blockPrefixByte}
+	executedHeightKey = []byte{executedHeightByte}
 
 	errInvalidKeyLength = errors.New("invalid key length")
 )
@@ -107,3 +109,21 @@ func makeBlockKey(height uint64) []byte {
 	blockKey := database.PackUInt64(height)
 	return append(blockPrefix, blockKey...)
 }
+
+// GetExecutedHeight returns the height of the last block that was executed
+// during bootstrapping. If no block has been executed, database.ErrNotFound is
+// returned.
+func GetExecutedHeight(db database.KeyValueReader) (uint64, error) {
+	return database.GetUInt64(db, executedHeightKey)
+}
+
+// PutExecutedHeight records [height] as the height of the last block that was
+// executed during bootstrapping. It should be written atomically with the
+// removal of the block from the tree.
+func PutExecutedHeight(db database.KeyValueWriter, height uint64) error {
+	return database.PutUInt64(db, executedHeightKey, height)
+}
+
+func DeleteExecutedHeight(db database.KeyValueDeleter) error {
+	return db.Delete(executedHeightKey)
+}
@@ -5,6 +5,7 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap/interval"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -20,13 +22,67 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer"
 )
 
+var errExecutedHeightAheadOfVM = errors.New("bootstrapping executed blocks that the VM didn't persist")
+
 const (
 	batchWritePeriod      = 64
 	iteratorReleasePeriod = 1024
 	logPeriod             = 5 * time.Second
 	minBlocksToCompact    = 5000
+	localBlocksBatchSize  = 1024
 )
 
+// seedLocalBlocks adds the blocks that [vm] has persisted beyond
+// [lastAccepted] to the tree, so that they don't need to be fetched from the
+// network. Seeding stops at the first block that doesn't extend the previously
+// seeded block.
+//
+// Returns the number of blocks that were added to the tree.
+func seedLocalBlocks(
+	ctx context.Context,
+	db database.KeyValueWriterDeleter,
+	vm block.LocalBlocksVM,
+	nonVerifyingParser block.Parser,
+	tree *interval.Tree,
+	lastAccepted snowman.Block,
+) (uint64, error) {
+	var (
+		lastAcceptedHeight = lastAccepted.Height()
+		parentID           = lastAccepted.ID()
+		height             = lastAcceptedHeight
+		numSeeded          uint64
+	)
+	for {
+		blocks, err := vm.GetLocalBlocks(ctx, height, localBlocksBatchSize)
+		if err == block.ErrLocalBlocksNotImplemented {
+			return numSeeded, nil
+		}
+		if err != nil {
+			return numSeeded, err
+		}
+
+		for _, blkBytes := range blocks {
+			blk, err := nonVerifyingParser.ParseBlock(ctx, blkBytes)
+			if err != nil {
+				return numSeeded, err
+			}
+			if blk.Parent() != parentID || blk.Height() != height+1 {
+				return numSeeded, nil
+			}
+
+			height++
+			parentID = blk.ID()
+			if _, err := interval.Add(db, tree, lastAcceptedHeight, height, blkBytes); err != nil {
+				return numSeeded, err
+			}
+			numSeeded++
+		}
+		if len(blocks) < localBlocksBatchSize {
+			return numSeeded, nil
+		}
+	}
+}
+
 // getMissingBlockIDs returns the ID of the blocks that should be fetched to
 // attempt to make a single continuous range from
 // (lastAcceptedHeight, highestTrackedHeight].
@@ -117,22 +173,61 @@ func process(
 	}
 }
 
+// verifyExecutedHeight ensures that the VM's last accepted block is consistent
+// with the progress persisted by a previous call to execute.
+//
+// If the VM's last accepted height is lower than the persisted executed height,
+// blocks were removed from the tree that the VM no longer considers accepted.
+// Those blocks can't be executed again, so bootstrapping can't safely continue.
+func verifyExecutedHeight(db database.KeyValueReader, lastAcceptedHeight uint64) error {
+	executedHeight, err := interval.GetExecutedHeight(db)
+	if err == database.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if executedHeight > lastAcceptedHeight {
+		return fmt.Errorf("%w: executed height %d > last accepted height %d",
+			errExecutedHeightAheadOfVM,
+			executedHeight,
+			lastAcceptedHeight,
+		)
+	}
+	return nil
+}
+
 // execute all the blocks tracked by the tree. If a block is in the tree but is
 // already accepted based on the lastAcceptedHeight, it will be removed from the
 // tree but not executed.
 //
 // execute assumes that getMissingBlockIDs would return an empty set.
 //
+// The verification of a block is interrupted if it takes longer than
+// [verifyTimeout] or if [haltable] is halted. If a block times out, it is kept
+// in the tree so that it can be retried and a *block.VerifyTimeoutError is
+// returned.
+//
+// The height of the last executed block is persisted atomically with the
+// removal of the executed blocks from the tree, so that a restart resumes
+// execution from the first block that wasn't committed. See
+// verifyExecutedHeight.
+//
 // TODO: Replace usage of haltable with context cancellation.
 func execute(
 	ctx context.Context,
-	shouldHalt func() bool,
+	haltable common.Haltable,
 	log logging.Func,
 	db database.Database,
 	nonVerifyingParser block.Parser,
 	tree *interval.Tree,
 	lastAcceptedHeight uint64,
+	verifyTimeout time.Duration,
 ) error {
+	ctx, cancel := haltable.CancelOnHalt(ctx)
+	defer cancel()
+
+	shouldHalt := haltable.Halted
 	totalNumberToProcess := tree.Len()
 	if totalNumberToProcess >= minBlocksToCompact {
 		log("compacting database before executing blocks...")
@@ -207,6 +302,49 @@ func execute(
 			return err
 		}
 
+		// Blocks that were accepted prior to a restart are removed from the
+		// tree without being verified again.
+		if height > lastAcceptedHeight {
+			if err := block.Verify(ctx, blk, verifyTimeout); err != nil {
+				var timeoutErr *block.VerifyTimeoutError
+				if errors.As(err, &timeoutErr) {
+					// The block isn't known to be invalid, so it is added back
+					// to the tree to be executed again.
+					if _, err := interval.Add(batch, tree, lastAcceptedHeight, height, blk.Bytes()); err != nil {
+						return err
+					}
+					if err := batch.Write(); err != nil {
+						return err
+					}
+					if shouldHalt() {
+						return nil
+					}
+					return timeoutErr
+				}
+				return fmt.Errorf("failed to verify block %s (height=%d, parentID=%s) in bootstrapping: %w",
+					blk.ID(),
+					height,
+					blk.Parent(),
+					err,
+				)
+			}
+			if err := blk.Accept(ctx); err != nil {
+				return fmt.Errorf("failed to accept block %s (height=%d, parentID=%s) in bootstrapping: %w",
+					blk.ID(),
+					height,
+					blk.Parent(),
+					err,
+				)
+			}
+
+			// The executed height is written in the same batch as the removal
+			// of the block so that the tree never loses a block that the VM
+			// hasn't accepted.
+			if err := interval.PutExecutedHeight(batch, height); err != nil {
+				return err
+			}
+		}
+
 		// Periodically write the batch to disk to avoid memory pressure.
 		processedSinceBatchWrite++
 		if processedSinceBatchWrite >= batchWritePeriod {
@@ -248,29 +386,15 @@ func execute(
 			)
 			timeOfNextLog = now.Add(logPeriod)
 		}
-
-		if height <= lastAcceptedHeight {
-			continue
-		}
-
-		if err := blk.Verify(ctx); err != nil {
-			return fmt.Errorf("failed to verify block %s (height=%d, parentID=%s) in bootstrapping: %w",
-				blk.ID(),
-				height,
-				blk.Parent(),
-				err,
-			)
-		}
-		if err := blk.Accept(ctx); err != nil {
-			return fmt.Errorf("failed to accept block %s (height=%d, parentID=%s) in bootstrapping: %w",
-				blk.ID(),
-				height,
-				blk.Parent(),
-				err,
-			)
+	}
+	if tree.Len() == 0 {
+		// All the blocks have been executed, so there is no progress left to
+		// resume.
+		if err := interval.DeleteExecutedHeight(batch); err != nil {
+			return err
 		}
 	}
-	if err := writeBatch(); err != nil {
+	if err := batch.Write(); err != nil {
 		return err
 	}
 	return iterator.Error()
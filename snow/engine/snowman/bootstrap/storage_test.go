@@ -6,7 +6,9 @@ package bootstrap
 import (
 	"bytes"
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -23,7 +25,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
-var _ block.Parser = testParser(nil)
+var (
+	_ block.Parser        = testParser(nil)
+	_ block.LocalBlocksVM = testLocalBlocksVM(nil)
+
+	errTest = errors.New("non-nil test error")
+)
 
 func TestGetMissingBlockIDs(t *testing.T) {
 	blocks := snowmantest.BuildChain(7)
@@ -216,6 +223,99 @@ func TestProcess(t *testing.T) {
 	}
 }
 
+func TestSeedLocalBlocks(t *testing.T) {
+	const numBlocks = 5
+
+	blocks := snowmantest.BuildChain(numBlocks)
+	blocksBytes := make([][]byte, numBlocks)
+	for i, blk := range blocks {
+		blocksBytes[i] = blk.Bytes()
+	}
+	fork := snowmantest.BuildChild(blocks[1])
+
+	tests := []struct {
+		name               string
+		localBlocks        [][]byte
+		localBlocksErr     error
+		lastAcceptedHeight uint64
+		expectedHeights    []uint64
+		expectedErr        error
+	}{
+		{
+			name:            "not implemented",
+			localBlocksErr:  block.ErrLocalBlocksNotImplemented,
+			expectedHeights: nil,
+			expectedErr:     nil,
+		},
+		{
+			name:            "no local blocks",
+			expectedHeights: nil,
+			expectedErr:     nil,
+		},
+		{
+			name:            "seed all local blocks",
+			localBlocks:     blocksBytes[1:],
+			expectedHeights: []uint64{1, 2, 3, 4},
+			expectedErr:     nil,
+		},
+		{
+			name:               "seed local blocks after last accepted",
+			localBlocks:        blocksBytes[3:],
+			lastAcceptedHeight: 2,
+			expectedHeights:    []uint64{3, 4},
+			expectedErr:        nil,
+		},
+		{
+			name: "stop at first block not extending the chain",
+			localBlocks: [][]byte{
+				blocksBytes[1],
+				blocksBytes[2],
+				fork.Bytes(),
+			},
+			expectedHeights: []uint64{1, 2},
+			expectedErr:     nil,
+		},
+		{
+			name:           "unexpected error",
+			localBlocksErr: errTest,
+			expectedErr:    errTest,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memdb.New()
+			tree, err := interval.NewTree(db)
+			require.NoError(err)
+
+			vm := testLocalBlocksVM(func(_ context.Context, height uint64, _ int) ([][]byte, error) {
+				require.Equal(test.lastAcceptedHeight, height)
+				return test.localBlocks, test.localBlocksErr
+			})
+			parser := makeParser(append(blocks, fork))
+			numSeeded, err := seedLocalBlocks(
+				context.Background(),
+				db,
+				vm,
+				parser,
+				tree,
+				blocks[test.lastAcceptedHeight],
+			)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(uint64(len(test.expectedHeights)), numSeeded)
+			require.Equal(uint64(len(test.expectedHeights)), tree.Len())
+			for _, height := range test.expectedHeights {
+				require.True(tree.Contains(height))
+
+				blkBytes, err := interval.GetBlock(db, height)
+				require.NoError(err)
+				require.Equal(blocks[height].Bytes(), blkBytes)
+			}
+		})
+	}
+}
+
 func TestExecute(t *testing.T) {
 	const numBlocks = 7
 
@@ -269,12 +369,13 @@ func TestExecute(t *testing.T) {
 
 			require.NoError(execute(
 				context.Background(),
-				test.haltable.Halted,
+				test.haltable,
 				logging.NoLog{}.Info,
 				db,
 				parser,
 				tree,
 				test.lastAcceptedHeight,
+				0,
 			))
 			for _, height := range test.expectedProcessingHeights {
 				require.Equal(snowtest.Undecided, blocks[height].Status)
@@ -294,6 +395,133 @@ func TestExecute(t *testing.T) {
 	}
 }
 
+func TestExecuteVerifyTimeout(t *testing.T) {
+	const (
+		numBlocks     = 7
+		timeoutHeight = 3
+	)
+
+	require := require.New(t)
+
+	db := memdb.New()
+	tree, err := interval.NewTree(db)
+	require.NoError(err)
+
+	blocks := snowmantest.BuildChain(numBlocks)
+	for _, blk := range blocks {
+		_, err := interval.Add(db, tree, 0, blk.Height(), blk.Bytes())
+		require.NoError(err)
+	}
+
+	blockingBlk := &blockingBlock{
+		Block: blocks[timeoutHeight],
+	}
+	parser := testParser(func(ctx context.Context, b []byte) (snowman.Block, error) {
+		if bytes.Equal(b, blockingBlk.Bytes()) {
+			return blockingBlk, nil
+		}
+		return makeParser(blocks).ParseBlock(ctx, b)
+	})
+
+	err = execute(
+		context.Background(),
+		&common.Halter{},
+		logging.NoLog{}.Info,
+		db,
+		parser,
+		tree,
+		0,
+		time.Millisecond,
+	)
+	var timeoutErr *block.VerifyTimeoutError
+	require.ErrorAs(err, &timeoutErr)
+	require.Equal(uint64(timeoutHeight), timeoutErr.Height)
+
+	for _, blk := range blocks[:timeoutHeight] {
+		require.Equal(snowtest.Accepted, blk.Status)
+	}
+	for _, blk := range blocks[timeoutHeight:] {
+		require.Equal(snowtest.Undecided, blk.Status)
+	}
+
+	// The block that timed out must be executed again.
+	blkBytes, err := interval.GetBlock(db, timeoutHeight)
+	require.NoError(err)
+	require.Equal(blockingBlk.Bytes(), blkBytes)
+	require.True(tree.Contains(timeoutHeight))
+
+	// The progress prior to the block that timed out must be persisted.
+	executedHeight, err := interval.GetExecutedHeight(db)
+	require.NoError(err)
+	require.Equal(uint64(timeoutHeight-1), executedHeight)
+}
+
+func TestVerifyExecutedHeight(t *testing.T) {
+	tests := []struct {
+		name               string
+		hasExecuted        bool
+		executedHeight     uint64
+		lastAcceptedHeight uint64
+		expectedErr        error
+	}{
+		{
+			name:               "nothing executed",
+			lastAcceptedHeight: 5,
+			expectedErr:        nil,
+		},
+		{
+			name:               "executed height matches last accepted",
+			hasExecuted:        true,
+			executedHeight:     5,
+			lastAcceptedHeight: 5,
+			expectedErr:        nil,
+		},
+		{
+			name:               "last accepted ahead of executed height",
+			hasExecuted:        true,
+			executedHeight:     3,
+			lastAcceptedHeight: 5,
+			expectedErr:        nil,
+		},
+		{
+			name:               "executed height ahead of last accepted",
+			hasExecuted:        true,
+			executedHeight:     6,
+			lastAcceptedHeight: 5,
+			expectedErr:        errExecutedHeightAheadOfVM,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memdb.New()
+			if test.hasExecuted {
+				require.NoError(interval.PutExecutedHeight(db, test.executedHeight))
+			}
+
+			err := verifyExecutedHeight(db, test.lastAcceptedHeight)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+// blockingBlock only finishes verification once its context is done.
+type blockingBlock struct {
+	*snowmantest.Block
+}
+
+func (*blockingBlock) Verify(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+type testLocalBlocksVM func(context.Context, uint64, int) ([][]byte, error)
+
+func (f testLocalBlocksVM) GetLocalBlocks(ctx context.Context, height uint64, maxBlocksNum int) ([][]byte, error) {
+	return f(ctx, height, maxBlocksNum)
+}
+
 type testParser func(context.Context, []byte) (snowman.Block, error)
 
 func (f testParser) ParseBlock(ctx context.Context, bytes []byte) (snowman.Block, error) {
@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package checkpoint lets validators publish the IDs of the blocks they
+// accepted at regular heights. A bootstrapping node fetches the ancestry of
+// the checkpoints published by a majority of the stake in parallel, rather
+// than walking the full ancestry of the accepted frontier one block at a time.
+package checkpoint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+const (
+	// Interval is the number of heights between two checkpoints.
+	Interval = 16_384
+
+	// MaxCheckpoints is the maximum number of checkpoints a validator
+	// publishes.
+	MaxCheckpoints = 256
+
+	checkpointLen = wrappers.LongLen + ids.IDLen
+)
+
+var (
+	// payloadPrefix prefixes the signed payload, so that signed checkpoints
+	// can't be parsed as any other warp payload.
+	payloadPrefix = []byte("checkpoints")
+
+	errInvalidLen         = errors.New("invalid checkpoints length")
+	errTooManyCheckpoints = errors.New("too many checkpoints")
+	errInvalidHeight      = errors.New("invalid checkpoint height")
+	errInvalidSignature   = errors.New("invalid signature")
+)
+
+// Checkpoint is the ID of the block accepted at a height.
+type Checkpoint struct {
+	Height  uint64
+	BlockID ids.ID
+}
+
+// Heights returns the heights of the checkpoints published by a validator
+// whose last accepted height is [lastAcceptedHeight], from the highest to the
+// lowest.
+func Heights(lastAcceptedHeight uint64) []uint64 {
+	var (
+		height  = lastAcceptedHeight - lastAcceptedHeight%Interval
+		heights []uint64
+	)
+	for height > 0 && len(heights) < MaxCheckpoints {
+		heights = append(heights, height)
+		height -= Interval
+	}
+	return heights
+}
+
+// Sign returns the message that publishes [checkpoints], signed by [signer].
+func Sign(
+	signer warp.Signer,
+	networkID uint32,
+	chainID ids.ID,
+	checkpoints []Checkpoint,
+) ([]byte, error) {
+	checkpointsBytes := marshal(checkpoints)
+	msg, err := newUnsignedMessage(networkID, chainID, checkpointsBytes)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(msg)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't sign checkpoints: %w", err)
+	}
+	return append(signature, checkpointsBytes...), nil
+}
+
+// Parse returns the checkpoints published by [msgBytes], after verifying that
+// they were signed by [pk].
+func Parse(
+	pk *bls.PublicKey,
+	networkID uint32,
+	chainID ids.ID,
+	msgBytes []byte,
+) ([]Checkpoint, error) {
+	if len(msgBytes) < bls.SignatureLen {
+		return nil, fmt.Errorf("%w: %d", errInvalidLen, len(msgBytes))
+	}
+	signatureBytes, checkpointsBytes := msgBytes[:bls.SignatureLen], msgBytes[bls.SignatureLen:]
+
+	checkpoints, err := parse(checkpointsBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := bls.SignatureFromBytes(signatureBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidSignature, err)
+	}
+	msg, err := newUnsignedMessage(networkID, chainID, checkpointsBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !bls.Verify(pk, signature, msg.Bytes()) {
+		return nil, errInvalidSignature
+	}
+	return checkpoints, nil
+}
+
+func newUnsignedMessage(networkID uint32, chainID ids.ID, checkpointsBytes []byte) (*warp.UnsignedMessage, error) {
+	payload := append(bytes.Clone(payloadPrefix), checkpointsBytes...)
+	return warp.NewUnsignedMessage(networkID, chainID, payload)
+}
+
+func marshal(checkpoints []Checkpoint) []byte {
+	checkpointsBytes := make([]byte, 0, len(checkpoints)*checkpointLen)
+	for _, c := range checkpoints {
+		checkpointsBytes = binary.BigEndian.AppendUint64(checkpointsBytes, c.Height)
+		checkpointsBytes = append(checkpointsBytes, c.BlockID[:]...)
+	}
+	return checkpointsBytes
+}
+
+// parse returns the checkpoints encoded in [checkpointsBytes]. The checkpoints
+// must be at multiples of [Interval], from the highest to the lowest.
+func parse(checkpointsBytes []byte) ([]Checkpoint, error) {
+	if len(checkpointsBytes)%checkpointLen != 0 {
+		return nil, fmt.Errorf("%w: %d", errInvalidLen, len(checkpointsBytes))
+	}
+	numCheckpoints := len(checkpointsBytes) / checkpointLen
+	if numCheckpoints > MaxCheckpoints {
+		return nil, fmt.Errorf("%w: %d > %d", errTooManyCheckpoints, numCheckpoints, MaxCheckpoints)
+	}
+
+	checkpoints := make([]Checkpoint, numCheckpoints)
+	for i := range checkpoints {
+		offset := i * checkpointLen
+		c := Checkpoint{
+			Height:  binary.BigEndian.Uint64(checkpointsBytes[offset:]),
+			BlockID: ids.ID(checkpointsBytes[offset+wrappers.LongLen : offset+checkpointLen]),
+		}
+		if c.Height == 0 || c.Height%Interval != 0 || (i > 0 && c.Height >= checkpoints[i-1].Height) {
+			return nil, fmt.Errorf("%w: %d", errInvalidHeight, c.Height)
+		}
+		checkpoints[i] = c
+	}
+	return checkpoints, nil
+}
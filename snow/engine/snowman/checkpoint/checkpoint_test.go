@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+func TestHeights(t *testing.T) {
+	tests := []struct {
+		name               string
+		lastAcceptedHeight uint64
+		expected           []uint64
+	}{
+		{
+			name:               "below first checkpoint",
+			lastAcceptedHeight: Interval - 1,
+			expected:           nil,
+		},
+		{
+			name:               "at checkpoint",
+			lastAcceptedHeight: 2 * Interval,
+			expected:           []uint64{2 * Interval, Interval},
+		},
+		{
+			name:               "between checkpoints",
+			lastAcceptedHeight: 3*Interval + 1,
+			expected:           []uint64{3 * Interval, 2 * Interval, Interval},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, Heights(test.lastAcceptedHeight))
+		})
+	}
+
+	heights := Heights(10 * MaxCheckpoints * Interval)
+	require.Len(t, heights, MaxCheckpoints)
+	require.Equal(t, uint64(10*MaxCheckpoints*Interval), heights[0])
+}
+
+func TestSignAndParse(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+
+	chainID := ids.GenerateTestID()
+	signer := warp.NewSigner(sk, constants.UnitTestID, chainID)
+	checkpoints := []Checkpoint{
+		{
+			Height:  2 * Interval,
+			BlockID: ids.GenerateTestID(),
+		},
+		{
+			Height:  Interval,
+			BlockID: ids.GenerateTestID(),
+		},
+	}
+
+	msg, err := Sign(signer, constants.UnitTestID, chainID, checkpoints)
+	require.NoError(err)
+
+	parsed, err := Parse(sk.PublicKey(), constants.UnitTestID, chainID, msg)
+	require.NoError(err)
+	require.Equal(checkpoints, parsed)
+
+	// The signature commits to the chain.
+	_, err = Parse(sk.PublicKey(), constants.UnitTestID, ids.GenerateTestID(), msg)
+	require.ErrorIs(err, errInvalidSignature)
+
+	otherSK, err := localsigner.New()
+	require.NoError(err)
+	_, err = Parse(otherSK.PublicKey(), constants.UnitTestID, chainID, msg)
+	require.ErrorIs(err, errInvalidSignature)
+
+	_, err = Parse(sk.PublicKey(), constants.UnitTestID, chainID, msg[:len(msg)-1])
+	require.ErrorIs(err, errInvalidLen)
+}
+
+func TestParseInvalidHeights(t *testing.T) {
+	tests := []struct {
+		name        string
+		checkpoints []Checkpoint
+		expectedErr error
+	}{
+		{
+			name: "no checkpoints",
+		},
+		{
+			name: "genesis",
+			checkpoints: []Checkpoint{
+				{Height: 0},
+			},
+			expectedErr: errInvalidHeight,
+		},
+		{
+			name: "not at interval",
+			checkpoints: []Checkpoint{
+				{Height: Interval + 1},
+			},
+			expectedErr: errInvalidHeight,
+		},
+		{
+			name: "increasing",
+			checkpoints: []Checkpoint{
+				{Height: Interval},
+				{Height: 2 * Interval},
+			},
+			expectedErr: errInvalidHeight,
+		},
+		{
+			name: "duplicate",
+			checkpoints: []Checkpoint{
+				{Height: Interval},
+				{Height: Interval},
+			},
+			expectedErr: errInvalidHeight,
+		},
+		{
+			name:        "too many",
+			checkpoints: make([]Checkpoint, MaxCheckpoints+1),
+			expectedErr: errTooManyCheckpoints,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parse(marshal(test.checkpoints))
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
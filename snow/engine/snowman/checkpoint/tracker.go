@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package checkpoint
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+)
+
+var (
+	errNotValidator     = errors.New("not a validator")
+	errMissingPublicKey = errors.New("validator has no BLS public key")
+)
+
+// Tracker records the checkpoints published by the validators of a chain.
+//
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	networkID  uint32
+	subnetID   ids.ID
+	chainID    ids.ID
+	validators validators.Manager
+
+	lock sync.Mutex
+	// Validator --> Checkpoints last published by the validator
+	published map[ids.NodeID][]Checkpoint
+}
+
+func NewTracker(
+	networkID uint32,
+	subnetID ids.ID,
+	chainID ids.ID,
+	validators validators.Manager,
+) *Tracker {
+	return &Tracker{
+		networkID:  networkID,
+		subnetID:   subnetID,
+		chainID:    chainID,
+		validators: validators,
+		published:  make(map[ids.NodeID][]Checkpoint),
+	}
+}
+
+// IsValidator returns true if the checkpoints published by [nodeID] are
+// tracked.
+func (t *Tracker) IsValidator(nodeID ids.NodeID) bool {
+	_, ok := t.validators.GetValidator(t.subnetID, nodeID)
+	return ok
+}
+
+// Add records the checkpoints published by [nodeID] in [msgBytes], replacing
+// the checkpoints it previously published.
+func (t *Tracker) Add(nodeID ids.NodeID, msgBytes []byte) error {
+	vdr, ok := t.validators.GetValidator(t.subnetID, nodeID)
+	if !ok {
+		return fmt.Errorf("%w: %s", errNotValidator, nodeID)
+	}
+	if vdr.PublicKey == nil {
+		return fmt.Errorf("%w: %s", errMissingPublicKey, nodeID)
+	}
+
+	checkpoints, err := Parse(vdr.PublicKey, t.networkID, t.chainID, msgBytes)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.published[nodeID] = checkpoints
+	return nil
+}
+
+// Quorum returns the checkpoints published by validators holding a majority of
+// the stake, from the highest to the lowest.
+//
+// The returned bool is true if validators holding a majority of the stake
+// have published their checkpoints, even if they didn't agree on any
+// checkpoint.
+func (t *Tracker) Quorum() ([]Checkpoint, bool, error) {
+	totalWeight, err := t.validators.TotalWeight(t.subnetID)
+	if err != nil {
+		return nil, false, err
+	}
+	if totalWeight == 0 {
+		return nil, true, nil
+	}
+	quorumWeight := totalWeight/2 + 1
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var (
+		publishedWeight uint64
+		weights         = make(map[Checkpoint]uint64)
+	)
+	for nodeID, checkpoints := range t.published {
+		// Validators may have left the validator set since they published
+		// their checkpoints.
+		weight := t.validators.GetWeight(t.subnetID, nodeID)
+		publishedWeight += weight
+		for _, c := range checkpoints {
+			weights[c] += weight
+		}
+	}
+
+	var quorum []Checkpoint
+	for c, weight := range weights {
+		if weight >= quorumWeight {
+			quorum = append(quorum, c)
+		}
+	}
+	slices.SortFunc(quorum, func(a, b Checkpoint) int {
+		return cmp.Compare(b.Height, a.Height)
+	})
+	return quorum, publishedWeight >= quorumWeight, nil
+}
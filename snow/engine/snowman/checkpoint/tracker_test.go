@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+func TestTrackerQuorum(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		subnetID = ids.GenerateTestID()
+		chainID  = ids.GenerateTestID()
+		vdrs     = validators.NewManager()
+		tracker  = NewTracker(constants.UnitTestID, subnetID, chainID, vdrs)
+		nodeIDs  = make([]ids.NodeID, 3)
+		signers  = make([]warp.Signer, 3)
+	)
+	for i := range nodeIDs {
+		sk, err := localsigner.New()
+		require.NoError(err)
+
+		nodeIDs[i] = ids.GenerateTestNodeID()
+		signers[i] = warp.NewSigner(sk, constants.UnitTestID, chainID)
+		require.NoError(vdrs.AddStaker(subnetID, nodeIDs[i], sk.PublicKey(), ids.Empty, 1))
+	}
+	require.True(tracker.IsValidator(nodeIDs[0]))
+
+	var (
+		accepted = Checkpoint{
+			Height:  Interval,
+			BlockID: ids.GenerateTestID(),
+		}
+		conflicting = Checkpoint{
+			Height:  Interval,
+			BlockID: ids.GenerateTestID(),
+		}
+	)
+	publish := func(i int, c Checkpoint) {
+		msg, err := Sign(signers[i], constants.UnitTestID, chainID, []Checkpoint{c})
+		require.NoError(err)
+		require.NoError(tracker.Add(nodeIDs[i], msg))
+	}
+
+	checkpoints, published, err := tracker.Quorum()
+	require.NoError(err)
+	require.Empty(checkpoints)
+	require.False(published)
+
+	publish(0, accepted)
+	publish(1, conflicting)
+	checkpoints, published, err = tracker.Quorum()
+	require.NoError(err)
+	require.Empty(checkpoints)
+	require.True(published)
+
+	publish(2, accepted)
+	checkpoints, published, err = tracker.Quorum()
+	require.NoError(err)
+	require.Equal([]Checkpoint{accepted}, checkpoints)
+	require.True(published)
+
+	// The checkpoints of validators that left aren't counted.
+	require.NoError(vdrs.RemoveWeight(subnetID, nodeIDs[2], 1))
+	checkpoints, _, err = tracker.Quorum()
+	require.NoError(err)
+	require.Empty(checkpoints)
+
+	// Checkpoints signed by another key are dropped.
+	msg, err := Sign(signers[0], constants.UnitTestID, chainID, []Checkpoint{accepted})
+	require.NoError(err)
+	err = tracker.Add(nodeIDs[1], msg)
+	require.ErrorIs(err, errInvalidSignature)
+
+	err = tracker.Add(ids.GenerateTestNodeID(), msg)
+	require.ErrorIs(err, errNotValidator)
+}
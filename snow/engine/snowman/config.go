@@ -4,6 +4,8 @@
 package snowman
 
 import (
+	"time"
+
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -25,4 +27,8 @@ type Config struct {
 	Params              snowball.Parameters
 	Consensus           snowman.Consensus
 	PartialSync         bool
+
+	// BlockVerificationTimeout is the maximum duration of the verification of
+	// a block. If 0, the verification of a block isn't bounded.
+	BlockVerificationTimeout time.Duration
 }
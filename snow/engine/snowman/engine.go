@@ -5,7 +5,9 @@ package snowman
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -20,6 +22,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/ancestor"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/job"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/bag"
@@ -28,12 +31,20 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
 )
 
 const (
 	nonVerifiedCacheSize = 64 * units.MiB
 	errInsufficientStake = "insufficient connected stake"
+
+	failedVerificationCacheSize = units.MiB
+	// Verification may fail transiently, for example if the timestamp of a
+	// block is slightly ahead of the local clock, so failures are only
+	// remembered for a short time.
+	failedVerificationTTL = 2 * time.Second
 )
 
 var _ common.Engine = (*Engine)(nil)
@@ -42,6 +53,19 @@ func cachedBlockSize(_ ids.ID, blk snowman.Block) int {
 	return ids.IDLen + len(blk.Bytes()) + constants.PointerOverhead
 }
 
+// failedVerification is the result of a block verification that failed.
+type failedVerification struct {
+	err error
+	// Last accepted block when the verification failed. A block may pass
+	// verification once one of its ancestors is accepted.
+	lastAcceptedID ids.ID
+	expiry         time.Time
+}
+
+func cachedFailedVerificationSize(_ ids.ID, f failedVerification) int {
+	return 2*ids.IDLen + len(f.err.Error()) + 2*constants.PointerOverhead + wrappers.LongLen
+}
+
 // Engine implements the Engine interface by attempting to fetch all
 // Engine dependencies.
 type Engine struct {
@@ -80,6 +104,14 @@ type Engine struct {
 	// on the block or one of its ancestors returns an error.
 	unverifiedBlockCache cache.Cacher[ids.ID, snowman.Block]
 
+	// Block ID --> Failed verification
+	//
+	// A block is put into this cache if its verification failed, so that it
+	// isn't verified again each time it is pushed by a peer while its siblings
+	// are processing.
+	failedVerifications cache.Cacher[ids.ID, failedVerification]
+	clock               mockable.Clock
+
 	// acceptedFrontiers of the other validators of this chain
 	acceptedFrontiers tracker.Accepted
 
@@ -107,6 +139,18 @@ func New(config Config) (*Engine, error) {
 		return nil, err
 	}
 
+	failedVerifications, err := metercacher.New[ids.ID, failedVerification](
+		"failed_verification_cache",
+		config.Ctx.Registerer,
+		cache.NewSizedLRU[ids.ID, failedVerification](
+			failedVerificationCacheSize,
+			cachedFailedVerificationSize,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	acceptedFrontiers := tracker.NewAccepted()
 	config.Validators.RegisterSetCallbackListener(config.Ctx.SubnetID, acceptedFrontiers)
 
@@ -146,6 +190,7 @@ func New(config Config) (*Engine, error) {
 		pending:                     make(map[ids.ID]snowman.Block),
 		unverifiedIDToAncestor:      ancestor.NewTree(),
 		unverifiedBlockCache:        nonVerifiedCache,
+		failedVerifications:         failedVerifications,
 		acceptedFrontiers:           acceptedFrontiers,
 		blocked:                     job.NewScheduler[ids.ID](),
 		polls:                       polls,
@@ -1076,7 +1121,22 @@ func (e *Engine) addUnverifiedBlockToConsensus(
 	blkHeight := blk.Height()
 
 	// make sure this block is valid
-	if err := blk.Verify(ctx); err != nil {
+	if err := e.verify(ctx, blk); err != nil {
+		var timeoutErr *block.VerifyTimeoutError
+		if errors.As(err, &timeoutErr) {
+			// The block isn't known to be invalid, so it isn't tracked as
+			// unverified. It will be issued again if it is received again.
+			e.metrics.numVerifyTimeouts.Inc()
+			e.Ctx.Log.Warn("block verification timed out",
+				zap.Stringer("nodeID", nodeID),
+				zap.Stringer("blkID", blkID),
+				zap.Uint64("height", blkHeight),
+				zap.Duration("timeout", timeoutErr.Timeout),
+				zap.Error(timeoutErr.Err),
+			)
+			return false, nil
+		}
+
 		e.Ctx.Log.Debug("block verification failed",
 			zap.Stringer("nodeID", nodeID),
 			zap.Stringer("blkID", blkID),
@@ -1105,6 +1165,36 @@ func (e *Engine) addUnverifiedBlockToConsensus(
 	})
 }
 
+// verify verifies [blk], unless its verification recently failed and no block
+// was accepted since, in which case the previous failure is returned.
+//
+// Timeouts aren't remembered, as they don't imply that [blk] is invalid.
+func (e *Engine) verify(ctx context.Context, blk snowman.Block) error {
+	var (
+		blkID             = blk.ID()
+		lastAcceptedID, _ = e.Consensus.LastAccepted()
+		now               = e.clock.Time()
+	)
+	if f, ok := e.failedVerifications.Get(blkID); ok {
+		if f.lastAcceptedID == lastAcceptedID && now.Before(f.expiry) {
+			e.metrics.numVerificationsAvoided.Inc()
+			return f.err
+		}
+		e.failedVerifications.Evict(blkID)
+	}
+
+	err := block.Verify(ctx, blk, e.BlockVerificationTimeout)
+	var timeoutErr *block.VerifyTimeoutError
+	if err != nil && !errors.As(err, &timeoutErr) {
+		e.failedVerifications.Put(blkID, failedVerification{
+			err:            err,
+			lastAcceptedID: lastAcceptedID,
+			expiry:         now.Add(failedVerificationTTL),
+		})
+	}
+	return err
+}
+
 // getProcessingAncestor finds [initialVote]'s most recent ancestor that is
 // processing in consensus. If no ancestor could be found, false is returned.
 //
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/cache"
@@ -1218,6 +1219,54 @@ func TestEngineUndeclaredDependencyDeadlock(t *testing.T) {
 	require.Equal(snowtest.Accepted, validBlk.Status)
 }
 
+func TestEngineCachesFailedVerification(t *testing.T) {
+	require := require.New(t)
+
+	_, _, sender, vm, te := setup(t, DefaultConfig(t))
+
+	sender.Default(true)
+	sender.SendPushQueryF = func(context.Context, set.Set[ids.NodeID], uint32, []byte, uint64) {}
+	sender.SendPullQueryF = func(context.Context, set.Set[ids.NodeID], uint32, ids.ID, uint64) {}
+
+	blk := snowmantest.BuildChild(snowmantest.Genesis)
+	blk.VerifyV = errInvalid
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case snowmantest.GenesisID:
+			return snowmantest.Genesis, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+
+	issue := func() {
+		require.NoError(te.issue(
+			context.Background(),
+			te.Ctx.NodeID,
+			blk,
+			false,
+			te.metrics.issued.WithLabelValues(unknownSource),
+		))
+	}
+
+	issue()
+	require.False(te.Consensus.Processing(blk.ID()))
+	require.Zero(testutil.ToFloat64(te.metrics.numVerificationsAvoided))
+
+	// The failed verification is remembered, so the block isn't verified
+	// again even though it would now pass verification.
+	blk.VerifyV = nil
+	issue()
+	require.False(te.Consensus.Processing(blk.ID()))
+	require.Equal(float64(1), testutil.ToFloat64(te.metrics.numVerificationsAvoided))
+
+	// Once the failed verification expires, the block is verified again.
+	te.clock.Set(te.clock.Time().Add(failedVerificationTTL))
+	issue()
+	require.True(te.Consensus.Processing(blk.ID()))
+	require.Equal(float64(1), testutil.ToFloat64(te.metrics.numVerificationsAvoided))
+}
+
 func TestEngineGossip(t *testing.T) {
 	require := require.New(t)
 
@@ -1876,7 +1925,10 @@ func TestEngineBubbleVotesThroughInvalidBlock(t *testing.T) {
 	require.Equal(snowtest.Undecided, blk2.Status)
 
 	// Now that [blk1] has been marked as Accepted, [blk2] can pass verification.
+	// The failed verification of [blk2] must expire for the block to be
+	// verified again.
 	blk2.VerifyV = nil
+	te.clock.Set(te.clock.Time().Add(failedVerificationTTL))
 	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
 		switch blkID {
 		case snowmantest.GenesisID:
@@ -2119,6 +2171,10 @@ func TestEngineBuildBlockWithCachedNonVerifiedParent(t *testing.T) {
 	// When we fetch it using [GetBlockF] we get [parentBlkB].
 	// Note that [parentBlkB] doesn't fail verification and is issued into consensus.
 	// This evicts [parentBlkA] from [te.nonVerifiedCache].
+	//
+	// The failed verification of [parentBlkA] must expire for the block to be
+	// verified again.
+	te.clock.Set(te.clock.Time().Add(failedVerificationTTL))
 	require.NoError(te.Put(context.Background(), vdr, 0, parentBlkA.BytesV))
 
 	// Give 2 chits for [parentBlkA]/[parentBlkB]
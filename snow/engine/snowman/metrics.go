@@ -25,6 +25,8 @@ type metrics struct {
 	numNonVerifieds                       prometheus.Gauge
 	numBuilt                              prometheus.Counter
 	numBuildsFailed                       prometheus.Counter
+	numVerifyTimeouts                     prometheus.Counter
+	numVerificationsAvoided               prometheus.Counter
 	numUselessPutBytes                    prometheus.Counter
 	numUselessPushQueryBytes              prometheus.Counter
 	numMissingAcceptedBlocks              prometheus.Counter
@@ -69,6 +71,14 @@ func newMetrics(reg prometheus.Registerer) (*metrics, error) {
 			Name: "blk_builds_failed",
 			Help: "Number of BuildBlock calls that have failed",
 		}),
+		numVerifyTimeouts: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "blk_verify_timeouts",
+			Help: "Number of blocks whose verification timed out",
+		}),
+		numVerificationsAvoided: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "blk_verifications_avoided",
+			Help: "Number of block verifications that were avoided because the block recently failed verification",
+		}),
 		numUselessPutBytes: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "num_useless_put_bytes",
 			Help: "Amount of useless bytes received in Put messages",
@@ -135,6 +145,8 @@ func newMetrics(reg prometheus.Registerer) (*metrics, error) {
 		reg.Register(m.numNonVerifieds),
 		reg.Register(m.numBuilt),
 		reg.Register(m.numBuildsFailed),
+		reg.Register(m.numVerifyTimeouts),
+		reg.Register(m.numVerificationsAvoided),
 		reg.Register(m.numUselessPutBytes),
 		reg.Register(m.numUselessPushQueryBytes),
 		reg.Register(m.numMissingAcceptedBlocks),
@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replica
+
+import (
+	"context"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+// Source provides the accepted blocks of a chain, in the order they were
+// accepted by a trusted node.
+type Source interface {
+	// GetIndex returns the index of the accepted block [blkID].
+	GetIndex(ctx context.Context, blkID ids.ID) (uint64, error)
+	// GetLastAcceptedIndex returns the index of the most recently accepted
+	// block.
+	GetLastAcceptedIndex(ctx context.Context) (uint64, error)
+	// GetBlocks returns the bytes of up to [n] accepted blocks, starting with
+	// the block at index [startIndex].
+	GetBlocks(ctx context.Context, startIndex uint64, n int) ([][]byte, error)
+}
+
+// Config wraps all the parameters needed for a read replica engine
+type Config struct {
+	common.AllGetsServer
+
+	Ctx *snow.ConsensusContext
+	VM  block.ChainVM
+
+	// Sources are the trusted nodes whose accepted blocks are followed. If a
+	// source fails, the next source is used.
+	Sources []Source
+	// PollFrequency is how often the current source is asked for newly
+	// accepted blocks.
+	PollFrequency time.Duration
+
+	// BlockVerificationTimeout is the maximum duration of the verification of
+	// a block. If 0, the verification of a block isn't bounded.
+	BlockVerificationTimeout time.Duration
+}
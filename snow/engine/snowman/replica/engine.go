@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package replica implements an engine that follows the blocks accepted by
+// trusted nodes rather than running consensus.
+package replica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/validators"
+)
+
+// maxBlocksPerRequest is the maximum number of blocks requested from a source
+// at once.
+const maxBlocksPerRequest = 1024
+
+var (
+	_ common.Engine = (*Engine)(nil)
+
+	errNoSources         = errors.New("no read replica sources")
+	errUnexpectedParent  = errors.New("block doesn't extend the last accepted block")
+	errNoSourceAvailable = errors.New("couldn't fetch blocks from any read replica source")
+)
+
+// Engine accepts the blocks accepted by its sources, in the order they were
+// accepted, without issuing or answering consensus queries. Blocks are never
+// built.
+type Engine struct {
+	Config
+
+	// list of NoOpsHandler for messages dropped by engine
+	common.StateSummaryFrontierHandler
+	common.AcceptedStateSummaryHandler
+	common.AcceptedFrontierHandler
+	common.AcceptedHandler
+	common.AncestorsHandler
+	common.PutHandler
+	common.QueryHandler
+	common.ChitsHandler
+	common.AppHandler
+	validators.Connector
+
+	numReplicated prometheus.Counter
+
+	// onShutdownCtx is canceled when the engine is shut down, which stops
+	// following the sources.
+	onShutdownCtx       context.Context
+	onShutdownCtxCancel context.CancelFunc
+
+	// Must be held while accessing [sourceIndex], [lastPoll] and [pollErr]
+	lock sync.Mutex
+	// sourceIndex is the index of the source blocks are fetched from.
+	sourceIndex int
+	// lastPoll is the time that the sources were last polled successfully.
+	lastPoll time.Time
+	// pollErr is the error of the most recent poll, if it failed.
+	pollErr error
+}
+
+func New(config Config) (*Engine, error) {
+	if len(config.Sources) == 0 {
+		return nil, errNoSources
+	}
+
+	config.Ctx.Log.Info("initializing read replica engine",
+		zap.Int("numSources", len(config.Sources)),
+		zap.Duration("pollFrequency", config.PollFrequency),
+	)
+
+	numReplicated := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "replicated_blks",
+		Help: "Number of blocks accepted from the read replica sources",
+	})
+	if err := config.Ctx.Registerer.Register(numReplicated); err != nil {
+		return nil, err
+	}
+
+	onShutdownCtx, onShutdownCtxCancel := context.WithCancel(context.Background())
+	return &Engine{
+		Config:                      config,
+		StateSummaryFrontierHandler: common.NewNoOpStateSummaryFrontierHandler(config.Ctx.Log),
+		AcceptedStateSummaryHandler: common.NewNoOpAcceptedStateSummaryHandler(config.Ctx.Log),
+		AcceptedFrontierHandler:     common.NewNoOpAcceptedFrontierHandler(config.Ctx.Log),
+		AcceptedHandler:             common.NewNoOpAcceptedHandler(config.Ctx.Log),
+		AncestorsHandler:            common.NewNoOpAncestorsHandler(config.Ctx.Log),
+		PutHandler:                  common.NewNoOpPutHandler(config.Ctx.Log),
+		QueryHandler:                common.NewNoOpQueryHandler(config.Ctx.Log),
+		ChitsHandler:                common.NewNoOpChitsHandler(config.Ctx.Log),
+		AppHandler:                  config.VM,
+		Connector:                   config.VM,
+		numReplicated:               numReplicated,
+		onShutdownCtx:               onShutdownCtx,
+		onShutdownCtxCancel:         onShutdownCtxCancel,
+	}, nil
+}
+
+func (e *Engine) Start(ctx context.Context, _ uint32) error {
+	lastAcceptedID, err := e.VM.LastAccepted(ctx)
+	if err != nil {
+		return err
+	}
+	if err := e.VM.SetPreference(ctx, lastAcceptedID); err != nil {
+		return err
+	}
+
+	e.Ctx.Log.Info("starting read replica",
+		zap.Stringer("lastAcceptedID", lastAcceptedID),
+	)
+
+	e.Ctx.State.Set(snow.EngineState{
+		Type:  p2p.EngineType_ENGINE_TYPE_SNOWMAN,
+		State: snow.NormalOp,
+	})
+	if err := e.VM.SetState(ctx, snow.NormalOp); err != nil {
+		return fmt.Errorf("failed to notify VM that the read replica is starting: %w",
+			err)
+	}
+
+	go e.follow()
+	return nil
+}
+
+func (*Engine) Gossip(context.Context) error {
+	return nil
+}
+
+func (e *Engine) Shutdown(ctx context.Context) error {
+	e.Ctx.Log.Info("shutting down read replica engine")
+
+	e.onShutdownCtxCancel()
+
+	e.Ctx.Lock.Lock()
+	defer e.Ctx.Lock.Unlock()
+
+	return e.VM.Shutdown(ctx)
+}
+
+func (e *Engine) Notify(_ context.Context, msg common.Message) error {
+	switch msg {
+	case common.PendingTxs:
+		// Blocks are only accepted from the sources, so there is no need to
+		// build a block.
+		return nil
+	case common.StateSyncDone:
+		e.Ctx.StateSyncing.Set(false)
+		return nil
+	default:
+		e.Ctx.Log.Warn("received an unexpected message from the VM",
+			zap.Stringer("messageString", msg),
+		)
+		return nil
+	}
+}
+
+func (e *Engine) HealthCheck(ctx context.Context) (interface{}, error) {
+	e.lock.Lock()
+	var (
+		sourceIndex = e.sourceIndex
+		lastPoll    = e.lastPoll
+		pollErr     = e.pollErr
+	)
+	e.lock.Unlock()
+
+	e.Ctx.Lock.Lock()
+	defer e.Ctx.Lock.Unlock()
+
+	vmIntf, vmErr := e.VM.HealthCheck(ctx)
+	intf := map[string]interface{}{
+		"source":   sourceIndex,
+		"lastPoll": lastPoll,
+		"vm":       vmIntf,
+	}
+	if pollErr == nil {
+		return intf, vmErr
+	}
+	if vmErr == nil {
+		return intf, pollErr
+	}
+	return intf, fmt.Errorf("vm: %w ; replica: %w", vmErr, pollErr)
+}
+
+// follow polls the sources for newly accepted blocks until the engine is shut
+// down.
+func (e *Engine) follow() {
+	ticker := time.NewTicker(e.PollFrequency)
+	defer ticker.Stop()
+
+	for {
+		err := e.poll(e.onShutdownCtx)
+		if e.onShutdownCtx.Err() != nil {
+			return
+		}
+
+		e.lock.Lock()
+		e.pollErr = err
+		if err == nil {
+			e.lastPoll = time.Now()
+		}
+		e.lock.Unlock()
+
+		select {
+		case <-ticker.C:
+		case <-e.onShutdownCtx.Done():
+			return
+		}
+	}
+}
+
+// poll accepts the blocks accepted by the current source since the last
+// accepted block. If the current source fails, the remaining sources are tried
+// in order.
+func (e *Engine) poll(ctx context.Context) error {
+	e.lock.Lock()
+	sourceIndex := e.sourceIndex
+	e.lock.Unlock()
+
+	var errs []error
+	for range e.Sources {
+		err := e.replicate(ctx, e.Sources[sourceIndex])
+		if err == nil || ctx.Err() != nil {
+			return nil
+		}
+
+		e.Ctx.Log.Warn("failed to replicate blocks",
+			zap.Int("source", sourceIndex),
+			zap.Error(err),
+		)
+		errs = append(errs, err)
+
+		sourceIndex = (sourceIndex + 1) % len(e.Sources)
+		e.lock.Lock()
+		e.sourceIndex = sourceIndex
+		e.lock.Unlock()
+	}
+	return fmt.Errorf("%w: %w", errNoSourceAvailable, errors.Join(errs...))
+}
+
+// replicate accepts the blocks accepted by [source] since the last accepted
+// block, until the last accepted block of [source] is reached.
+func (e *Engine) replicate(ctx context.Context, source Source) error {
+	for {
+		e.Ctx.Lock.Lock()
+		lastAcceptedID, err := e.VM.LastAccepted(ctx)
+		e.Ctx.Lock.Unlock()
+		if err != nil {
+			return err
+		}
+
+		index, err := source.GetIndex(ctx, lastAcceptedID)
+		if err != nil {
+			return fmt.Errorf("couldn't get index of last accepted block %s: %w", lastAcceptedID, err)
+		}
+		lastIndex, err := source.GetLastAcceptedIndex(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't get last accepted index: %w", err)
+		}
+		if lastIndex <= index {
+			return nil
+		}
+
+		numToFetch := min(lastIndex-index, maxBlocksPerRequest)
+		blks, err := source.GetBlocks(ctx, index+1, int(numToFetch))
+		if err != nil {
+			return fmt.Errorf("couldn't get blocks starting at index %d: %w", index+1, err)
+		}
+		if err := e.accept(ctx, lastAcceptedID, blks); err != nil {
+			return err
+		}
+	}
+}
+
+// accept verifies and accepts [blks], which must extend [lastAcceptedID] in
+// order.
+func (e *Engine) accept(ctx context.Context, lastAcceptedID ids.ID, blks [][]byte) error {
+	e.Ctx.Lock.Lock()
+	defer e.Ctx.Lock.Unlock()
+
+	// The engine may have been shut down while the blocks were fetched.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, blkBytes := range blks {
+		blk, err := e.VM.ParseBlock(ctx, blkBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't parse block: %w", err)
+		}
+
+		blkID := blk.ID()
+		if parentID := blk.Parent(); parentID != lastAcceptedID {
+			return fmt.Errorf("%w: block %s has parent %s, expected %s",
+				errUnexpectedParent,
+				blkID,
+				parentID,
+				lastAcceptedID,
+			)
+		}
+		if err := block.Verify(ctx, blk, e.BlockVerificationTimeout); err != nil {
+			return fmt.Errorf("failed to verify block %s (height=%d): %w", blkID, blk.Height(), err)
+		}
+
+		// Note that BlockAcceptor.Accept must be called before blk.Accept to
+		// honor Acceptor.Accept's invariant.
+		if err := e.Ctx.BlockAcceptor.Accept(e.Ctx, blkID, blkBytes); err != nil {
+			return err
+		}
+		if err := blk.Accept(ctx); err != nil {
+			return err
+		}
+		e.numReplicated.Inc()
+		lastAcceptedID = blkID
+	}
+
+	e.Ctx.Log.Debug("replicated blocks",
+		zap.Int("numBlocks", len(blks)),
+		zap.Stringer("lastAcceptedID", lastAcceptedID),
+	)
+	return e.VM.SetPreference(ctx, lastAcceptedID)
+}
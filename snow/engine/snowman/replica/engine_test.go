@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package replica
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+)
+
+var (
+	_ Source = (*testSource)(nil)
+
+	errUnavailable  = errors.New("unavailable")
+	errUnknownBytes = errors.New("unknown bytes")
+)
+
+// testSource serves [blks], where the block at index i is blks[i].
+type testSource struct {
+	blks []*snowmantest.Block
+	err  error
+}
+
+func (s *testSource) GetIndex(_ context.Context, blkID ids.ID) (uint64, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	for i, blk := range s.blks {
+		if blk.ID() == blkID {
+			return uint64(i), nil
+		}
+	}
+	return 0, database.ErrNotFound
+}
+
+func (s *testSource) GetLastAcceptedIndex(context.Context) (uint64, error) {
+	return uint64(len(s.blks) - 1), s.err
+}
+
+func (s *testSource) GetBlocks(_ context.Context, startIndex uint64, n int) ([][]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	endIndex := min(startIndex+uint64(n), uint64(len(s.blks)))
+	blks := make([][]byte, 0, endIndex-startIndex)
+	for _, blk := range s.blks[startIndex:endIndex] {
+		blks = append(blks, blk.Bytes())
+	}
+	return blks, nil
+}
+
+func newTest(t *testing.T, chain []*snowmantest.Block, sources ...Source) *Engine {
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	vm := &blocktest.VM{}
+	vm.T = t
+	vm.Default(true)
+	vm.CantSetState = false
+	vm.LastAcceptedF = snowmantest.MakeLastAcceptedBlockF(chain)
+	vm.ParseBlockF = func(_ context.Context, blkBytes []byte) (snowman.Block, error) {
+		for _, blk := range chain {
+			if bytes.Equal(blk.Bytes(), blkBytes) {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBytes
+	}
+	vm.SetPreferenceF = func(context.Context, ids.ID) error {
+		return nil
+	}
+
+	engine, err := New(Config{
+		Ctx:           ctx,
+		VM:            vm,
+		Sources:       sources,
+		PollFrequency: time.Hour,
+	})
+	require.NoError(t, err)
+	return engine
+}
+
+func TestEnginePoll(t *testing.T) {
+	chain := snowmantest.BuildChain(maxBlocksPerRequest + 5)
+	otherChain := append(
+		[]*snowmantest.Block{snowmantest.Genesis},
+		snowmantest.BuildDescendants(snowmantest.Genesis, 2)...,
+	)
+
+	tests := []struct {
+		name                string
+		sources             []Source
+		expectedSourceIndex int
+		expectedAccepted    int
+		expectedErr         error
+	}{
+		{
+			name: "single source",
+			sources: []Source{
+				&testSource{blks: chain},
+			},
+			expectedAccepted: len(chain),
+		},
+		{
+			name: "source behind",
+			sources: []Source{
+				&testSource{blks: chain[:3]},
+			},
+			expectedAccepted: 3,
+		},
+		{
+			name: "fail over to next source",
+			sources: []Source{
+				&testSource{err: errUnavailable},
+				&testSource{blks: chain},
+			},
+			expectedSourceIndex: 1,
+			expectedAccepted:    len(chain),
+		},
+		{
+			name: "all sources unavailable",
+			sources: []Source{
+				&testSource{err: errUnavailable},
+				&testSource{err: errUnavailable},
+			},
+			expectedAccepted: 1,
+			expectedErr:      errUnavailable,
+		},
+		{
+			name: "diverged source",
+			sources: []Source{
+				&testSource{blks: append(chain[:2:2], otherChain[2])},
+			},
+			expectedAccepted: 2,
+			expectedErr:      errUnexpectedParent,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			// Reset the statuses of the blocks accepted by previous tests.
+			for _, blk := range append(chain[1:], otherChain[1:]...) {
+				blk.Status = snowtest.Undecided
+			}
+
+			engine := newTest(t, append(chain, otherChain[1:]...), test.sources...)
+			err := engine.poll(context.Background())
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				require.ErrorIs(err, errNoSourceAvailable)
+			}
+			require.Equal(test.expectedSourceIndex, engine.sourceIndex)
+
+			snowmantest.RequireStatusIs(require, snowtest.Accepted, chain[:test.expectedAccepted]...)
+			snowmantest.RequireStatusIs(require, snowtest.Undecided, chain[test.expectedAccepted:]...)
+			snowmantest.RequireStatusIs(require, snowtest.Undecided, otherChain[1:]...)
+		})
+	}
+}
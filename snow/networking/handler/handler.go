@@ -132,6 +132,7 @@ func New(
 	msgFromVMChan <-chan common.Message,
 	gossipFrequency time.Duration,
 	threadPoolSize int,
+	maxUnprocessedMsgs int,
 	resourceTracker tracker.ResourceTracker,
 	subnet subnets.Subnet,
 	peerTracker commontracker.Peers,
@@ -166,6 +167,7 @@ func New(
 		h.ctx.SubnetID,
 		h.validators,
 		cpuTracker,
+		maxUnprocessedMsgs,
 		"sync",
 		reg,
 	)
@@ -177,6 +179,7 @@ func New(
 		h.ctx.SubnetID,
 		h.validators,
 		cpuTracker,
+		maxUnprocessedMsgs,
 		"async",
 		reg,
 	)
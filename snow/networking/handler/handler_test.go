@@ -72,6 +72,7 @@ func TestHandlerDropsTimedOutMessages(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -179,6 +180,7 @@ func TestHandlerClosesOnError(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -282,6 +284,7 @@ func TestHandlerDropsGossipDuringBootstrapping(t *testing.T) {
 		nil,
 		1,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -373,6 +376,7 @@ func TestHandlerDispatchInternal(t *testing.T) {
 		msgFromVMChan,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -549,6 +553,7 @@ func TestDynamicEngineTypeDispatch(t *testing.T) {
 				nil,
 				time.Second,
 				testThreadPoolSize,
+				0,
 				resourceTracker,
 				subnets.New(ids.EmptyNodeID, subnets.Config{}),
 				commontracker.NewPeers(),
@@ -632,6 +637,7 @@ func TestHandlerStartError(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
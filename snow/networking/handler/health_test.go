@@ -88,6 +88,7 @@ func TestHealthCheckSubnet(t *testing.T) {
 				nil,
 				time.Second,
 				testThreadPoolSize,
+				0,
 				resourceTracker,
 				sb,
 				peerTracker,
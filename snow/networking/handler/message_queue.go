@@ -66,6 +66,9 @@ type messageQueue struct {
 	vdrs validators.Manager
 	// Tracks CPU utilization of each node
 	cpuTracker tracker.Tracker
+	// Once there are [maxSize] unprocessed messages, bulk messages are dropped.
+	// If 0, no messages are dropped.
+	maxSize int
 
 	cond   *sync.Cond
 	closed bool
@@ -80,6 +83,7 @@ func NewMessageQueue(
 	subnetID ids.ID,
 	vdrs validators.Manager,
 	cpuTracker tracker.Tracker,
+	maxSize int,
 	metricsNamespace string,
 	reg prometheus.Registerer,
 ) (MessageQueue, error) {
@@ -88,6 +92,7 @@ func NewMessageQueue(
 		subnetID:              subnetID,
 		vdrs:                  vdrs,
 		cpuTracker:            cpuTracker,
+		maxSize:               maxSize,
 		cond:                  sync.NewCond(&sync.Mutex{}),
 		nodeToUnprocessedMsgs: make(map[ids.NodeID]int),
 		msgAndCtxs:            buffer.NewUnboundedDeque[*msgAndContext](1 /*=initSize*/),
@@ -104,6 +109,26 @@ func (m *messageQueue) Push(ctx context.Context, msg Message) {
 		return
 	}
 
+	// If the queue is saturated, drop bulk messages so that consensus messages
+	// can still be handled in a timely manner.
+	if m.maxSize > 0 && m.msgAndCtxs.Len() >= m.maxSize && isBulk(msg.Op()) {
+		m.log.Debug("dropping message",
+			zap.String("reason", "queue saturated"),
+			zap.Stringer("nodeID", msg.NodeID()),
+			zap.Stringer("messageOp", msg.Op()),
+		)
+		m.metrics.dropped.With(prometheus.Labels{
+			opLabel: msg.Op().String(),
+		}).Inc()
+
+		msg.OnFinishedHandling()
+		var ok bool
+		msg, ok = failedResponse(msg)
+		if !ok {
+			return
+		}
+	}
+
 	// Add the message to the queue
 	m.msgAndCtxs.PushRight(&msgAndContext{
 		msg: msg,
@@ -242,6 +267,40 @@ func (m *messageQueue) canPop(msg message.InboundMessage) bool {
 	return recentCPUUsage <= maxCPU
 }
 
+// isBulk returns true if messages with [op] can be large or numerous and can be
+// dropped without impacting the liveness of consensus.
+func isBulk(op message.Op) bool {
+	switch op {
+	case message.GetAncestorsOp, message.AncestorsOp, message.AppGossipOp:
+		return true
+	default:
+		return false
+	}
+}
+
+// failedResponse returns the message that must be handled instead of [msg] if
+// [msg] is a response that was dropped. This ensures that the engine doesn't
+// wait for a response that will never be handled.
+func failedResponse(msg Message) (Message, bool) {
+	ancestors, ok := msg.Message().(*p2p.Ancestors)
+	if !ok {
+		return Message{}, false
+	}
+	chainID, err := ids.ToID(ancestors.ChainId)
+	if err != nil {
+		return Message{}, false
+	}
+	return Message{
+		InboundMessage: message.InternalGetAncestorsFailed(
+			msg.NodeID(),
+			chainID,
+			ancestors.RequestId,
+			msg.EngineType,
+		),
+		EngineType: msg.EngineType,
+	}, true
+}
+
 type msgAndContext struct {
 	msg Message
 	ctx context.Context
@@ -19,6 +19,7 @@ type messageQueueMetrics struct {
 	count             *prometheus.GaugeVec
 	nodesWithMessages prometheus.Gauge
 	numExcessiveCPU   prometheus.Counter
+	dropped           *prometheus.CounterVec
 }
 
 func (m *messageQueueMetrics) initialize(
@@ -44,10 +45,19 @@ func (m *messageQueueMetrics) initialize(
 		Name:      "excessive_cpu",
 		Help:      "times a message has been deferred due to excessive CPU usage",
 	})
+	m.dropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "dropped",
+			Help:      "messages dropped because the queue was saturated",
+		},
+		opLabels,
+	)
 
 	return errors.Join(
 		metricsRegisterer.Register(m.count),
 		metricsRegisterer.Register(m.nodesWithMessages),
 		metricsRegisterer.Register(m.numExcessiveCPU),
+		metricsRegisterer.Register(m.dropped),
 	)
 }
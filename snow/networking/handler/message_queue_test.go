@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -34,6 +35,7 @@ func TestQueue(t *testing.T) {
 		constants.PrimaryNetworkID,
 		vdrs,
 		cpuTracker,
+		0,
 		"",
 		prometheus.NewRegistry(),
 	)
@@ -168,3 +170,76 @@ func TestQueue(t *testing.T) {
 	require.Equal(msg3, gotMsg3)
 	require.Zero(u.Len())
 }
+
+func TestQueueDropsBulkMessagesWhenSaturated(t *testing.T) {
+	require := require.New(t)
+
+	cpuTracker := trackermock.NewTracker(gomock.NewController(t))
+	cpuTracker.EXPECT().Usage(gomock.Any(), gomock.Any()).Return(0.0).AnyTimes()
+	reg := prometheus.NewRegistry()
+	mIntf, err := NewMessageQueue(
+		logging.NoLog{},
+		constants.PrimaryNetworkID,
+		validators.NewManager(),
+		cpuTracker,
+		2,
+		"",
+		reg,
+	)
+	require.NoError(err)
+	u := mIntf.(*messageQueue)
+
+	var (
+		chainID   = ids.GenerateTestID()
+		nodeID    = ids.GenerateTestNodeID()
+		requestID = uint32(1)
+	)
+	newMessage := func(msg message.InboundMessage) Message {
+		return Message{
+			InboundMessage: msg,
+			EngineType:     p2p.EngineType_ENGINE_TYPE_SNOWMAN,
+		}
+	}
+	gossip := newMessage(message.InboundAppGossip(chainID, []byte{1}, nodeID))
+	chits := newMessage(message.InboundChits(chainID, requestID, ids.Empty, ids.Empty, ids.Empty, nodeID))
+	ancestors := newMessage(message.InboundAncestors(chainID, requestID, [][]byte{{1}}, nodeID))
+
+	// Bulk messages are queued until the queue is saturated.
+	u.Push(context.Background(), gossip)
+	u.Push(context.Background(), gossip)
+	require.Equal(2, u.Len())
+
+	// Once saturated, bulk messages are dropped and consensus messages are
+	// still queued.
+	u.Push(context.Background(), gossip)
+	require.Equal(2, u.Len())
+	u.Push(context.Background(), chits)
+	require.Equal(3, u.Len())
+
+	// Dropped responses are replaced by the corresponding failure.
+	u.Push(context.Background(), ancestors)
+	require.Equal(4, u.Len())
+	require.Equal(1.0, testutil.ToFloat64(u.metrics.dropped.WithLabelValues(message.AppGossipOp.String())))
+	require.Equal(1.0, testutil.ToFloat64(u.metrics.dropped.WithLabelValues(message.AncestorsOp.String())))
+
+	for _, expected := range []Message{gossip, gossip, chits} {
+		_, msg, ok := u.Pop()
+		require.True(ok)
+		require.Equal(expected, msg)
+	}
+
+	_, msg, ok := u.Pop()
+	require.True(ok)
+	require.Equal(message.GetAncestorsFailedOp, msg.Op())
+	require.Equal(nodeID, msg.NodeID())
+	require.Equal(p2p.EngineType_ENGINE_TYPE_SNOWMAN, msg.EngineType)
+	require.Equal(
+		&message.GetAncestorsFailed{
+			ChainID:    chainID,
+			RequestID:  requestID,
+			EngineType: p2p.EngineType_ENGINE_TYPE_SNOWMAN,
+		},
+		msg.Message(),
+	)
+	require.Zero(u.Len())
+}
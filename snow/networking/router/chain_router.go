@@ -404,7 +404,7 @@ func (cr *ChainRouter) AddChain(ctx context.Context, chain handler.Handler) {
 		zap.Stringer("chainID", chainID),
 	)
 	chain.SetOnStopped(func() {
-		cr.removeChain(ctx, chainID)
+		cr.removeChain(ctx, chain)
 	})
 	cr.chainHandlers[chainID] = chain
 
@@ -646,11 +646,13 @@ func (cr *ChainRouter) HealthCheck(context.Context) (interface{}, error) {
 }
 
 // RemoveChain removes the specified chain so that incoming
-// messages can't be routed to it
-func (cr *ChainRouter) removeChain(ctx context.Context, chainID ids.ID) {
+// messages can't be routed to it. The chain isn't removed if it was replaced
+// by a newer handler, such as when the chain is restarted.
+func (cr *ChainRouter) removeChain(ctx context.Context, chain handler.Handler) {
+	chainID := chain.Context().ChainID
+
 	cr.lock.Lock()
-	chain, exists := cr.chainHandlers[chainID]
-	if !exists {
+	if current, exists := cr.chainHandlers[chainID]; !exists || current != chain {
 		cr.log.Debug("can't remove unknown chain",
 			zap.Stringer("chainID", chainID),
 		)
@@ -109,6 +109,7 @@ func TestShutdown(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(chainCtx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -235,6 +236,7 @@ func TestConnectedAfterShutdownErrorLogRegression(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(chainCtx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -368,6 +370,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -537,6 +540,7 @@ func TestRouterTimeout(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -1069,6 +1073,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		sb,
 		commontracker.NewPeers(),
@@ -1235,6 +1240,7 @@ func TestValidatorOnlyAllowedNodeMessageDrops(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		sb,
 		commontracker.NewPeers(),
@@ -1487,6 +1493,7 @@ func newChainRouterTest(t *testing.T) (*ChainRouter, *enginetest.Engine) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
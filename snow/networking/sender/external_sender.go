@@ -11,6 +11,51 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
+// SendFailure is the reason a message wasn't sent to a peer.
+type SendFailure uint8
+
+const (
+	// NotConnected means that the node isn't connected to the peer.
+	NotConnected SendFailure = iota + 1
+	// NotAllowed means that the peer isn't allowed to receive messages of the
+	// subnet.
+	NotAllowed
+	// QueueFull means that the message was dropped by the peer's outbound
+	// message queue, either because it was full or because the peer is
+	// disconnecting.
+	QueueFull
+	// MessageTooLarge means that the message exceeds the maximum message size.
+	MessageTooLarge
+	// Benched means that the peer was benched for being unresponsive.
+	Benched
+)
+
+func (f SendFailure) String() string {
+	switch f {
+	case NotConnected:
+		return "not connected"
+	case NotAllowed:
+		return "not allowed"
+	case QueueFull:
+		return "queue full"
+	case MessageTooLarge:
+		return "message too large"
+	case Benched:
+		return "benched"
+	default:
+		return "unknown"
+	}
+}
+
+// SendResult is the result of sending a message.
+type SendResult struct {
+	// SentTo contains the peers the message was sent to.
+	SentTo set.Set[ids.NodeID]
+	// Failed contains the reason the message wasn't sent to each of the
+	// explicitly requested peers that it wasn't sent to.
+	Failed map[ids.NodeID]SendFailure
+}
+
 // ExternalSender sends consensus messages to other validators
 // Right now this is implemented in the networking package
 type ExternalSender interface {
@@ -21,3 +66,35 @@ type ExternalSender interface {
 		allower subnets.Allower,
 	) set.Set[ids.NodeID]
 }
+
+// ResultSender is an ExternalSender that can also report why a message wasn't
+// sent to each of the peers it was explicitly sent to.
+type ResultSender interface {
+	ExternalSender
+
+	// SendWithResult is like Send but additionally returns the reason the
+	// message wasn't sent to each of [config.NodeIDs] it wasn't sent to.
+	SendWithResult(
+		msg message.OutboundMessage,
+		config common.SendConfig,
+		subnetID ids.ID,
+		allower subnets.Allower,
+	) SendResult
+}
+
+// sendWithResult sends [msg] with [sender]. If [sender] is a ResultSender, the
+// reasons the message wasn't sent are included in the result.
+func sendWithResult(
+	sender ExternalSender,
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	subnetID ids.ID,
+	allower subnets.Allower,
+) SendResult {
+	if resultSender, ok := sender.(ResultSender); ok {
+		return resultSender.SendWithResult(msg, config, subnetID, allower)
+	}
+	return SendResult{
+		SentTo: sender.Send(msg, config, subnetID, allower),
+	}
+}
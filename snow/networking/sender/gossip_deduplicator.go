@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sender
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+var _ ResultSender = (*gossipDeduplicator)(nil)
+
+type gossipKey struct {
+	nodeID      ids.NodeID
+	containerID ids.ID
+}
+
+// gossipDeduplicator wraps an ExternalSender to avoid gossiping the same
+// container to the same peer more than once within a window.
+type gossipDeduplicator struct {
+	sender ExternalSender
+	window time.Duration
+	clock  mockable.Clock
+
+	// Key: Peer and container that were gossiped
+	// Value: The last time the container was gossiped to the peer
+	recentlyGossiped *cache.LRU[gossipKey, time.Time]
+
+	// Counts how many times a container wasn't gossiped to a peer because it
+	// was recently gossiped to the peer
+	suppressed prometheus.Counter
+}
+
+// NewGossipDeduplicator returns an ExternalSender that doesn't send an
+// AppGossip message to a peer that was sent the same message within [window].
+// The containerID of a message is the hash of its bytes, which include the ID
+// of the chain.
+//
+// Only the peers that are explicitly specified in the send config are
+// filtered. Because peers are sampled by [sender], sampled peers may receive
+// the same message repeatedly.
+//
+// The returned sender is intended to be shared by all the chains of a subnet.
+// The last [size] peer and container pairs that were gossiped are remembered.
+func NewGossipDeduplicator(
+	sender ExternalSender,
+	window time.Duration,
+	size int,
+	reg prometheus.Registerer,
+) (ResultSender, error) {
+	d := &gossipDeduplicator{
+		sender:           sender,
+		window:           window,
+		recentlyGossiped: &cache.LRU[gossipKey, time.Time]{Size: size},
+		suppressed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "gossip_suppressed",
+			Help: "number of times a container wasn't gossiped to a peer because it was recently gossiped to the peer",
+		}),
+	}
+	return d, reg.Register(d.suppressed)
+}
+
+func (d *gossipDeduplicator) Send(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	subnetID ids.ID,
+	allower subnets.Allower,
+) set.Set[ids.NodeID] {
+	return d.SendWithResult(msg, config, subnetID, allower).SentTo
+}
+
+func (d *gossipDeduplicator) SendWithResult(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	subnetID ids.ID,
+	allower subnets.Allower,
+) SendResult {
+	if msg.Op() != message.AppGossipOp {
+		return sendWithResult(d.sender, msg, config, subnetID, allower)
+	}
+
+	var (
+		containerID   = ids.ID(hashing.ComputeHash256Array(msg.Bytes()))
+		now           = d.clock.Time()
+		nodeIDs       = set.NewSet[ids.NodeID](config.NodeIDs.Len())
+		numSuppressed int
+	)
+	for nodeID := range config.NodeIDs {
+		lastGossiped, ok := d.recentlyGossiped.Get(gossipKey{
+			nodeID:      nodeID,
+			containerID: containerID,
+		})
+		if ok && now.Sub(lastGossiped) < d.window {
+			numSuppressed++
+			continue
+		}
+		nodeIDs.Add(nodeID)
+	}
+	d.suppressed.Add(float64(numSuppressed))
+
+	config.NodeIDs = nodeIDs
+	result := sendWithResult(d.sender, msg, config, subnetID, allower)
+	for nodeID := range result.SentTo {
+		d.recentlyGossiped.Put(
+			gossipKey{
+				nodeID:      nodeID,
+				containerID: containerID,
+			},
+			now,
+		)
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sender
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var _ ExternalSender = sendFunc(nil)
+
+// sendFunc is an ExternalSender that sends messages by calling itself.
+type sendFunc func(msg message.OutboundMessage, config common.SendConfig) set.Set[ids.NodeID]
+
+func (f sendFunc) Send(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	_ ids.ID,
+	_ subnets.Allower,
+) set.Set[ids.NodeID] {
+	return f(msg, config)
+}
+
+func TestGossipDeduplicator(t *testing.T) {
+	require := require.New(t)
+
+	mc, err := message.NewCreator(
+		logging.NoLog{},
+		prometheus.NewRegistry(),
+		constants.DefaultNetworkCompressionType,
+		10*time.Second,
+	)
+	require.NoError(err)
+
+	var (
+		chainID = ids.GenerateTestID()
+		nodeID0 = ids.GenerateTestNodeID()
+		nodeID1 = ids.GenerateTestNodeID()
+		nodeID2 = ids.GenerateTestNodeID()
+	)
+	gossip, err := mc.AppGossip(chainID, []byte("container"))
+	require.NoError(err)
+	otherGossip, err := mc.AppGossip(chainID, []byte("other container"))
+	require.NoError(err)
+	request, err := mc.AppRequest(chainID, 1, time.Second, []byte("request"))
+	require.NoError(err)
+
+	// The wrapped sender sends the message to all the requested peers.
+	var sentTo set.Set[ids.NodeID]
+	external := sendFunc(func(_ message.OutboundMessage, config common.SendConfig) set.Set[ids.NodeID] {
+		sentTo = config.NodeIDs
+		return config.NodeIDs
+	})
+
+	const window = time.Minute
+	reg := prometheus.NewRegistry()
+	resultSender, err := NewGossipDeduplicator(external, window, 10, reg)
+	require.NoError(err)
+	d := resultSender.(*gossipDeduplicator)
+	now := time.Now()
+	d.clock.Set(now)
+
+	send := func(msg message.OutboundMessage, nodeIDs ...ids.NodeID) set.Set[ids.NodeID] {
+		return d.Send(
+			msg,
+			common.SendConfig{
+				NodeIDs: set.Of(nodeIDs...),
+			},
+			constants.PrimaryNetworkID,
+			subnets.NoOpAllower,
+		)
+	}
+
+	require.Equal(set.Of(nodeID0, nodeID1), send(gossip, nodeID0, nodeID1))
+	require.Equal(set.Of(nodeID0, nodeID1), sentTo)
+
+	// The container was recently gossiped to nodeID0 and nodeID1.
+	require.Equal(set.Of(nodeID2), send(gossip, nodeID0, nodeID1, nodeID2))
+	require.Equal(set.Of(nodeID2), sentTo)
+	require.Equal(2.0, testutil.ToFloat64(d.suppressed))
+
+	// Other containers are gossiped as usual.
+	require.Equal(set.Of(nodeID0), send(otherGossip, nodeID0))
+
+	// Requests are never suppressed.
+	require.Equal(set.Of(nodeID0), send(request, nodeID0))
+	require.Equal(set.Of(nodeID0), send(request, nodeID0))
+	require.Equal(2.0, testutil.ToFloat64(d.suppressed))
+
+	// The container can be gossiped again once the window has passed.
+	d.clock.Set(now.Add(window))
+	require.Equal(set.Of(nodeID0, nodeID1, nodeID2), send(gossip, nodeID0, nodeID1, nodeID2))
+	require.Equal(2.0, testutil.ToFloat64(d.suppressed))
+}
@@ -1267,8 +1267,8 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 				nodeID,
 				s.ctx.ChainID,
 				requestID,
-				common.ErrTimeout.Code,
-				common.ErrTimeout.Message,
+				common.ErrNotSent.Code,
+				notSentMessage(Benched),
 			)
 			go s.router.HandleInbound(ctx, inMsg)
 		}
@@ -1283,16 +1283,14 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 	)
 
 	// Send the message over the network.
-	// [sentTo] are the IDs of nodes who may receive the message.
-	var sentTo set.Set[ids.NodeID]
+	// [result.SentTo] are the IDs of nodes who may receive the message.
+	var result SendResult
 	if err == nil {
-		sentTo = s.sender.Send(
+		result = s.send(
 			outMsg,
 			common.SendConfig{
 				NodeIDs: nodeIDs,
 			},
-			s.ctx.SubnetID,
-			s.subnet,
 		)
 	} else {
 		s.ctx.Log.Error("failed to build message",
@@ -1305,13 +1303,15 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 	}
 
 	for nodeID := range nodeIDs {
-		if !sentTo.Contains(nodeID) {
+		if !result.SentTo.Contains(nodeID) {
+			reason := result.Failed[nodeID]
 			if s.ctx.Log.Enabled(logging.Verbo) {
 				s.ctx.Log.Verbo("failed to send message",
 					zap.Stringer("messageOp", message.AppRequestOp),
 					zap.Stringer("nodeID", nodeID),
 					zap.Stringer("chainID", s.ctx.ChainID),
 					zap.Uint32("requestID", requestID),
+					zap.Stringer("reason", reason),
 					zap.Binary("payload", appRequestBytes),
 				)
 			} else {
@@ -1320,6 +1320,7 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 					zap.Stringer("nodeID", nodeID),
 					zap.Stringer("chainID", s.ctx.ChainID),
 					zap.Uint32("requestID", requestID),
+					zap.Stringer("reason", reason),
 				)
 			}
 
@@ -1329,8 +1330,8 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 				nodeID,
 				s.ctx.ChainID,
 				requestID,
-				common.ErrTimeout.Code,
-				common.ErrTimeout.Message,
+				common.ErrNotSent.Code,
+				notSentMessage(reason),
 			)
 			go s.router.HandleInbound(ctx, inMsg)
 		}
@@ -1338,6 +1339,23 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 	return nil
 }
 
+// send sends [msg] over the network. If the external sender reports why the
+// message wasn't sent to some of [config.NodeIDs], the reasons are included in
+// the result.
+func (s *sender) send(msg message.OutboundMessage, config common.SendConfig) SendResult {
+	return sendWithResult(s.sender, msg, config, s.ctx.SubnetID, s.subnet)
+}
+
+// notSentMessage returns the message of the AppError reported for a request
+// that wasn't sent because of [reason]. If the reason isn't known, [reason]
+// is 0.
+func notSentMessage(reason SendFailure) string {
+	if reason == 0 {
+		return common.ErrNotSent.Message
+	}
+	return common.ErrNotSent.Message + ": " + reason.String()
+}
+
 func (s *sender) SendAppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, appResponseBytes []byte) error {
 	ctx = context.WithoutCancel(ctx)
 
@@ -135,6 +135,7 @@ func TestTimeout(t *testing.T) {
 		nil,
 		time.Hour,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -394,6 +395,7 @@ func TestReliableMessages(t *testing.T) {
 		nil,
 		1,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -551,6 +553,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		nil,
 		time.Second,
 		testThreadPoolSize,
+		0,
 		resourceTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
@@ -1337,3 +1340,98 @@ func TestSender_Single_Request(t *testing.T) {
 		})
 	}
 }
+
+func TestSendAppRequestNotSent(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	var (
+		benchedNodeID      = ids.GenerateTestNodeID()
+		notConnectedNodeID = ids.GenerateTestNodeID()
+		sentNodeID         = ids.GenerateTestNodeID()
+		requestID          = uint32(1337)
+		deadline           = time.Second
+	)
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	var (
+		msgCreator     = messagemock.NewOutboundMsgBuilder(ctrl)
+		timeoutManager = timeoutmock.NewManager(ctrl)
+		router         = routermock.NewRouter(ctrl)
+		externalSender = &sendertest.External{
+			SendWithResultF: func(_ message.OutboundMessage, config common.SendConfig, _ ids.ID, _ subnets.Allower) SendResult {
+				require.Equal(set.Of(notConnectedNodeID, sentNodeID), config.NodeIDs)
+				return SendResult{
+					SentTo: set.Of(sentNodeID),
+					Failed: map[ids.NodeID]SendFailure{
+						notConnectedNodeID: NotConnected,
+					},
+				}
+			},
+		}
+	)
+
+	sender, err := New(
+		ctx,
+		msgCreator,
+		externalSender,
+		router,
+		timeoutManager,
+		p2ppb.EngineType_ENGINE_TYPE_SNOWMAN,
+		subnets.New(ctx.NodeID, subnets.Config{}),
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+
+	timeoutManager.EXPECT().TimeoutDuration().Return(deadline).AnyTimes()
+	timeoutManager.EXPECT().IsBenched(benchedNodeID, ctx.ChainID).Return(true)
+	timeoutManager.EXPECT().IsBenched(notConnectedNodeID, ctx.ChainID).Return(false)
+	timeoutManager.EXPECT().IsBenched(sentNodeID, ctx.ChainID).Return(false)
+	timeoutManager.EXPECT().RegisterRequestToUnreachableValidator().Times(2)
+	router.EXPECT().RegisterRequest(
+		gomock.Any(),
+		gomock.Any(),
+		ctx.ChainID,
+		requestID,
+		message.AppResponseOp,
+		gomock.Any(),
+		p2ppb.EngineType_ENGINE_TYPE_UNSPECIFIED,
+	).Times(3)
+	msgCreator.EXPECT().AppRequest(ctx.ChainID, requestID, deadline, gomock.Any()).Return(nil, nil)
+
+	// The failures are delivered asynchronously.
+	var (
+		lock     sync.Mutex
+		wg       sync.WaitGroup
+		failures = make(map[ids.NodeID]*p2ppb.AppError)
+	)
+	wg.Add(2)
+	router.EXPECT().HandleInbound(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, msg message.InboundMessage) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			failures[msg.NodeID()] = msg.Message().(*p2ppb.AppError)
+			wg.Done()
+		},
+	).Times(2)
+
+	require.NoError(sender.SendAppRequest(
+		context.Background(),
+		set.Of(benchedNodeID, notConnectedNodeID, sentNodeID),
+		requestID,
+		nil,
+	))
+	wg.Wait()
+
+	require.Len(failures, 2)
+	for nodeID, expectedMessage := range map[ids.NodeID]string{
+		benchedNodeID:      "not sent: benched",
+		notConnectedNodeID: "not sent: not connected",
+	} {
+		failure := failures[nodeID]
+		require.Equal(common.ErrNotSent.Code, failure.ErrorCode)
+		require.Equal(expectedMessage, failure.ErrorMessage)
+	}
+}
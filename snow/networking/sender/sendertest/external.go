@@ -16,7 +16,7 @@ import (
 )
 
 var (
-	_ sender.ExternalSender = (*External)(nil)
+	_ sender.ResultSender = (*External)(nil)
 
 	errSend = errors.New("unexpectedly called Send")
 )
@@ -27,7 +27,8 @@ type External struct {
 
 	CantSend bool
 
-	SendF func(msg message.OutboundMessage, config common.SendConfig, subnetID ids.ID, allower subnets.Allower) set.Set[ids.NodeID]
+	SendF           func(msg message.OutboundMessage, config common.SendConfig, subnetID ids.ID, allower subnets.Allower) set.Set[ids.NodeID]
+	SendWithResultF func(msg message.OutboundMessage, config common.SendConfig, subnetID ids.ID, allower subnets.Allower) sender.SendResult
 }
 
 // Default set the default callable value to [cant]
@@ -52,3 +53,19 @@ func (s *External) Send(
 	}
 	return nil
 }
+
+// SendWithResult calls SendWithResultF if it is set. Otherwise, the message is
+// sent with Send and no failure reasons are reported.
+func (s *External) SendWithResult(
+	msg message.OutboundMessage,
+	config common.SendConfig,
+	subnetID ids.ID,
+	allower subnets.Allower,
+) sender.SendResult {
+	if s.SendWithResultF != nil {
+		return s.SendWithResultF(msg, config, subnetID, allower)
+	}
+	return sender.SendResult{
+		SentTo: s.Send(msg, config, subnetID, allower),
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+const (
+	// MaxMaintenanceWindowDuration is the longest maintenance window that a
+	// validator may announce.
+	MaxMaintenanceWindowDuration = 24 * time.Hour
+	// MaxMaintenanceWindowLeadTime is how far in the future a maintenance
+	// window may start when it is announced.
+	MaxMaintenanceWindowLeadTime = 24 * 7 * 2 * time.Hour
+)
+
+var (
+	ErrInvalidMaintenanceWindow     = errors.New("maintenance window must end after it starts")
+	ErrMaintenanceWindowTooLong     = errors.New("maintenance window is too long")
+	ErrMaintenanceWindowAlreadyOver = errors.New("maintenance window has already ended")
+	ErrMaintenanceWindowTooFar      = errors.New("maintenance window starts too far in the future")
+)
+
+// MaintenanceWindow is a period of time during which a validator has announced
+// that it will be offline for planned maintenance.
+type MaintenanceWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Verify returns an error if the window is empty, inverted or longer than
+// [MaxMaintenanceWindowDuration].
+func (w MaintenanceWindow) Verify() error {
+	duration := w.End.Sub(w.Start)
+	switch {
+	case duration <= 0:
+		return ErrInvalidMaintenanceWindow
+	case duration > MaxMaintenanceWindowDuration:
+		return fmt.Errorf("%w: %s > %s", ErrMaintenanceWindowTooLong, duration, MaxMaintenanceWindowDuration)
+	default:
+		return nil
+	}
+}
+
+// VerifyStart returns an error if, at [now], the window has already ended or
+// starts more than [MaxMaintenanceWindowLeadTime] in the future.
+func (w MaintenanceWindow) VerifyStart(now time.Time) error {
+	if !now.Before(w.End) {
+		return ErrMaintenanceWindowAlreadyOver
+	}
+	if maxStart := now.Add(MaxMaintenanceWindowLeadTime); w.Start.After(maxStart) {
+		return fmt.Errorf("%w: %s > %s", ErrMaintenanceWindowTooFar, w.Start, maxStart)
+	}
+	return nil
+}
+
+// MaintenanceTracker records the maintenance windows announced by validators.
+type MaintenanceTracker interface {
+	// RecordMaintenance records that [nodeID] announced [window], replacing
+	// any window previously announced by [nodeID].
+	RecordMaintenance(nodeID ids.NodeID, window MaintenanceWindow) error
+	// GetMaintenance returns the window announced by [nodeID], if it has not
+	// yet ended.
+	GetMaintenance(nodeID ids.NodeID) (MaintenanceWindow, bool)
+}
+
+func (m *manager) RecordMaintenance(nodeID ids.NodeID, window MaintenanceWindow) error {
+	if err := window.Verify(); err != nil {
+		return err
+	}
+	now := m.clock.Time()
+	if err := window.VerifyStart(now); err != nil {
+		return err
+	}
+
+	// Remove windows that have ended to bound memory usage.
+	for otherNodeID, otherWindow := range m.maintenance {
+		if !now.Before(otherWindow.End) {
+			delete(m.maintenance, otherNodeID)
+		}
+	}
+
+	m.maintenance[nodeID] = window
+	return nil
+}
+
+func (m *manager) GetMaintenance(nodeID ids.NodeID) (MaintenanceWindow, bool) {
+	window, ok := m.maintenance[nodeID]
+	if !ok || !m.clock.Time().Before(window.End) {
+		return MaintenanceWindow{}, false
+	}
+	return window, true
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+func TestMaintenanceWindowVerify(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	tests := []struct {
+		name        string
+		window      MaintenanceWindow
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start.Add(time.Hour),
+			},
+		},
+		{
+			name: "maximum duration",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start.Add(MaxMaintenanceWindowDuration),
+			},
+		},
+		{
+			name: "empty",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start,
+			},
+			expectedErr: ErrInvalidMaintenanceWindow,
+		},
+		{
+			name: "inverted",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start.Add(-time.Second),
+			},
+			expectedErr: ErrInvalidMaintenanceWindow,
+		},
+		{
+			name: "too long",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start.Add(MaxMaintenanceWindowDuration + time.Second),
+			},
+			expectedErr: ErrMaintenanceWindowTooLong,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.window.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestRecordMaintenance(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+	currentTime := time.Now()
+
+	clk := mockable.Clock{}
+	clk.Set(currentTime)
+	up := NewManager(NewTestState(), &clk)
+
+	_, ok := up.GetMaintenance(nodeID0)
+	require.False(ok)
+
+	expiredWindow := MaintenanceWindow{
+		Start: currentTime.Add(-2 * time.Hour),
+		End:   currentTime.Add(-time.Hour),
+	}
+	err := up.RecordMaintenance(nodeID0, expiredWindow)
+	require.ErrorIs(err, ErrMaintenanceWindowAlreadyOver)
+
+	farWindow := MaintenanceWindow{
+		Start: currentTime.Add(MaxMaintenanceWindowLeadTime + time.Second),
+		End:   currentTime.Add(MaxMaintenanceWindowLeadTime + time.Hour),
+	}
+	err = up.RecordMaintenance(nodeID0, farWindow)
+	require.ErrorIs(err, ErrMaintenanceWindowTooFar)
+
+	latestWindow := MaintenanceWindow{
+		Start: currentTime.Add(MaxMaintenanceWindowLeadTime),
+		End:   currentTime.Add(MaxMaintenanceWindowLeadTime + time.Hour),
+	}
+	require.NoError(up.RecordMaintenance(nodeID0, latestWindow))
+
+	window0 := MaintenanceWindow{
+		Start: currentTime.Add(time.Hour),
+		End:   currentTime.Add(2 * time.Hour),
+	}
+	require.NoError(up.RecordMaintenance(nodeID0, window0))
+
+	window1 := MaintenanceWindow{
+		Start: currentTime,
+		End:   currentTime.Add(3 * time.Hour),
+	}
+	require.NoError(up.RecordMaintenance(nodeID1, window1))
+
+	window, ok := up.GetMaintenance(nodeID0)
+	require.True(ok)
+	require.Equal(window0, window)
+
+	// A new announcement replaces the previous one
+	window0 = MaintenanceWindow{
+		Start: currentTime.Add(30 * time.Minute),
+		End:   currentTime.Add(90 * time.Minute),
+	}
+	require.NoError(up.RecordMaintenance(nodeID0, window0))
+	window, ok = up.GetMaintenance(nodeID0)
+	require.True(ok)
+	require.Equal(window0, window)
+
+	// Windows are no longer reported once they end
+	clk.Set(window0.End)
+	_, ok = up.GetMaintenance(nodeID0)
+	require.False(ok)
+
+	window, ok = up.GetMaintenance(nodeID1)
+	require.True(ok)
+	require.Equal(window1, window)
+}
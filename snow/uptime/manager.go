@@ -9,6 +9,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 )
 
@@ -22,6 +23,7 @@ var (
 type Manager interface {
 	Tracker
 	Calculator
+	MaintenanceTracker
 }
 
 type Tracker interface {
@@ -47,9 +49,15 @@ type manager struct {
 
 	state       State
 	connections map[ids.NodeID]time.Time // nodeID  -> connected at
+	maintenance map[ids.NodeID]MaintenanceWindow
 	// Whether we have started tracking the uptime of the nodes
 	// This is used to avoid setting the uptime before we have started tracking
 	startedTracking bool
+	startedAt       time.Time
+	// Nodes whose uptime prior to [startedAt] has not been written to the
+	// state yet. Nodes are assumed to have been online until [startedAt], and
+	// their uptime is only written once it is next updated.
+	unsynced set.Set[ids.NodeID]
 }
 
 func NewManager(state State, clk *mockable.Clock) Manager {
@@ -57,24 +65,27 @@ func NewManager(state State, clk *mockable.Clock) Manager {
 		clock:       clk,
 		state:       state,
 		connections: make(map[ids.NodeID]time.Time),
+		maintenance: make(map[ids.NodeID]MaintenanceWindow),
 	}
 }
 
+// StartTracking does not write the uptimes of [nodeIDs]. The time they were
+// assumed to be online is written the next time their uptime is updated, which
+// avoids rewriting every validator on startup.
 func (m *manager) StartTracking(nodeIDs []ids.NodeID) error {
 	if m.startedTracking {
 		return errAlreadyStartedTracking
 	}
 
-	for _, nodeID := range nodeIDs {
-		if err := m.updateUptime(nodeID); err != nil {
-			return err
-		}
-	}
-
+	m.unsynced = set.Of(nodeIDs...)
+	m.startedAt = m.clock.UnixTime()
 	m.startedTracking = true
 	return nil
 }
 
+// StopTracking writes the uptimes of all [nodeIDs] as of now, including nodes
+// that are disconnected, so that their time offline until now is not counted
+// after tracking is restarted. The time tracking stopped is also recorded.
 func (m *manager) StopTracking(nodeIDs []ids.NodeID) error {
 	if !m.startedTracking {
 		return errNotStartedTracking
@@ -86,6 +97,8 @@ func (m *manager) StopTracking(nodeIDs []ids.NodeID) error {
 		}
 	}
 
+	m.state.SetTrackingStoppedAt(m.clock.UnixTime())
+	m.unsynced = nil
 	m.startedTracking = false
 	return nil
 }
@@ -115,7 +128,7 @@ func (m *manager) Disconnect(nodeID ids.NodeID) error {
 }
 
 func (m *manager) CalculateUptime(nodeID ids.NodeID) (time.Duration, time.Time, error) {
-	upDuration, lastUpdated, err := m.state.GetUptime(nodeID)
+	upDuration, lastUpdated, err := m.getUptime(nodeID)
 	if err != nil {
 		return 0, time.Time{}, err
 	}
@@ -182,6 +195,29 @@ func (m *manager) CalculateUptimePercentFrom(nodeID ids.NodeID, startTime time.T
 	return uptime, nil
 }
 
+// getUptime returns the uptime of the node as of the last time it was updated,
+// accounting for updates that have not been written to the state.
+func (m *manager) getUptime(nodeID ids.NodeID) (time.Duration, time.Time, error) {
+	upDuration, lastUpdated, err := m.state.GetUptime(nodeID)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	// If the node's uptime wasn't written when tracking was last stopped, the
+	// node was offline from when it was last updated until tracking stopped.
+	if stoppedAt := m.state.GetTrackingStoppedAt(); lastUpdated.Before(stoppedAt) {
+		lastUpdated = stoppedAt
+	}
+
+	// If the node's uptime hasn't been written since tracking started, the
+	// node is assumed to have been online until tracking started.
+	if m.unsynced.Contains(nodeID) && lastUpdated.Before(m.startedAt) {
+		upDuration += m.startedAt.Sub(lastUpdated)
+		lastUpdated = m.startedAt
+	}
+	return upDuration, lastUpdated, nil
+}
+
 // updateUptime updates the uptime of the node on the state by the amount of
 // time that the node has been connected.
 func (m *manager) updateUptime(nodeID ids.NodeID) error {
@@ -194,5 +230,9 @@ func (m *manager) updateUptime(nodeID ids.NodeID) error {
 		return err
 	}
 
-	return m.state.SetUptime(nodeID, newDuration, newLastUpdated)
+	if err := m.state.SetUptime(nodeID, newDuration, newLastUpdated); err != nil {
+		return err
+	}
+	m.unsynced.Remove(nodeID)
+	return nil
 }
@@ -40,7 +40,7 @@ func TestStartTracking(t *testing.T) {
 	require.Equal(clk.UnixTime(), lastUpdated)
 }
 
-func TestStartTrackingDBError(t *testing.T) {
+func TestStartTrackingDeferredDBError(t *testing.T) {
 	require := require.New(t)
 
 	nodeID0 := ids.GenerateTestNodeID()
@@ -56,7 +56,12 @@ func TestStartTrackingDBError(t *testing.T) {
 	currentTime := startTime.Add(time.Second)
 	clk.Set(currentTime)
 
-	err := up.StartTracking([]ids.NodeID{nodeID0})
+	// Uptimes aren't written when tracking starts
+	require.NoError(up.StartTracking([]ids.NodeID{nodeID0}))
+	require.Zero(s.writes)
+
+	require.NoError(up.Connect(nodeID0))
+	err := up.Disconnect(nodeID0)
 	require.ErrorIs(err, errTest)
 }
 
@@ -557,3 +562,79 @@ func TestStopTrackingUnixTimeRegression(t *testing.T) {
 	require.NoError(err)
 	require.GreaterOrEqual(float64(1), perc)
 }
+
+func TestStopTrackingWritesDisconnectedNodes(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	startTime := time.Unix(time.Now().Unix(), 0)
+
+	s := NewTestState()
+	s.AddNode(nodeID0, startTime)
+	clk := mockable.Clock{}
+	up := NewManager(s, &clk)
+
+	currentTime := startTime.Add(time.Second)
+	clk.Set(currentTime)
+	require.NoError(up.StartTracking([]ids.NodeID{nodeID0}))
+
+	currentTime = currentTime.Add(time.Second)
+	clk.Set(currentTime)
+	require.NoError(up.Connect(nodeID0))
+
+	currentTime = currentTime.Add(time.Second)
+	clk.Set(currentTime)
+	require.NoError(up.Disconnect(nodeID0))
+	require.Equal(1, s.writes)
+
+	stopTime := currentTime.Add(time.Second)
+	clk.Set(stopTime)
+	require.NoError(up.StopTracking([]ids.NodeID{nodeID0}))
+	require.Equal(2, s.writes)
+	require.Equal(stopTime, s.GetTrackingStoppedAt())
+
+	// The node was offline from when it disconnected until tracking stopped.
+	duration, lastUpdated, err := s.GetUptime(nodeID0)
+	require.NoError(err)
+	require.Equal(2*time.Second, duration)
+	require.Equal(stopTime, lastUpdated)
+
+	// The node is assumed to be online from when tracking stopped, but not
+	// for the time it was disconnected prior to stopping.
+	up = NewManager(s, &clk)
+	currentTime = stopTime.Add(time.Second)
+	clk.Set(currentTime)
+
+	duration, lastUpdated, err = up.CalculateUptime(nodeID0)
+	require.NoError(err)
+	require.Equal(3*time.Second, duration)
+	require.Equal(currentTime, lastUpdated)
+}
+
+func TestStopTrackingWritesUnsyncedNodes(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	startTime := time.Unix(time.Now().Unix(), 0)
+
+	s := NewTestState()
+	s.AddNode(nodeID0, startTime)
+	clk := mockable.Clock{}
+	up := NewManager(s, &clk)
+
+	currentTime := startTime.Add(time.Second)
+	clk.Set(currentTime)
+	require.NoError(up.StartTracking([]ids.NodeID{nodeID0}))
+	require.Zero(s.writes)
+
+	currentTime = currentTime.Add(time.Second)
+	clk.Set(currentTime)
+	require.NoError(up.StopTracking([]ids.NodeID{nodeID0}))
+	require.Equal(1, s.writes)
+
+	// The node was online until tracking started and offline afterwards.
+	duration, lastUpdated, err := s.GetUptime(nodeID0)
+	require.NoError(err)
+	require.Equal(time.Second, duration)
+	require.Equal(currentTime, lastUpdated)
+}
@@ -31,4 +31,14 @@ type State interface {
 	GetStartTime(
 		nodeID ids.NodeID,
 	) (startTime time.Time, err error)
+
+	// GetTrackingStoppedAt returns the last time that uptime tracking was
+	// stopped. Returns the zero time if tracking has never been stopped.
+	GetTrackingStoppedAt() time.Time
+
+	// SetTrackingStoppedAt updates the last time that uptime tracking was
+	// stopped.
+	// Invariant: expects [stoppedAt] to be truncated (floored) to the nearest
+	//            second.
+	SetTrackingStoppedAt(stoppedAt time.Time)
 }
@@ -19,9 +19,12 @@ type uptime struct {
 }
 
 type TestState struct {
-	dbReadError  error
-	dbWriteError error
-	nodes        map[ids.NodeID]*uptime
+	dbReadError       error
+	dbWriteError      error
+	nodes             map[ids.NodeID]*uptime
+	trackingStoppedAt time.Time
+	// writes counts the calls to SetUptime
+	writes int
 }
 
 func NewTestState() *TestState {
@@ -53,6 +56,7 @@ func (s *TestState) SetUptime(nodeID ids.NodeID, upDuration time.Duration, lastU
 	}
 	up.upDuration = upDuration
 	up.lastUpdated = time.Unix(lastUpdated.Unix(), 0)
+	s.writes++
 	return s.dbWriteError
 }
 
@@ -63,3 +67,11 @@ func (s *TestState) GetStartTime(nodeID ids.NodeID) (time.Time, error) {
 	}
 	return up.startTime, s.dbReadError
 }
+
+func (s *TestState) GetTrackingStoppedAt() time.Time {
+	return s.trackingStoppedAt
+}
+
+func (s *TestState) SetTrackingStoppedAt(stoppedAt time.Time) {
+	s.trackingStoppedAt = time.Unix(stoppedAt.Unix(), 0)
+}
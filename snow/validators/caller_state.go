@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var _ State = (*callerState)(nil)
+
+type callerKey struct{}
+
+// WithCaller returns a copy of [ctx] that attributes the validator sets
+// requested with it to [caller].
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// GetCaller returns who the validator sets requested with [ctx] are attributed
+// to, or the empty string if they aren't attributed to anyone.
+func GetCaller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+type callerState struct {
+	s      State
+	caller string
+}
+
+// NewCallerState returns a State that attributes the validator sets requested
+// through it to [caller], unless they are already attributed to someone.
+func NewCallerState(s State, caller string) State {
+	return &callerState{
+		s:      s,
+		caller: caller,
+	}
+}
+
+func (s *callerState) withCaller(ctx context.Context) context.Context {
+	if GetCaller(ctx) != "" {
+		return ctx
+	}
+	return WithCaller(ctx, s.caller)
+}
+
+func (s *callerState) GetMinimumHeight(ctx context.Context) (uint64, error) {
+	return s.s.GetMinimumHeight(ctx)
+}
+
+func (s *callerState) GetCurrentHeight(ctx context.Context) (uint64, error) {
+	return s.s.GetCurrentHeight(ctx)
+}
+
+func (s *callerState) GetSubnetID(ctx context.Context, chainID ids.ID) (ids.ID, error) {
+	return s.s.GetSubnetID(ctx, chainID)
+}
+
+func (s *callerState) GetValidatorSet(
+	ctx context.Context,
+	height uint64,
+	subnetID ids.ID,
+) (map[ids.NodeID]*GetValidatorOutput, error) {
+	return s.s.GetValidatorSet(s.withCaller(ctx), height, subnetID)
+}
+
+func (s *callerState) GetCurrentValidatorSet(
+	ctx context.Context,
+	subnetID ids.ID,
+) (map[ids.ID]*GetCurrentValidatorOutput, uint64, error) {
+	return s.s.GetCurrentValidatorSet(s.withCaller(ctx), subnetID)
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package keyfile encrypts the private keys that identify a node, so that they
+// can't be used by anyone who obtains the files they are stored in without
+// also obtaining the secret they are encrypted with.
+package keyfile
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	version = 1
+
+	// KDFScrypt derives the encryption key from a passphrase.
+	KDFScrypt = "scrypt"
+	// KDFHKDF derives the encryption key from a random data key, such as a
+	// data key generated and wrapped by a KMS.
+	KDFHKDF = "hkdf-sha256"
+
+	// MinDataKeyLen is the minimum length, in bytes, of a data key.
+	MinDataKeyLen = 32
+
+	encryptionKeyLen = 32 // AES-256
+	saltLen          = 32
+
+	// scrypt parameters recommended for interactive logins. They are stored
+	// in the file, so that they can be increased without breaking existing
+	// files.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+var (
+	// header prefixes encrypted files, so that they can be distinguished from
+	// unencrypted keys.
+	header = []byte("avalanchego-encrypted-key\n")
+
+	hkdfInfo = []byte("avalanchego key file encryption")
+
+	ErrNotEncrypted       = errors.New("file isn't encrypted")
+	ErrUnsupportedVersion = errors.New("unsupported version")
+	ErrKDFMismatch        = errors.New("file was encrypted with a different kind of secret")
+	ErrDecryptionFailed   = errors.New("decryption failed, the secret may be wrong")
+	ErrDataKeyTooShort    = errors.New("data key is too short")
+	ErrEmptyPassphrase    = errors.New("passphrase is empty")
+
+	errUnknownKDF = errors.New("unknown kdf")
+)
+
+// file is the encoding of an encrypted file after its header.
+type file struct {
+	Version    uint16 `json:"version"`
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt"`
+	ScryptN    int    `json:"scryptN,omitempty"`
+	ScryptR    int    `json:"scryptR,omitempty"`
+	ScryptP    int    `json:"scryptP,omitempty"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Secret encrypts and decrypts key files.
+type Secret struct {
+	kdf    string
+	secret []byte
+}
+
+// NewPassphrase returns a secret that encrypts files with a key derived from
+// [passphrase].
+func NewPassphrase(passphrase []byte) (*Secret, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+	return &Secret{
+		kdf:    KDFScrypt,
+		secret: passphrase,
+	}, nil
+}
+
+// NewDataKey returns a secret that encrypts files with a key derived from
+// [dataKey], which must be at least [MinDataKeyLen] random bytes.
+func NewDataKey(dataKey []byte) (*Secret, error) {
+	if len(dataKey) < MinDataKeyLen {
+		return nil, fmt.Errorf("%w: %d < %d", ErrDataKeyTooShort, len(dataKey), MinDataKeyLen)
+	}
+	return &Secret{
+		kdf:    KDFHKDF,
+		secret: dataKey,
+	}, nil
+}
+
+// IsEncrypted returns true if [b] is the content of an encrypted file.
+func IsEncrypted(b []byte) bool {
+	return bytes.HasPrefix(b, header)
+}
+
+// Encrypt returns the content of a file that contains [plaintext] encrypted
+// with the secret.
+func (s *Secret) Encrypt(plaintext []byte) ([]byte, error) {
+	f := file{
+		Version: version,
+		KDF:     s.kdf,
+		Salt:    make([]byte, saltLen),
+	}
+	if _, err := rand.Read(f.Salt); err != nil {
+		return nil, fmt.Errorf("couldn't generate salt: %w", err)
+	}
+	if s.kdf == KDFScrypt {
+		f.ScryptN = scryptN
+		f.ScryptR = scryptR
+		f.ScryptP = scryptP
+	}
+
+	aead, err := s.newAEAD(&f)
+	if err != nil {
+		return nil, err
+	}
+	f.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(f.Nonce); err != nil {
+		return nil, fmt.Errorf("couldn't generate nonce: %w", err)
+	}
+	f.Ciphertext = aead.Seal(nil, f.Nonce, plaintext, header)
+
+	fileBytes, err := json.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+	return append(bytes.Clone(header), fileBytes...), nil
+}
+
+// Decrypt returns the plaintext of the encrypted file whose content is [b].
+func (s *Secret) Decrypt(b []byte) ([]byte, error) {
+	if !IsEncrypted(b) {
+		return nil, ErrNotEncrypted
+	}
+
+	var f file
+	if err := json.Unmarshal(b[len(header):], &f); err != nil {
+		return nil, fmt.Errorf("couldn't parse encrypted file: %w", err)
+	}
+	if f.Version != version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, f.Version)
+	}
+	if f.KDF != s.kdf {
+		return nil, fmt.Errorf("%w: expected %q but file uses %q", ErrKDFMismatch, s.kdf, f.KDF)
+	}
+
+	aead, err := s.newAEAD(&f)
+	if err != nil {
+		return nil, err
+	}
+	if len(f.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid nonce length %d", ErrDecryptionFailed, len(f.Nonce))
+	}
+	plaintext, err := aead.Open(nil, f.Nonce, f.Ciphertext, header)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+// newAEAD returns the cipher that encrypts [f], whose key is derived from the
+// secret with the parameters of [f].
+func (s *Secret) newAEAD(f *file) (cipher.AEAD, error) {
+	key := make([]byte, encryptionKeyLen)
+	switch f.KDF {
+	case KDFScrypt:
+		var err error
+		key, err = scrypt.Key(s.secret, f.Salt, f.ScryptN, f.ScryptR, f.ScryptP, encryptionKeyLen)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't derive key: %w", err)
+		}
+	case KDFHKDF:
+		if _, err := io.ReadFull(hkdf.New(sha256.New, s.secret, f.Salt, hkdfInfo), key); err != nil {
+			return nil, fmt.Errorf("couldn't derive key: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownKDF, f.KDF)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
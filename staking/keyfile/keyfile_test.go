@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keyfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	passphrase, err := NewPassphrase([]byte("passphrase"))
+	require.NoError(t, err)
+	dataKey, err := NewDataKey(utils.RandomBytes(MinDataKeyLen))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		secret *Secret
+	}{
+		{
+			name:   "passphrase",
+			secret: passphrase,
+		},
+		{
+			name:   "data key",
+			secret: dataKey,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			plaintext := []byte("staking key")
+			encrypted, err := test.secret.Encrypt(plaintext)
+			require.NoError(err)
+			require.True(IsEncrypted(encrypted))
+			require.NotContains(string(encrypted), string(plaintext))
+
+			decrypted, err := test.secret.Decrypt(encrypted)
+			require.NoError(err)
+			require.Equal(plaintext, decrypted)
+
+			// Encrypting the same plaintext again uses a new salt and nonce.
+			encryptedAgain, err := test.secret.Encrypt(plaintext)
+			require.NoError(err)
+			require.NotEqual(encrypted, encryptedAgain)
+		})
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	require := require.New(t)
+
+	passphrase, err := NewPassphrase([]byte("passphrase"))
+	require.NoError(err)
+	encrypted, err := passphrase.Encrypt([]byte("staking key"))
+	require.NoError(err)
+
+	_, err = passphrase.Decrypt([]byte("staking key"))
+	require.ErrorIs(err, ErrNotEncrypted)
+
+	wrongPassphrase, err := NewPassphrase([]byte("wrong passphrase"))
+	require.NoError(err)
+	_, err = wrongPassphrase.Decrypt(encrypted)
+	require.ErrorIs(err, ErrDecryptionFailed)
+
+	dataKey, err := NewDataKey(utils.RandomBytes(MinDataKeyLen))
+	require.NoError(err)
+	_, err = dataKey.Decrypt(encrypted)
+	require.ErrorIs(err, ErrKDFMismatch)
+
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(tampered)-4] ^= 1
+	_, err = passphrase.Decrypt(tampered)
+	require.ErrorIs(err, ErrDecryptionFailed)
+}
+
+func TestNewSecretErrors(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewPassphrase(nil)
+	require.ErrorIs(err, ErrEmptyPassphrase)
+
+	_, err = NewDataKey(make([]byte, MinDataKeyLen-1))
+	require.ErrorIs(err, ErrDataKeyTooShort)
+}
@@ -17,13 +17,15 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/ava-labs/avalanchego/staking/keyfile"
 	"github.com/ava-labs/avalanchego/utils/perms"
 )
 
 // InitNodeStakingKeyPair generates a self-signed TLS key/cert pair to use in
 // staking. The key and files will be placed at [keyPath] and [certPath],
-// respectively. If there is already a file at [keyPath], returns nil.
-func InitNodeStakingKeyPair(keyPath, certPath string) error {
+// respectively. If [secret] isn't nil, the key is encrypted with it. If there
+// is already a file at [keyPath], returns nil.
+func InitNodeStakingKeyPair(keyPath, certPath string, secret *keyfile.Secret) error {
 	// If there is already a file at [keyPath], do nothing
 	if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
 		return nil
@@ -33,6 +35,12 @@ func InitNodeStakingKeyPair(keyPath, certPath string) error {
 	if err != nil {
 		return err
 	}
+	if secret != nil {
+		keyBytes, err = secret.Encrypt(keyBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encrypt key: %w", err)
+		}
+	}
 
 	// Ensure directory where key/cert will live exist
 	if err := os.MkdirAll(filepath.Dir(certPath), perms.ReadWriteExecute); err != nil {
@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package staking
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+// transportCertSignaturePrefix domain separates the delegation signature from
+// other signatures produced with the staking key.
+const transportCertSignaturePrefix = "avalanche transport certificate\n"
+
+var (
+	// oidTransportCertDelegation identifies the certificate extension that
+	// contains the delegation of a transport certificate by a staking
+	// certificate.
+	oidTransportCertDelegation = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57004, 1, 1}
+
+	ErrTransportCertExpired          = errors.New("staking: transport certificate expired")
+	ErrInvalidTransportCertSignature = errors.New("staking: invalid transport certificate signature")
+	errInvalidTransportCertExtension = errors.New("staking: invalid transport certificate extension")
+)
+
+// transportCertDelegation is the value of the delegation extension.
+type transportCertDelegation struct {
+	// StakingCert is the raw staking certificate that delegated the transport
+	// certificate.
+	StakingCert []byte
+	// Expiry is the unix time after which the delegation is no longer valid.
+	Expiry int64
+	// Signature is the staking key's signature over the expiry and the public
+	// key of the transport certificate.
+	Signature []byte
+}
+
+// NewTransportCert generates a TLS certificate with a new key that may be
+// presented instead of [stakingCert] until [expiry]. Peers derive the node ID
+// from the embedded [stakingCert], so the transport certificate can be rotated
+// without changing the node ID.
+func NewTransportCert(
+	stakingCert *Certificate,
+	stakingSigner crypto.Signer,
+	expiry time.Time,
+) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate ecdsa key: %w", err)
+	}
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal public key: %w", err)
+	}
+
+	expiryUnix := expiry.Unix()
+	hash := hashing.ComputeHash256(transportCertSignedBytes(expiryUnix, publicKeyBytes))
+	signature, err := stakingSigner.Sign(rand.Reader, hash, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't sign transport certificate: %w", err)
+	}
+	delegationBytes, err := asn1.Marshal(transportCertDelegation{
+		StakingCert: stakingCert.Raw,
+		Expiry:      expiryUnix,
+		Signature:   signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal delegation: %w", err)
+	}
+
+	certTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		NotBefore:             time.Date(2000, time.January, 0, 0, 0, 0, 0, time.UTC),
+		NotAfter:              expiry,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		ExtraExtensions: []pkix.Extension{{
+			Id:    oidTransportCertDelegation,
+			Value: delegationBytes,
+		}},
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, certTemplate, certTemplate, key.Public(), key)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create certificate: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse certificate: %w", err)
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{certBytes},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+// ParseTLSCertificate returns the staking certificate that authenticates
+// [cert], which was presented during a TLS handshake. [cert] is either a
+// staking certificate or a transport certificate that was delegated by a
+// staking certificate and has not expired at [now].
+func ParseTLSCertificate(cert *x509.Certificate, now time.Time) (*Certificate, error) {
+	var delegationBytes []byte
+	for _, extension := range cert.Extensions {
+		if extension.Id.Equal(oidTransportCertDelegation) {
+			delegationBytes = extension.Value
+			break
+		}
+	}
+	if delegationBytes == nil {
+		return ParseCertificate(cert.Raw)
+	}
+
+	var delegation transportCertDelegation
+	rest, err := asn1.Unmarshal(delegationBytes, &delegation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidTransportCertExtension, err)
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", errInvalidTransportCertExtension, len(rest))
+	}
+	if now.Unix() > delegation.Expiry {
+		return nil, fmt.Errorf("%w at %s", ErrTransportCertExpired, time.Unix(delegation.Expiry, 0))
+	}
+
+	stakingCert, err := ParseCertificate(delegation.StakingCert)
+	if err != nil {
+		return nil, err
+	}
+	signedBytes := transportCertSignedBytes(delegation.Expiry, cert.RawSubjectPublicKeyInfo)
+	if err := CheckSignature(stakingCert, signedBytes, delegation.Signature); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTransportCertSignature, err)
+	}
+	return stakingCert, nil
+}
+
+func transportCertSignedBytes(expiry int64, publicKeyBytes []byte) []byte {
+	signedBytes := make([]byte, 0, len(transportCertSignaturePrefix)+8+len(publicKeyBytes))
+	signedBytes = append(signedBytes, transportCertSignaturePrefix...)
+	signedBytes = binary.BigEndian.AppendUint64(signedBytes, uint64(expiry))
+	return append(signedBytes, publicKeyBytes...)
+}
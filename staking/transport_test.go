@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package staking
+
+import (
+	"crypto"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTLSCertificate(t *testing.T) {
+	stakingTLSCert, err := NewTLSCert()
+	require.NoError(t, err)
+	stakingCert, err := ParseCertificate(stakingTLSCert.Leaf.Raw)
+	require.NoError(t, err)
+	stakingSigner := stakingTLSCert.PrivateKey.(crypto.Signer)
+
+	otherTLSCert, err := NewTLSCert()
+	require.NoError(t, err)
+	otherCert, err := ParseCertificate(otherTLSCert.Leaf.Raw)
+	require.NoError(t, err)
+
+	var (
+		now    = time.Unix(1_000_000, 0)
+		expiry = now.Add(time.Hour)
+	)
+	transportCert, err := NewTransportCert(stakingCert, stakingSigner, expiry)
+	require.NoError(t, err)
+
+	// The delegation is signed by a key that doesn't match the embedded
+	// staking certificate.
+	forgedCert, err := NewTransportCert(otherCert, stakingSigner, expiry)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		leaf         *x509.Certificate
+		now          time.Time
+		expectedCert *Certificate
+		expectedErr  error
+	}{
+		{
+			name:         "staking certificate",
+			leaf:         stakingTLSCert.Leaf,
+			now:          now,
+			expectedCert: stakingCert,
+		},
+		{
+			name:         "transport certificate",
+			leaf:         transportCert.Leaf,
+			now:          now,
+			expectedCert: stakingCert,
+		},
+		{
+			name:        "expired transport certificate",
+			leaf:        transportCert.Leaf,
+			now:         expiry.Add(time.Second),
+			expectedErr: ErrTransportCertExpired,
+		},
+		{
+			name:        "forged transport certificate",
+			leaf:        forgedCert.Leaf,
+			now:         now,
+			expectedErr: ErrInvalidTransportCertSignature,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			cert, err := ParseTLSCertificate(test.leaf, test.now)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedCert, cert)
+		})
+	}
+}
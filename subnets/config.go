@@ -13,7 +13,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
-var errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+var (
+	errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+	errAPIAuthTokensWhenNotPrivateAPI   = errors.New("apiAuthTokens can only be set when PrivateAPI is true")
+	errPrivateAPIWithoutAuthTokens      = errors.New("privateAPI requires apiAuthTokens")
+	errRequireCheckpointsWhenDisabled   = errors.New("requireCheckpoints can't be set when disableCheckpoints is true")
+)
 
 type Config struct {
 	// ValidatorOnly indicates that this Subnet's Chains are available to only subnet validators.
@@ -31,6 +36,11 @@ type Config struct {
 	//
 	// TODO: Remove this flag once all VMs throttle their own block production.
 	ProposerMinBlockDelay time.Duration `json:"proposerMinBlockDelay" yaml:"proposerMinBlockDelay"`
+	// ProposerMaxBlockDelay is the maximum delay this node will enforce when
+	// building a snowman++ block. If larger than ProposerMinBlockDelay, the
+	// enforced delay moves between the two bounds based on the utilization of
+	// recently accepted blocks, as reported by the VM.
+	ProposerMaxBlockDelay time.Duration `json:"proposerMaxBlockDelay" yaml:"proposerMaxBlockDelay"`
 	// ProposerNumHistoricalBlocks is the number of historical snowman++ blocks
 	// this node will index per chain. If set to 0, the node will index all
 	// snowman++ blocks.
@@ -49,6 +59,25 @@ type Config struct {
 	// TODO: Move this flag once the proposervm is configurable on a per-chain
 	// basis.
 	ProposerNumHistoricalBlocks uint64 `json:"proposerNumHistoricalBlocks" yaml:"proposerNumHistoricalBlocks"`
+
+	// APIAllowedMethods is the set of API methods exposed by this Subnet's
+	// chains. If empty, all methods are exposed.
+	APIAllowedMethods set.Set[string] `json:"apiAllowedMethods" yaml:"apiAllowedMethods"`
+	// PrivateAPI indicates that API calls to this Subnet's chains must be
+	// authenticated with one of [APIAuthTokens].
+	PrivateAPI bool `json:"privateAPI" yaml:"privateAPI"`
+	// APIAuthTokens is the set of bearer tokens that are accepted by this
+	// Subnet's chains when PrivateAPI is enabled.
+	APIAuthTokens set.Set[string] `json:"apiAuthTokens" yaml:"apiAuthTokens"`
+
+	// DisableCheckpoints stops this node from publishing the checkpoints of
+	// this Subnet's chains and from bootstrapping from the checkpoints
+	// published by the Subnet's validators.
+	DisableCheckpoints bool `json:"disableCheckpoints" yaml:"disableCheckpoints"`
+	// RequireCheckpoints delays fetching blocks when bootstrapping this
+	// Subnet's chains until validators holding a majority of the stake have
+	// published their checkpoints.
+	RequireCheckpoints bool `json:"requireCheckpoints" yaml:"requireCheckpoints"`
 }
 
 func (c *Config) Valid() error {
@@ -58,5 +87,14 @@ func (c *Config) Valid() error {
 	if !c.ValidatorOnly && c.AllowedNodes.Len() > 0 {
 		return errAllowedNodesWhenNotValidatorOnly
 	}
+	if !c.PrivateAPI && c.APIAuthTokens.Len() > 0 {
+		return errAPIAuthTokensWhenNotPrivateAPI
+	}
+	if c.PrivateAPI && c.APIAuthTokens.Len() == 0 {
+		return errPrivateAPIWithoutAuthTokens
+	}
+	if c.DisableCheckpoints && c.RequireCheckpoints {
+		return errRequireCheckpointsWhenDisabled
+	}
 	return nil
 }
@@ -49,6 +49,31 @@ func TestValid(t *testing.T) {
 			},
 			expectedErr: errAllowedNodesWhenNotValidatorOnly,
 		},
+		{
+			name: "auth tokens without private API",
+			s: Config{
+				ConsensusParameters: validParameters,
+				APIAuthTokens:       set.Of("token"),
+			},
+			expectedErr: errAPIAuthTokensWhenNotPrivateAPI,
+		},
+		{
+			name: "private API without auth tokens",
+			s: Config{
+				ConsensusParameters: validParameters,
+				PrivateAPI:          true,
+			},
+			expectedErr: errPrivateAPIWithoutAuthTokens,
+		},
+		{
+			name: "required checkpoints when disabled",
+			s: Config{
+				ConsensusParameters: validParameters,
+				DisableCheckpoints:  true,
+				RequireCheckpoints:  true,
+			},
+			expectedErr: errRequireCheckpointsWhenDisabled,
+		},
 		{
 			name: "valid",
 			s: Config{
@@ -27,6 +27,10 @@ type Subnet interface {
 	// AddChain adds a chain to this Subnet
 	AddChain(chainID ids.ID) bool
 
+	// RemoveChain removes a chain from this Subnet. Returns false if the chain
+	// wasn't part of this Subnet.
+	RemoveChain(chainID ids.ID) bool
+
 	// Config returns config of this Subnet
 	Config() Config
 
@@ -85,6 +89,25 @@ func (s *subnet) AddChain(chainID ids.ID) bool {
 	return true
 }
 
+func (s *subnet) RemoveChain(chainID ids.ID) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch {
+	case s.bootstrapped.Contains(chainID):
+		s.bootstrapped.Remove(chainID)
+		return true
+	case !s.bootstrapping.Contains(chainID):
+		return false
+	}
+
+	s.bootstrapping.Remove(chainID)
+	if s.bootstrapping.Len() == 0 {
+		s.bootstrapSignal.Preempt()
+	}
+	return true
+}
+
 func (s *subnet) Config() Config {
 	return s.config
 }
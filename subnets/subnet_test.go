@@ -40,6 +40,36 @@ func TestSubnet(t *testing.T) {
 	require.True(s.IsBootstrapped(), "A subnet with only bootstrapped chains should be considered bootstrapped")
 }
 
+func TestSubnetRemoveChain(t *testing.T) {
+	require := require.New(t)
+
+	chainID0 := ids.GenerateTestID()
+	chainID1 := ids.GenerateTestID()
+
+	s := New(ids.GenerateTestNodeID(), Config{})
+	require.False(s.RemoveChain(chainID0))
+
+	require.True(s.AddChain(chainID0))
+	require.True(s.AddChain(chainID1))
+	s.Bootstrapped(chainID0)
+
+	require.True(s.RemoveChain(chainID0))
+	require.False(s.RemoveChain(chainID0))
+	require.False(s.IsBootstrapped())
+
+	// Removing the last bootstrapping chain marks the subnet as bootstrapped.
+	require.True(s.RemoveChain(chainID1))
+	require.True(s.IsBootstrapped())
+	select {
+	case <-s.AllBootstrapped():
+	default:
+		require.FailNow("subnet should have signaled that it is bootstrapped")
+	}
+
+	// A removed chain can be added again.
+	require.True(s.AddChain(chainID0))
+}
+
 func TestIsAllowed(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+)
+
+// etnaActivationDelay is the delay between the start of the test and the
+// activation of Etna. It needs to be long enough for the private network to
+// start and for pre-Etna transactions to be accepted.
+const etnaActivationDelay = 2 * time.Minute
+
+var _ = e2e.DescribePChain("[Etna Activation]", ginkgo.Label(e2e.ChaosLabel), func() {
+	tc := e2e.NewTestContext()
+	require := require.New(tc)
+
+	ginkgo.It("should switch fee calculation at the exact activation time", func() {
+		env := e2e.GetEnv(tc)
+		publicNetwork := env.GetNetwork()
+
+		etnaTime := time.Now().Add(etnaActivationDelay).Truncate(time.Second)
+		upgrades := upgrade.Default
+		upgrades.EtnaTime = etnaTime
+		upgrades.FortunaTime = upgrade.UnscheduledActivationTime
+		tc.Log().Info("scheduling Etna activation",
+			zap.Time("etnaTime", etnaTime),
+		)
+
+		upgradeJSON, err := json.Marshal(upgrades)
+		require.NoError(err)
+
+		tc.By("creating a new private network with a scheduled Etna activation")
+		privateNetwork := tmpnet.NewDefaultNetwork("avalanchego-e2e-etna-activation")
+		privateNetwork.DefaultFlags = tmpnet.FlagsMap{}
+		privateNetwork.DefaultFlags.SetDefaults(publicNetwork.DefaultFlags)
+		privateNetwork.DefaultFlags[config.UpgradeFileContentKey] = base64.StdEncoding.EncodeToString(upgradeJSON)
+		env.StartPrivateNetwork(privateNetwork)
+
+		// Avoid emitting a spec-scoped metrics link for the shared
+		// network since the link emitted by the start of the private
+		// network is more relevant.
+		e2e.EmitMetricsLink = false
+
+		node := privateNetwork.Nodes[0]
+		nodeURI := e2e.GetLocalURI(tc, node)
+		pChainClient := platformvm.NewClient(nodeURI)
+
+		tc.By("verifying that Etna is not yet activated", func() {
+			infoClient := info.NewClient(nodeURI)
+			nodeUpgrades, err := infoClient.Upgrades(tc.DefaultContext())
+			require.NoError(err)
+			require.Equal(etnaTime.Unix(), nodeUpgrades.EtnaTime.Unix())
+			require.False(
+				nodeUpgrades.IsEtnaActivated(time.Now()),
+				"network took too long to start, increase etnaActivationDelay",
+			)
+		})
+
+		keychain := secp256k1fx.NewKeychain(privateNetwork.PreFundedKeys[0])
+		pWallet, err := primary.MakePWallet(
+			tc.DefaultContext(),
+			nodeURI,
+			keychain,
+			primary.WalletConfig{},
+		)
+		require.NoError(err)
+		pContext := pWallet.Builder().Context()
+
+		// refreshFork updates the wallet's context to match the fee rules of
+		// the fork active at the current chain time. The P-Chain charges no
+		// fees prior to Etna.
+		refreshFork := func() {
+			_, gasPrice, chainTime, err := pChainClient.GetFeeState(tc.DefaultContext())
+			require.NoError(err)
+
+			if upgrades.IsEtnaActivated(chainTime) {
+				pContext.GasPrice = 2 * gasPrice
+			} else {
+				pContext.GasPrice = 0
+			}
+			tc.Log().Info("refreshed wallet fork",
+				zap.Time("chainTime", chainTime),
+				zap.Uint64("gasPrice", uint64(pContext.GasPrice)),
+			)
+		}
+
+		recipient := e2e.NewPrivateKey(tc).Address()
+		issueTransfer := func() (*txs.Tx, error) {
+			return pWallet.IssueBaseTx(
+				[]*avax.TransferableOutput{{
+					Asset: avax.Asset{
+						ID: pContext.AVAXAssetID,
+					},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: units.Avax,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{recipient},
+						},
+					},
+				}},
+				tc.WithDefaultContext(),
+			)
+		}
+		verifyBurned := func(tx *txs.Tx) uint64 {
+			expectedFee, err := e2e.NewPChainFeeCalculatorFromContext(pContext).CalculateFee(tx.Unsigned)
+			require.NoError(err)
+
+			utx, ok := tx.Unsigned.(*txs.BaseTx)
+			require.True(ok)
+			var burned uint64
+			for _, in := range utx.Ins {
+				burned += in.In.Amount()
+			}
+			for _, out := range utx.Outs {
+				burned -= out.Out.Amount()
+			}
+			require.Equal(expectedFee, burned)
+			return burned
+		}
+
+		var preEtnaHeight uint64
+		tc.By("issuing a transaction without fees before Etna", func() {
+			refreshFork()
+			require.Zero(pContext.GasPrice)
+
+			tx, err := issueTransfer()
+			require.NoError(err)
+			require.Zero(verifyBurned(tx))
+
+			preEtnaHeight, err = pChainClient.GetHeight(tc.DefaultContext())
+			require.NoError(err)
+		})
+
+		tc.By("waiting for Etna to activate", func() {
+			time.Sleep(time.Until(etnaTime) + time.Second)
+		})
+
+		tc.By("verifying that a transaction without fees is rejected after Etna", func() {
+			_, err := issueTransfer()
+			require.Error(err) //nolint:forbidigo // the precise error is returned over the API as a string
+		})
+
+		var postEtnaHeight uint64
+		tc.By("issuing a transaction with dynamic fees after refreshing the wallet's fork", func() {
+			refreshFork()
+			require.NotZero(pContext.GasPrice)
+
+			tx, err := issueTransfer()
+			require.NoError(err)
+			require.NotZero(verifyBurned(tx))
+
+			postEtnaHeight, err = pChainClient.GetHeight(tc.DefaultContext())
+			require.NoError(err)
+		})
+
+		tc.By("verifying that all nodes accepted the blocks straddling activation", func() {
+			var expectedBlocks [][]byte
+			for _, node := range privateNetwork.Nodes {
+				nodeClient := platformvm.NewClient(e2e.GetLocalURI(tc, node))
+				tc.Eventually(func() bool {
+					height, err := nodeClient.GetHeight(tc.DefaultContext())
+					require.NoError(err)
+					return height >= postEtnaHeight
+				}, e2e.DefaultTimeout, e2e.DefaultPollingInterval, "failed to see node accept the post-Etna block")
+
+				var blocks [][]byte
+				for height := preEtnaHeight; height <= postEtnaHeight; height++ {
+					blockBytes, err := nodeClient.GetBlockByHeight(tc.DefaultContext(), height)
+					require.NoError(err)
+					blocks = append(blocks, blockBytes)
+				}
+				if expectedBlocks == nil {
+					expectedBlocks = blocks
+					continue
+				}
+				require.Equal(expectedBlocks, blocks, "node %s accepted different blocks", node.NodeID)
+			}
+
+			firstBlock, err := block.Parse(block.Codec, expectedBlocks[0])
+			require.NoError(err)
+			lastBlock, err := block.Parse(block.Codec, expectedBlocks[len(expectedBlocks)-1])
+			require.NoError(err)
+			require.True(timestampOf(tc, firstBlock).Before(etnaTime))
+			require.False(timestampOf(tc, lastBlock).Before(etnaTime))
+		})
+
+		_ = e2e.CheckBootstrapIsPossible(tc, privateNetwork)
+	})
+})
+
+func timestampOf(tc require.TestingT, blk block.Block) time.Time {
+	banffBlock, ok := blk.(block.BanffBlock)
+	require.True(tc, ok)
+	return banffBlock.Timestamp()
+}
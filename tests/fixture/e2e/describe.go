@@ -12,6 +12,11 @@ const (
 	// but nonentheless uses the C-Chain. Intended to support
 	// execution of all C-Chain tests by the coreth repo in an e2e job.
 	UsesCChainLabel = "uses-c"
+
+	// Label for filtering tests that exercise network behavior across
+	// disruptive events like upgrade activations. These tests are slow
+	// and typically run against a private network.
+	ChaosLabel = "chaos"
 )
 
 // DescribeXChain annotates the tests for X-Chain.
@@ -0,0 +1,392 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/coreth/core/types"
+	"github.com/ava-labs/coreth/ethclient"
+	"github.com/ava-labs/coreth/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	walletcommon "github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+const (
+	// Arbitrary limit that is large enough for most tests while
+	// preventing a single test from draining the faucet.
+	DefaultFaucetMaxPerAddress = 1_000 * units.Avax
+
+	// The faucet listens on a random port of the loopback interface
+	// by default.
+	DefaultFaucetListenAddress = "127.0.0.1:0"
+
+	faucetDispensePath = "/dispense"
+	faucetGasLimit     = uint64(21_000) // Standard gas limit of a transfer
+)
+
+var (
+	errNoFaucetKey        = errors.New("network has no pre-funded key to fund the faucet")
+	errNoFaucetNode       = errors.New("network has no running node for the faucet to target")
+	errUnknownFaucetChain = errors.New("unknown chain, expected one of P, X or C")
+	errFaucetLimitReached = errors.New("dispense limit reached for address")
+	errInvalidEthAddress  = errors.New("invalid eth address")
+)
+
+// FaucetConfig configures a faucet for a temporary network.
+type FaucetConfig struct {
+	// The address the faucet's HTTP server listens on
+	ListenAddress string
+	// The maximum amount of nAVAX that will be dispensed to a single
+	// address on a single chain
+	MaxPerAddress uint64
+}
+
+// DispenseRequest is the body of a request to a faucet.
+type DispenseRequest struct {
+	// One of P, X or C
+	Chain string `json:"chain"`
+	// A bech32 address for the P-Chain and X-Chain or a hex address
+	// for the C-Chain
+	Address string `json:"address"`
+	// The amount of nAVAX to send
+	Amount uint64 `json:"amount"`
+}
+
+// DispenseResponse is the body of a response from a faucet.
+type DispenseResponse struct {
+	TxID  string `json:"txID,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Faucet dispenses AVAX on the P-Chain, X-Chain and C-Chain of a
+// temporary network from one of the network's pre-funded keys. This
+// avoids the need for parallel tests and developers to share
+// pre-funded keys.
+type Faucet struct {
+	log     logging.Logger
+	config  FaucetConfig
+	key     *secp256k1.PrivateKey
+	nodeURI string
+
+	// Dispense requests are serialized to avoid conflicting use of
+	// the faucet key's funds.
+	lock      sync.Mutex
+	wallet    *primary.Wallet
+	ethClient ethclient.Client
+	// Chain alias -> address -> nAVAX dispensed
+	dispensed map[string]map[string]uint64
+
+	listener net.Listener
+	server   *http.Server
+}
+
+// NewFaucet creates a faucet funded by the first pre-funded key of the
+// network. Tests using the faucet should not also use that key.
+func NewFaucet(ctx context.Context, log logging.Logger, network *Network, config FaucetConfig) (*Faucet, error) {
+	if len(network.PreFundedKeys) == 0 {
+		return nil, errNoFaucetKey
+	}
+	nodeURIs := network.GetNodeURIs()
+	if len(nodeURIs) == 0 {
+		return nil, errNoFaucetNode
+	}
+	if len(config.ListenAddress) == 0 {
+		config.ListenAddress = DefaultFaucetListenAddress
+	}
+	if config.MaxPerAddress == 0 {
+		config.MaxPerAddress = DefaultFaucetMaxPerAddress
+	}
+
+	key := network.PreFundedKeys[0]
+	nodeURI := nodeURIs[0].URI
+	keychain := secp256k1fx.NewKeychain(key)
+	wallet, err := primary.MakeWallet(ctx, nodeURI, keychain, keychain, primary.WalletConfig{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create faucet wallet: %w", err)
+	}
+	ethClient, err := ethclient.Dial(nodeURI + "/ext/bc/C/rpc")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create faucet eth client: %w", err)
+	}
+
+	return &Faucet{
+		log:       log,
+		config:    config,
+		key:       key,
+		nodeURI:   nodeURI,
+		wallet:    wallet,
+		ethClient: ethClient,
+		dispensed: make(map[string]map[string]uint64),
+	}, nil
+}
+
+// Start serves dispense requests in a goroutine until Stop is called.
+func (f *Faucet) Start() error {
+	listener, err := net.Listen("tcp", f.config.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", f.config.ListenAddress, err)
+	}
+	f.listener = listener
+
+	mux := http.NewServeMux()
+	mux.Handle(faucetDispensePath, f)
+	f.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		if err := f.server.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			f.log.Error("faucet server failed",
+				zap.Error(err),
+			)
+		}
+	}()
+
+	f.log.Info("started faucet",
+		zap.String("uri", f.URI()),
+		zap.Stringer("fundingAddress", f.key.Address()),
+		zap.Uint64("maxPerAddress", f.config.MaxPerAddress),
+	)
+	return nil
+}
+
+// URI returns the base URI of the faucet. Only valid after Start has
+// been called.
+func (f *Faucet) URI() string {
+	return "http://" + f.listener.Addr().String()
+}
+
+// Stop shuts down the faucet's HTTP server.
+func (f *Faucet) Stop(ctx context.Context) error {
+	if f.server == nil {
+		return nil
+	}
+	return f.server.Shutdown(ctx)
+}
+
+func (f *Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var (
+		request  DispenseRequest
+		response DispenseResponse
+		status   = http.StatusOK
+	)
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		status = http.StatusBadRequest
+		response.Error = err.Error()
+	} else if txID, err := f.Dispense(r.Context(), request.Chain, request.Address, request.Amount); err != nil {
+		status = http.StatusBadRequest
+		response.Error = err.Error()
+	} else {
+		response.TxID = txID
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		f.log.Warn("failed to write faucet response",
+			zap.Error(err),
+		)
+	}
+}
+
+// Dispense sends [amount] nAVAX to [addr] on [chain] and returns the
+// ID of the accepted transaction.
+func (f *Faucet) Dispense(ctx context.Context, chain string, addr string, amount uint64) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	dispensed := f.dispensed[chain][addr]
+	if amount > f.config.MaxPerAddress || dispensed > f.config.MaxPerAddress-amount {
+		return "", fmt.Errorf("%w: %s has received %d of %d nAVAX on %s",
+			errFaucetLimitReached,
+			addr,
+			dispensed,
+			f.config.MaxPerAddress,
+			chain,
+		)
+	}
+
+	var (
+		txID string
+		err  error
+	)
+	switch chain {
+	case "P", "X":
+		txID, err = f.dispenseAVAX(ctx, chain, addr, amount)
+	case "C":
+		txID, err = f.dispenseEth(ctx, addr, amount)
+	default:
+		return "", fmt.Errorf("%w: %q", errUnknownFaucetChain, chain)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if f.dispensed[chain] == nil {
+		f.dispensed[chain] = make(map[string]uint64)
+	}
+	f.dispensed[chain][addr] = dispensed + amount
+	f.log.Info("dispensed funds",
+		zap.String("chain", chain),
+		zap.String("address", addr),
+		zap.Uint64("amount", amount),
+		zap.String("txID", txID),
+	)
+	return txID, nil
+}
+
+func (f *Faucet) dispenseAVAX(ctx context.Context, chain string, addr string, amount uint64) (string, error) {
+	shortID, err := address.ParseToID(addr)
+	if err != nil {
+		return "", err
+	}
+	outputs := []*avax.TransferableOutput{{
+		Asset: avax.Asset{
+			ID: f.wallet.X().Builder().Context().AVAXAssetID,
+		},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: amount,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{shortID},
+			},
+		},
+	}}
+
+	if chain == "P" {
+		tx, err := f.wallet.P().IssueBaseTx(outputs, walletcommon.WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		return tx.ID().String(), nil
+	}
+	tx, err := f.wallet.X().IssueBaseTx(outputs, walletcommon.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	return tx.ID().String(), nil
+}
+
+func (f *Faucet) dispenseEth(ctx context.Context, addr string, amount uint64) (string, error) {
+	if !common.IsHexAddress(addr) {
+		return "", fmt.Errorf("%w: %q", errInvalidEthAddress, addr)
+	}
+
+	senderAddress := f.key.EthAddress()
+	nonce, err := f.ethClient.AcceptedNonceAt(ctx, senderAddress)
+	if err != nil {
+		return "", err
+	}
+	gasPrice, err := f.ethClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return "", err
+	}
+	chainID, err := f.ethClient.ChainID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// The C-Chain denominates AVAX with 18 decimals rather than 9
+	value := new(big.Int).Mul(
+		new(big.Int).SetUint64(amount),
+		new(big.Int).SetUint64(units.Avax),
+	)
+	tx := types.NewTransaction(
+		nonce,
+		common.HexToAddress(addr),
+		value,
+		faucetGasLimit,
+		gasPrice,
+		nil,
+	)
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), f.key.ToECDSA())
+	if err != nil {
+		return "", err
+	}
+	if err := f.ethClient.SendTransaction(ctx, signedTx); err != nil {
+		return "", err
+	}
+
+	// Wait for acceptance so that the funds are spendable as soon as the
+	// request completes
+	txHash := signedTx.Hash()
+	ticker := time.NewTicker(DefaultPollingInterval)
+	defer ticker.Stop()
+	for {
+		receipt, err := f.ethClient.TransactionReceipt(ctx, txHash)
+		switch {
+		case err == nil && receipt.Status == types.ReceiptStatusSuccessful:
+			return txHash.Hex(), nil
+		case err == nil:
+			return "", fmt.Errorf("transaction %s failed", txHash)
+		case !errors.Is(err, interfaces.NotFound):
+			return "", err
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RequestFaucetFunds requests [amount] nAVAX to be sent to [addr] on
+// [chain] by the faucet at [faucetURI] and returns the ID of the
+// accepted transaction.
+func RequestFaucetFunds(ctx context.Context, faucetURI string, chain string, addr string, amount uint64) (string, error) {
+	body, err := json.Marshal(DispenseRequest{
+		Chain:   chain,
+		Address: addr,
+		Amount:  amount,
+	})
+	if err != nil {
+		return "", err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, faucetURI+faucetDispensePath, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var response DispenseResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return "", fmt.Errorf("failed to decode faucet response: %w", err)
+	}
+	if len(response.Error) > 0 {
+		return "", fmt.Errorf("faucet request failed: %s", response.Error)
+	}
+	return response.TxID, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tmpnet
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func TestFaucetDispenseRejected(t *testing.T) {
+	faucet := &Faucet{
+		log: logging.NoLog{},
+		config: FaucetConfig{
+			MaxPerAddress: 100,
+		},
+		dispensed: map[string]map[string]uint64{
+			"X": {
+				"funded": 60,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		chain       string
+		addr        string
+		amount      uint64
+		expectedErr error
+	}{
+		{
+			name:        "amount exceeds limit",
+			chain:       "P",
+			addr:        "unfunded",
+			amount:      101,
+			expectedErr: errFaucetLimitReached,
+		},
+		{
+			name:        "cumulative amount exceeds limit",
+			chain:       "X",
+			addr:        "funded",
+			amount:      41,
+			expectedErr: errFaucetLimitReached,
+		},
+		{
+			name:        "unknown chain",
+			chain:       "D",
+			addr:        "unfunded",
+			amount:      1,
+			expectedErr: errUnknownFaucetChain,
+		},
+		{
+			name:        "invalid eth address",
+			chain:       "C",
+			addr:        "not-hex",
+			amount:      1,
+			expectedErr: errInvalidEthAddress,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := faucet.Dispense(context.Background(), test.chain, test.addr, test.amount)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+	require.Equal(t, uint64(60), faucet.dispensed["X"]["funded"])
+}
+
+func TestFaucetServeHTTPError(t *testing.T) {
+	require := require.New(t)
+
+	faucet := &Faucet{
+		log: logging.NoLog{},
+		config: FaucetConfig{
+			MaxPerAddress: 100,
+		},
+		dispensed: make(map[string]map[string]uint64),
+	}
+	server := httptest.NewServer(faucet)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL) //#nosec G107
+	require.NoError(err)
+	require.NoError(resp.Body.Close())
+	require.Equal(http.StatusMethodNotAllowed, resp.StatusCode)
+
+	_, err = RequestFaucetFunds(context.Background(), server.URL, "X", "addr", 101)
+	require.ErrorContains(err, errFaucetLimitReached.Error()) //nolint:forbidigo // the faucet returns errors over HTTP as strings
+}
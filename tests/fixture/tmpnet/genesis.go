@@ -4,31 +4,22 @@
 package tmpnet
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"time"
 
-	"github.com/ava-labs/coreth/core"
-	"github.com/ava-labs/coreth/params"
-
 	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/genesis/builder"
 	"github.com/ava-labs/avalanchego/ids"
-	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
-	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 )
 
-const (
-	defaultGasLimit = uint64(100_000_000) // Gas limit is arbitrary
-
-	// Arbitrarily large amount of AVAX to fund keys on the X-Chain for testing
-	defaultFundedKeyXChainAmount = 30 * units.MegaAvax
-)
+// Arbitrarily large amount of AVAX to fund keys on the X-Chain for testing
+const defaultFundedKeyXChainAmount = 30 * units.MegaAvax
 
 var (
 	// Arbitrarily large amount of AVAX (10^12) to fund keys on the C-Chain for testing
@@ -62,126 +53,86 @@ func NewTestGenesis(
 		return nil, errNoKeysForGenesis
 	}
 
-	initialStakers, err := stakersForNodes(networkID, nodes)
+	validators, err := validatorsForNodes(nodes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure stakers for nodes: %w", err)
 	}
 
-	// Address that controls stake doesn't matter -- generate it randomly
-	stakeAddress, err := address.Format(
-		"X",
-		constants.GetHRP(networkID),
-		ids.GenerateTestShortID().Bytes(),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to format stake address: %w", err)
-	}
-
 	// Ensure the total stake allows a MegaAvax per staker
-	totalStake := uint64(len(initialStakers)) * units.MegaAvax
-
-	// The eth address is only needed to link pre-mainnet assets. Until that capability
-	// becomes necessary for testing, use a bogus address.
-	//
-	// Reference: https://github.com/ava-labs/avalanchego/issues/1365#issuecomment-1511508767
-	ethAddress := "0x0000000000000000000000000000000000000000"
-
-	now := time.Now()
+	totalStake := uint64(len(validators)) * units.MegaAvax
 
-	config := &genesis.UnparsedConfig{
+	var (
+		now           = time.Now()
+		stakeLocktime = now.Add(7 * 24 * time.Hour) // 1 Week
+	)
+	spec := &builder.Spec{
 		NetworkID: networkID,
-		Allocations: []genesis.UnparsedAllocation{
-			{
-				ETHAddr:       ethAddress,
-				AVAXAddr:      stakeAddress,
-				InitialAmount: 0,
-				UnlockSchedule: []genesis.LockedAmount{ // Provides stake to validators
-					{
-						Amount:   totalStake,
-						Locktime: uint64(now.Add(7 * 24 * time.Hour).Unix()), // 1 Week
-					},
-				},
-			},
-		},
-		StartTime:                  uint64(now.Unix()),
-		InitialStakedFunds:         []string{stakeAddress},
-		InitialStakeDuration:       365 * 24 * 60 * 60, // 1 year
-		InitialStakeDurationOffset: 90 * 60,            // 90 minutes
-		Message:                    "hello avalanche!",
-		InitialStakers:             initialStakers,
+		StartTime: now,
+		Message:   "hello avalanche!",
+
+		Validators: validators,
+		// Address that controls stake doesn't matter -- generate it randomly
+		StakeAddress:        ids.GenerateTestShortID(),
+		ValidatorStake:      units.MegaAvax,
+		StakeLocktime:       stakeLocktime,
+		StakeDuration:       365 * 24 * time.Hour, // 1 year
+		StakeDurationOffset: 90 * time.Minute,
 	}
 
 	// Ensure pre-funded keys have arbitrary large balances on both chains to support testing
-	xChainBalances := make(XChainBalanceMap, len(keysToFund))
-	cChainBalances := make(core.GenesisAlloc, len(keysToFund))
 	for _, key := range keysToFund {
-		xChainBalances[key.Address()] = defaultFundedKeyXChainAmount
-		cChainBalances[key.EthAddress()] = core.GenesisAccount{
-			Balance: defaultFundedKeyCChainAmount,
-		}
-	}
-
-	// Set X-Chain balances
-	for xChainAddress, balance := range xChainBalances {
-		avaxAddr, err := address.Format("X", constants.GetHRP(networkID), xChainAddress[:])
-		if err != nil {
-			return nil, fmt.Errorf("failed to format X-Chain address: %w", err)
-		}
-		config.Allocations = append(
-			config.Allocations,
-			genesis.UnparsedAllocation{
-				ETHAddr:       ethAddress,
-				AVAXAddr:      avaxAddr,
-				InitialAmount: balance,
-				UnlockSchedule: []genesis.LockedAmount{
-					{
-						Amount: 20 * units.MegaAvax,
-					},
-					{
-						Amount:   totalStake,
-						Locktime: uint64(now.Add(7 * 24 * time.Hour).Unix()), // 1 Week
-					},
+		spec.Allocations = append(spec.Allocations, builder.Allocation{
+			Address:      key.Address(),
+			XChainAmount: defaultFundedKeyXChainAmount,
+			PChainAmounts: []genesis.LockedAmount{
+				{
+					Amount: 20 * units.MegaAvax,
+				},
+				{
+					Amount:   totalStake,
+					Locktime: uint64(stakeLocktime.Unix()),
 				},
 			},
-		)
+		})
+		spec.CChainAllocations = append(spec.CChainAllocations, builder.CChainAllocation{
+			Address: key.EthAddress(),
+			Balance: defaultFundedKeyCChainAmount,
+		})
 	}
 
-	chainID := big.NewInt(int64(networkID))
-	// Define C-Chain genesis
-	cChainGenesis := &core.Genesis{
-		Config:     &params.ChainConfig{ChainID: chainID},      // The rest of the config is set in coreth on VM initialization
-		Difficulty: big.NewInt(0),                              // Difficulty is a mandatory field
-		Timestamp:  uint64(upgrade.InitiallyActiveTime.Unix()), // This time enables Avalanche upgrades by default
-		GasLimit:   defaultGasLimit,
-		Alloc:      cChainBalances,
-	}
-	cChainGenesisBytes, err := json.Marshal(cChainGenesis)
+	testGenesis, err := builder.New(spec)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal C-Chain genesis: %w", err)
+		return nil, err
 	}
-	config.CChainGenesis = string(cChainGenesisBytes)
 
-	return config, nil
+	// Allow stakers to be added for the minimum stake duration used by e2e
+	// testing
+	stakingCfg := genesis.LocalParams.StakingConfig
+	testGenesis.Config.StakingParams = &genesis.StakingParams{
+		MinValidatorStake: stakingCfg.MinValidatorStake,
+		MaxValidatorStake: stakingCfg.MaxValidatorStake,
+		MinDelegatorStake: stakingCfg.MinDelegatorStake,
+		MinStakeDuration:  uint64(DefaultMinStakeDuration / time.Second),
+		MaxStakeDuration:  uint64(stakingCfg.MaxStakeDuration / time.Second),
+	}
+	return testGenesis.Config, nil
 }
 
-// Returns staker configuration for the given set of nodes.
-func stakersForNodes(networkID uint32, nodes []*Node) ([]genesis.UnparsedStaker, error) {
+// Returns the initial validator configuration for the given set of nodes.
+func validatorsForNodes(nodes []*Node) ([]builder.Validator, error) {
 	// Give staking rewards for initial validators to a random address. Any testing of staking rewards
 	// will be easier to perform with nodes other than the initial validators since the timing of
 	// staking can be more easily controlled.
-	rewardAddr, err := address.Format("X", constants.GetHRP(networkID), ids.GenerateTestShortID().Bytes())
-	if err != nil {
-		return nil, fmt.Errorf("failed to format reward address: %w", err)
-	}
+	rewardAddr := ids.GenerateTestShortID()
 
 	// Configure provided nodes as initial stakers
-	initialStakers := make([]genesis.UnparsedStaker, len(nodes))
+	validators := make([]builder.Validator, len(nodes))
 	for i, node := range nodes {
 		pop, err := node.GetProofOfPossession()
 		if err != nil {
 			return nil, fmt.Errorf("failed to derive proof of possession for node %s: %w", node.NodeID, err)
 		}
-		initialStakers[i] = genesis.UnparsedStaker{
+		validators[i] = builder.Validator{
 			NodeID:        node.NodeID,
 			RewardAddress: rewardAddr,
 			DelegationFee: .01 * reward.PercentDenominator,
@@ -189,5 +140,5 @@ func stakersForNodes(networkID uint32, nodes []*Node) ([]genesis.UnparsedStaker,
 		}
 	}
 
-	return initialStakers, nil
+	return validators, nil
 }
@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -163,6 +165,46 @@ func main() {
 	}
 	rootCmd.AddCommand(restartNetworkCmd)
 
+	var faucetConfig tmpnet.FaucetConfig
+	startFaucetCmd := &cobra.Command{
+		Use:   "start-faucet",
+		Short: "Start a faucet dispensing funds on a temporary network until interrupted",
+		RunE: func(*cobra.Command, []string) error {
+			if len(networkDir) == 0 {
+				return errNetworkDirRequired
+			}
+			log, err := tests.LoggerForFormat("", rawLogFormat)
+			if err != nil {
+				return err
+			}
+			network, err := tmpnet.ReadNetwork(networkDir)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			faucet, err := tmpnet.NewFaucet(ctx, log, network, faucetConfig)
+			if err != nil {
+				return err
+			}
+			if err := faucet.Start(); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stdout, "Started faucet at %s for network configured at: %s\n", faucet.URI(), networkDir)
+
+			<-ctx.Done()
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), tmpnet.DefaultNetworkTimeout)
+			defer cancel()
+			return faucet.Stop(shutdownCtx)
+		},
+	}
+	startFaucetCmd.PersistentFlags().StringVar(&faucetConfig.ListenAddress, "listen-address", tmpnet.DefaultFaucetListenAddress, "The address the faucet should listen on")
+	startFaucetCmd.PersistentFlags().Uint64Var(&faucetConfig.MaxPerAddress, "max-per-address", tmpnet.DefaultFaucetMaxPerAddress, "The maximum nAVAX to dispense to an address on a single chain")
+	rootCmd.AddCommand(startFaucetCmd)
+
 	startCollectorsCmd := &cobra.Command{
 		Use:   "start-collectors",
 		Short: "Start log and metric collectors for local process-based nodes",
@@ -12,7 +12,9 @@ import (
 
 	"github.com/onsi/ginkgo/v2"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
 	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
 )
@@ -24,6 +26,7 @@ func TestUpgrade(t *testing.T) {
 var (
 	avalancheGoExecPath            string
 	avalancheGoExecPathToUpgradeTo string
+	referenceGenesisPath           string
 	startCollectors                bool
 	checkMonitoring                bool
 )
@@ -41,12 +44,40 @@ func init() {
 		"",
 		"avalanchego executable path to upgrade to",
 	)
+	flag.StringVar(
+		&referenceGenesisPath,
+		"reference-genesis-path",
+		"",
+		"[optional] genesis file that the allocations and C-chain genesis of the rehearsal network must match",
+	)
 	e2e.SetMonitoringFlags(
 		&startCollectors,
 		&checkMonitoring,
 	)
 }
 
+// checkGenesis requires the allocations and C-chain genesis of [unparsed] to
+// match those of the genesis at [referencePath]. The validators of a rehearsal
+// network are expected to differ.
+func checkGenesis(tc *e2e.GinkgoTestContext, unparsed *genesis.UnparsedConfig, referencePath string) {
+	require := require.New(tc)
+
+	reference, err := genesis.GetConfigFile(referencePath)
+	require.NoError(err)
+	config, err := unparsed.Parse()
+	require.NoError(err)
+
+	diff, err := genesis.Diff(reference, &config)
+	require.NoError(err)
+	tc.Log().Info("compared genesis to reference",
+		zap.String("referencePath", referencePath),
+		zap.Reflect("diff", diff),
+	)
+
+	require.Empty(diff.Allocations)
+	require.Empty(diff.ChainConfig)
+}
+
 var _ = ginkgo.Describe("[Upgrade]", func() {
 	tc := e2e.NewTestContext()
 	require := require.New(tc)
@@ -59,6 +90,11 @@ var _ = ginkgo.Describe("[Upgrade]", func() {
 		require.NoError(err)
 		network.Genesis = genesis
 
+		if referenceGenesisPath != "" {
+			tc.By(fmt.Sprintf("checking that the genesis matches %q", referenceGenesisPath))
+			checkGenesis(tc, network.Genesis, referenceGenesisPath)
+		}
+
 		shutdownDelay := 0 * time.Second
 		if startCollectors {
 			require.NoError(tmpnet.StartCollectors(tc.DefaultContext(), tc.Log()))
@@ -71,3 +71,38 @@ func SetTimesTo(c *upgrade.Config, fork Fork, upgradeTime time.Time) {
 		c.ApricotPhase1Time = upgradeTime
 	}
 }
+
+// GetActivationTime returns the time at which the provided fork is scheduled to
+// be activated in the provided config.
+func GetActivationTime(c upgrade.Config, fork Fork) time.Time {
+	switch fork {
+	case Fortuna:
+		return c.FortunaTime
+	case Etna:
+		return c.EtnaTime
+	case Durango:
+		return c.DurangoTime
+	case Cortina:
+		return c.CortinaTime
+	case Banff:
+		return c.BanffTime
+	case ApricotPhasePost6:
+		return c.ApricotPhasePost6Time
+	case ApricotPhase6:
+		return c.ApricotPhase6Time
+	case ApricotPhasePre6:
+		return c.ApricotPhasePre6Time
+	case ApricotPhase5:
+		return c.ApricotPhase5Time
+	case ApricotPhase4:
+		return c.ApricotPhase4Time
+	case ApricotPhase3:
+		return c.ApricotPhase3Time
+	case ApricotPhase2:
+		return c.ApricotPhase2Time
+	case ApricotPhase1:
+		return c.ApricotPhase1Time
+	default:
+		return upgrade.InitiallyActiveTime
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package clockskew
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api/health"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/linked"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+const (
+	ntpQueryTimeout = 10 * time.Second
+
+	// maxPeerSamples is the maximum number of peers whose offsets are tracked.
+	maxPeerSamples = 1024
+	// minPeerSamples is the minimum number of peers whose offsets must be
+	// known before the median offset is compared against the maximum skew.
+	minPeerSamples = 5
+)
+
+var (
+	// DefaultNTPServers are the NTP servers queried if none are provided.
+	DefaultNTPServers = []string{
+		"time.cloudflare.com:123",
+		"pool.ntp.org:123",
+	}
+
+	_ health.Checker = (*Monitor)(nil)
+
+	errClockSkew = errors.New("clock skew exceeds maximum")
+)
+
+type Config struct {
+	// NTPServers are queried, as host:port, to measure the offset of the local
+	// clock. If empty, the offset is only measured against peers.
+	NTPServers []string `json:"ntpServers"`
+	// NTPFrequency is how often the NTP servers are queried.
+	NTPFrequency time.Duration `json:"ntpFrequency"`
+	// MaxSkew is the maximum offset of the local clock before the node is
+	// reported as unhealthy. It should be well below the proposer window
+	// duration so that operators are warned before blocks are missed.
+	MaxSkew time.Duration `json:"maxSkew"`
+}
+
+// Report describes the measured offsets of the local clock. Offsets are
+// positive if the local clock is behind.
+type Report struct {
+	// PeerOffset is the median offset reported by the peers we recently
+	// completed a handshake with.
+	PeerOffset   time.Duration `json:"peerOffset"`
+	NumPeers     int           `json:"numPeers"`
+	NTP          *NTPResult    `json:"ntp,omitempty"`
+	LastNTPQuery time.Time     `json:"lastNTPQuery"`
+	NTPError     string        `json:"ntpError,omitempty"`
+}
+
+// Monitor measures the offset of the local clock against peers and NTP
+// servers.
+//
+// Dispatch() and Stop() should only be called once.
+type Monitor struct {
+	log    logging.Logger
+	config Config
+
+	peerOffsetMetric  prometheus.Gauge
+	ntpOffsetMetric   prometheus.Gauge
+	ntpRTTMetric      prometheus.Gauge
+	ntpFailuresMetric prometheus.Counter
+
+	lock sync.Mutex
+	// peerOffsets is ordered by the time each peer's offset was last
+	// reported.
+	peerOffsets *linked.Hashmap[ids.NodeID, time.Duration]
+	peerOffset  time.Duration
+	ntpResult   *NTPResult
+	lastNTP     time.Time
+	ntpErr      error
+	// skewed is true if the last measured offset exceeded the maximum skew.
+	skewed bool
+
+	// Cancelling causes Dispatch() to eventually return.
+	rootCtx       context.Context
+	rootCtxCancel context.CancelFunc
+	// Closed when Dispatch() has returned.
+	doneChan chan struct{}
+}
+
+func NewMonitor(
+	log logging.Logger,
+	config Config,
+	registerer prometheus.Registerer,
+) (*Monitor, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Monitor{
+		log:    log,
+		config: config,
+		peerOffsetMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "peer_offset",
+			Help: "median offset, in seconds, of the local clock reported by peers",
+		}),
+		ntpOffsetMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "ntp_offset",
+			Help: "offset, in seconds, of the local clock reported by NTP",
+		}),
+		ntpRTTMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "ntp_rtt",
+			Help: "round trip time, in seconds, of the last NTP query",
+		}),
+		ntpFailuresMetric: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "ntp_failures",
+			Help: "number of times no NTP server responded",
+		}),
+		peerOffsets:   linked.NewHashmap[ids.NodeID, time.Duration](),
+		rootCtx:       ctx,
+		rootCtxCancel: cancel,
+		doneChan:      make(chan struct{}),
+	}
+	err := errors.Join(
+		registerer.Register(m.peerOffsetMetric),
+		registerer.Register(m.ntpOffsetMetric),
+		registerer.Register(m.ntpRTTMetric),
+		registerer.Register(m.ntpFailuresMetric),
+	)
+	return m, err
+}
+
+// ObservePeer records that [nodeID] reported a time that is [offset] ahead of
+// the local clock.
+func (m *Monitor) ObservePeer(nodeID ids.NodeID, offset time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.peerOffsets.Put(nodeID, offset)
+	if m.peerOffsets.Len() > maxPeerSamples {
+		oldest, _, _ := m.peerOffsets.Oldest()
+		m.peerOffsets.Delete(oldest)
+	}
+
+	offsets := make([]time.Duration, 0, m.peerOffsets.Len())
+	it := m.peerOffsets.NewIterator()
+	for it.Next() {
+		offsets = append(offsets, it.Value())
+	}
+	slices.Sort(offsets)
+	m.peerOffset = offsets[len(offsets)/2]
+	m.peerOffsetMetric.Set(m.peerOffset.Seconds())
+	m.checkSkew()
+}
+
+// Dispatch periodically queries the NTP servers. Doesn't return until after
+// Stop() is called. Should be called in a goroutine.
+func (m *Monitor) Dispatch() {
+	defer close(m.doneChan)
+
+	if len(m.config.NTPServers) == 0 {
+		<-m.rootCtx.Done()
+		return
+	}
+
+	ticker := time.NewTicker(m.config.NTPFrequency)
+	defer ticker.Stop()
+
+	for {
+		m.queryNTP()
+
+		select {
+		case <-ticker.C:
+		case <-m.rootCtx.Done():
+			return
+		}
+	}
+}
+
+// Stop querying the NTP servers.
+func (m *Monitor) Stop() {
+	m.rootCtxCancel()
+	<-m.doneChan
+}
+
+func (m *Monitor) queryNTP() {
+	ctx, cancel := context.WithTimeout(m.rootCtx, ntpQueryTimeout)
+	result, err := queryNTPServers(ctx, m.config.NTPServers)
+	cancel()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.lastNTP = time.Now()
+	m.ntpErr = err
+	if err != nil {
+		// Fall back to the peer offsets rather than relying on an outdated
+		// NTP measurement.
+		m.ntpResult = nil
+		m.ntpFailuresMetric.Inc()
+		m.log.Debug("failed to query NTP servers",
+			zap.Error(err),
+		)
+		m.checkSkew()
+		return
+	}
+
+	m.ntpResult = &result
+	m.ntpOffsetMetric.Set(result.Offset.Seconds())
+	m.ntpRTTMetric.Set(result.RTT.Seconds())
+	m.checkSkew()
+}
+
+// skew returns an error if a measured offset exceeds the maximum skew.
+//
+// Assumes [m.lock] is held.
+func (m *Monitor) skew() error {
+	// NTP measurements are far more precise than the offsets reported by
+	// peers, so they are preferred when available.
+	if m.ntpResult != nil {
+		if offset := m.ntpResult.Offset; offset.Abs() > m.config.MaxSkew {
+			return fmt.Errorf("%w: NTP offset %s exceeds %s", errClockSkew, offset, m.config.MaxSkew)
+		}
+		return nil
+	}
+	if m.peerOffsets.Len() < minPeerSamples {
+		return nil
+	}
+	if m.peerOffset.Abs() > m.config.MaxSkew {
+		return fmt.Errorf("%w: median peer offset %s exceeds %s", errClockSkew, m.peerOffset, m.config.MaxSkew)
+	}
+	return nil
+}
+
+// checkSkew logs when the measured offset starts or stops exceeding the
+// maximum skew.
+//
+// Assumes [m.lock] is held.
+func (m *Monitor) checkSkew() {
+	err := m.skew()
+	skewed := err != nil
+	switch {
+	case skewed && !m.skewed:
+		m.log.Warn("local clock is skewed. Blocks proposed by this node may be rejected or missed. Make sure the system clock is synchronized",
+			zap.Error(err),
+		)
+	case !skewed && m.skewed:
+		m.log.Info("local clock is no longer skewed")
+	}
+	m.skewed = skewed
+}
+
+func (m *Monitor) HealthCheck(context.Context) (interface{}, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	report := Report{
+		PeerOffset:   m.peerOffset,
+		NumPeers:     m.peerOffsets.Len(),
+		NTP:          m.ntpResult,
+		LastNTPQuery: m.lastNTP,
+	}
+	if m.ntpErr != nil {
+		report.NTPError = m.ntpErr.Error()
+	}
+	return report, m.skew()
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package clockskew
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func newMonitor(t *testing.T, config Config) *Monitor {
+	t.Helper()
+
+	m, err := NewMonitor(logging.NoLog{}, config, prometheus.NewRegistry())
+	require.NoError(t, err)
+	return m
+}
+
+func TestMonitorPeerOffsets(t *testing.T) {
+	require := require.New(t)
+
+	m := newMonitor(t, Config{
+		MaxSkew: 2 * time.Second,
+	})
+
+	// Too few peers are known to report the clock as skewed.
+	nodeIDs := make([]ids.NodeID, minPeerSamples)
+	for i := range nodeIDs {
+		nodeIDs[i] = ids.GenerateTestNodeID()
+	}
+	for _, nodeID := range nodeIDs[:minPeerSamples-1] {
+		m.ObservePeer(nodeID, 5*time.Second)
+	}
+	report, err := m.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Equal(minPeerSamples-1, report.(Report).NumPeers)
+
+	// The median offset exceeds the maximum skew.
+	m.ObservePeer(nodeIDs[minPeerSamples-1], 0)
+	_, err = m.HealthCheck(context.Background())
+	require.ErrorIs(err, errClockSkew)
+
+	// Only the latest offset of each peer is used.
+	for _, nodeID := range nodeIDs[:minPeerSamples/2+1] {
+		m.ObservePeer(nodeID, -time.Second)
+	}
+	report, err = m.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Equal(Report{
+		PeerOffset: -time.Second,
+		NumPeers:   minPeerSamples,
+	}, report)
+}
+
+func TestMonitorMaxPeerSamples(t *testing.T) {
+	require := require.New(t)
+
+	m := newMonitor(t, Config{
+		MaxSkew: 2 * time.Second,
+	})
+	for range maxPeerSamples {
+		m.ObservePeer(ids.GenerateTestNodeID(), 5*time.Second)
+	}
+	_, err := m.HealthCheck(context.Background())
+	require.ErrorIs(err, errClockSkew)
+
+	// The oldest offsets are evicted.
+	for range maxPeerSamples/2 + 1 {
+		m.ObservePeer(ids.GenerateTestNodeID(), 0)
+	}
+	report, err := m.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Equal(maxPeerSamples, report.(Report).NumPeers)
+}
+
+func TestMonitorNTP(t *testing.T) {
+	require := require.New(t)
+
+	m := newMonitor(t, Config{
+		NTPServers:   []string{startNTPServer(t, 3*time.Second)},
+		NTPFrequency: time.Hour,
+		MaxSkew:      2 * time.Second,
+	})
+
+	// NTP is preferred over the offsets reported by peers.
+	for range minPeerSamples {
+		m.ObservePeer(ids.GenerateTestNodeID(), 0)
+	}
+	m.queryNTP()
+	report, err := m.HealthCheck(context.Background())
+	require.ErrorIs(err, errClockSkew)
+	require.NotNil(report.(Report).NTP)
+
+	// Peers are used if the NTP servers stop responding.
+	m.config.NTPServers = []string{startUnresponsiveServer(t)}
+	m.rootCtxCancel()
+	m.queryNTP()
+	report, err = m.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Nil(report.(Report).NTP)
+	require.NotEmpty(report.(Report).NTPError)
+}
+
+func TestMonitorDispatch(t *testing.T) {
+	m := newMonitor(t, Config{
+		NTPServers:   []string{startNTPServer(t, 0)},
+		NTPFrequency: time.Millisecond,
+		MaxSkew:      2 * time.Second,
+	})
+	go m.Dispatch()
+
+	require.Eventually(t, func() bool {
+		report, err := m.HealthCheck(context.Background())
+		return err == nil && report.(Report).NTP != nil
+	}, 5*time.Second, time.Millisecond)
+	m.Stop()
+}
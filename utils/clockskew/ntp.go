@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package clockskew
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// See RFC 4330 for the SNTP message format.
+	ntpMessageLen           = 48
+	ntpVersion              = 4
+	ntpModeClient           = 3
+	ntpModeServer           = 4
+	ntpLeapUnsynchronized   = 3
+	ntpOriginTimestampOff   = 24
+	ntpReceiveTimestampOff  = 32
+	ntpTransmitTimestampOff = 40
+
+	// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and
+	// the Unix epoch (1970).
+	ntpEpochOffset = 2_208_988_800
+)
+
+var (
+	errNoNTPResponse           = errors.New("no NTP server responded")
+	errMalformedNTPResponse    = errors.New("malformed NTP response")
+	errUnexpectedNTPResponse   = errors.New("unexpected NTP response")
+	errUnsynchronizedNTPServer = errors.New("NTP server is unsynchronized")
+)
+
+// NTPResult is the result of querying an NTP server.
+type NTPResult struct {
+	Server string `json:"server"`
+	// Offset is the time of the server minus our local time.
+	Offset time.Duration `json:"offset"`
+	// RTT is the round trip time of the query, excluding the time spent by the
+	// server processing it.
+	RTT time.Duration `json:"rtt"`
+}
+
+// queryNTPServers queries all of [servers] and returns the result with the
+// lowest round trip time, as its offset is the most accurate.
+func queryNTPServers(ctx context.Context, servers []string) (NTPResult, error) {
+	type result struct {
+		result NTPResult
+		err    error
+	}
+	results := make(chan result, len(servers))
+	for _, server := range servers {
+		go func() {
+			r, err := queryNTP(ctx, server)
+			if err != nil {
+				err = fmt.Errorf("%s: %w", server, err)
+			}
+			results <- result{
+				result: r,
+				err:    err,
+			}
+		}()
+	}
+
+	var (
+		best    NTPResult
+		success bool
+		errs    []error
+	)
+	for range servers {
+		r := <-results
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		if !success || r.result.RTT < best.RTT {
+			best = r.result
+			success = true
+		}
+	}
+	if !success {
+		return NTPResult{}, fmt.Errorf("%w: %w", errNoNTPResponse, errors.Join(errs...))
+	}
+	return best, nil
+}
+
+// queryNTP sends an SNTP request to [server] and returns the offset of our
+// local clock from the server's clock.
+func queryNTP(ctx context.Context, server string) (NTPResult, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", server)
+	if err != nil {
+		return NTPResult{}, err
+	}
+	defer conn.Close()
+
+	// Unblock the read below once the context is cancelled.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	// The transmit timestamp of the request is only echoed back by the server,
+	// so a random value is sent to avoid revealing our local time.
+	var nonce [8]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return NTPResult{}, err
+	}
+	request := make([]byte, ntpMessageLen)
+	request[0] = ntpVersion<<3 | ntpModeClient
+	copy(request[ntpTransmitTimestampOff:], nonce[:])
+
+	sent := time.Now()
+	if _, err := conn.Write(request); err != nil {
+		return NTPResult{}, err
+	}
+
+	response := make([]byte, ntpMessageLen)
+	for {
+		n, err := conn.Read(response)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return NTPResult{}, ctxErr
+			}
+			return NTPResult{}, err
+		}
+		received := time.Now()
+
+		result, err := parseNTPResponse(response[:n], nonce, sent, received)
+		if errors.Is(err, errUnexpectedNTPResponse) {
+			// The response isn't to our request, keep waiting.
+			continue
+		}
+		result.Server = server
+		return result, err
+	}
+}
+
+// parseNTPResponse returns the offset and round trip time reported by
+// [response], given that the request was [sent] and the response [received].
+func parseNTPResponse(response []byte, nonce [8]byte, sent, received time.Time) (NTPResult, error) {
+	if len(response) < ntpMessageLen {
+		return NTPResult{}, fmt.Errorf("%w: length %d", errMalformedNTPResponse, len(response))
+	}
+	if mode := response[0] & 0x07; mode != ntpModeServer {
+		return NTPResult{}, fmt.Errorf("%w: mode %d", errUnexpectedNTPResponse, mode)
+	}
+	if [8]byte(response[ntpOriginTimestampOff:ntpReceiveTimestampOff]) != nonce {
+		return NTPResult{}, fmt.Errorf("%w: origin timestamp mismatch", errUnexpectedNTPResponse)
+	}
+	// A stratum of 0 is a kiss-o'-death message, which asks clients to back
+	// off.
+	if leap, stratum := response[0]>>6, response[1]; leap == ntpLeapUnsynchronized || stratum == 0 {
+		return NTPResult{}, fmt.Errorf("%w: leap %d stratum %d", errUnsynchronizedNTPServer, leap, stratum)
+	}
+
+	serverReceived := parseNTPTime(response[ntpReceiveTimestampOff:])
+	serverSent := parseNTPTime(response[ntpTransmitTimestampOff:])
+	rtt := received.Sub(sent) - serverSent.Sub(serverReceived)
+	if rtt < 0 {
+		return NTPResult{}, fmt.Errorf("%w: negative round trip time %s", errMalformedNTPResponse, rtt)
+	}
+	return NTPResult{
+		Offset: (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2,
+		RTT:    rtt,
+	}, nil
+}
+
+func parseNTPTime(b []byte) time.Time {
+	seconds := binary.BigEndian.Uint32(b)
+	fraction := binary.BigEndian.Uint32(b[4:])
+	nanoseconds := (uint64(fraction) * uint64(time.Second)) >> 32
+	return time.Unix(int64(seconds)-ntpEpochOffset, int64(nanoseconds))
+}
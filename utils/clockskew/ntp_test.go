@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package clockskew
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func putNTPTime(b []byte, t time.Time) {
+	seconds := uint32(t.Unix() + ntpEpochOffset)
+	fraction := uint32((uint64(t.Nanosecond()) << 32) / uint64(time.Second))
+	binary.BigEndian.PutUint32(b, seconds)
+	binary.BigEndian.PutUint32(b[4:], fraction)
+}
+
+// newNTPResponse returns a response to a request whose transmit timestamp was
+// [nonce], that was received by the server at [received] and sent at [sent].
+func newNTPResponse(nonce [8]byte, stratum byte, received, sent time.Time) []byte {
+	response := make([]byte, ntpMessageLen)
+	response[0] = ntpVersion<<3 | ntpModeServer
+	response[1] = stratum
+	copy(response[ntpOriginTimestampOff:], nonce[:])
+	putNTPTime(response[ntpReceiveTimestampOff:], received)
+	putNTPTime(response[ntpTransmitTimestampOff:], sent)
+	return response
+}
+
+// startNTPServer starts an NTP server whose clock is [offset] ahead of the
+// local clock and returns the address it listens on.
+func startNTPServer(t *testing.T, offset time.Duration) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	go func() {
+		request := make([]byte, ntpMessageLen)
+		for {
+			n, from, err := conn.ReadFrom(request)
+			if err != nil {
+				return
+			}
+			if n < ntpMessageLen {
+				continue
+			}
+			now := time.Now().Add(offset)
+			nonce := [8]byte(request[ntpTransmitTimestampOff:])
+			_, _ = conn.WriteTo(newNTPResponse(nonce, 1, now, now), from)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestParseNTPResponse(t *testing.T) {
+	var (
+		nonce    = [8]byte{1, 2, 3, 4, 5, 6, 7, 8}
+		sent     = time.Unix(1_700_000_000, 0)
+		received = sent.Add(625 * time.Millisecond)
+		// The server's clock is 2s ahead, each way took 250ms and the server
+		// took 125ms to respond. These durations are exact NTP timestamps.
+		serverReceived = sent.Add(2*time.Second + 250*time.Millisecond)
+		serverSent     = serverReceived.Add(125 * time.Millisecond)
+	)
+	tests := []struct {
+		name           string
+		response       []byte
+		expectedResult NTPResult
+		expectedErr    error
+	}{
+		{
+			name:     "valid",
+			response: newNTPResponse(nonce, 2, serverReceived, serverSent),
+			expectedResult: NTPResult{
+				Offset: 2 * time.Second,
+				RTT:    500 * time.Millisecond,
+			},
+		},
+		{
+			name:        "too short",
+			response:    make([]byte, ntpMessageLen-1),
+			expectedErr: errMalformedNTPResponse,
+		},
+		{
+			name: "not a server response",
+			response: func() []byte {
+				response := newNTPResponse(nonce, 2, serverReceived, serverSent)
+				response[0] = ntpVersion<<3 | ntpModeClient
+				return response
+			}(),
+			expectedErr: errUnexpectedNTPResponse,
+		},
+		{
+			name:        "wrong origin timestamp",
+			response:    newNTPResponse([8]byte{}, 2, serverReceived, serverSent),
+			expectedErr: errUnexpectedNTPResponse,
+		},
+		{
+			name:        "kiss-o'-death",
+			response:    newNTPResponse(nonce, 0, serverReceived, serverSent),
+			expectedErr: errUnsynchronizedNTPServer,
+		},
+		{
+			name: "unsynchronized",
+			response: func() []byte {
+				response := newNTPResponse(nonce, 2, serverReceived, serverSent)
+				response[0] |= ntpLeapUnsynchronized << 6
+				return response
+			}(),
+			expectedErr: errUnsynchronizedNTPServer,
+		},
+		{
+			name:        "negative round trip time",
+			response:    newNTPResponse(nonce, 2, serverReceived, serverReceived.Add(time.Second)),
+			expectedErr: errMalformedNTPResponse,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			result, err := parseNTPResponse(test.response, nonce, sent, received)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedResult, result)
+		})
+	}
+}
+
+func TestQueryNTPServers(t *testing.T) {
+	require := require.New(t)
+
+	const offset = 3 * time.Second
+	var (
+		server             = startNTPServer(t, offset)
+		unresponsiveServer = startUnresponsiveServer(t)
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	result, err := queryNTPServers(ctx, []string{unresponsiveServer, server})
+	require.NoError(err)
+	require.Equal(server, result.Server)
+	require.InDelta(offset.Seconds(), result.Offset.Seconds(), 0.1)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = queryNTPServers(ctx, []string{unresponsiveServer})
+	require.ErrorIs(err, errNoNTPResponse)
+}
+
+// startUnresponsiveServer returns the address of a UDP socket that never
+// responds.
+func startUnresponsiveServer(t *testing.T) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return conn.LocalAddr().String()
+}
@@ -8,5 +8,9 @@ package compression
 // Decompress(Compress(msg)) == msg.
 type Compressor interface {
 	Compress([]byte) ([]byte, error)
+	// CompressInto compresses msg into dst if dst has enough capacity, so
+	// that the buffer can be reused. Otherwise, a new buffer is allocated. The
+	// returned bytes may alias dst.
+	CompressInto(dst []byte, msg []byte) ([]byte, error)
 	Decompress([]byte) ([]byte, error)
 }
@@ -107,6 +107,37 @@ func TestCompressDecompress(t *testing.T) {
 	}
 }
 
+func TestCompressInto(t *testing.T) {
+	for compressionType, newCompressorFunc := range newCompressorFuncs {
+		t.Run(compressionType.String(), func(t *testing.T) {
+			require := require.New(t)
+
+			compressor, err := newCompressorFunc(maxMessageSize)
+			require.NoError(err)
+
+			data := utils.RandomBytes(4096)
+			expected, err := compressor.Compress(data)
+			require.NoError(err)
+
+			// A buffer that is too small is replaced.
+			compressed, err := compressor.CompressInto(nil, data)
+			require.NoError(err)
+			require.Equal(expected, compressed)
+
+			// A buffer that is large enough is reused.
+			dst := make([]byte, 2*len(data))
+			compressed, err = compressor.CompressInto(dst, data)
+			require.NoError(err)
+			require.Equal(expected, compressed)
+			require.Equal(cap(dst), cap(compressed))
+
+			decompressed, err := compressor.Decompress(compressed)
+			require.NoError(err)
+			require.Equal(data, decompressed)
+		})
+	}
+}
+
 func TestSizeLimiting(t *testing.T) {
 	for compressionType, compressorFunc := range newCompressorFuncs {
 		if compressionType == TypeNone {
@@ -121,6 +152,8 @@ func TestSizeLimiting(t *testing.T) {
 			data := make([]byte, maxMessageSize+1)
 			_, err = compressor.Compress(data) // should be too large
 			require.ErrorIs(err, ErrMsgTooLarge)
+			_, err = compressor.CompressInto(nil, data) // should be too large
+			require.ErrorIs(err, ErrMsgTooLarge)
 
 			compressor2, err := compressorFunc(2 * maxMessageSize)
 			require.NoError(err)
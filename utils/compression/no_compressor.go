@@ -11,6 +11,10 @@ func (*noCompressor) Compress(msg []byte) ([]byte, error) {
 	return msg, nil
 }
 
+func (*noCompressor) CompressInto(dst []byte, msg []byte) ([]byte, error) {
+	return append(dst[:0], msg...), nil
+}
+
 func (*noCompressor) Decompress(msg []byte) ([]byte, error) {
 	return msg, nil
 }
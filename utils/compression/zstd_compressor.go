@@ -46,6 +46,13 @@ func (z *zstdCompressor) Compress(msg []byte) ([]byte, error) {
 	return zstd.Compress(nil, msg)
 }
 
+func (z *zstdCompressor) CompressInto(dst []byte, msg []byte) ([]byte, error) {
+	if int64(len(msg)) > z.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrMsgTooLarge, len(msg), z.maxSize)
+	}
+	return zstd.Compress(dst, msg)
+}
+
 func (z *zstdCompressor) Decompress(msg []byte) ([]byte, error) {
 	reader := zstd.NewReader(bytes.NewReader(msg))
 	defer reader.Close()
@@ -68,9 +68,13 @@ const (
 	DefaultBenchlistMinFailingDuration = 2*time.Minute + 30*time.Second
 
 	// Router
-	DefaultConsensusAppConcurrency  = 2
-	DefaultConsensusShutdownTimeout = time.Minute
-	DefaultFrontierPollFrequency    = 100 * time.Millisecond
+	DefaultConsensusAppConcurrency           = 2
+	DefaultConsensusMaxUnprocessedMsgs       = 4096
+	DefaultConsensusShutdownTimeout          = time.Minute
+	DefaultConsensusBlockVerificationTimeout = time.Duration(0)
+	DefaultFrontierPollFrequency             = 100 * time.Millisecond
+	DefaultConsensusAppGossipDedupWindow     = 30 * time.Second
+	DefaultConsensusAppGossipDedupSize       = 16_384
 
 	// Inbound Throttling
 	DefaultInboundThrottlerAtLargeAllocSize         = 6 * units.MiB
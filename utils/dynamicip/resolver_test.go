@@ -32,6 +32,10 @@ func TestNewResolver(t *testing.T) {
 			service: IFConfigMeName,
 			err:     nil,
 		},
+		{
+			service: STUNName,
+			err:     nil,
+		},
 		{
 			service: strings.ToUpper(IFConfigMeName),
 			err:     nil,
@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dynamicip
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/ava-labs/avalanchego/utils/ips"
+)
+
+const (
+	// See RFC 5389 for the STUN message format.
+	stunHeaderLen            = 20
+	stunTransactionIDLen     = 12
+	stunMaxMessageLen        = 1280
+	stunMagicCookie          = 0x2112A442
+	stunBindingRequest       = 0x0001
+	stunBindingSuccess       = 0x0101
+	stunAttrMappedAddress    = 0x0001
+	stunAttrXORMappedAddress = 0x0020
+	stunFamilyIPv4           = 0x01
+	stunFamilyIPv6           = 0x02
+)
+
+var (
+	// DefaultSTUNServers are the STUN servers used to resolve our public IP if
+	// none are provided.
+	DefaultSTUNServers = []string{
+		"stun.l.google.com:19302",
+		"stun1.l.google.com:19302",
+		"stun.cloudflare.com:3478",
+	}
+
+	errNoSTUNServers          = errors.New("no STUN servers")
+	errNoSTUNResponse         = errors.New("no STUN server responded")
+	errSTUNServersDisagree    = errors.New("STUN servers disagree on the public IP")
+	errMalformedSTUNResponse  = errors.New("malformed STUN response")
+	errUnexpectedSTUNResponse = errors.New("unexpected STUN response")
+	errNoSTUNMappedAddress    = errors.New("STUN response has no mapped address")
+
+	_ Resolver = (*stunResolver)(nil)
+)
+
+// stunResolver resolves our public IP by sending STUN binding requests to
+// multiple servers. The IP reported by a majority of the servers that
+// responded is returned, so a single faulty server can't change our IP.
+type stunResolver struct {
+	servers []string
+}
+
+// NewSTUNResolver returns a Resolver that uses the provided STUN servers,
+// formatted as host:port.
+func NewSTUNResolver(servers []string) (Resolver, error) {
+	if len(servers) == 0 {
+		return nil, errNoSTUNServers
+	}
+	return &stunResolver{
+		servers: servers,
+	}, nil
+}
+
+func (r *stunResolver) Resolve(ctx context.Context) (netip.Addr, error) {
+	type result struct {
+		addr netip.Addr
+		err  error
+	}
+	results := make(chan result, len(r.servers))
+	for _, server := range r.servers {
+		go func() {
+			addr, err := querySTUN(ctx, server)
+			if err != nil {
+				err = fmt.Errorf("%s: %w", server, err)
+			}
+			results <- result{
+				addr: addr,
+				err:  err,
+			}
+		}()
+	}
+
+	var (
+		votes        = make(map[netip.Addr]int)
+		numResponses int
+		errs         []error
+	)
+	for range r.servers {
+		result := <-results
+		if result.err != nil {
+			errs = append(errs, result.err)
+			continue
+		}
+		votes[result.addr]++
+		numResponses++
+	}
+	if numResponses == 0 {
+		return netip.Addr{}, fmt.Errorf("%w: %w", errNoSTUNResponse, errors.Join(errs...))
+	}
+
+	var (
+		addr     netip.Addr
+		maxVotes int
+	)
+	for candidate, numVotes := range votes {
+		if numVotes > maxVotes {
+			addr = candidate
+			maxVotes = numVotes
+		}
+	}
+	if 2*maxVotes <= numResponses {
+		return netip.Addr{}, fmt.Errorf("%w: %d of %d responses agreed", errSTUNServersDisagree, maxVotes, numResponses)
+	}
+	return addr, nil
+}
+
+// querySTUN sends a binding request to [server] and returns the address that
+// the server observed the request from.
+func querySTUN(ctx context.Context, server string) (netip.Addr, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", server)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	defer conn.Close()
+
+	// Unblock the read below once the context is cancelled.
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.Close()
+	})
+	defer stop()
+
+	var transactionID [stunTransactionIDLen]byte
+	if _, err := rand.Read(transactionID[:]); err != nil {
+		return netip.Addr{}, err
+	}
+	if _, err := conn.Write(newSTUNBindingRequest(transactionID)); err != nil {
+		return netip.Addr{}, err
+	}
+
+	response := make([]byte, stunMaxMessageLen)
+	for {
+		n, err := conn.Read(response)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return netip.Addr{}, ctxErr
+			}
+			return netip.Addr{}, err
+		}
+
+		addr, err := parseSTUNBindingResponse(response[:n], transactionID)
+		// Ignore stray responses to other requests.
+		if errors.Is(err, errUnexpectedSTUNResponse) {
+			continue
+		}
+		return addr, err
+	}
+}
+
+func newSTUNBindingRequest(transactionID [stunTransactionIDLen]byte) []byte {
+	request := make([]byte, stunHeaderLen)
+	binary.BigEndian.PutUint16(request[0:], stunBindingRequest)
+	binary.BigEndian.PutUint16(request[2:], 0) // No attributes
+	binary.BigEndian.PutUint32(request[4:], stunMagicCookie)
+	copy(request[8:], transactionID[:])
+	return request
+}
+
+// parseSTUNBindingResponse returns the mapped address in [response]. The
+// XOR-MAPPED-ADDRESS attribute is preferred over the MAPPED-ADDRESS attribute,
+// which is only sent by servers that predate RFC 5389.
+func parseSTUNBindingResponse(response []byte, transactionID [stunTransactionIDLen]byte) (netip.Addr, error) {
+	if len(response) < stunHeaderLen {
+		return netip.Addr{}, fmt.Errorf("%w: length %d", errMalformedSTUNResponse, len(response))
+	}
+	var (
+		msgType     = binary.BigEndian.Uint16(response[0:])
+		msgLen      = int(binary.BigEndian.Uint16(response[2:]))
+		magicCookie = binary.BigEndian.Uint32(response[4:])
+	)
+	if msgType != stunBindingSuccess ||
+		magicCookie != stunMagicCookie ||
+		[stunTransactionIDLen]byte(response[8:stunHeaderLen]) != transactionID {
+		return netip.Addr{}, errUnexpectedSTUNResponse
+	}
+	if stunHeaderLen+msgLen > len(response) {
+		return netip.Addr{}, fmt.Errorf("%w: attributes length %d exceeds message", errMalformedSTUNResponse, msgLen)
+	}
+
+	var (
+		attributes = response[stunHeaderLen : stunHeaderLen+msgLen]
+		mapped     netip.Addr
+	)
+	for len(attributes) >= 4 {
+		attrType := binary.BigEndian.Uint16(attributes[0:])
+		attrLen := int(binary.BigEndian.Uint16(attributes[2:]))
+		// Attribute values are padded to a multiple of 4 bytes.
+		paddedLen := (attrLen + 3) &^ 3
+		if 4+paddedLen > len(attributes) {
+			return netip.Addr{}, fmt.Errorf("%w: attribute length %d exceeds message", errMalformedSTUNResponse, attrLen)
+		}
+		value := attributes[4 : 4+attrLen]
+		attributes = attributes[4+paddedLen:]
+
+		switch attrType {
+		case stunAttrXORMappedAddress:
+			var mask [net.IPv6len]byte
+			binary.BigEndian.PutUint32(mask[:], stunMagicCookie)
+			copy(mask[4:], transactionID[:])
+			return parseSTUNAddress(value, mask[:])
+		case stunAttrMappedAddress:
+			addr, err := parseSTUNAddress(value, nil)
+			if err != nil {
+				return netip.Addr{}, err
+			}
+			mapped = addr
+		}
+	}
+	if !mapped.IsValid() {
+		return netip.Addr{}, errNoSTUNMappedAddress
+	}
+	return mapped, nil
+}
+
+// parseSTUNAddress parses the address of a (XOR-)MAPPED-ADDRESS attribute. If
+// [mask] is provided, the address is XORed with it.
+func parseSTUNAddress(value []byte, mask []byte) (netip.Addr, error) {
+	if len(value) < 4 {
+		return netip.Addr{}, fmt.Errorf("%w: address length %d", errMalformedSTUNResponse, len(value))
+	}
+
+	var addrLen int
+	switch family := value[1]; family {
+	case stunFamilyIPv4:
+		addrLen = net.IPv4len
+	case stunFamilyIPv6:
+		addrLen = net.IPv6len
+	default:
+		return netip.Addr{}, fmt.Errorf("%w: unknown address family %d", errMalformedSTUNResponse, family)
+	}
+	if len(value) != 4+addrLen {
+		return netip.Addr{}, fmt.Errorf("%w: address length %d", errMalformedSTUNResponse, len(value))
+	}
+
+	addrBytes := make([]byte, addrLen)
+	copy(addrBytes, value[4:])
+	if mask != nil {
+		for i := range addrBytes {
+			addrBytes[i] ^= mask[i]
+		}
+	}
+	addr, _ := ips.AddrFromSlice(addrBytes)
+	return addr, nil
+}
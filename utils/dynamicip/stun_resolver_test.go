@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dynamicip
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newSTUNBindingResponse returns a binding response for [transactionID] with a
+// single address attribute.
+func newSTUNBindingResponse(
+	transactionID [stunTransactionIDLen]byte,
+	attrType uint16,
+	addr netip.Addr,
+) []byte {
+	var (
+		family    byte = stunFamilyIPv4
+		addrBytes      = addr.AsSlice()
+	)
+	if addr.Is6() {
+		family = stunFamilyIPv6
+	}
+	if attrType == stunAttrXORMappedAddress {
+		var mask [net.IPv6len]byte
+		binary.BigEndian.PutUint32(mask[:], stunMagicCookie)
+		copy(mask[4:], transactionID[:])
+		for i := range addrBytes {
+			addrBytes[i] ^= mask[i]
+		}
+	}
+
+	value := append([]byte{0, family, 0, 0}, addrBytes...)
+	response := make([]byte, stunHeaderLen+4, stunHeaderLen+4+len(value))
+	binary.BigEndian.PutUint16(response[0:], stunBindingSuccess)
+	binary.BigEndian.PutUint16(response[2:], uint16(4+len(value)))
+	binary.BigEndian.PutUint32(response[4:], stunMagicCookie)
+	copy(response[8:], transactionID[:])
+	binary.BigEndian.PutUint16(response[stunHeaderLen:], attrType)
+	binary.BigEndian.PutUint16(response[stunHeaderLen+2:], uint16(len(value)))
+	return append(response, value...)
+}
+
+// startSTUNServer starts a STUN server that reports [addr] as the mapped
+// address of every request and returns the address it listens on.
+func startSTUNServer(t *testing.T, addr netip.Addr) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	go func() {
+		request := make([]byte, stunMaxMessageLen)
+		for {
+			n, from, err := conn.ReadFrom(request)
+			if err != nil {
+				return
+			}
+			if n < stunHeaderLen {
+				continue
+			}
+			transactionID := [stunTransactionIDLen]byte(request[8:stunHeaderLen])
+			response := newSTUNBindingResponse(transactionID, stunAttrXORMappedAddress, addr)
+			_, _ = conn.WriteTo(response, from)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestParseSTUNBindingResponse(t *testing.T) {
+	var (
+		transactionID = [stunTransactionIDLen]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+		ipv4          = netip.AddrFrom4([4]byte{1, 2, 3, 4})
+		ipv6          = netip.MustParseAddr("2001:db8::1")
+	)
+	tests := []struct {
+		name          string
+		response      []byte
+		expectedAddr  netip.Addr
+		expectedError error
+	}{
+		{
+			name:         "xor mapped ipv4",
+			response:     newSTUNBindingResponse(transactionID, stunAttrXORMappedAddress, ipv4),
+			expectedAddr: ipv4,
+		},
+		{
+			name:         "xor mapped ipv6",
+			response:     newSTUNBindingResponse(transactionID, stunAttrXORMappedAddress, ipv6),
+			expectedAddr: ipv6,
+		},
+		{
+			name:         "mapped ipv4",
+			response:     newSTUNBindingResponse(transactionID, stunAttrMappedAddress, ipv4),
+			expectedAddr: ipv4,
+		},
+		{
+			name:          "other transaction",
+			response:      newSTUNBindingResponse([stunTransactionIDLen]byte{}, stunAttrXORMappedAddress, ipv4),
+			expectedError: errUnexpectedSTUNResponse,
+		},
+		{
+			name:          "truncated",
+			response:      newSTUNBindingResponse(transactionID, stunAttrXORMappedAddress, ipv4)[:stunHeaderLen+4],
+			expectedError: errMalformedSTUNResponse,
+		},
+		{
+			name:          "no address",
+			response:      newSTUNBindingResponse(transactionID, 0x8022, ipv4),
+			expectedError: errNoSTUNMappedAddress,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			addr, err := parseSTUNBindingResponse(test.response, transactionID)
+			require.ErrorIs(err, test.expectedError)
+			require.Equal(test.expectedAddr, addr)
+		})
+	}
+}
+
+func TestSTUNResolver(t *testing.T) {
+	var (
+		addr0 = netip.AddrFrom4([4]byte{1, 2, 3, 4})
+		addr1 = netip.AddrFrom4([4]byte{5, 6, 7, 8})
+	)
+	tests := []struct {
+		name          string
+		serverAddrs   []netip.Addr
+		expectedAddr  netip.Addr
+		expectedError error
+	}{
+		{
+			name:         "single server",
+			serverAddrs:  []netip.Addr{addr0},
+			expectedAddr: addr0,
+		},
+		{
+			name:         "majority",
+			serverAddrs:  []netip.Addr{addr0, addr1, addr0},
+			expectedAddr: addr0,
+		},
+		{
+			name:          "no majority",
+			serverAddrs:   []netip.Addr{addr0, addr1},
+			expectedError: errSTUNServersDisagree,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			servers := make([]string, len(test.serverAddrs))
+			for i, addr := range test.serverAddrs {
+				servers[i] = startSTUNServer(t, addr)
+			}
+			resolver, err := NewSTUNResolver(servers)
+			require.NoError(err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			addr, err := resolver.Resolve(ctx)
+			require.ErrorIs(err, test.expectedError)
+			require.Equal(test.expectedAddr, addr)
+		})
+	}
+}
+
+func TestSTUNResolverNoResponse(t *testing.T) {
+	require := require.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	defer conn.Close()
+
+	resolver, err := NewSTUNResolver([]string{conn.LocalAddr().String()})
+	require.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = resolver.Resolve(ctx)
+	require.ErrorIs(err, errNoSTUNResponse)
+	require.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestNewSTUNResolverNoServers(t *testing.T) {
+	_, err := NewSTUNResolver(nil)
+	require.ErrorIs(t, err, errNoSTUNServers)
+}
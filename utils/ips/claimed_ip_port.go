@@ -16,7 +16,9 @@ import (
 const (
 	// Certificate length, signature length, IP, timestamp, tx ID
 	baseIPCertDescLen = 2*wrappers.IntLen + net.IPv6len + wrappers.ShortLen + wrappers.LongLen + ids.IDLen
-	preimageLen       = ids.IDLen + wrappers.LongLen
+	// Signature length, IP
+	secondaryIPDescLen = wrappers.IntLen + net.IPv6len + wrappers.ShortLen
+	preimageLen        = ids.IDLen + wrappers.LongLen
 )
 
 // A self contained proof that a peer is claiming ownership of an IPPort at a
@@ -33,6 +35,11 @@ type ClaimedIPPort struct {
 	// actually claimed by the peer in question, and not by a malicious peer
 	// trying to get us to dial bogus IPPorts.
 	Signature []byte
+	// The peer's optional claimed IP and port of the other address family than
+	// [AddrPort], claimed at [Timestamp].
+	SecondaryAddrPort netip.AddrPort
+	// [Cert]'s signature over the SecondaryAddrPort and timestamp.
+	SecondarySignature []byte
 	// NodeID derived from the peer certificate.
 	NodeID ids.NodeID
 	// GossipID derived from the nodeID and timestamp.
@@ -64,5 +71,9 @@ func NewClaimedIPPort(
 
 // Returns the approximate size of the binary representation of this ClaimedIPPort.
 func (i *ClaimedIPPort) Size() int {
-	return baseIPCertDescLen + len(i.Cert.Raw) + len(i.Signature)
+	size := baseIPCertDescLen + len(i.Cert.Raw) + len(i.Signature)
+	if i.SecondaryAddrPort.IsValid() {
+		size += secondaryIPDescLen + len(i.SecondarySignature)
+	}
+	return size
 }
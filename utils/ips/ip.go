@@ -55,3 +55,10 @@ func AddrFromSlice(b []byte) (netip.Addr, bool) {
 	}
 	return addr, true
 }
+
+// IsSameFamily returns true if the provided addresses are both IPv4 or both
+// IPv6 addresses. IPv4 addresses in IPv6 addresses are treated as IPv4
+// addresses.
+func IsSameFamily(a, b netip.Addr) bool {
+	return a.Unmap().Is4() == b.Unmap().Is4()
+}
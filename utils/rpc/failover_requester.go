@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// DefaultUnavailableDuration is how long an endpoint that failed a request is
+// only used if no other endpoint is available.
+const DefaultUnavailableDuration = 10 * time.Second
+
+var (
+	_ EndpointRequester = (*failoverEndpointRequester)(nil)
+
+	errNoEndpoints = errors.New("no endpoints")
+)
+
+type failoverEndpoint struct {
+	uri string
+	// The endpoint isn't available until this time
+	unavailableUntil time.Time
+}
+
+type failoverEndpointRequester struct {
+	writeMethods        set.Set[string]
+	unavailableDuration time.Duration
+	clock               mockable.Clock
+
+	// Sends the request to the uri. Replaced in tests.
+	send func(
+		ctx context.Context,
+		uri *url.URL,
+		method string,
+		params interface{},
+		reply interface{},
+		options ...Option,
+	) error
+
+	lock sync.Mutex
+	// The first endpoint is preferred for write methods
+	endpoints []*failoverEndpoint
+	// Index of the endpoint the next read method is sent to
+	nextRead int
+}
+
+// NewFailoverEndpointRequester returns an EndpointRequester that sends
+// requests to multiple equivalent endpoints.
+//
+//   - [uris] are the endpoints, in order of preference.
+//   - [writeMethods] are sent to the most preferred available endpoint. Other
+//     methods are spread across all the available endpoints.
+//   - [unavailableDuration] is how long an endpoint that returned
+//     ErrUnavailable is skipped for.
+//
+// If an endpoint returns ErrUnavailable, the request is retried on the next
+// endpoint. Endpoints that are unavailable are only tried once all the
+// available endpoints failed. Because the request may have been handled by an
+// endpoint that failed to respond, write methods should be idempotent.
+func NewFailoverEndpointRequester(
+	uris []string,
+	writeMethods set.Set[string],
+	unavailableDuration time.Duration,
+) EndpointRequester {
+	endpoints := make([]*failoverEndpoint, len(uris))
+	for i, uri := range uris {
+		endpoints[i] = &failoverEndpoint{
+			uri: uri,
+		}
+	}
+	return &failoverEndpointRequester{
+		writeMethods:        writeMethods,
+		unavailableDuration: unavailableDuration,
+		send:                SendJSONRequest,
+		endpoints:           endpoints,
+	}
+}
+
+func (f *failoverEndpointRequester) SendRequest(
+	ctx context.Context,
+	method string,
+	params interface{},
+	reply interface{},
+	options ...Option,
+) error {
+	err := errNoEndpoints
+	for _, endpoint := range f.order(method) {
+		uri, parseErr := url.Parse(endpoint.uri)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		err = f.send(ctx, uri, method, params, reply, options...)
+		if !errors.Is(err, ErrUnavailable) || ctx.Err() != nil {
+			return err
+		}
+
+		f.lock.Lock()
+		endpoint.unavailableUntil = f.clock.Time().Add(f.unavailableDuration)
+		f.lock.Unlock()
+	}
+	return err
+}
+
+// order returns the endpoints in the order they should be tried for [method].
+func (f *failoverEndpointRequester) order(method string) []*failoverEndpoint {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var (
+		numEndpoints = len(f.endpoints)
+		start        int
+	)
+	if !f.writeMethods.Contains(method) && numEndpoints > 0 {
+		start = f.nextRead
+		f.nextRead = (f.nextRead + 1) % numEndpoints
+	}
+
+	var (
+		now         = f.clock.Time()
+		available   = make([]*failoverEndpoint, 0, numEndpoints)
+		unavailable []*failoverEndpoint
+	)
+	for i := range numEndpoints {
+		endpoint := f.endpoints[(start+i)%numEndpoints]
+		if now.Before(endpoint.unavailableUntil) {
+			unavailable = append(unavailable, endpoint)
+		} else {
+			available = append(available, endpoint)
+		}
+	}
+	return append(available, unavailable...)
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var errTest = errors.New("non-nil error")
+
+func TestFailoverEndpointRequester(t *testing.T) {
+	const (
+		writeMethod         = "test.write"
+		readMethod          = "test.read"
+		unavailableDuration = time.Minute
+	)
+
+	tests := []struct {
+		name                string
+		unavailable         set.Set[string]
+		failing             set.Set[string]
+		method              string
+		numRequests         int
+		expectedRequestedTo []string
+		expectedErr         error
+	}{
+		{
+			name:                "writes are sent to the preferred endpoint",
+			method:              writeMethod,
+			numRequests:         2,
+			expectedRequestedTo: []string{"a", "a"},
+		},
+		{
+			name:                "reads are spread across endpoints",
+			method:              readMethod,
+			numRequests:         4,
+			expectedRequestedTo: []string{"a", "b", "c", "a"},
+		},
+		{
+			name:                "writes fail over to the next endpoint",
+			unavailable:         set.Of("a"),
+			method:              writeMethod,
+			numRequests:         2,
+			expectedRequestedTo: []string{"a", "b", "b"},
+		},
+		{
+			name:                "reads skip unavailable endpoints",
+			unavailable:         set.Of("b"),
+			method:              readMethod,
+			numRequests:         4,
+			expectedRequestedTo: []string{"a", "b", "c", "c", "a"},
+		},
+		{
+			name:                "other errors are not retried",
+			failing:             set.Of("a"),
+			method:              writeMethod,
+			numRequests:         1,
+			expectedRequestedTo: []string{"a"},
+			expectedErr:         errTest,
+		},
+		{
+			name:                "all endpoints unavailable",
+			unavailable:         set.Of("a", "b", "c"),
+			method:              writeMethod,
+			numRequests:         1,
+			expectedRequestedTo: []string{"a", "b", "c"},
+			expectedErr:         ErrUnavailable,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			requester := NewFailoverEndpointRequester(
+				[]string{"a", "b", "c"},
+				set.Of(writeMethod),
+				unavailableDuration,
+			).(*failoverEndpointRequester)
+
+			var requestedTo []string
+			requester.send = func(
+				_ context.Context,
+				uri *url.URL,
+				_ string,
+				_ interface{},
+				_ interface{},
+				_ ...Option,
+			) error {
+				requestedTo = append(requestedTo, uri.String())
+				switch {
+				case test.unavailable.Contains(uri.String()):
+					return fmt.Errorf("%w: test", ErrUnavailable)
+				case test.failing.Contains(uri.String()):
+					return errTest
+				default:
+					return nil
+				}
+			}
+
+			var err error
+			for range test.numRequests {
+				err = requester.SendRequest(context.Background(), test.method, nil, nil)
+			}
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedRequestedTo, requestedTo)
+		})
+	}
+}
+
+func TestFailoverEndpointRequesterRecovers(t *testing.T) {
+	require := require.New(t)
+
+	const unavailableDuration = time.Minute
+	requester := NewFailoverEndpointRequester(
+		[]string{"a", "b"},
+		set.Of("test.write"),
+		unavailableDuration,
+	).(*failoverEndpointRequester)
+	now := time.Now()
+	requester.clock.Set(now)
+
+	aAvailable := false
+	var requestedTo []string
+	requester.send = func(
+		_ context.Context,
+		uri *url.URL,
+		_ string,
+		_ interface{},
+		_ interface{},
+		_ ...Option,
+	) error {
+		requestedTo = append(requestedTo, uri.String())
+		if uri.String() == "a" && !aAvailable {
+			return ErrUnavailable
+		}
+		return nil
+	}
+
+	require.NoError(requester.SendRequest(context.Background(), "test.write", nil, nil))
+	require.Equal([]string{"a", "b"}, requestedTo)
+
+	// The preferred endpoint is skipped while it is unavailable.
+	aAvailable = true
+	requestedTo = nil
+	require.NoError(requester.SendRequest(context.Background(), "test.write", nil, nil))
+	require.Equal([]string{"b"}, requestedTo)
+
+	// The preferred endpoint is retried once the unavailable duration passed.
+	requester.clock.Set(now.Add(unavailableDuration))
+	requestedTo = nil
+	require.NoError(requester.SendRequest(context.Background(), "test.write", nil, nil))
+	require.Equal([]string{"a"}, requestedTo)
+}
@@ -6,6 +6,7 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -13,6 +14,10 @@ import (
 	rpc "github.com/gorilla/rpc/v2/json2"
 )
 
+// ErrUnavailable is returned when the endpoint couldn't be reached or didn't
+// successfully handle the request.
+var ErrUnavailable = errors.New("endpoint unavailable")
+
 func SendJSONRequest(
 	ctx context.Context,
 	uri *url.URL,
@@ -44,14 +49,14 @@ func SendJSONRequest(
 
 	resp, err := http.DefaultClient.Do(request)
 	if err != nil {
-		return fmt.Errorf("failed to issue request: %w", err)
+		return fmt.Errorf("%w: failed to issue request: %w", ErrUnavailable, err)
 	}
 
 	// Return an error for any non successful status code
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		// Drop any error during close to report the original error
 		_ = resp.Body.Close()
-		return fmt.Errorf("received status code: %d", resp.StatusCode)
+		return fmt.Errorf("%w: received status code: %d", ErrUnavailable, resp.StatusCode)
 	}
 
 	if err := rpc.DecodeClientResponse(resp.Body, reply); err != nil {
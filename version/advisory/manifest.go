@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package advisory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+var (
+	ErrUntrustedPublisher = errors.New("manifest was not signed by a trusted publisher")
+	ErrWrongNetworkID     = errors.New("wrong network ID")
+
+	errMissingUpgradeName = errors.New("upgrade is missing a name")
+)
+
+// SignedManifest is the document served by an update advisory URL.
+type SignedManifest struct {
+	// Manifest is the serialized [Manifest].
+	Manifest []byte `json:"manifest"`
+	// Signature is the secp256k1 signature of the SHA-256 hash of [Manifest].
+	Signature []byte `json:"signature"`
+}
+
+// Manifest lists the mandatory upgrades of a network.
+type Manifest struct {
+	NetworkID uint32    `json:"networkID"`
+	Upgrades  []Upgrade `json:"upgrades"`
+}
+
+// Upgrade is a mandatory network upgrade.
+type Upgrade struct {
+	Name           string    `json:"name"`
+	ActivationTime time.Time `json:"activationTime"`
+	// MinVersion is the first release that supports the upgrade, formatted as
+	// vX.Y.Z.
+	MinVersion string `json:"minVersion"`
+	// ConfigKey is the key of the upgrade's activation time in the upgrade
+	// config, such as "fortunaTime". If provided, the activation time
+	// scheduled by the running node must match [ActivationTime].
+	ConfigKey string `json:"configKey,omitempty"`
+}
+
+// Verify returns nil if the manifest is for [networkID] and is well formed.
+func (m *Manifest) Verify(networkID uint32) error {
+	if m.NetworkID != networkID {
+		return fmt.Errorf("%w: expected %d but got %d", ErrWrongNetworkID, networkID, m.NetworkID)
+	}
+	for _, u := range m.Upgrades {
+		if u.Name == "" {
+			return errMissingUpgradeName
+		}
+		if _, err := version.Parse(u.MinVersion); err != nil {
+			return fmt.Errorf("invalid minimum version of upgrade %q: %w", u.Name, err)
+		}
+	}
+	return nil
+}
+
+// SignManifest returns [manifest] signed by [key].
+func SignManifest(manifest *Manifest, key *secp256k1.PrivateKey) (*SignedManifest, error) {
+	return sign(manifest, key)
+}
+
+// ParseManifest verifies that [signed] was signed by one of the
+// [trustedPublishers] and returns the parsed manifest.
+func ParseManifest(signed *SignedManifest, trustedPublishers set.Set[ids.ShortID]) (*Manifest, error) {
+	manifest := &Manifest{}
+	if err := parse(signed, trustedPublishers, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// sign returns the serialization of [manifest] signed by [key].
+func sign(manifest any, key *secp256k1.PrivateKey) (*SignedManifest, error) {
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	signature, err := key.SignHash(hashing.ComputeHash256(manifestBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign manifest: %w", err)
+	}
+	return &SignedManifest{
+		Manifest:  manifestBytes,
+		Signature: signature,
+	}, nil
+}
+
+// parse verifies that [signed] was signed by one of the [trustedPublishers]
+// and unmarshals it into [manifest].
+func parse(signed *SignedManifest, trustedPublishers set.Set[ids.ShortID], manifest any) error {
+	publicKey, err := secp256k1.RecoverPublicKeyFromHash(hashing.ComputeHash256(signed.Manifest), signed.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to recover manifest signer: %w", err)
+	}
+	if publisher := publicKey.Address(); !trustedPublishers.Contains(publisher) {
+		return fmt.Errorf("%w: %s", ErrUntrustedPublisher, publisher)
+	}
+
+	if err := json.Unmarshal(signed.Manifest, manifest); err != nil {
+		return fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+	return nil
+}
+
+// UpgradeStatus reports whether the running node supports an upgrade.
+type UpgradeStatus struct {
+	Upgrade
+	// TimeUntilActivation is negative once the upgrade has activated.
+	TimeUntilActivation time.Duration `json:"timeUntilActivation"`
+	// VersionSupported is true if the running version is at least the
+	// minimum version of the upgrade.
+	VersionSupported bool `json:"versionSupported"`
+	// ScheduleSupported is true if the running node schedules the upgrade at
+	// its activation time.
+	ScheduleSupported bool `json:"scheduleSupported"`
+}
+
+// Supported returns true if the running node will follow the upgrade.
+func (s *UpgradeStatus) Supported() bool {
+	return s.VersionSupported && s.ScheduleSupported
+}
+
+// evaluate returns the status of each upgrade in [manifest] for a node running
+// [current] with the [upgrades] schedule at time [now].
+//
+// The manifest is assumed to be verified.
+func evaluate(
+	manifest *Manifest,
+	current *version.Semantic,
+	upgrades upgrade.Config,
+	now time.Time,
+) ([]UpgradeStatus, error) {
+	upgradesBytes, err := json.Marshal(upgrades)
+	if err != nil {
+		return nil, err
+	}
+	var scheduled map[string]json.RawMessage
+	if err := json.Unmarshal(upgradesBytes, &scheduled); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]UpgradeStatus, len(manifest.Upgrades))
+	for i, u := range manifest.Upgrades {
+		minVersion, err := version.Parse(u.MinVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		scheduleSupported := true
+		if u.ConfigKey != "" {
+			// An upgrade that is unknown to the running node is not scheduled.
+			var activationTime time.Time
+			if rawTime, ok := scheduled[u.ConfigKey]; ok {
+				if err := json.Unmarshal(rawTime, &activationTime); err != nil {
+					return nil, fmt.Errorf("failed to unmarshal %q: %w", u.ConfigKey, err)
+				}
+			}
+			scheduleSupported = activationTime.Equal(u.ActivationTime)
+		}
+
+		statuses[i] = UpgradeStatus{
+			Upgrade:             u,
+			TimeUntilActivation: u.ActivationTime.Sub(now),
+			VersionSupported:    current.Compare(minVersion) >= 0,
+			ScheduleSupported:   scheduleSupported,
+		}
+	}
+	return statuses, nil
+}
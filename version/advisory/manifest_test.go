@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package advisory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+func TestSignAndParseManifest(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	manifest := &Manifest{
+		NetworkID: constants.FujiID,
+		Upgrades: []Upgrade{{
+			Name:           "Fortuna",
+			ActivationTime: time.Date(2025, time.April, 8, 15, 0, 0, 0, time.UTC),
+			MinVersion:     "v1.13.0",
+			ConfigKey:      "fortunaTime",
+		}},
+	}
+	signed, err := SignManifest(manifest, key)
+	require.NoError(err)
+
+	parsed, err := ParseManifest(signed, set.Of(key.Address()))
+	require.NoError(err)
+	require.Equal(manifest, parsed)
+
+	_, err = ParseManifest(signed, set.Of(ids.GenerateTestShortID()))
+	require.ErrorIs(err, ErrUntrustedPublisher)
+
+	// Tampering with the manifest changes the recovered signer.
+	signed.Manifest[len(signed.Manifest)-2] = ' '
+	_, err = ParseManifest(signed, set.Of(key.Address()))
+	require.ErrorIs(err, ErrUntrustedPublisher)
+}
+
+func TestManifestVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    *Manifest
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			manifest: &Manifest{
+				NetworkID: constants.FujiID,
+				Upgrades: []Upgrade{{
+					Name:       "Fortuna",
+					MinVersion: "v1.13.0",
+				}},
+			},
+		},
+		{
+			name: "wrong network ID",
+			manifest: &Manifest{
+				NetworkID: constants.MainnetID,
+			},
+			expectedErr: ErrWrongNetworkID,
+		},
+		{
+			name: "missing name",
+			manifest: &Manifest{
+				NetworkID: constants.FujiID,
+				Upgrades: []Upgrade{{
+					MinVersion: "v1.13.0",
+				}},
+			},
+			expectedErr: errMissingUpgradeName,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.manifest.Verify(constants.FujiID)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		now            = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		activationTime = now.Add(24 * time.Hour)
+		upgrades       = upgrade.Fuji
+		current        = &version.Semantic{
+			Major: 1,
+			Minor: 13,
+			Patch: 0,
+		}
+	)
+	upgrades.FortunaTime = activationTime
+
+	manifest := &Manifest{
+		NetworkID: constants.FujiID,
+		Upgrades: []Upgrade{
+			{
+				Name:           "supported",
+				ActivationTime: activationTime,
+				MinVersion:     "v1.13.0",
+				ConfigKey:      "fortunaTime",
+			},
+			{
+				Name:           "newer version",
+				ActivationTime: activationTime,
+				MinVersion:     "v1.13.1",
+			},
+			{
+				Name:           "different schedule",
+				ActivationTime: activationTime.Add(time.Hour),
+				MinVersion:     "v1.12.0",
+				ConfigKey:      "fortunaTime",
+			},
+			{
+				Name:           "unknown upgrade",
+				ActivationTime: now.Add(-time.Hour),
+				MinVersion:     "v1.12.0",
+				ConfigKey:      "unknownTime",
+			},
+		},
+	}
+	statuses, err := evaluate(manifest, current, upgrades, now)
+	require.NoError(err)
+	require.Equal(
+		[]UpgradeStatus{
+			{
+				Upgrade:             manifest.Upgrades[0],
+				TimeUntilActivation: 24 * time.Hour,
+				VersionSupported:    true,
+				ScheduleSupported:   true,
+			},
+			{
+				Upgrade:             manifest.Upgrades[1],
+				TimeUntilActivation: 24 * time.Hour,
+				VersionSupported:    false,
+				ScheduleSupported:   true,
+			},
+			{
+				Upgrade:             manifest.Upgrades[2],
+				TimeUntilActivation: 25 * time.Hour,
+				VersionSupported:    true,
+				ScheduleSupported:   false,
+			},
+			{
+				Upgrade:             manifest.Upgrades[3],
+				TimeUntilActivation: -time.Hour,
+				VersionSupported:    true,
+				ScheduleSupported:   false,
+			},
+		},
+		statuses,
+	)
+}
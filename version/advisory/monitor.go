@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package advisory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api/health"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+const (
+	fetchTimeout    = 30 * time.Second
+	maxManifestSize = units.MiB
+)
+
+var (
+	_ health.Checker = (*Monitor)(nil)
+
+	errUnexpectedStatusCode = errors.New("unexpected status code")
+	errUnsupportedUpgrade   = errors.New("unsupported mandatory upgrade")
+)
+
+type Config struct {
+	// URL serves the signed manifest. If empty, the advisory is disabled.
+	URL string `json:"url"`
+	// TrustedPublishers are the addresses of the keys trusted to sign the
+	// manifest.
+	TrustedPublishers set.Set[ids.ShortID] `json:"trustedPublishers"`
+	// Frequency is how often the manifest is fetched.
+	Frequency time.Duration `json:"frequency"`
+	// WarningPeriod is how long before the activation of an unsupported
+	// upgrade the node is reported as unhealthy.
+	WarningPeriod time.Duration `json:"warningPeriod"`
+}
+
+// Report describes the mandatory upgrades listed by the last manifest that
+// was fetched.
+type Report struct {
+	LastFetched time.Time       `json:"lastFetched"`
+	FetchError  string          `json:"fetchError,omitempty"`
+	Upgrades    []UpgradeStatus `json:"upgrades"`
+}
+
+// Monitor periodically fetches the update advisory manifest and reports the
+// mandatory upgrades that the running node doesn't support.
+//
+// Dispatch() and Stop() should only be called once.
+type Monitor struct {
+	log       logging.Logger
+	config    Config
+	networkID uint32
+	upgrades  upgrade.Config
+	clock     mockable.Clock
+
+	lock        sync.Mutex
+	manifest    *Manifest
+	lastFetched time.Time
+	fetchErr    error
+
+	// Cancelling causes Dispatch() to eventually return.
+	rootCtx       context.Context
+	rootCtxCancel context.CancelFunc
+	// Closed when Dispatch() has returned.
+	doneChan chan struct{}
+}
+
+func NewMonitor(
+	log logging.Logger,
+	config Config,
+	networkID uint32,
+	upgrades upgrade.Config,
+) *Monitor {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Monitor{
+		log:           log,
+		config:        config,
+		networkID:     networkID,
+		upgrades:      upgrades,
+		rootCtx:       ctx,
+		rootCtxCancel: cancel,
+		doneChan:      make(chan struct{}),
+	}
+}
+
+// Dispatch periodically fetches the manifest. Doesn't return until after
+// Stop() is called. Should be called in a goroutine.
+func (m *Monitor) Dispatch() {
+	ticker := time.NewTicker(m.config.Frequency)
+	defer func() {
+		ticker.Stop()
+		close(m.doneChan)
+	}()
+
+	for {
+		m.update()
+
+		select {
+		case <-ticker.C:
+		case <-m.rootCtx.Done():
+			return
+		}
+	}
+}
+
+// Stop fetching the manifest.
+func (m *Monitor) Stop() {
+	m.rootCtxCancel()
+	<-m.doneChan
+}
+
+// update fetches the manifest and logs the unsupported upgrades it lists.
+func (m *Monitor) update() {
+	ctx, cancel := context.WithTimeout(m.rootCtx, fetchTimeout)
+	manifest, err := m.fetch(ctx)
+	cancel()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.lastFetched = m.clock.Time()
+	m.fetchErr = err
+	if err != nil {
+		m.log.Warn("failed to fetch update advisory",
+			zap.String("url", m.config.URL),
+			zap.Error(err),
+		)
+		return
+	}
+	m.manifest = manifest
+
+	statuses, err := m.statuses()
+	if err != nil {
+		m.log.Error("failed to evaluate update advisory",
+			zap.Error(err),
+		)
+		return
+	}
+	for _, status := range statuses {
+		if status.Supported() {
+			continue
+		}
+		if status.TimeUntilActivation <= 0 {
+			m.log.Error("mandatory upgrade has activated but isn't supported by this node. Update this node immediately",
+				zap.String("upgrade", status.Name),
+				zap.Time("activationTime", status.ActivationTime),
+				zap.String("minVersion", status.MinVersion),
+				zap.Stringer("currentVersion", version.Current),
+				zap.Bool("scheduleSupported", status.ScheduleSupported),
+			)
+			continue
+		}
+		m.log.Warn("mandatory upgrade isn't supported by this node. Update this node before it activates",
+			zap.String("upgrade", status.Name),
+			zap.Time("activationTime", status.ActivationTime),
+			zap.Duration("timeUntilActivation", status.TimeUntilActivation),
+			zap.String("minVersion", status.MinVersion),
+			zap.Stringer("currentVersion", version.Current),
+			zap.Bool("scheduleSupported", status.ScheduleSupported),
+		)
+	}
+}
+
+// fetch returns the verified manifest served by the advisory URL.
+func (m *Monitor) fetch(ctx context.Context) (*Manifest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.config.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %d", errUnexpectedStatusCode, resp.StatusCode)
+	}
+	signedBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	signed := &SignedManifest{}
+	if err := json.Unmarshal(signedBytes, signed); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal signed manifest: %w", err)
+	}
+	manifest, err := ParseManifest(signed, m.config.TrustedPublishers)
+	if err != nil {
+		return nil, err
+	}
+	return manifest, manifest.Verify(m.networkID)
+}
+
+// statuses returns the status of the upgrades listed by the last fetched
+// manifest.
+//
+// Assumes [m.lock] is held.
+func (m *Monitor) statuses() ([]UpgradeStatus, error) {
+	if m.manifest == nil {
+		return nil, nil
+	}
+	return evaluate(m.manifest, version.Current, m.upgrades, m.clock.Time())
+}
+
+// HealthCheck returns an error if an upgrade that isn't supported by the
+// running node activates within the warning period, or has already activated.
+func (m *Monitor) HealthCheck(context.Context) (interface{}, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	report := Report{
+		LastFetched: m.lastFetched,
+	}
+	if m.fetchErr != nil {
+		report.FetchError = m.fetchErr.Error()
+	}
+	statuses, err := m.statuses()
+	if err != nil {
+		return report, err
+	}
+	report.Upgrades = statuses
+
+	var unsupported []string
+	for _, status := range statuses {
+		if !status.Supported() && status.TimeUntilActivation <= m.config.WarningPeriod {
+			unsupported = append(unsupported, fmt.Sprintf("%s activating at %s requires %s",
+				status.Name,
+				status.ActivationTime,
+				status.MinVersion,
+			))
+		}
+	}
+	if len(unsupported) > 0 {
+		return report, fmt.Errorf("%w: %s", errUnsupportedUpgrade, strings.Join(unsupported, ", "))
+	}
+	return report, nil
+}
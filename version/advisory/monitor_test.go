@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package advisory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+// startAdvisoryServer serves [manifest] signed by [key] and returns its URL.
+func startAdvisoryServer(t *testing.T, manifest *Manifest, key *secp256k1.PrivateKey) string {
+	t.Helper()
+	require := require.New(t)
+
+	signed, err := SignManifest(manifest, key)
+	require.NoError(err)
+	signedBytes, err := json.Marshal(signed)
+	require.NoError(err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(signedBytes)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestMonitor(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	var (
+		now            = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		activationTime = now.Add(7 * 24 * time.Hour)
+		nextVersion    = &version.Semantic{
+			Major: version.Current.Major,
+			Minor: version.Current.Minor + 1,
+			Patch: 0,
+		}
+		manifest = &Manifest{
+			NetworkID: constants.FujiID,
+			Upgrades: []Upgrade{{
+				Name:           "Fortuna",
+				ActivationTime: activationTime,
+				MinVersion:     nextVersion.String(),
+				ConfigKey:      "fortunaTime",
+			}},
+		}
+	)
+
+	m := NewMonitor(
+		logging.NoLog{},
+		Config{
+			URL:               startAdvisoryServer(t, manifest, key),
+			TrustedPublishers: set.Of(key.Address()),
+			Frequency:         time.Hour,
+			WarningPeriod:     24 * time.Hour,
+		},
+		constants.FujiID,
+		upgrade.Fuji,
+	)
+	m.clock.Set(now)
+
+	// Nothing is reported before the manifest is fetched.
+	report, err := m.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Empty(report.(Report).Upgrades)
+
+	// The upgrade isn't supported, but doesn't activate within the warning
+	// period.
+	m.update()
+	report, err = m.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Empty(report.(Report).FetchError)
+	require.Len(report.(Report).Upgrades, 1)
+	status := report.(Report).Upgrades[0]
+	require.False(status.Supported())
+	require.Equal(7*24*time.Hour, status.TimeUntilActivation)
+
+	m.clock.Set(activationTime.Add(-time.Hour))
+	_, err = m.HealthCheck(context.Background())
+	require.ErrorIs(err, errUnsupportedUpgrade)
+
+	m.clock.Set(activationTime.Add(time.Hour))
+	_, err = m.HealthCheck(context.Background())
+	require.ErrorIs(err, errUnsupportedUpgrade)
+}
+
+func TestMonitorUntrustedPublisher(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	manifest := &Manifest{
+		NetworkID: constants.FujiID,
+		Upgrades: []Upgrade{{
+			Name:           "Fortuna",
+			ActivationTime: time.Now(),
+			MinVersion:     "v99.0.0",
+		}},
+	}
+	m := NewMonitor(
+		logging.NoLog{},
+		Config{
+			URL:               startAdvisoryServer(t, manifest, key),
+			TrustedPublishers: set.Of(ids.GenerateTestShortID()),
+			Frequency:         time.Hour,
+			WarningPeriod:     24 * time.Hour,
+		},
+		constants.FujiID,
+		upgrade.Fuji,
+	)
+
+	// The manifest is ignored, so its upgrade doesn't make the node unhealthy.
+	m.update()
+	report, err := m.HealthCheck(context.Background())
+	require.NoError(err)
+	require.NotEmpty(report.(Report).FetchError)
+	require.Empty(report.(Report).Upgrades)
+}
+
+func TestMonitorDispatch(t *testing.T) {
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(t, err)
+
+	manifest := &Manifest{
+		NetworkID: constants.FujiID,
+	}
+	m := NewMonitor(
+		logging.NoLog{},
+		Config{
+			URL:               startAdvisoryServer(t, manifest, key),
+			TrustedPublishers: set.Of(key.Address()),
+			Frequency:         time.Millisecond,
+			WarningPeriod:     24 * time.Hour,
+		},
+		constants.FujiID,
+		upgrade.Fuji,
+	)
+	go m.Dispatch()
+
+	require.Eventually(t, func() bool {
+		report, err := m.HealthCheck(context.Background())
+		return err == nil && !report.(Report).LastFetched.IsZero()
+	}, 5*time.Second, time.Millisecond)
+	m.Stop()
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package advisory
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+var (
+	ErrUnknownRelease     = errors.New("release isn't listed in the manifest")
+	ErrUnknownPlatform    = errors.New("platform isn't listed for the release")
+	ErrCommitMismatch     = errors.New("binary wasn't built from the release's commit")
+	ErrDirtyBuild         = errors.New("binary was built with uncommitted changes")
+	ErrBinaryHashMismatch = errors.New("binary hash doesn't match the release")
+
+	errMissingReleaseVersion = errors.New("release is missing a version")
+)
+
+// ReleaseManifest lists the binaries published for the releases of a network.
+type ReleaseManifest struct {
+	NetworkID uint32    `json:"networkID"`
+	Releases  []Release `json:"releases"`
+}
+
+// Release is a published release.
+type Release struct {
+	// Version of the release, formatted as vX.Y.Z.
+	Version string `json:"version"`
+	// Commit the release was built from.
+	Commit   string   `json:"commit"`
+	Binaries []Binary `json:"binaries"`
+}
+
+// Binary is a published binary of a release.
+type Binary struct {
+	// Platform is the GOOS/GOARCH the binary was built for, such as
+	// "linux/amd64".
+	Platform string `json:"platform"`
+	// SHA256 is the hex encoded SHA-256 hash of the binary.
+	SHA256 string `json:"sha256"`
+}
+
+// Verify returns nil if the manifest is for [networkID] and is well formed.
+func (m *ReleaseManifest) Verify(networkID uint32) error {
+	if m.NetworkID != networkID {
+		return fmt.Errorf("%w: expected %d but got %d", ErrWrongNetworkID, networkID, m.NetworkID)
+	}
+	for _, r := range m.Releases {
+		if r.Version == "" {
+			return errMissingReleaseVersion
+		}
+		if _, err := version.Parse(r.Version); err != nil {
+			return fmt.Errorf("invalid version of release %q: %w", r.Version, err)
+		}
+	}
+	return nil
+}
+
+// VerifyBuild returns the [current] release, along with nil if [build] is the
+// published binary of the release for its platform.
+//
+// The manifest is assumed to be verified.
+func (m *ReleaseManifest) VerifyBuild(current *version.Semantic, build *version.BuildInfo) (*Release, error) {
+	for i := range m.Releases {
+		r := &m.Releases[i]
+		v, err := version.Parse(r.Version)
+		if err != nil {
+			return nil, err
+		}
+		if v.Compare(current) != 0 {
+			continue
+		}
+
+		if r.Commit != build.Commit {
+			return r, fmt.Errorf("%w: expected %q but got %q", ErrCommitMismatch, r.Commit, build.Commit)
+		}
+		if build.Dirty {
+			return r, ErrDirtyBuild
+		}
+		for _, b := range r.Binaries {
+			if b.Platform != build.Platform {
+				continue
+			}
+			if b.SHA256 != build.BinaryHash {
+				return r, fmt.Errorf("%w: expected %s but got %s", ErrBinaryHashMismatch, b.SHA256, build.BinaryHash)
+			}
+			return r, nil
+		}
+		return r, fmt.Errorf("%w: %s", ErrUnknownPlatform, build.Platform)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownRelease, current)
+}
+
+// SignReleaseManifest returns [manifest] signed by [key].
+func SignReleaseManifest(manifest *ReleaseManifest, key *secp256k1.PrivateKey) (*SignedManifest, error) {
+	return sign(manifest, key)
+}
+
+// ParseReleaseManifest verifies that [signed] was signed by one of the
+// [trustedPublishers] and returns the parsed manifest.
+func ParseReleaseManifest(signed *SignedManifest, trustedPublishers set.Set[ids.ShortID]) (*ReleaseManifest, error) {
+	manifest := &ReleaseManifest{}
+	if err := parse(signed, trustedPublishers, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
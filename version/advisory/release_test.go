@@ -0,0 +1,148 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package advisory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+func TestSignAndParseReleaseManifest(t *testing.T) {
+	require := require.New(t)
+
+	key, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+
+	manifest := &ReleaseManifest{
+		NetworkID: constants.FujiID,
+		Releases: []Release{{
+			Version: "v1.12.3",
+			Commit:  "79cd09ba728e1cecef40acd60702f0a2d41ea404",
+			Binaries: []Binary{{
+				Platform: "linux/amd64",
+				SHA256:   "f0e4c2f76c58916ec258f246851bea091d14d4247a2fc3e18694461b1816e13b",
+			}},
+		}},
+	}
+	signed, err := SignReleaseManifest(manifest, key)
+	require.NoError(err)
+
+	parsed, err := ParseReleaseManifest(signed, set.Of(key.Address()))
+	require.NoError(err)
+	require.Equal(manifest, parsed)
+	require.NoError(parsed.Verify(constants.FujiID))
+	require.ErrorIs(parsed.Verify(constants.MainnetID), ErrWrongNetworkID)
+
+	wrongKey, err := secp256k1.NewPrivateKey()
+	require.NoError(err)
+	_, err = ParseReleaseManifest(signed, set.Of(wrongKey.Address()))
+	require.ErrorIs(err, ErrUntrustedPublisher)
+}
+
+func TestVerifyBuild(t *testing.T) {
+	const (
+		commit = "79cd09ba728e1cecef40acd60702f0a2d41ea404"
+		hash   = "f0e4c2f76c58916ec258f246851bea091d14d4247a2fc3e18694461b1816e13b"
+	)
+	manifest := &ReleaseManifest{
+		NetworkID: constants.FujiID,
+		Releases: []Release{{
+			Version: "v1.12.3",
+			Commit:  commit,
+			Binaries: []Binary{{
+				Platform: "linux/amd64",
+				SHA256:   hash,
+			}},
+		}},
+	}
+	current := &version.Semantic{
+		Major: 1,
+		Minor: 12,
+		Patch: 3,
+	}
+
+	tests := []struct {
+		name        string
+		current     *version.Semantic
+		build       version.BuildInfo
+		expectedErr error
+	}{
+		{
+			name:    "verified",
+			current: current,
+			build: version.BuildInfo{
+				Commit:     commit,
+				Platform:   "linux/amd64",
+				BinaryHash: hash,
+			},
+		},
+		{
+			name: "unknown release",
+			current: &version.Semantic{
+				Major: 1,
+				Minor: 12,
+				Patch: 4,
+			},
+			build: version.BuildInfo{
+				Commit:     commit,
+				Platform:   "linux/amd64",
+				BinaryHash: hash,
+			},
+			expectedErr: ErrUnknownRelease,
+		},
+		{
+			name:    "wrong commit",
+			current: current,
+			build: version.BuildInfo{
+				Commit:     "2ec6e6a5a8dc3bbdbdb8c3a7d8e0ca8e5a64e2f1",
+				Platform:   "linux/amd64",
+				BinaryHash: hash,
+			},
+			expectedErr: ErrCommitMismatch,
+		},
+		{
+			name:    "dirty",
+			current: current,
+			build: version.BuildInfo{
+				Commit:     commit,
+				Dirty:      true,
+				Platform:   "linux/amd64",
+				BinaryHash: hash,
+			},
+			expectedErr: ErrDirtyBuild,
+		},
+		{
+			name:    "unknown platform",
+			current: current,
+			build: version.BuildInfo{
+				Commit:     commit,
+				Platform:   "darwin/arm64",
+				BinaryHash: hash,
+			},
+			expectedErr: ErrUnknownPlatform,
+		},
+		{
+			name:    "wrong hash",
+			current: current,
+			build: version.BuildInfo{
+				Commit:     commit,
+				Platform:   "linux/amd64",
+				BinaryHash: "00",
+			},
+			expectedErr: ErrBinaryHashMismatch,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := manifest.VerifyBuild(test.current, &test.build)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
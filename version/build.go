@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package version
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+var (
+	binaryHashOnce sync.Once
+	binaryHash     string
+	binaryHashErr  error
+)
+
+// BuildInfo describes how the running binary was built.
+type BuildInfo struct {
+	// Commit is the commit the binary was built from. It may be empty if the
+	// binary wasn't built from a git checkout.
+	Commit     string `json:"commit"`
+	CommitTime string `json:"commitTime,omitempty"`
+	// Dirty is true if the checkout had uncommitted changes.
+	Dirty     bool   `json:"dirty"`
+	GoVersion string `json:"goVersion"`
+	// Platform is the GOOS/GOARCH the binary was built for.
+	Platform string `json:"platform"`
+	// Settings are the build settings, such as "-trimpath" and "CGO_ENABLED",
+	// that affect the built binary.
+	Settings map[string]string `json:"settings"`
+	// Dependencies are the modules the binary was built with.
+	Dependencies []Dependency `json:"dependencies"`
+	// BinaryHash is the hex encoded SHA-256 hash of the running executable.
+	BinaryHash string `json:"binaryHash"`
+}
+
+// Dependency is a module that the binary was built with.
+type Dependency struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+	// Sum is the go.sum hash of the module.
+	Sum string `json:"sum"`
+	// Replace is the module that replaced this module, if any.
+	Replace *Dependency `json:"replace,omitempty"`
+}
+
+// GetBuildInfo returns the build metadata embedded in the running binary by the
+// go toolchain, along with the hash of the running executable.
+func GetBuildInfo() (*BuildInfo, error) {
+	info := &BuildInfo{
+		Commit:    GitCommit,
+		GoVersion: strings.TrimPrefix(runtime.Version(), "go"),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+		Settings:  make(map[string]string),
+	}
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range buildInfo.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				info.Commit = setting.Value
+			case "vcs.time":
+				info.CommitTime = setting.Value
+			case "vcs.modified":
+				info.Dirty = setting.Value == "true"
+			case "vcs":
+			default:
+				info.Settings[setting.Key] = setting.Value
+			}
+		}
+		info.Dependencies = make([]Dependency, len(buildInfo.Deps))
+		for i, dep := range buildInfo.Deps {
+			info.Dependencies[i] = newDependency(dep)
+		}
+	}
+
+	binaryHashOnce.Do(func() {
+		binaryHash, binaryHashErr = hashExecutable()
+	})
+	info.BinaryHash = binaryHash
+	return info, binaryHashErr
+}
+
+func newDependency(m *debug.Module) Dependency {
+	dep := Dependency{
+		Path:    m.Path,
+		Version: m.Version,
+		Sum:     m.Sum,
+	}
+	if m.Replace != nil {
+		replace := newDependency(m.Replace)
+		dep.Replace = &replace
+	}
+	return dep
+}
+
+// hashExecutable returns the hex encoded SHA-256 hash of the running
+// executable.
+func hashExecutable() (string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("couldn't find executable: %w", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("couldn't open executable: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("couldn't hash executable: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package version
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBuildInfo(t *testing.T) {
+	require := require.New(t)
+
+	info, err := GetBuildInfo()
+	require.NoError(err)
+	require.Equal(runtime.GOOS+"/"+runtime.GOARCH, info.Platform)
+
+	executable, err := os.Executable()
+	require.NoError(err)
+	executableBytes, err := os.ReadFile(executable)
+	require.NoError(err)
+	expectedHash := sha256.Sum256(executableBytes)
+	require.Equal(hex.EncodeToString(expectedHash[:]), info.BinaryHash)
+}
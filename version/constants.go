@@ -15,7 +15,7 @@ const (
 	// RPCChainVMProtocol should be bumped anytime changes are made which
 	// require the plugin vm to upgrade to latest avalanchego release to be
 	// compatible.
-	RPCChainVMProtocol uint = 39
+	RPCChainVMProtocol uint = 40
 )
 
 // These are globals that describe network upgrades and node versions
@@ -23,7 +23,7 @@ var (
 	Current = &Semantic{
 		Major: 1,
 		Minor: 12,
-		Patch: 2,
+		Patch: 3,
 	}
 	CurrentApp = &Application{
 		Name:  Client,
@@ -44,6 +44,13 @@ var (
 		Patch: 0,
 	}
 
+	// SupportedRPCChainVMProtocols are the RPCChainVM protocol versions that
+	// a plugin VM may implement to be run by this node.
+	//
+	// Plugins implementing protocol 39 don't support config updates and don't
+	// report the utilization of their blocks.
+	SupportedRPCChainVMProtocols = []uint{RPCChainVMProtocol, 39}
+
 	CurrentDatabase = DatabaseVersion1_4_5
 	PrevDatabase    = DatabaseVersion1_0_0
 
@@ -11,19 +11,16 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
-	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/state"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 
 	blockexecutor "github.com/ava-labs/avalanchego/vms/avm/block/executor"
 	txexecutor "github.com/ava-labs/avalanchego/vms/avm/txs/executor"
 )
 
-// targetBlockSize is the max block size we aim to produce
-const targetBlockSize = 128 * units.KiB
-
 var (
 	_ Builder = (*builder)(nil)
 
@@ -87,18 +84,18 @@ func (b *builder) BuildBlock(context.Context) (snowman.Block, error) {
 		return nil, err
 	}
 
+	backend := *b.backend
+	backend.Height = nextHeight
+
 	var (
 		blockTxs      []*txs.Tx
 		inputs        set.Set[ids.ID]
-		remainingSize = targetBlockSize
+		remainingSize = limits.Default.MaxBlockSize
 	)
 	for {
 		tx, exists := b.mempool.Peek()
-		// Invariant: [mempool.MaxTxSize] < [targetBlockSize]. This guarantees
-		// that we will only stop building a block once there are no
-		// transactions in the mempool or the block is at least
-		// [targetBlockSize - mempool.MaxTxSize] bytes full.
-		if !exists || len(tx.Bytes()) > remainingSize {
+		// See the invariant of [limits.Default].
+		if !exists || tx.Size() > remainingSize {
 			break
 		}
 		b.mempool.Remove(tx)
@@ -111,7 +108,7 @@ func (b *builder) BuildBlock(context.Context) (snowman.Block, error) {
 		}
 
 		err = tx.Unsigned.Visit(&txexecutor.SemanticVerifier{
-			Backend: b.backend,
+			Backend: &backend,
 			State:   txDiff,
 			Tx:      tx,
 		})
@@ -149,7 +146,7 @@ func (b *builder) BuildBlock(context.Context) (snowman.Block, error) {
 		txDiff.AddTx(tx)
 		txDiff.Apply(stateDiff)
 
-		remainingSize -= len(tx.Bytes())
+		remainingSize -= tx.Size()
 		blockTxs = append(blockTxs, tx)
 	}
 
@@ -515,7 +515,7 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 
 	baseDB := versiondb.New(memdb.New())
 
-	state, err := state.New(baseDB, parser, registerer, trackChecksums)
+	state, err := state.New(baseDB, parser, registerer, trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	clk := &mockable.Clock{}
@@ -17,12 +17,16 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/state"
 	"github.com/ava-labs/avalanchego/vms/avm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
+
+	smblock "github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 )
 
 const SyncBound = 10 * time.Second
 
 var (
-	_ snowman.Block = (*Block)(nil)
+	_ snowman.Block           = (*Block)(nil)
+	_ smblock.WithUtilization = (*Block)(nil)
 
 	ErrUnexpectedMerkleRoot        = errors.New("unexpected merkle root")
 	ErrTimestampBeyondSyncBound    = errors.New("proposed timestamp is too far in the future relative to local time")
@@ -70,6 +74,14 @@ func (b *Block) Verify(context.Context) error {
 		return ErrEmptyBlock
 	}
 
+	// After Fortuna, the size of the txs is limited the same way as in the
+	// mempool and the block builder.
+	if b.manager.backend.Config.Upgrades.IsFortunaActivated(newChainTime) {
+		if err := limits.VerifyBlockTxs(limits.Default, txs); err != nil {
+			return err
+		}
+	}
+
 	// Syntactic verification is generally pretty fast, so we verify this first
 	// before performing any possible DB reads.
 	for _, tx := range txs {
@@ -125,13 +137,16 @@ func (b *Block) Verify(context.Context) error {
 		statelessBlock: b.Block,
 		onAcceptState:  stateDiff,
 		atomicRequests: make(map[ids.ID]*atomic.Requests),
+		utilization:    b.manager.utilization(newChainTime, txs),
 	}
 
+	backend := *b.manager.backend
+	backend.Height = b.Height()
 	for _, tx := range txs {
 		// Verify that the tx is valid according to the current state of the
 		// chain.
 		err := tx.Unsigned.Visit(&executor.SemanticVerifier{
-			Backend: b.manager.backend,
+			Backend: &backend,
 			State:   stateDiff,
 			Tx:      tx,
 		})
@@ -245,6 +260,7 @@ func (b *Block) Accept(context.Context) error {
 	if err := b.manager.metrics.MarkBlockAccepted(b); err != nil {
 		return err
 	}
+	b.manager.consumeGas(b.Timestamp(), txs)
 
 	b.manager.backend.Ctx.Log.Trace(
 		"accepted block",
@@ -256,6 +272,15 @@ func (b *Block) Accept(context.Context) error {
 	return nil
 }
 
+// Utilization returns the utilization of the block, which is only known while
+// the block is processing.
+func (b *Block) Utilization() []float64 {
+	if blkState, ok := b.manager.blkIDToState[b.ID()]; ok {
+		return blkState.utilization
+	}
+	return nil
+}
+
 func (b *Block) Reject(context.Context) error {
 	blkID := b.ID()
 	defer b.manager.free(blkID)
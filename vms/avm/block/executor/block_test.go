@@ -740,6 +740,7 @@ func TestBlockAccept(t *testing.T) {
 				mockBlock.EXPECT().Height().Return(uint64(0)).AnyTimes()
 				mockBlock.EXPECT().Parent().Return(ids.GenerateTestID()).AnyTimes()
 				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Timestamp().Return(time.Now()).AnyTimes()
 
 				mempool, err := mempool.New("", prometheus.NewRegistry(), nil)
 				require.NoError(t, err)
@@ -851,7 +852,10 @@ func TestBlockReject(t *testing.T) {
 				require.NoError(t, err)
 
 				lastAcceptedID := ids.GenerateTestID()
+				lastAccepted := block.NewMockBlock(ctrl)
+				lastAccepted.EXPECT().Height().Return(uint64(0)).AnyTimes()
 				mockState := statemock.NewState(ctrl)
+				mockState.EXPECT().GetBlock(lastAcceptedID).Return(lastAccepted, nil).AnyTimes()
 				mockState.EXPECT().GetLastAccepted().Return(lastAcceptedID).AnyTimes()
 				mockState.EXPECT().GetTimestamp().Return(time.Now()).AnyTimes()
 
@@ -904,7 +908,10 @@ func TestBlockReject(t *testing.T) {
 				require.NoError(t, err)
 
 				lastAcceptedID := ids.GenerateTestID()
+				lastAccepted := block.NewMockBlock(ctrl)
+				lastAccepted.EXPECT().Height().Return(uint64(0)).AnyTimes()
 				mockState := statemock.NewState(ctrl)
+				mockState.EXPECT().GetBlock(lastAcceptedID).Return(lastAccepted, nil).AnyTimes()
 				mockState.EXPECT().GetLastAccepted().Return(lastAcceptedID).AnyTimes()
 				mockState.EXPECT().GetTimestamp().Return(time.Now()).AnyTimes()
 
@@ -18,6 +18,7 @@ import (
 	block "github.com/ava-labs/avalanchego/vms/avm/block"
 	state "github.com/ava-labs/avalanchego/vms/avm/state"
 	txs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	gas "github.com/ava-labs/avalanchego/vms/components/gas"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -45,6 +46,20 @@ func (m *Manager) EXPECT() *ManagerMockRecorder {
 	return m.recorder
 }
 
+// GasPrice mocks base method.
+func (m *Manager) GasPrice() gas.Price {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GasPrice")
+	ret0, _ := ret[0].(gas.Price)
+	return ret0
+}
+
+// GasPrice indicates an expected call of GasPrice.
+func (mr *ManagerMockRecorder) GasPrice() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GasPrice", reflect.TypeOf((*Manager)(nil).GasPrice))
+}
+
 // GetBlock mocks base method.
 func (m *Manager) GetBlock(blkID ids.ID) (snowman.Block, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,8 @@ package executor
 
 import (
 	"errors"
+	"math"
+	"time"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/ids"
@@ -16,7 +18,12 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/state"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/avm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/avm/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
 )
 
 var (
@@ -46,6 +53,10 @@ type Manager interface {
 	// VerifyUniqueInputs returns nil iff no blocks in the inclusive
 	// ancestry of [blkID] consume an input in [inputs].
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
+
+	// GasPrice returns the price of the gas that transactions must burn to be
+	// added to the mempool.
+	GasPrice() gas.Price
 }
 
 func NewManager(
@@ -67,6 +78,7 @@ func NewManager(
 		blkIDToState: map[ids.ID]*blockState{},
 		lastAccepted: lastAccepted,
 		preferred:    lastAccepted,
+		feeTimestamp: state.GetTimestamp(),
 	}
 }
 
@@ -89,6 +101,12 @@ type manager struct {
 	// lastAccepted is the ID of the last block that had Accept() called on it.
 	lastAccepted ids.ID
 	preferred    ids.ID
+
+	// The excess gas consumed by accepted blocks as of [feeTimestamp]. Excess
+	// gas isn't part of the chain's state, so it is reset when the node
+	// restarts.
+	feeExcess    gas.Gas
+	feeTimestamp time.Time
 }
 
 type blockState struct {
@@ -96,6 +114,7 @@ type blockState struct {
 	onAcceptState  state.Diff
 	importedInputs set.Set[ids.ID]
 	atomicRequests map[ids.ID]*atomic.Requests
+	utilization    []float64
 }
 
 func (m *manager) GetState(blkID ids.ID) (state.Chain, bool) {
@@ -155,13 +174,21 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		return err
 	}
 
+	lastAccepted, err := m.GetStatelessBlock(m.lastAccepted)
+	if err != nil {
+		return err
+	}
+
 	stateDiff, err := state.NewDiff(m.lastAccepted, m)
 	if err != nil {
 		return err
 	}
 
+	// The tx is verified as if it were included in the next block.
+	backend := *m.backend
+	backend.Height = lastAccepted.Height() + 1
 	err = tx.Unsigned.Visit(&executor.SemanticVerifier{
-		Backend: m.backend,
+		Backend: &backend,
 		State:   stateDiff,
 		Tx:      tx,
 	})
@@ -174,7 +201,18 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		State: stateDiff,
 		Tx:    tx,
 	}
-	return tx.Unsigned.Visit(executor)
+	if err := tx.Unsigned.Visit(executor); err != nil {
+		return err
+	}
+
+	if !m.backend.Config.Upgrades.IsEtnaActivated(stateDiff.GetTimestamp()) {
+		return nil
+	}
+	feeCalculator := fee.NewCalculator(
+		m.backend.Config.DynamicFeeWeights(stateDiff.GetTimestamp()),
+		m.GasPrice(),
+	)
+	return feeCalculator.VerifyTx(tx, m.backend.FeeAssetID)
 }
 
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
@@ -200,6 +238,88 @@ func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
 	}
 }
 
+func (m *manager) GasPrice() gas.Price {
+	config := m.backend.Config.DynamicFeeConfig
+	return gas.CalculatePrice(
+		config.MinPrice,
+		m.excessAt(m.clk.Time()),
+		config.ExcessConversionConstant,
+	)
+}
+
+// excessAt returns the excess gas at [timestamp], assuming no blocks are
+// accepted before then.
+func (m *manager) excessAt(timestamp time.Time) gas.Gas {
+	if !timestamp.After(m.feeTimestamp) {
+		return m.feeExcess
+	}
+	seconds := uint64(timestamp.Sub(m.feeTimestamp) / time.Second)
+	return m.feeExcess.SubPerSecond(
+		m.backend.Config.DynamicFeeConfig.TargetPerSecond,
+		seconds,
+	)
+}
+
+// consumeGas adds the gas consumed by the txs of an accepted block to the
+// excess gas.
+func (m *manager) consumeGas(timestamp time.Time, txs []*txs.Tx) {
+	if !m.backend.Config.Upgrades.IsEtnaActivated(timestamp) {
+		return
+	}
+
+	excess, err := safemath.Add(uint64(m.excessAt(timestamp)), uint64(m.gasConsumed(timestamp, txs)))
+	if err != nil {
+		excess = math.MaxUint64
+	}
+	m.feeExcess = gas.Gas(excess)
+	if timestamp.After(m.feeTimestamp) {
+		m.feeTimestamp = timestamp
+	}
+}
+
+// gasConsumed returns the gas consumed by [txs] at [timestamp]. Txs whose
+// complexity can't be calculated don't consume any gas.
+func (m *manager) gasConsumed(timestamp time.Time, txs []*txs.Tx) gas.Gas {
+	var (
+		weights  = m.backend.Config.DynamicFeeWeights(timestamp)
+		consumed uint64
+	)
+	for _, tx := range txs {
+		complexity, err := fee.TxComplexity(tx)
+		if err != nil {
+			continue
+		}
+		txGas, err := complexity.ToGas(weights)
+		if err != nil {
+			return math.MaxUint64
+		}
+		consumed, err = safemath.Add(consumed, uint64(txGas))
+		if err != nil {
+			return math.MaxUint64
+		}
+	}
+	return gas.Gas(consumed)
+}
+
+// utilization returns the portion of a block's capacity that [txs] consume in
+// each dimension: the size of the txs, and after Etna, the gas they consume.
+func (m *manager) utilization(timestamp time.Time, txs []*txs.Tx) []float64 {
+	var size int
+	for _, tx := range txs {
+		size += tx.Size()
+	}
+	utilization := []float64{
+		float64(size) / float64(limits.Default.MaxBlockSize),
+	}
+
+	maxCapacity := m.backend.Config.DynamicFeeConfig.MaxCapacity
+	if !m.backend.Config.Upgrades.IsEtnaActivated(timestamp) || maxCapacity == 0 {
+		return utilization
+	}
+	gasConsumed := m.gasConsumed(timestamp, txs)
+	return append(utilization, float64(gasConsumed)/float64(maxCapacity))
+}
+
 func (m *manager) free(blkID ids.ID) {
 	delete(m.blkIDToState, blkID)
 }
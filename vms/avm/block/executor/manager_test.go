@@ -12,11 +12,15 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/state/statemock"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs/txsmock"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 )
 
 var (
@@ -160,7 +164,10 @@ func TestManagerVerifyTx(t *testing.T) {
 				lastAcceptedID := ids.GenerateTestID()
 
 				// These values don't matter for this test
+				lastAccepted := block.NewMockBlock(ctrl)
+				lastAccepted.EXPECT().Height().Return(uint64(0))
 				state := statemock.NewState(ctrl)
+				state.EXPECT().GetBlock(lastAcceptedID).Return(lastAccepted, nil)
 				state.EXPECT().GetLastAccepted().Return(lastAcceptedID)
 				state.EXPECT().GetTimestamp().Return(time.Time{})
 
@@ -190,7 +197,10 @@ func TestManagerVerifyTx(t *testing.T) {
 				lastAcceptedID := ids.GenerateTestID()
 
 				// These values don't matter for this test
+				lastAccepted := block.NewMockBlock(ctrl)
+				lastAccepted.EXPECT().Height().Return(uint64(0))
 				state := statemock.NewState(ctrl)
+				state.EXPECT().GetBlock(lastAcceptedID).Return(lastAccepted, nil)
 				state.EXPECT().GetLastAccepted().Return(lastAcceptedID)
 				state.EXPECT().GetTimestamp().Return(time.Time{})
 
@@ -220,7 +230,10 @@ func TestManagerVerifyTx(t *testing.T) {
 				lastAcceptedID := ids.GenerateTestID()
 
 				// These values don't matter for this test
+				lastAccepted := block.NewMockBlock(ctrl)
+				lastAccepted.EXPECT().Height().Return(uint64(0))
 				state := statemock.NewState(ctrl)
+				state.EXPECT().GetBlock(lastAcceptedID).Return(lastAccepted, nil)
 				state.EXPECT().GetLastAccepted().Return(lastAcceptedID)
 				state.EXPECT().GetTimestamp().Return(time.Time{})
 
@@ -282,3 +295,82 @@ func TestVerifyUniqueInputs(t *testing.T) {
 
 	require.NoError(m.VerifyUniqueInputs(blk1ID, set.Of(ids.GenerateTestID())))
 }
+
+func TestManagerGasPrice(t *testing.T) {
+	require := require.New(t)
+
+	backend := defaultTestBackend(true, nil)
+	backend.Config.Upgrades = upgradetest.GetConfig(upgradetest.Latest)
+	backend.Config.DynamicFeeConfig = gas.Config{
+		Weights: gas.Dimensions{
+			gas.Bandwidth: 1,
+		},
+		TargetPerSecond:          1_000,
+		MinPrice:                 1,
+		ExcessConversionConstant: 1_000,
+	}
+
+	var (
+		now = time.Now().Truncate(time.Second)
+		clk = &mockable.Clock{}
+		m   = &manager{
+			backend:      backend,
+			clk:          clk,
+			feeTimestamp: now,
+		}
+		tx = &txs.Tx{Unsigned: &txs.BaseTx{}}
+	)
+	clk.Set(now)
+	tx.SetBytes(nil, make([]byte, 2_000))
+
+	require.Equal(gas.Price(1), m.GasPrice())
+
+	// Accepting a block raises the gas price until the excess gas is
+	// consumed.
+	m.consumeGas(now, []*txs.Tx{tx})
+	require.Equal(gas.Gas(2_000), m.feeExcess)
+	require.Greater(m.GasPrice(), gas.Price(1))
+
+	clk.Set(now.Add(2 * time.Second))
+	require.Equal(gas.Price(1), m.GasPrice())
+
+	// Blocks accepted before Etna don't consume gas.
+	m.backend.Config.Upgrades = upgradetest.GetConfig(upgradetest.Durango)
+	m.consumeGas(now.Add(time.Second), []*txs.Tx{tx})
+	require.Equal(gas.Gas(2_000), m.feeExcess)
+}
+
+func TestManagerUtilization(t *testing.T) {
+	require := require.New(t)
+
+	backend := defaultTestBackend(true, nil)
+	backend.Config.Upgrades = upgradetest.GetConfig(upgradetest.Latest)
+	backend.Config.DynamicFeeConfig = gas.Config{
+		Weights: gas.Dimensions{
+			gas.Bandwidth: 1,
+		},
+		MaxCapacity: 10_000,
+	}
+
+	var (
+		now = time.Now()
+		m   = &manager{
+			backend: backend,
+		}
+		tx = &txs.Tx{Unsigned: &txs.BaseTx{}}
+	)
+	tx.SetBytes(nil, make([]byte, 2_000))
+
+	sizeUtilization := 2_000 / float64(limits.Default.MaxBlockSize)
+	require.Equal(
+		[]float64{sizeUtilization, .2},
+		m.utilization(now, []*txs.Tx{tx}),
+	)
+
+	// Prior to Etna, only the size of the txs is limited.
+	m.backend.Config.Upgrades = upgradetest.GetConfig(upgradetest.Durango)
+	require.Equal(
+		[]float64{sizeUtilization},
+		m.utilization(now, []*txs.Tx{tx}),
+	)
+}
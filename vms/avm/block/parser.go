@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 // CodecVersion is the current default codec version
@@ -35,16 +36,9 @@ func NewParser(fxs []fxs.Fx) (Parser, error) {
 	if err != nil {
 		return nil, err
 	}
-	c := p.CodecRegistry()
-	gc := p.GenesisCodecRegistry()
-
-	err = errors.Join(
-		c.RegisterType(&StandardBlock{}),
-		gc.RegisterType(&StandardBlock{}),
-	)
 	return &parser{
 		Parser: p,
-	}, err
+	}, registerTypes(p, make(map[reflect.Type]int))
 }
 
 func NewCustomParser(
@@ -57,16 +51,27 @@ func NewCustomParser(
 	if err != nil {
 		return nil, err
 	}
+	return &parser{
+		Parser: p,
+	}, registerTypes(p, typeToFxIndex)
+}
+
+func registerTypes(p txs.Parser, typeToFxIndex map[reflect.Type]int) error {
 	c := p.CodecRegistry()
 	gc := p.GenesisCodecRegistry()
 
-	err = errors.Join(
+	// The secp256k1fx.HeightLockedOutput was added after the blocks, so it is
+	// registered last to keep the type IDs of the other types unchanged. It is
+	// handled by the same fx as the secp256k1fx.TransferOutput.
+	if fxIndex, ok := typeToFxIndex[reflect.TypeOf(&secp256k1fx.TransferOutput{})]; ok {
+		typeToFxIndex[reflect.TypeOf(&secp256k1fx.HeightLockedOutput{})] = fxIndex
+	}
+	return errors.Join(
 		c.RegisterType(&StandardBlock{}),
 		gc.RegisterType(&StandardBlock{}),
+		c.RegisterType(&secp256k1fx.HeightLockedOutput{}),
+		gc.RegisterType(&secp256k1fx.HeightLockedOutput{}),
 	)
-	return &parser{
-		Parser: p,
-	}, err
 }
 
 func (p *parser) ParseBlock(bytes []byte) (Block, error) {
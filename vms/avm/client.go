@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 )
 
 var (
@@ -41,6 +42,16 @@ type Client interface {
 	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error)
 	// GetTx returns the byte representation of [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
+	// GetTxsByMemo returns the IDs of the accepted transactions that were
+	// issued with exactly [memo], starting at [cursor], along with the cursor
+	// to use to fetch the next page.
+	GetTxsByMemo(
+		ctx context.Context,
+		memo []byte,
+		cursor uint64,
+		pageSize uint64,
+		options ...rpc.Option,
+	) ([]ids.ID, uint64, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
 	GetUTXOs(
 		ctx context.Context,
@@ -61,6 +72,25 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetUTXODiff returns the byte representation of the UTXOs controlled by
+	// [addrs] that were added after [height], the IDs of the UTXOs that were
+	// removed after [height] and the height the changes were returned up to.
+	GetUTXODiff(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		height uint64,
+		options ...rpc.Option,
+	) ([][]byte, []ids.ID, uint64, error)
+	// GetUTXOSnapshot returns at most [limit] UTXOs of the UTXO set at
+	// [height], starting at [startUTXOID]. If [limit] is 0, the maximum number
+	// of UTXOs is returned.
+	GetUTXOSnapshot(
+		ctx context.Context,
+		height uint64,
+		startUTXOID ids.ID,
+		limit uint32,
+		options ...rpc.Option,
+	) (*avax.UTXOSnapshotPage, error)
 	// GetAssetDescription returns a description of [assetID]
 	GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error)
 	// GetBalance returns the balance of [assetID] held by [addr].
@@ -75,6 +105,10 @@ type Client interface {
 
 	// GetTxFee returns the cost to issue certain transactions
 	GetTxFee(context.Context, ...rpc.Option) (uint64, uint64, error)
+	// SuggestFee returns the gas price that transactions must pay to be added
+	// to the mempool and, if [txBytes] isn't empty, the fee that the signed
+	// transaction must burn
+	SuggestFee(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SuggestFeeReply, error)
 }
 
 // implementation for an AVM client for interacting with avm [chain]
@@ -146,6 +180,22 @@ func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Op
 	return res.Status, err
 }
 
+func (c *client) GetTxsByMemo(
+	ctx context.Context,
+	memo []byte,
+	cursor uint64,
+	pageSize uint64,
+	options ...rpc.Option,
+) ([]ids.ID, uint64, error) {
+	res := &GetTxsByMemoReply{}
+	err := c.requester.SendRequest(ctx, "avm.getTxsByMemo", &GetTxsByMemoArgs{
+		Memo:     memo,
+		Cursor:   json.Uint64(cursor),
+		PageSize: json.Uint64(pageSize),
+	}, res, options...)
+	return res.TxIDs, uint64(res.Cursor), err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "avm.getTx", &api.GetTxArgs{
@@ -209,6 +259,68 @@ func (c *client) GetAtomicUTXOs(
 	return utxos, endAddr, endUTXOID, err
 }
 
+func (c *client) GetUTXODiff(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	height uint64,
+	options ...rpc.Option,
+) ([][]byte, []ids.ID, uint64, error) {
+	res := &api.GetUTXODiffReply{}
+	err := c.requester.SendRequest(ctx, "avm.getUTXODiff", &api.GetUTXODiffArgs{
+		Addresses: ids.ShortIDsToStrings(addrs),
+		Height:    json.Uint64(height),
+		Encoding:  formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	added := make([][]byte, len(res.Added))
+	for i, utxo := range res.Added {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		added[i] = utxoBytes
+	}
+	return added, res.Removed, uint64(res.Height), nil
+}
+
+func (c *client) GetUTXOSnapshot(
+	ctx context.Context,
+	height uint64,
+	startUTXOID ids.ID,
+	limit uint32,
+	options ...rpc.Option,
+) (*avax.UTXOSnapshotPage, error) {
+	res := &api.GetUTXOSnapshotReply{}
+	err := c.requester.SendRequest(ctx, "avm.getUTXOSnapshot", &api.GetUTXOSnapshotArgs{
+		Height:      json.Uint64(height),
+		StartUTXOID: startUTXOID,
+		Limit:       json.Uint32(limit),
+		Encoding:    formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([][]byte, len(res.UTXOs))
+	for i, utxo := range res.UTXOs {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+		if err != nil {
+			return nil, err
+		}
+		utxos[i] = utxoBytes
+	}
+	return &avax.UTXOSnapshotPage{
+		UTXOIDs:    res.UTXOIDs,
+		UTXOs:      utxos,
+		Digest:     res.Digest,
+		More:       res.More,
+		NextUTXOID: res.NextUTXOID,
+	}, nil
+}
+
 func (c *client) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error) {
 	res := &GetAssetDescriptionReply{}
 	err := c.requester.SendRequest(ctx, "avm.getAssetDescription", &GetAssetDescriptionArgs{
@@ -253,6 +365,22 @@ func (c *client) GetTxFee(ctx context.Context, options ...rpc.Option) (uint64, u
 	return uint64(res.TxFee), uint64(res.CreateAssetTxFee), err
 }
 
+func (c *client) SuggestFee(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SuggestFeeReply, error) {
+	args := &SuggestFeeArgs{
+		Encoding: formatting.Hex,
+	}
+	if len(txBytes) > 0 {
+		txStr, err := formatting.Encode(formatting.Hex, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		args.Tx = txStr
+	}
+	res := &SuggestFeeReply{}
+	err := c.requester.SendRequest(ctx, "avm.suggestFee", args, res, options...)
+	return res, err
+}
+
 func AwaitTxAccepted(
 	c Client,
 	ctx context.Context,
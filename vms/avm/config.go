@@ -10,17 +10,25 @@ import (
 )
 
 var DefaultConfig = Config{
-	Network:              network.DefaultConfig,
-	IndexTransactions:    false,
-	IndexAllowIncomplete: false,
-	ChecksumsEnabled:     false,
+	Network:               network.DefaultConfig,
+	IndexTransactions:     false,
+	IndexAllowIncomplete:  false,
+	IndexMemos:            false,
+	ChecksumsEnabled:      false,
+	IndexUTXODiffs:        false,
+	UTXOSnapshotInterval:  0,
+	UTXOSnapshotRetention: 0,
 }
 
 type Config struct {
-	Network              network.Config `json:"network"`
-	IndexTransactions    bool           `json:"index-transactions"`
-	IndexAllowIncomplete bool           `json:"index-allow-incomplete"`
-	ChecksumsEnabled     bool           `json:"checksums-enabled"`
+	Network               network.Config `json:"network"`
+	IndexTransactions     bool           `json:"index-transactions"`
+	IndexAllowIncomplete  bool           `json:"index-allow-incomplete"`
+	IndexMemos            bool           `json:"index-memos"`
+	ChecksumsEnabled      bool           `json:"checksums-enabled"`
+	IndexUTXODiffs        bool           `json:"index-utxo-diffs"`
+	UTXOSnapshotInterval  uint64         `json:"utxo-snapshot-interval"`
+	UTXOSnapshotRetention uint64         `json:"utxo-snapshot-retention"`
 }
 
 func ParseConfig(configBytes []byte) (Config, error) {
@@ -3,7 +3,12 @@
 
 package config
 
-import "github.com/ava-labs/avalanchego/upgrade"
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+)
 
 // Struct collecting all the foundational parameters of the AVM
 type Config struct {
@@ -14,4 +19,18 @@ type Config struct {
 
 	// Fee that must be burned by every asset creating transaction
 	CreateAssetTxFee uint64
+
+	// After the Etna upgrade, transactions must also burn at least the dynamic
+	// fee calculated with this config to be added to the mempool
+	DynamicFeeConfig gas.Config
+}
+
+// DynamicFeeWeights returns the weights used to calculate the gas of txs at
+// [timestamp]. State growth isn't charged before Fortuna.
+func (c *Config) DynamicFeeWeights(timestamp time.Time) gas.Dimensions {
+	weights := c.DynamicFeeConfig.Weights
+	if !c.Upgrades.IsFortunaActivated(timestamp) {
+		weights[gas.StateGrowth] = 0
+	}
+	return weights
 }
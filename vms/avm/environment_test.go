@@ -423,7 +423,7 @@ func issueAndAccept(
 	issuer <-chan common.Message,
 	tx *txs.Tx,
 ) {
-	txID, err := vm.issueTxFromRPC(tx)
+	txID, err := vm.IssueTxFromRPC(tx)
 	require.NoError(err)
 	require.Equal(tx.ID(), txID)
 
@@ -145,6 +145,70 @@ func TestIndexTransaction_MultipleAddresses(t *testing.T) {
 	assertLatestIdx(t, env.vm.db, addr, txAssetID.ID, 1)
 }
 
+func TestIndexTransaction_Memo(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.IndexTransactions = true
+	vmDynamicConfig.IndexMemos = true
+	env := setup(t, &envConfig{
+		fork:            upgradetest.Durango,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	defer env.vm.ctx.Lock.Unlock()
+
+	key := keys[0]
+	addr := key.PublicKey().Address()
+	txAssetID := avax.Asset{ID: env.genesisTx.ID()}
+
+	memos := [][]byte{
+		[]byte("payment reference"),
+		nil,
+		[]byte("payment reference"),
+		[]byte("other reference"),
+	}
+	txIDs := make([]ids.ID, len(memos))
+	for i, memo := range memos {
+		// make utxo
+		utxoID := avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		}
+		utxo := buildUTXO(utxoID, txAssetID, addr)
+		env.vm.state.AddUTXO(utxo)
+
+		// make transaction
+		tx := buildTX(env.vm.ctx.XChainID, utxoID, txAssetID, addr)
+		tx.Unsigned.(*txs.BaseTx).Memo = memo
+		require.NoError(tx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+
+		env.vm.ctx.Lock.Unlock()
+
+		issueAndAccept(require, env.vm, env.issuer, tx)
+
+		env.vm.ctx.Lock.Lock()
+
+		txIDs[i] = tx.ID()
+	}
+
+	indexedTxIDs, err := env.vm.memoTxsIndexer.Read([]byte("payment reference"), 0, maxPageSize)
+	require.NoError(err)
+	require.Equal([]ids.ID{txIDs[0], txIDs[2]}, indexedTxIDs)
+
+	// Read from the cursor
+	indexedTxIDs, err = env.vm.memoTxsIndexer.Read([]byte("payment reference"), 1, maxPageSize)
+	require.NoError(err)
+	require.Equal([]ids.ID{txIDs[2]}, indexedTxIDs)
+
+	indexedTxIDs, err = env.vm.memoTxsIndexer.Read([]byte("other reference"), 0, maxPageSize)
+	require.NoError(err)
+	require.Equal([]ids.ID{txIDs[3]}, indexedTxIDs)
+
+	// Only exact matches are returned
+	indexedTxIDs, err = env.vm.memoTxsIndexer.Read([]byte("payment"), 0, maxPageSize)
+	require.NoError(err)
+	require.Empty(indexedTxIDs)
+}
+
 func TestIndexer_Read(t *testing.T) {
 	require := require.New(t)
 
@@ -234,6 +298,24 @@ func TestIndexingAllowIncomplete(t *testing.T) {
 	require.ErrorIs(err, index.ErrIndexingRequiredFromGenesis)
 }
 
+func TestMemoIndexingNewInitWithIndexingDisabled(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+
+	// disable indexing with allow-incomplete set to false
+	_, err := index.NewNoMemoIndexer(db, false)
+	require.NoError(err)
+
+	// It's not OK to have an incomplete index when allowIncompleteIndices is false
+	_, err = index.NewMemoIndexer(db, logging.NoWarn{}, "", prometheus.NewRegistry(), false)
+	require.ErrorIs(err, index.ErrIndexingRequiredFromGenesis)
+
+	// It's OK to have an incomplete index when allowIncompleteIndices is true
+	_, err = index.NewMemoIndexer(db, logging.NoWarn{}, "", prometheus.NewRegistry(), true)
+	require.NoError(err)
+}
+
 func buildUTXO(utxoID avax.UTXOID, txAssetID avax.Asset, addr ids.ShortID) *avax.UTXO {
 	return &avax.UTXO{
 		UTXOID: utxoID,
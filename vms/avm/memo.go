@@ -0,0 +1,40 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import "github.com/ava-labs/avalanchego/vms/avm/txs"
+
+var _ txs.Visitor = (*memoGetter)(nil)
+
+// txMemo returns the memo of [tx].
+func txMemo(tx txs.UnsignedTx) []byte {
+	g := memoGetter{}
+	_ = tx.Visit(&g) // memoGetter never errors
+	return g.memo
+}
+
+type memoGetter struct {
+	memo []byte
+}
+
+func (g *memoGetter) BaseTx(tx *txs.BaseTx) error {
+	g.memo = tx.Memo
+	return nil
+}
+
+func (g *memoGetter) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
+
+func (g *memoGetter) OperationTx(tx *txs.OperationTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
+
+func (g *memoGetter) ImportTx(tx *txs.ImportTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
+
+func (g *memoGetter) ExportTx(tx *txs.ExportTx) error {
+	return g.BaseTx(&tx.BaseTx)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/nftfx"
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
 
 	xmempool "github.com/ava-labs/avalanchego/vms/avm/txs/mempool"
@@ -101,11 +102,11 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 			},
 			tx: func() *txs.Tx {
 				tx := &txs.Tx{Unsigned: &txs.BaseTx{}}
-				bytes := make([]byte, mempool.MaxTxSize+1)
+				bytes := make([]byte, limits.Default.MaxTxSize+1)
 				tx.SetBytes(bytes, bytes)
 				return tx
 			}(),
-			expectedErr: mempool.ErrTxTooLarge,
+			expectedErr: limits.ErrTxTooLarge,
 		},
 		{
 			name: "tx conflicts",
@@ -158,7 +159,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 
 				for i := 0; i < 1024; i++ {
 					tx := &txs.Tx{Unsigned: &txs.BaseTx{}}
-					bytes := make([]byte, mempool.MaxTxSize)
+					bytes := make([]byte, limits.Default.MaxTxSize)
 					tx.SetBytes(bytes, bytes)
 					tx.TxID = ids.GenerateTestID()
 					require.NoError(t, m.Add(tx))
@@ -20,8 +20,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/types"
 
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 	safemath "github.com/ava-labs/avalanchego/utils/math"
@@ -36,10 +39,12 @@ const (
 )
 
 var (
-	errTxNotCreateAsset = errors.New("transaction doesn't create an asset")
-	errNilTxID          = errors.New("nil transaction ID")
-	errNoAddresses      = errors.New("no addresses provided")
-	errNotLinearized    = errors.New("chain is not linearized")
+	errTxNotCreateAsset     = errors.New("transaction doesn't create an asset")
+	errNilTxID              = errors.New("nil transaction ID")
+	errNoAddresses          = errors.New("no addresses provided")
+	errNotLinearized        = errors.New("chain is not linearized")
+	errMemoIndexingDisabled = errors.New("memo indexing is disabled")
+	errEmptyMemo            = errors.New("memo must be non-empty")
 )
 
 // FormattedAssetID defines a JSON formatted struct containing an assetID as a string
@@ -201,7 +206,7 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSO
 		return err
 	}
 
-	reply.TxID, err = s.vm.issueTxFromRPC(tx)
+	reply.TxID, err = s.vm.IssueTxFromRPC(tx)
 	return err
 }
 
@@ -284,6 +289,56 @@ func (s *Service) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply
 	return nil
 }
 
+type GetTxsByMemoArgs struct {
+	Memo types.JSONByteSlice `json:"memo"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+}
+
+type GetTxsByMemoReply struct {
+	TxIDs []ids.ID `json:"txIDs"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetTxsByMemo returns the IDs of the accepted transactions that were issued
+// with exactly the provided memo.
+func (s *Service) GetTxsByMemo(_ *http.Request, args *GetTxsByMemoArgs, reply *GetTxsByMemoReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getTxsByMemo"),
+		zap.Binary("memo", args.Memo),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if !s.vm.indexMemos {
+		return errMemoIndexingDisabled
+	}
+	if len(args.Memo) == 0 {
+		return errEmptyMemo
+	}
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	txIDs, err := s.vm.memoTxsIndexer.Read(args.Memo, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+	reply.TxIDs = txIDs
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(txIDs)))
+	return nil
+}
+
 // GetTxStatus returns the status of the specified transaction
 //
 // Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
@@ -460,6 +515,109 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 	return nil
 }
 
+// GetUTXODiff returns the changes made to the UTXOs of the passed in addresses
+// after the passed in height
+func (s *Service) GetUTXODiff(_ *http.Request, args *api.GetUTXODiffArgs, reply *api.GetUTXODiffReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getUTXODiff"),
+		logging.UserStrings("addresses", args.Addresses),
+		zap.Uint64("height", uint64(args.Height)),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.chainManager == nil {
+		return errNotLinearized
+	}
+
+	lastAcceptedID := s.vm.state.GetLastAccepted()
+	lastAccepted, err := s.vm.chainManager.GetStatelessBlock(lastAcceptedID)
+	if err != nil {
+		return fmt.Errorf("couldn't get block with id %s: %w", lastAcceptedID, err)
+	}
+
+	startHeight := uint64(args.Height)
+	endHeight := startHeight
+	if lastAcceptedHeight := lastAccepted.Height(); startHeight < lastAcceptedHeight {
+		endHeight = min(lastAcceptedHeight, startHeight+avax.MaxUTXODiffHeights)
+	}
+
+	added, removed, err := avax.GetUTXOChanges(s.vm.state, s.vm.state, addrSet, startHeight, endHeight)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXO changes: %w", err)
+	}
+
+	reply.Added = make([]string, len(added))
+	codec := s.vm.parser.Codec()
+	for i, utxo := range added {
+		b, err := codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("problem marshalling UTXO: %w", err)
+		}
+		reply.Added[i], err = formatting.Encode(args.Encoding, b)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as string: %w", utxo.InputID(), err)
+		}
+	}
+	reply.Removed = removed
+	reply.Height = avajson.Uint64(endHeight)
+	reply.Encoding = args.Encoding
+	return nil
+}
+
+// GetUTXOSnapshot returns a page of the UTXO set at a height that a UTXO
+// snapshot was taken at
+func (s *Service) GetUTXOSnapshot(_ *http.Request, args *api.GetUTXOSnapshotArgs, reply *api.GetUTXOSnapshotReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getUTXOSnapshot"),
+		zap.Uint64("height", uint64(args.Height)),
+		zap.Stringer("startUTXOID", args.StartUTXOID),
+	)
+
+	limit := int(args.Limit)
+	if limit <= 0 || limit > avax.MaxUTXOSnapshotPageSize {
+		limit = avax.MaxUTXOSnapshotPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	page, err := s.vm.state.GetUTXOSnapshot(uint64(args.Height), args.StartUTXOID, limit)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXO snapshot: %w", err)
+	}
+
+	reply.UTXOIDs = make([]ids.ID, len(page.UTXOIDs))
+	reply.UTXOs = make([]string, len(page.UTXOs))
+	for i, utxoBytes := range page.UTXOs {
+		reply.UTXOIDs[i] = page.UTXOIDs[i]
+		reply.UTXOs[i], err = formatting.Encode(args.Encoding, utxoBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as string: %w", page.UTXOIDs[i], err)
+		}
+	}
+	reply.Digest = page.Digest
+	reply.More = page.More
+	reply.NextUTXOID = page.NextUTXOID
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 // GetAssetDescriptionArgs are arguments for passing into GetAssetDescription requests
 type GetAssetDescriptionArgs struct {
 	AssetID string `json:"assetID"`
@@ -675,3 +833,84 @@ func (s *Service) GetTxFee(_ *http.Request, _ *struct{}, reply *GetTxFeeReply) e
 	reply.CreateAssetTxFee = avajson.Uint64(s.vm.CreateAssetTxFee)
 	return nil
 }
+
+type SuggestFeeArgs struct {
+	// Optional signed transaction to calculate the fee of
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+type SuggestFeeReply struct {
+	// Weights used to convert the complexity of a transaction into gas
+	Weights gas.Dimensions `json:"weights"`
+	// Price of the gas that transactions must burn to be added to the mempool
+	Price avajson.Uint64 `json:"price"`
+
+	// The following fields are only set if a transaction was provided
+
+	// Dynamic fee of the transaction in each fee dimension
+	Fees *gas.Dimensions `json:"fees,omitempty"`
+	// Minimum fee the transaction must burn to be added to the mempool, which
+	// is the larger of its static and dynamic fees
+	Fee avajson.Uint64 `json:"fee,omitempty"`
+}
+
+// SuggestFee returns the gas price that transactions must pay to be added to
+// the mempool and, if a transaction is provided, the fee it must burn.
+func (s *Service) SuggestFee(_ *http.Request, args *SuggestFeeArgs, reply *SuggestFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "suggestFee"),
+	)
+
+	var tx *txs.Tx
+	if args.Tx != "" {
+		txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+		if err != nil {
+			return fmt.Errorf("problem decoding transaction: %w", err)
+		}
+		tx, err = s.vm.parser.ParseTx(txBytes)
+		if err != nil {
+			return fmt.Errorf("problem parsing transaction: %w", err)
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.chainManager == nil {
+		return errNotLinearized
+	}
+
+	var (
+		weights       = s.vm.DynamicFeeWeights(s.vm.clock.Time())
+		price         = s.vm.chainManager.GasPrice()
+		feeCalculator = fee.NewCalculator(weights, price)
+	)
+	reply.Weights = weights
+	reply.Price = avajson.Uint64(price)
+	if tx == nil {
+		return nil
+	}
+
+	fees, err := feeCalculator.Fees(tx)
+	if err != nil {
+		return fmt.Errorf("problem calculating fees: %w", err)
+	}
+	dynamicFee, err := feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return fmt.Errorf("problem calculating fee: %w", err)
+	}
+
+	staticFee := s.vm.TxFee
+	if _, ok := tx.Unsigned.(*txs.CreateAssetTx); ok {
+		staticFee = s.vm.CreateAssetTxFee
+	}
+	if !s.vm.Upgrades.IsEtnaActivated(s.vm.clock.Time()) {
+		dynamicFee = 0
+	}
+
+	reply.Fees = &fees
+	reply.Fee = avajson.Uint64(max(staticFee, dynamicFee))
+	return nil
+}
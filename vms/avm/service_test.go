@@ -31,9 +31,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/block/executor/executormock"
+	"github.com/ava-labs/avalanchego/vms/avm/config"
 	"github.com/ava-labs/avalanchego/vms/avm/state/statemock"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/components/index"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/nftfx"
@@ -505,6 +508,58 @@ func TestServiceGetTxFee(t *testing.T) {
 	require.Equal(avajson.Uint64(testTxFee), reply.CreateAssetTxFee)
 }
 
+func TestServiceSuggestFee(t *testing.T) {
+	require := require.New(t)
+
+	weights := gas.Dimensions{
+		gas.Bandwidth: 1,
+		gas.DBRead:    1_000,
+		gas.DBWrite:   1_000,
+		gas.Compute:   4,
+	}
+	env := setup(t, &envConfig{
+		vmStaticConfig: &config.Config{
+			Upgrades:         upgradetest.GetConfig(upgradetest.Latest),
+			TxFee:            testTxFee,
+			CreateAssetTxFee: testTxFee,
+			DynamicFeeConfig: gas.Config{
+				Weights:                  weights,
+				TargetPerSecond:          50_000,
+				MinPrice:                 1,
+				ExcessConversionConstant: 2_164_043,
+			},
+		},
+	})
+	service := &Service{vm: env.vm}
+	env.vm.ctx.Lock.Unlock()
+
+	reply := SuggestFeeReply{}
+	require.NoError(service.SuggestFee(nil, &SuggestFeeArgs{}, &reply))
+	require.Equal(weights, reply.Weights)
+	require.Equal(avajson.Uint64(1), reply.Price)
+	require.Nil(reply.Fees)
+
+	tx := newAvaxBaseTxWithOutputs(t, env)
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	reply = SuggestFeeReply{}
+	require.NoError(service.SuggestFee(nil, &SuggestFeeArgs{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply))
+
+	expectedFee, err := fee.NewCalculator(weights, 1).CalculateFee(tx)
+	require.NoError(err)
+	require.Greater(expectedFee, testTxFee)
+	require.Equal(avajson.Uint64(expectedFee), reply.Fee)
+	require.NotNil(reply.Fees)
+
+	// The tx only burns the static fee, so it isn't added to the mempool.
+	_, err = env.vm.IssueTxFromRPC(tx)
+	require.ErrorIs(err, fee.ErrInsufficientFee)
+}
+
 func TestServiceGetTx(t *testing.T) {
 	require := require.New(t)
 
@@ -33,6 +33,9 @@ var (
 	blockIDPrefix   = []byte("blockID")
 	blockPrefix     = []byte("block")
 	singletonPrefix = []byte("singleton")
+	utxoDiffPrefix  = []byte("utxoDiff")
+
+	utxoSnapshotsPrefix = []byte("utxoSnapshots")
 
 	isInitializedKey = []byte{0x00}
 	timestampKey     = []byte{0x01}
@@ -67,6 +70,8 @@ type Chain interface {
 type State interface {
 	Chain
 	avax.UTXOReader
+	avax.UTXODiffGetter
+	avax.UTXOSnapshotGetter
 
 	IsInitialized() (bool, error)
 	SetInitialized() error
@@ -105,6 +110,13 @@ type State interface {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
+ * |-. utxoDiffs
+ * | '-- height -> UTXO diff
+ * |-. utxoSnapshots
+ * | |-. digest
+ * | | '-- height -> digest
+ * | '-. utxo
+ * |   '-- height + utxoID -> utxo bytes
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
@@ -117,6 +129,13 @@ type state struct {
 	modifiedUTXOs map[ids.ID]*avax.UTXO // map of modified UTXOID -> *UTXO if the UTXO is nil, it has been removed
 	utxoDB        database.Database
 	utxoState     avax.UTXOState
+	utxoDiffDB    database.Database // nil if UTXO diffs aren't indexed
+
+	// nil if UTXO snapshots aren't taken
+	utxoSnapshots        *avax.UTXOSnapshots
+	utxoSnapshotInterval uint64
+	// 0 if UTXO snapshots are never pruned
+	utxoSnapshotRetention uint64
 
 	addedTxs map[ids.ID]*txs.Tx            // map of txID -> *txs.Tx
 	txCache  cache.Cacher[ids.ID, *txs.Tx] // cache of txID -> *txs.Tx. If the entry is nil, it is not in the database
@@ -141,6 +160,9 @@ func New(
 	parser block.Parser,
 	metrics prometheus.Registerer,
 	trackChecksums bool,
+	indexUTXODiffs bool,
+	utxoSnapshotInterval uint64,
+	utxoSnapshotRetention uint64,
 ) (State, error) {
 	utxoDB := prefixdb.New(utxoPrefix, db)
 	txDB := prefixdb.New(txPrefix, db)
@@ -148,6 +170,16 @@ func New(
 	blockDB := prefixdb.New(blockPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
 
+	var utxoDiffDB database.Database
+	if indexUTXODiffs {
+		utxoDiffDB = prefixdb.New(utxoDiffPrefix, db)
+	}
+
+	var utxoSnapshots *avax.UTXOSnapshots
+	if utxoSnapshotInterval > 0 {
+		utxoSnapshots = avax.NewUTXOSnapshots(prefixdb.New(utxoSnapshotsPrefix, db))
+	}
+
 	txCache, err := metercacher.New[ids.ID, *txs.Tx](
 		"tx_cache",
 		metrics,
@@ -187,6 +219,11 @@ func New(
 		modifiedUTXOs: make(map[ids.ID]*avax.UTXO),
 		utxoDB:        utxoDB,
 		utxoState:     utxoState,
+		utxoDiffDB:    utxoDiffDB,
+
+		utxoSnapshots:         utxoSnapshots,
+		utxoSnapshotInterval:  utxoSnapshotInterval,
+		utxoSnapshotRetention: utxoSnapshotRetention,
 
 		addedTxs: make(map[ids.ID]*txs.Tx),
 		txCache:  txCache,
@@ -407,10 +444,40 @@ func (s *state) Close() error {
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
 		s.singletonDB.Close(),
+		s.closeUTXODiffDB(),
+		s.closeUTXOSnapshots(),
 		s.db.Close(),
 	)
 }
 
+func (s *state) closeUTXODiffDB() error {
+	if s.utxoDiffDB == nil {
+		return nil
+	}
+	return s.utxoDiffDB.Close()
+}
+
+func (s *state) closeUTXOSnapshots() error {
+	if s.utxoSnapshots == nil {
+		return nil
+	}
+	return s.utxoSnapshots.Close()
+}
+
+func (s *state) GetUTXOSnapshot(height uint64, startUTXOID ids.ID, limit int) (*avax.UTXOSnapshotPage, error) {
+	if s.utxoSnapshots == nil {
+		return nil, avax.ErrUTXOSnapshotsDisabled
+	}
+	return s.utxoSnapshots.Get(height, startUTXOID, limit)
+}
+
+func (s *state) GetUTXODiff(height uint64) (*avax.UTXODiff, error) {
+	if s.utxoDiffDB == nil {
+		return nil, avax.ErrUTXODiffsDisabled
+	}
+	return avax.GetUTXODiff(s.utxoDiffDB, height)
+}
+
 func (s *state) write() error {
 	return errors.Join(
 		s.writeUTXOs(),
@@ -422,6 +489,7 @@ func (s *state) write() error {
 }
 
 func (s *state) writeUTXOs() error {
+	var diff avax.UTXODiff
 	for utxoID, utxo := range s.modifiedUTXOs {
 		delete(s.modifiedUTXOs, utxoID)
 
@@ -429,15 +497,59 @@ func (s *state) writeUTXOs() error {
 			if err := s.utxoState.PutUTXO(utxo); err != nil {
 				return fmt.Errorf("failed to add utxo: %w", err)
 			}
+			diff.Added = append(diff.Added, utxoID)
 		} else {
 			if err := s.utxoState.DeleteUTXO(utxoID); err != nil {
 				return fmt.Errorf("failed to remove utxo: %w", err)
 			}
+			diff.Removed = append(diff.Removed, utxoID)
+		}
+	}
+
+	if err := s.writeUTXOSnapshot(); err != nil {
+		return fmt.Errorf("failed to add utxo snapshot: %w", err)
+	}
+
+	// The UTXO diff is only recorded when a single block is being committed,
+	// as otherwise the height of the changes is unknown. This must be called
+	// before the block IDs are written, as writing them clears them.
+	if s.utxoDiffDB == nil || len(s.addedBlockIDs) != 1 {
+		return nil
+	}
+	for height := range s.addedBlockIDs {
+		if err := avax.PutUTXODiff(s.utxoDiffDB, height, &diff); err != nil {
+			return fmt.Errorf("failed to add utxo diff: %w", err)
 		}
 	}
 	return nil
 }
 
+// writeUTXOSnapshot copies the UTXO set if the height of the last block being
+// committed is a multiple of the snapshot interval, and prunes the snapshot
+// that is no longer retained. This must be called before the block IDs are
+// written, as writing them clears them.
+func (s *state) writeUTXOSnapshot() error {
+	if s.utxoSnapshots == nil || len(s.addedBlockIDs) == 0 {
+		return nil
+	}
+
+	var height uint64
+	for blkHeight := range s.addedBlockIDs {
+		height = max(height, blkHeight)
+	}
+	if height%s.utxoSnapshotInterval != 0 {
+		return nil
+	}
+	if _, err := s.utxoSnapshots.Put(height, s.utxoState.NewUTXOIterator()); err != nil {
+		return err
+	}
+
+	if s.utxoSnapshotRetention == 0 || height/s.utxoSnapshotInterval < s.utxoSnapshotRetention {
+		return nil
+	}
+	return s.utxoSnapshots.Delete(height - s.utxoSnapshotRetention*s.utxoSnapshotInterval)
+}
+
 func (s *state) writeTxs() error {
 	for txID, tx := range s.addedTxs {
 		txBytes := tx.Bytes()
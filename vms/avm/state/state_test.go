@@ -102,7 +102,7 @@ func TestState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -110,7 +110,7 @@ func TestState(t *testing.T) {
 	s.AddBlock(populatedBlk)
 	require.NoError(s.Commit())
 
-	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	ChainUTXOTest(t, s)
@@ -118,12 +118,79 @@ func TestState(t *testing.T) {
 	ChainBlockTest(t, s)
 }
 
+func TestUTXODiffs(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, true /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
+	require.NoError(err)
+
+	// Changes that aren't made by a single block aren't recorded.
+	s.AddUTXO(populatedUTXO)
+	require.NoError(s.Commit())
+
+	_, err = s.GetUTXODiff(populatedBlkHeight)
+	require.ErrorIs(err, avax.ErrUTXODiffUnavailable)
+
+	removedUTXOID := ids.GenerateTestID()
+	s.DeleteUTXO(removedUTXOID)
+	s.AddBlock(populatedBlk)
+	require.NoError(s.Commit())
+
+	diff, err := s.GetUTXODiff(populatedBlkHeight)
+	require.NoError(err)
+	require.Equal(
+		&avax.UTXODiff{
+			Added:   []ids.ID{},
+			Removed: []ids.ID{removedUTXOID},
+		},
+		diff,
+	)
+
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
+	require.NoError(err)
+
+	_, err = s.GetUTXODiff(populatedBlkHeight)
+	require.ErrorIs(err, avax.ErrUTXODiffsDisabled)
+}
+
+func TestUTXOSnapshots(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false /*=indexUTXODiffs*/, populatedBlkHeight /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
+	require.NoError(err)
+
+	// Changes that aren't made by a block aren't snapshotted.
+	s.AddUTXO(populatedUTXO)
+	require.NoError(s.Commit())
+
+	_, err = s.GetUTXOSnapshot(populatedBlkHeight, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.ErrorIs(err, avax.ErrUTXOSnapshotUnavailable)
+
+	s.AddBlock(populatedBlk)
+	require.NoError(s.Commit())
+
+	page, err := s.GetUTXOSnapshot(populatedBlkHeight, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.NoError(err)
+	require.Equal([]ids.ID{populatedUTXO.InputID()}, page.UTXOIDs)
+	require.False(page.More)
+
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
+	require.NoError(err)
+
+	_, err = s.GetUTXOSnapshot(populatedBlkHeight, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.ErrorIs(err, avax.ErrUTXOSnapshotsDisabled)
+}
+
 func TestDiff(t *testing.T) {
 	require := require.New(t)
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	s.AddUTXO(populatedUTXO)
@@ -283,7 +350,7 @@ func TestInitializeChainState(t *testing.T) {
 
 	db := memdb.New()
 	vdb := versiondb.New(db)
-	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	stopVertexID := ids.GenerateTestID()
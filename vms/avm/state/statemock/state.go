@@ -250,6 +250,36 @@ func (mr *StateMockRecorder) GetUTXO(utxoID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*State)(nil).GetUTXO), utxoID)
 }
 
+// GetUTXODiff mocks base method.
+func (m *State) GetUTXODiff(height uint64) (*avax.UTXODiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXODiff", height)
+	ret0, _ := ret[0].(*avax.UTXODiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXODiff indicates an expected call of GetUTXODiff.
+func (mr *StateMockRecorder) GetUTXODiff(height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXODiff", reflect.TypeOf((*State)(nil).GetUTXODiff), height)
+}
+
+// GetUTXOSnapshot mocks base method.
+func (m *State) GetUTXOSnapshot(height uint64, startUTXOID ids.ID, limit int) (*avax.UTXOSnapshotPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOSnapshot", height, startUTXOID, limit)
+	ret0, _ := ret[0].(*avax.UTXOSnapshotPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXOSnapshot indicates an expected call of GetUTXOSnapshot.
+func (mr *StateMockRecorder) GetUTXOSnapshot(height, startUTXOID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOSnapshot", reflect.TypeOf((*State)(nil).GetUTXOSnapshot), height, startUTXOID, limit)
+}
+
 // InitializeChainState mocks base method.
 func (m *State) InitializeChainState(stopVertexID ids.ID, genesisTimestamp time.Time) error {
 	m.ctrl.T.Helper()
@@ -23,4 +23,8 @@ type Backend struct {
 	// running in a subnet.
 	FeeAssetID   ids.ID
 	Bootstrapped bool
+
+	// Height is the height of the block that the txs are being verified in.
+	// Height locked UTXOs can only be spent once it reaches their lock height.
+	Height uint64
 }
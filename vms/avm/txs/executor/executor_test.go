@@ -45,7 +45,7 @@ func TestBaseTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := state.New(vdb, parser, registerer, trackChecksums)
+	state, err := state.New(vdb, parser, registerer, trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	utxoID := avax.UTXOID{
@@ -152,7 +152,7 @@ func TestCreateAssetTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := state.New(vdb, parser, registerer, trackChecksums)
+	state, err := state.New(vdb, parser, registerer, trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	utxoID := avax.UTXOID{
@@ -297,7 +297,7 @@ func TestOperationTxExecutor(t *testing.T) {
 	db := memdb.New()
 	vdb := versiondb.New(db)
 	registerer := prometheus.NewRegistry()
-	state, err := state.New(vdb, parser, registerer, trackChecksums)
+	state, err := state.New(vdb, parser, registerer, trackChecksums, false /*=indexUTXODiffs*/, 0 /*=utxoSnapshotInterval*/, 0 /*=utxoSnapshotRetention*/)
 	require.NoError(err)
 
 	outputOwners := secp256k1fx.OutputOwners{
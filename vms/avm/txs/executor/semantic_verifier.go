@@ -13,15 +13,19 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var (
-	_ txs.Visitor = (*SemanticVerifier)(nil)
+	_ txs.Visitor          = (*SemanticVerifier)(nil)
+	_ secp256k1fx.HeightTx = (*heightTx)(nil)
 
 	errAssetIDMismatch = errors.New("asset IDs in the input don't match the utxo")
 	errNotAnAsset      = errors.New("not an asset")
 	errIncompatibleFx  = errors.New("incompatible feature extension")
 	errUnknownFx       = errors.New("unknown feature extension")
+
+	errHeightLockedOutputNotActive = errors.New("attempting to use height locked outputs before the Fortuna upgrade")
 )
 
 type SemanticVerifier struct {
@@ -30,7 +34,21 @@ type SemanticVerifier struct {
 	Tx    *txs.Tx
 }
 
+// heightTx is a tx that is being verified in the block at [height].
+type heightTx struct {
+	txs.UnsignedTx
+	height uint64
+}
+
+func (tx *heightTx) Height() uint64 {
+	return tx.height
+}
+
 func (v *SemanticVerifier) BaseTx(tx *txs.BaseTx) error {
+	if err := v.verifyHeightLockedOutputs(); err != nil {
+		return err
+	}
+
 	for i, in := range tx.Ins {
 		// Note: Verification of the length of [t.tx.Creds] happens during
 		// syntactic verification, which happens before semantic verification.
@@ -179,8 +197,18 @@ func (v *SemanticVerifier) verifyTransferOfUTXO(
 		return err
 	}
 
+	// The fxs are told the height of the block that [tx] is being verified in
+	// so that they can verify spends of height locked UTXOs.
 	fx := v.Fxs[fxIndex].Fx
-	return fx.VerifyTransfer(tx, in.In, cred, utxo.Out)
+	return fx.VerifyTransfer(
+		&heightTx{
+			UnsignedTx: tx,
+			height:     v.Height,
+		},
+		in.In,
+		cred,
+		utxo.Out,
+	)
 }
 
 func (v *SemanticVerifier) verifyOperation(
@@ -242,6 +270,35 @@ func (v *SemanticVerifier) verifyFxUsage(
 	return errIncompatibleFx
 }
 
+// verifyHeightLockedOutputs returns an error if [v.Tx] produces height locked
+// outputs before the Fortuna upgrade was activated.
+//
+// This is verified against all the outputs of [v.Tx], including the initial
+// states of assets, the outputs of operations and the exported outputs.
+func (v *SemanticVerifier) verifyHeightLockedOutputs() error {
+	utxos := v.Tx.UTXOs()
+	outs := make([]verify.State, len(utxos))
+	for i, utxo := range utxos {
+		outs[i] = utxo.Out
+	}
+	if tx, ok := v.Tx.Unsigned.(*txs.ExportTx); ok {
+		for _, out := range tx.ExportedOuts {
+			outs = append(outs, out.Out)
+		}
+	}
+
+	for _, out := range outs {
+		if _, ok := out.(*secp256k1fx.HeightLockedOutput); !ok {
+			continue
+		}
+		if !v.Config.Upgrades.IsFortunaActivated(v.State.GetTimestamp()) {
+			return errHeightLockedOutputNotActive
+		}
+		return nil
+	}
+	return nil
+}
+
 func (v *SemanticVerifier) getFx(val interface{}) (int, error) {
 	valType := reflect.TypeOf(val)
 	fx, exists := v.TypeToFxIndex[valType]
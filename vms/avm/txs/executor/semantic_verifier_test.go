@@ -17,10 +17,14 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators/validatorsmock"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/avm/block"
+	"github.com/ava-labs/avalanchego/vms/avm/config"
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
 	"github.com/ava-labs/avalanchego/vms/avm/state"
 	"github.com/ava-labs/avalanchego/vms/avm/state/statemock"
@@ -1121,3 +1125,199 @@ func TestSemanticVerifierImportTx(t *testing.T) {
 		})
 	}
 }
+
+func TestSemanticVerifierHeightLockedOutput(t *testing.T) {
+	ctx := snowtest.Context(t, snowtest.XChainID)
+
+	var (
+		typeToFxIndex = make(map[reflect.Type]int)
+		secpFx        = &secp256k1fx.Fx{}
+	)
+	parser, err := block.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, secpFx.Bootstrapped())
+
+	var (
+		codec = parser.Codec()
+		asset = avax.Asset{
+			ID: ids.GenerateTestID(),
+		}
+		utxoID = avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		}
+		lockedOutput = &secp256k1fx.HeightLockedOutput{
+			LockHeight: 5,
+			TransferOutput: secp256k1fx.TransferOutput{
+				Amt: 12345,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						keys[0].Address(),
+					},
+				},
+			},
+		}
+		createAssetTx = &txs.Tx{
+			Unsigned: &txs.CreateAssetTx{
+				States: []*txs.InitialState{{
+					FxIndex: 0,
+				}},
+			},
+		}
+		createTx = &txs.Tx{
+			Unsigned: &txs.BaseTx{
+				BaseTx: avax.BaseTx{
+					Outs: []*avax.TransferableOutput{
+						{
+							Asset: asset,
+							Out:   lockedOutput,
+						},
+					},
+				},
+			},
+		}
+		createLockedAssetTx = &txs.Tx{
+			Unsigned: &txs.CreateAssetTx{
+				States: []*txs.InitialState{{
+					FxIndex: 0,
+					Outs: []verify.State{
+						lockedOutput,
+					},
+				}},
+			},
+		}
+		exportTx = &txs.Tx{
+			Unsigned: &txs.ExportTx{
+				DestinationChain: ctx.CChainID,
+				ExportedOuts: []*avax.TransferableOutput{
+					{
+						Asset: asset,
+						Out:   lockedOutput,
+					},
+				},
+			},
+		}
+		spendTx = &txs.Tx{
+			Unsigned: &txs.BaseTx{
+				BaseTx: avax.BaseTx{
+					Ins: []*avax.TransferableInput{
+						{
+							UTXOID: utxoID,
+							Asset:  asset,
+							In: &secp256k1fx.TransferInput{
+								Amt: 12345,
+								Input: secp256k1fx.Input{
+									SigIndices: []uint32{0},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	)
+	require.NoError(t, createTx.SignSECP256K1Fx(codec, nil))
+	require.NoError(t, createLockedAssetTx.SignSECP256K1Fx(codec, nil))
+	require.NoError(t, exportTx.SignSECP256K1Fx(codec, nil))
+	require.NoError(t, spendTx.SignSECP256K1Fx(
+		codec,
+		[][]*secp256k1.PrivateKey{
+			{keys[0]},
+		},
+	))
+
+	tests := []struct {
+		name        string
+		fork        upgradetest.Fork
+		height      uint64
+		tx          *txs.Tx
+		expectedErr error
+	}{
+		{
+			name:        "created before fortuna",
+			fork:        upgradetest.Etna,
+			tx:          createTx,
+			expectedErr: errHeightLockedOutputNotActive,
+		},
+		{
+			name: "created after fortuna",
+			fork: upgradetest.Fortuna,
+			tx:   createTx,
+		},
+		{
+			name:        "asset initial state created before fortuna",
+			fork:        upgradetest.Etna,
+			tx:          createLockedAssetTx,
+			expectedErr: errHeightLockedOutputNotActive,
+		},
+		{
+			name: "asset initial state created after fortuna",
+			fork: upgradetest.Fortuna,
+			tx:   createLockedAssetTx,
+		},
+		{
+			name:        "exported before fortuna",
+			fork:        upgradetest.Etna,
+			tx:          exportTx,
+			expectedErr: errHeightLockedOutputNotActive,
+		},
+		{
+			name:        "spent before lock height",
+			fork:        upgradetest.Fortuna,
+			height:      4,
+			tx:          spendTx,
+			expectedErr: secp256k1fx.ErrHeightLocked,
+		},
+		{
+			name:   "spent at lock height",
+			fork:   upgradetest.Fortuna,
+			height: 5,
+			tx:     spendTx,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			state := statemock.NewChain(ctrl)
+			state.EXPECT().GetTimestamp().Return(upgrade.InitiallyActiveTime).AnyTimes()
+			state.EXPECT().GetUTXO(utxoID.InputID()).Return(&avax.UTXO{
+				UTXOID: utxoID,
+				Asset:  asset,
+				Out:    lockedOutput,
+			}, nil).AnyTimes()
+			state.EXPECT().GetTx(asset.ID).Return(createAssetTx, nil).AnyTimes()
+
+			err := test.tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: &Backend{
+					Ctx: ctx,
+					Config: &config.Config{
+						Upgrades: upgradetest.GetConfig(test.fork),
+					},
+					Fxs: []*fxs.ParsedFx{
+						{
+							ID: secp256k1fx.ID,
+							Fx: secpFx,
+						},
+					},
+					TypeToFxIndex: typeToFxIndex,
+					Codec:         codec,
+					FeeAssetID:    ids.GenerateTestID(),
+					Bootstrapped:  true,
+					Height:        test.height,
+				},
+				State: state,
+				Tx:    test.tx,
+			})
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
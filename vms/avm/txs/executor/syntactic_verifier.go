@@ -14,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 const (
@@ -43,6 +44,7 @@ var (
 	errDoubleSpend                  = errors.New("inputs attempt to double spend an input")
 	errNoImportInputs               = errors.New("no import inputs")
 	errNoExportOutputs              = errors.New("no export outputs")
+	errHeightLockedExport           = errors.New("height locked outputs can't be exported")
 )
 
 type SyntacticVerifier struct {
@@ -264,6 +266,14 @@ func (v *SyntacticVerifier) ExportTx(tx *txs.ExportTx) error {
 		return errNoExportOutputs
 	}
 
+	// The lock height of an output is only meaningful on the chain it was
+	// created on.
+	for _, out := range tx.ExportedOuts {
+		if _, ok := out.Out.(*secp256k1fx.HeightLockedOutput); ok {
+			return errHeightLockedExport
+		}
+	}
+
 	if err := tx.BaseTx.BaseTx.Verify(v.Ctx); err != nil {
 		return err
 	}
@@ -2010,6 +2010,26 @@ func TestSyntacticVerifierExportTx(t *testing.T) {
 			},
 			err: errNoExportOutputs,
 		},
+		{
+			name: "height locked exported output",
+			txFunc: func() *txs.Tx {
+				tx := tx
+				tx.ExportedOuts = []*avax.TransferableOutput{
+					{
+						Asset: asset,
+						Out: &secp256k1fx.HeightLockedOutput{
+							LockHeight:     1,
+							TransferOutput: fxOutput,
+						},
+					},
+				}
+				return &txs.Tx{
+					Unsigned: &tx,
+					Creds:    creds,
+				}
+			},
+			err: errHeightLockedExport,
+		},
 		{
 			name: "wrong networkID",
 			txFunc: func() *txs.Tx {
@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+)
+
+var (
+	_ txs.Visitor = (*burnedCalculator)(nil)
+
+	ErrInsufficientFee = errors.New("insufficient fee")
+)
+
+// Calculator calculates the dynamic fee of X-chain transactions.
+type Calculator struct {
+	weights gas.Dimensions
+	price   gas.Price
+}
+
+func NewCalculator(weights gas.Dimensions, price gas.Price) *Calculator {
+	return &Calculator{
+		weights: weights,
+		price:   price,
+	}
+}
+
+// Fees returns the fee [tx] must burn for each fee dimension. The fee of [tx]
+// is the sum of these fees.
+func (c *Calculator) Fees(tx *txs.Tx) (gas.Dimensions, error) {
+	complexity, err := TxComplexity(tx)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+
+	var fees gas.Dimensions
+	for i, weight := range c.weights {
+		dimensionGas, err := math.Mul(complexity[i], weight)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+		fees[i], err = gas.Gas(dimensionGas).Cost(c.price)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	}
+	return fees, nil
+}
+
+// CalculateFee returns the fee [tx] must burn.
+func (c *Calculator) CalculateFee(tx *txs.Tx) (uint64, error) {
+	fees, err := c.Fees(tx)
+	if err != nil {
+		return 0, err
+	}
+	return sum(fees)
+}
+
+// VerifyTx returns an error if [tx] burns less [feeAssetID] than its fee.
+//
+// The error reports the minimum fee of each fee dimension, so that the issuer
+// can tell why the fee is higher than expected.
+func (c *Calculator) VerifyTx(tx *txs.Tx, feeAssetID ids.ID) error {
+	fees, err := c.Fees(tx)
+	if err != nil {
+		return err
+	}
+	fee, err := sum(fees)
+	if err != nil {
+		return err
+	}
+	burned, err := Burned(tx.Unsigned, feeAssetID)
+	if err != nil {
+		return err
+	}
+	if burned >= fee {
+		return nil
+	}
+
+	feeStrs := make([]string, len(fees))
+	for i, dimensionFee := range fees {
+		feeStrs[i] = fmt.Sprintf("%s: %d", gas.Dimension(i), dimensionFee)
+	}
+	return fmt.Errorf("%w: burned (%d) < fee (%d) at gas price (%d) {%s}",
+		ErrInsufficientFee,
+		burned,
+		fee,
+		c.price,
+		strings.Join(feeStrs, ", "),
+	)
+}
+
+func sum(fees gas.Dimensions) (uint64, error) {
+	var total uint64
+	for _, fee := range fees {
+		var err error
+		total, err = math.Add(total, fee)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return total, nil
+}
+
+// Burned returns the amount of [assetID] consumed by [tx] and not produced by
+// it.
+func Burned(tx txs.UnsignedTx, assetID ids.ID) (uint64, error) {
+	b := burnedCalculator{
+		assetID: assetID,
+	}
+	if err := tx.Visit(&b); err != nil {
+		return 0, err
+	}
+	return math.Sub(b.consumed, b.produced)
+}
+
+type burnedCalculator struct {
+	assetID  ids.ID
+	consumed uint64
+	produced uint64
+}
+
+func (b *burnedCalculator) BaseTx(tx *txs.BaseTx) error {
+	if err := b.consume(tx.Ins); err != nil {
+		return err
+	}
+	return b.produce(tx.Outs)
+}
+
+func (b *burnedCalculator) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	return b.BaseTx(&tx.BaseTx)
+}
+
+func (b *burnedCalculator) OperationTx(tx *txs.OperationTx) error {
+	return b.BaseTx(&tx.BaseTx)
+}
+
+func (b *burnedCalculator) ImportTx(tx *txs.ImportTx) error {
+	if err := b.BaseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return b.consume(tx.ImportedIns)
+}
+
+func (b *burnedCalculator) ExportTx(tx *txs.ExportTx) error {
+	if err := b.BaseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return b.produce(tx.ExportedOuts)
+}
+
+func (b *burnedCalculator) consume(ins []*avax.TransferableInput) error {
+	for _, in := range ins {
+		if in.AssetID() != b.assetID {
+			continue
+		}
+		var err error
+		b.consumed, err = math.Add(b.consumed, in.Input().Amount())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *burnedCalculator) produce(outs []*avax.TransferableOutput) error {
+	for _, out := range outs {
+		if out.AssetID() != b.assetID {
+			continue
+		}
+		var err error
+		b.produced, err = math.Add(b.produced, out.Output().Amount())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
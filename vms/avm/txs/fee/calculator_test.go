@@ -0,0 +1,210 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	feeAssetID   = ids.GenerateTestID()
+	otherAssetID = ids.GenerateTestID()
+
+	testWeights = gas.Dimensions{
+		gas.Bandwidth:   1,
+		gas.DBRead:      1_000,
+		gas.DBWrite:     1_000,
+		gas.Compute:     4,
+		gas.StateGrowth: 1,
+	}
+)
+
+func newExportTx(t *testing.T) *txs.Tx {
+	require := require.New(t)
+
+	key := secp256k1.TestKeys()[0]
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{key.Address()},
+	}
+	input := func(assetID ids.ID, amount uint64) *avax.TransferableInput {
+		return &avax.TransferableInput{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: assetID},
+			In: &secp256k1fx.TransferInput{
+				Amt: amount,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+		}
+	}
+	output := func(assetID ids.ID, amount uint64) *avax.TransferableOutput {
+		return &avax.TransferableOutput{
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: owners,
+			},
+		}
+	}
+
+	tx := &txs.Tx{Unsigned: &txs.ExportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: ids.GenerateTestID(),
+			Ins: []*avax.TransferableInput{
+				input(feeAssetID, 100),
+				input(otherAssetID, 50),
+			},
+			Outs: []*avax.TransferableOutput{
+				output(feeAssetID, 60),
+				output(otherAssetID, 50),
+			},
+		}},
+		DestinationChain: ids.GenerateTestID(),
+		ExportedOuts: []*avax.TransferableOutput{
+			output(feeAssetID, 10),
+		},
+	}}
+
+	parser, err := txs.NewParser(
+		[]fxs.Fx{
+			&secp256k1fx.Fx{},
+		},
+	)
+	require.NoError(err)
+	require.NoError(tx.SignSECP256K1Fx(
+		parser.Codec(),
+		[][]*secp256k1.PrivateKey{
+			{key},
+			{key},
+		},
+	))
+	return tx
+}
+
+func TestTxComplexity(t *testing.T) {
+	require := require.New(t)
+
+	tx := newExportTx(t)
+	complexity, err := TxComplexity(tx)
+	require.NoError(err)
+	require.Equal(
+		gas.Dimensions{
+			gas.Bandwidth:   uint64(tx.Size()),
+			gas.DBRead:      2,       // 2 inputs
+			gas.DBWrite:     2 + 3,   // 2 inputs + 2 outputs + 1 exported output
+			gas.Compute:     2 * 200, // 2 signatures
+			gas.StateGrowth: 3 * 118, // 2 outputs + 1 exported output, with 1 address each
+		},
+		complexity,
+	)
+}
+
+func TestUTXOStateGrowth(t *testing.T) {
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	tests := []struct {
+		name     string
+		out      verify.State
+		expected uint64
+	}{
+		{
+			name: "transfer output",
+			out: &secp256k1fx.TransferOutput{
+				Amt:          1,
+				OutputOwners: owners,
+			},
+			expected: 118,
+		},
+		{
+			name: "height locked output",
+			out: &secp256k1fx.HeightLockedOutput{
+				LockHeight: 1,
+				TransferOutput: secp256k1fx.TransferOutput{
+					Amt:          1,
+					OutputOwners: owners,
+				},
+			},
+			expected: 118 + 8, // lock height
+		},
+		{
+			name:     "unknown output",
+			out:      &avax.TestState{},
+			expected: intrinsicUTXOStateGrowth,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, utxoStateGrowth(test.out))
+		})
+	}
+}
+
+func TestBurned(t *testing.T) {
+	require := require.New(t)
+
+	tx := newExportTx(t)
+	burned, err := Burned(tx.Unsigned, feeAssetID)
+	require.NoError(err)
+	require.Equal(uint64(100-60-10), burned)
+
+	burned, err = Burned(tx.Unsigned, otherAssetID)
+	require.NoError(err)
+	require.Zero(burned)
+}
+
+func TestCalculatorVerifyTx(t *testing.T) {
+	tx := newExportTx(t)
+	complexity, err := TxComplexity(tx)
+	require.NoError(t, err)
+	txGas, err := complexity.ToGas(testWeights)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		price       gas.Price
+		expectedErr error
+	}{
+		{
+			name:  "free",
+			price: 0,
+		},
+		{
+			name:        "insufficient fee",
+			price:       1,
+			expectedErr: ErrInsufficientFee,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			calculator := NewCalculator(testWeights, test.price)
+			fee, err := calculator.CalculateFee(tx)
+			require.NoError(err)
+			require.Equal(uint64(txGas)*uint64(test.price), fee)
+
+			err = calculator.VerifyTx(tx, feeAssetID)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
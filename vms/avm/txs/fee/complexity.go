@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package fee calculates the dynamic fees of X-chain transactions.
+//
+// Unlike the P-chain, the X-chain doesn't charge dynamic fees in consensus.
+// Dynamic fees are only enforced when transactions are added to the mempool,
+// so that the X-chain isn't flooded with transactions when its blocks are
+// full.
+package fee
+
+import (
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const (
+	intrinsicInputDBRead  = 1
+	intrinsicInputDBWrite = 1
+	// Consuming an output and producing an output both write to the database
+	intrinsicOutputDBWrite = 1
+
+	// Signature verification costs match the P-chain.
+	intrinsicSECP256k1FxSignatureCompute = 200 // secp256k1 signature verification time is around 200us
+
+	intrinsicUTXOStateGrowth = codec.VersionSize + // codecVersion
+		ids.IDLen + // txID
+		wrappers.IntLen + // output index
+		ids.IDLen + // assetID
+		wrappers.IntLen // output typeID
+
+	intrinsicOutputOwnersStateGrowth = wrappers.LongLen + // locktime
+		wrappers.IntLen + // threshold
+		wrappers.IntLen // num addresses
+)
+
+var _ txs.Visitor = (*exportedOutputsGetter)(nil)
+
+// TxComplexity returns the complexity of [tx] in each fee dimension.
+//
+// Because the X-chain supports transactions of any fx, the complexity is
+// measured from the signed transaction rather than from its fields:
+//   - Bandwidth is the size of the signed transaction.
+//   - DBRead is the number of consumed UTXOs.
+//   - DBWrite is the number of consumed and produced UTXOs, including the
+//     UTXOs exported to other chains.
+//   - Compute is the number of signatures to verify.
+//   - StateGrowth is the size of the produced UTXOs, including the UTXOs
+//     exported to other chains.
+func TxComplexity(tx *txs.Tx) (gas.Dimensions, error) {
+	var (
+		numInputs = uint64(len(tx.Unsigned.InputUTXOs()))
+		utxos     = tx.UTXOs()
+		exported  exportedOutputsGetter
+	)
+	// The visit error is explicitly dropped here because no error is ever
+	// returned from the exportedOutputsGetter.
+	_ = tx.Unsigned.Visit(&exported)
+
+	outputs := make([]verify.State, 0, len(utxos)+len(exported.outs))
+	for _, utxo := range utxos {
+		outputs = append(outputs, utxo.Out)
+	}
+	for _, out := range exported.outs {
+		outputs = append(outputs, out.Out)
+	}
+	numOutputs := uint64(len(outputs))
+
+	var (
+		complexity gas.Dimensions
+		err        error
+	)
+	complexity[gas.Bandwidth] = uint64(tx.Size())
+	complexity[gas.DBRead], err = math.Mul(numInputs, intrinsicInputDBRead)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+
+	inputsDBWrite, err := math.Mul(numInputs, intrinsicInputDBWrite)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+	outputsDBWrite, err := math.Mul(numOutputs, intrinsicOutputDBWrite)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+	complexity[gas.DBWrite], err = math.Add(inputsDBWrite, outputsDBWrite)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+
+	complexity[gas.Compute], err = math.Mul(numSignatures(tx), intrinsicSECP256k1FxSignatureCompute)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+
+	for _, out := range outputs {
+		complexity[gas.StateGrowth], err = math.Add(complexity[gas.StateGrowth], utxoStateGrowth(out))
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	}
+	return complexity, nil
+}
+
+// utxoStateGrowth returns the size of the UTXO that holds [out]. The fields of
+// outputs of unknown fxs aren't included.
+func utxoStateGrowth(out verify.State) uint64 {
+	var (
+		size   uint64 = intrinsicUTXOStateGrowth
+		owners *secp256k1fx.OutputOwners
+	)
+	switch out := out.(type) {
+	case *secp256k1fx.TransferOutput:
+		size += wrappers.LongLen // amount
+		owners = &out.OutputOwners
+	case *secp256k1fx.HeightLockedOutput:
+		size += wrappers.LongLen + // lock height
+			wrappers.LongLen // amount
+		owners = &out.OutputOwners
+	case *secp256k1fx.MintOutput:
+		owners = &out.OutputOwners
+	case *nftfx.TransferOutput:
+		size += wrappers.IntLen + // groupID
+			wrappers.IntLen + // payload length
+			uint64(len(out.Payload))
+		owners = &out.OutputOwners
+	case *nftfx.MintOutput:
+		size += wrappers.IntLen // groupID
+		owners = &out.OutputOwners
+	case *propertyfx.OwnedOutput:
+		owners = &out.OutputOwners
+	case *propertyfx.MintOutput:
+		owners = &out.OutputOwners
+	default:
+		return size
+	}
+	return size +
+		intrinsicOutputOwnersStateGrowth +
+		uint64(len(owners.Addrs))*ids.ShortIDLen
+}
+
+func numSignatures(tx *txs.Tx) uint64 {
+	var numSignatures uint64
+	for _, cred := range tx.Creds {
+		switch cred := cred.Credential.(type) {
+		case *secp256k1fx.Credential:
+			numSignatures += uint64(len(cred.Sigs))
+		case *nftfx.Credential:
+			numSignatures += uint64(len(cred.Sigs))
+		case *propertyfx.Credential:
+			numSignatures += uint64(len(cred.Sigs))
+		}
+	}
+	return numSignatures
+}
+
+// exportedOutputsGetter returns the outputs a transaction exports to other
+// chains, which aren't included in txs.Tx.UTXOs.
+type exportedOutputsGetter struct {
+	outs []*avax.TransferableOutput
+}
+
+func (*exportedOutputsGetter) BaseTx(*txs.BaseTx) error {
+	return nil
+}
+
+func (*exportedOutputsGetter) CreateAssetTx(*txs.CreateAssetTx) error {
+	return nil
+}
+
+func (*exportedOutputsGetter) OperationTx(*txs.OperationTx) error {
+	return nil
+}
+
+func (*exportedOutputsGetter) ImportTx(*txs.ImportTx) error {
+	return nil
+}
+
+func (e *exportedOutputsGetter) ExportTx(tx *txs.ExportTx) error {
+	e.outs = tx.ExportedOuts
+	return nil
+}
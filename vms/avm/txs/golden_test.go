@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/codectest"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// TestGoldenTxSerialization ensures that the serialization of every tx type
+// doesn't change unexpectedly.
+//
+// To regenerate the golden files after an intentional change, run:
+//
+//	UPDATE_GOLDENS=1 go test ./vms/avm/txs -run TestGoldenTxSerialization
+func TestGoldenTxSerialization(t *testing.T) {
+	parser, err := NewParser(
+		[]fxs.Fx{
+			&secp256k1fx.Fx{},
+		},
+	)
+	require.NoError(t, err)
+	codec := parser.Codec()
+
+	populator := codectest.NewPopulator(map[interface{}]interface{}{
+		(*avax.TransferableIn)(nil):  &secp256k1fx.TransferInput{},
+		(*avax.TransferableOut)(nil): &secp256k1fx.TransferOutput{},
+		(*verify.State)(nil):         &secp256k1fx.TransferOutput{},
+		(*fxs.FxOperation)(nil):      &secp256k1fx.MintOperation{},
+	})
+
+	// Every tx type has a corresponding method on the Visitor, so iterating
+	// over the Visitor's methods guarantees that new tx types are covered.
+	visitorType := reflect.TypeOf((*Visitor)(nil)).Elem()
+	for i := 0; i < visitorType.NumMethod(); i++ {
+		method := visitorType.Method(i)
+		t.Run(method.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			utx, ok := reflect.New(method.Type.In(0).Elem()).Interface().(UnsignedTx)
+			require.True(ok)
+			require.NoError(populator.Populate(utx))
+
+			bytes, err := codec.Marshal(CodecVersion, &utx)
+			require.NoError(err)
+			codectest.RequireGolden(
+				t,
+				filepath.Join("testdata", fmt.Sprintf("v%d", CodecVersion), method.Name+".hex"),
+				bytes,
+			)
+
+			var parsedUTX UnsignedTx
+			_, err = codec.Unmarshal(bytes, &parsedUTX)
+			require.NoError(err)
+			require.Equal(utx, parsedUTX)
+		})
+	}
+}
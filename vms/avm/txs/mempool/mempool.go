@@ -8,6 +8,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 
 	txmempool "github.com/ava-labs/avalanchego/vms/txs/mempool"
 )
@@ -40,6 +41,7 @@ func New(
 	}
 	pool := txmempool.New[*txs.Tx](
 		metrics,
+		limits.Default,
 	)
 	return &mempool{
 		Mempool:  pool,
@@ -18,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/api/metrics"
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
@@ -38,6 +39,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/utxo"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/index"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
 
 	blockbuilder "github.com/ava-labs/avalanchego/vms/avm/block/builder"
@@ -55,7 +57,10 @@ var (
 	errUnknownFx                 = errors.New("unknown feature extension")
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
 
+	memoIndexPrefix = []byte("memo index")
+
 	_ vertex.LinearizableVMWithEngine = (*VM)(nil)
+	_ limits.Provider                 = (*VM)(nil)
 )
 
 type VM struct {
@@ -104,6 +109,8 @@ type VM struct {
 	walletService WalletService
 
 	addressTxsIndexer index.AddressTxsIndexer
+	memoTxsIndexer    index.MemoTxsIndexer
+	indexMemos        bool
 
 	txBackend *txexecutor.Backend
 
@@ -226,6 +233,9 @@ func (vm *VM) Initialize(
 		vm.parser,
 		vm.registerer,
 		avmConfig.ChecksumsEnabled,
+		avmConfig.IndexUTXODiffs,
+		avmConfig.UTXOSnapshotInterval,
+		avmConfig.UTXOSnapshotRetention,
 	)
 	if err != nil {
 		return err
@@ -255,6 +265,21 @@ func (vm *VM) Initialize(
 		}
 	}
 
+	vm.indexMemos = avmConfig.IndexMemos
+	memoIndexDB := prefixdb.New(memoIndexPrefix, vm.db)
+	if vm.indexMemos {
+		vm.ctx.Log.Info("memo transaction indexing is enabled")
+		vm.memoTxsIndexer, err = index.NewMemoIndexer(memoIndexDB, vm.ctx.Log, "memo", vm.registerer, avmConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize memo transaction indexer: %w", err)
+		}
+	} else {
+		vm.memoTxsIndexer, err = index.NewNoMemoIndexer(memoIndexDB, avmConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize disabled memo indexer: %w", err)
+		}
+	}
+
 	vm.txBackend = &txexecutor.Backend{
 		Ctx:           ctx,
 		Config:        &vm.Config,
@@ -380,6 +405,16 @@ func (vm *VM) GetBlockIDAtHeight(_ context.Context, height uint64) (ids.ID, erro
 	return vm.state.GetBlockIDAtHeight(height)
 }
 
+// TxLimits returns the limits enforced on the txs of the X-chain.
+func (*VM) TxLimits() limits.Limits {
+	return limits.Default
+}
+
+// Clock returns the clock that the VM uses to timestamp the blocks it builds.
+func (vm *VM) Clock() *mockable.Clock {
+	return &vm.clock
+}
+
 /*
  ******************************************************************************
  *********************************** DAG VM ***********************************
@@ -490,11 +525,11 @@ func (vm *VM) ParseTx(_ context.Context, bytes []byte) (snowstorm.Tx, error) {
  ******************************************************************************
  */
 
-// issueTxFromRPC attempts to send a transaction to consensus.
+// IssueTxFromRPC attempts to send a transaction to consensus.
 //
 // Invariant: The context lock is not held
 // Invariant: This function is only called after Linearize has been called.
-func (vm *VM) issueTxFromRPC(tx *txs.Tx) (ids.ID, error) {
+func (vm *VM) IssueTxFromRPC(tx *txs.Tx) (ids.ID, error) {
 	txID := tx.ID()
 	err := vm.network.IssueTxFromRPC(tx)
 	if err != nil && !errors.Is(err, mempool.ErrDuplicateTx) {
@@ -624,6 +659,9 @@ func (vm *VM) onAccept(tx *txs.Tx) error {
 	if err := vm.addressTxsIndexer.Accept(txID, inputUTXOs, outputUTXOs); err != nil {
 		return fmt.Errorf("error indexing tx: %w", err)
 	}
+	if err := vm.memoTxsIndexer.Accept(txID, txMemo(tx.Unsigned)); err != nil {
+		return fmt.Errorf("error indexing tx memo: %w", err)
+	}
 
 	vm.walletService.decided(txID)
 	return nil
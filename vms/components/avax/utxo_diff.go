@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// MaxUTXODiffHeights is the maximum number of heights whose UTXO changes are
+// returned at once.
+const MaxUTXODiffHeights = 1024
+
+var (
+	ErrUTXODiffsDisabled   = errors.New("UTXO diffs are disabled")
+	ErrUTXODiffUnavailable = errors.New("UTXO diff isn't available at height")
+
+	errInvalidUTXODiff = errors.New("invalid UTXO diff")
+)
+
+// UTXODiff is the change made to the UTXO set by accepting a block.
+type UTXODiff struct {
+	Added   []ids.ID
+	Removed []ids.ID
+}
+
+// UTXODiffGetter returns the UTXO diff of accepted heights.
+type UTXODiffGetter interface {
+	// GetUTXODiff returns the changes made to the UTXO set at [height].
+	//
+	// Returns [ErrUTXODiffsDisabled] if UTXO diffs aren't indexed.
+	GetUTXODiff(height uint64) (*UTXODiff, error)
+}
+
+// Bytes returns the number of added UTXOs followed by the IDs of the added and
+// removed UTXOs.
+func (d *UTXODiff) Bytes() []byte {
+	b := make([]byte, wrappers.IntLen, wrappers.IntLen+ids.IDLen*(len(d.Added)+len(d.Removed)))
+	binary.BigEndian.PutUint32(b, uint32(len(d.Added)))
+	for _, utxoID := range d.Added {
+		b = append(b, utxoID[:]...)
+	}
+	for _, utxoID := range d.Removed {
+		b = append(b, utxoID[:]...)
+	}
+	return b
+}
+
+// ParseUTXODiff parses the output of [UTXODiff.Bytes].
+func ParseUTXODiff(b []byte) (*UTXODiff, error) {
+	if len(b) < wrappers.IntLen || (len(b)-wrappers.IntLen)%ids.IDLen != 0 {
+		return nil, fmt.Errorf("%w: unexpected length %d", errInvalidUTXODiff, len(b))
+	}
+	numAdded := uint64(binary.BigEndian.Uint32(b))
+	numIDs := uint64(len(b)-wrappers.IntLen) / ids.IDLen
+	if numAdded > numIDs {
+		return nil, fmt.Errorf("%w: %d added UTXOs but only %d IDs", errInvalidUTXODiff, numAdded, numIDs)
+	}
+
+	utxoIDs := make([]ids.ID, numIDs)
+	for i := range utxoIDs {
+		offset := wrappers.IntLen + i*ids.IDLen
+		copy(utxoIDs[i][:], b[offset:])
+	}
+	return &UTXODiff{
+		Added:   utxoIDs[:numAdded],
+		Removed: utxoIDs[numAdded:],
+	}, nil
+}
+
+// PutUTXODiff writes [diff] as the change made to the UTXO set at [height].
+func PutUTXODiff(db database.KeyValueWriter, height uint64, diff *UTXODiff) error {
+	return db.Put(database.PackUInt64(height), diff.Bytes())
+}
+
+// GetUTXODiff reads the change made to the UTXO set at [height].
+//
+// Returns [ErrUTXODiffUnavailable] if the diff wasn't written.
+func GetUTXODiff(db database.KeyValueReader, height uint64) (*UTXODiff, error) {
+	diffBytes, err := db.Get(database.PackUInt64(height))
+	if err == database.ErrNotFound {
+		return nil, fmt.Errorf("%w: %d", ErrUTXODiffUnavailable, height)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseUTXODiff(diffBytes)
+}
+
+// GetUTXOChanges returns the changes made to the UTXO set after [startHeight]
+// up to and including [endHeight].
+//
+// The added UTXOs are the UTXOs that were added in the range, reference at
+// least one of the addresses in [addrs] and are still in [utxos]. The removed
+// UTXO IDs aren't filtered, as the removed UTXOs can no longer be read.
+func GetUTXOChanges(
+	diffs UTXODiffGetter,
+	utxos UTXOGetter,
+	addrs set.Set[ids.ShortID],
+	startHeight uint64,
+	endHeight uint64,
+) ([]*UTXO, []ids.ID, error) {
+	var (
+		added   []*UTXO
+		removed []ids.ID
+	)
+	for height := startHeight + 1; height <= endHeight; height++ {
+		diff, err := diffs.GetUTXODiff(height)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		removed = append(removed, diff.Removed...)
+		for _, utxoID := range diff.Added {
+			utxo, err := utxos.GetUTXO(utxoID)
+			if err == database.ErrNotFound {
+				// The UTXO was removed after it was added.
+				continue
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			if referencesAny(utxo, addrs) {
+				added = append(added, utxo)
+			}
+		}
+	}
+	return added, removed, nil
+}
+
+func referencesAny(utxo *UTXO, addrs set.Set[ids.ShortID]) bool {
+	out, ok := utxo.Out.(Addressable)
+	if !ok {
+		return false
+	}
+	for _, addrBytes := range out.Addresses() {
+		addr, err := ids.ToShortID(addrBytes)
+		if err == nil && addrs.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+type testUTXODiffs map[uint64]*UTXODiff
+
+func (d testUTXODiffs) GetUTXODiff(height uint64) (*UTXODiff, error) {
+	diff, ok := d[height]
+	if !ok {
+		return nil, ErrUTXODiffUnavailable
+	}
+	return diff, nil
+}
+
+func TestUTXODiffSerialization(t *testing.T) {
+	tests := []struct {
+		name string
+		diff *UTXODiff
+	}{
+		{
+			name: "empty",
+			diff: &UTXODiff{
+				Added:   []ids.ID{},
+				Removed: []ids.ID{},
+			},
+		},
+		{
+			name: "added and removed",
+			diff: &UTXODiff{
+				Added:   []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()},
+				Removed: []ids.ID{ids.GenerateTestID()},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memdb.New()
+			require.NoError(PutUTXODiff(db, 5, test.diff))
+
+			diff, err := GetUTXODiff(db, 5)
+			require.NoError(err)
+			require.Equal(test.diff, diff)
+
+			_, err = GetUTXODiff(db, 6)
+			require.ErrorIs(err, ErrUTXODiffUnavailable)
+		})
+	}
+}
+
+func TestParseUTXODiffInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		bytes []byte
+	}{
+		{
+			name:  "too short",
+			bytes: []byte{0, 0},
+		},
+		{
+			name:  "partial ID",
+			bytes: make([]byte, 4+ids.IDLen+1),
+		},
+		{
+			name:  "too many added",
+			bytes: append([]byte{0, 0, 0, 2}, make([]byte, ids.IDLen)...),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseUTXODiff(test.bytes)
+			require.ErrorIs(t, err, errInvalidUTXODiff)
+		})
+	}
+}
+
+func TestGetUTXOChanges(t *testing.T) {
+	require := require.New(t)
+
+	c := linearcodec.NewDefault()
+	manager := codec.NewDefaultManager()
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	require.NoError(manager.RegisterCodec(codecVersion, c))
+
+	utxos, err := NewUTXOState(memdb.New(), manager, trackChecksum)
+	require.NoError(err)
+
+	addr := ids.GenerateTestShortID()
+	newUTXO := func(owner ids.ShortID) *UTXO {
+		return &UTXO{
+			UTXOID: UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: Asset{ID: ids.GenerateTestID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{owner},
+				},
+			},
+		}
+	}
+
+	var (
+		ownedUTXO       = newUTXO(addr)
+		otherUTXO       = newUTXO(ids.GenerateTestShortID())
+		spentUTXO       = newUTXO(addr)
+		removedUTXOID   = ids.GenerateTestID()
+		laterUTXO       = newUTXO(addr)
+		addrs           = set.Of(addr)
+		diffs           = testUTXODiffs{}
+		expectedAdded   = []*UTXO{ownedUTXO}
+		expectedRemoved = []ids.ID{removedUTXOID, spentUTXO.InputID()}
+	)
+	for _, utxo := range []*UTXO{ownedUTXO, otherUTXO, laterUTXO} {
+		require.NoError(utxos.PutUTXO(utxo))
+	}
+	diffs[1] = &UTXODiff{
+		Added:   []ids.ID{ownedUTXO.InputID(), otherUTXO.InputID(), spentUTXO.InputID()},
+		Removed: []ids.ID{removedUTXOID},
+	}
+	diffs[2] = &UTXODiff{
+		Removed: []ids.ID{spentUTXO.InputID()},
+	}
+	diffs[3] = &UTXODiff{
+		Added: []ids.ID{laterUTXO.InputID()},
+	}
+
+	added, removed, err := GetUTXOChanges(diffs, utxos, addrs, 0, 2)
+	require.NoError(err)
+	require.Equal(expectedAdded, added)
+	require.Equal(expectedRemoved, removed)
+
+	added, removed, err = GetUTXOChanges(diffs, utxos, addrs, 2, 2)
+	require.NoError(err)
+	require.Empty(added)
+	require.Empty(removed)
+
+	_, _, err = GetUTXOChanges(diffs, utxos, addrs, 2, 4)
+	require.ErrorIs(err, ErrUTXODiffUnavailable)
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// MaxUTXOSnapshotPageSize is the maximum number of UTXOs of a snapshot that
+// are returned at once.
+const MaxUTXOSnapshotPageSize = 1024
+
+var (
+	ErrUTXOSnapshotsDisabled   = errors.New("UTXO snapshots are disabled")
+	ErrUTXOSnapshotUnavailable = errors.New("UTXO snapshot isn't available at height")
+	ErrUTXOSnapshotMismatch    = errors.New("UTXO snapshot doesn't match its digest")
+
+	errUTXOSnapshotUnordered = errors.New("UTXO snapshot isn't sorted by UTXO ID")
+
+	utxoSnapshotDigestPrefix = []byte("digest")
+	utxoSnapshotUTXOPrefix   = []byte("utxo")
+)
+
+// UTXOSnapshotPage is a range of the UTXOs of a snapshot, sorted by UTXO ID.
+type UTXOSnapshotPage struct {
+	UTXOIDs []ids.ID
+	// UTXOs[i] is the serialized UTXO with ID UTXOIDs[i]
+	UTXOs [][]byte
+	// Digest of the whole snapshot
+	Digest ids.ID
+	// If true, the snapshot has more UTXOs, starting at NextUTXOID
+	More       bool
+	NextUTXOID ids.ID
+}
+
+// UTXOSnapshotGetter returns the UTXO set at archived heights.
+type UTXOSnapshotGetter interface {
+	// GetUTXOSnapshot returns at most [limit] UTXOs of the UTXO set at
+	// [height], starting at [startUTXOID].
+	//
+	// Returns [ErrUTXOSnapshotsDisabled] if UTXO snapshots aren't taken and
+	// [ErrUTXOSnapshotUnavailable] if no snapshot was taken at [height].
+	GetUTXOSnapshot(height uint64, startUTXOID ids.ID, limit int) (*UTXOSnapshotPage, error)
+}
+
+// UTXOSnapshotHasher calculates the digest of a UTXO set.
+//
+// The digest is the SHA-256 hash of the canonical encoding of the UTXO set:
+// for each UTXO, in increasing order of UTXO ID, the UTXO ID, the length of
+// the serialized UTXO as a big-endian uint32 and the serialized UTXO.
+type UTXOSnapshotHasher struct {
+	hash     hash.Hash
+	previous *ids.ID
+}
+
+func NewUTXOSnapshotHasher() *UTXOSnapshotHasher {
+	return &UTXOSnapshotHasher{
+		hash: sha256.New(),
+	}
+}
+
+// Add the next UTXO of the UTXO set. Returns an error if [utxoID] isn't greater
+// than the previously added UTXO ID.
+func (h *UTXOSnapshotHasher) Add(utxoID ids.ID, utxoBytes []byte) error {
+	if h.previous != nil && utxoID.Compare(*h.previous) <= 0 {
+		return fmt.Errorf("%w: %s follows %s", errUTXOSnapshotUnordered, utxoID, *h.previous)
+	}
+	h.previous = &utxoID
+
+	var length [wrappers.IntLen]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(utxoBytes)))
+	_, _ = h.hash.Write(utxoID[:])
+	_, _ = h.hash.Write(length[:])
+	_, _ = h.hash.Write(utxoBytes)
+	return nil
+}
+
+// Digest returns the digest of the UTXOs added so far.
+func (h *UTXOSnapshotHasher) Digest() ids.ID {
+	var digest ids.ID
+	h.hash.Sum(digest[:0])
+	return digest
+}
+
+// UTXOSnapshots stores copies of the UTXO set taken at different heights.
+type UTXOSnapshots struct {
+	digestDB database.Database // height -> digest
+	utxoDB   database.Database // height + utxoID -> UTXO bytes
+}
+
+func NewUTXOSnapshots(db database.Database) *UTXOSnapshots {
+	return &UTXOSnapshots{
+		digestDB: prefixdb.New(utxoSnapshotDigestPrefix, db),
+		utxoDB:   prefixdb.New(utxoSnapshotUTXOPrefix, db),
+	}
+}
+
+// Put copies [utxos], which must iterate over the UTXO set in increasing order
+// of UTXO ID, as the snapshot at [height]. Returns the digest of the snapshot.
+func (s *UTXOSnapshots) Put(height uint64, utxos database.Iterator) (ids.ID, error) {
+	defer utxos.Release()
+
+	hasher := NewUTXOSnapshotHasher()
+	heightBytes := database.PackUInt64(height)
+	for utxos.Next() {
+		utxoID, err := ids.ToID(utxos.Key())
+		if err != nil {
+			return ids.Empty, err
+		}
+		utxoBytes := utxos.Value()
+		if err := hasher.Add(utxoID, utxoBytes); err != nil {
+			return ids.Empty, err
+		}
+
+		key := make([]byte, 0, database.Uint64Size+ids.IDLen)
+		key = append(key, heightBytes...)
+		key = append(key, utxoID[:]...)
+		if err := s.utxoDB.Put(key, slices.Clone(utxoBytes)); err != nil {
+			return ids.Empty, err
+		}
+	}
+	if err := utxos.Error(); err != nil {
+		return ids.Empty, err
+	}
+
+	digest := hasher.Digest()
+	return digest, database.PutID(s.digestDB, heightBytes, digest)
+}
+
+// Delete removes the snapshot at [height], if it exists.
+func (s *UTXOSnapshots) Delete(height uint64) error {
+	heightBytes := database.PackUInt64(height)
+	if err := s.digestDB.Delete(heightBytes); err != nil {
+		return err
+	}
+	return database.AtomicClearPrefix(s.utxoDB, s.utxoDB, heightBytes)
+}
+
+// Get returns at most [limit] UTXOs of the snapshot at [height], starting at
+// [startUTXOID].
+//
+// Returns [ErrUTXOSnapshotUnavailable] if there is no snapshot at [height].
+func (s *UTXOSnapshots) Get(height uint64, startUTXOID ids.ID, limit int) (*UTXOSnapshotPage, error) {
+	heightBytes := database.PackUInt64(height)
+	digest, err := database.GetID(s.digestDB, heightBytes)
+	if err == database.ErrNotFound {
+		return nil, fmt.Errorf("%w: %d", ErrUTXOSnapshotUnavailable, height)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	start := make([]byte, 0, database.Uint64Size+ids.IDLen)
+	start = append(start, heightBytes...)
+	start = append(start, startUTXOID[:]...)
+	it := s.utxoDB.NewIteratorWithStartAndPrefix(start, heightBytes)
+	defer it.Release()
+
+	page := &UTXOSnapshotPage{
+		Digest: digest,
+	}
+	for it.Next() {
+		utxoID, err := ids.ToID(it.Key()[database.Uint64Size:])
+		if err != nil {
+			return nil, err
+		}
+		if len(page.UTXOIDs) >= limit {
+			page.More = true
+			page.NextUTXOID = utxoID
+			break
+		}
+		page.UTXOIDs = append(page.UTXOIDs, utxoID)
+		page.UTXOs = append(page.UTXOs, slices.Clone(it.Value()))
+	}
+	return page, it.Error()
+}
+
+// Close the underlying databases.
+func (s *UTXOSnapshots) Close() error {
+	return errors.Join(
+		s.digestDB.Close(),
+		s.utxoDB.Close(),
+	)
+}
+
+// FetchUTXOSnapshot calls [getPage] until every UTXO of a snapshot has been
+// passed to [onUTXO], in increasing order of UTXO ID. Returns the digest of the
+// snapshot after verifying that the UTXOs match it.
+func FetchUTXOSnapshot(
+	getPage func(startUTXOID ids.ID) (*UTXOSnapshotPage, error),
+	onUTXO func(utxoID ids.ID, utxoBytes []byte) error,
+) (ids.ID, error) {
+	var (
+		hasher      = NewUTXOSnapshotHasher()
+		startUTXOID ids.ID
+		digest      *ids.ID
+	)
+	for {
+		page, err := getPage(startUTXOID)
+		if err != nil {
+			return ids.Empty, err
+		}
+		if len(page.UTXOIDs) != len(page.UTXOs) {
+			return ids.Empty, fmt.Errorf("%w: got %d UTXO IDs but %d UTXOs", ErrUTXOSnapshotMismatch, len(page.UTXOIDs), len(page.UTXOs))
+		}
+		if digest != nil && *digest != page.Digest {
+			return ids.Empty, fmt.Errorf("%w: digest changed from %s to %s", ErrUTXOSnapshotMismatch, *digest, page.Digest)
+		}
+		digest = &page.Digest
+
+		for i, utxoID := range page.UTXOIDs {
+			if err := hasher.Add(utxoID, page.UTXOs[i]); err != nil {
+				return ids.Empty, err
+			}
+			if err := onUTXO(utxoID, page.UTXOs[i]); err != nil {
+				return ids.Empty, err
+			}
+		}
+		if !page.More {
+			break
+		}
+		if page.NextUTXOID.Compare(startUTXOID) <= 0 {
+			return ids.Empty, fmt.Errorf("%w: next UTXO ID %s doesn't follow %s", errUTXOSnapshotUnordered, page.NextUTXOID, startUTXOID)
+		}
+		startUTXOID = page.NextUTXOID
+	}
+
+	if computed := hasher.Digest(); computed != *digest {
+		return ids.Empty, fmt.Errorf("%w: expected %s but computed %s", ErrUTXOSnapshotMismatch, *digest, computed)
+	}
+	return *digest, nil
+}
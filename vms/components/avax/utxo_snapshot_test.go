@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+)
+
+func TestUTXOSnapshots(t *testing.T) {
+	require := require.New(t)
+
+	utxoDB := memdb.New()
+	utxoIDs := make([]ids.ID, 5)
+	for i := range utxoIDs {
+		utxoIDs[i] = ids.GenerateTestID()
+		require.NoError(utxoDB.Put(utxoIDs[i][:], utils.RandomBytes(16)))
+	}
+	utils.Sort(utxoIDs)
+
+	snapshots := NewUTXOSnapshots(memdb.New())
+	digest, err := snapshots.Put(10, utxoDB.NewIterator())
+	require.NoError(err)
+
+	// Fetching the snapshot in pages returns every UTXO, in order.
+	var fetched []ids.ID
+	fetchedDigest, err := FetchUTXOSnapshot(
+		func(startUTXOID ids.ID) (*UTXOSnapshotPage, error) {
+			return snapshots.Get(10, startUTXOID, 2)
+		},
+		func(utxoID ids.ID, utxoBytes []byte) error {
+			expectedBytes, err := utxoDB.Get(utxoID[:])
+			require.NoError(err)
+			require.Equal(expectedBytes, utxoBytes)
+
+			fetched = append(fetched, utxoID)
+			return nil
+		},
+	)
+	require.NoError(err)
+	require.Equal(digest, fetchedDigest)
+	require.Equal(utxoIDs, fetched)
+
+	// Modifying the UTXO set doesn't modify the snapshot.
+	require.NoError(utxoDB.Delete(utxoIDs[0][:]))
+	page, err := snapshots.Get(10, ids.Empty, MaxUTXOSnapshotPageSize)
+	require.NoError(err)
+	require.Equal(utxoIDs, page.UTXOIDs)
+
+	_, err = snapshots.Get(11, ids.Empty, MaxUTXOSnapshotPageSize)
+	require.ErrorIs(err, ErrUTXOSnapshotUnavailable)
+
+	require.NoError(snapshots.Delete(10))
+	_, err = snapshots.Get(10, ids.Empty, MaxUTXOSnapshotPageSize)
+	require.ErrorIs(err, ErrUTXOSnapshotUnavailable)
+}
+
+func TestFetchUTXOSnapshotMismatch(t *testing.T) {
+	utxoID := ids.GenerateTestID()
+	utxoBytes := utils.RandomBytes(16)
+
+	hasher := NewUTXOSnapshotHasher()
+	require.NoError(t, hasher.Add(utxoID, utxoBytes))
+	digest := hasher.Digest()
+
+	tests := []struct {
+		name        string
+		page        *UTXOSnapshotPage
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			page: &UTXOSnapshotPage{
+				UTXOIDs: []ids.ID{utxoID},
+				UTXOs:   [][]byte{utxoBytes},
+				Digest:  digest,
+			},
+		},
+		{
+			name: "modified UTXO",
+			page: &UTXOSnapshotPage{
+				UTXOIDs: []ids.ID{utxoID},
+				UTXOs:   [][]byte{utils.RandomBytes(16)},
+				Digest:  digest,
+			},
+			expectedErr: ErrUTXOSnapshotMismatch,
+		},
+		{
+			name: "missing UTXO",
+			page: &UTXOSnapshotPage{
+				Digest: digest,
+			},
+			expectedErr: ErrUTXOSnapshotMismatch,
+		},
+		{
+			name: "unordered UTXOs",
+			page: &UTXOSnapshotPage{
+				UTXOIDs: []ids.ID{utxoID, utxoID},
+				UTXOs:   [][]byte{utxoBytes, utxoBytes},
+				Digest:  digest,
+			},
+			expectedErr: errUTXOSnapshotUnordered,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := FetchUTXOSnapshot(
+				func(ids.ID) (*UTXOSnapshotPage, error) {
+					return test.page, nil
+				},
+				func(ids.ID, []byte) error {
+					return nil
+				},
+			)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
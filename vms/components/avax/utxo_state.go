@@ -33,6 +33,10 @@ type UTXOState interface {
 
 	// Checksum returns the current UTXOChecksum.
 	Checksum() ids.ID
+
+	// NewUTXOIterator returns an iterator over all the stored UTXOs, ordered
+	// by their IDs. Keys are UTXO IDs and values are the serialized UTXOs.
+	NewUTXOIterator() database.Iterator
 }
 
 // UTXOReader is a thin wrapper around a database to provide fetching of UTXOs.
@@ -255,6 +259,10 @@ func (s *utxoState) Checksum() ids.ID {
 	return s.checksum
 }
 
+func (s *utxoState) NewUTXOIterator() database.Iterator {
+	return s.utxoDB.NewIterator()
+}
+
 func (s *utxoState) getIndexDB(addr []byte) linkeddb.LinkedDB {
 	addrStr := string(addr)
 	if indexList, exists := s.indexCache.Get(addrStr); exists {
@@ -15,6 +15,7 @@ import (
 var (
 	_ snowman.Block           = (*BlockWrapper)(nil)
 	_ block.WithVerifyContext = (*BlockWrapper)(nil)
+	_ block.WithUtilization   = (*BlockWrapper)(nil)
 
 	errExpectedBlockWithVerifyContext = errors.New("expected block.WithVerifyContext")
 )
@@ -88,6 +89,17 @@ func (bw *BlockWrapper) VerifyWithContext(ctx context.Context, blockCtx *block.C
 	return nil
 }
 
+// Utilization returns the utilization of the underlying block. If the
+// underlying block does not implement the block.WithUtilization interface, nil
+// is returned.
+func (bw *BlockWrapper) Utilization() []float64 {
+	blkWithUtilization, ok := bw.Block.(block.WithUtilization)
+	if !ok {
+		return nil
+	}
+	return blkWithUtilization.Utilization()
+}
+
 // Accept accepts the underlying block, removes it from verifiedBlocks, caches it as a decided
 // block, and updates the last accepted block.
 func (bw *BlockWrapper) Accept(ctx context.Context) error {
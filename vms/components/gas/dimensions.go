@@ -3,33 +3,198 @@
 
 package gas
 
-import "github.com/ava-labs/avalanchego/utils/math"
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/utils/math"
+)
 
 const (
 	Bandwidth Dimension = iota
 	DBRead
 	DBWrite // includes deletes
 	Compute
+	// StateGrowth is the number of bytes persisted to state that outlive the
+	// transaction, such as the UTXOs it produces. Unlike DBWrite, which counts
+	// every write and delete, it is only charged for the state that remains.
+	StateGrowth
 
 	NumDimensions = iota
 )
 
+var (
+	_ fmt.Stringer     = Dimensions{}
+	_ json.Marshaler   = Dimensions{}
+	_ json.Unmarshaler = (*Dimensions)(nil)
+
+	errUnknownDimension = errors.New("unknown dimension")
+)
+
 type (
 	Dimension  uint
 	Dimensions [NumDimensions]uint64
+
+	// DimensionsOption sets the value of a dimension in NewDimensions.
+	DimensionsOption func(*Dimensions)
 )
 
+// NewDimensions returns the Dimensions with the values set by [opts]. The
+// dimensions that aren't set are 0.
+func NewDimensions(opts ...DimensionsOption) Dimensions {
+	var d Dimensions
+	for _, opt := range opts {
+		opt(&d)
+	}
+	return d
+}
+
+func WithBandwidth(bandwidth uint64) DimensionsOption {
+	return withDimension(Bandwidth, bandwidth)
+}
+
+func WithDBRead(dbRead uint64) DimensionsOption {
+	return withDimension(DBRead, dbRead)
+}
+
+func WithDBWrite(dbWrite uint64) DimensionsOption {
+	return withDimension(DBWrite, dbWrite)
+}
+
+func WithCompute(compute uint64) DimensionsOption {
+	return withDimension(Compute, compute)
+}
+
+func WithStateGrowth(stateGrowth uint64) DimensionsOption {
+	return withDimension(StateGrowth, stateGrowth)
+}
+
+func withDimension(dimension Dimension, value uint64) DimensionsOption {
+	return func(d *Dimensions) {
+		d[dimension] = value
+	}
+}
+
+func (d Dimension) String() string {
+	switch d {
+	case Bandwidth:
+		return "bandwidth"
+	case DBRead:
+		return "dbRead"
+	case DBWrite:
+		return "dbWrite"
+	case Compute:
+		return "compute"
+	case StateGrowth:
+		return "stateGrowth"
+	default:
+		return fmt.Sprintf("dimension(%d)", uint(d))
+	}
+}
+
+// Unit returns the unit of the complexity of d.
+func (d Dimension) Unit() string {
+	switch d {
+	case Bandwidth:
+		return "bytes"
+	case DBRead:
+		return "reads"
+	case DBWrite:
+		return "writes"
+	case Compute:
+		return "us"
+	case StateGrowth:
+		return "bytes"
+	default:
+		return "units"
+	}
+}
+
+// String formats d as a complexity, labeling each dimension with its unit.
+func (d Dimensions) String() string {
+	strs := make([]string, len(d))
+	for i, v := range d {
+		dimension := Dimension(i)
+		strs[i] = fmt.Sprintf("%s: %d %s", dimension, v, dimension.Unit())
+	}
+	return "{" + strings.Join(strs, ", ") + "}"
+}
+
+// MarshalJSON encodes d as an object keyed by the name of each dimension.
+func (d Dimensions) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, v := range d {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Quote(Dimension(i).String()))
+		b.WriteByte(':')
+		b.WriteString(strconv.FormatUint(v, 10))
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON decodes d from an object keyed by the name of each dimension.
+// The dimensions that aren't specified are 0.
+//
+// For backwards compatibility, d can also be decoded from an array of values
+// ordered by dimension. The dimensions missing from the end of the array, such
+// as StateGrowth in arrays written before it was added, are 0.
+func (d *Dimensions) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if string(b) == "null" {
+		return nil
+	}
+	if len(b) > 0 && b[0] == '[' {
+		var values [NumDimensions]uint64
+		if err := json.Unmarshal(b, &values); err != nil {
+			return err
+		}
+		*d = values
+		return nil
+	}
+
+	var values map[string]uint64
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+	var parsed Dimensions
+	for name, v := range values {
+		dimension, ok := dimensionByName(name)
+		if !ok {
+			return fmt.Errorf("%w: %q", errUnknownDimension, name)
+		}
+		parsed[dimension] = v
+	}
+	*d = parsed
+	return nil
+}
+
+func dimensionByName(name string) (Dimension, bool) {
+	for d := Dimension(0); d < NumDimensions; d++ {
+		if d.String() == name {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
 // Add returns d + sum(os...).
 //
-// If overflow occurs, an error is returned.
+// If overflow occurs, an error wrapping math.ErrOverflow is returned along with
+// the zero value.
 func (d Dimensions) Add(os ...*Dimensions) (Dimensions, error) {
 	var err error
 	for _, o := range os {
-		for i := range o {
-			d[i], err = math.Add(d[i], o[i])
-			if err != nil {
-				return d, err
-			}
+		d, err = d.combine(o, math.Add[uint64])
+		if err != nil {
+			return Dimensions{}, err
 		}
 	}
 	return d, nil
@@ -37,33 +202,60 @@ func (d Dimensions) Add(os ...*Dimensions) (Dimensions, error) {
 
 // Sub returns d - sum(os...).
 //
-// If underflow occurs, an error is returned.
+// If underflow occurs, an error wrapping math.ErrUnderflow is returned along
+// with the zero value.
 func (d Dimensions) Sub(os ...*Dimensions) (Dimensions, error) {
 	var err error
 	for _, o := range os {
-		for i := range o {
-			d[i], err = math.Sub(d[i], o[i])
-			if err != nil {
-				return d, err
-			}
+		d, err = d.combine(o, math.Sub[uint64])
+		if err != nil {
+			return Dimensions{}, err
 		}
 	}
 	return d, nil
 }
 
-// ToGas returns d · weights.
+// Mul returns d * scalar.
 //
-// If overflow occurs, an error is returned.
-func (d Dimensions) ToGas(weights Dimensions) (Gas, error) {
-	var res uint64
+// If overflow occurs, an error wrapping math.ErrOverflow is returned along with
+// the zero value.
+func (d Dimensions) Mul(scalar uint64) (Dimensions, error) {
+	var scalars Dimensions
+	for i := range scalars {
+		scalars[i] = scalar
+	}
+	return d.combine(&scalars, math.Mul[uint64])
+}
+
+// combine returns op applied to each dimension of d and o.
+//
+// If op fails for any dimension, the error is returned, annotated with the
+// dimension, along with the zero value.
+func (d Dimensions) combine(o *Dimensions, op func(a, b uint64) (uint64, error)) (Dimensions, error) {
 	for i := range d {
-		v, err := math.Mul(d[i], weights[i])
+		v, err := op(d[i], o[i])
 		if err != nil {
-			return 0, err
+			return Dimensions{}, fmt.Errorf("%w of %s", err, Dimension(i))
 		}
+		d[i] = v
+	}
+	return d, nil
+}
+
+// ToGas returns d · weights.
+//
+// If overflow occurs, an error wrapping math.ErrOverflow is returned.
+func (d Dimensions) ToGas(weights Dimensions) (Gas, error) {
+	weighted, err := d.combine(&weights, math.Mul[uint64])
+	if err != nil {
+		return 0, err
+	}
+
+	var res uint64
+	for _, v := range weighted {
 		res, err = math.Add(res, v)
 		if err != nil {
-			return 0, err
+			return 0, fmt.Errorf("%w of gas", err)
 		}
 	}
 	return Gas(res), nil
@@ -4,7 +4,9 @@
 package gas
 
 import (
+	"encoding/json"
 	"math"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -90,12 +92,7 @@ func Test_Dimensions_Add(t *testing.T) {
 					Compute:   40,
 				},
 			},
-			expected: Dimensions{
-				Bandwidth: 0,
-				DBRead:    2,
-				DBWrite:   3,
-				Compute:   4,
-			},
+			expected:    Dimensions{},
 			expectedErr: safemath.ErrOverflow,
 		},
 		{
@@ -114,12 +111,7 @@ func Test_Dimensions_Add(t *testing.T) {
 					Compute:   40,
 				},
 			},
-			expected: Dimensions{
-				Bandwidth: 11,
-				DBRead:    0,
-				DBWrite:   3,
-				Compute:   4,
-			},
+			expected:    Dimensions{},
 			expectedErr: safemath.ErrOverflow,
 		},
 		{
@@ -138,12 +130,7 @@ func Test_Dimensions_Add(t *testing.T) {
 					Compute:   40,
 				},
 			},
-			expected: Dimensions{
-				Bandwidth: 11,
-				DBRead:    22,
-				DBWrite:   0,
-				Compute:   4,
-			},
+			expected:    Dimensions{},
 			expectedErr: safemath.ErrOverflow,
 		},
 		{
@@ -162,12 +149,7 @@ func Test_Dimensions_Add(t *testing.T) {
 					Compute:   40,
 				},
 			},
-			expected: Dimensions{
-				Bandwidth: 11,
-				DBRead:    22,
-				DBWrite:   33,
-				Compute:   0,
-			},
+			expected:    Dimensions{},
 			expectedErr: safemath.ErrOverflow,
 		},
 	}
@@ -260,12 +242,7 @@ func Test_Dimensions_Sub(t *testing.T) {
 					Compute:   4,
 				},
 			},
-			expected: Dimensions{
-				Bandwidth: 0,
-				DBRead:    22,
-				DBWrite:   33,
-				Compute:   44,
-			},
+			expected:    Dimensions{},
 			expectedErr: safemath.ErrUnderflow,
 		},
 		{
@@ -284,12 +261,7 @@ func Test_Dimensions_Sub(t *testing.T) {
 					Compute:   4,
 				},
 			},
-			expected: Dimensions{
-				Bandwidth: 10,
-				DBRead:    0,
-				DBWrite:   33,
-				Compute:   44,
-			},
+			expected:    Dimensions{},
 			expectedErr: safemath.ErrUnderflow,
 		},
 		{
@@ -308,12 +280,7 @@ func Test_Dimensions_Sub(t *testing.T) {
 					Compute:   4,
 				},
 			},
-			expected: Dimensions{
-				Bandwidth: 10,
-				DBRead:    20,
-				DBWrite:   0,
-				Compute:   44,
-			},
+			expected:    Dimensions{},
 			expectedErr: safemath.ErrUnderflow,
 		},
 		{
@@ -332,20 +299,76 @@ func Test_Dimensions_Sub(t *testing.T) {
 					Compute:   math.MaxUint64,
 				},
 			},
+			expected:    Dimensions{},
+			expectedErr: safemath.ErrUnderflow,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := test.lhs.Sub(test.rhs...)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+		})
+	}
+}
+
+func Test_Dimensions_Mul(t *testing.T) {
+	tests := []struct {
+		name        string
+		lhs         Dimensions
+		scalar      uint64
+		expected    Dimensions
+		expectedErr error
+	}{
+		{
+			name: "no error",
+			lhs: Dimensions{
+				Bandwidth: 1,
+				DBRead:    2,
+				DBWrite:   3,
+				Compute:   4,
+			},
+			scalar: 10,
 			expected: Dimensions{
 				Bandwidth: 10,
 				DBRead:    20,
 				DBWrite:   30,
-				Compute:   0,
+				Compute:   40,
 			},
-			expectedErr: safemath.ErrUnderflow,
+			expectedErr: nil,
+		},
+		{
+			name: "zero scalar",
+			lhs: Dimensions{
+				Bandwidth: math.MaxUint64,
+				DBRead:    2,
+				DBWrite:   3,
+				Compute:   4,
+			},
+			scalar:      0,
+			expected:    Dimensions{},
+			expectedErr: nil,
+		},
+		{
+			name: "compute overflow",
+			lhs: Dimensions{
+				Bandwidth: 1,
+				DBRead:    2,
+				DBWrite:   3,
+				Compute:   math.MaxUint64/2 + 1,
+			},
+			scalar:      2,
+			expected:    Dimensions{},
+			expectedErr: safemath.ErrOverflow,
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			require := require.New(t)
 
-			actual, err := test.lhs.Sub(test.rhs...)
+			actual, err := test.lhs.Mul(test.scalar)
 			require.ErrorIs(err, test.expectedErr)
 			require.Equal(test.expected, actual)
 		})
@@ -427,6 +450,118 @@ func Test_Dimensions_ToGas(t *testing.T) {
 	}
 }
 
+func Test_NewDimensions(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(
+		Dimensions{
+			Bandwidth:   1,
+			DBRead:      2,
+			DBWrite:     3,
+			Compute:     4,
+			StateGrowth: 5,
+		},
+		NewDimensions(
+			WithStateGrowth(5),
+			WithCompute(4),
+			WithDBWrite(3),
+			WithDBRead(2),
+			WithBandwidth(1),
+		),
+	)
+	require.Equal(
+		Dimensions{
+			DBWrite: 3,
+		},
+		NewDimensions(WithDBWrite(3)),
+	)
+}
+
+func Test_Dimensions_String(t *testing.T) {
+	d := NewDimensions(
+		WithBandwidth(1),
+		WithDBRead(2),
+		WithDBWrite(3),
+		WithCompute(4),
+		WithStateGrowth(5),
+	)
+	require.Equal(t, "{bandwidth: 1 bytes, dbRead: 2 reads, dbWrite: 3 writes, compute: 4 us, stateGrowth: 5 bytes}", d.String())
+}
+
+func Test_Dimensions_JSON(t *testing.T) {
+	d := NewDimensions(
+		WithBandwidth(1),
+		WithDBRead(2),
+		WithDBWrite(3),
+		WithCompute(4),
+		WithStateGrowth(5),
+	)
+	tests := []struct {
+		name        string
+		json        string
+		expected    Dimensions
+		expectedErr error
+	}{
+		{
+			name:     "object",
+			json:     `{"bandwidth":1,"dbRead":2,"dbWrite":3,"compute":4,"stateGrowth":5}`,
+			expected: d,
+		},
+		{
+			name:     "unordered object",
+			json:     `{"stateGrowth":5,"compute":4,"dbWrite":3,"dbRead":2,"bandwidth":1}`,
+			expected: d,
+		},
+		{
+			name:     "partial object",
+			json:     `{"dbWrite":3}`,
+			expected: NewDimensions(WithDBWrite(3)),
+		},
+		{
+			name:     "array",
+			json:     `[1, 2, 3, 4, 5]`,
+			expected: d,
+		},
+		{
+			name: "array without state growth",
+			json: `[1, 2, 3, 4]`,
+			expected: NewDimensions(
+				WithBandwidth(1),
+				WithDBRead(2),
+				WithDBWrite(3),
+				WithCompute(4),
+			),
+		},
+		{
+			name:        "unknown dimension",
+			json:        `{"storage":1}`,
+			expectedErr: errUnknownDimension,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var parsed Dimensions
+			err := json.Unmarshal([]byte(test.json), &parsed)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, parsed)
+		})
+	}
+
+	t.Run("marshal", func(t *testing.T) {
+		require := require.New(t)
+
+		b, err := json.Marshal(d)
+		require.NoError(err)
+		require.JSONEq(`{"bandwidth":1,"dbRead":2,"dbWrite":3,"compute":4,"stateGrowth":5}`, string(b))
+
+		var parsed Dimensions
+		require.NoError(json.Unmarshal(b, &parsed))
+		require.Equal(d, parsed)
+	})
+}
+
 func Benchmark_Dimensions_Add(b *testing.B) {
 	lhs := Dimensions{600, 10, 10, 1000}
 	rhs := []*Dimensions{
@@ -475,3 +610,78 @@ func Benchmark_Dimensions_Sub(b *testing.B) {
 		}
 	})
 }
+
+// overflows returns true if a[i] + b[i] overflows for any dimension.
+func overflows(a, b Dimensions) bool {
+	for i := range a {
+		if a[i] > math.MaxUint64-b[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func FuzzDimensionsAddSub(f *testing.F) {
+	f.Add(uint64(1), uint64(2), uint64(3), uint64(4), uint64(10), uint64(20), uint64(30), uint64(40))
+	f.Add(uint64(math.MaxUint64), uint64(0), uint64(0), uint64(0), uint64(1), uint64(0), uint64(0), uint64(0))
+	f.Fuzz(func(t *testing.T, a0, a1, a2, a3, b0, b1, b2, b3 uint64) {
+		require := require.New(t)
+
+		var (
+			a = Dimensions{a0, a1, a2, a3}
+			b = Dimensions{b0, b1, b2, b3}
+		)
+		sum, err := a.Add(&b)
+		if overflows(a, b) {
+			require.ErrorIs(err, safemath.ErrOverflow)
+			require.Zero(sum)
+			return
+		}
+		require.NoError(err)
+
+		// Sub is the inverse of Add.
+		diff, err := sum.Sub(&b)
+		require.NoError(err)
+		require.Equal(a, diff)
+
+		diff, err = sum.Sub(&a)
+		require.NoError(err)
+		require.Equal(b, diff)
+
+		// Removing more than was added underflows unless nothing was added.
+		_, err = a.Sub(&sum)
+		if b == (Dimensions{}) {
+			require.NoError(err)
+		} else {
+			require.ErrorIs(err, safemath.ErrUnderflow)
+		}
+	})
+}
+
+func FuzzDimensionsMul(f *testing.F) {
+	f.Add(uint64(1), uint64(2), uint64(3), uint64(4), uint64(10))
+	f.Add(uint64(math.MaxUint64), uint64(0), uint64(0), uint64(0), uint64(2))
+	f.Fuzz(func(t *testing.T, d0, d1, d2, d3, scalar uint64) {
+		require := require.New(t)
+
+		d := Dimensions{d0, d1, d2, d3}
+		product, err := d.Mul(scalar)
+		if scalar != 0 && slices.ContainsFunc(d[:], func(v uint64) bool {
+			return v > math.MaxUint64/scalar
+		}) {
+			require.ErrorIs(err, safemath.ErrOverflow)
+			require.Zero(product)
+			return
+		}
+		require.NoError(err)
+		for i := range d {
+			require.Equal(d[i]*scalar, product[i])
+		}
+
+		// Multiplying by 2 is equivalent to adding to itself.
+		doubled, err := d.Mul(2)
+		sum, addErr := d.Add(&d)
+		require.Equal(addErr, err)
+		require.Equal(sum, doubled)
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var (
+	_ MemoTxsIndexer = (*memoIndexer)(nil)
+	_ MemoTxsIndexer = (*noMemoIndexer)(nil)
+)
+
+// MemoTxsIndexer maintains information about which transactions were issued
+// with which memo.
+type MemoTxsIndexer interface {
+	// Accept is called when [txID] is accepted.
+	// Persists that [txID] was issued with [memo]. Empty memos are not
+	// indexed.
+	// If the error is non-nil, do not persist [txID] to disk as accepted in the VM
+	Accept(txID ids.ID, memo []byte) error
+
+	// Read returns the IDs of transactions that were issued with exactly
+	// [memo].
+	// The returned transactions are in order of increasing acceptance time.
+	// The length of the returned slice <= [pageSize].
+	// [cursor] is the offset to start reading from.
+	Read(memo []byte, cursor, pageSize uint64) ([]ids.ID, error)
+}
+
+type memoIndexer struct {
+	log     logging.Logger
+	metrics metrics
+	db      database.Database
+}
+
+// NewMemoIndexer returns a new MemoTxsIndexer.
+//
+// Transactions are indexed under the hash of their memo, so the size of an
+// entry doesn't depend on the size of the memo.
+func NewMemoIndexer(
+	db database.Database,
+	log logging.Logger,
+	metricsNamespace string,
+	metricsRegisterer prometheus.Registerer,
+	allowIncompleteIndices bool,
+) (MemoTxsIndexer, error) {
+	i := &memoIndexer{
+		db:  db,
+		log: log,
+	}
+	// initialize the indexer
+	if err := checkIndexStatus(i.db, true, allowIncompleteIndices); err != nil {
+		return nil, err
+	}
+	// initialize the metrics
+	if err := i.metrics.initialize(metricsNamespace, metricsRegisterer); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// Accept persists that [txID] was issued with [memo].
+// The database structure is:
+// [hash(memo)]
+// |  "idx" => 2 		Running transaction index key, represents the next index
+// |  "0"   => txID1
+// |  "1"   => txID2
+// See interface documentation MemoTxsIndexer.Accept
+func (i *memoIndexer) Accept(txID ids.ID, memo []byte) error {
+	if len(memo) == 0 {
+		return nil
+	}
+
+	i.log.Verbo("writing indexed tx to DB",
+		zap.Binary("memo", memo),
+		zap.Stringer("txID", txID),
+	)
+	memoPrefixDB := prefixdb.New(hashing.ComputeHash256(memo), i.db)
+	if err := appendTxID(memoPrefixDB, txID); err != nil {
+		return err
+	}
+	i.metrics.numTxsIndexed.Inc()
+	return nil
+}
+
+// Read returns IDs of transactions that were issued with [memo], starting at
+// [cursor], in order of transaction acceptance.
+// Returns at most [pageSize] elements.
+// See MemoTxsIndexer
+func (i *memoIndexer) Read(memo []byte, cursor, pageSize uint64) ([]ids.ID, error) {
+	if len(memo) == 0 {
+		return nil, nil
+	}
+
+	memoPrefixDB := prefixdb.New(hashing.ComputeHash256(memo), i.db)
+	return readTxIDs(memoPrefixDB, cursor, pageSize)
+}
+
+type noMemoIndexer struct{}
+
+func NewNoMemoIndexer(db database.Database, allowIncomplete bool) (MemoTxsIndexer, error) {
+	return &noMemoIndexer{}, checkIndexStatus(db, false, allowIncomplete)
+}
+
+func (*noMemoIndexer) Accept(ids.ID, []byte) error {
+	return nil
+}
+
+func (*noMemoIndexer) Read([]byte, uint64, uint64) ([]ids.ID, error) {
+	return nil, nil
+}
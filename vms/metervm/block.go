@@ -16,6 +16,7 @@ var (
 	_ snowman.Block           = (*meterBlock)(nil)
 	_ snowman.OracleBlock     = (*meterBlock)(nil)
 	_ block.WithVerifyContext = (*meterBlock)(nil)
+	_ block.WithUtilization   = (*meterBlock)(nil)
 
 	errExpectedBlockWithVerifyContext = errors.New("expected block.WithVerifyContext")
 )
@@ -110,3 +111,11 @@ func (mb *meterBlock) VerifyWithContext(ctx context.Context, blockCtx *block.Con
 	}
 	return err
 }
+
+func (mb *meterBlock) Utilization() []float64 {
+	blkWithUtilization, ok := mb.Block.(block.WithUtilization)
+	if !ok {
+		return nil
+	}
+	return blkWithUtilization.Utilization()
+}
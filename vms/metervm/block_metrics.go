@@ -42,13 +42,16 @@ type blockMetrics struct {
 	parseStateSummary,
 	parseStateSummaryErr,
 	getStateSummary,
-	getStateSummaryErr metric.Averager
+	getStateSummaryErr,
+	// Local blocks metrics
+	getLocalBlocks metric.Averager
 }
 
 func (m *blockMetrics) Initialize(
 	supportsBlockBuildingWithContext bool,
 	supportsBatchedFetching bool,
 	supportsStateSync bool,
+	supportsLocalBlocks bool,
 	reg prometheus.Registerer,
 ) error {
 	errs := wrappers.Errs{}
@@ -86,5 +89,8 @@ func (m *blockMetrics) Initialize(
 		m.getStateSummary = newAverager("get_state_summary", reg, &errs)
 		m.getStateSummaryErr = newAverager("get_state_summary_err", reg, &errs)
 	}
+	if supportsLocalBlocks {
+		m.getLocalBlocks = newAverager("get_local_blocks", reg, &errs)
+	}
 	return errs.Err
 }
@@ -22,6 +22,7 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*blockVM)(nil)
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.LocalBlocksVM                = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -29,6 +30,7 @@ type blockVM struct {
 	buildBlockVM block.BuildBlockWithContextChainVM
 	batchedVM    block.BatchedChainVM
 	ssVM         block.StateSyncableVM
+	localVM      block.LocalBlocksVM
 
 	blockMetrics
 	registry prometheus.Registerer
@@ -42,11 +44,13 @@ func NewBlockVM(
 	buildBlockVM, _ := vm.(block.BuildBlockWithContextChainVM)
 	batchedVM, _ := vm.(block.BatchedChainVM)
 	ssVM, _ := vm.(block.StateSyncableVM)
+	localVM, _ := vm.(block.LocalBlocksVM)
 	return &blockVM{
 		ChainVM:      vm,
 		buildBlockVM: buildBlockVM,
 		batchedVM:    batchedVM,
 		ssVM:         ssVM,
+		localVM:      localVM,
 		registry:     reg,
 	}
 }
@@ -66,6 +70,7 @@ func (vm *blockVM) Initialize(
 		vm.buildBlockVM != nil,
 		vm.batchedVM != nil,
 		vm.ssVM != nil,
+		vm.localVM != nil,
 		vm.registry,
 	)
 	if err != nil {
@@ -0,0 +1,26 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metervm
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+func (vm *blockVM) GetLocalBlocks(
+	ctx context.Context,
+	height uint64,
+	maxBlocksNum int,
+) ([][]byte, error) {
+	if vm.localVM == nil {
+		return nil, block.ErrLocalBlocksNotImplemented
+	}
+
+	start := vm.clock.Time()
+	blocks, err := vm.localVM.GetLocalBlocks(ctx, height, maxBlocksNum)
+	end := vm.clock.Time()
+	vm.blockMetrics.getLocalBlocks.Observe(float64(end.Sub(start)))
+	return blocks, err
+}
@@ -100,6 +100,13 @@ type Owner struct {
 	Addresses []string    `json:"addresses"`
 }
 
+// MaintenanceWindow is the repr. of a planned maintenance window announced by
+// a validator sent over APIs.
+type MaintenanceWindow struct {
+	Start json.Uint64 `json:"start"`
+	End   json.Uint64 `json:"end"`
+}
+
 // PermissionlessValidator is the repr. of a permissionless validator sent over
 // APIs.
 type PermissionlessValidator struct {
@@ -118,6 +125,7 @@ type PermissionlessValidator struct {
 	ExactDelegationFee     *json.Uint32              `json:"exactDelegationFee,omitempty"`
 	Uptime                 *json.Float32             `json:"uptime,omitempty"`
 	Connected              *bool                     `json:"connected,omitempty"`
+	MaintenanceWindow      *MaintenanceWindow        `json:"maintenanceWindow,omitempty"`
 	Staked                 []UTXO                    `json:"staked,omitempty"`
 	Signer                 *signer.ProofOfPossession `json:"signer,omitempty"`
 
@@ -175,6 +183,7 @@ type BuildGenesisArgs struct {
 	Time          json.Uint64                      `json:"time"`
 	InitialSupply json.Uint64                      `json:"initialSupply"`
 	Message       string                           `json:"message"`
+	StakingParams *genesis.StakingParams           `json:"stakingParams,omitempty"`
 	Encoding      formatting.Encoding              `json:"encoding"`
 }
 
@@ -376,10 +385,11 @@ func (*StaticService) BuildGenesis(_ *http.Request, args *BuildGenesisArgs, repl
 		Timestamp:     uint64(args.Time),
 		InitialSupply: uint64(args.InitialSupply),
 		Message:       args.Message,
+		StakingParams: args.StakingParams,
 	}
 
 	// Marshal genesis to bytes
-	bytes, err := genesis.Codec.Marshal(genesis.CodecVersion, g)
+	bytes, err := g.Bytes()
 	if err != nil {
 		return fmt.Errorf("couldn't marshal genesis: %w", err)
 	}
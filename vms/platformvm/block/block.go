@@ -36,6 +36,14 @@ type BanffBlock interface {
 	Timestamp() time.Time
 }
 
+type FortunaBlock interface {
+	BanffBlock
+
+	// ParentStateRoot returns the merkle root of the state after the parent
+	// block was accepted.
+	ParentStateRoot() ids.ID
+}
+
 func initialize(blk Block, commonBlk *CommonBlock) error {
 	// We serialize this block as a pointer so that it can be deserialized into
 	// a Block
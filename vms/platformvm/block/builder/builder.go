@@ -18,7 +18,6 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
-	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -26,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 
 	smblock "github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
@@ -33,10 +33,6 @@ import (
 )
 
 const (
-	// targetBlockSize is maximum number of transaction bytes to place into a
-	// StandardBlock
-	targetBlockSize = 128 * units.KiB
-
 	// maxTimeToSleep is the maximum time to sleep between checking if a block
 	// should be produced.
 	maxTimeToSleep = time.Hour
@@ -269,6 +265,10 @@ func (b *builder) BuildBlockWithContext(
 
 func (b *builder) PackAllBlockTxs() ([]*txs.Tx, error) {
 	preferredID := b.blkManager.Preferred()
+	preferred, err := b.blkManager.GetStatelessBlock(preferredID)
+	if err != nil {
+		return nil, err
+	}
 	preferredState, ok := b.blkManager.GetState(preferredID)
 	if !ok {
 		return nil, fmt.Errorf("%w: %s", errMissingPreferredState, preferredID)
@@ -288,13 +288,16 @@ func (b *builder) PackAllBlockTxs() ([]*txs.Tx, error) {
 		return nil, err
 	}
 
+	backend := *b.txExecutorBackend
+	backend.Height = preferred.Height() + 1
+
 	if !b.txExecutorBackend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
 		return packDurangoBlockTxs(
 			context.TODO(),
 			preferredID,
 			preferredState,
 			b.Mempool,
-			b.txExecutorBackend,
+			&backend,
 			b.blkManager,
 			timestamp,
 			recommendedPChainHeight,
@@ -306,11 +309,12 @@ func (b *builder) PackAllBlockTxs() ([]*txs.Tx, error) {
 		preferredID,
 		preferredState,
 		b.Mempool,
-		b.txExecutorBackend,
+		&backend,
 		b.blkManager,
 		timestamp,
 		recommendedPChainHeight,
 		math.MaxUint64,
+		math.MaxInt,
 	)
 }
 
@@ -328,18 +332,22 @@ func buildBlock(
 	var (
 		blockTxs []*txs.Tx
 		err      error
+		backend  = *builder.txExecutorBackend
 	)
+	backend.Height = height
+
 	if builder.txExecutorBackend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
 		blockTxs, err = packEtnaBlockTxs(
 			ctx,
 			parentID,
 			parentState,
 			builder.Mempool,
-			builder.txExecutorBackend,
+			&backend,
 			builder.blkManager,
 			timestamp,
 			pChainHeight,
 			0, // minCapacity is 0 as we want to honor the capacity in state.
+			limits.Default.MaxBlockSize,
 		)
 	} else {
 		blockTxs, err = packDurangoBlockTxs(
@@ -347,11 +355,11 @@ func buildBlock(
 			parentID,
 			parentState,
 			builder.Mempool,
-			builder.txExecutorBackend,
+			&backend,
 			builder.blkManager,
 			timestamp,
 			pChainHeight,
-			targetBlockSize,
+			limits.Default.MaxBlockSize,
 		)
 	}
 	if err != nil {
@@ -374,6 +382,20 @@ func buildBlock(
 			return nil, fmt.Errorf("could not build tx to reward staker: %w", err)
 		}
 
+		if builder.txExecutorBackend.Config.UpgradeConfig.IsFortunaActivated(timestamp) {
+			parentStateRoot, err := state.GetStateRoot(ctx, parentState)
+			if err != nil {
+				return nil, fmt.Errorf("could not calculate parent state root: %w", err)
+			}
+			return block.NewFortunaProposalBlock(
+				timestamp,
+				parentID,
+				parentStateRoot,
+				height,
+				rewardValidatorTx,
+				blockTxs,
+			)
+		}
 		return block.NewBanffProposalBlock(
 			timestamp,
 			parentID,
@@ -390,6 +412,19 @@ func buildBlock(
 	}
 
 	// Issue a block with as many transactions as possible.
+	if builder.txExecutorBackend.Config.UpgradeConfig.IsFortunaActivated(timestamp) {
+		parentStateRoot, err := state.GetStateRoot(ctx, parentState)
+		if err != nil {
+			return nil, fmt.Errorf("could not calculate parent state root: %w", err)
+		}
+		return block.NewFortunaStandardBlock(
+			timestamp,
+			parentID,
+			parentStateRoot,
+			height,
+			blockTxs,
+		)
+	}
 	return block.NewBanffStandardBlock(
 		timestamp,
 		parentID,
@@ -418,6 +453,8 @@ func packDurangoBlockTxs(
 		return nil, err
 	}
 
+	dropExpiredTxs(mempool, backend, timestamp)
+
 	var (
 		blockTxs      []*txs.Tx
 		inputs        set.Set[ids.ID]
@@ -469,6 +506,7 @@ func packEtnaBlockTxs(
 	timestamp time.Time,
 	pChainHeight uint64,
 	minCapacity gas.Gas,
+	maxBlockSize int,
 ) ([]*txs.Tx, error) {
 	stateDiff, err := state.NewDiffOn(parentState)
 	if err != nil {
@@ -479,14 +517,18 @@ func packEtnaBlockTxs(
 		return nil, err
 	}
 
+	dropExpiredTxs(mempool, backend, timestamp)
+
 	feeState := stateDiff.GetFeeState()
 	capacity := max(feeState.Capacity, minCapacity)
 
 	var (
 		blockTxs        []*txs.Tx
+		blockSize       int
 		inputs          set.Set[ids.ID]
 		blockComplexity gas.Dimensions
 		feeCalculator   = state.PickFeeCalculator(backend.Config, stateDiff)
+		weights         = backend.Config.DynamicFeeWeights(timestamp)
 	)
 
 	backend.Ctx.Log.Debug("starting to pack block txs",
@@ -496,7 +538,7 @@ func packEtnaBlockTxs(
 		zap.Int("mempoolLen", mempool.Len()),
 	)
 	for {
-		currentBlockGas, err := blockComplexity.ToGas(backend.Config.DynamicFeeConfig.Weights)
+		currentBlockGas, err := blockComplexity.ToGas(weights)
 		if err != nil {
 			return nil, err
 		}
@@ -519,7 +561,7 @@ func packEtnaBlockTxs(
 		if err != nil {
 			return nil, err
 		}
-		newBlockGas, err := newBlockComplexity.ToGas(backend.Config.DynamicFeeConfig.Weights)
+		newBlockGas, err := newBlockComplexity.ToGas(weights)
 		if err != nil {
 			return nil, err
 		}
@@ -532,6 +574,14 @@ func packEtnaBlockTxs(
 			)
 			break
 		}
+		if newBlockSize := blockSize + tx.Size(); newBlockSize > maxBlockSize {
+			backend.Ctx.Log.Debug("block is full",
+				zap.Int("nextBlockSize", newBlockSize),
+				zap.Int("maxBlockSize", maxBlockSize),
+				zap.Int("blockLen", len(blockTxs)),
+			)
+			break
+		}
 
 		shouldAdd, err := executeTx(
 			ctx,
@@ -553,12 +603,39 @@ func packEtnaBlockTxs(
 		}
 
 		blockComplexity = newBlockComplexity
+		blockSize += tx.Size()
 		blockTxs = append(blockTxs, tx)
 	}
 
 	return blockTxs, nil
 }
 
+// dropExpiredTxs removes all transactions from the mempool that can no longer
+// be accepted at [timestamp].
+func dropExpiredTxs(
+	mempool mempool.Mempool,
+	backend *txexecutor.Backend,
+	timestamp time.Time,
+) {
+	var expiredTxs []*txs.Tx
+	mempool.Iterate(func(tx *txs.Tx) bool {
+		expiringTx, ok := txs.GetExpiringTx(tx.Unsigned)
+		if ok && timestamp.After(expiringTx.ExpiryTime()) {
+			expiredTxs = append(expiredTxs, tx)
+		}
+		return true
+	})
+	for _, tx := range expiredTxs {
+		txID := tx.ID()
+		backend.Ctx.Log.Debug("dropping expired transaction",
+			zap.Stringer("txID", txID),
+		)
+
+		mempool.Remove(tx)
+		mempool.MarkDropped(txID, txexecutor.ErrTxExpired)
+	}
+}
+
 func executeTx(
 	ctx context.Context,
 	parentID ids.ID,
@@ -20,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/iterator"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
@@ -153,7 +154,7 @@ func TestBuildBlockShouldReward(t *testing.T) {
 	// Build and accept a block with the tx
 	blk, err := env.Builder.BuildBlock(context.Background())
 	require.NoError(err)
-	require.IsType(&block.BanffStandardBlock{}, blk.(*blockexecutor.Block).Block)
+	require.IsType(&block.FortunaStandardBlock{}, blk.(*blockexecutor.Block).Block)
 	require.Equal([]*txs.Tx{tx}, blk.(*blockexecutor.Block).Block.Txs())
 	require.NoError(blk.Verify(context.Background()))
 	require.NoError(blk.Accept(context.Background()))
@@ -178,7 +179,7 @@ func TestBuildBlockShouldReward(t *testing.T) {
 		blk, err := env.Builder.BuildBlock(context.Background())
 		require.NoError(err)
 		require.NoError(blk.Verify(context.Background()))
-		require.IsType(&block.BanffProposalBlock{}, blk.(*blockexecutor.Block).Block)
+		require.IsType(&block.FortunaProposalBlock{}, blk.(*blockexecutor.Block).Block)
 
 		expectedTx, err := NewRewardValidatorTx(env.ctx, staker.TxID)
 		require.NoError(err)
@@ -238,8 +239,8 @@ func TestBuildBlockAdvanceTime(t *testing.T) {
 	require.IsType(&blockexecutor.Block{}, blkIntf)
 	blk := blkIntf.(*blockexecutor.Block)
 	require.Empty(blk.Txs())
-	require.IsType(&block.BanffStandardBlock{}, blk.Block)
-	standardBlk := blk.Block.(*block.BanffStandardBlock)
+	require.IsType(&block.FortunaStandardBlock{}, blk.Block)
+	standardBlk := blk.Block.(*block.FortunaStandardBlock)
 	require.Equal(nextTime.Unix(), standardBlk.Timestamp().Unix())
 }
 
@@ -296,8 +297,8 @@ func TestBuildBlockForceAdvanceTime(t *testing.T) {
 	require.IsType(&blockexecutor.Block{}, blkIntf)
 	blk := blkIntf.(*blockexecutor.Block)
 	require.Equal([]*txs.Tx{tx}, blk.Txs())
-	require.IsType(&block.BanffStandardBlock{}, blk.Block)
-	standardBlk := blk.Block.(*block.BanffStandardBlock)
+	require.IsType(&block.FortunaStandardBlock{}, blk.Block)
+	standardBlk := blk.Block.(*block.FortunaStandardBlock)
 	require.Equal(nextTime.Unix(), standardBlk.Timestamp().Unix())
 }
 
@@ -628,3 +629,67 @@ func TestGetNextStakerToReward(t *testing.T) {
 		})
 	}
 }
+
+func TestDropExpiredTxs(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, upgradetest.Latest)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	now := time.Unix(1_000, 0)
+	newTx := func(memo string, wrap func(txs.UnsignedTx) txs.UnsignedTx) *txs.Tx {
+		tx := &txs.Tx{
+			Unsigned: wrap(&txs.BaseTx{
+				BaseTx: avax.BaseTx{
+					Memo: []byte(memo),
+				},
+			}),
+		}
+		require.NoError(tx.Initialize(txs.Codec))
+		require.NoError(env.mempool.Add(tx))
+		return tx
+	}
+	expiring := func(expiry time.Time) func(txs.UnsignedTx) txs.UnsignedTx {
+		return func(utx txs.UnsignedTx) txs.UnsignedTx {
+			return &txs.ExpiringTx{
+				Expiry: uint64(expiry.Unix()),
+				Tx:     utx,
+			}
+		}
+	}
+	dependent := func(wrap func(txs.UnsignedTx) txs.UnsignedTx) func(txs.UnsignedTx) txs.UnsignedTx {
+		return func(utx txs.UnsignedTx) txs.UnsignedTx {
+			return &txs.DependentTx{
+				DependsOn: ids.GenerateTestID(),
+				Tx:        wrap(utx),
+			}
+		}
+	}
+	unwrapped := func(utx txs.UnsignedTx) txs.UnsignedTx {
+		return utx
+	}
+
+	var (
+		notExpiringTx         = newTx("not expiring", unwrapped)
+		notExpiredTx          = newTx("not expired", expiring(now))
+		expiredTx             = newTx("expired", expiring(now.Add(-time.Second)))
+		dependentNotExpiredTx = newTx("dependent not expired", dependent(expiring(now)))
+		dependentExpiredTx    = newTx("dependent expired", dependent(expiring(now.Add(-time.Second))))
+	)
+
+	dropExpiredTxs(env.mempool, &env.backend, now)
+
+	for _, tx := range []*txs.Tx{notExpiringTx, notExpiredTx, dependentNotExpiredTx} {
+		txID := tx.ID()
+		_, ok := env.mempool.Get(txID)
+		require.True(ok)
+		require.NoError(env.mempool.GetDropReason(txID))
+	}
+	for _, tx := range []*txs.Tx{expiredTx, dependentExpiredTx} {
+		txID := tx.ID()
+		_, ok := env.mempool.Get(txID)
+		require.False(ok)
+		require.ErrorIs(env.mempool.GetDropReason(txID), txexecutor.ErrTxExpired)
+	}
+}
@@ -36,6 +36,7 @@ func init() {
 			RegisterBanffTypes(c),
 			RegisterDurangoTypes(c),
 			RegisterEtnaTypes(c),
+			RegisterFortunaTypes(c),
 		)
 	}
 
@@ -86,3 +87,13 @@ func RegisterDurangoTypes(targetCodec linearcodec.Codec) error {
 func RegisterEtnaTypes(targetCodec linearcodec.Codec) error {
 	return txs.RegisterEtnaTypes(targetCodec)
 }
+
+// RegisterFortunaTypes registers the type information for blocks that were
+// valid during the Fortuna series of upgrades.
+func RegisterFortunaTypes(targetCodec linearcodec.Codec) error {
+	return errors.Join(
+		txs.RegisterFortunaTypes(targetCodec),
+		targetCodec.RegisterType(&FortunaProposalBlock{}),
+		targetCodec.RegisterType(&FortunaStandardBlock{}),
+	)
+}
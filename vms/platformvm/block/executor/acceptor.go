@@ -12,8 +12,10 @@ import (
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward/report"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators"
+	"github.com/ava-labs/avalanchego/vms/platformvm/validators/capacity"
 )
 
 var (
@@ -27,9 +29,20 @@ var (
 // being shutdown.
 type acceptor struct {
 	*backend
-	metrics      metrics.Metrics
-	validators   validators.Manager
-	bootstrapped *utils.Atomic[bool]
+	metrics             metrics.Metrics
+	validators          validators.Manager
+	validatorCapacities capacity.Index
+	rewardReports       report.Reporter
+	bootstrapped        *utils.Atomic[bool]
+}
+
+func (a *acceptor) FortunaProposalBlock(b *block.FortunaProposalBlock) error {
+	a.proposalBlock(b, "fortuna proposal")
+	return nil
+}
+
+func (a *acceptor) FortunaStandardBlock(b *block.FortunaStandardBlock) error {
+	return a.standardBlock(b, "fortuna standard")
 }
 
 func (a *acceptor) BanffAbortBlock(b *block.BanffAbortBlock) error {
@@ -104,6 +117,14 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		)
 	}
 
+	if err := a.validatorCapacities.Accept(b); err != nil {
+		return fmt.Errorf("failed to index validator capacities of block %s: %w", blkID, err)
+	}
+
+	if err := a.rewardReports.Accept(); err != nil {
+		return fmt.Errorf("failed to generate reward reports after block %s: %w", blkID, err)
+	}
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", "apricot atomic"),
@@ -174,6 +195,16 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	// Option blocks don't contain any txs, so only the parent needs to be
+	// indexed.
+	if err := a.validatorCapacities.Accept(parentState.statelessBlock); err != nil {
+		return fmt.Errorf("failed to index validator capacities of block %s: %w", parentID, err)
+	}
+
+	if err := a.rewardReports.Accept(); err != nil {
+		return fmt.Errorf("failed to generate reward reports after block %s: %w", blkID, err)
+	}
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -253,6 +284,14 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	if err := a.validatorCapacities.Accept(b); err != nil {
+		return fmt.Errorf("failed to index validator capacities of block %s: %w", blkID, err)
+	}
+
+	if err := a.rewardReports.Accept(); err != nil {
+		return fmt.Errorf("failed to generate reward reports after block %s: %w", blkID, err)
+	}
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
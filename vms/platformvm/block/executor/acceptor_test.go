@@ -21,8 +21,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward/report"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/validators/capacity"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/validatorstest"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
@@ -61,8 +63,10 @@ func TestAcceptorVisitProposalBlock(t *testing.T) {
 			},
 			state: s,
 		},
-		metrics:    metrics.Noop,
-		validators: validatorstest.Manager,
+		metrics:             metrics.Noop,
+		validators:          validatorstest.Manager,
+		validatorCapacities: capacity.NewNoIndex(),
+		rewardReports:       report.NewNoReporter(),
 	}
 
 	require.NoError(acceptor.ApricotProposalBlock(blk))
@@ -96,8 +100,10 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:    metrics.Noop,
-		validators: validatorstest.Manager,
+		metrics:             metrics.Noop,
+		validators:          validatorstest.Manager,
+		validatorCapacities: capacity.NewNoIndex(),
+		rewardReports:       report.NewNoReporter(),
 	}
 
 	blk, err := block.NewApricotAtomicBlock(
@@ -174,8 +180,10 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:    metrics.Noop,
-		validators: validatorstest.Manager,
+		metrics:             metrics.Noop,
+		validators:          validatorstest.Manager,
+		validatorCapacities: capacity.NewNoIndex(),
+		rewardReports:       report.NewNoReporter(),
 	}
 
 	blk, err := block.NewBanffStandardBlock(
@@ -261,9 +269,11 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:      metrics.Noop,
-		validators:   validatorstest.Manager,
-		bootstrapped: &utils.Atomic[bool]{},
+		metrics:             metrics.Noop,
+		validators:          validatorstest.Manager,
+		validatorCapacities: capacity.NewNoIndex(),
+		rewardReports:       report.NewNoReporter(),
+		bootstrapped:        &utils.Atomic[bool]{},
 	}
 
 	blk, err := block.NewApricotCommitBlock(parentID, 1 /*height*/)
@@ -371,9 +381,11 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:      metrics.Noop,
-		validators:   validatorstest.Manager,
-		bootstrapped: &utils.Atomic[bool]{},
+		metrics:             metrics.Noop,
+		validators:          validatorstest.Manager,
+		validatorCapacities: capacity.NewNoIndex(),
+		rewardReports:       report.NewNoReporter(),
+		bootstrapped:        &utils.Atomic[bool]{},
 	}
 
 	blk, err := block.NewApricotAbortBlock(parentID, 1 /*height*/)
@@ -100,6 +100,15 @@ func (b *backend) getTimestamp(blkID ids.ID) time.Time {
 	return b.state.GetTimestamp()
 }
 
+// getUtilization returns the utilization of the block, or nil if it is unknown.
+// The utilization is only known while the block is processing.
+func (b *backend) getUtilization(blkID ids.ID) []float64 {
+	if blkState, ok := b.blkIDToState[blkID]; ok {
+		return blkState.utilization
+	}
+	return nil
+}
+
 // verifyUniqueInputs returns nil iff no blocks in the inclusive
 // ancestry of [blkID] consume an input in [inputs].
 func (b *backend) verifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
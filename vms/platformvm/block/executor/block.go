@@ -17,6 +17,7 @@ var (
 	_ snowman.Block             = (*Block)(nil)
 	_ snowman.OracleBlock       = (*Block)(nil)
 	_ smblock.WithVerifyContext = (*Block)(nil)
+	_ smblock.WithUtilization   = (*Block)(nil)
 )
 
 // Exported for testing in platformvm package.
@@ -58,9 +59,11 @@ func (b *Block) VerifyWithContext(ctx context.Context, blockContext *smblock.Con
 
 	// Since this is the first time we are verifying this block, we must execute
 	// the state transitions to generate the state diffs.
+	txExecutorBackend := *b.manager.txExecutorBackend
+	txExecutorBackend.Height = b.Height()
 	return b.Visit(&verifier{
 		backend:           b.manager.backend,
-		txExecutorBackend: b.manager.txExecutorBackend,
+		txExecutorBackend: &txExecutorBackend,
 		pChainHeight:      blockContext.PChainHeight,
 	})
 }
@@ -86,6 +89,10 @@ func (b *Block) Timestamp() time.Time {
 	return b.manager.getTimestamp(b.ID())
 }
 
+func (b *Block) Utilization() []float64 {
+	return b.manager.getUtilization(b.ID())
+}
+
 func (b *Block) Options(context.Context) ([2]snowman.Block, error) {
 	options := options{
 		log:                     b.manager.ctx.Log,
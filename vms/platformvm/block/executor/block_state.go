@@ -34,4 +34,5 @@ type blockState struct {
 	atomicRequests  map[ids.ID]*atomic.Requests
 	verifiedHeights set.Set[uint64]
 	metrics         metrics.Block
+	utilization     []float64
 }
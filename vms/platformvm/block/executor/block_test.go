@@ -5,6 +5,7 @@ package executor
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -20,7 +21,6 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
-	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
 )
@@ -99,7 +99,7 @@ func TestBlockOptions(t *testing.T) {
 				stakerTxID := ids.GenerateTestID()
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(nil, status.Unknown, database.ErrNotFound)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(nil, database.ErrNotFound)
 
 				uptimes := uptimemock.NewCalculator(ctrl)
 
@@ -137,7 +137,7 @@ func TestBlockOptions(t *testing.T) {
 				stakerTxID := ids.GenerateTestID()
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(nil, status.Unknown, database.ErrClosed)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(nil, database.ErrClosed)
 
 				uptimes := uptimemock.NewCalculator(ctrl)
 
@@ -172,13 +172,13 @@ func TestBlockOptions(t *testing.T) {
 		{
 			name: "banff proposal block; unexpected staker tx type",
 			blkF: func(ctrl *gomock.Controller) *Block {
-				stakerTxID := ids.GenerateTestID()
-				stakerTx := &txs.Tx{
-					Unsigned: &txs.CreateChainTx{},
-				}
+				var (
+					stakerTxID = ids.GenerateTestID()
+					stakerErr  = fmt.Errorf("%w: %T", state.ErrNotStakerTx, &txs.CreateChainTx{})
+				)
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(stakerTx, status.Committed, nil)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(nil, stakerErr)
 
 				uptimes := uptimemock.NewCalculator(ctrl)
 
@@ -217,18 +217,16 @@ func TestBlockOptions(t *testing.T) {
 					stakerTxID = ids.GenerateTestID()
 					nodeID     = ids.GenerateTestNodeID()
 					subnetID   = ids.GenerateTestID()
-					stakerTx   = &txs.Tx{
-						Unsigned: &txs.AddPermissionlessValidatorTx{
-							Validator: txs.Validator{
-								NodeID: nodeID,
-							},
-							Subnet: subnetID,
+					stakerTx   = &txs.AddPermissionlessValidatorTx{
+						Validator: txs.Validator{
+							NodeID: nodeID,
 						},
+						Subnet: subnetID,
 					}
 				)
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(stakerTx, status.Committed, nil)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(stakerTx, nil)
 				state.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
 
 				uptimes := uptimemock.NewCalculator(ctrl)
@@ -268,13 +266,11 @@ func TestBlockOptions(t *testing.T) {
 					stakerTxID = ids.GenerateTestID()
 					nodeID     = ids.GenerateTestNodeID()
 					subnetID   = constants.PrimaryNetworkID
-					stakerTx   = &txs.Tx{
-						Unsigned: &txs.AddPermissionlessValidatorTx{
-							Validator: txs.Validator{
-								NodeID: nodeID,
-							},
-							Subnet: subnetID,
+					stakerTx   = &txs.AddPermissionlessValidatorTx{
+						Validator: txs.Validator{
+							NodeID: nodeID,
 						},
+						Subnet: subnetID,
 					}
 					primaryNetworkValidatorStartTime = time.Now()
 					staker                           = &state.Staker{
@@ -283,7 +279,7 @@ func TestBlockOptions(t *testing.T) {
 				)
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(stakerTx, status.Committed, nil)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(stakerTx, nil)
 				state.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(staker, nil)
 
 				uptimes := uptimemock.NewCalculator(ctrl)
@@ -324,13 +320,11 @@ func TestBlockOptions(t *testing.T) {
 					stakerTxID = ids.GenerateTestID()
 					nodeID     = ids.GenerateTestNodeID()
 					subnetID   = ids.GenerateTestID()
-					stakerTx   = &txs.Tx{
-						Unsigned: &txs.AddPermissionlessValidatorTx{
-							Validator: txs.Validator{
-								NodeID: nodeID,
-							},
-							Subnet: subnetID,
+					stakerTx   = &txs.AddPermissionlessValidatorTx{
+						Validator: txs.Validator{
+							NodeID: nodeID,
 						},
+						Subnet: subnetID,
 					}
 					primaryNetworkValidatorStartTime = time.Now()
 					staker                           = &state.Staker{
@@ -339,7 +333,7 @@ func TestBlockOptions(t *testing.T) {
 				)
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(stakerTx, status.Committed, nil)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(stakerTx, nil)
 				state.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(staker, nil)
 				state.EXPECT().GetSubnetTransformation(subnetID).Return(nil, database.ErrNotFound)
 
@@ -380,13 +374,11 @@ func TestBlockOptions(t *testing.T) {
 					stakerTxID = ids.GenerateTestID()
 					nodeID     = ids.GenerateTestNodeID()
 					subnetID   = ids.GenerateTestID()
-					stakerTx   = &txs.Tx{
-						Unsigned: &txs.AddPermissionlessValidatorTx{
-							Validator: txs.Validator{
-								NodeID: nodeID,
-							},
-							Subnet: subnetID,
+					stakerTx   = &txs.AddPermissionlessValidatorTx{
+						Validator: txs.Validator{
+							NodeID: nodeID,
 						},
+						Subnet: subnetID,
 					}
 					primaryNetworkValidatorStartTime = time.Now()
 					staker                           = &state.Staker{
@@ -400,7 +392,7 @@ func TestBlockOptions(t *testing.T) {
 				)
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(stakerTx, status.Committed, nil)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(stakerTx, nil)
 				state.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(staker, nil)
 				state.EXPECT().GetSubnetTransformation(subnetID).Return(transformSubnetTx, nil)
 
@@ -442,13 +434,11 @@ func TestBlockOptions(t *testing.T) {
 					stakerTxID = ids.GenerateTestID()
 					nodeID     = ids.GenerateTestNodeID()
 					subnetID   = ids.GenerateTestID()
-					stakerTx   = &txs.Tx{
-						Unsigned: &txs.AddPermissionlessValidatorTx{
-							Validator: txs.Validator{
-								NodeID: nodeID,
-							},
-							Subnet: subnetID,
+					stakerTx   = &txs.AddPermissionlessValidatorTx{
+						Validator: txs.Validator{
+							NodeID: nodeID,
 						},
+						Subnet: subnetID,
 					}
 					primaryNetworkValidatorStartTime = time.Now()
 					staker                           = &state.Staker{
@@ -462,7 +452,7 @@ func TestBlockOptions(t *testing.T) {
 				)
 
 				state := state.NewMockState(ctrl)
-				state.EXPECT().GetTx(stakerTxID).Return(stakerTx, status.Committed, nil)
+				state.EXPECT().GetStakerTx(stakerTxID).Return(stakerTx, nil)
 				state.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(staker, nil)
 				state.EXPECT().GetSubnetTransformation(subnetID).Return(transformSubnetTx, nil)
 
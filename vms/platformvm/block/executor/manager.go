@@ -13,12 +13,14 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward/report"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators"
+	"github.com/ava-labs/avalanchego/vms/platformvm/validators/capacity"
 )
 
 var (
@@ -43,6 +45,9 @@ type Manager interface {
 
 	// VerifyTx verifies that the transaction can be issued based on the currently
 	// preferred state. This should *not* be used to verify transactions in a block.
+	//
+	// If the transaction fails several of its fee, authorization, timing and
+	// weight checks, all of the failures are returned as a joined error.
 	VerifyTx(tx *txs.Tx) error
 
 	// VerifyUniqueInputs verifies that the inputs are not duplicated in the
@@ -56,6 +61,8 @@ func NewManager(
 	s state.State,
 	txExecutorBackend *executor.Backend,
 	validatorManager validators.Manager,
+	validatorCapacities capacity.Index,
+	rewardReports report.Reporter,
 ) Manager {
 	lastAccepted := s.GetLastAccepted()
 	backend := &backend{
@@ -69,10 +76,12 @@ func NewManager(
 	return &manager{
 		backend: backend,
 		acceptor: &acceptor{
-			backend:      backend,
-			metrics:      metrics,
-			validators:   validatorManager,
-			bootstrapped: txExecutorBackend.Bootstrapped,
+			backend:             backend,
+			metrics:             metrics,
+			validators:          validatorManager,
+			validatorCapacities: validatorCapacities,
+			rewardReports:       rewardReports,
+			bootstrapped:        txExecutorBackend.Bootstrapped,
 		},
 		rejector: &rejector{
 			backend:         backend,
@@ -150,6 +159,11 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		return fmt.Errorf("failed verifying warp messages: %w", err)
 	}
 
+	preferred, err := m.GetStatelessBlock(m.preferred)
+	if err != nil {
+		return fmt.Errorf("failed fetching preferred block: %w", err)
+	}
+
 	stateDiff, err := state.NewDiff(m.preferred, m)
 	if err != nil {
 		return fmt.Errorf("failed creating state diff: %w", err)
@@ -174,7 +188,7 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		if err != nil {
 			return fmt.Errorf("failed to calculate tx complexity: %w", err)
 		}
-		gas, err := complexity.ToGas(m.txExecutorBackend.Config.DynamicFeeConfig.Weights)
+		gas, err := complexity.ToGas(m.txExecutorBackend.Config.DynamicFeeWeights(timestamp))
 		if err != nil {
 			return fmt.Errorf("failed to calculate tx gas: %w", err)
 		}
@@ -187,9 +201,16 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		}
 	}
 
+	// The tx is executed as if it were included in the next block. Unlike
+	// during block execution, all the failed checks are reported so that the
+	// issuer of the tx sees every problem with it at once.
+	txExecutorBackend := *m.txExecutorBackend
+	txExecutorBackend.Height = preferred.Height() + 1
+	txExecutorBackend.ReportAllErrors = true
+
 	feeCalculator := state.PickFeeCalculator(m.txExecutorBackend.Config, stateDiff)
 	_, _, _, err = executor.StandardTx(
-		m.txExecutorBackend,
+		&txExecutorBackend,
 		feeCalculator,
 		tx,
 		stateDiff,
@@ -25,7 +25,6 @@ var (
 
 	errUnexpectedProposalTxType           = errors.New("unexpected proposal transaction type")
 	errFailedFetchingStakerTx             = errors.New("failed fetching staker transaction")
-	errUnexpectedStakerTxType             = errors.New("unexpected staker transaction type")
 	errFailedFetchingPrimaryStaker        = errors.New("failed fetching primary staker")
 	errFailedFetchingSubnetTransformation = errors.New("failed fetching subnet transformation")
 	errFailedCalculatingUptime            = errors.New("failed calculating uptime")
@@ -44,6 +43,15 @@ type options struct {
 	alternateBlock block.Block
 }
 
+func (o *options) FortunaProposalBlock(b *block.FortunaProposalBlock) error {
+	// Fortuna proposal blocks have the same options as Banff proposal blocks.
+	return o.BanffProposalBlock(&b.BanffProposalBlock)
+}
+
+func (*options) FortunaStandardBlock(*block.FortunaStandardBlock) error {
+	return snowman.ErrNotOracle
+}
+
 func (*options) BanffAbortBlock(*block.BanffAbortBlock) error {
 	return snowman.ErrNotOracle
 }
@@ -146,16 +154,11 @@ func (o *options) prefersCommit(tx *txs.Tx) (bool, error) {
 		return false, fmt.Errorf("%w: %T", errUnexpectedProposalTxType, tx.Unsigned)
 	}
 
-	stakerTx, _, err := o.state.GetTx(unsignedTx.TxID)
+	staker, err := o.state.GetStakerTx(unsignedTx.TxID)
 	if err != nil {
 		return false, fmt.Errorf("%w: %w", errFailedFetchingStakerTx, err)
 	}
 
-	staker, ok := stakerTx.Unsigned.(txs.Staker)
-	if !ok {
-		return false, fmt.Errorf("%w: %T", errUnexpectedStakerTxType, stakerTx.Unsigned)
-	}
-
 	nodeID := staker.NodeID()
 	primaryNetworkValidator, err := o.state.GetCurrentValidator(
 		constants.PrimaryNetworkID,
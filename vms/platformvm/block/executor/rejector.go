@@ -19,6 +19,14 @@ type rejector struct {
 	addTxsToMempool bool
 }
 
+func (r *rejector) FortunaProposalBlock(b *block.FortunaProposalBlock) error {
+	return r.rejectBlock(b, "fortuna proposal")
+}
+
+func (r *rejector) FortunaStandardBlock(b *block.FortunaStandardBlock) error {
+	return r.rejectBlock(b, "fortuna standard")
+}
+
 func (r *rejector) BanffAbortBlock(b *block.BanffAbortBlock) error {
 	return r.rejectBlock(b, "banff abort")
 }
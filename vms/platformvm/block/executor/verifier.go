@@ -4,8 +4,10 @@
 package executor
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/ids"
@@ -20,6 +22,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 
 	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	validatorfee "github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
@@ -30,8 +33,11 @@ var (
 
 	ErrConflictingBlockTxs         = errors.New("block contains conflicting transactions")
 	ErrStandardBlockWithoutChanges = errors.New("BanffStandardBlock performs no state changes")
+	ErrIncorrectParentStateRoot    = errors.New("incorrect parent state root")
 
 	errApricotBlockIssuedAfterFork           = errors.New("apricot block issued after fork")
+	errBanffBlockIssuedAfterFork             = errors.New("banff block issued after fork")
+	errFortunaBlockIssuedBeforeFork          = errors.New("fortuna block issued before fork")
 	errIncorrectBlockHeight                  = errors.New("incorrect block height")
 	errOptionBlockTimestampNotMatchingParent = errors.New("option block proposed timestamp not matching parent block one")
 )
@@ -43,6 +49,14 @@ type verifier struct {
 	pChainHeight      uint64
 }
 
+func (v *verifier) FortunaProposalBlock(b *block.FortunaProposalBlock) error {
+	return v.banffProposalBlock(b, b.Transactions, b.Tx)
+}
+
+func (v *verifier) FortunaStandardBlock(b *block.FortunaStandardBlock) error {
+	return v.banffStandardBlock(b, b.Transactions)
+}
+
 func (v *verifier) BanffAbortBlock(b *block.BanffAbortBlock) error {
 	if err := v.banffOptionBlock(b); err != nil {
 		return err
@@ -58,6 +72,18 @@ func (v *verifier) BanffCommitBlock(b *block.BanffCommitBlock) error {
 }
 
 func (v *verifier) BanffProposalBlock(b *block.BanffProposalBlock) error {
+	return v.banffProposalBlock(b, b.Transactions, b.Tx)
+}
+
+func (v *verifier) BanffStandardBlock(b *block.BanffStandardBlock) error {
+	return v.banffStandardBlock(b, b.Transactions)
+}
+
+func (v *verifier) banffProposalBlock(
+	b block.BanffBlock,
+	decisionTxs []*txs.Tx,
+	proposalTx *txs.Tx,
+) error {
 	if err := v.banffNonOptionBlock(b); err != nil {
 		return err
 	}
@@ -76,7 +102,7 @@ func (v *verifier) BanffProposalBlock(b *block.BanffProposalBlock) error {
 
 	feeCalculator := state.PickFeeCalculator(v.txExecutorBackend.Config, onDecisionState)
 	inputs, atomicRequests, onAcceptFunc, gasConsumed, _, err := v.processStandardTxs(
-		b.Transactions,
+		decisionTxs,
 		feeCalculator,
 		onDecisionState,
 		b.Parent(),
@@ -97,7 +123,7 @@ func (v *verifier) BanffProposalBlock(b *block.BanffProposalBlock) error {
 
 	return v.proposalBlock( // Must be the last validity check on the block
 		b,
-		b.Tx,
+		proposalTx,
 		onDecisionState,
 		gasConsumed,
 		onCommitState,
@@ -109,7 +135,10 @@ func (v *verifier) BanffProposalBlock(b *block.BanffProposalBlock) error {
 	)
 }
 
-func (v *verifier) BanffStandardBlock(b *block.BanffStandardBlock) error {
+func (v *verifier) banffStandardBlock(
+	b block.BanffBlock,
+	txs []*txs.Tx,
+) error {
 	if err := v.banffNonOptionBlock(b); err != nil {
 		return err
 	}
@@ -133,7 +162,7 @@ func (v *verifier) BanffStandardBlock(b *block.BanffStandardBlock) error {
 	feeCalculator := state.PickFeeCalculator(v.txExecutorBackend.Config, onAcceptState)
 	return v.standardBlock( // Must be the last validity check on the block
 		b,
-		b.Transactions,
+		txs,
 		feeCalculator,
 		onAcceptState,
 		changed,
@@ -302,12 +331,43 @@ func (v *verifier) banffNonOptionBlock(b block.BanffBlock) error {
 
 	newChainTime := b.Timestamp()
 	now := v.txExecutorBackend.Clk.Time()
-	return executor.VerifyNewChainTime(
+	err := executor.VerifyNewChainTime(
 		v.txExecutorBackend.Config.ValidatorFeeConfig,
 		newChainTime,
 		now,
 		parentState,
 	)
+	if err != nil {
+		return err
+	}
+
+	// After Fortuna, blocks must commit to the state of their parent.
+	var (
+		isFortuna                = v.txExecutorBackend.Config.UpgradeConfig.IsFortunaActivated(newChainTime)
+		fortunaBlk, isFortunaBlk = b.(block.FortunaBlock)
+	)
+	switch {
+	case isFortuna && !isFortunaBlk:
+		return fmt.Errorf("%w: timestamp = %s", errBanffBlockIssuedAfterFork, newChainTime)
+	case !isFortuna && isFortunaBlk:
+		return fmt.Errorf("%w: timestamp = %s", errFortunaBlockIssuedBeforeFork, newChainTime)
+	case !isFortunaBlk:
+		return nil
+	}
+
+	parentStateRoot, err := state.GetStateRoot(context.TODO(), parentState)
+	if err != nil {
+		return fmt.Errorf("failed to calculate parent state root: %w", err)
+	}
+	if claimedParentStateRoot := fortunaBlk.ParentStateRoot(); claimedParentStateRoot != parentStateRoot {
+		return fmt.Errorf(
+			"%w: expected %s but got %s",
+			ErrIncorrectParentStateRoot,
+			parentStateRoot,
+			claimedParentStateRoot,
+		)
+	}
+	return nil
 }
 
 func (v *verifier) apricotCommonBlock(b block.Block) error {
@@ -460,6 +520,12 @@ func (v *verifier) proposalBlock(
 			onCommitState,
 			gasConsumed,
 		),
+		utilization: calculateUtilization(
+			v.txExecutorBackend.Config,
+			onAbortState.GetTimestamp(),
+			onCommitState.GetFeeState(),
+			gasConsumed,
+		),
 	}
 	return nil
 }
@@ -523,6 +589,12 @@ func (v *verifier) standardBlock(
 			onAcceptState,
 			gasConsumed,
 		),
+		utilization: calculateUtilization(
+			v.txExecutorBackend.Config,
+			timestamp,
+			onAcceptState.GetFeeState(),
+			gasConsumed,
+		),
 	}
 	return nil
 }
@@ -535,9 +607,18 @@ func (v *verifier) processStandardTxs(txs []*txs.Tx, feeCalculator txfee.Calcula
 	bool,
 	error,
 ) {
+	timestamp := diff.GetTimestamp()
+	// After Fortuna, the size of the txs is limited the same way as in the
+	// mempool and the block builder.
+	if v.txExecutorBackend.Config.UpgradeConfig.IsFortunaActivated(timestamp) {
+		if err := limits.VerifyBlockTxs(limits.Default, txs); err != nil {
+			return nil, nil, nil, 0, false, err
+		}
+	}
+
 	// Complexity is limited first to avoid processing too large of a block.
 	var gasConsumed gas.Gas
-	if timestamp := diff.GetTimestamp(); v.txExecutorBackend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
+	if v.txExecutorBackend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
 		var blockComplexity gas.Dimensions
 		for _, tx := range txs {
 			txComplexity, err := txfee.TxComplexity(tx.Unsigned)
@@ -554,7 +635,7 @@ func (v *verifier) processStandardTxs(txs []*txs.Tx, feeCalculator txfee.Calcula
 		}
 
 		var err error
-		gasConsumed, err = blockComplexity.ToGas(v.txExecutorBackend.Config.DynamicFeeConfig.Weights)
+		gasConsumed, err = blockComplexity.ToGas(v.txExecutorBackend.Config.DynamicFeeWeights(timestamp))
 		if err != nil {
 			return nil, nil, nil, 0, false, fmt.Errorf("block gas overflow: %w", err)
 		}
@@ -676,6 +757,29 @@ func calculateBlockMetrics(
 	}
 }
 
+// calculateUtilization returns the portion of the gas capacity available to a
+// block that it consumed, where [gasState] is the fee state after the block's
+// txs were executed. Prior to Etna, blocks were not limited by the gas they
+// consumed, so their utilization is unknown.
+func calculateUtilization(
+	config *config.Internal,
+	timestamp time.Time,
+	gasState gas.State,
+	gasConsumed gas.Gas,
+) []float64 {
+	if !config.UpgradeConfig.IsEtnaActivated(timestamp) {
+		return nil
+	}
+
+	// The capacity that was consumed by the block was removed from the fee
+	// state, so it is added back to get the capacity available to the block.
+	capacity := gasState.Capacity + gasConsumed
+	if capacity == 0 {
+		return []float64{1}
+	}
+	return []float64{float64(gasConsumed) / float64(capacity)}
+}
+
 // deactivateLowBalanceL1Validators deactivates any L1 validators that might not
 // have sufficient fees to pay for the next second. The returned bool will be
 // true if at least one L1 validator was deactivated.
@@ -710,6 +710,7 @@ func TestBanffAbortBlockTimestampChecks(t *testing.T) {
 			s.EXPECT().GetFeeState().Return(gas.State{}).Times(3)
 			s.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(3)
 			s.EXPECT().GetAccruedFees().Return(uint64(0)).Times(3)
+			s.EXPECT().GetStakingConfig().Return(state.StakingConfig{}).Times(3)
 			s.EXPECT().NumActiveL1Validators().Return(0).Times(3)
 
 			onDecisionState, err := state.NewDiff(parentID, backend)
@@ -811,6 +812,7 @@ func TestBanffCommitBlockTimestampChecks(t *testing.T) {
 			s.EXPECT().GetFeeState().Return(gas.State{}).Times(3)
 			s.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(3)
 			s.EXPECT().GetAccruedFees().Return(uint64(0)).Times(3)
+			s.EXPECT().GetStakingConfig().Return(state.StakingConfig{}).Times(3)
 			s.EXPECT().NumActiveL1Validators().Return(0).Times(3)
 
 			onDecisionState, err := state.NewDiff(parentID, backend)
@@ -1164,10 +1166,11 @@ func TestBlockExecutionWithComplexity(t *testing.T) {
 	require.NoError(t, err)
 
 	tests := []struct {
-		name             string
-		timestamp        time.Time
-		expectedErr      error
-		expectedFeeState gas.State
+		name                string
+		timestamp           time.Time
+		expectedErr         error
+		expectedFeeState    gas.State
+		expectedUtilization []float64
 	}{
 		{
 			name:        "no capacity",
@@ -1178,6 +1181,9 @@ func TestBlockExecutionWithComplexity(t *testing.T) {
 			name:             "updates fee state",
 			timestamp:        genesistest.DefaultValidatorStartTime.Add(secondsToAdvance * time.Second),
 			expectedFeeState: feeStateAfterGasConsumed,
+			expectedUtilization: []float64{
+				float64(blockGas) / float64(feeStateAfterTimeAdvanced.Capacity),
+			},
 		},
 	}
 	for _, test := range tests {
@@ -1199,9 +1205,13 @@ func TestBlockExecutionWithComplexity(t *testing.T) {
 			lastAccepted, err := verifier.state.GetStatelessBlock(lastAcceptedID)
 			require.NoError(err)
 
-			blk, err := block.NewBanffStandardBlock(
+			parentStateRoot, err := state.GetStateRoot(context.Background(), verifier.state)
+			require.NoError(err)
+
+			blk, err := block.NewFortunaStandardBlock(
 				timestamp,
 				lastAcceptedID,
+				parentStateRoot,
 				lastAccepted.Height()+1,
 				[]*txs.Tx{
 					baseTx0,
@@ -1222,6 +1232,109 @@ func TestBlockExecutionWithComplexity(t *testing.T) {
 			blockState := verifier.blkIDToState[blkID]
 			require.Equal(blk, blockState.statelessBlock)
 			require.Equal(test.expectedFeeState, blockState.onAcceptState.GetFeeState())
+			require.Equal(test.expectedUtilization, blockState.utilization)
+		})
+	}
+}
+
+func TestVerifierVisitFortunaBlockParentStateRoot(t *testing.T) {
+	tests := []struct {
+		name            string
+		fork            upgradetest.Fork
+		fortunaBlock    bool
+		wrongParentRoot bool
+		expectedErr     error
+	}{
+		{
+			name: "banff block before fork",
+			fork: upgradetest.Etna,
+		},
+		{
+			name:         "fortuna block before fork",
+			fork:         upgradetest.Etna,
+			fortunaBlock: true,
+			expectedErr:  errFortunaBlockIssuedBeforeFork,
+		},
+		{
+			name:        "banff block after fork",
+			fork:        upgradetest.Fortuna,
+			expectedErr: errBanffBlockIssuedAfterFork,
+		},
+		{
+			name:         "fortuna block after fork",
+			fork:         upgradetest.Fortuna,
+			fortunaBlock: true,
+		},
+		{
+			name:            "incorrect parent state root",
+			fork:            upgradetest.Fortuna,
+			fortunaBlock:    true,
+			wrongParentRoot: true,
+			expectedErr:     ErrIncorrectParentStateRoot,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			verifier := newTestVerifier(t, testVerifierConfig{
+				Upgrades: upgradetest.GetConfig(test.fork),
+			})
+
+			wallet := txstest.NewWallet(
+				t,
+				verifier.ctx,
+				verifier.txExecutorBackend.Config,
+				verifier.state,
+				secp256k1fx.NewKeychain(genesis.EWOQKey),
+				nil, // subnetIDs
+				nil, // validationIDs
+				nil, // chainIDs
+			)
+			tx, err := wallet.IssueBaseTx([]*avax.TransferableOutput{})
+			require.NoError(err)
+
+			lastAcceptedID := verifier.state.GetLastAccepted()
+			lastAccepted, err := verifier.state.GetStatelessBlock(lastAcceptedID)
+			require.NoError(err)
+
+			// Advance the time so that there is capacity for the tx.
+			timestamp := verifier.state.GetTimestamp().Add(10 * time.Second)
+			verifier.txExecutorBackend.Clk.Set(timestamp)
+
+			var blk block.Block
+			if test.fortunaBlock {
+				parentStateRoot, err := state.GetStateRoot(context.Background(), verifier.state)
+				require.NoError(err)
+				if test.wrongParentRoot {
+					parentStateRoot = ids.GenerateTestID()
+				}
+
+				blk, err = block.NewFortunaStandardBlock(
+					timestamp,
+					lastAcceptedID,
+					parentStateRoot,
+					lastAccepted.Height()+1,
+					[]*txs.Tx{tx},
+				)
+				require.NoError(err)
+			} else {
+				blk, err = block.NewBanffStandardBlock(
+					timestamp,
+					lastAcceptedID,
+					lastAccepted.Height()+1,
+					[]*txs.Tx{tx},
+				)
+				require.NoError(err)
+			}
+
+			err = blk.Visit(verifier)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				require.NotContains(verifier.blkIDToState, blk.ID())
+				return
+			}
+			require.Contains(verifier.blkIDToState, blk.ID())
 		})
 	}
 }
@@ -1405,15 +1518,35 @@ func TestDeactivateLowBalanceL1ValidatorBlockChanges(t *testing.T) {
 
 			require.NoError(verifier.state.PutL1Validator(fractionalTimeL1Validator))
 
-			blk, err := block.NewBanffStandardBlock(
-				genesistest.DefaultValidatorStartTime.Add(test.durationToAdvance),
-				verifier.state.GetLastAccepted(),
-				1,   // This block is built on top of the genesis
-				nil, // There are no transactions in the block
+			var (
+				timestamp = genesistest.DefaultValidatorStartTime.Add(test.durationToAdvance)
+				parentID  = verifier.state.GetLastAccepted()
+				blk       block.Block
+				err       error
 			)
+			if test.currentFork >= upgradetest.Fortuna {
+				var parentStateRoot ids.ID
+				parentStateRoot, err = state.GetStateRoot(context.Background(), verifier.state)
+				require.NoError(err)
+
+				blk, err = block.NewFortunaStandardBlock(
+					timestamp,
+					parentID,
+					parentStateRoot,
+					1,   // This block is built on top of the genesis
+					nil, // There are no transactions in the block
+				)
+			} else {
+				blk, err = block.NewBanffStandardBlock(
+					timestamp,
+					parentID,
+					1,   // This block is built on top of the genesis
+					nil, // There are no transactions in the block
+				)
+			}
 			require.NoError(err)
 
-			err = verifier.BanffStandardBlock(blk)
+			err = blk.Visit(verifier)
 			require.ErrorIs(err, test.expectedErr)
 		})
 	}
@@ -13,10 +13,49 @@ import (
 )
 
 var (
-	_ BanffBlock = (*BanffProposalBlock)(nil)
-	_ Block      = (*ApricotProposalBlock)(nil)
+	_ FortunaBlock = (*FortunaProposalBlock)(nil)
+	_ BanffBlock   = (*BanffProposalBlock)(nil)
+	_ Block        = (*ApricotProposalBlock)(nil)
 )
 
+type FortunaProposalBlock struct {
+	PrntStateRoot      ids.ID `serialize:"true" json:"parentStateRoot"`
+	BanffProposalBlock `serialize:"true"`
+}
+
+func (b *FortunaProposalBlock) ParentStateRoot() ids.ID {
+	return b.PrntStateRoot
+}
+
+func (b *FortunaProposalBlock) Visit(v Visitor) error {
+	return v.FortunaProposalBlock(b)
+}
+
+func NewFortunaProposalBlock(
+	timestamp time.Time,
+	parentID ids.ID,
+	parentStateRoot ids.ID,
+	height uint64,
+	proposalTx *txs.Tx,
+	decisionTxs []*txs.Tx,
+) (*FortunaProposalBlock, error) {
+	blk := &FortunaProposalBlock{
+		PrntStateRoot: parentStateRoot,
+		BanffProposalBlock: BanffProposalBlock{
+			Transactions: decisionTxs,
+			Time:         uint64(timestamp.Unix()),
+			ApricotProposalBlock: ApricotProposalBlock{
+				CommonBlock: CommonBlock{
+					PrntID: parentID,
+					Hght:   height,
+				},
+				Tx: proposalTx,
+			},
+		},
+	}
+	return blk, initialize(blk, &blk.CommonBlock)
+}
+
 type BanffProposalBlock struct {
 	Time                 uint64    `serialize:"true" json:"time"`
 	Transactions         []*txs.Tx `serialize:"true" json:"txs"`
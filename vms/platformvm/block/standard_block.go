@@ -13,10 +13,47 @@ import (
 )
 
 var (
-	_ BanffBlock = (*BanffStandardBlock)(nil)
-	_ Block      = (*ApricotStandardBlock)(nil)
+	_ FortunaBlock = (*FortunaStandardBlock)(nil)
+	_ BanffBlock   = (*BanffStandardBlock)(nil)
+	_ Block        = (*ApricotStandardBlock)(nil)
 )
 
+type FortunaStandardBlock struct {
+	PrntStateRoot      ids.ID `serialize:"true" json:"parentStateRoot"`
+	BanffStandardBlock `serialize:"true"`
+}
+
+func (b *FortunaStandardBlock) ParentStateRoot() ids.ID {
+	return b.PrntStateRoot
+}
+
+func (b *FortunaStandardBlock) Visit(v Visitor) error {
+	return v.FortunaStandardBlock(b)
+}
+
+func NewFortunaStandardBlock(
+	timestamp time.Time,
+	parentID ids.ID,
+	parentStateRoot ids.ID,
+	height uint64,
+	txs []*txs.Tx,
+) (*FortunaStandardBlock, error) {
+	blk := &FortunaStandardBlock{
+		PrntStateRoot: parentStateRoot,
+		BanffStandardBlock: BanffStandardBlock{
+			Time: uint64(timestamp.Unix()),
+			ApricotStandardBlock: ApricotStandardBlock{
+				CommonBlock: CommonBlock{
+					PrntID: parentID,
+					Hght:   height,
+				},
+				Transactions: txs,
+			},
+		},
+	}
+	return blk, initialize(blk, &blk.CommonBlock)
+}
+
 type BanffStandardBlock struct {
 	Time                 uint64 `serialize:"true" json:"time"`
 	ApricotStandardBlock `serialize:"true"`
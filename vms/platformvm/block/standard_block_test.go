@@ -59,6 +59,58 @@ func TestNewBanffStandardBlock(t *testing.T) {
 	require.Equal(height, blk.Height())
 }
 
+func TestNewFortunaStandardBlock(t *testing.T) {
+	require := require.New(t)
+
+	timestamp := time.Now().Truncate(time.Second)
+	parentID := ids.GenerateTestID()
+	parentStateRoot := ids.GenerateTestID()
+	height := uint64(1337)
+
+	tx := &txs.Tx{
+		Unsigned: &txs.AddValidatorTx{
+			BaseTx: txs.BaseTx{
+				BaseTx: avax.BaseTx{
+					Ins:  []*avax.TransferableInput{},
+					Outs: []*avax.TransferableOutput{},
+				},
+			},
+			StakeOuts: []*avax.TransferableOutput{},
+			Validator: txs.Validator{},
+			RewardsOwner: &secp256k1fx.OutputOwners{
+				Addrs: []ids.ShortID{},
+			},
+		},
+		Creds: []verify.Verifiable{},
+	}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	blk, err := NewFortunaStandardBlock(
+		timestamp,
+		parentID,
+		parentStateRoot,
+		height,
+		[]*txs.Tx{tx},
+	)
+	require.NoError(err)
+
+	// Make sure the block and tx are initialized
+	require.NotEmpty(blk.Bytes())
+	require.NotEmpty(blk.Transactions[0].Bytes())
+	require.NotEqual(ids.Empty, blk.Transactions[0].ID())
+	require.Equal(tx.Bytes(), blk.Transactions[0].Bytes())
+	require.Equal(timestamp, blk.Timestamp())
+	require.Equal(parentID, blk.Parent())
+	require.Equal(parentStateRoot, blk.ParentStateRoot())
+	require.Equal(height, blk.Height())
+
+	parsed, err := Parse(Codec, blk.Bytes())
+	require.NoError(err)
+	require.Equal(blk.ID(), parsed.ID())
+	require.IsType(&FortunaStandardBlock{}, parsed)
+	require.Equal(parentStateRoot, parsed.(*FortunaStandardBlock).ParentStateRoot())
+}
+
 func TestNewApricotStandardBlock(t *testing.T) {
 	require := require.New(t)
 
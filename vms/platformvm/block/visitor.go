@@ -4,6 +4,9 @@
 package block
 
 type Visitor interface {
+	FortunaProposalBlock(*FortunaProposalBlock) error
+	FortunaStandardBlock(*FortunaStandardBlock) error
+
 	BanffAbortBlock(*BanffAbortBlock) error
 	BanffCommitBlock(*BanffCommitBlock) error
 	BanffProposalBlock(*BanffProposalBlock) error
@@ -7,6 +7,8 @@ import (
 	"context"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -16,12 +18,17 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward/report"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/x/merkledb"
 
+	pb "github.com/ava-labs/avalanchego/proto/pb/sync"
 	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
 )
 
@@ -57,6 +64,25 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetUTXODiff returns the byte representation of the UTXOs controlled by
+	// [addrs] that were added after [height], the IDs of the UTXOs that were
+	// removed after [height] and the height the changes were returned up to.
+	GetUTXODiff(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		height uint64,
+		options ...rpc.Option,
+	) ([][]byte, []ids.ID, uint64, error)
+	// GetUTXOSnapshot returns at most [limit] UTXOs of the UTXO set at
+	// [height], starting at [startUTXOID]. If [limit] is 0, the maximum number
+	// of UTXOs is returned.
+	GetUTXOSnapshot(
+		ctx context.Context,
+		height uint64,
+		startUTXOID ids.ID,
+		limit uint32,
+		options ...rpc.Option,
+	) (*avax.UTXOSnapshotPage, error)
 	// GetSubnet returns information about the specified subnet
 	GetSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (GetSubnetClientResponse, error)
 	// GetSubnets returns information about the specified subnets
@@ -68,6 +94,10 @@ type Client interface {
 	GetStakingAssetID(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (ids.ID, error)
 	// GetCurrentValidators returns the list of current validators for subnet with ID [subnetID]
 	GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientPermissionlessValidator, error)
+	// GetCurrentValidatorsPage returns the page of current validators selected
+	// by [args], along with the cursor to use to fetch the next page. Fields
+	// that aren't selected by [args] are left empty.
+	GetCurrentValidatorsPage(ctx context.Context, args *GetCurrentValidatorsArgs, options ...rpc.Option) ([]ClientPermissionlessValidator, uint64, error)
 	// GetL1Validator returns the requested L1 validator with [validationID] and
 	// the height at which it was calculated.
 	GetL1Validator(ctx context.Context, validationID ids.ID, options ...rpc.Option) (L1Validator, uint64, error)
@@ -75,6 +105,32 @@ type Client interface {
 	GetCurrentSupply(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for subnet with ID [subnetID]
 	SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
+	// GetValidatorCapacities returns the Primary Network validators with at
+	// least [minRemainingDuration] left, ordered by [sortBy], starting at
+	// [cursor], along with the cursor to use to fetch the next page.
+	GetValidatorCapacities(
+		ctx context.Context,
+		sortBy string,
+		minRemainingDuration time.Duration,
+		cursor uint64,
+		pageSize uint64,
+		options ...rpc.Option,
+	) ([]APIValidatorCapacity, uint64, error)
+	// GetRewardReport returns the signed report of the staking rewards
+	// distributed during the epoch that includes [t]. If [t] is the zero time,
+	// the most recently generated report is returned.
+	GetRewardReport(ctx context.Context, t time.Time, options ...rpc.Option) (*report.SignedReport, error)
+	// GetFeeReport returns the fees paid by the transactions accepted in
+	// [startHeight, endHeight] whose inputs were signed by any of [addrs]. If
+	// [endHeight] is 0, the transactions are reported up to the last accepted
+	// block.
+	GetFeeReport(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		startHeight uint64,
+		endHeight uint64,
+		options ...rpc.Option,
+	) ([]APIFeeReportTx, error)
 	// GetBlockchainStatus returns the current status of blockchain with ID: [blockchainID]
 	GetBlockchainStatus(ctx context.Context, blockchainID string, options ...rpc.Option) (status.BlockchainStatus, error)
 	// ValidatedBy returns the ID of the Subnet that validates [blockchainID]
@@ -87,6 +143,9 @@ type Client interface {
 	GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error)
 	// IssueTx issues the transaction and returns its txID
 	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
+	// SimulateTx executes the transaction on top of the node's preferred
+	// state without issuing it
+	SimulateTx(ctx context.Context, tx []byte, options ...rpc.Option) (*SimulateTxReply, error)
 	// GetTx returns the byte representation of the transaction corresponding to [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
@@ -110,6 +169,15 @@ type Client interface {
 	//
 	// Deprecated: GetRewardUTXOs should be fetched from a dedicated indexer.
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
+	// GetUTXOProof returns a proof of whether [utxoID] was in the UTXO set at
+	// [height], along with the merkle root of the UTXO set at [height]. The
+	// proof can be verified with [state.VerifyUTXOProof].
+	GetUTXOProof(
+		ctx context.Context,
+		utxoID ids.ID,
+		height uint64,
+		options ...rpc.Option,
+	) (*merkledb.RangeProof, ids.ID, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
@@ -121,6 +189,36 @@ type Client interface {
 		height platformapi.Height,
 		options ...rpc.Option,
 	) (map[ids.NodeID]*validators.GetValidatorOutput, error)
+	// GetValidatorsAtHeights returns the weights of the validator sets of a
+	// provided subnet at each of the specified heights
+	GetValidatorsAtHeights(
+		ctx context.Context,
+		subnetID ids.ID,
+		heights []uint64,
+		options ...rpc.Option,
+	) (map[uint64]map[ids.NodeID]*validators.GetValidatorOutput, error)
+	// GetValidatorSetQueries returns at most [limit] of the recently created
+	// validator sets that required the validator diffs of the most heights to
+	// be applied, deepest first. If [limit] is 0, all of the remembered
+	// validator set creations are returned.
+	GetValidatorSetQueries(
+		ctx context.Context,
+		limit uint32,
+		options ...rpc.Option,
+	) ([]ValidatorSetQuery, error)
+	// CheckWarpQuorum returns whether [nodeIDs] have at least
+	// [quorumNum]/[quorumDen] of the stake of the canonical warp validator set
+	// of [subnetID] at the specified height, along with the validators that
+	// are missing.
+	CheckWarpQuorum(
+		ctx context.Context,
+		subnetID ids.ID,
+		height platformapi.Height,
+		nodeIDs []ids.NodeID,
+		quorumNum uint64,
+		quorumDen uint64,
+		options ...rpc.Option,
+	) (*CheckWarpQuorumReply, error)
 	// GetBlock returns the block with the given id.
 	GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
@@ -158,6 +256,22 @@ func NewClient(uri string) Client {
 	)}
 }
 
+// NewFailoverClient returns a Client that sends requests to the nodes at
+// [uris]. Transactions are issued to the first available node, in the order
+// of [uris], while other requests are spread across all available nodes.
+// Nodes that fail to respond are skipped for rpc.DefaultUnavailableDuration.
+func NewFailoverClient(uris []string) Client {
+	endpoints := make([]string, len(uris))
+	for i, uri := range uris {
+		endpoints[i] = uri + "/ext/P"
+	}
+	return &client{requester: rpc.NewFailoverEndpointRequester(
+		endpoints,
+		set.Of("platform.issueTx"),
+		rpc.DefaultUnavailableDuration,
+	)}
+}
+
 func (c *client) GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error) {
 	res := &api.GetHeightResponse{}
 	err := c.requester.SendRequest(ctx, "platform.getHeight", struct{}{}, res, options...)
@@ -229,6 +343,68 @@ func (c *client) GetAtomicUTXOs(
 	return utxos, endAddr, endUTXOID, err
 }
 
+func (c *client) GetUTXODiff(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	height uint64,
+	options ...rpc.Option,
+) ([][]byte, []ids.ID, uint64, error) {
+	res := &api.GetUTXODiffReply{}
+	err := c.requester.SendRequest(ctx, "platform.getUTXODiff", &api.GetUTXODiffArgs{
+		Addresses: ids.ShortIDsToStrings(addrs),
+		Height:    json.Uint64(height),
+		Encoding:  formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	added := make([][]byte, len(res.Added))
+	for i, utxo := range res.Added {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		added[i] = utxoBytes
+	}
+	return added, res.Removed, uint64(res.Height), nil
+}
+
+func (c *client) GetUTXOSnapshot(
+	ctx context.Context,
+	height uint64,
+	startUTXOID ids.ID,
+	limit uint32,
+	options ...rpc.Option,
+) (*avax.UTXOSnapshotPage, error) {
+	res := &api.GetUTXOSnapshotReply{}
+	err := c.requester.SendRequest(ctx, "platform.getUTXOSnapshot", &api.GetUTXOSnapshotArgs{
+		Height:      json.Uint64(height),
+		StartUTXOID: startUTXOID,
+		Limit:       json.Uint32(limit),
+		Encoding:    formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([][]byte, len(res.UTXOs))
+	for i, utxo := range res.UTXOs {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+		if err != nil {
+			return nil, err
+		}
+		utxos[i] = utxoBytes
+	}
+	return &avax.UTXOSnapshotPage{
+		UTXOIDs:    res.UTXOIDs,
+		UTXOs:      utxos,
+		Digest:     res.Digest,
+		More:       res.More,
+		NextUTXOID: res.NextUTXOID,
+	}, nil
+}
+
 // GetSubnetClientResponse is the response from calling GetSubnet on the client
 type GetSubnetClientResponse struct {
 	// whether it is permissioned or not
@@ -330,6 +506,20 @@ func (c *client) GetCurrentValidators(
 	return getClientPermissionlessValidators(res.Validators)
 }
 
+func (c *client) GetCurrentValidatorsPage(
+	ctx context.Context,
+	args *GetCurrentValidatorsArgs,
+	options ...rpc.Option,
+) ([]ClientPermissionlessValidator, uint64, error) {
+	res := &GetCurrentValidatorsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getCurrentValidators", args, res, options...)
+	if err != nil {
+		return nil, 0, err
+	}
+	validators, err := getClientPermissionlessValidators(res.Validators)
+	return validators, uint64(res.Cursor), err
+}
+
 // L1Validator is the response from calling GetL1Validator on the API client.
 type L1Validator struct {
 	SubnetID              ids.ID
@@ -412,6 +602,50 @@ func (c *client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSi
 	return res.Validators, err
 }
 
+func (c *client) GetValidatorCapacities(
+	ctx context.Context,
+	sortBy string,
+	minRemainingDuration time.Duration,
+	cursor uint64,
+	pageSize uint64,
+	options ...rpc.Option,
+) ([]APIValidatorCapacity, uint64, error) {
+	res := &GetValidatorCapacitiesReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorCapacities", &GetValidatorCapacitiesArgs{
+		SortBy:               sortBy,
+		MinRemainingDuration: json.Uint64(minRemainingDuration / time.Second),
+		Cursor:               json.Uint64(cursor),
+		PageSize:             json.Uint64(pageSize),
+	}, res, options...)
+	return res.Validators, uint64(res.Cursor), err
+}
+
+func (c *client) GetRewardReport(ctx context.Context, t time.Time, options ...rpc.Option) (*report.SignedReport, error) {
+	args := &GetRewardReportArgs{}
+	if !t.IsZero() {
+		args.Time = json.Uint64(t.Unix())
+	}
+	res := &GetRewardReportReply{}
+	err := c.requester.SendRequest(ctx, "platform.getRewardReport", args, res, options...)
+	return &res.SignedReport, err
+}
+
+func (c *client) GetFeeReport(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	startHeight uint64,
+	endHeight uint64,
+	options ...rpc.Option,
+) ([]APIFeeReportTx, error) {
+	res := &GetFeeReportReply{}
+	err := c.requester.SendRequest(ctx, "platform.getFeeReport", &GetFeeReportArgs{
+		Addresses:   ids.ShortIDsToStrings(addrs),
+		StartHeight: json.Uint64(startHeight),
+		EndHeight:   json.Uint64(endHeight),
+	}, res, options...)
+	return res.Txs, err
+}
+
 func (c *client) GetBlockchainStatus(ctx context.Context, blockchainID string, options ...rpc.Option) (status.BlockchainStatus, error) {
 	res := &GetBlockchainStatusReply{}
 	err := c.requester.SendRequest(ctx, "platform.getBlockchainStatus", &GetBlockchainStatusArgs{
@@ -456,6 +690,20 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SimulateTxReply{}
+	err = c.requester.SendRequest(ctx, "platform.simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "platform.getTx", &api.GetTxArgs{
@@ -555,6 +803,32 @@ func (c *client) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, option
 	return utxos, err
 }
 
+func (c *client) GetUTXOProof(
+	ctx context.Context,
+	utxoID ids.ID,
+	height uint64,
+	options ...rpc.Option,
+) (*merkledb.RangeProof, ids.ID, error) {
+	res := &GetUTXOProofReply{}
+	err := c.requester.SendRequest(ctx, "platform.getUTXOProof", &GetUTXOProofArgs{
+		UTXOID: utxoID,
+		Height: json.Uint64(height),
+	}, res, options...)
+	if err != nil {
+		return nil, ids.Empty, err
+	}
+
+	var pbProof pb.RangeProof
+	if err := proto.Unmarshal(res.Proof, &pbProof); err != nil {
+		return nil, ids.Empty, err
+	}
+	var proof merkledb.RangeProof
+	if err := proof.UnmarshalProto(&pbProof); err != nil {
+		return nil, ids.Empty, err
+	}
+	return &proof, res.Root, nil
+}
+
 func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error) {
 	res := &GetTimestampReply{}
 	err := c.requester.SendRequest(ctx, "platform.getTimestamp", struct{}{}, res, options...)
@@ -575,6 +849,68 @@ func (c *client) GetValidatorsAt(
 	return res.Validators, err
 }
 
+func (c *client) GetValidatorsAtHeights(
+	ctx context.Context,
+	subnetID ids.ID,
+	heights []uint64,
+	options ...rpc.Option,
+) (map[uint64]map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	jsonHeights := make([]json.Uint64, len(heights))
+	for i, height := range heights {
+		jsonHeights[i] = json.Uint64(height)
+	}
+
+	res := &GetValidatorsAtHeightsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorsAtHeights", &GetValidatorsAtHeightsArgs{
+		Heights:  jsonHeights,
+		SubnetID: subnetID,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSets := make(map[uint64]map[ids.NodeID]*validators.GetValidatorOutput, len(res.ValidatorSets))
+	for _, validatorSet := range res.ValidatorSets {
+		if validatorSet.Validators == nil {
+			continue
+		}
+		validatorSets[uint64(validatorSet.Height)] = validatorSet.Validators.Validators
+	}
+	return validatorSets, nil
+}
+
+func (c *client) GetValidatorSetQueries(
+	ctx context.Context,
+	limit uint32,
+	options ...rpc.Option,
+) ([]ValidatorSetQuery, error) {
+	res := &GetValidatorSetQueriesReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorSetQueries", &GetValidatorSetQueriesArgs{
+		Limit: json.Uint32(limit),
+	}, res, options...)
+	return res.Queries, err
+}
+
+func (c *client) CheckWarpQuorum(
+	ctx context.Context,
+	subnetID ids.ID,
+	height platformapi.Height,
+	nodeIDs []ids.NodeID,
+	quorumNum uint64,
+	quorumDen uint64,
+	options ...rpc.Option,
+) (*CheckWarpQuorumReply, error) {
+	res := &CheckWarpQuorumReply{}
+	err := c.requester.SendRequest(ctx, "platform.checkWarpQuorum", &CheckWarpQuorumArgs{
+		Height:            height,
+		SubnetID:          subnetID,
+		NodeIDs:           nodeIDs,
+		QuorumNumerator:   json.Uint64(quorumNum),
+		QuorumDenominator: json.Uint64(quorumDen),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedBlock{}
 	if err := c.requester.SendRequest(ctx, "platform.getBlock", &api.GetBlockArgs{
@@ -632,8 +968,14 @@ func (c *client) GetValidatorFeeState(ctx context.Context, options ...rpc.Option
 	return res.Excess, res.Price, res.Time, err
 }
 
+// TxStatusGetter reports the status of P-chain transactions.
+type TxStatusGetter interface {
+	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxStatusResponse, error)
+}
+
+// AwaitTxAccepted polls [c] every [freq] until [txID] is decided.
 func AwaitTxAccepted(
-	c Client,
+	c TxStatusGetter,
 	ctx context.Context,
 	txID ids.ID,
 	freq time.Duration,
@@ -699,12 +1041,36 @@ func GetDeactivationOwners(
 	return deactivationOwners, nil
 }
 
-// GetOwners returns the union of GetSubnetOwners and GetDeactivationOwners.
+// GetChainOwners returns a map of chain ID to the current owner of the subnet
+// that validates the chain
+func GetChainOwners(
+	c Client,
+	ctx context.Context,
+	chainIDs ...ids.ID,
+) (map[ids.ID]fx.Owner, error) {
+	chainOwners := make(map[ids.ID]fx.Owner, len(chainIDs))
+	for _, chainID := range chainIDs {
+		subnetID, err := c.ValidatedBy(ctx, chainID)
+		if err != nil {
+			return nil, err
+		}
+		subnetOwners, err := GetSubnetOwners(c, ctx, subnetID)
+		if err != nil {
+			return nil, err
+		}
+		chainOwners[chainID] = subnetOwners[subnetID]
+	}
+	return chainOwners, nil
+}
+
+// GetOwners returns the union of GetSubnetOwners, GetDeactivationOwners, and
+// GetChainOwners.
 func GetOwners(
 	c Client,
 	ctx context.Context,
 	subnetIDs []ids.ID,
 	validationIDs []ids.ID,
+	chainIDs []ids.ID,
 ) (map[ids.ID]fx.Owner, error) {
 	subnetOwners, err := GetSubnetOwners(c, ctx, subnetIDs...)
 	if err != nil {
@@ -714,13 +1080,20 @@ func GetOwners(
 	if err != nil {
 		return nil, err
 	}
+	chainOwners, err := GetChainOwners(c, ctx, chainIDs...)
+	if err != nil {
+		return nil, err
+	}
 
-	owners := make(map[ids.ID]fx.Owner, len(subnetOwners)+len(deactivationOwners))
+	owners := make(map[ids.ID]fx.Owner, len(subnetOwners)+len(deactivationOwners)+len(chainOwners))
 	for id, owner := range subnetOwners {
 		owners[id] = owner
 	}
 	for id, owner := range deactivationOwners {
 		owners[id] = owner
 	}
+	for id, owner := range chainOwners {
+		owners[id] = owner
+	}
 	return owners, nil
 }
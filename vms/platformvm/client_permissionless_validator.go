@@ -48,13 +48,22 @@ type ClientPermissionlessValidator struct {
 	// Connected is deprecated for Subnet Validators.
 	// It will be available only for Primary Network Validators.
 	Connected *bool
-	Signer    *signer.ProofOfPossession
+	// MaintenanceWindow is the planned maintenance window announced by the
+	// validator, if any. It is only available for Primary Network Validators.
+	MaintenanceWindow *ClientMaintenanceWindow
+	Signer            *signer.ProofOfPossession
 	// The delegators delegating to this validator
 	DelegatorCount  *uint64
 	DelegatorWeight *uint64
 	Delegators      []ClientDelegator
 }
 
+// ClientMaintenanceWindow is the repr. of a maintenance window sent over client
+type ClientMaintenanceWindow struct {
+	Start uint64
+	End   uint64
+}
+
 // ClientDelegator is the repr. of a delegator sent over client
 type ClientDelegator struct {
 	ClientStaker
@@ -135,6 +144,7 @@ func getClientPermissionlessValidators(validatorsSliceIntf []interface{}) ([]Cli
 			DelegationFee:          float32(apiValidator.DelegationFee),
 			Uptime:                 (*float32)(apiValidator.Uptime),
 			Connected:              apiValidator.Connected,
+			MaintenanceWindow:      apiMaintenanceWindowToClientMaintenanceWindow(apiValidator.MaintenanceWindow),
 			Signer:                 apiValidator.Signer,
 			DelegatorCount:         (*uint64)(apiValidator.DelegatorCount),
 			DelegatorWeight:        (*uint64)(apiValidator.DelegatorWeight),
@@ -143,3 +153,13 @@ func getClientPermissionlessValidators(validatorsSliceIntf []interface{}) ([]Cli
 	}
 	return clientValidators, nil
 }
+
+func apiMaintenanceWindowToClientMaintenanceWindow(window *api.MaintenanceWindow) *ClientMaintenanceWindow {
+	if window == nil {
+		return nil
+	}
+	return &ClientMaintenanceWindow{
+		Start: uint64(window.Start),
+		End:   uint64(window.End),
+	}
+}
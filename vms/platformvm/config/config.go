@@ -26,6 +26,14 @@ var Default = Config{
 	L1SubnetIDNodeIDCacheSize:     16 * units.KiB,
 	ChecksumsEnabled:              false,
 	MempoolPruneFrequency:         30 * time.Minute,
+	IndexValidatorCapacities:      false,
+	IndexUTXOProofs:               false,
+	IndexUTXODiffs:                false,
+	UTXOSnapshotInterval:          0,
+	UTXOSnapshotRetention:         0,
+	RewardReportEpochDuration:     0,
+	ValidatorSetSnapshotInterval:  0,
+	ValidatorDiffsRetention:       0,
 }
 
 // Config contains all of the user-configurable parameters of the PlatformVM.
@@ -45,6 +53,14 @@ type Config struct {
 	L1SubnetIDNodeIDCacheSize     int           `json:"l1-subnet-id-node-id-cache-size"`
 	ChecksumsEnabled              bool          `json:"checksums-enabled"`
 	MempoolPruneFrequency         time.Duration `json:"mempool-prune-frequency"`
+	IndexValidatorCapacities      bool          `json:"index-validator-capacities"`
+	IndexUTXOProofs               bool          `json:"index-utxo-proofs"`
+	IndexUTXODiffs                bool          `json:"index-utxo-diffs"`
+	UTXOSnapshotInterval          uint64        `json:"utxo-snapshot-interval"`
+	UTXOSnapshotRetention         uint64        `json:"utxo-snapshot-retention"`
+	RewardReportEpochDuration     time.Duration `json:"reward-report-epoch-duration"`
+	ValidatorSetSnapshotInterval  uint64        `json:"validator-set-snapshot-interval"`
+	ValidatorDiffsRetention       uint64        `json:"validator-diffs-retention"`
 }
 
 // GetConfig returns a Config from the provided json encoded bytes. If a
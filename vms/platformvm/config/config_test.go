@@ -78,6 +78,7 @@ func TestConfigUnmarshal(t *testing.T) {
 				ExpectedBloomFilterElements:                 15,
 				ExpectedBloomFilterFalsePositiveProbability: 16,
 				MaxBloomFilterFalsePositiveProbability:      17,
+				MaintenanceGossipFrequency:                  18,
 			},
 			BlockCacheSize:                1,
 			TxCacheSize:                   2,
@@ -93,6 +94,14 @@ func TestConfigUnmarshal(t *testing.T) {
 			L1SubnetIDNodeIDCacheSize:     13,
 			ChecksumsEnabled:              true,
 			MempoolPruneFrequency:         time.Minute,
+			IndexValidatorCapacities:      true,
+			IndexUTXOProofs:               true,
+			IndexUTXODiffs:                true,
+			UTXOSnapshotInterval:          16,
+			UTXOSnapshotRetention:         17,
+			RewardReportEpochDuration:     time.Hour,
+			ValidatorSetSnapshotInterval:  14,
+			ValidatorDiffsRetention:       15,
 		}
 		verifyInitializedStruct(t, *expected)
 		verifyInitializedStruct(t, expected.Network)
@@ -36,6 +36,11 @@ type Internal struct {
 	// Dynamic fees are active after Etna
 	DynamicFeeConfig gas.Config
 
+	// If non-zero, the weights used instead of [DynamicFeeConfig.Weights] to
+	// calculate the fee of txs whose only stateful effect is subnet
+	// governance.
+	SubnetAuthFeeWeights gas.Dimensions
+
 	// ACP-77 validator fees are active after Etna
 	ValidatorFeeConfig fee.Config
 
@@ -86,17 +91,26 @@ type Internal struct {
 	// on recently created subnets (without this, users need to wait for
 	// [recentlyAcceptedWindowTTL] to pass for activation to occur).
 	UseCurrentHeight bool
+
+	// MaintenanceWindow is the planned maintenance window this node announces
+	// to validators. If zero, no maintenance window is announced.
+	MaintenanceWindow uptime.MaintenanceWindow
 }
 
-// Create the blockchain described in [tx], but only if this node is a member of
-// the subnet that validates the chain
-func (c *Internal) CreateChain(chainID ids.ID, tx *txs.CreateChainTx) {
-	if c.SybilProtectionEnabled && // Sybil protection is enabled, so nodes might not validate all chains
-		constants.PrimaryNetworkID != tx.SubnetID && // All nodes must validate the primary network
-		!c.TrackedSubnets.Contains(tx.SubnetID) { // This node doesn't validate this blockchain
-		return
+// DynamicFeeWeights returns the weights used to calculate the gas of txs at
+// [timestamp]. State growth isn't charged before Fortuna.
+func (c *Internal) DynamicFeeWeights(timestamp time.Time) gas.Dimensions {
+	weights := c.DynamicFeeConfig.Weights
+	if !c.UpgradeConfig.IsFortunaActivated(timestamp) {
+		weights[gas.StateGrowth] = 0
 	}
+	return weights
+}
 
+// Create the blockchain described in [tx] if this node is a member of the
+// subnet that validates the chain. Otherwise, the chain is only registered so
+// that it can be created if this node starts tracking the subnet.
+func (c *Internal) CreateChain(chainID ids.ID, tx *txs.CreateChainTx) {
 	chainParams := chains.ChainParameters{
 		ID:          chainID,
 		SubnetID:    tx.SubnetID,
@@ -105,5 +119,19 @@ func (c *Internal) CreateChain(chainID ids.ID, tx *txs.CreateChainTx) {
 		FxIDs:       tx.FxIDs,
 	}
 
+	if c.SybilProtectionEnabled && // Sybil protection is enabled, so nodes might not validate all chains
+		constants.PrimaryNetworkID != tx.SubnetID && // All nodes must validate the primary network
+		!c.TrackedSubnets.Contains(tx.SubnetID) { // This node doesn't validate this blockchain
+		c.Chains.RegisterChain(chainParams)
+		return
+	}
+
 	c.Chains.QueueChainCreation(chainParams)
 }
+
+// Retire the blockchain described in [tx]. The chain is retired even if this
+// node isn't a member of the subnet that validates the chain, so that it isn't
+// created if this node starts tracking the subnet.
+func (c *Internal) RetireChain(chainID ids.ID, tx *txs.CreateChainTx) {
+	c.Chains.RetireChain(tx.SubnetID, chainID)
+}
@@ -27,6 +27,7 @@ var DefaultNetwork = Network{
 	ExpectedBloomFilterElements:                 8 * 1024,
 	ExpectedBloomFilterFalsePositiveProbability: .01,
 	MaxBloomFilterFalsePositiveProbability:      .05,
+	MaintenanceGossipFrequency:                  time.Minute,
 }
 
 type Network struct {
@@ -90,4 +91,7 @@ type Network struct {
 	// The smaller this number is, the more frequently that the bloom filter
 	// will be regenerated.
 	MaxBloomFilterFalsePositiveProbability float64 `json:"max-bloom-filter-false-positive-probability"`
+	// MaintenanceGossipFrequency is how frequently this node announces its
+	// planned maintenance window to validators, if one is configured.
+	MaintenanceGossipFrequency time.Duration `json:"maintenance-gossip-frequency"`
 }
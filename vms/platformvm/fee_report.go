@@ -0,0 +1,338 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var (
+	_ txs.Visitor = (*burnVisitor)(nil)
+
+	errProducedMoreThanConsumed = errors.New("produced more AVAX than consumed")
+	errUnknownRewardUTXO        = errors.New("unknown reward UTXO")
+
+	feeReportCSVHeader = []string{
+		"txID",
+		"height",
+		"timestamp",
+		"type",
+		"fee",
+		"bandwidth",
+		"dbRead",
+		"dbWrite",
+		"compute",
+		"stateGrowth",
+		"gas",
+	}
+)
+
+// burnVisitor sums the AVAX consumed and produced by a tx. The AVAX that is
+// consumed without being produced, or moved into an L1 validator's balance, is
+// the fee that was paid by the tx.
+type burnVisitor struct {
+	avaxAssetID ids.ID
+	state       state.State
+
+	consumed uint64
+	produced uint64
+	// numInputs is the number of inputs of the tx. The first numInputs
+	// credentials of the tx authorize them.
+	numInputs int
+}
+
+// burned returns the AVAX burned by [tx] along with the number of credentials
+// of [tx] that authorize its inputs.
+func burned(s state.State, avaxAssetID ids.ID, tx txs.UnsignedTx) (uint64, int, error) {
+	v := &burnVisitor{
+		avaxAssetID: avaxAssetID,
+		state:       s,
+	}
+	if err := tx.Visit(v); err != nil {
+		return 0, 0, err
+	}
+	if v.produced > v.consumed {
+		return 0, 0, fmt.Errorf("%w: %d > %d", errProducedMoreThanConsumed, v.produced, v.consumed)
+	}
+	return v.consumed - v.produced, v.numInputs, nil
+}
+
+func (v *burnVisitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return v.produce(tx.StakeOuts)
+}
+
+func (v *burnVisitor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return v.produce(tx.StakeOuts)
+}
+
+func (v *burnVisitor) CreateChainTx(tx *txs.CreateChainTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) ImportTx(tx *txs.ImportTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	v.numInputs += len(tx.ImportedInputs)
+	return v.consume(tx.ImportedInputs)
+}
+
+func (v *burnVisitor) ExportTx(tx *txs.ExportTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return v.produce(tx.ExportedOutputs)
+}
+
+func (v *burnVisitor) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return v.produce(tx.StakeOuts)
+}
+
+func (v *burnVisitor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return v.produce(tx.StakeOuts)
+}
+
+func (v *burnVisitor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) BaseTx(tx *txs.BaseTx) error {
+	return v.baseTx(tx)
+}
+
+func (v *burnVisitor) ConvertSubnetToL1Tx(tx *txs.ConvertSubnetToL1Tx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	for _, vdr := range tx.Validators {
+		if err := v.produceAmount(vdr.Balance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *burnVisitor) RegisterL1ValidatorTx(tx *txs.RegisterL1ValidatorTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return v.produceAmount(tx.Balance)
+}
+
+func (v *burnVisitor) SetL1ValidatorWeightTx(tx *txs.SetL1ValidatorWeightTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) IncreaseL1ValidatorBalanceTx(tx *txs.IncreaseL1ValidatorBalanceTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	return v.produceAmount(tx.Balance)
+}
+
+func (v *burnVisitor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) ExpiringTx(tx *txs.ExpiringTx) error {
+	return tx.Tx.Visit(v)
+}
+
+func (v *burnVisitor) DependentTx(tx *txs.DependentTx) error {
+	return tx.Tx.Visit(v)
+}
+
+// ClaimRewardsTx consumes the claimed reward UTXOs in addition to its inputs.
+func (v *burnVisitor) ClaimRewardsTx(tx *txs.ClaimRewardsTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	for _, utxoID := range tx.RewardUTXOs {
+		amount, err := v.rewardAmount(utxoID)
+		if err != nil {
+			return err
+		}
+		if err := v.consumeAmount(amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *burnVisitor) SetSubnetValidatorWeightTx(tx *txs.SetSubnetValidatorWeightTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) AddContinuousValidatorTx(tx *txs.AddContinuousValidatorTx) error {
+	return v.AddPermissionlessValidatorTx(&tx.AddPermissionlessValidatorTx)
+}
+
+func (v *burnVisitor) StopContinuousValidatorTx(tx *txs.StopContinuousValidatorTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) AddMultiDelegatorTx(tx *txs.AddMultiDelegatorTx) error {
+	if err := v.baseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+	for _, delegation := range tx.Delegations {
+		if err := v.produce(delegation.StakeOuts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *burnVisitor) SetWithdrawalOwnerTx(tx *txs.SetWithdrawalOwnerTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) RetireChainTx(tx *txs.RetireChainTx) error {
+	return v.baseTx(&tx.BaseTx)
+}
+
+func (v *burnVisitor) baseTx(tx *txs.BaseTx) error {
+	v.numInputs += len(tx.Ins)
+	if err := v.consume(tx.Ins); err != nil {
+		return err
+	}
+	return v.produce(tx.Outs)
+}
+
+func (v *burnVisitor) consume(ins []*avax.TransferableInput) error {
+	for _, in := range ins {
+		if in.AssetID() != v.avaxAssetID {
+			continue
+		}
+		if err := v.consumeAmount(in.In.Amount()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *burnVisitor) consumeAmount(amount uint64) error {
+	var err error
+	v.consumed, err = safemath.Add(v.consumed, amount)
+	return err
+}
+
+func (v *burnVisitor) produce(outs []*avax.TransferableOutput) error {
+	for _, out := range outs {
+		if out.AssetID() != v.avaxAssetID {
+			continue
+		}
+		if err := v.produceAmount(out.Out.Amount()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *burnVisitor) produceAmount(amount uint64) error {
+	var err error
+	v.produced, err = safemath.Add(v.produced, amount)
+	return err
+}
+
+// rewardAmount returns the amount of AVAX held by the reward UTXO [utxoID].
+// Reward UTXOs are indexed by the staker tx that produced them, so they can be
+// looked up after they were claimed.
+func (v *burnVisitor) rewardAmount(utxoID *avax.UTXOID) (uint64, error) {
+	rewardUTXOs, err := v.state.GetRewardUTXOs(utxoID.TxID)
+	if err != nil {
+		return 0, err
+	}
+	inputID := utxoID.InputID()
+	for _, utxo := range rewardUTXOs {
+		if utxo.InputID() != inputID {
+			continue
+		}
+		if utxo.AssetID() != v.avaxAssetID {
+			return 0, nil
+		}
+		out, ok := utxo.Out.(avax.Amounter)
+		if !ok {
+			return 0, fmt.Errorf("%w: %s has unexpected output type", errUnknownRewardUTXO, utxoID)
+		}
+		return out.Amount(), nil
+	}
+	return 0, fmt.Errorf("%w: %s", errUnknownRewardUTXO, utxoID)
+}
+
+// WriteFeeReportCSV writes [txs], as returned by platform.getFeeReport, to [w]
+// as CSV. The complexity and gas columns are empty for transactions accepted
+// prior to Etna.
+func WriteFeeReportCSV(w io.Writer, txs []APIFeeReportTx) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(feeReportCSVHeader); err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		record := []string{
+			tx.TxID.String(),
+			strconv.FormatUint(uint64(tx.Height), 10),
+			strconv.FormatUint(uint64(tx.Timestamp), 10),
+			tx.Type,
+			strconv.FormatUint(uint64(tx.Fee), 10),
+			"",
+			"",
+			"",
+			"",
+			"",
+			"",
+		}
+		if tx.Complexity != nil {
+			for i, dimension := range tx.Complexity {
+				record[5+i] = strconv.FormatUint(dimension, 10)
+			}
+			record[5+gas.NumDimensions] = strconv.FormatUint(uint64(tx.Gas), 10)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
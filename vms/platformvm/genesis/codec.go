@@ -3,8 +3,50 @@
 
 package genesis
 
-import "github.com/ava-labs/avalanchego/vms/platformvm/block"
+import (
+	"math"
 
-const CodecVersion = block.CodecVersion
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+)
 
-var Codec = block.GenesisCodec
+const (
+	CodecVersion = block.CodecVersion
+	// StakingParamsCodecVersion is the version of geneses that specify the
+	// staking params of the primary network.
+	StakingParamsCodecVersion = CodecVersion + 1
+)
+
+// Codec allows genesis blocks of larger than usual size to be parsed.
+var Codec codec.Manager
+
+func init() {
+	c := linearcodec.NewDefault()
+	sc := linearcodec.New([]string{
+		reflectcodec.DefaultTagName,
+		reflectcodec.DefaultTagName + "V1",
+	})
+
+	errs := wrappers.Errs{}
+	for _, c := range []linearcodec.Codec{c, sc} {
+		errs.Add(
+			block.RegisterApricotTypes(c),
+			block.RegisterBanffTypes(c),
+			block.RegisterDurangoTypes(c),
+			block.RegisterEtnaTypes(c),
+			block.RegisterFortunaTypes(c),
+		)
+	}
+
+	Codec = codec.NewManager(math.MaxInt32)
+	errs.Add(
+		Codec.RegisterCodec(CodecVersion, c),
+		Codec.RegisterCodec(StakingParamsCodecVersion, sc),
+	)
+	if errs.Errored() {
+		panic(errs.Err)
+	}
+}
@@ -22,6 +22,30 @@ type Genesis struct {
 	Timestamp     uint64    `serialize:"true"`
 	InitialSupply uint64    `serialize:"true"`
 	Message       string    `serialize:"true"`
+
+	// StakingParams, if set, replace the built-in staking bounds of the
+	// network. They are only serialized by [StakingParamsCodecVersion].
+	StakingParams *StakingParams `serializeV1:"true"`
+}
+
+// StakingParams are the bounds that primary network stakers must satisfy.
+// Durations are in seconds.
+type StakingParams struct {
+	MinValidatorStake uint64 `serialize:"true" json:"minValidatorStake"`
+	MaxValidatorStake uint64 `serialize:"true" json:"maxValidatorStake"`
+	MinDelegatorStake uint64 `serialize:"true" json:"minDelegatorStake"`
+	MinStakeDuration  uint64 `serialize:"true" json:"minStakeDuration"`
+	MaxStakeDuration  uint64 `serialize:"true" json:"maxStakeDuration"`
+}
+
+// Bytes returns the binary representation of the genesis. Geneses that
+// specify staking params are serialized with [StakingParamsCodecVersion].
+func (g *Genesis) Bytes() ([]byte, error) {
+	codecVersion := uint16(CodecVersion)
+	if g.StakingParams != nil {
+		codecVersion = StakingParamsCodecVersion
+	}
+	return Codec.Marshal(codecVersion, g)
 }
 
 func Parse(genesisBytes []byte) (*Genesis, error) {
@@ -48,6 +48,14 @@ var (
 
 	// Node IDs of genesis validators
 	DefaultNodeIDs []ids.NodeID
+
+	DefaultStakingParams = platformvmgenesis.StakingParams{
+		MinValidatorStake: 5 * units.MilliAvax,
+		MaxValidatorStake: 500 * units.MilliAvax,
+		MinDelegatorStake: 1 * units.MilliAvax,
+		MinStakeDuration:  uint64(24 * time.Hour / time.Second),
+		MaxStakeDuration:  uint64(365 * 24 * time.Hour / time.Second),
+	}
 )
 
 func init() {
@@ -59,6 +67,7 @@ func init() {
 
 type Config struct {
 	NetworkID          uint32
+	AVAXAssetID        ids.ID
 	NodeIDs            []ids.NodeID
 	ValidatorWeight    uint64
 	ValidatorStartTime time.Time
@@ -66,12 +75,18 @@ type Config struct {
 
 	FundedKeys     []*secp256k1.PrivateKey
 	InitialBalance uint64
+
+	// StakingParams default to [DefaultStakingParams]
+	StakingParams *platformvmgenesis.StakingParams
 }
 
 func New(t testing.TB, c Config) *platformvmgenesis.Genesis {
 	if c.NetworkID == 0 {
 		c.NetworkID = constants.UnitTestID
 	}
+	if c.AVAXAssetID == ids.Empty {
+		c.AVAXAssetID = AVAXAsset.ID
+	}
 	if len(c.NodeIDs) == 0 {
 		c.NodeIDs = DefaultNodeIDs
 	}
@@ -90,14 +105,19 @@ func New(t testing.TB, c Config) *platformvmgenesis.Genesis {
 	if c.InitialBalance == 0 {
 		c.InitialBalance = DefaultInitialBalance
 	}
+	if c.StakingParams == nil {
+		c.StakingParams = &DefaultStakingParams
+	}
 
 	require := require.New(t)
 
+	avaxAsset := avax.Asset{ID: c.AVAXAssetID}
 	genesis := &platformvmgenesis.Genesis{
 		UTXOs:         make([]*platformvmgenesis.UTXO, len(c.FundedKeys)),
 		Validators:    make([]*txs.Tx, len(c.NodeIDs)),
 		Timestamp:     uint64(c.ValidatorStartTime.Unix()),
 		InitialSupply: InitialSupply,
+		StakingParams: c.StakingParams,
 	}
 	for i, key := range c.FundedKeys {
 		genesis.UTXOs[i] = &platformvmgenesis.UTXO{UTXO: avax.UTXO{
@@ -105,7 +125,7 @@ func New(t testing.TB, c Config) *platformvmgenesis.Genesis {
 				TxID:        snowtest.AVAXAssetID,
 				OutputIndex: uint32(i),
 			},
-			Asset: AVAXAsset,
+			Asset: avaxAsset,
 			Out: &secp256k1fx.TransferOutput{
 				Amt: c.InitialBalance,
 				OutputOwners: secp256k1fx.OutputOwners{
@@ -138,7 +158,7 @@ func New(t testing.TB, c Config) *platformvmgenesis.Genesis {
 			},
 			StakeOuts: []*avax.TransferableOutput{
 				{
-					Asset: AVAXAsset,
+					Asset: avaxAsset,
 					Out: &secp256k1fx.TransferOutput{
 						Amt:          c.ValidatorWeight,
 						OutputOwners: owner,
@@ -173,7 +193,7 @@ func New(t testing.TB, c Config) *platformvmgenesis.Genesis {
 
 func NewBytes(t testing.TB, c Config) []byte {
 	g := New(t, c)
-	genesisBytes, err := platformvmgenesis.Codec.Marshal(platformvmgenesis.CodecVersion, g)
+	genesisBytes, err := g.Bytes()
 	require.NoError(t, err)
 	return genesisBytes
 }
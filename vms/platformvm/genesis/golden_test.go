@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/codectest"
+	"github.com/ava-labs/avalanchego/codec/reflectcodec"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// TestGoldenGenesisSerialization ensures that the serialization of the genesis
+// doesn't change unexpectedly with any of the codec versions. Geneses are
+// parsed by every node joining the network, so they must keep parsing to the
+// same state.
+//
+// To regenerate the golden files after an intentional change, run:
+//
+//	UPDATE_GOLDENS=1 go test ./vms/platformvm/genesis -run TestGoldenGenesisSerialization
+func TestGoldenGenesisSerialization(t *testing.T) {
+	implementations := map[interface{}]interface{}{
+		(*avax.TransferableIn)(nil):  &secp256k1fx.TransferInput{},
+		(*avax.TransferableOut)(nil): &secp256k1fx.TransferOutput{},
+		(*verify.State)(nil):         &secp256k1fx.TransferOutput{},
+		(*verify.Verifiable)(nil):    &secp256k1fx.Credential{},
+		(*txs.UnsignedTx)(nil):       &txs.BaseTx{},
+	}
+
+	tests := []struct {
+		codecVersion uint16
+		tagNames     []string
+	}{
+		{
+			codecVersion: CodecVersion,
+			tagNames: []string{
+				reflectcodec.DefaultTagName,
+			},
+		},
+		{
+			codecVersion: StakingParamsCodecVersion,
+			tagNames: []string{
+				reflectcodec.DefaultTagName,
+				reflectcodec.DefaultTagName + "V1",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("v%d", test.codecVersion), func(t *testing.T) {
+			require := require.New(t)
+
+			// Only the fields serialized by the codec version are populated,
+			// so that the genesis is unchanged after being parsed.
+			populator := codectest.NewPopulatorWithTags(test.tagNames, implementations)
+			genesis := &Genesis{}
+			require.NoError(populator.Populate(genesis))
+
+			bytes, err := Codec.Marshal(test.codecVersion, genesis)
+			require.NoError(err)
+			codectest.RequireGolden(
+				t,
+				filepath.Join("testdata", fmt.Sprintf("v%d", test.codecVersion), "Genesis.hex"),
+				bytes,
+			)
+
+			parsedGenesis := &Genesis{}
+			codecVersion, err := Codec.Unmarshal(bytes, parsedGenesis)
+			require.NoError(err)
+			require.Equal(test.codecVersion, codecVersion)
+			require.Equal(genesis, parsedGenesis)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package lightclient
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+var _ Backend = (*apiBackend)(nil)
+
+// Backend provides the P-chain data that a Client verifies. Nothing returned by
+// a Backend is trusted.
+type Backend interface {
+	// GetBlockByHeight returns the bytes of the P-chain block that was accepted
+	// at [height].
+	GetBlockByHeight(ctx context.Context, height uint64) ([]byte, error)
+	// GetValidatorsAt returns the Primary Network validator set at [height].
+	GetValidatorsAt(
+		ctx context.Context,
+		height uint64,
+	) (map[ids.NodeID]*validators.GetValidatorOutput, error)
+	SignatureAggregator
+}
+
+// SignatureAggregator collects signatures over warp messages from the Primary
+// Network validators.
+type SignatureAggregator interface {
+	// AggregateSignatures returns [msg] signed by at least [quorumNum]/[quorumDen]
+	// of the weight of [vdrs].
+	AggregateSignatures(
+		ctx context.Context,
+		msg *warp.UnsignedMessage,
+		vdrs warp.CanonicalValidatorSet,
+		quorumNum uint64,
+		quorumDen uint64,
+	) (*warp.Message, error)
+}
+
+// NewBackend returns a Backend that fetches blocks and validator sets from the
+// P-chain API exposed by [client] and collects signatures with [aggregator].
+func NewBackend(client platformvm.Client, aggregator SignatureAggregator) Backend {
+	return &apiBackend{
+		SignatureAggregator: aggregator,
+		client:              client,
+	}
+}
+
+type apiBackend struct {
+	SignatureAggregator
+
+	client platformvm.Client
+}
+
+func (b *apiBackend) GetBlockByHeight(ctx context.Context, height uint64) ([]byte, error) {
+	return b.client.GetBlockByHeight(ctx, height)
+}
+
+func (b *apiBackend) GetValidatorsAt(
+	ctx context.Context,
+	height uint64,
+) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	return b.client.GetValidatorsAt(ctx, constants.PrimaryNetworkID, api.Height(height))
+}
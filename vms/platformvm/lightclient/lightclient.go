@@ -0,0 +1,220 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package lightclient follows the P-chain and the Primary Network validator set
+// from a trusted checkpoint without executing P-chain blocks.
+//
+// Every validator set transition is attested to by a warp message, signed by
+// the last verified validator set, that commits to the accepted block ID and
+// the hash of the new validator set. Because the attestation must be signed by
+// the previously trusted validators, a Client should be synced frequently
+// enough that a quorum of those validators is still online.
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
+
+	proposervmblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+const (
+	QuorumNumerator   = 67
+	QuorumDenominator = 100
+)
+
+var (
+	ErrStaleHeight           = errors.New("height is not after the latest checkpoint")
+	ErrUnknownPChainHeight   = errors.New("no verified checkpoint at P-chain height")
+	ErrUnsignedProposerBlock = errors.New("proposervm block does not reference a P-chain height")
+	ErrUnknownProposer       = errors.New("proposer is not a validator")
+
+	errWrongHeight        = errors.New("wrong block height")
+	errWrongSignedMessage = errors.New("signed message does not match the requested message")
+)
+
+// Checkpoint is a verified P-chain height along with the Primary Network
+// validator set at that height.
+type Checkpoint struct {
+	Height     uint64
+	BlockID    ids.ID
+	Validators warp.CanonicalValidatorSet
+}
+
+// Client verifies P-chain blocks and validator set transitions starting from a
+// trusted checkpoint.
+//
+// Client is not safe for concurrent use.
+type Client struct {
+	networkID   uint32
+	backend     Backend
+	latest      Checkpoint
+	checkpoints map[uint64]Checkpoint
+}
+
+// New returns a Client that trusts [checkpoint] and fetches all further data
+// from [backend].
+func New(networkID uint32, checkpoint Checkpoint, backend Backend) *Client {
+	return &Client{
+		networkID: networkID,
+		backend:   backend,
+		latest:    checkpoint,
+		checkpoints: map[uint64]Checkpoint{
+			checkpoint.Height: checkpoint,
+		},
+	}
+}
+
+// Latest returns the most recently verified checkpoint.
+func (c *Client) Latest() Checkpoint {
+	return c.latest
+}
+
+// Checkpoint returns the verified checkpoint at [height], if one exists.
+func (c *Client) Checkpoint(height uint64) (Checkpoint, bool) {
+	checkpoint, ok := c.checkpoints[height]
+	return checkpoint, ok
+}
+
+// Sync fetches the P-chain block and validator set at [height] and verifies
+// them against the latest checkpoint. If verification succeeds, the result
+// becomes the latest checkpoint.
+func (c *Client) Sync(ctx context.Context, height uint64) (Checkpoint, error) {
+	if height <= c.latest.Height {
+		return Checkpoint{}, fmt.Errorf("%w: %d <= %d", ErrStaleHeight, height, c.latest.Height)
+	}
+
+	blkBytes, err := c.backend.GetBlockByHeight(ctx, height)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("failed to fetch block at height %d: %w", height, err)
+	}
+	blk, err := block.Parse(block.Codec, blkBytes)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("failed to parse block at height %d: %w", height, err)
+	}
+	if blkHeight := blk.Height(); blkHeight != height {
+		return Checkpoint{}, fmt.Errorf("%w: expected %d but got %d", errWrongHeight, height, blkHeight)
+	}
+
+	vdrSet, err := c.backend.GetValidatorsAt(ctx, height)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("failed to fetch validator set at height %d: %w", height, err)
+	}
+	vdrs, err := warp.FlattenValidatorSet(vdrSet)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("failed to flatten validator set: %w", err)
+	}
+	validatorsHash, err := message.HashValidatorSet(vdrs)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+
+	unsignedMsg, err := newValidatorSetMessage(c.networkID, height, blk.ID(), validatorsHash)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	signedMsg, err := c.backend.AggregateSignatures(
+		ctx,
+		unsignedMsg,
+		c.latest.Validators,
+		QuorumNumerator,
+		QuorumDenominator,
+	)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("failed to aggregate signatures: %w", err)
+	}
+	if err := c.verifySignedMessage(unsignedMsg, signedMsg); err != nil {
+		return Checkpoint{}, err
+	}
+
+	checkpoint := Checkpoint{
+		Height:     height,
+		BlockID:    blk.ID(),
+		Validators: vdrs,
+	}
+	c.latest = checkpoint
+	c.checkpoints[height] = checkpoint
+	return checkpoint, nil
+}
+
+// VerifyProposerBlock parses a proposervm block of [chainID] and verifies that
+// it was signed by a Primary Network validator at the P-chain height the block
+// references. The P-chain height must have previously been verified.
+func (c *Client) VerifyProposerBlock(blkBytes []byte, chainID ids.ID) (proposervmblock.SignedBlock, error) {
+	blk, err := proposervmblock.Parse(blkBytes, chainID)
+	if err != nil {
+		return nil, err
+	}
+	signedBlk, ok := blk.(proposervmblock.SignedBlock)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsignedProposerBlock, blk.ID())
+	}
+
+	pChainHeight := signedBlk.PChainHeight()
+	checkpoint, ok := c.checkpoints[pChainHeight]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownPChainHeight, pChainHeight)
+	}
+
+	// Blocks without a proposer may be issued by anyone once the proposer
+	// windows have passed, so there is nothing further to verify.
+	proposer := signedBlk.Proposer()
+	if proposer == ids.EmptyNodeID {
+		return signedBlk, nil
+	}
+	for _, vdr := range checkpoint.Validators.Validators {
+		for _, nodeID := range vdr.NodeIDs {
+			if nodeID == proposer {
+				return signedBlk, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %s at P-chain height %d", ErrUnknownProposer, proposer, pChainHeight)
+}
+
+func (c *Client) verifySignedMessage(
+	expected *warp.UnsignedMessage,
+	signed *warp.Message,
+) error {
+	if signed.UnsignedMessage.ID() != expected.ID() {
+		return errWrongSignedMessage
+	}
+	return signed.Signature.Verify(
+		&signed.UnsignedMessage,
+		c.networkID,
+		c.latest.Validators,
+		QuorumNumerator,
+		QuorumDenominator,
+	)
+}
+
+// newValidatorSetMessage returns the unsigned warp message that the P-chain
+// validators sign to attest to the validator set at [height].
+func newValidatorSetMessage(
+	networkID uint32,
+	height uint64,
+	blockID ids.ID,
+	validatorsHash ids.ID,
+) (*warp.UnsignedMessage, error) {
+	validatorSet, err := message.NewValidatorSet(height, blockID, validatorsHash)
+	if err != nil {
+		return nil, err
+	}
+	addressedCall, err := payload.NewAddressedCall(nil, validatorSet.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return warp.NewUnsignedMessage(
+		networkID,
+		constants.PlatformChainID,
+		addressedCall.Bytes(),
+	)
+}
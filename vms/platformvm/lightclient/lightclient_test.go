@@ -0,0 +1,276 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package lightclient
+
+import (
+	"context"
+	"crypto"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+
+	proposervmblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+var _ Backend = (*testBackend)(nil)
+
+type testBackend struct {
+	blocks     map[uint64][]byte
+	validators map[uint64]map[ids.NodeID]*validators.GetValidatorOutput
+	// signers maps the uncompressed public key of each online validator to
+	// its secret key.
+	signers map[string]bls.Signer
+	// signedMsg, if non-nil, is signed in place of the requested message.
+	signedMsg *warp.UnsignedMessage
+}
+
+func newTestBackend() *testBackend {
+	return &testBackend{
+		blocks:     make(map[uint64][]byte),
+		validators: make(map[uint64]map[ids.NodeID]*validators.GetValidatorOutput),
+		signers:    make(map[string]bls.Signer),
+	}
+}
+
+// addValidators generates [numValidators] validators at [height], each with a
+// weight of 1 and an online signer.
+func (b *testBackend) addValidators(
+	t *testing.T,
+	height uint64,
+	numValidators int,
+) map[ids.NodeID]*validators.GetValidatorOutput {
+	vdrs := make(map[ids.NodeID]*validators.GetValidatorOutput, numValidators)
+	for range numValidators {
+		sk, err := localsigner.New()
+		require.NoError(t, err)
+
+		nodeID := ids.GenerateTestNodeID()
+		pk := sk.PublicKey()
+		vdrs[nodeID] = &validators.GetValidatorOutput{
+			NodeID:    nodeID,
+			PublicKey: pk,
+			Weight:    1,
+		}
+		b.signers[string(bls.PublicKeyToUncompressedBytes(pk))] = sk
+	}
+	b.validators[height] = vdrs
+	return vdrs
+}
+
+func (b *testBackend) addBlock(t *testing.T, height uint64) ids.ID {
+	blk, err := block.NewBanffStandardBlock(time.Unix(int64(height), 0), ids.GenerateTestID(), height, nil)
+	require.NoError(t, err)
+	b.blocks[height] = blk.Bytes()
+	return blk.ID()
+}
+
+func (b *testBackend) GetBlockByHeight(_ context.Context, height uint64) ([]byte, error) {
+	return b.blocks[height], nil
+}
+
+func (b *testBackend) GetValidatorsAt(
+	_ context.Context,
+	height uint64,
+) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	return b.validators[height], nil
+}
+
+func (b *testBackend) AggregateSignatures(
+	_ context.Context,
+	msg *warp.UnsignedMessage,
+	vdrs warp.CanonicalValidatorSet,
+	_ uint64,
+	_ uint64,
+) (*warp.Message, error) {
+	if b.signedMsg != nil {
+		msg = b.signedMsg
+	}
+
+	var (
+		signers = set.NewBits()
+		sigs    []*bls.Signature
+	)
+	for i, vdr := range vdrs.Validators {
+		sk, ok := b.signers[string(vdr.PublicKeyBytes)]
+		if !ok {
+			continue
+		}
+		sig, err := sk.Sign(msg.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		signers.Add(i)
+		sigs = append(sigs, sig)
+	}
+
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, err
+	}
+	return warp.NewMessage(msg, &warp.BitSetSignature{
+		Signers:   signers.Bytes(),
+		Signature: [bls.SignatureLen]byte(bls.SignatureToBytes(aggSig)),
+	})
+}
+
+func newTestClient(t *testing.T) (*Client, *testBackend) {
+	backend := newTestBackend()
+	genesisVdrs, err := warp.FlattenValidatorSet(backend.addValidators(t, 0, 3))
+	require.NoError(t, err)
+
+	return New(
+		constants.UnitTestID,
+		Checkpoint{
+			Height:     0,
+			BlockID:    backend.addBlock(t, 0),
+			Validators: genesisVdrs,
+		},
+		backend,
+	), backend
+}
+
+func TestSync(t *testing.T) {
+	require := require.New(t)
+
+	client, backend := newTestClient(t)
+	blkID := backend.addBlock(t, 5)
+	vdrSet := backend.addValidators(t, 5, 4)
+	expectedVdrs, err := warp.FlattenValidatorSet(vdrSet)
+	require.NoError(err)
+
+	checkpoint, err := client.Sync(context.Background(), 5)
+	require.NoError(err)
+	require.Equal(uint64(5), checkpoint.Height)
+	require.Equal(blkID, checkpoint.BlockID)
+	require.Equal(expectedVdrs, checkpoint.Validators)
+	require.Equal(checkpoint, client.Latest())
+
+	stored, ok := client.Checkpoint(5)
+	require.True(ok)
+	require.Equal(checkpoint, stored)
+
+	_, err = client.Sync(context.Background(), 5)
+	require.ErrorIs(err, ErrStaleHeight)
+}
+
+func TestSyncErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		setup       func(*testing.T, *testBackend)
+		expectedErr error
+	}{
+		{
+			name: "wrong block height",
+			setup: func(t *testing.T, b *testBackend) {
+				b.addBlock(t, 2)
+				b.blocks[1] = b.blocks[2]
+				b.addValidators(t, 1, 1)
+			},
+			expectedErr: errWrongHeight,
+		},
+		{
+			name: "insufficient weight",
+			setup: func(t *testing.T, b *testBackend) {
+				b.addBlock(t, 1)
+				b.addValidators(t, 1, 1)
+				for _, vdr := range b.validators[0] {
+					delete(b.signers, string(bls.PublicKeyToUncompressedBytes(vdr.PublicKey)))
+					break
+				}
+			},
+			expectedErr: warp.ErrInsufficientWeight,
+		},
+		{
+			name: "signed different message",
+			setup: func(t *testing.T, b *testBackend) {
+				b.addBlock(t, 1)
+				b.addValidators(t, 1, 1)
+
+				msg, err := newValidatorSetMessage(constants.UnitTestID, 1, ids.GenerateTestID(), ids.GenerateTestID())
+				require.NoError(t, err)
+				b.signedMsg = msg
+			},
+			expectedErr: errWrongSignedMessage,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, backend := newTestClient(t)
+			test.setup(t, backend)
+
+			initial := client.Latest()
+			_, err := client.Sync(context.Background(), 1)
+			require.ErrorIs(t, err, test.expectedErr)
+			require.Equal(t, initial, client.Latest())
+		})
+	}
+}
+
+func TestVerifyProposerBlock(t *testing.T) {
+	require := require.New(t)
+
+	client, backend := newTestClient(t)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(err)
+	key := tlsCert.PrivateKey.(crypto.Signer)
+	nodeID := ids.NodeIDFromCert(cert)
+
+	chainID := ids.GenerateTestID()
+	build := func(pChainHeight uint64) []byte {
+		blk, err := proposervmblock.Build(
+			ids.GenerateTestID(),
+			time.Unix(123, 0),
+			pChainHeight,
+			cert,
+			[]byte{1},
+			chainID,
+			key,
+		)
+		require.NoError(err)
+		return blk.Bytes()
+	}
+
+	// The proposer is not a validator at the genesis checkpoint.
+	_, err = client.VerifyProposerBlock(build(0), chainID)
+	require.ErrorIs(err, ErrUnknownProposer)
+
+	// The P-chain height hasn't been verified.
+	_, err = client.VerifyProposerBlock(build(1), chainID)
+	require.ErrorIs(err, ErrUnknownPChainHeight)
+
+	backend.addBlock(t, 1)
+	vdrSet := backend.addValidators(t, 1, 1)
+	sk, err := localsigner.New()
+	require.NoError(err)
+	vdrSet[nodeID] = &validators.GetValidatorOutput{
+		NodeID:    nodeID,
+		PublicKey: sk.PublicKey(),
+		Weight:    1,
+	}
+	_, err = client.Sync(context.Background(), 1)
+	require.NoError(err)
+
+	blk, err := client.VerifyProposerBlock(build(1), chainID)
+	require.NoError(err)
+	require.Equal(nodeID, blk.Proposer())
+
+	unsignedBlk, err := proposervmblock.BuildUnsigned(ids.GenerateTestID(), time.Unix(123, 0), 1, []byte{1})
+	require.NoError(err)
+	_, err = client.VerifyProposerBlock(unsignedBlk.Bytes(), chainID)
+	require.NoError(err)
+}
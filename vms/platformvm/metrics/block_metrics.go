@@ -41,6 +41,14 @@ func newBlockMetrics(registerer prometheus.Registerer) (*blockMetrics, error) {
 	return m, registerer.Register(m.numBlocks)
 }
 
+func (m *blockMetrics) FortunaProposalBlock(b *block.FortunaProposalBlock) error {
+	return m.BanffProposalBlock(&b.BanffProposalBlock)
+}
+
+func (m *blockMetrics) FortunaStandardBlock(b *block.FortunaStandardBlock) error {
+	return m.BanffStandardBlock(&b.BanffStandardBlock)
+}
+
 func (m *blockMetrics) BanffAbortBlock(*block.BanffAbortBlock) error {
 	m.numBlocks.With(prometheus.Labels{
 		blkLabel: "abort",
@@ -22,6 +22,11 @@ const (
 )
 
 var (
+	// 1 height to ~262k heights
+	validatorSetHeightsWalkedBuckets = prometheus.ExponentialBuckets(1, 4, 10)
+	// 100us to ~26s
+	validatorSetCreationDurationBuckets = prometheus.ExponentialBuckets(.0001, 4, 10)
+
 	gasLabels = prometheus.Labels{
 		ResourceLabel: GasLabel,
 	}
@@ -60,6 +65,9 @@ type Metrics interface {
 	// Mark that we computed a validator diff at a height with the given
 	// difference from the top.
 	AddValidatorSetsHeightDiff(uint64)
+	// Mark that a validator set was created by applying the validator diffs of
+	// the given number of heights, which took the given time.
+	ObserveValidatorSetCreated(heightsWalked uint64, duration time.Duration)
 
 	// Mark that this much stake is staked on the node.
 	SetLocalStake(uint64)
@@ -142,6 +150,16 @@ func New(registerer prometheus.Registerer) (Metrics, error) {
 			Name: "validator_sets_duration_sum",
 			Help: "Total amount of time generating validator sets in nanoseconds",
 		}),
+		validatorSetHeightsWalked: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "validator_set_heights_walked",
+			Help:    "Number of heights whose validator diffs were applied to create a validator set",
+			Buckets: validatorSetHeightsWalkedBuckets,
+		}),
+		validatorSetCreationDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "validator_set_creation_duration",
+			Help:    "Time (in seconds) taken to create a validator set",
+			Buckets: validatorSetCreationDurationBuckets,
+		}),
 	}
 
 	errs := wrappers.Errs{Err: err}
@@ -165,6 +183,8 @@ func New(registerer prometheus.Registerer) (Metrics, error) {
 		registerer.Register(m.validatorSetsCached),
 		registerer.Register(m.validatorSetsHeightDiff),
 		registerer.Register(m.validatorSetsDuration),
+		registerer.Register(m.validatorSetHeightsWalked),
+		registerer.Register(m.validatorSetCreationDuration),
 	)
 
 	return m, errs.Err
@@ -193,6 +213,9 @@ type metrics struct {
 	validatorSetsCreated    prometheus.Counter
 	validatorSetsHeightDiff prometheus.Gauge
 	validatorSetsDuration   prometheus.Gauge
+
+	validatorSetHeightsWalked    prometheus.Histogram
+	validatorSetCreationDuration prometheus.Histogram
 }
 
 func (m *metrics) MarkAccepted(b Block) error {
@@ -225,6 +248,11 @@ func (m *metrics) AddValidatorSetsHeightDiff(d uint64) {
 	m.validatorSetsHeightDiff.Add(float64(d))
 }
 
+func (m *metrics) ObserveValidatorSetCreated(heightsWalked uint64, duration time.Duration) {
+	m.validatorSetHeightsWalked.Observe(float64(heightsWalked))
+	m.validatorSetCreationDuration.Observe(duration.Seconds())
+}
+
 func (m *metrics) SetLocalStake(s uint64) {
 	m.localStake.Set(float64(s))
 }
@@ -38,6 +38,8 @@ func (noopMetrics) AddValidatorSetsDuration(time.Duration) {}
 
 func (noopMetrics) AddValidatorSetsHeightDiff(uint64) {}
 
+func (noopMetrics) ObserveValidatorSetCreated(uint64, time.Duration) {}
+
 func (noopMetrics) SetLocalStake(uint64) {}
 
 func (noopMetrics) SetTotalStake(uint64) {}
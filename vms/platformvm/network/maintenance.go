@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const maintenanceAnnouncementLen = 2 * wrappers.LongLen
+
+var (
+	_ p2p.Handler               = (*maintenanceHandler)(nil)
+	_ uptime.MaintenanceTracker = (*LockedMaintenanceTracker)(nil)
+
+	errInvalidMaintenanceAnnouncementLen = errors.New("invalid maintenance announcement length")
+)
+
+// LockedMaintenanceTracker serializes access to a MaintenanceTracker.
+type LockedMaintenanceTracker struct {
+	lock    sync.Locker
+	tracker uptime.MaintenanceTracker
+}
+
+func NewLockedMaintenanceTracker(lock sync.Locker, tracker uptime.MaintenanceTracker) *LockedMaintenanceTracker {
+	return &LockedMaintenanceTracker{
+		lock:    lock,
+		tracker: tracker,
+	}
+}
+
+func (l *LockedMaintenanceTracker) RecordMaintenance(nodeID ids.NodeID, window uptime.MaintenanceWindow) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.tracker.RecordMaintenance(nodeID, window)
+}
+
+func (l *LockedMaintenanceTracker) GetMaintenance(nodeID ids.NodeID) (uptime.MaintenanceWindow, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.tracker.GetMaintenance(nodeID)
+}
+
+// maintenanceHandler records the maintenance windows announced by peers.
+//
+// Announcements are only ever sent directly by the validator that is
+// announcing its own maintenance window, so the sender of the message is the
+// subject of the announcement.
+type maintenanceHandler struct {
+	p2p.NoOpHandler
+
+	log     logging.Logger
+	tracker uptime.MaintenanceTracker
+}
+
+func (m *maintenanceHandler) AppGossip(_ context.Context, nodeID ids.NodeID, gossipBytes []byte) {
+	window, err := parseMaintenanceAnnouncement(gossipBytes)
+	if err != nil {
+		m.log.Debug("dropping maintenance announcement",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	if err := m.tracker.RecordMaintenance(nodeID, window); err != nil {
+		m.log.Debug("dropping maintenance announcement",
+			zap.Stringer("nodeID", nodeID),
+			zap.Time("start", window.Start),
+			zap.Time("end", window.End),
+			zap.Error(err),
+		)
+		return
+	}
+
+	m.log.Verbo("recorded maintenance announcement",
+		zap.Stringer("nodeID", nodeID),
+		zap.Time("start", window.Start),
+		zap.Time("end", window.End),
+	)
+}
+
+// MaintenanceGossip periodically announces this node's maintenance window to
+// all connected validators until the window ends.
+func (n *Network) MaintenanceGossip(ctx context.Context) {
+	if n.maintenanceWindow == (uptime.MaintenanceWindow{}) {
+		return
+	}
+
+	announcement := marshalMaintenanceAnnouncement(n.maintenanceWindow)
+	ticker := time.NewTicker(n.maintenanceGossipFrequency)
+	defer ticker.Stop()
+
+	for time.Now().Before(n.maintenanceWindow.End) {
+		err := n.maintenanceClient.AppGossip(
+			ctx,
+			common.SendConfig{
+				Validators: math.MaxInt32,
+			},
+			announcement,
+		)
+		if err != nil {
+			n.log.Warn("failed to announce maintenance window",
+				zap.Error(err),
+			)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func marshalMaintenanceAnnouncement(window uptime.MaintenanceWindow) []byte {
+	bytes := make([]byte, maintenanceAnnouncementLen)
+	binary.BigEndian.PutUint64(bytes, uint64(window.Start.Unix()))
+	binary.BigEndian.PutUint64(bytes[wrappers.LongLen:], uint64(window.End.Unix()))
+	return bytes
+}
+
+func parseMaintenanceAnnouncement(bytes []byte) (uptime.MaintenanceWindow, error) {
+	if len(bytes) != maintenanceAnnouncementLen {
+		return uptime.MaintenanceWindow{}, fmt.Errorf("%w: %d != %d",
+			errInvalidMaintenanceAnnouncementLen,
+			len(bytes),
+			maintenanceAnnouncementLen,
+		)
+	}
+
+	window := uptime.MaintenanceWindow{
+		Start: time.Unix(int64(binary.BigEndian.Uint64(bytes)), 0),
+		End:   time.Unix(int64(binary.BigEndian.Uint64(bytes[wrappers.LongLen:])), 0),
+	}
+	return window, window.Verify()
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+func TestParseMaintenanceAnnouncement(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	tests := []struct {
+		name           string
+		bytes          []byte
+		expectedWindow uptime.MaintenanceWindow
+		expectedErr    error
+	}{
+		{
+			name: "valid",
+			bytes: marshalMaintenanceAnnouncement(uptime.MaintenanceWindow{
+				Start: start,
+				End:   start.Add(time.Hour),
+			}),
+			expectedWindow: uptime.MaintenanceWindow{
+				Start: start,
+				End:   start.Add(time.Hour),
+			},
+		},
+		{
+			name:        "too short",
+			bytes:       make([]byte, maintenanceAnnouncementLen-1),
+			expectedErr: errInvalidMaintenanceAnnouncementLen,
+		},
+		{
+			name:        "too long",
+			bytes:       make([]byte, maintenanceAnnouncementLen+1),
+			expectedErr: errInvalidMaintenanceAnnouncementLen,
+		},
+		{
+			name: "ends before it starts",
+			bytes: marshalMaintenanceAnnouncement(uptime.MaintenanceWindow{
+				Start: start,
+				End:   start.Add(-time.Hour),
+			}),
+			expectedErr: uptime.ErrInvalidMaintenanceWindow,
+		},
+		{
+			name: "too long window",
+			bytes: marshalMaintenanceAnnouncement(uptime.MaintenanceWindow{
+				Start: start,
+				End:   start.Add(uptime.MaxMaintenanceWindowDuration + time.Second),
+			}),
+			expectedErr: uptime.ErrMaintenanceWindowTooLong,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			window, err := parseMaintenanceAnnouncement(test.bytes)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.True(test.expectedWindow.Start.Equal(window.Start))
+				require.True(test.expectedWindow.End.Equal(window.End))
+			}
+		})
+	}
+}
+
+func TestMaintenanceHandlerAppGossip(t *testing.T) {
+	require := require.New(t)
+
+	clk := &mockable.Clock{}
+	now := time.Unix(1_000_000, 0)
+	clk.Set(now)
+
+	tracker := uptime.NewManager(uptime.NewTestState(), clk)
+	handler := &maintenanceHandler{
+		log:     logging.NoLog{},
+		tracker: tracker,
+	}
+
+	nodeID := ids.GenerateTestNodeID()
+	window := uptime.MaintenanceWindow{
+		Start: now.Add(time.Minute),
+		End:   now.Add(time.Hour),
+	}
+
+	// Malformed announcements are dropped
+	handler.AppGossip(context.Background(), nodeID, []byte{0})
+	_, ok := tracker.GetMaintenance(nodeID)
+	require.False(ok)
+
+	handler.AppGossip(context.Background(), nodeID, marshalMaintenanceAnnouncement(window))
+	recordedWindow, ok := tracker.GetMaintenance(nodeID)
+	require.True(ok)
+	require.True(window.Start.Equal(recordedWindow.Start))
+	require.True(window.End.Equal(recordedWindow.End))
+}
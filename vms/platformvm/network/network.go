@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/network/p2p/acp118"
 	"github.com/ava-labs/avalanchego/network/p2p/gossip"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
@@ -37,6 +38,10 @@ type Network struct {
 	txPushGossipFrequency time.Duration
 	txPullGossiper        gossip.Gossiper
 	txPullGossipFrequency time.Duration
+
+	maintenanceClient          *p2p.Client
+	maintenanceWindow          uptime.MaintenanceWindow
+	maintenanceGossipFrequency time.Duration
 }
 
 func New(
@@ -49,8 +54,10 @@ func New(
 	partialSyncPrimaryNetwork bool,
 	appSender common.AppSender,
 	stateLock sync.Locker,
-	state state.Chain,
+	state state.State,
 	signer warp.Signer,
+	maintenanceTracker uptime.MaintenanceTracker,
+	maintenanceWindow uptime.MaintenanceWindow,
 	registerer prometheus.Registerer,
 	config config.Network,
 ) (*Network, error) {
@@ -162,8 +169,9 @@ func New(
 
 	// We allow all peers to request warp messaging signatures
 	signatureRequestVerifier := signatureRequestVerifier{
-		stateLock: stateLock,
-		state:     state,
+		stateLock:      stateLock,
+		state:          state,
+		validatorState: vdrs,
 	}
 	signatureRequestHandler := acp118.NewHandler(signatureRequestVerifier, signer)
 
@@ -171,16 +179,32 @@ func New(
 		return nil, err
 	}
 
+	// Maintenance windows are only recorded for validators
+	maintenanceHandler := p2p.NewValidatorHandler(
+		&maintenanceHandler{
+			log:     log,
+			tracker: maintenanceTracker,
+		},
+		validators,
+		log,
+	)
+	if err := p2pNetwork.AddHandler(p2p.MaintenanceHandlerID, maintenanceHandler); err != nil {
+		return nil, err
+	}
+
 	return &Network{
-		Network:                   p2pNetwork,
-		log:                       log,
-		mempool:                   gossipMempool,
-		partialSyncPrimaryNetwork: partialSyncPrimaryNetwork,
-		appSender:                 appSender,
-		txPushGossiper:            txPushGossiper,
-		txPushGossipFrequency:     config.PushGossipFrequency,
-		txPullGossiper:            txPullGossiper,
-		txPullGossipFrequency:     config.PullGossipFrequency,
+		Network:                    p2pNetwork,
+		log:                        log,
+		mempool:                    gossipMempool,
+		partialSyncPrimaryNetwork:  partialSyncPrimaryNetwork,
+		appSender:                  appSender,
+		txPushGossiper:             txPushGossiper,
+		txPushGossipFrequency:      config.PushGossipFrequency,
+		txPullGossiper:             txPullGossiper,
+		txPullGossipFrequency:      config.PullGossipFrequency,
+		maintenanceClient:          p2pNetwork.NewClient(p2p.MaintenanceHandlerID),
+		maintenanceWindow:          maintenanceWindow,
+		maintenanceGossipFrequency: config.MaintenanceGossipFrequency,
 	}, nil
 }
 
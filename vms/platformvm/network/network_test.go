@@ -17,9 +17,11 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/commonmock"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/uptime"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
 
 	pmempool "github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
@@ -45,6 +47,7 @@ var (
 		ExpectedBloomFilterElements:                 10,
 		ExpectedBloomFilterFalsePositiveProbability: .1,
 		MaxBloomFilterFalsePositiveProbability:      .5,
+		MaintenanceGossipFrequency:                  time.Second,
 	}
 )
 
@@ -126,11 +129,11 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 			},
 			tx: func() *txs.Tx {
 				tx := &txs.Tx{Unsigned: &txs.BaseTx{}}
-				bytes := make([]byte, mempool.MaxTxSize+1)
+				bytes := make([]byte, limits.Default.MaxTxSize+1)
 				tx.SetBytes(bytes, bytes)
 				return tx
 			}(),
-			expectedErr: mempool.ErrTxTooLarge,
+			expectedErr: limits.ErrTxTooLarge,
 		},
 		{
 			name: "tx conflicts",
@@ -182,7 +185,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 
 				for i := 0; i < 1024; i++ {
 					tx := &txs.Tx{Unsigned: &txs.BaseTx{}}
-					bytes := make([]byte, mempool.MaxTxSize)
+					bytes := make([]byte, limits.Default.MaxTxSize)
 					tx.SetBytes(bytes, bytes)
 					tx.TxID = ids.GenerateTestID()
 					require.NoError(t, m.Add(tx))
@@ -236,6 +239,8 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				uptime.MaintenanceWindow{},
 				prometheus.NewRegistry(),
 				testConfig,
 			)
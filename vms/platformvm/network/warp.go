@@ -16,6 +16,8 @@ import (
 	"github.com/ava-labs/avalanchego/network/p2p/acp118"
 	"github.com/ava-labs/avalanchego/proto/pb/platformvm"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
@@ -43,13 +45,18 @@ const (
 	ErrImpossibleNonce
 	ErrWrongNonce
 	ErrWrongWeight
+
+	ErrUnfinalizedHeight
+	ErrMismatchedBlockID
+	ErrMismatchedValidatorsHash
 )
 
 var _ acp118.Verifier = (*signatureRequestVerifier)(nil)
 
 type signatureRequestVerifier struct {
-	stateLock sync.Locker
-	state     state.Chain
+	stateLock      sync.Locker
+	state          state.State
+	validatorState validators.State
 }
 
 func (s signatureRequestVerifier) Verify(
@@ -86,6 +93,8 @@ func (s signatureRequestVerifier) Verify(
 		return s.verifyL1ValidatorRegistration(payload, justification)
 	case *message.L1ValidatorWeight:
 		return s.verifyL1ValidatorWeight(payload)
+	case *message.ValidatorSet:
+		return s.verifyValidatorSet(payload)
 	default:
 		return &common.AppError{
 			Code:    ErrUnsupportedWarpAddressedCallPayloadType,
@@ -354,3 +363,57 @@ func (s signatureRequestVerifier) verifyL1ValidatorWeight(
 		return nil // The nonce and weight are correct
 	}
 }
+
+func (s signatureRequestVerifier) verifyValidatorSet(
+	msg *message.ValidatorSet,
+) *common.AppError {
+	// The state lock must be released prior to fetching the validator set, as
+	// the validator state grabs the lock itself.
+	s.stateLock.Lock()
+	blockID, err := s.state.GetBlockIDAtHeight(msg.Height)
+	s.stateLock.Unlock()
+	switch {
+	case err == database.ErrNotFound:
+		return &common.AppError{
+			Code:    ErrUnfinalizedHeight,
+			Message: fmt.Sprintf("height %d has not been accepted", msg.Height),
+		}
+	case err != nil:
+		return &common.AppError{
+			Code:    common.ErrUndefined.Code,
+			Message: "failed to get block ID: " + err.Error(),
+		}
+	case msg.BlockID != blockID:
+		return &common.AppError{
+			Code:    ErrMismatchedBlockID,
+			Message: fmt.Sprintf("provided blockID %s != expected blockID %s", msg.BlockID, blockID),
+		}
+	}
+
+	vdrs, err := warp.GetCanonicalValidatorSetFromSubnetID(
+		context.TODO(),
+		s.validatorState,
+		msg.Height,
+		constants.PrimaryNetworkID,
+	)
+	if err != nil {
+		return &common.AppError{
+			Code:    common.ErrUndefined.Code,
+			Message: "failed to get validator set: " + err.Error(),
+		}
+	}
+	validatorsHash, err := message.HashValidatorSet(vdrs)
+	if err != nil {
+		return &common.AppError{
+			Code:    common.ErrUndefined.Code,
+			Message: "failed to hash validator set: " + err.Error(),
+		}
+	}
+	if msg.ValidatorsHash != validatorsHash {
+		return &common.AppError{
+			Code:    ErrMismatchedValidatorsHash,
+			Message: fmt.Sprintf("provided validatorsHash %s != expected validatorsHash %s", msg.ValidatorsHash, validatorsHash),
+		}
+	}
+	return nil // The block and validator set are correct
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/proto/pb/platformvm"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/snow/validators/validatorstest"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
@@ -640,6 +642,99 @@ func TestSignatureRequestVerifyL1ValidatorWeight(t *testing.T) {
 	}
 }
 
+func TestSignatureRequestVerifyValidatorSet(t *testing.T) {
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+
+	var (
+		nodeID = ids.GenerateTestNodeID()
+		vdrSet = map[ids.NodeID]*validators.GetValidatorOutput{
+			nodeID: {
+				NodeID:    nodeID,
+				PublicKey: sk.PublicKey(),
+				Weight:    1,
+			},
+		}
+
+		state = statetest.New(t, statetest.Config{})
+		s     = signatureRequestVerifier{
+			stateLock: &sync.Mutex{},
+			state:     state,
+			validatorState: &validatorstest.State{
+				GetValidatorSetF: func(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+					return vdrSet, nil
+				},
+			},
+		}
+	)
+
+	blockID, err := state.GetBlockIDAtHeight(0)
+	require.NoError(t, err)
+
+	vdrs, err := warp.FlattenValidatorSet(vdrSet)
+	require.NoError(t, err)
+	validatorsHash, err := message.HashValidatorSet(vdrs)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		height         uint64
+		blockID        ids.ID
+		validatorsHash ids.ID
+		expectedErr    *common.AppError
+	}{
+		{
+			name:   "unfinalized height",
+			height: 1,
+			expectedErr: &common.AppError{
+				Code:    ErrUnfinalizedHeight,
+				Message: "height 1 has not been accepted",
+			},
+		},
+		{
+			name: "wrong blockID",
+			expectedErr: &common.AppError{
+				Code:    ErrMismatchedBlockID,
+				Message: "provided blockID 11111111111111111111111111111111LpoYY != expected blockID " + blockID.String(),
+			},
+		},
+		{
+			name:    "wrong validators hash",
+			blockID: blockID,
+			expectedErr: &common.AppError{
+				Code:    ErrMismatchedValidatorsHash,
+				Message: "provided validatorsHash 11111111111111111111111111111111LpoYY != expected validatorsHash " + validatorsHash.String(),
+			},
+		},
+		{
+			name:           "valid",
+			blockID:        blockID,
+			validatorsHash: validatorsHash,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := s.Verify(
+				context.Background(),
+				must[*warp.UnsignedMessage](t)(warp.NewUnsignedMessage(
+					constants.UnitTestID,
+					constants.PlatformChainID,
+					must[*payload.AddressedCall](t)(payload.NewAddressedCall(
+						nil,
+						must[*message.ValidatorSet](t)(message.NewValidatorSet(
+							test.height,
+							test.blockID,
+							test.validatorsHash,
+						)).Bytes(),
+					)).Bytes(),
+				)),
+				nil,
+			)
+			require.Equal(t, test.expectedErr, err)
+		})
+	}
+}
+
 func must[T any](t require.TestingT) func(T, error) T {
 	return func(val T, err error) T {
 		require.NoError(t, err)
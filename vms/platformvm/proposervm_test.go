@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"crypto"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/snow/validators/validatorstest"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/proposervm"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+)
+
+// TestProposerVMUtilization verifies that the proposervm spaces out P-chain
+// blocks based on the share of the gas capacity that the P-chain reports its
+// accepted blocks consumed.
+func TestProposerVMUtilization(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		minBlkDelay = time.Second
+		maxBlkDelay = 5 * time.Second
+	)
+
+	latestForkTime = genesistest.DefaultValidatorStartTime.Add(time.Second)
+	vm := &VM{Internal: config.Internal{
+		Chains:                 chains.TestManager,
+		UptimeLockedCalculator: uptime.NewLockedCalculator(),
+		SybilProtectionEnabled: true,
+		Validators:             validators.NewManager(),
+		DynamicFeeConfig:       defaultDynamicFeeConfig,
+		ValidatorFeeConfig:     defaultValidatorFeeConfig,
+		MinValidatorStake:      defaultMinValidatorStake,
+		MaxValidatorStake:      defaultMaxValidatorStake,
+		MinDelegatorStake:      defaultMinDelegatorStake,
+		MinStakeDuration:       defaultMinStakingDuration,
+		MaxStakeDuration:       defaultMaxStakingDuration,
+		RewardConfig:           defaultRewardConfig,
+		UpgradeConfig:          upgradetest.GetConfigWithUpgradeTime(upgradetest.Latest, latestForkTime),
+	}}
+	vm.clock.Set(latestForkTime)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	stakingCert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(err)
+
+	registry := prometheus.NewRegistry()
+	proVM := proposervm.New(
+		vm,
+		proposervm.Config{
+			Upgrades:            upgradetest.GetConfig(upgradetest.Latest),
+			MinBlkDelay:         minBlkDelay,
+			MaxBlkDelay:         maxBlkDelay,
+			NumHistoricalBlocks: proposervm.DefaultNumHistoricalBlocks,
+			StakingLeafSigner:   tlsCert.PrivateKey.(crypto.Signer),
+			StakingCertLeaf:     stakingCert,
+			Registerer:          registry,
+		},
+	)
+	proVM.Set(latestForkTime)
+
+	ctx := snowtest.Context(t, snowtest.PChainID)
+	ctx.SharedMemory = atomic.NewMemory(memdb.New()).NewSharedMemory(ctx.ChainID)
+	// Without any validators, anyone can propose blocks at any time.
+	ctx.ValidatorState = &validatorstest.State{
+		GetMinimumHeightF: func(context.Context) (uint64, error) {
+			return 0, nil
+		},
+		GetCurrentHeightF: func(context.Context) (uint64, error) {
+			return 0, nil
+		},
+		GetValidatorSetF: func(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+			return nil, nil
+		},
+	}
+
+	appSender := &enginetest.Sender{
+		SendAppGossipF: func(context.Context, common.SendConfig, []byte) error {
+			return nil
+		},
+	}
+
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	require.NoError(proVM.Initialize(
+		context.Background(),
+		ctx,
+		memdb.New(),
+		genesistest.NewBytes(t, genesistest.Config{}),
+		nil,
+		nil,
+		make(chan common.Message, 1),
+		nil,
+		appSender,
+	))
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+	require.NoError(proVM.SetState(context.Background(), snow.NormalOp))
+
+	lastAcceptedID, err := proVM.LastAccepted(context.Background())
+	require.NoError(err)
+	require.NoError(proVM.SetPreference(context.Background(), lastAcceptedID))
+
+	wallet := newWallet(t, vm, walletConfig{})
+	tx, err := wallet.IssueCreateSubnetTx(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{genesistest.DefaultFundedKeys[0].Address()},
+		},
+	)
+	require.NoError(err)
+
+	ctx.Lock.Unlock()
+	require.NoError(vm.issueTxFromRPC(tx))
+	ctx.Lock.Lock()
+
+	blk, err := proVM.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+	require.NoError(proVM.SetPreference(context.Background(), blk.ID()))
+
+	// The block consumed the gas of the tx out of the capacity that was
+	// available before the tx was executed.
+	complexity, err := txfee.TxComplexity(tx.Unsigned)
+	require.NoError(err)
+	gasConsumed, err := complexity.ToGas(vm.Internal.DynamicFeeWeights(vm.state.GetTimestamp()))
+	require.NoError(err)
+	capacity := vm.state.GetFeeState().Capacity + gasConsumed
+	expectedUtilization := float64(gasConsumed) / float64(capacity)
+	require.Positive(expectedUtilization)
+	require.Less(expectedUtilization, 1.)
+
+	expectedDelay := maxBlkDelay - time.Duration(float64(maxBlkDelay-minBlkDelay)*expectedUtilization)
+	require.Equal(expectedUtilization, gaugeValue(t, registry, "block_utilization"))
+	require.Equal(expectedDelay.Seconds(), gaugeValue(t, registry, "min_block_delay"))
+}
+
+func gaugeValue(t *testing.T, gatherer prometheus.Gatherer, name string) float64 {
+	metricFamilies, err := gatherer.Gather()
+	require.NoError(t, err)
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() == name {
+			return metricFamily.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	require.FailNow(t, "missing gauge", name)
+	return 0
+}
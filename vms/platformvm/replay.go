@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+
+	platformvmmetrics "github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+)
+
+var (
+	_ common.AppSender = noAppSender{}
+
+	vmDBPrefix     = []byte("vm")
+	atomicDBPrefix = []byte("atomic")
+
+	ErrStateRootMismatch    = errors.New("state root mismatch")
+	ErrUTXOChecksumMismatch = errors.New("UTXO checksum mismatch")
+
+	errInvalidReplayRange = errors.New("invalid replay range")
+)
+
+// ReplayConfig describes the blocks to replay and the VM they are replayed
+// through.
+type ReplayConfig struct {
+	// Internal is the config of the VM the blocks are replayed through. The
+	// chains, validators and uptimes of the VM are replaced so that they are
+	// not shared with a running node.
+	Internal config.Internal
+	// Ctx is the context of the VM the blocks are replayed through. Its shared
+	// memory is replaced with an in-memory one.
+	Ctx          *snow.Context
+	GenesisBytes []byte
+	// ConfigBytes is the chain config of the P-chain.
+	ConfigBytes []byte
+
+	// Source is the database of the P-chain whose accepted blocks are
+	// replayed. It is never written to.
+	Source database.Database
+	// StartHeight is the height of the first block whose replay is reported.
+	// All the blocks before it are still replayed to rebuild the state.
+	StartHeight uint64
+	// EndHeight is the height of the last block to replay. If 0, the blocks
+	// are replayed up to the last accepted block of [Source].
+	EndHeight uint64
+}
+
+// ReplayedBlock reports the replay of a single block.
+type ReplayedBlock struct {
+	Height uint64        `json:"height"`
+	ID     ids.ID        `json:"id"`
+	Verify time.Duration `json:"verify"`
+	Accept time.Duration `json:"accept"`
+}
+
+// ReplayResult reports the replay of a range of blocks.
+type ReplayResult struct {
+	Blocks []ReplayedBlock `json:"blocks"`
+	// Compared is true if the replayed state was compared against the state
+	// of [ReplayConfig.Source]. This is only possible if the blocks were
+	// replayed up to the last accepted block.
+	Compared     bool   `json:"compared"`
+	StateRoot    ids.ID `json:"stateRoot"`
+	UTXOChecksum ids.ID `json:"utxoChecksum"`
+}
+
+// Replay re-executes the accepted blocks of [ReplayConfig.Source] through a
+// fresh, in-memory, VM starting from genesis.
+//
+// Blocks are verified and accepted as they would be while bootstrapping. The
+// parent state roots included in Fortuna blocks are verified against the
+// replayed state, so a divergence is reported at the first Fortuna block that
+// follows it. If the blocks are replayed up to the last accepted block, the
+// state root and UTXO set of the replayed state are also compared against
+// the state of [ReplayConfig.Source].
+func Replay(ctx context.Context, c ReplayConfig) (*ReplayResult, error) {
+	execConfig, err := config.GetConfig(c.ConfigBytes)
+	if err != nil {
+		return nil, err
+	}
+	// The UTXO sets are compared by their checksums. Reward reports are
+	// disabled as they would be written to disk.
+	execConfig.ChecksumsEnabled = true
+	execConfig.RewardReportEpochDuration = 0
+	configBytes, err := json.Marshal(execConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingConfig, err := state.NewStakingConfig(c.GenesisBytes, &c.Internal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the staking config: %w", err)
+	}
+
+	source, err := state.New(
+		versiondb.New(c.Source),
+		c.GenesisBytes,
+		prometheus.NewRegistry(),
+		validators.NewManager(),
+		c.Internal.UpgradeConfig,
+		stakingConfig,
+		execConfig,
+		c.Ctx,
+		platformvmmetrics.Noop,
+		reward.NewCalculator(c.Internal.RewardConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open source state: %w", err)
+	}
+	defer source.Close()
+
+	lastAccepted, err := source.GetStatelessBlock(source.GetLastAccepted())
+	if err != nil {
+		return nil, err
+	}
+	lastAcceptedHeight := lastAccepted.Height()
+
+	endHeight := c.EndHeight
+	if endHeight == 0 {
+		endHeight = lastAcceptedHeight
+	}
+	startHeight := max(c.StartHeight, 1)
+	if startHeight > endHeight || endHeight > lastAcceptedHeight {
+		return nil, fmt.Errorf("%w: [%d, %d] with last accepted height %d",
+			errInvalidReplayRange,
+			startHeight,
+			endHeight,
+			lastAcceptedHeight,
+		)
+	}
+
+	vm := &VM{Internal: c.Internal}
+	vm.Chains = chains.TestManager
+	vm.Validators = validators.NewManager()
+	vm.UptimeLockedCalculator = uptime.NewLockedCalculator()
+
+	// Shared memory writes the VM's batches to its own database, so both must
+	// be on the same database.
+	db := memdb.New()
+	c.Ctx.SharedMemory = atomic.NewMemory(prefixdb.New(atomicDBPrefix, db)).NewSharedMemory(c.Ctx.ChainID)
+
+	c.Ctx.Lock.Lock()
+	defer c.Ctx.Lock.Unlock()
+
+	err = vm.Initialize(
+		ctx,
+		c.Ctx,
+		prefixdb.New(vmDBPrefix, db),
+		c.GenesisBytes,
+		nil,
+		configBytes,
+		make(chan common.Message, 1),
+		nil,
+		noAppSender{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize VM: %w", err)
+	}
+	defer func() {
+		if err := vm.Shutdown(ctx); err != nil {
+			c.Ctx.Log.Warn("failed to shutdown VM",
+				zap.Error(err),
+			)
+		}
+	}()
+	if err := vm.SetState(ctx, snow.Bootstrapping); err != nil {
+		return nil, err
+	}
+
+	result := &ReplayResult{
+		Blocks: make([]ReplayedBlock, 0, endHeight-startHeight+1),
+	}
+	for height := uint64(1); height <= endHeight; height++ {
+		blkID, err := source.GetBlockIDAtHeight(height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block ID at height %d: %w", height, err)
+		}
+		statelessBlk, err := source.GetStatelessBlock(blkID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get block %s: %w", blkID, err)
+		}
+
+		start := time.Now()
+		blk, err := vm.ParseBlock(ctx, statelessBlk.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse block %s: %w", blkID, err)
+		}
+		if err := blk.Verify(ctx); err != nil {
+			return nil, fmt.Errorf("failed to verify block %s at height %d: %w", blkID, height, err)
+		}
+		verified := time.Now()
+		if err := blk.Accept(ctx); err != nil {
+			return nil, fmt.Errorf("failed to accept block %s at height %d: %w", blkID, height, err)
+		}
+		accepted := time.Now()
+
+		if height < startHeight {
+			continue
+		}
+		replayed := ReplayedBlock{
+			Height: height,
+			ID:     blkID,
+			Verify: verified.Sub(start),
+			Accept: accepted.Sub(verified),
+		}
+		result.Blocks = append(result.Blocks, replayed)
+		c.Ctx.Log.Info("replayed block",
+			zap.Uint64("height", height),
+			zap.Stringer("blkID", blkID),
+			zap.Duration("verify", replayed.Verify),
+			zap.Duration("accept", replayed.Accept),
+		)
+	}
+
+	result.StateRoot, err = state.GetStateRoot(ctx, vm.state)
+	if err != nil {
+		return nil, err
+	}
+	result.UTXOChecksum = vm.state.Checksum()
+	if endHeight != lastAcceptedHeight {
+		return result, nil
+	}
+
+	result.Compared = true
+	sourceStateRoot, err := state.GetStateRoot(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if result.StateRoot != sourceStateRoot {
+		return result, fmt.Errorf("%w: replayed %s but expected %s",
+			ErrStateRootMismatch,
+			result.StateRoot,
+			sourceStateRoot,
+		)
+	}
+	if sourceChecksum := source.Checksum(); result.UTXOChecksum != sourceChecksum {
+		return result, fmt.Errorf("%w: replayed %s but expected %s",
+			ErrUTXOChecksumMismatch,
+			result.UTXOChecksum,
+			sourceChecksum,
+		)
+	}
+	return result, nil
+}
+
+// noAppSender drops all the messages sent by the replayed VM, which isn't
+// connected to the network.
+type noAppSender struct{}
+
+func (noAppSender) SendAppRequest(context.Context, set.Set[ids.NodeID], uint32, []byte) error {
+	return nil
+}
+
+func (noAppSender) SendAppResponse(context.Context, ids.NodeID, uint32, []byte) error {
+	return nil
+}
+
+func (noAppSender) SendAppError(context.Context, ids.NodeID, uint32, int32, string) error {
+	return nil
+}
+
+func (noAppSender) SendAppGossip(context.Context, common.SendConfig, []byte) error {
+	return nil
+}
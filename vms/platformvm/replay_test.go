@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+)
+
+func TestReplay(t *testing.T) {
+	require := require.New(t)
+	vm, baseDB, _ := defaultVM(t, upgradetest.Latest)
+	vm.ctx.Lock.Lock()
+
+	// defaultVM sets the fee state outside of a block, which isn't replayed.
+	// Advancing time makes sure the replayed fee state has enough capacity.
+	vm.clock.Set(vm.clock.Time().Add(time.Minute))
+
+	// Accept a few more blocks, including a block that removes a staker.
+	wallet := newWallet(t, vm, walletConfig{
+		subnetIDs: []ids.ID{testSubnet1.ID()},
+	})
+	startTime := vm.clock.Time().Add(txexecutor.SyncBound).Add(time.Second)
+	endTime := startTime.Add(defaultMinStakingDuration)
+	addValidatorTx, err := wallet.IssueAddSubnetValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: genesistest.DefaultNodeIDs[0],
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   genesistest.DefaultValidatorWeight,
+			},
+			Subnet: testSubnet1.ID(),
+		},
+	)
+	require.NoError(err)
+
+	vm.ctx.Lock.Unlock()
+	require.NoError(vm.issueTxFromRPC(addValidatorTx))
+	vm.ctx.Lock.Lock()
+	require.NoError(buildAndAcceptStandardBlock(vm))
+
+	vm.clock.Set(endTime)
+	require.NoError(buildAndAcceptStandardBlock(vm))
+
+	lastAccepted, err := vm.state.GetStatelessBlock(vm.state.GetLastAccepted())
+	require.NoError(err)
+	require.Equal(uint64(3), lastAccepted.Height())
+	stateRoot, err := state.GetStateRoot(context.Background(), vm.state)
+	require.NoError(err)
+	vm.ctx.Lock.Unlock()
+
+	newConfig := func(startHeight, endHeight uint64) ReplayConfig {
+		return ReplayConfig{
+			Internal:     vm.Internal,
+			Ctx:          snowtest.Context(t, snowtest.PChainID),
+			GenesisBytes: genesistest.NewBytes(t, genesistest.Config{}),
+			Source:       prefixdb.New([]byte{0}, baseDB),
+			StartHeight:  startHeight,
+			EndHeight:    endHeight,
+		}
+	}
+
+	result, err := Replay(context.Background(), newConfig(0, 0))
+	require.NoError(err)
+	require.Len(result.Blocks, 3)
+	for i, blk := range result.Blocks {
+		require.Equal(uint64(i+1), blk.Height)
+		expectedID, err := vm.GetBlockIDAtHeight(context.Background(), blk.Height)
+		require.NoError(err)
+		require.Equal(expectedID, blk.ID)
+	}
+	require.True(result.Compared)
+	require.Equal(stateRoot, result.StateRoot)
+
+	// Replaying a prefix of the chain can't be compared against the source.
+	result, err = Replay(context.Background(), newConfig(2, 2))
+	require.NoError(err)
+	require.Len(result.Blocks, 1)
+	require.Equal(uint64(2), result.Blocks[0].Height)
+	require.False(result.Compared)
+
+	_, err = Replay(context.Background(), newConfig(0, 4))
+	require.ErrorIs(err, errInvalidReplayRange)
+
+	_, err = Replay(context.Background(), newConfig(3, 2))
+	require.ErrorIs(err, errInvalidReplayRange)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package report
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
+	"github.com/ava-labs/avalanchego/vms/types"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+var errInvalidSignature = errors.New("invalid signature")
+
+// Report lists the staking rewards distributed on the P-chain during an epoch.
+type Report struct {
+	NetworkID uint32 `json:"networkID"`
+	ChainID   ids.ID `json:"chainID"`
+	// Unix time the epoch starts at, inclusive
+	StartTime avajson.Uint64 `json:"startTime"`
+	// Unix time the epoch ends at, exclusive
+	EndTime avajson.Uint64 `json:"endTime"`
+	Rewards []Reward       `json:"rewards"`
+}
+
+// Reward is the reward distributed to a validator or delegator when it stopped
+// staking.
+type Reward struct {
+	TxID        ids.ID     `json:"txID"`
+	NodeID      ids.NodeID `json:"nodeID"`
+	SubnetID    ids.ID     `json:"subnetID"`
+	IsValidator bool       `json:"isValidator"`
+	// Sum of the amounts of the reward outputs
+	Amount  avajson.Uint64 `json:"amount"`
+	Outputs []Output       `json:"outputs"`
+}
+
+// Output is a reward UTXO.
+type Output struct {
+	UTXOID    ids.ID         `json:"utxoID"`
+	AssetID   ids.ID         `json:"assetID"`
+	Amount    avajson.Uint64 `json:"amount"`
+	Addresses []string       `json:"addresses"`
+}
+
+// SignedReport is a report signed with the BLS key of the node that generated
+// it.
+//
+// The signature is over a warp message, from the report's chain, whose payload
+// is the hash of the bytes of the report.
+type SignedReport struct {
+	Report    json.RawMessage     `json:"report"`
+	NodeID    ids.NodeID          `json:"nodeID"`
+	PublicKey types.JSONByteSlice `json:"publicKey"`
+	Signature types.JSONByteSlice `json:"signature"`
+}
+
+// Verify returns the report if it is signed by [r.PublicKey].
+//
+// The caller is responsible for verifying that [r.PublicKey] is the key of the
+// expected node.
+func (r *SignedReport) Verify() (*Report, error) {
+	var report Report
+	if err := json.Unmarshal(r.Report, &report); err != nil {
+		return nil, err
+	}
+
+	msg, err := newUnsignedMessage(report.NetworkID, report.ChainID, r.Report)
+	if err != nil {
+		return nil, err
+	}
+	pk, err := bls.PublicKeyFromCompressedBytes(r.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(r.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if !bls.Verify(pk, sig, msg.Bytes()) {
+		return nil, errInvalidSignature
+	}
+	return &report, nil
+}
+
+func newUnsignedMessage(networkID uint32, chainID ids.ID, reportBytes []byte) (*warp.UnsignedMessage, error) {
+	hash, err := payload.NewHash(hashing.ComputeHash256Array(reportBytes))
+	if err != nil {
+		return nil, err
+	}
+	return warp.NewUnsignedMessage(networkID, chainID, hash.Bytes())
+}
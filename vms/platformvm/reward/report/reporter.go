@@ -0,0 +1,343 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var (
+	_ Reporter = (*reporter)(nil)
+	_ Reporter = (*noReporter)(nil)
+
+	ErrReportsDisabled = errors.New("reward reports are disabled")
+	ErrReportNotFound  = errors.New("reward report not found")
+
+	errEpochTooShort = errors.New("epoch duration must be at least a second")
+
+	reportPrefix = []byte("report")
+	nextStartKey = []byte("nextStart")
+)
+
+// State is the chain state that reward reports are generated from.
+type State interface {
+	GetTimestamp() time.Time
+	GetStakerTx(stakerID ids.ID) (txs.Staker, error)
+	GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error)
+	GetRewardedStakers(start, end time.Time) ([]ids.ID, error)
+}
+
+// Reporter generates a signed report of the staking rewards distributed during
+// each epoch.
+//
+// Epochs are aligned to multiples of the epoch duration since the Unix epoch,
+// so daily epochs start at midnight UTC. The first report starts at the chain
+// time the reporter was first created at.
+type Reporter interface {
+	// Accept generates the reports of the epochs that ended by the current
+	// chain time.
+	//
+	// Invariant: The last accepted block must have already been committed to
+	// the state the reporter was created with.
+	Accept() error
+
+	// GetReport returns the report of the epoch that includes [t].
+	//
+	// Returns [ErrReportNotFound] if the report hasn't been generated.
+	GetReport(t time.Time) (*SignedReport, error)
+
+	// GetLastReport returns the most recently generated report.
+	//
+	// Returns [ErrReportNotFound] if no report has been generated.
+	GetLastReport() (*SignedReport, error)
+}
+
+type reporter struct {
+	ctx           *snow.Context
+	addrManager   avax.AddressManager
+	state         State
+	db            database.Database
+	reportDB      database.Database
+	dir           string
+	epochDuration time.Duration
+
+	// Start of the next epoch to report on
+	nextStart time.Time
+}
+
+// NewReporter returns a reporter that generates the reports of [chainState]
+// and signs them with the warp signer of [ctx].
+//
+// The reports are stored in [db]. If [dir] is non-empty, each report is also
+// written to a file in [dir].
+func NewReporter(
+	ctx *snow.Context,
+	chainState State,
+	db database.Database,
+	dir string,
+	epochDuration time.Duration,
+) (Reporter, error) {
+	if epochDuration < time.Second {
+		return nil, fmt.Errorf("%w: %s", errEpochTooShort, epochDuration)
+	}
+
+	nextStart, err := database.GetTimestamp(db, nextStartKey)
+	if err == database.ErrNotFound {
+		nextStart = chainState.GetTimestamp()
+		err = database.PutTimestamp(db, nextStartKey, nextStart)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if dir != "" {
+		if err := os.MkdirAll(dir, perms.ReadWriteExecute); err != nil {
+			return nil, fmt.Errorf("failed to create reward report directory: %w", err)
+		}
+	}
+
+	return &reporter{
+		ctx:           ctx,
+		addrManager:   avax.NewAddressManager(ctx),
+		state:         chainState,
+		db:            db,
+		reportDB:      prefixdb.New(reportPrefix, db),
+		dir:           dir,
+		epochDuration: epochDuration,
+		nextStart:     nextStart,
+	}, nil
+}
+
+func (r *reporter) Accept() error {
+	now := r.state.GetTimestamp()
+	for {
+		end := r.epochEnd(r.nextStart)
+		if now.Before(end) {
+			return nil
+		}
+		if err := r.writeReport(r.nextStart, end); err != nil {
+			return fmt.Errorf("failed to write reward report ending at %s: %w", end, err)
+		}
+		r.nextStart = end
+	}
+}
+
+func (r *reporter) GetReport(t time.Time) (*SignedReport, error) {
+	// Reports are keyed by their end time, so the first report that ends after
+	// [t] is the only one that may include [t].
+	it := r.reportDB.NewIteratorWithStart(database.PackUInt64(uint64(t.Unix()) + 1))
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: at %s", ErrReportNotFound, t)
+	}
+
+	signedReport, err := parseSignedReport(it.Value())
+	if err != nil {
+		return nil, err
+	}
+	var report Report
+	if err := json.Unmarshal(signedReport.Report, &report); err != nil {
+		return nil, err
+	}
+	if uint64(report.StartTime) > uint64(t.Unix()) {
+		return nil, fmt.Errorf("%w: at %s", ErrReportNotFound, t)
+	}
+	return signedReport, nil
+}
+
+func (r *reporter) GetLastReport() (*SignedReport, error) {
+	// The last report ends where the next one starts.
+	signedReportBytes, err := r.reportDB.Get(database.PackUInt64(uint64(r.nextStart.Unix())))
+	if err == database.ErrNotFound {
+		return nil, ErrReportNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseSignedReport(signedReportBytes)
+}
+
+// epochEnd returns the end of the epoch that includes [t].
+func (r *reporter) epochEnd(t time.Time) time.Time {
+	epochSeconds := int64(r.epochDuration / time.Second)
+	epoch := t.Unix() / epochSeconds
+	return time.Unix((epoch+1)*epochSeconds, 0)
+}
+
+// writeReport signs and stores the report of the rewards distributed in
+// [start, end).
+func (r *reporter) writeReport(start, end time.Time) error {
+	report, err := r.newReport(start, end)
+	if err != nil {
+		return err
+	}
+	reportBytes, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	msg, err := newUnsignedMessage(r.ctx.NetworkID, r.ctx.ChainID, reportBytes)
+	if err != nil {
+		return err
+	}
+	sig, err := r.ctx.WarpSigner.Sign(msg)
+	if err != nil {
+		return fmt.Errorf("failed to sign reward report: %w", err)
+	}
+	signedReportBytes, err := json.Marshal(&SignedReport{
+		Report:    reportBytes,
+		NodeID:    r.ctx.NodeID,
+		PublicKey: bls.PublicKeyToCompressedBytes(r.ctx.PublicKey),
+		Signature: sig,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The report is written before the next epoch is marked as the next to
+	// report on. If the node stops in between, the report is generated again,
+	// which produces the same bytes as the signature is deterministic.
+	if r.dir != "" {
+		fileName := fmt.Sprintf("%d-%d.json", start.Unix(), end.Unix())
+		if err := os.WriteFile(filepath.Join(r.dir, fileName), signedReportBytes, perms.ReadWrite); err != nil {
+			return err
+		}
+	}
+	if err := r.reportDB.Put(database.PackUInt64(uint64(end.Unix())), signedReportBytes); err != nil {
+		return err
+	}
+	if err := database.PutTimestamp(r.db, nextStartKey, end); err != nil {
+		return err
+	}
+
+	r.ctx.Log.Info("generated reward report",
+		zap.Time("startTime", start),
+		zap.Time("endTime", end),
+		zap.Int("numRewards", len(report.Rewards)),
+	)
+	return nil
+}
+
+// newReport returns the report of the rewards distributed in [start, end).
+func (r *reporter) newReport(start, end time.Time) (*Report, error) {
+	txIDs, err := r.state.GetRewardedStakers(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		NetworkID: r.ctx.NetworkID,
+		ChainID:   r.ctx.ChainID,
+		StartTime: avajson.Uint64(start.Unix()),
+		EndTime:   avajson.Uint64(end.Unix()),
+		Rewards:   make([]Reward, 0, len(txIDs)),
+	}
+	for _, txID := range txIDs {
+		reward, err := r.newReward(txID)
+		if err != nil {
+			return nil, err
+		}
+		report.Rewards = append(report.Rewards, *reward)
+	}
+	return report, nil
+}
+
+// newReward returns the reward distributed to the staker added by [txID].
+func (r *reporter) newReward(txID ids.ID) (*Reward, error) {
+	staker, err := r.state.GetStakerTx(txID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staker %s: %w", txID, err)
+	}
+	utxos, err := r.state.GetRewardUTXOs(txID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reward UTXOs of %s: %w", txID, err)
+	}
+
+	_, isValidator := staker.(txs.ValidatorTx)
+	reward := &Reward{
+		TxID:        txID,
+		NodeID:      staker.NodeID(),
+		SubnetID:    staker.SubnetID(),
+		IsValidator: isValidator,
+		Outputs:     make([]Output, len(utxos)),
+	}
+	for i, utxo := range utxos {
+		output := Output{
+			UTXOID:    utxo.InputID(),
+			AssetID:   utxo.AssetID(),
+			Addresses: []string{},
+		}
+		if out, ok := utxo.Out.(avax.Amounter); ok {
+			output.Amount = avajson.Uint64(out.Amount())
+		}
+		if out, ok := utxo.Out.(avax.Addressable); ok {
+			for _, addrBytes := range out.Addresses() {
+				addr, err := ids.ToShortID(addrBytes)
+				if err != nil {
+					return nil, err
+				}
+				addrStr, err := r.addrManager.FormatLocalAddress(addr)
+				if err != nil {
+					return nil, err
+				}
+				output.Addresses = append(output.Addresses, addrStr)
+			}
+		}
+
+		amount, err := safemath.Add(uint64(reward.Amount), uint64(output.Amount))
+		if err != nil {
+			return nil, err
+		}
+		reward.Amount = avajson.Uint64(amount)
+		reward.Outputs[i] = output
+	}
+	return reward, nil
+}
+
+func parseSignedReport(b []byte) (*SignedReport, error) {
+	signedReport := &SignedReport{}
+	return signedReport, json.Unmarshal(b, signedReport)
+}
+
+// NewNoReporter returns a reporter that fails all reads. It is used when
+// reward reports are disabled.
+func NewNoReporter() Reporter {
+	return noReporter{}
+}
+
+type noReporter struct{}
+
+func (noReporter) Accept() error {
+	return nil
+}
+
+func (noReporter) GetReport(time.Time) (*SignedReport, error) {
+	return nil, ErrReportsDisabled
+}
+
+func (noReporter) GetLastReport() (*SignedReport, error) {
+	return nil, ErrReportsDisabled
+}
@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package report
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+type rewardedStaker struct {
+	time time.Time
+	txID ids.ID
+}
+
+type testState struct {
+	timestamp   time.Time
+	stakers     map[ids.ID]txs.Staker
+	rewardUTXOs map[ids.ID][]*avax.UTXO
+	rewarded    []rewardedStaker
+}
+
+func (s *testState) GetTimestamp() time.Time {
+	return s.timestamp
+}
+
+func (s *testState) GetStakerTx(txID ids.ID) (txs.Staker, error) {
+	staker, ok := s.stakers[txID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return staker, nil
+}
+
+func (s *testState) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
+	return s.rewardUTXOs[txID], nil
+}
+
+func (s *testState) GetRewardedStakers(start, end time.Time) ([]ids.ID, error) {
+	var txIDs []ids.ID
+	for _, staker := range s.rewarded {
+		if !staker.time.Before(start) && staker.time.Before(end) {
+			txIDs = append(txIDs, staker.txID)
+		}
+	}
+	return txIDs, nil
+}
+
+// reward marks [staker] as rewarded with [amount] sent to [addr] at the current
+// chain time.
+func (s *testState) reward(txID ids.ID, staker txs.Staker, amount uint64, addr ids.ShortID) {
+	s.stakers[txID] = staker
+	s.rewardUTXOs[txID] = []*avax.UTXO{
+		{
+			UTXOID: avax.UTXOID{
+				TxID: txID,
+			},
+			Asset: avax.Asset{ID: snowtest.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		},
+	}
+	s.rewarded = append(s.rewarded, rewardedStaker{
+		time: s.timestamp,
+		txID: txID,
+	})
+}
+
+func TestReporter(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+	ctx := snowtest.Context(t, constants.PlatformChainID)
+	ctx.NodeID = ids.GenerateTestNodeID()
+	ctx.PublicKey = sk.PublicKey()
+	ctx.WarpSigner = warp.NewSigner(sk, ctx.NetworkID, ctx.ChainID)
+
+	var (
+		startTime  = time.Unix(10*3600+1800, 0)
+		chainState = &testState{
+			timestamp:   startTime,
+			stakers:     make(map[ids.ID]txs.Staker),
+			rewardUTXOs: make(map[ids.ID][]*avax.UTXO),
+		}
+		db  = memdb.New()
+		dir = t.TempDir()
+	)
+	reporter, err := NewReporter(ctx, chainState, db, dir, time.Hour)
+	require.NoError(err)
+
+	_, err = reporter.GetLastReport()
+	require.ErrorIs(err, ErrReportNotFound)
+
+	var (
+		nodeID        = ids.GenerateTestNodeID()
+		validatorTxID = ids.GenerateTestID()
+		delegatorTxID = ids.GenerateTestID()
+		rewardAddr    = ids.GenerateTestShortID()
+	)
+	chainState.timestamp = startTime.Add(10 * time.Minute)
+	chainState.reward(
+		validatorTxID,
+		&txs.AddPermissionlessValidatorTx{
+			Validator: txs.Validator{NodeID: nodeID},
+			Subnet:    constants.PrimaryNetworkID,
+		},
+		5,
+		rewardAddr,
+	)
+	require.NoError(reporter.Accept())
+
+	// The first epoch hasn't ended yet.
+	_, err = reporter.GetLastReport()
+	require.ErrorIs(err, ErrReportNotFound)
+
+	// Rewards distributed at the end of an epoch are included in the next one.
+	firstEnd := time.Unix(11*3600, 0)
+	chainState.timestamp = firstEnd
+	chainState.reward(
+		delegatorTxID,
+		&txs.AddPermissionlessDelegatorTx{
+			Validator: txs.Validator{NodeID: nodeID},
+			Subnet:    constants.PrimaryNetworkID,
+		},
+		3,
+		rewardAddr,
+	)
+	require.NoError(reporter.Accept())
+
+	rewardAddrStr, err := avax.NewAddressManager(ctx).FormatLocalAddress(rewardAddr)
+	require.NoError(err)
+	newReward := func(txID ids.ID, isValidator bool, amount uint64) Reward {
+		return Reward{
+			TxID:        txID,
+			NodeID:      nodeID,
+			SubnetID:    constants.PrimaryNetworkID,
+			IsValidator: isValidator,
+			Amount:      avajson.Uint64(amount),
+			Outputs: []Output{
+				{
+					UTXOID:    chainState.rewardUTXOs[txID][0].InputID(),
+					AssetID:   snowtest.AVAXAssetID,
+					Amount:    avajson.Uint64(amount),
+					Addresses: []string{rewardAddrStr},
+				},
+			},
+		}
+	}
+
+	signedReport, err := reporter.GetLastReport()
+	require.NoError(err)
+	require.Equal(ctx.NodeID, signedReport.NodeID)
+	report, err := signedReport.Verify()
+	require.NoError(err)
+	require.Equal(
+		&Report{
+			NetworkID: ctx.NetworkID,
+			ChainID:   ctx.ChainID,
+			StartTime: avajson.Uint64(startTime.Unix()),
+			EndTime:   avajson.Uint64(firstEnd.Unix()),
+			Rewards: []Reward{
+				newReward(validatorTxID, true, 5),
+			},
+		},
+		report,
+	)
+
+	// The report is also written to a file.
+	fileBytes, err := os.ReadFile(filepath.Join(dir, "37800-39600.json"))
+	require.NoError(err)
+	signedReportBytes, err := json.Marshal(signedReport)
+	require.NoError(err)
+	require.JSONEq(string(signedReportBytes), string(fileBytes))
+
+	// A report is generated for every epoch that ended, even if no rewards
+	// were distributed.
+	chainState.timestamp = time.Unix(13*3600+60, 0)
+	require.NoError(reporter.Accept())
+
+	signedReport, err = reporter.GetReport(firstEnd)
+	require.NoError(err)
+	report, err = signedReport.Verify()
+	require.NoError(err)
+	require.Equal([]Reward{newReward(delegatorTxID, false, 3)}, report.Rewards)
+
+	signedReport, err = reporter.GetLastReport()
+	require.NoError(err)
+	report, err = signedReport.Verify()
+	require.NoError(err)
+	require.Equal(avajson.Uint64(12*3600), report.StartTime)
+	require.Equal(avajson.Uint64(13*3600), report.EndTime)
+	require.Empty(report.Rewards)
+
+	_, err = reporter.GetReport(startTime.Add(-time.Second))
+	require.ErrorIs(err, ErrReportNotFound)
+	_, err = reporter.GetReport(chainState.timestamp)
+	require.ErrorIs(err, ErrReportNotFound)
+
+	// Reports that were already generated are kept across restarts.
+	reporter, err = NewReporter(ctx, chainState, db, dir, time.Hour)
+	require.NoError(err)
+	require.NoError(reporter.Accept())
+	restartedReport, err := reporter.GetLastReport()
+	require.NoError(err)
+	require.Equal(signedReport, restartedReport)
+
+	// Modified reports are rejected.
+	restartedReport.Report = []byte(`{"networkID":1}`)
+	_, err = restartedReport.Verify()
+	require.ErrorIs(err, errInvalidSignature)
+}
+
+func TestNewReporterEpochTooShort(t *testing.T) {
+	_, err := NewReporter(
+		snowtest.Context(t, constants.PlatformChainID),
+		&testState{},
+		memdb.New(),
+		"",
+		time.Millisecond,
+	)
+	require.ErrorIs(t, err, errEpochTooShort)
+}
+
+func TestNoReporter(t *testing.T) {
+	require := require.New(t)
+
+	reporter := NewNoReporter()
+	require.NoError(reporter.Accept())
+
+	_, err := reporter.GetReport(time.Now())
+	require.ErrorIs(err, ErrReportsDisabled)
+
+	_, err = reporter.GetLastReport()
+	require.ErrorIs(err, ErrReportsDisabled)
+}
@@ -4,6 +4,7 @@
 package platformvm
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,9 +12,12 @@ import (
 	"maps"
 	"math"
 	"net/http"
+	"reflect"
+	"slices"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/cache"
@@ -23,19 +27,25 @@ import (
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/iterator"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward/report"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/types"
@@ -43,6 +53,8 @@ import (
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 	safemath "github.com/ava-labs/avalanchego/utils/math"
 	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+	vdrcapacity "github.com/ava-labs/avalanchego/vms/platformvm/validators/capacity"
 )
 
 const (
@@ -55,9 +67,24 @@ const (
 	// Max number of items allowed in a page
 	maxPageSize = 1024
 
+	// Max number of heights that can be passed in as argument to
+	// GetValidatorsAtHeights
+	maxGetValidatorsAtHeights = 256
+
+	// Max number of validator set creations that can be reported by
+	// GetValidatorSetQueries
+	maxGetValidatorSetQueries = 1024
+
+	// Max number of blocks that can be reported by GetFeeReport
+	maxFeeReportHeights = 1024
+
 	// Note: Staker attributes cache should be large enough so that no evictions
 	// happen when the API loops through all stakers.
 	stakerAttributesCacheSize = 100_000
+
+	// Orders of the validators returned by GetCurrentValidators
+	currentValidatorsSortByStake   = "stake"
+	currentValidatorsSortByEndTime = "endTime"
 )
 
 var (
@@ -65,6 +92,10 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errInvalidQuorum              = errors.New("invalid quorum")
+	errTooManyHeights             = errors.New("too many heights")
+	errInvalidHeightRange         = errors.New("invalid height range")
+	errUnknownSortBy              = errors.New("unknown sort order")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -81,6 +112,7 @@ type stakerAttributes struct {
 	validationRewardsOwner fx.Owner
 	delegationRewardsOwner fx.Owner
 	proofOfPossession      *signer.ProofOfPossession
+	stake                  []*avax.TransferableOutput
 }
 
 // GetHeight returns the height of the last accepted block
@@ -344,7 +376,7 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	for i, utxo := range utxos {
 		bytes, err := txs.Codec.Marshal(txs.CodecVersion, utxo)
 		if err != nil {
-			return fmt.Errorf("couldn't serialize UTXO %q: %w", utxo.InputID(), err)
+			return fmt.Errorf("couldn't serialize UTXO %s: %w", utxo.InputID(), err)
 		}
 		response.UTXOs[i], err = formatting.Encode(args.Encoding, bytes)
 		if err != nil {
@@ -364,6 +396,102 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	return nil
 }
 
+// GetUTXODiff returns the changes made to the UTXOs controlled by the given
+// addresses after the given height
+func (s *Service) GetUTXODiff(r *http.Request, args *api.GetUTXODiffArgs, response *api.GetUTXODiffReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getUTXODiff"),
+		zap.Uint64("height", uint64(args.Height)),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	lastAcceptedHeight, err := s.vm.GetCurrentHeight(r.Context())
+	if err != nil {
+		return fmt.Errorf("couldn't get the last accepted height: %w", err)
+	}
+
+	startHeight := uint64(args.Height)
+	endHeight := startHeight
+	if startHeight < lastAcceptedHeight {
+		endHeight = min(lastAcceptedHeight, startHeight+avax.MaxUTXODiffHeights)
+	}
+
+	added, removed, err := avax.GetUTXOChanges(s.vm.state, s.vm.state, addrSet, startHeight, endHeight)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXO changes: %w", err)
+	}
+
+	response.Added = make([]string, len(added))
+	for i, utxo := range added {
+		bytes, err := txs.Codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't serialize UTXO %s: %w", utxo.InputID(), err)
+		}
+		response.Added[i], err = formatting.Encode(args.Encoding, bytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as %s: %w", utxo.InputID(), args.Encoding, err)
+		}
+	}
+	response.Removed = removed
+	response.Height = avajson.Uint64(endHeight)
+	response.Encoding = args.Encoding
+	return nil
+}
+
+// GetUTXOSnapshot returns a page of the UTXO set at a height that a UTXO
+// snapshot was taken at
+func (s *Service) GetUTXOSnapshot(_ *http.Request, args *api.GetUTXOSnapshotArgs, response *api.GetUTXOSnapshotReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getUTXOSnapshot"),
+		zap.Uint64("height", uint64(args.Height)),
+		zap.Stringer("startUTXOID", args.StartUTXOID),
+	)
+
+	limit := int(args.Limit)
+	if limit <= 0 || limit > avax.MaxUTXOSnapshotPageSize {
+		limit = avax.MaxUTXOSnapshotPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	page, err := s.vm.state.GetUTXOSnapshot(uint64(args.Height), args.StartUTXOID, limit)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXO snapshot: %w", err)
+	}
+
+	response.UTXOIDs = make([]ids.ID, len(page.UTXOIDs))
+	response.UTXOs = make([]string, len(page.UTXOs))
+	for i, utxoBytes := range page.UTXOs {
+		response.UTXOIDs[i] = page.UTXOIDs[i]
+		response.UTXOs[i], err = formatting.Encode(args.Encoding, utxoBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as %s: %w", page.UTXOIDs[i], args.Encoding, err)
+		}
+	}
+	response.Digest = page.Digest
+	response.More = page.More
+	response.NextUTXOID = page.NextUTXOID
+	response.Encoding = args.Encoding
+	return nil
+}
+
 // GetSubnetArgs are the arguments to GetSubnet
 type GetSubnetArgs struct {
 	// ID of the subnet to retrieve information about
@@ -651,12 +779,28 @@ type GetCurrentValidatorsArgs struct {
 	// some nodeIDs are not currently validators, they
 	// will be omitted from the response.
 	NodeIDs []ids.NodeID `json:"nodeIDs"`
+	// Order of the returned validators. If omitted, the validators are
+	// returned in the order they are stored in.
+	SortBy string `json:"sortBy"`
+	// Fields of the validators to return. If omitted, all the fields are
+	// returned.
+	Fields []string `json:"fields"`
+	// OmitDelegators skips the list of delegators that is returned when a
+	// single nodeID is requested.
+	OmitDelegators bool `json:"omitDelegators"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page. If omitted, all the validators after
+	// [Cursor] are returned.
+	PageSize avajson.Uint64 `json:"pageSize"`
 }
 
 // GetCurrentValidatorsReply are the results from calling GetCurrentValidators.
 // Each validator contains a list of delegators to itself.
 type GetCurrentValidatorsReply struct {
 	Validators []any `json:"validators"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
 }
 
 func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error) {
@@ -667,34 +811,38 @@ func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error)
 	}
 
 	// Tx not available in cache; pull it from disk and populate the cache.
-	tx, _, err := s.vm.state.GetTx(txID)
+	stakerTx, err := s.vm.state.GetStakerTx(txID)
 	if err != nil {
 		return nil, err
 	}
 
-	switch stakerTx := tx.Unsigned.(type) {
+	switch stakerTx := stakerTx.(type) {
 	case txs.ValidatorTx:
-		var pop *signer.ProofOfPossession
-		if staker, ok := stakerTx.(*txs.AddPermissionlessValidatorTx); ok {
-			if s, ok := staker.Signer.(*signer.ProofOfPossession); ok {
-				pop = s
-			}
+		var vdrSigner signer.Signer
+		switch staker := stakerTx.(type) {
+		case *txs.AddPermissionlessValidatorTx:
+			vdrSigner = staker.Signer
+		case *txs.AddContinuousValidatorTx:
+			vdrSigner = staker.Signer
 		}
+		pop, _ := vdrSigner.(*signer.ProofOfPossession)
 
 		attr = &stakerAttributes{
 			shares:                 stakerTx.Shares(),
 			validationRewardsOwner: stakerTx.ValidationRewardsOwner(),
 			delegationRewardsOwner: stakerTx.DelegationRewardsOwner(),
 			proofOfPossession:      pop,
+			stake:                  stakerTx.Stake(),
 		}
 
 	case txs.DelegatorTx:
 		attr = &stakerAttributes{
 			rewardsOwner: stakerTx.RewardsOwner(),
+			stake:        stakerTx.Stake(),
 		}
 
 	default:
-		return nil, fmt.Errorf("unexpected staker tx type %T", tx.Unsigned)
+		return nil, fmt.Errorf("unexpected staker tx type %T", stakerTx)
 	}
 
 	s.stakerAttributesCache.Put(txID, attr)
@@ -704,12 +852,28 @@ func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error)
 // GetCurrentValidators returns the current validators. If a single nodeID
 // is provided, full delegators information is also returned. Otherwise only
 // delegators' number and total weight is returned.
+//
+// The validators can be sorted by [args.SortBy], paginated with [args.Cursor]
+// and [args.PageSize], and restricted to [args.Fields].
 func (s *Service) GetCurrentValidators(request *http.Request, args *GetCurrentValidatorsArgs, reply *GetCurrentValidatorsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getCurrentValidators"),
+		zap.String("sortBy", args.SortBy),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
 	)
 
+	compare, err := compareCurrentValidators(args.SortBy)
+	if err != nil {
+		return err
+	}
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	}
+
 	// Create set of nodeIDs
 	nodeIDs := set.Of(args.NodeIDs...)
 
@@ -717,34 +881,126 @@ func (s *Service) GetCurrentValidators(request *http.Request, args *GetCurrentVa
 	defer s.vm.ctx.Lock.Unlock()
 
 	// Check if subnet is L1
-	_, err := s.vm.state.GetSubnetToL1Conversion(args.SubnetID)
-	if errors.Is(err, database.ErrNotFound) {
+	var validators []any
+	_, err = s.vm.state.GetSubnetToL1Conversion(args.SubnetID)
+	switch {
+	case errors.Is(err, database.ErrNotFound):
 		// Subnet is not L1, get validators for the subnet
-		reply.Validators, err = s.getPrimaryOrSubnetValidators(
+		validators, err = s.getPrimaryOrSubnetValidators(
 			args.SubnetID,
 			nodeIDs,
+			!args.OmitDelegators,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to get primary or subnet validators: %w", err)
 		}
-		return nil
-	}
-	if err != nil {
+	case err != nil:
 		return fmt.Errorf("failed to get subnet to L1 conversion: %w", err)
+	default:
+		// Subnet is L1, get validators for L1
+		validators, err = s.getL1Validators(
+			request.Context(),
+			args.SubnetID,
+			nodeIDs,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get L1 validators: %w", err)
+		}
 	}
 
-	// Subnet is L1, get validators for L1
-	reply.Validators, err = s.getL1Validators(
-		request.Context(),
-		args.SubnetID,
-		nodeIDs,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to get L1 validators: %w", err)
+	if compare != nil {
+		slices.SortStableFunc(validators, compare)
+	}
+
+	start := min(cursor, uint64(len(validators)))
+	end := uint64(len(validators))
+	if pageSize != 0 {
+		end = min(end, start+pageSize)
+	}
+	validators = validators[start:end]
+
+	if len(args.Fields) != 0 {
+		fields := set.Of(args.Fields...)
+		for i, vdr := range validators {
+			validators[i], err = selectFields(vdr, fields)
+			if err != nil {
+				return fmt.Errorf("failed to select validator fields: %w", err)
+			}
+		}
 	}
+
+	reply.Validators = validators
+	reply.Cursor = avajson.Uint64(end)
 	return nil
 }
 
+// compareCurrentValidators returns the comparison function that orders the
+// validators returned by GetCurrentValidators by [sortBy]. Ties are broken by
+// nodeID. If [sortBy] is empty, nil is returned.
+func compareCurrentValidators(sortBy string) (func(a, b any) int, error) {
+	switch sortBy {
+	case "":
+		return nil, nil
+	case currentValidatorsSortByStake:
+		// Most stake first
+		return func(a, b any) int {
+			aNodeID, aWeight, _ := currentValidatorSortKey(a)
+			bNodeID, bWeight, _ := currentValidatorSortKey(b)
+			if c := cmp.Compare(bWeight, aWeight); c != 0 {
+				return c
+			}
+			return aNodeID.Compare(bNodeID)
+		}, nil
+	case currentValidatorsSortByEndTime:
+		// Latest end time first
+		return func(a, b any) int {
+			aNodeID, _, aEndTime := currentValidatorSortKey(a)
+			bNodeID, _, bEndTime := currentValidatorSortKey(b)
+			if c := cmp.Compare(bEndTime, aEndTime); c != 0 {
+				return c
+			}
+			return aNodeID.Compare(bNodeID)
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownSortBy, sortBy)
+	}
+}
+
+// currentValidatorSortKey returns the nodeID, weight and end time of a
+// validator returned by GetCurrentValidators. L1 validators don't have an end
+// time, so they are treated as ending last.
+func currentValidatorSortKey(vdr any) (ids.NodeID, uint64, uint64) {
+	switch vdr := vdr.(type) {
+	case platformapi.PermissionlessValidator:
+		return vdr.NodeID, uint64(vdr.Weight), uint64(vdr.EndTime)
+	case platformapi.Staker:
+		return vdr.NodeID, uint64(vdr.Weight), uint64(vdr.EndTime)
+	case APIL1Validator:
+		return vdr.NodeID, uint64(vdr.Weight), math.MaxUint64
+	default:
+		return ids.EmptyNodeID, 0, 0
+	}
+}
+
+// selectFields returns the JSON object of [vdr] restricted to [fields].
+func selectFields(vdr any, fields set.Set[string]) (map[string]json.RawMessage, error) {
+	vdrJSON, err := json.Marshal(vdr)
+	if err != nil {
+		return nil, err
+	}
+	var allFields map[string]json.RawMessage
+	if err := json.Unmarshal(vdrJSON, &allFields); err != nil {
+		return nil, err
+	}
+	selected := make(map[string]json.RawMessage, fields.Len())
+	for field, value := range allFields {
+		if fields.Contains(field) {
+			selected[field] = value
+		}
+	}
+	return selected, nil
+}
+
 func (s *Service) getL1Validators(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -783,8 +1039,14 @@ func (s *Service) getL1Validators(
 	return validators, nil
 }
 
-func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[ids.NodeID]) ([]any, error) {
+func (s *Service) getPrimaryOrSubnetValidators(
+	subnetID ids.ID,
+	nodeIDs set.Set[ids.NodeID],
+	includeDelegators bool,
+) ([]any, error) {
 	numNodeIDs := nodeIDs.Len()
+	// Delegators are only returned when a single validator is requested.
+	includeDelegators = includeDelegators && numNodeIDs == 1
 
 	targetStakers := make([]*state.Staker, 0, numNodeIDs)
 
@@ -853,8 +1115,9 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 			shares := attr.shares
 			delegationFee := avajson.Float32(100 * float32(shares) / float32(reward.PercentDenominator))
 			var (
-				uptime    *avajson.Float32
-				connected *bool
+				uptime            *avajson.Float32
+				connected         *bool
+				maintenanceWindow *platformapi.MaintenanceWindow
 			)
 			if subnetID == constants.PrimaryNetworkID {
 				rawUptime, err := s.vm.uptimeManager.CalculateUptimePercentFrom(currentStaker.NodeID, currentStaker.StartTime)
@@ -870,6 +1133,13 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 				isConnected := s.vm.uptimeManager.IsConnected(currentStaker.NodeID)
 				connected = &isConnected
 				uptime = &currentUptime
+
+				if window, ok := s.vm.uptimeManager.GetMaintenance(currentStaker.NodeID); ok {
+					maintenanceWindow = &platformapi.MaintenanceWindow{
+						Start: avajson.Uint64(window.Start.Unix()),
+						End:   avajson.Uint64(window.End.Unix()),
+					}
+				}
 			}
 
 			var (
@@ -895,6 +1165,7 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 				Staker:                 apiStaker,
 				Uptime:                 uptime,
 				Connected:              connected,
+				MaintenanceWindow:      maintenanceWindow,
 				PotentialReward:        &potentialReward,
 				AccruedDelegateeReward: &jsonDelegateeReward,
 				RewardOwner:            validationRewardOwner,
@@ -907,9 +1178,9 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 
 		case txs.PrimaryNetworkDelegatorCurrentPriority, txs.SubnetPermissionlessDelegatorCurrentPriority:
 			var rewardOwner *platformapi.Owner
-			// If we are handling multiple nodeIDs, we don't return the
+			// If we aren't returning the delegators, we don't load the
 			// delegator information.
-			if numNodeIDs == 1 {
+			if includeDelegators {
 				attr, err := s.loadStakerTxAttributes(currentStaker.TxID)
 				if err != nil {
 					return nil, err
@@ -959,7 +1230,7 @@ func (s *Service) getPrimaryOrSubnetValidators(subnetID ids.ID, nodeIDs set.Set[
 		vdr.DelegatorCount = &delegatorCount
 		vdr.DelegatorWeight = &delegatorWeight
 
-		if numNodeIDs == 1 {
+		if includeDelegators {
 			// queried a specific validator, load all of its delegators
 			vdr.Delegators = &delegators
 		}
@@ -1146,6 +1417,300 @@ func (s *Service) SampleValidators(_ *http.Request, args *SampleValidatorsArgs,
 	return nil
 }
 
+// GetValidatorCapacitiesArgs are the arguments for calling
+// GetValidatorCapacities
+type GetValidatorCapacitiesArgs struct {
+	// Order of the returned validators. If omitted, defaults to the validators
+	// with the most remaining delegation capacity first.
+	SortBy string `json:"sortBy"`
+	// Minimum number of seconds until the end time of the returned validators
+	MinRemainingDuration avajson.Uint64 `json:"minRemainingDuration"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+}
+
+// APIValidatorCapacity is the delegation capacity of a Primary Network
+// validator
+type APIValidatorCapacity struct {
+	TxID              ids.ID          `json:"txID"`
+	NodeID            ids.NodeID      `json:"nodeID"`
+	StartTime         avajson.Uint64  `json:"startTime"`
+	EndTime           avajson.Uint64  `json:"endTime"`
+	Weight            avajson.Uint64  `json:"weight"`
+	DelegatorWeight   avajson.Uint64  `json:"delegatorWeight"`
+	RemainingCapacity avajson.Uint64  `json:"remainingCapacity"`
+	DelegationFee     avajson.Float32 `json:"delegationFee"`
+	Uptime            avajson.Float32 `json:"uptime"`
+}
+
+// GetValidatorCapacitiesReply are the results from calling
+// GetValidatorCapacities
+type GetValidatorCapacitiesReply struct {
+	Validators []APIValidatorCapacity `json:"validators"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetValidatorCapacities returns the current Primary Network validators along
+// with how much more stake can be delegated to them.
+func (s *Service) GetValidatorCapacities(_ *http.Request, args *GetValidatorCapacitiesArgs, reply *GetValidatorCapacitiesReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorCapacities"),
+		zap.String("sortBy", args.SortBy),
+		zap.Uint64("minRemainingDuration", uint64(args.MinRemainingDuration)),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+
+	sortBy := vdrcapacity.SortBy(args.SortBy)
+	if sortBy == "" {
+		sortBy = vdrcapacity.SortByRemainingCapacity
+	}
+	const maxRemainingDuration = math.MaxInt64 / uint64(time.Second)
+	if uint64(args.MinRemainingDuration) > maxRemainingDuration {
+		return fmt.Errorf("minRemainingDuration > maximum allowed (%d)", maxRemainingDuration)
+	}
+	minRemainingDuration := time.Duration(args.MinRemainingDuration) * time.Second
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	vdrs, err := s.vm.validatorCapacities.Validators(
+		sortBy,
+		minRemainingDuration,
+		int(min(cursor, math.MaxInt)),
+		int(pageSize),
+	)
+	if err != nil {
+		return err
+	}
+
+	reply.Validators = make([]APIValidatorCapacity, len(vdrs))
+	for i, vdr := range vdrs {
+		reply.Validators[i] = APIValidatorCapacity{
+			TxID:              vdr.TxID,
+			NodeID:            vdr.NodeID,
+			StartTime:         avajson.Uint64(vdr.StartTime.Unix()),
+			EndTime:           avajson.Uint64(vdr.EndTime.Unix()),
+			Weight:            avajson.Uint64(vdr.Weight),
+			DelegatorWeight:   avajson.Uint64(vdr.DelegatorWeight),
+			RemainingCapacity: avajson.Uint64(vdr.RemainingCapacity),
+			DelegationFee:     avajson.Float32(100 * float32(vdr.DelegationFee) / float32(reward.PercentDenominator)),
+			// Transform this to a percentage (0-100) to make it consistent
+			// with getCurrentValidators
+			Uptime: avajson.Float32(vdr.Uptime * 100),
+		}
+	}
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(vdrs)))
+	return nil
+}
+
+// GetRewardReportArgs are the arguments for calling GetRewardReport
+type GetRewardReportArgs struct {
+	// Unix time in the epoch of the requested report. If omitted, the most
+	// recently generated report is returned.
+	Time avajson.Uint64 `json:"time"`
+}
+
+// GetRewardReportReply is the response from calling GetRewardReport
+type GetRewardReportReply struct {
+	report.SignedReport
+}
+
+// GetRewardReport returns the signed report of the staking rewards distributed
+// during an epoch.
+func (s *Service) GetRewardReport(_ *http.Request, args *GetRewardReportArgs, reply *GetRewardReportReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getRewardReport"),
+		zap.Uint64("time", uint64(args.Time)),
+	)
+
+	if uint64(args.Time) > math.MaxInt64 {
+		return fmt.Errorf("time > maximum allowed (%d)", int64(math.MaxInt64))
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	var (
+		signedReport *report.SignedReport
+		err          error
+	)
+	if args.Time == 0 {
+		signedReport, err = s.vm.rewardReports.GetLastReport()
+	} else {
+		signedReport, err = s.vm.rewardReports.GetReport(time.Unix(int64(args.Time), 0))
+	}
+	if err != nil {
+		return err
+	}
+	reply.SignedReport = *signedReport
+	return nil
+}
+
+// GetFeeReportArgs are the arguments for calling GetFeeReport
+type GetFeeReportArgs struct {
+	// Addresses that signed for the inputs of the reported transactions
+	Addresses []string `json:"addresses"`
+	// Height of the first block to report the transactions of
+	StartHeight avajson.Uint64 `json:"startHeight"`
+	// Height of the last block to report the transactions of. If omitted,
+	// defaults to the last accepted block.
+	EndHeight avajson.Uint64 `json:"endHeight"`
+}
+
+// APIFeeReportTx is the fee paid by an accepted transaction
+type APIFeeReportTx struct {
+	TxID   ids.ID         `json:"txID"`
+	Height avajson.Uint64 `json:"height"`
+	// Unix time of the block that included the transaction. Blocks issued
+	// prior to Banff don't have a timestamp, so it is 0 for them.
+	Timestamp avajson.Uint64 `json:"timestamp"`
+	Type      string         `json:"type"`
+	// AVAX burned by the transaction
+	Fee avajson.Uint64 `json:"fee"`
+	// Complexity and Gas are only reported for transactions accepted after
+	// Etna.
+	Complexity *gas.Dimensions `json:"complexity,omitempty"`
+	Gas        avajson.Uint64  `json:"gas,omitempty"`
+}
+
+// GetFeeReportReply are the results from calling GetFeeReport
+type GetFeeReportReply struct {
+	Txs []APIFeeReportTx `json:"txs"`
+}
+
+// GetFeeReport returns the fees paid by the transactions accepted in
+// [args.StartHeight, args.EndHeight] whose inputs were signed by any of
+// [args.Addresses].
+func (s *Service) GetFeeReport(_ *http.Request, args *GetFeeReportArgs, reply *GetFeeReportReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getFeeReport"),
+		logging.UserStrings("addresses", args.Addresses),
+		zap.Uint64("startHeight", uint64(args.StartHeight)),
+		zap.Uint64("endHeight", uint64(args.EndHeight)),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	startHeight := uint64(args.StartHeight)
+	endHeight := uint64(args.EndHeight)
+	if endHeight == 0 {
+		lastAccepted, err := s.vm.state.GetStatelessBlock(s.vm.state.GetLastAccepted())
+		if err != nil {
+			return err
+		}
+		endHeight = lastAccepted.Height()
+	}
+	switch {
+	case startHeight > endHeight:
+		return fmt.Errorf("%w: startHeight %d > endHeight %d", errInvalidHeightRange, startHeight, endHeight)
+	case endHeight-startHeight >= maxFeeReportHeights:
+		return fmt.Errorf("%w: this method can report at most %d blocks", errInvalidHeightRange, maxFeeReportHeights)
+	}
+
+	reply.Txs = []APIFeeReportTx{}
+	for height := startHeight; height <= endHeight; height++ {
+		blkID, err := s.vm.state.GetBlockIDAtHeight(height)
+		if err != nil {
+			return fmt.Errorf("couldn't get block at height %d: %w", height, err)
+		}
+		blk, err := s.vm.manager.GetStatelessBlock(blkID)
+		if err != nil {
+			return fmt.Errorf("couldn't get block with id %s: %w", blkID, err)
+		}
+
+		var timestamp time.Time
+		if banffBlk, ok := blk.(block.BanffBlock); ok {
+			timestamp = banffBlk.Timestamp()
+		}
+		isEtna := s.vm.Internal.UpgradeConfig.IsEtnaActivated(timestamp)
+		for _, tx := range blk.Txs() {
+			fee, numInputs, err := burned(s.vm.state, s.vm.ctx.AVAXAssetID, tx.Unsigned)
+			if err != nil {
+				return fmt.Errorf("couldn't calculate the fee of tx %s: %w", tx.ID(), err)
+			}
+			signed, err := signedInputs(tx, numInputs, addrs)
+			if err != nil {
+				return fmt.Errorf("couldn't recover the signers of tx %s: %w", tx.ID(), err)
+			}
+			if !signed {
+				continue
+			}
+
+			reportedTx := APIFeeReportTx{
+				TxID:   tx.ID(),
+				Height: avajson.Uint64(height),
+				Type:   reflect.TypeOf(tx.Unsigned).Elem().Name(),
+				Fee:    avajson.Uint64(fee),
+			}
+			if !timestamp.IsZero() {
+				reportedTx.Timestamp = avajson.Uint64(timestamp.Unix())
+			}
+			if isEtna {
+				complexity, err := txfee.TxComplexity(tx.Unsigned)
+				if err != nil {
+					return err
+				}
+				txGas, err := complexity.ToGas(s.vm.Internal.DynamicFeeWeights(timestamp))
+				if err != nil {
+					return err
+				}
+				reportedTx.Complexity = &complexity
+				reportedTx.Gas = avajson.Uint64(txGas)
+			}
+			reply.Txs = append(reply.Txs, reportedTx)
+		}
+	}
+	return nil
+}
+
+// signedInputs returns true if any of the first [numInputs] credentials of
+// [tx] include a signature of any of [addrs].
+func signedInputs(tx *txs.Tx, numInputs int, addrs set.Set[ids.ShortID]) (bool, error) {
+	txHash := hashing.ComputeHash256(tx.Unsigned.Bytes())
+	for _, cred := range tx.Creds[:min(numInputs, len(tx.Creds))] {
+		secpCred, ok := cred.(*secp256k1fx.Credential)
+		if !ok {
+			continue
+		}
+		for _, sig := range secpCred.Sigs {
+			pk, err := secp256k1.RecoverPublicKeyFromHash(txHash, sig[:])
+			if err != nil {
+				return false, err
+			}
+			if addrs.Contains(pk.Address()) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 // GetBlockchainStatusArgs is the arguments for calling GetBlockchainStatus
 // [BlockchainID] is the ID of or an alias of the blockchain to get the status of.
 type GetBlockchainStatusArgs struct {
@@ -1437,6 +2002,74 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.
 	return nil
 }
 
+// SimulateTxReply is the response from calling SimulateTx
+type SimulateTxReply struct {
+	// Valid is true if the tx could currently be issued
+	Valid bool `json:"valid"`
+	// Error is the reason the tx couldn't be issued, if it is invalid
+	Error string `json:"error,omitempty"`
+	// Complexity of the tx. Zero if the tx doesn't support dynamic fees.
+	Complexity gas.Dimensions `json:"complexity"`
+	// Gas is the complexity of the tx, weighted by the dynamic fee config
+	Gas avajson.Uint64 `json:"gas"`
+	// UTXOs that the tx produces if it is accepted
+	UTXOs []string `json:"utxos"`
+	// Encoding specifies the encoding format the UTXOs are returned in
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// SimulateTx executes the tx on top of the currently preferred state, without
+// issuing it, and reports whether it could be issued.
+func (s *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "simulateTx"),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	// Txs that don't support dynamic fees are reported without a complexity.
+	if complexity, err := txfee.TxComplexity(tx.Unsigned); err == nil {
+		txGas, err := complexity.ToGas(s.vm.DynamicFeeWeights(s.vm.state.GetTimestamp()))
+		if err != nil {
+			return fmt.Errorf("couldn't calculate tx gas: %w", err)
+		}
+		reply.Complexity = complexity
+		reply.Gas = avajson.Uint64(txGas)
+	}
+
+	utxos := tx.UTXOs()
+	reply.UTXOs = make([]string, len(utxos))
+	for i, utxo := range utxos {
+		utxoBytes, err := txs.Codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't serialize UTXO %s: %w", utxo.InputID(), err)
+		}
+		reply.UTXOs[i], err = formatting.Encode(args.Encoding, utxoBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as %s: %w", utxo.InputID(), args.Encoding, err)
+		}
+	}
+	reply.Encoding = args.Encoding
+
+	if err := s.vm.manager.VerifyTx(tx); err != nil {
+		reply.Error = err.Error()
+		return nil
+	}
+	reply.Valid = true
+	return nil
+}
+
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1469,6 +2102,8 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetT
 
 type GetTxStatusArgs struct {
 	TxID ids.ID `json:"txID"`
+	// If true, the details of why a tx was dropped are also returned.
+	Verbose bool `json:"verbose"`
 }
 
 type GetTxStatusResponse struct {
@@ -1476,6 +2111,13 @@ type GetTxStatusResponse struct {
 	// Reason this tx was dropped.
 	// Only non-empty if Status is dropped
 	Reason string `json:"reason,omitempty"`
+	// ConflictingTxID is the ID of the tx that consumed an input of this tx.
+	// Only non-nil if Status is dropped because of a conflict and Verbose is
+	// set.
+	ConflictingTxID *ids.ID `json:"conflictingTxID,omitempty"`
+	// DroppedAt is when this tx was dropped.
+	// Only non-nil if Status is dropped and Verbose is set.
+	DroppedAt *time.Time `json:"droppedAt,omitempty"`
 }
 
 // GetTxStatus gets a tx's status
@@ -1523,8 +2165,8 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 
 	// Note: we check if tx is dropped only after having looked for it
 	// in the database and the mempool, because dropped txs may be re-issued.
-	reason := s.vm.Builder.GetDropReason(args.TxID)
-	if reason == nil {
+	dropped, ok := s.vm.Builder.GetDroppedTx(args.TxID)
+	if !ok {
 		// The tx isn't being tracked by the node.
 		response.Status = status.Unknown
 		return nil
@@ -1532,7 +2174,13 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 
 	// The tx was recently dropped because it was invalid.
 	response.Status = status.Dropped
-	response.Reason = reason.Error()
+	response.Reason = dropped.Reason.Error()
+	if args.Verbose {
+		if dropped.ConflictingTxID != ids.Empty {
+			response.ConflictingTxID = &dropped.ConflictingTxID
+		}
+		response.DroppedAt = &dropped.Time
+	}
 	return nil
 }
 
@@ -1546,6 +2194,12 @@ type GetStakeArgs struct {
 type GetStakeReply struct {
 	Staked  avajson.Uint64            `json:"staked"`
 	Stakeds map[ids.ID]avajson.Uint64 `json:"stakeds"`
+	// nAVAX staked by the current stakers
+	CurrentStaked avajson.Uint64 `json:"currentStaked"`
+	// nAVAX staked by the pending stakers
+	PendingStaked avajson.Uint64 `json:"pendingStaked"`
+	// Stake of each validator and delegator
+	Stakers []OwnedStake `json:"stakers"`
 	// String representation of staked outputs
 	// Each is of type avax.TransferableOutput
 	Outputs []string `json:"stakedOutputs"`
@@ -1553,14 +2207,37 @@ type GetStakeReply struct {
 	Encoding formatting.Encoding `json:"encoding"`
 }
 
+// OwnedStake is the stake of a validator or delegator that is owned by the
+// requested addresses.
+type OwnedStake struct {
+	TxID        ids.ID     `json:"txID"`
+	NodeID      ids.NodeID `json:"nodeID"`
+	SubnetID    ids.ID     `json:"subnetID"`
+	IsValidator bool       `json:"isValidator"`
+	IsPending   bool       `json:"isPending"`
+	// Asset that was staked
+	AssetID ids.ID `json:"assetID"`
+	// Amount of the stake owned by the requested addresses
+	Amount    avajson.Uint64 `json:"amount"`
+	Weight    avajson.Uint64 `json:"weight"`
+	StartTime avajson.Uint64 `json:"startTime"`
+	EndTime   avajson.Uint64 `json:"endTime"`
+	// Reward of the staker if it stays eligible for rewards. Zero for pending
+	// stakers, as their reward is calculated once they start staking.
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+}
+
 // GetStake returns the amount of nAVAX that [args.Addresses] have cumulatively
-// staked on the Primary Network.
+// staked on the Primary Network, along with the stake of each validator and
+// delegator.
+//
+// The stake is read from the current and pending stakers, and the stake
+// outputs of each staker are cached, so the staker txs are only parsed the
+// first time they are requested.
 //
 // This method assumes that each stake output has only owner
 // This method assumes only AVAX can be staked
 // This method only concerns itself with the Primary Network, not subnets
-// TODO: Improve the performance of this method by maintaining this data
-// in a data structure rather than re-calculating it by iterating over stakers
 func (s *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *GetStakeReply) error {
 	s.vm.ctx.Log.Debug("deprecated API called",
 		zap.String("service", "platform"),
@@ -1585,23 +2262,10 @@ func (s *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *GetSta
 	}
 	defer currentStakerIterator.Release()
 
-	var (
-		totalAmountStaked = make(map[ids.ID]uint64)
-		stakedOuts        []avax.TransferableOutput
-	)
-	for currentStakerIterator.Next() { // Iterates over current stakers
-		staker := currentStakerIterator.Value()
-
-		if args.ValidatorsOnly && !staker.Priority.IsValidator() {
-			continue
-		}
-
-		tx, _, err := s.vm.state.GetTx(staker.TxID)
-		if err != nil {
-			return err
-		}
-
-		stakedOuts = append(stakedOuts, getStakeHelper(tx, addrs, totalAmountStaked)...)
+	totalAmountStaked := make(map[ids.ID]uint64)
+	currentStakers, currentOuts, err := s.getOwnedStake(currentStakerIterator, addrs, args.ValidatorsOnly, totalAmountStaked)
+	if err != nil {
+		return err
 	}
 
 	pendingStakerIterator, err := s.vm.state.GetPendingStakerIterator()
@@ -1610,23 +2274,18 @@ func (s *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *GetSta
 	}
 	defer pendingStakerIterator.Release()
 
-	for pendingStakerIterator.Next() { // Iterates over pending stakers
-		staker := pendingStakerIterator.Value()
-
-		if args.ValidatorsOnly && !staker.Priority.IsValidator() {
-			continue
-		}
-
-		tx, _, err := s.vm.state.GetTx(staker.TxID)
-		if err != nil {
-			return err
-		}
-
-		stakedOuts = append(stakedOuts, getStakeHelper(tx, addrs, totalAmountStaked)...)
+	pendingStakers, pendingOuts, err := s.getOwnedStake(pendingStakerIterator, addrs, args.ValidatorsOnly, totalAmountStaked)
+	if err != nil {
+		return err
 	}
 
 	response.Stakeds = newJSONBalanceMap(totalAmountStaked)
 	response.Staked = response.Stakeds[s.vm.ctx.AVAXAssetID]
+	response.CurrentStaked = avajson.Uint64(s.sumAVAXStake(currentStakers))
+	response.PendingStaked = avajson.Uint64(s.sumAVAXStake(pendingStakers))
+	response.Stakers = append(currentStakers, pendingStakers...)
+
+	stakedOuts := append(currentOuts, pendingOuts...)
 	response.Outputs = make([]string, len(stakedOuts))
 	for i, output := range stakedOuts {
 		bytes, err := txs.Codec.Marshal(txs.CodecVersion, output)
@@ -1643,6 +2302,81 @@ func (s *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *GetSta
 	return nil
 }
 
+// getOwnedStake returns the stake owned by [addrs] of each of the [stakers],
+// along with the owned stake outputs. The owned amounts are added to
+// [totalAmountStaked].
+func (s *Service) getOwnedStake(
+	stakers iterator.Iterator[*state.Staker],
+	addrs set.Set[ids.ShortID],
+	validatorsOnly bool,
+	totalAmountStaked map[ids.ID]uint64,
+) ([]OwnedStake, []avax.TransferableOutput, error) {
+	var (
+		ownedStakes []OwnedStake
+		stakedOuts  []avax.TransferableOutput
+	)
+	for stakers.Next() {
+		staker := stakers.Value()
+
+		// Permissioned subnet validators don't stake any outputs.
+		if staker.Priority.IsPermissionedValidator() {
+			continue
+		}
+		if validatorsOnly && !staker.Priority.IsValidator() {
+			continue
+		}
+
+		attr, err := s.loadStakerTxAttributes(staker.TxID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ownedOuts := getStakeHelper(attr.stake, addrs, totalAmountStaked)
+		if len(ownedOuts) == 0 {
+			continue
+		}
+
+		var amount uint64
+		for _, out := range ownedOuts {
+			amount, err = safemath.Add(amount, out.Out.Amount())
+			if err != nil {
+				amount = math.MaxUint64
+			}
+		}
+		ownedStakes = append(ownedStakes, OwnedStake{
+			TxID:            staker.TxID,
+			NodeID:          staker.NodeID,
+			SubnetID:        staker.SubnetID,
+			IsValidator:     staker.Priority.IsValidator(),
+			IsPending:       staker.Priority.IsPending(),
+			AssetID:         ownedOuts[0].AssetID(),
+			Amount:          avajson.Uint64(amount),
+			Weight:          avajson.Uint64(staker.Weight),
+			StartTime:       avajson.Uint64(staker.StartTime.Unix()),
+			EndTime:         avajson.Uint64(staker.EndTime.Unix()),
+			PotentialReward: avajson.Uint64(staker.PotentialReward),
+		})
+		stakedOuts = append(stakedOuts, ownedOuts...)
+	}
+	return ownedStakes, stakedOuts, nil
+}
+
+// sumAVAXStake returns the amount of nAVAX staked in [stakes].
+func (s *Service) sumAVAXStake(stakes []OwnedStake) uint64 {
+	var total uint64
+	for _, stake := range stakes {
+		if stake.AssetID != s.vm.ctx.AVAXAssetID {
+			continue
+		}
+		newTotal, err := safemath.Add(total, uint64(stake.Amount))
+		if err != nil {
+			return math.MaxUint64
+		}
+		total = newTotal
+	}
+	return total
+}
+
 // GetMinStakeArgs are the arguments for calling GetMinStake.
 type GetMinStakeArgs struct {
 	SubnetID ids.ID `json:"subnetID"`
@@ -1663,15 +2397,16 @@ func (s *Service) GetMinStake(_ *http.Request, args *GetMinStakeArgs, reply *Get
 		zap.String("method", "getMinStake"),
 	)
 
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
 	if args.SubnetID == constants.PrimaryNetworkID {
-		reply.MinValidatorStake = avajson.Uint64(s.vm.MinValidatorStake)
-		reply.MinDelegatorStake = avajson.Uint64(s.vm.MinDelegatorStake)
+		stakingParams := s.vm.state.GetStakingParams()
+		reply.MinValidatorStake = avajson.Uint64(stakingParams.MinValidatorStake)
+		reply.MinDelegatorStake = avajson.Uint64(stakingParams.MinDelegatorStake)
 		return nil
 	}
 
-	s.vm.ctx.Lock.Lock()
-	defer s.vm.ctx.Lock.Unlock()
-
 	transformSubnetIntf, err := s.vm.state.GetSubnetTransformation(args.SubnetID)
 	if err != nil {
 		return fmt.Errorf(
@@ -1770,6 +2505,48 @@ func (s *Service) GetRewardUTXOs(_ *http.Request, args *api.GetTxArgs, reply *Ge
 	return nil
 }
 
+// GetUTXOProofArgs are the arguments for calling GetUTXOProof
+type GetUTXOProofArgs struct {
+	UTXOID ids.ID `json:"utxoID"`
+	// Height of the P-chain to prove the UTXO at
+	Height avajson.Uint64 `json:"height"`
+}
+
+// GetUTXOProofReply is the response from calling GetUTXOProof
+type GetUTXOProofReply struct {
+	// Merkle root of the UTXO set at the requested height
+	Root ids.ID `json:"root"`
+	// Protobuf serialized merkle range proof of the UTXO
+	Proof types.JSONByteSlice `json:"proof"`
+}
+
+// GetUTXOProof returns a proof of whether a UTXO was in the UTXO set at a
+// recent height.
+func (s *Service) GetUTXOProof(r *http.Request, args *GetUTXOProofArgs, reply *GetUTXOProofReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getUTXOProof"),
+		zap.Stringer("utxoID", args.UTXOID),
+		zap.Uint64("height", uint64(args.Height)),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	proof, root, err := s.vm.state.GetUTXOProof(r.Context(), args.UTXOID, uint64(args.Height))
+	if err != nil {
+		return err
+	}
+	proofBytes, err := proto.Marshal(proof.ToProto())
+	if err != nil {
+		return fmt.Errorf("couldn't serialize UTXO proof: %w", err)
+	}
+
+	reply.Root = root
+	reply.Proof = proofBytes
+	return nil
+}
+
 // GetTimestampReply is the response from GetTimestamp
 type GetTimestampReply struct {
 	// Current timestamp
@@ -1891,6 +2668,250 @@ func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, re
 	return nil
 }
 
+// GetValidatorsAtHeightsArgs are the arguments for calling
+// GetValidatorsAtHeights
+type GetValidatorsAtHeightsArgs struct {
+	Heights  []avajson.Uint64 `json:"heights"`
+	SubnetID ids.ID           `json:"subnetID"`
+}
+
+// ValidatorSetAtHeight is the validator set of a subnet at a P-Chain height
+type ValidatorSetAtHeight struct {
+	Height     avajson.Uint64        `json:"height"`
+	Validators *GetValidatorsAtReply `json:"validators"`
+}
+
+// GetValidatorsAtHeightsReply is the response from GetValidatorsAtHeights
+type GetValidatorsAtHeightsReply struct {
+	// ValidatorSets are in the order of the requested heights
+	ValidatorSets []ValidatorSetAtHeight `json:"validatorSets"`
+}
+
+// GetValidatorsAtHeights returns the weights of the validator sets of a
+// provided subnet at each of the specified heights.
+func (s *Service) GetValidatorsAtHeights(r *http.Request, args *GetValidatorsAtHeightsArgs, reply *GetValidatorsAtHeightsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorsAtHeights"),
+		zap.Int("numHeights", len(args.Heights)),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if len(args.Heights) > maxGetValidatorsAtHeights {
+		return fmt.Errorf("%w: %d provided but this method can take at most %d", errTooManyHeights, len(args.Heights), maxGetValidatorsAtHeights)
+	}
+
+	heights := make([]uint64, len(args.Heights))
+	for i, height := range args.Heights {
+		heights[i] = uint64(height)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	validatorSets, err := s.vm.validatorManager.GetValidatorSets(r.Context(), heights, args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator sets: %w", err)
+	}
+
+	reply.ValidatorSets = make([]ValidatorSetAtHeight, len(heights))
+	for i, height := range heights {
+		reply.ValidatorSets[i] = ValidatorSetAtHeight{
+			Height: avajson.Uint64(height),
+			Validators: &GetValidatorsAtReply{
+				Validators: validatorSets[height],
+			},
+		}
+	}
+	return nil
+}
+
+// GetValidatorSetQueriesArgs are the arguments for calling
+// GetValidatorSetQueries
+type GetValidatorSetQueriesArgs struct {
+	// Max number of validator set creations to report. If 0, all of the
+	// remembered validator set creations are reported.
+	Limit avajson.Uint32 `json:"limit"`
+}
+
+// ValidatorSetQuery is the creation of a validator set that wasn't cached
+type ValidatorSetQuery struct {
+	// Alias of the chain that requested the validator set, if known
+	Caller        string         `json:"caller,omitempty"`
+	SubnetID      ids.ID         `json:"subnetID"`
+	Height        avajson.Uint64 `json:"height"`
+	CurrentHeight avajson.Uint64 `json:"currentHeight"`
+	// Height of the validator set the validator diffs were applied to
+	StartHeight avajson.Uint64 `json:"startHeight"`
+	// Number of heights whose validator diffs were applied
+	HeightsWalked avajson.Uint64 `json:"heightsWalked"`
+	Timestamp     time.Time      `json:"timestamp"`
+	// Time taken to create the validator set, in nanoseconds
+	Duration avajson.Uint64 `json:"duration"`
+}
+
+// GetValidatorSetQueriesReply is the response from GetValidatorSetQueries
+type GetValidatorSetQueriesReply struct {
+	// Queries are sorted by the number of heights walked, deepest first
+	Queries []ValidatorSetQuery `json:"queries"`
+}
+
+// GetValidatorSetQueries returns the recently created validator sets that
+// required the validator diffs of the most heights to be applied.
+func (s *Service) GetValidatorSetQueries(_ *http.Request, args *GetValidatorSetQueriesArgs, reply *GetValidatorSetQueriesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorSetQueries"),
+		zap.Uint32("limit", uint32(args.Limit)),
+	)
+
+	limit := int(args.Limit)
+	if limit == 0 || limit > maxGetValidatorSetQueries {
+		limit = maxGetValidatorSetQueries
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	queries := s.vm.validatorManager.GetDeepestQueries(limit)
+	reply.Queries = make([]ValidatorSetQuery, len(queries))
+	for i, query := range queries {
+		reply.Queries[i] = ValidatorSetQuery{
+			Caller:        query.Caller,
+			SubnetID:      query.SubnetID,
+			Height:        avajson.Uint64(query.Height),
+			CurrentHeight: avajson.Uint64(query.CurrentHeight),
+			StartHeight:   avajson.Uint64(query.StartHeight),
+			HeightsWalked: avajson.Uint64(query.HeightsWalked()),
+			Timestamp:     query.Timestamp.UTC(),
+			Duration:      avajson.Uint64(query.Duration),
+		}
+	}
+	return nil
+}
+
+// CheckWarpQuorumArgs are the arguments for calling CheckWarpQuorum
+type CheckWarpQuorumArgs struct {
+	Height            platformapi.Height `json:"height"`
+	SubnetID          ids.ID             `json:"subnetID"`
+	NodeIDs           []ids.NodeID       `json:"nodeIDs"`
+	QuorumNumerator   avajson.Uint64     `json:"quorumNumerator"`
+	QuorumDenominator avajson.Uint64     `json:"quorumDenominator"`
+}
+
+// WarpSigner is a validator, identified by its BLS public key, of the
+// canonical validator set used to verify warp messages
+type WarpSigner struct {
+	// PublicKey is the compressed BLS public key of the validator
+	PublicKey types.JSONByteSlice `json:"publicKey"`
+	Weight    avajson.Uint64      `json:"weight"`
+	// NodeIDs are the nodes that registered [PublicKey]
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+// CheckWarpQuorumReply is the response from CheckWarpQuorum
+type CheckWarpQuorumReply struct {
+	// Height is the P-Chain height of the validator set the quorum was
+	// checked against
+	Height avajson.Uint64 `json:"height"`
+	// QuorumMet is true if the signing weight is sufficient to verify a warp
+	// message with the requested quorum
+	QuorumMet     bool           `json:"quorumMet"`
+	SigningWeight avajson.Uint64 `json:"signingWeight"`
+	TotalWeight   avajson.Uint64 `json:"totalWeight"`
+	// MissingSigners are the validators of the canonical validator set that
+	// none of the provided nodes registered
+	MissingSigners []WarpSigner `json:"missingSigners"`
+	// UnknownNodeIDs are the provided nodes that are not part of the canonical
+	// validator set, either because they aren't validators or because they
+	// didn't register a BLS public key
+	UnknownNodeIDs []ids.NodeID `json:"unknownNodeIDs"`
+}
+
+// CheckWarpQuorum returns whether the provided nodes would be able to produce
+// a warp signature with at least the provided quorum of stake of the provided
+// subnet at the specified height.
+func (s *Service) CheckWarpQuorum(r *http.Request, args *CheckWarpQuorumArgs, reply *CheckWarpQuorumReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "checkWarpQuorum"),
+		zap.Uint64("height", uint64(args.Height)),
+		zap.Bool("isProposed", args.Height.IsProposed()),
+		zap.Stringer("subnetID", args.SubnetID),
+		zap.Int("numNodeIDs", len(args.NodeIDs)),
+	)
+
+	if args.QuorumDenominator == 0 || args.QuorumNumerator > args.QuorumDenominator {
+		return fmt.Errorf("%w: %d/%d",
+			errInvalidQuorum,
+			args.QuorumNumerator,
+			args.QuorumDenominator,
+		)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	ctx := r.Context()
+	var err error
+	height := uint64(args.Height)
+	if args.Height.IsProposed() {
+		height, err = s.vm.GetMinimumHeight(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get proposed height: %w", err)
+		}
+	}
+
+	vdrSet, err := warp.GetCanonicalValidatorSetFromSubnetID(ctx, s.vm, height, args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("failed to get canonical validator set: %w", err)
+	}
+
+	signers := set.Of(args.NodeIDs...)
+	knownSigners := set.NewSet[ids.NodeID](len(args.NodeIDs))
+	reply.MissingSigners = []WarpSigner{}
+	var signingWeight uint64
+	for _, vdr := range vdrSet.Validators {
+		signed := false
+		for _, nodeID := range vdr.NodeIDs {
+			if signers.Contains(nodeID) {
+				signed = true
+				knownSigners.Add(nodeID)
+			}
+		}
+		if signed {
+			// Can not overflow because the total weight did not overflow
+			signingWeight += vdr.Weight
+			continue
+		}
+
+		reply.MissingSigners = append(reply.MissingSigners, WarpSigner{
+			PublicKey: bls.PublicKeyToCompressedBytes(vdr.PublicKey),
+			Weight:    avajson.Uint64(vdr.Weight),
+			NodeIDs:   vdr.NodeIDs,
+		})
+	}
+
+	reply.UnknownNodeIDs = []ids.NodeID{}
+	for nodeID := range signers {
+		if !knownSigners.Contains(nodeID) {
+			reply.UnknownNodeIDs = append(reply.UnknownNodeIDs, nodeID)
+		}
+	}
+	utils.Sort(reply.UnknownNodeIDs)
+
+	reply.Height = avajson.Uint64(height)
+	reply.SigningWeight = avajson.Uint64(signingWeight)
+	reply.TotalWeight = avajson.Uint64(vdrSet.TotalWeight)
+	reply.QuorumMet = warp.VerifyWeight(
+		signingWeight,
+		vdrSet.TotalWeight,
+		uint64(args.QuorumNumerator),
+		uint64(args.QuorumDenominator),
+	) == nil
+	return nil
+}
+
 func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1965,14 +2986,19 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 	return err
 }
 
-// GetFeeConfig returns the dynamic fee config of the chain.
+// GetFeeConfig returns the dynamic fee config of the chain. The weights are the
+// ones that apply at the chain's current time.
 func (s *Service) GetFeeConfig(_ *http.Request, _ *struct{}, reply *gas.Config) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getFeeConfig"),
 	)
 
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
 	*reply = s.vm.DynamicFeeConfig
+	reply.Weights = s.vm.DynamicFeeWeights(s.vm.state.GetTimestamp())
 	return nil
 }
 
@@ -2059,13 +3085,7 @@ func (s *Service) getAPIOwner(owner *secp256k1fx.OutputOwners) (*platformapi.Own
 // Returns:
 // 1) The total amount staked by addresses in [addrs]
 // 2) The staked outputs
-func getStakeHelper(tx *txs.Tx, addrs set.Set[ids.ShortID], totalAmountStaked map[ids.ID]uint64) []avax.TransferableOutput {
-	staker, ok := tx.Unsigned.(txs.PermissionlessStaker)
-	if !ok {
-		return nil
-	}
-
-	stake := staker.Stake()
+func getStakeHelper(stake []*avax.TransferableOutput, addrs set.Set[ids.ShortID], totalAmountStaked map[ids.ID]uint64) []avax.TransferableOutput {
 	stakedOuts := make([]avax.TransferableOutput, 0, len(stake))
 	// Go through all of the staked outputs
 	for _, output := range stake {
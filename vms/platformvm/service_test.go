@@ -4,6 +4,8 @@
 package platformvm
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,12 +13,14 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/cache"
@@ -26,8 +30,10 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
@@ -36,27 +42,36 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block/executor/executormock"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward/report"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state/statetest"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators/fee"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/types"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"github.com/ava-labs/avalanchego/x/merkledb"
 
+	pb "github.com/ava-labs/avalanchego/proto/pb/sync"
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 	pchainapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
 	blockbuilder "github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
 	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+	vdrcapacity "github.com/ava-labs/avalanchego/vms/platformvm/validators/capacity"
 )
 
 var encodings = []formatting.Encoding{
@@ -231,6 +246,32 @@ func TestGetTxStatus(t *testing.T) {
 	require.Zero(resp.Reason)
 }
 
+func TestGetTxStatusDropped(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	var (
+		txID    = ids.GenerateTestID()
+		dropErr = errors.New("dropped")
+	)
+	service.vm.ctx.Lock.Lock()
+	service.vm.Builder.MarkDropped(txID, dropErr)
+	service.vm.ctx.Lock.Unlock()
+
+	var resp GetTxStatusResponse
+	require.NoError(service.GetTxStatus(nil, &GetTxStatusArgs{TxID: txID}, &resp))
+	require.Equal(status.Dropped, resp.Status)
+	require.Equal(dropErr.Error(), resp.Reason)
+	require.Nil(resp.DroppedAt)
+
+	resp = GetTxStatusResponse{} // reset
+	require.NoError(service.GetTxStatus(nil, &GetTxStatusArgs{TxID: txID, Verbose: true}, &resp))
+	require.Equal(status.Dropped, resp.Status)
+	require.Equal(dropErr.Error(), resp.Reason)
+	require.Nil(resp.ConflictingTxID)
+	require.NotNil(resp.DroppedAt)
+}
+
 // Test issuing and then retrieving a transaction
 func TestGetTx(t *testing.T) {
 	type test struct {
@@ -455,6 +496,29 @@ func TestGetStake(t *testing.T) {
 		response := GetStakeReply{}
 		require.NoError(service.GetStake(nil, &args, &response))
 		require.Equal(genesistest.DefaultValidatorWeight, uint64(response.Staked))
+		require.Equal(genesistest.DefaultValidatorWeight, uint64(response.CurrentStaked))
+		require.Zero(response.PendingStaked)
+
+		staker, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, validator.NodeID())
+		require.NoError(err)
+		require.Equal(
+			[]OwnedStake{
+				{
+					TxID:        validatorTx.ID(),
+					NodeID:      validator.NodeID(),
+					SubnetID:    constants.PrimaryNetworkID,
+					IsValidator: true,
+					AssetID:     service.vm.ctx.AVAXAssetID,
+					Amount:      avajson.Uint64(genesistest.DefaultValidatorWeight),
+					Weight:      avajson.Uint64(genesistest.DefaultValidatorWeight),
+					StartTime:   avajson.Uint64(validator.StartTime().Unix()),
+					EndTime:     avajson.Uint64(validator.EndTime().Unix()),
+
+					PotentialReward: avajson.Uint64(staker.PotentialReward),
+				},
+			},
+			response.Stakers,
+		)
 		require.Len(response.Outputs, 1)
 
 		// Unmarshal into an output
@@ -542,11 +606,12 @@ func TestGetStake(t *testing.T) {
 	require.NoError(err)
 
 	addDelTx := tx.Unsigned.(*txs.AddDelegatorTx)
+	const delegatorPotentialReward = 1234
 	staker, err := state.NewCurrentStaker(
 		tx.ID(),
 		addDelTx,
 		genesistest.DefaultValidatorStartTime,
-		0,
+		delegatorPotentialReward,
 	)
 	require.NoError(err)
 
@@ -561,6 +626,20 @@ func TestGetStake(t *testing.T) {
 	args.Addresses = []string{addr}
 	require.NoError(service.GetStake(nil, &args, &response))
 	require.Equal(oldStake+stakeAmount, uint64(response.Staked))
+	require.Equal(oldStake+stakeAmount, uint64(response.CurrentStaked))
+	require.Zero(response.PendingStaked)
+	require.Len(response.Stakers, 2)
+	require.Contains(response.Stakers, OwnedStake{
+		TxID:            tx.ID(),
+		NodeID:          delegatorNodeID,
+		SubnetID:        constants.PrimaryNetworkID,
+		AssetID:         service.vm.ctx.AVAXAssetID,
+		Amount:          avajson.Uint64(stakeAmount),
+		Weight:          avajson.Uint64(stakeAmount),
+		StartTime:       avajson.Uint64(genesistest.DefaultValidatorStartTimeUnix),
+		EndTime:         avajson.Uint64(delegatorEndTime.Unix()),
+		PotentialReward: delegatorPotentialReward,
+	})
 	require.Len(response.Outputs, 2)
 
 	// Unmarshal into transferable outputs
@@ -612,6 +691,25 @@ func TestGetStake(t *testing.T) {
 	// Make sure the delegator has the right stake (old stake + stakeAmount)
 	require.NoError(service.GetStake(nil, &args, &response))
 	require.Equal(oldStake+stakeAmount, uint64(response.Staked))
+	require.Equal(oldStake, uint64(response.CurrentStaked))
+	require.Equal(stakeAmount, uint64(response.PendingStaked))
+	require.Len(response.Stakers, 3)
+	// Pending stakers are returned after the current stakers.
+	require.Equal(
+		OwnedStake{
+			TxID:        tx.ID(),
+			NodeID:      pendingStakerNodeID,
+			SubnetID:    constants.PrimaryNetworkID,
+			IsValidator: true,
+			IsPending:   true,
+			AssetID:     service.vm.ctx.AVAXAssetID,
+			Amount:      avajson.Uint64(stakeAmount),
+			Weight:      avajson.Uint64(stakeAmount),
+			StartTime:   avajson.Uint64(genesistest.DefaultValidatorStartTimeUnix),
+			EndTime:     avajson.Uint64(pendingStakerEndTime),
+		},
+		response.Stakers[2],
+	)
 	require.Len(response.Outputs, 3)
 
 	// Unmarshal
@@ -773,6 +871,81 @@ func TestGetCurrentValidators(t *testing.T) {
 	}
 }
 
+func TestGetCurrentValidatorsPage(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	genesis := genesistest.New(t, genesistest.Config{})
+
+	args := GetCurrentValidatorsArgs{
+		SubnetID: constants.PrimaryNetworkID,
+		SortBy:   currentValidatorsSortByStake,
+	}
+	var response GetCurrentValidatorsReply
+	require.NoError(service.GetCurrentValidators(nil, &args, &response))
+	require.Len(response.Validators, len(genesis.Validators))
+	require.Equal(avajson.Uint64(len(genesis.Validators)), response.Cursor)
+
+	sorted := response.Validators
+	require.True(slices.IsSortedFunc(sorted, func(a, b any) int {
+		aVdr := a.(pchainapi.PermissionlessValidator)
+		bVdr := b.(pchainapi.PermissionlessValidator)
+		if c := cmp.Compare(bVdr.Weight, aVdr.Weight); c != 0 {
+			return c
+		}
+		return aVdr.NodeID.Compare(bVdr.NodeID)
+	}))
+
+	// Fetching the validators page by page must return the same validators.
+	var paged []any
+	args.PageSize = 2
+	for {
+		response = GetCurrentValidatorsReply{}
+		require.NoError(service.GetCurrentValidators(nil, &args, &response))
+		if len(response.Validators) == 0 {
+			break
+		}
+		require.LessOrEqual(len(response.Validators), 2)
+		paged = append(paged, response.Validators...)
+		args.Cursor = response.Cursor
+	}
+	require.Equal(sorted, paged)
+
+	// Only the selected fields are returned.
+	args = GetCurrentValidatorsArgs{
+		SubnetID: constants.PrimaryNetworkID,
+		Fields:   []string{"nodeID", "weight"},
+		PageSize: 1,
+	}
+	response = GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &response))
+	require.Len(response.Validators, 1)
+	vdr := response.Validators[0].(map[string]json.RawMessage)
+	require.Len(vdr, 2)
+	require.Contains(vdr, "nodeID")
+	require.Contains(vdr, "weight")
+
+	// The delegators of a single validator can be omitted.
+	args = GetCurrentValidatorsArgs{
+		SubnetID:       constants.PrimaryNetworkID,
+		NodeIDs:        []ids.NodeID{genesistest.DefaultNodeIDs[0]},
+		OmitDelegators: true,
+	}
+	response = GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &response))
+	require.Len(response.Validators, 1)
+	permissionlessVdr := response.Validators[0].(pchainapi.PermissionlessValidator)
+	require.Nil(permissionlessVdr.Delegators)
+	require.NotNil(permissionlessVdr.DelegatorCount)
+
+	args = GetCurrentValidatorsArgs{
+		SubnetID: constants.PrimaryNetworkID,
+		SortBy:   "unknown",
+	}
+	err := service.GetCurrentValidators(nil, &args, &response)
+	require.ErrorIs(err, errUnknownSortBy)
+}
+
 func TestGetValidatorsAt(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -866,6 +1039,191 @@ func TestGetValidatorsAt(t *testing.T) {
 	// include the new validator
 	require.NoError(service.GetValidatorsAt(&http.Request{}, &args, &response))
 	require.Len(response.Validators, len(genesis.Validators)+1)
+
+	// Confirm that the validator sets at multiple heights are returned in the
+	// requested order
+	heightsArgs := GetValidatorsAtHeightsArgs{
+		Heights: []avajson.Uint64{
+			avajson.Uint64(lastAcceptedBlk.Height()),
+			avajson.Uint64(newLastAcceptedBlk.Height()),
+			avajson.Uint64(lastAcceptedBlk.Height()),
+		},
+	}
+	heightsResponse := GetValidatorsAtHeightsReply{}
+	require.NoError(service.GetValidatorsAtHeights(&http.Request{}, &heightsArgs, &heightsResponse))
+	require.Len(heightsResponse.ValidatorSets, len(heightsArgs.Heights))
+	for i, expectedLen := range []int{
+		len(genesis.Validators),
+		len(genesis.Validators) + 1,
+		len(genesis.Validators),
+	} {
+		validatorSet := heightsResponse.ValidatorSets[i]
+		require.Equal(heightsArgs.Heights[i], validatorSet.Height)
+		require.Len(validatorSet.Validators.Validators, expectedLen)
+	}
+
+	heightsArgs.Heights = make([]avajson.Uint64, maxGetValidatorsAtHeights+1)
+	err = service.GetValidatorsAtHeights(&http.Request{}, &heightsArgs, &heightsResponse)
+	require.ErrorIs(err, errTooManyHeights)
+}
+
+func TestCheckWarpQuorum(t *testing.T) {
+	service, _ := defaultService(t)
+
+	// Genesis validators do not have BLS keys, so add validators that do.
+	service.vm.ctx.Lock.Lock()
+	rewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	for i := 0; i < 2; i++ {
+		wallet := newWallet(t, service.vm, walletConfig{})
+		sk, err := localsigner.New()
+		require.NoError(t, err)
+		pop, err := signer.NewProofOfPossession(sk)
+		require.NoError(t, err)
+
+		tx, err := wallet.IssueAddPermissionlessValidatorTx(
+			&txs.SubnetValidator{
+				Validator: txs.Validator{
+					NodeID: ids.GenerateTestNodeID(),
+					Start:  uint64(service.vm.clock.Time().Unix()),
+					End:    uint64(service.vm.clock.Time().Add(defaultMinStakingDuration).Unix()),
+					Wght:   service.vm.MinValidatorStake,
+				},
+				Subnet: constants.PrimaryNetworkID,
+			},
+			pop,
+			service.vm.ctx.AVAXAssetID,
+			rewardsOwner,
+			rewardsOwner,
+			0,
+		)
+		require.NoError(t, err)
+
+		service.vm.ctx.Lock.Unlock()
+		require.NoError(t, service.vm.Network.IssueTxFromRPC(tx))
+		service.vm.ctx.Lock.Lock()
+
+		blk, err := service.vm.BuildBlock(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, blk.Verify(context.Background()))
+		require.NoError(t, blk.Accept(context.Background()))
+		require.NoError(t, service.vm.SetPreference(context.Background(), blk.ID()))
+	}
+
+	lastAccepted := service.vm.manager.LastAccepted()
+	lastAcceptedBlk, err := service.vm.manager.GetBlock(lastAccepted)
+	require.NoError(t, err)
+	height := lastAcceptedBlk.Height()
+
+	vdrSet, err := warp.GetCanonicalValidatorSetFromSubnetID(
+		context.Background(),
+		service.vm,
+		height,
+		constants.PrimaryNetworkID,
+	)
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(t, err)
+	require.Len(t, vdrSet.Validators, 2)
+
+	unknownNode := ids.GenerateTestNodeID()
+	genesisNodeID := genesistest.DefaultNodeIDs[0]
+	unknownNodeIDs := []ids.NodeID{unknownNode, genesisNodeID}
+	utils.Sort(unknownNodeIDs)
+	var (
+		signer       = vdrSet.Validators[0]
+		signerWeight = signer.Weight
+	)
+	tests := []struct {
+		name                   string
+		nodeIDs                []ids.NodeID
+		quorumNum              uint64
+		quorumDen              uint64
+		expectedErr            error
+		expectedQuorumMet      bool
+		expectedSigningWeight  uint64
+		expectedMissingSigners int
+		expectedUnknownNodeIDs []ids.NodeID
+	}{
+		{
+			name:                   "no signers",
+			quorumNum:              1,
+			quorumDen:              2,
+			expectedQuorumMet:      false,
+			expectedMissingSigners: len(vdrSet.Validators),
+			expectedUnknownNodeIDs: []ids.NodeID{},
+		},
+		{
+			name:                   "zero quorum",
+			quorumNum:              0,
+			quorumDen:              1,
+			expectedQuorumMet:      true,
+			expectedMissingSigners: len(vdrSet.Validators),
+			expectedUnknownNodeIDs: []ids.NodeID{},
+		},
+		{
+			name:                   "single signer with unknown nodes",
+			nodeIDs:                append([]ids.NodeID{unknownNode, genesisNodeID}, signer.NodeIDs...),
+			quorumNum:              signerWeight,
+			quorumDen:              vdrSet.TotalWeight,
+			expectedQuorumMet:      true,
+			expectedSigningWeight:  signerWeight,
+			expectedMissingSigners: len(vdrSet.Validators) - 1,
+			expectedUnknownNodeIDs: unknownNodeIDs,
+		},
+		{
+			name:                   "single signer below quorum",
+			nodeIDs:                signer.NodeIDs,
+			quorumNum:              signerWeight + 1,
+			quorumDen:              vdrSet.TotalWeight,
+			expectedQuorumMet:      false,
+			expectedSigningWeight:  signerWeight,
+			expectedMissingSigners: len(vdrSet.Validators) - 1,
+			expectedUnknownNodeIDs: []ids.NodeID{},
+		},
+		{
+			name:        "zero denominator",
+			quorumNum:   0,
+			quorumDen:   0,
+			expectedErr: errInvalidQuorum,
+		},
+		{
+			name:        "numerator exceeds denominator",
+			quorumNum:   2,
+			quorumDen:   1,
+			expectedErr: errInvalidQuorum,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			reply := CheckWarpQuorumReply{}
+			err := service.CheckWarpQuorum(
+				&http.Request{},
+				&CheckWarpQuorumArgs{
+					Height:            pchainapi.Height(height),
+					SubnetID:          constants.PrimaryNetworkID,
+					NodeIDs:           test.nodeIDs,
+					QuorumNumerator:   avajson.Uint64(test.quorumNum),
+					QuorumDenominator: avajson.Uint64(test.quorumDen),
+				},
+				&reply,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			require.Equal(avajson.Uint64(height), reply.Height)
+			require.Equal(test.expectedQuorumMet, reply.QuorumMet)
+			require.Equal(avajson.Uint64(test.expectedSigningWeight), reply.SigningWeight)
+			require.Equal(avajson.Uint64(vdrSet.TotalWeight), reply.TotalWeight)
+			require.Len(reply.MissingSigners, test.expectedMissingSigners)
+			require.Equal(test.expectedUnknownNodeIDs, reply.UnknownNodeIDs)
+		})
+	}
 }
 
 func TestGetValidatorsAtArgsMarshalling(t *testing.T) {
@@ -911,6 +1269,320 @@ func TestGetValidatorsAtArgsMarshalling(t *testing.T) {
 	}
 }
 
+func TestGetRewardReportDisabled(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	err := service.GetRewardReport(nil, &GetRewardReportArgs{}, &GetRewardReportReply{})
+	require.ErrorIs(err, report.ErrReportsDisabled)
+}
+
+func TestGetValidatorCapacities(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	args := GetValidatorCapacitiesArgs{}
+	reply := GetValidatorCapacitiesReply{}
+	err := service.GetValidatorCapacities(nil, &args, &reply)
+	require.ErrorIs(err, vdrcapacity.ErrIndexDisabled)
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.validatorCapacities, err = vdrcapacity.NewIndex(
+		service.vm.state,
+		service.vm.uptimeManager,
+		&service.vm.bootstrapped,
+		service.vm.MaxValidatorStake,
+	)
+	service.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	args.PageSize = avajson.Uint64(len(genesistest.DefaultNodeIDs) - 1)
+	require.NoError(service.GetValidatorCapacities(nil, &args, &reply))
+	require.Len(reply.Validators, len(genesistest.DefaultNodeIDs)-1)
+	require.Equal(args.PageSize, reply.Cursor)
+
+	args.Cursor = reply.Cursor
+	require.NoError(service.GetValidatorCapacities(nil, &args, &reply))
+	require.Len(reply.Validators, 1)
+	require.Equal(avajson.Uint64(len(genesistest.DefaultNodeIDs)), reply.Cursor)
+
+	vdr := reply.Validators[0]
+	require.Contains(genesistest.DefaultNodeIDs, vdr.NodeID)
+	require.Equal(avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix), vdr.EndTime)
+	require.Equal(avajson.Uint64(genesistest.DefaultValidatorWeight), vdr.Weight)
+	require.Equal(avajson.Uint64(4*genesistest.DefaultValidatorWeight), vdr.RemainingCapacity)
+	require.Equal(avajson.Float32(100), vdr.DelegationFee)
+
+	args.SortBy = "unknown"
+	err = service.GetValidatorCapacities(nil, &args, &reply)
+	require.ErrorIs(err, vdrcapacity.ErrUnknownSortBy)
+}
+
+func TestGetFeeReport(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	feeCalculator := state.PickFeeCalculator(&service.vm.Internal, service.vm.state)
+	createSubnetFee, err := feeCalculator.CalculateFee(testSubnet1.Unsigned)
+	require.NoError(err)
+
+	// Export AVAX using the same key that created the subnet.
+	wallet := newWallet(t, service.vm, walletConfig{
+		keys: genesistest.DefaultFundedKeys[:1],
+	})
+	exportTx, err := wallet.IssueExportTx(
+		service.vm.ctx.XChainID,
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MilliAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+				},
+			},
+		}},
+	)
+	require.NoError(err)
+	exportFee, err := feeCalculator.CalculateFee(exportTx.Unsigned)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.issueTxFromRPC(exportTx))
+	service.vm.ctx.Lock.Lock()
+	require.NoError(buildAndAcceptStandardBlock(service.vm))
+	service.vm.ctx.Lock.Unlock()
+
+	formatAddr := func(key *secp256k1.PrivateKey) string {
+		addr, err := address.Format("P", constants.UnitTestHRP, key.Address().Bytes())
+		require.NoError(err)
+		return addr
+	}
+
+	args := GetFeeReportArgs{
+		Addresses:   []string{formatAddr(genesistest.DefaultFundedKeys[0])},
+		StartHeight: 1,
+	}
+	reply := GetFeeReportReply{}
+	require.NoError(service.GetFeeReport(nil, &args, &reply))
+	require.Len(reply.Txs, 2)
+
+	for i, expected := range []struct {
+		tx     *txs.Tx
+		height uint64
+		txType string
+		fee    uint64
+	}{
+		{
+			tx:     testSubnet1,
+			height: 1,
+			txType: "CreateSubnetTx",
+			fee:    createSubnetFee,
+		},
+		{
+			tx:     exportTx,
+			height: 2,
+			txType: "ExportTx",
+			fee:    exportFee,
+		},
+	} {
+		complexity, err := txfee.TxComplexity(expected.tx.Unsigned)
+		require.NoError(err)
+		expectedGas, err := complexity.ToGas(service.vm.Internal.DynamicFeeConfig.Weights)
+		require.NoError(err)
+
+		tx := reply.Txs[i]
+		require.Equal(expected.tx.ID(), tx.TxID)
+		require.Equal(avajson.Uint64(expected.height), tx.Height)
+		require.NotZero(tx.Timestamp)
+		require.Equal(expected.txType, tx.Type)
+		require.Equal(avajson.Uint64(expected.fee), tx.Fee)
+		require.Equal(&complexity, tx.Complexity)
+		require.Equal(avajson.Uint64(expectedGas), tx.Gas)
+	}
+
+	// Only the last block is reported.
+	args.StartHeight = 2
+	require.NoError(service.GetFeeReport(nil, &args, &reply))
+	require.Len(reply.Txs, 1)
+	require.Equal(exportTx.ID(), reply.Txs[0].TxID)
+
+	// The other owners of the subnet didn't pay for any transaction.
+	args.Addresses = []string{formatAddr(genesistest.DefaultFundedKeys[1])}
+	args.StartHeight = 0
+	require.NoError(service.GetFeeReport(nil, &args, &reply))
+	require.Empty(reply.Txs)
+
+	args.Addresses = nil
+	err = service.GetFeeReport(nil, &args, &reply)
+	require.ErrorIs(err, errNoAddresses)
+
+	args.Addresses = []string{formatAddr(genesistest.DefaultFundedKeys[0])}
+	args.StartHeight = 2
+	args.EndHeight = 1
+	err = service.GetFeeReport(nil, &args, &reply)
+	require.ErrorIs(err, errInvalidHeightRange)
+}
+
+func TestWriteFeeReportCSV(t *testing.T) {
+	require := require.New(t)
+
+	reportedTxs := []APIFeeReportTx{
+		{
+			TxID:      ids.ID{1},
+			Height:    1,
+			Timestamp: 2,
+			Type:      "BaseTx",
+			Fee:       3,
+			Complexity: &gas.Dimensions{
+				gas.Bandwidth:   4,
+				gas.DBRead:      5,
+				gas.DBWrite:     6,
+				gas.Compute:     7,
+				gas.StateGrowth: 11,
+			},
+			Gas: 8,
+		},
+		{
+			TxID:   ids.ID{2},
+			Height: 9,
+			Type:   "CreateSubnetTx",
+			Fee:    10,
+		},
+	}
+
+	b := &bytes.Buffer{}
+	require.NoError(WriteFeeReportCSV(b, reportedTxs))
+	require.Equal(
+		"txID,height,timestamp,type,fee,bandwidth,dbRead,dbWrite,compute,stateGrowth,gas\n"+
+			ids.ID{1}.String()+",1,2,BaseTx,3,4,5,6,7,11,8\n"+
+			ids.ID{2}.String()+",9,0,CreateSubnetTx,10,,,,,,\n",
+		b.String(),
+	)
+}
+
+func TestGetUTXOProof(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	utxoID := avax.UTXOID{
+		TxID: snowtest.AVAXAssetID,
+	}
+	args := GetUTXOProofArgs{
+		UTXOID: utxoID.InputID(),
+	}
+	reply := GetUTXOProofReply{}
+	err := service.GetUTXOProof(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, state.ErrUTXOProofsDisabled)
+
+	execCfg := config.Default
+	execCfg.IndexUTXOProofs = true
+	service.vm.ctx.Lock.Lock()
+	service.vm.state = statetest.New(t, statetest.Config{
+		Config: execCfg,
+	})
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.GetUTXOProof(&http.Request{}, &args, &reply))
+
+	var pbProof pb.RangeProof
+	require.NoError(proto.Unmarshal(reply.Proof, &pbProof))
+	var proof merkledb.RangeProof
+	require.NoError(proof.UnmarshalProto(&pbProof))
+
+	utxo, err := state.VerifyUTXOProof(context.Background(), &proof, args.UTXOID, reply.Root)
+	require.NoError(err)
+	require.Equal(args.UTXOID, utxo.InputID())
+
+	args.Height = 1
+	err = service.GetUTXOProof(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, state.ErrUTXOProofHeightUnknown)
+}
+
+func TestGetUTXODiff(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	addr, err := service.addrManager.FormatLocalAddress(genesistest.DefaultFundedKeys[0].Address())
+	require.NoError(err)
+
+	// The subnet created by the default VM was accepted at height 1.
+	args := api.GetUTXODiffArgs{
+		Addresses: []string{addr},
+	}
+	reply := api.GetUTXODiffReply{}
+	err = service.GetUTXODiff(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, avax.ErrUTXODiffsDisabled)
+
+	// There are no changes after the last accepted height.
+	args.Height = 1
+	require.NoError(service.GetUTXODiff(&http.Request{}, &args, &reply))
+	require.Empty(reply.Added)
+	require.Empty(reply.Removed)
+	require.Equal(avajson.Uint64(1), reply.Height)
+
+	args.Addresses = nil
+	err = service.GetUTXODiff(&http.Request{}, &args, &reply)
+	require.ErrorIs(err, errNoAddresses)
+}
+
+func TestSimulateTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueBaseTx(
+		[]*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 100 * units.MicroAvax,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs: []ids.ShortID{
+							ids.GenerateTestShortID(),
+						},
+					},
+				},
+			},
+		},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	args := api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}
+
+	reply := SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, &args, &reply))
+	require.True(reply.Valid)
+	require.Empty(reply.Error)
+	require.NotZero(reply.Gas)
+	require.Len(reply.UTXOs, len(tx.UTXOs()))
+
+	// Simulating the tx must not issue it.
+	_, ok := service.vm.Builder.Get(tx.ID())
+	require.False(ok)
+
+	// Once the consumed UTXOs are spent, the tx is invalid.
+	service.vm.ctx.Lock.Lock()
+	for _, in := range tx.Unsigned.InputIDs().List() {
+		service.vm.state.DeleteUTXO(in)
+	}
+	service.vm.ctx.Lock.Unlock()
+
+	reply = SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, &args, &reply))
+	require.False(reply.Valid)
+	require.NotEmpty(reply.Error)
+}
+
 func TestGetTimestamp(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -970,9 +1642,15 @@ func TestGetBlock(t *testing.T) {
 			preferred, err := service.vm.manager.GetBlock(preferredID)
 			require.NoError(err)
 
-			statelessBlock, err := block.NewBanffStandardBlock(
+			preferredState, ok := service.vm.manager.GetState(preferredID)
+			require.True(ok)
+			preferredStateRoot, err := state.GetStateRoot(context.Background(), preferredState)
+			require.NoError(err)
+
+			statelessBlock, err := block.NewFortunaStandardBlock(
 				preferred.Timestamp(),
 				preferred.ID(),
+				preferredStateRoot,
 				preferred.Height()+1,
 				[]*txs.Tx{tx},
 			)
@@ -135,7 +135,9 @@ func getNextL1ValidatorEvictionTime(
 }
 
 // PickFeeCalculator creates either a simple or a dynamic fee calculator,
-// depending on the active upgrade.
+// depending on the active upgrade. If [config.SubnetAuthFeeWeights] is set, the
+// dynamic fee of subnet governance txs is calculated with those weights. State
+// growth isn't charged before Fortuna.
 //
 // PickFeeCalculator does not modify [state].
 func PickFeeCalculator(config *config.Internal, state Chain) txfee.Calculator {
@@ -150,8 +152,19 @@ func PickFeeCalculator(config *config.Internal, state Chain) txfee.Calculator {
 		feeState.Excess,
 		config.DynamicFeeConfig.ExcessConversionConstant,
 	)
-	return txfee.NewDynamicCalculator(
-		config.DynamicFeeConfig.Weights,
+	calculator := txfee.NewDynamicCalculator(
+		config.DynamicFeeWeights(timestamp),
 		gasPrice,
 	)
+	subnetAuthFeeWeights := config.SubnetAuthFeeWeights
+	if subnetAuthFeeWeights == (gas.Dimensions{}) {
+		return calculator
+	}
+	if !config.UpgradeConfig.IsFortunaActivated(timestamp) {
+		subnetAuthFeeWeights[gas.StateGrowth] = 0
+	}
+	return txfee.NewSubnetAuthCalculator(
+		calculator,
+		txfee.NewDynamicCalculator(subnetAuthFeeWeights, gasPrice),
+	)
 }
@@ -210,7 +210,11 @@ func TestGetNextStakerChangeTime(t *testing.T) {
 }
 
 func TestPickFeeCalculator(t *testing.T) {
-	dynamicFeeConfig := genesis.LocalParams.DynamicFeeConfig
+	var (
+		dynamicFeeConfig = genesis.LocalParams.DynamicFeeConfig
+		etnaWeights      = dynamicFeeConfig.Weights
+	)
+	etnaWeights[gas.StateGrowth] = 0
 
 	tests := []struct {
 		fork     upgradetest.Fork
@@ -226,6 +230,13 @@ func TestPickFeeCalculator(t *testing.T) {
 		},
 		{
 			fork: upgradetest.Etna,
+			expected: txfee.NewDynamicCalculator(
+				etnaWeights,
+				dynamicFeeConfig.MinPrice,
+			),
+		},
+		{
+			fork: upgradetest.Fortuna,
 			expected: txfee.NewDynamicCalculator(
 				dynamicFeeConfig.Weights,
 				dynamicFeeConfig.MinPrice,
@@ -246,3 +257,34 @@ func TestPickFeeCalculator(t *testing.T) {
 		})
 	}
 }
+
+func TestPickFeeCalculatorSubnetAuthFeeWeights(t *testing.T) {
+	var (
+		dynamicFeeConfig     = genesis.LocalParams.DynamicFeeConfig
+		subnetAuthFeeWeights = gas.NewDimensions(
+			gas.WithBandwidth(1),
+			gas.WithDBRead(1),
+			gas.WithDBWrite(1),
+			gas.WithCompute(1),
+			gas.WithStateGrowth(1),
+		)
+		config = &config.Internal{
+			DynamicFeeConfig:     dynamicFeeConfig,
+			SubnetAuthFeeWeights: subnetAuthFeeWeights,
+			UpgradeConfig:        upgradetest.GetConfig(upgradetest.Fortuna),
+		}
+		s = newTestState(t, memdb.New())
+	)
+	expected := txfee.NewSubnetAuthCalculator(
+		txfee.NewDynamicCalculator(
+			dynamicFeeConfig.Weights,
+			dynamicFeeConfig.MinPrice,
+		),
+		txfee.NewDynamicCalculator(
+			subnetAuthFeeWeights,
+			dynamicFeeConfig.MinPrice,
+		),
+	)
+	actual := PickFeeCalculator(config, s)
+	require.Equal(t, expected, actual)
+}
@@ -6,11 +6,13 @@ package state
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/iterator"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
@@ -39,6 +41,7 @@ type diff struct {
 	feeState                    gas.State
 	l1ValidatorExcess           gas.Gas
 	accruedFees                 uint64
+	stakingConfig               StakingConfig
 	parentNumActiveL1Validators int
 
 	// Subnet ID --> supply of native asset of the subnet
@@ -60,11 +63,16 @@ type diff struct {
 	// Subnet ID --> Tx that transforms the subnet
 	transformedSubnets map[ids.ID]*txs.Tx
 
-	addedChains map[ids.ID][]*txs.Tx
+	addedChains   map[ids.ID][]*txs.Tx
+	retiredChains set.Set[ids.ID]
 
 	addedRewardUTXOs map[ids.ID][]*avax.UTXO
+	// Validator txID --> Withdrawal owner of the validator
+	withdrawalOwners map[ids.ID]fx.Owner
 
 	addedTxs map[ids.ID]*txAndStatus
+	// map of delegationID -> txID
+	addedMultiDelegations map[ids.ID]ids.ID
 
 	// map of modified UTXOID -> *UTXO if the UTXO is nil, it has been removed
 	modifiedUTXOs map[ids.ID]*avax.UTXO
@@ -85,6 +93,7 @@ func NewDiff(
 		feeState:                    parentState.GetFeeState(),
 		l1ValidatorExcess:           parentState.GetL1ValidatorExcess(),
 		accruedFees:                 parentState.GetAccruedFees(),
+		stakingConfig:               parentState.GetStakingConfig(),
 		parentNumActiveL1Validators: parentState.NumActiveL1Validators(),
 		expiryDiff:                  newExpiryDiff(),
 		l1ValidatorsDiff:            newL1ValidatorsDiff(),
@@ -139,6 +148,14 @@ func (d *diff) SetAccruedFees(accruedFees uint64) {
 	d.accruedFees = accruedFees
 }
 
+func (d *diff) GetStakingParams() StakingParams {
+	return d.stakingConfig.At(d.timestamp)
+}
+
+func (d *diff) GetStakingConfig() StakingConfig {
+	return d.stakingConfig
+}
+
 func (d *diff) GetCurrentSupply(subnetID ids.ID) (uint64, error) {
 	supply, ok := d.currentSupply[subnetID]
 	if ok {
@@ -267,7 +284,7 @@ func (d *diff) GetCurrentValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker,
 	// validator.
 	newValidator, status := d.currentStakerDiffs.GetValidator(subnetID, nodeID)
 	switch status {
-	case added:
+	case added, modified:
 		return newValidator, nil
 	case deleted:
 		return nil, database.ErrNotFound
@@ -314,6 +331,14 @@ func (d *diff) DeleteCurrentValidator(staker *Staker) {
 	d.currentStakerDiffs.DeleteValidator(staker)
 }
 
+func (d *diff) UpdateCurrentValidator(staker *Staker) error {
+	prevStaker, err := d.GetCurrentValidator(staker.SubnetID, staker.NodeID)
+	if err != nil {
+		return err
+	}
+	return d.currentStakerDiffs.UpdateValidator(prevStaker, staker)
+}
+
 func (d *diff) GetCurrentDelegatorIterator(subnetID ids.ID, nodeID ids.NodeID) (iterator.Iterator[*Staker], error) {
 	parentState, ok := d.stateVersions.GetState(d.parentID)
 	if !ok {
@@ -488,6 +513,23 @@ func (d *diff) AddChain(createChainTx *txs.Tx) {
 	}
 }
 
+func (d *diff) IsChainRetired(chainID ids.ID) (bool, error) {
+	if d.retiredChains.Contains(chainID) {
+		return true, nil
+	}
+
+	// If the chain was not retired in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return false, ErrMissingParentState
+	}
+	return parentState.IsChainRetired(chainID)
+}
+
+func (d *diff) RetireChain(chainID ids.ID) {
+	d.retiredChains.Add(chainID)
+}
+
 func (d *diff) GetTx(txID ids.ID) (*txs.Tx, status.Status, error) {
 	if tx, exists := d.addedTxs[txID]; exists {
 		return tx.tx, tx.status, nil
@@ -513,6 +555,44 @@ func (d *diff) AddTx(tx *txs.Tx, status status.Status) {
 	} else {
 		d.addedTxs[txID] = txStatus
 	}
+
+	multiDelegatorTx, ok := txs.Unwrap(tx.Unsigned).(*txs.AddMultiDelegatorTx)
+	if !ok {
+		return
+	}
+	if d.addedMultiDelegations == nil {
+		d.addedMultiDelegations = make(map[ids.ID]ids.ID, len(multiDelegatorTx.Delegations))
+	}
+	for i := range multiDelegatorTx.Delegations {
+		d.addedMultiDelegations[txs.DelegationID(txID, i)] = txID
+	}
+}
+
+func (d *diff) GetStakerTx(stakerID ids.ID) (txs.Staker, error) {
+	if tx, exists := d.addedTxs[stakerID]; exists {
+		return getStaker(tx.tx, stakerID)
+	}
+	if txID, exists := d.addedMultiDelegations[stakerID]; exists {
+		return getStaker(d.addedTxs[txID].tx, stakerID)
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetStakerTx(stakerID)
+}
+
+func (d *diff) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	utxos, err := parentState.GetRewardUTXOs(txID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Concat(utxos, d.addedRewardUTXOs[txID]), nil
 }
 
 func (d *diff) AddRewardUTXO(txID ids.ID, utxo *avax.UTXO) {
@@ -522,6 +602,26 @@ func (d *diff) AddRewardUTXO(txID ids.ID, utxo *avax.UTXO) {
 	d.addedRewardUTXOs[txID] = append(d.addedRewardUTXOs[txID], utxo)
 }
 
+func (d *diff) GetWithdrawalOwner(txID ids.ID) (fx.Owner, error) {
+	if owner, exists := d.withdrawalOwners[txID]; exists {
+		return owner, nil
+	}
+
+	// If the withdrawal owner was not set in this diff, ask the parent state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, ErrMissingParentState
+	}
+	return parentState.GetWithdrawalOwner(txID)
+}
+
+func (d *diff) SetWithdrawalOwner(txID ids.ID, owner fx.Owner) {
+	if d.withdrawalOwners == nil {
+		d.withdrawalOwners = make(map[ids.ID]fx.Owner)
+	}
+	d.withdrawalOwners[txID] = owner
+}
+
 func (d *diff) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
 	utxo, modified := d.modifiedUTXOs[utxoID]
 	if !modified {
@@ -599,8 +699,20 @@ func (d *diff) Apply(baseState Chain) error {
 				if err := baseState.PutCurrentValidator(validatorDiff.validator); err != nil {
 					return err
 				}
+				if !validatorDiff.updated {
+					break
+				}
+				// The validator was modified after being added, so the
+				// modifications must be persisted separately from its tx.
+				if err := baseState.UpdateCurrentValidator(validatorDiff.validator); err != nil {
+					return err
+				}
 			case deleted:
 				baseState.DeleteCurrentValidator(validatorDiff.validator)
+			case modified:
+				if err := baseState.UpdateCurrentValidator(validatorDiff.validator); err != nil {
+					return err
+				}
 			}
 
 			addedDelegatorIterator := iterator.FromTree(validatorDiff.addedDelegators)
@@ -654,6 +766,9 @@ func (d *diff) Apply(baseState Chain) error {
 			baseState.AddChain(chain)
 		}
 	}
+	for chainID := range d.retiredChains {
+		baseState.RetireChain(chainID)
+	}
 	for _, tx := range d.addedTxs {
 		baseState.AddTx(tx.tx, tx.status)
 	}
@@ -662,6 +777,9 @@ func (d *diff) Apply(baseState Chain) error {
 			baseState.AddRewardUTXO(txID, utxo)
 		}
 	}
+	for txID, owner := range d.withdrawalOwners {
+		baseState.SetWithdrawalOwner(txID, owner)
+	}
 	for utxoID, utxo := range d.modifiedUTXOs {
 		if utxo != nil {
 			baseState.AddUTXO(utxo)
@@ -386,6 +386,7 @@ func TestDiffCurrentValidator(t *testing.T) {
 	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	state.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(1)
 	state.EXPECT().GetAccruedFees().Return(uint64(0)).Times(1)
+	state.EXPECT().GetStakingConfig().Return(StakingConfig{}).Times(1)
 	state.EXPECT().NumActiveL1Validators().Return(0).Times(1)
 
 	d, err := NewDiffOn(state)
@@ -423,6 +424,7 @@ func TestDiffPendingValidator(t *testing.T) {
 	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	state.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(1)
 	state.EXPECT().GetAccruedFees().Return(uint64(0)).Times(1)
+	state.EXPECT().GetStakingConfig().Return(StakingConfig{}).Times(1)
 	state.EXPECT().NumActiveL1Validators().Return(0).Times(1)
 
 	d, err := NewDiffOn(state)
@@ -466,6 +468,7 @@ func TestDiffCurrentDelegator(t *testing.T) {
 	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	state.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(1)
 	state.EXPECT().GetAccruedFees().Return(uint64(0)).Times(1)
+	state.EXPECT().GetStakingConfig().Return(StakingConfig{}).Times(1)
 	state.EXPECT().NumActiveL1Validators().Return(0).Times(1)
 
 	d, err := NewDiffOn(state)
@@ -512,6 +515,7 @@ func TestDiffPendingDelegator(t *testing.T) {
 	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	state.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(1)
 	state.EXPECT().GetAccruedFees().Return(uint64(0)).Times(1)
+	state.EXPECT().GetStakingConfig().Return(StakingConfig{}).Times(1)
 	state.EXPECT().NumActiveL1Validators().Return(0).Times(1)
 
 	d, err := NewDiffOn(state)
@@ -652,6 +656,7 @@ func TestDiffTx(t *testing.T) {
 	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	state.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(1)
 	state.EXPECT().GetAccruedFees().Return(uint64(0)).Times(1)
+	state.EXPECT().GetStakingConfig().Return(StakingConfig{}).Times(1)
 	state.EXPECT().NumActiveL1Validators().Return(0).Times(1)
 
 	d, err := NewDiffOn(state)
@@ -727,6 +732,17 @@ func TestDiffRewardUTXO(t *testing.T) {
 	}
 	diff.AddRewardUTXO(txID, rewardUTXO)
 
+	// Verify diff returns the reward UTXOs of both the parent and the diff
+	rewardUTXOs, err = diff.GetRewardUTXOs(txID)
+	require.NoError(err)
+	require.Equal(
+		[]*avax.UTXO{
+			parentRewardUTXO,
+			rewardUTXO,
+		},
+		rewardUTXOs,
+	)
+
 	// Apply diff to parent state
 	require.NoError(diff.Apply(state))
 
@@ -752,6 +768,7 @@ func TestDiffUTXO(t *testing.T) {
 	state.EXPECT().GetFeeState().Return(gas.State{}).Times(1)
 	state.EXPECT().GetL1ValidatorExcess().Return(gas.Gas(0)).Times(1)
 	state.EXPECT().GetAccruedFees().Return(uint64(0)).Times(1)
+	state.EXPECT().GetStakingConfig().Return(StakingConfig{}).Times(1)
 	state.EXPECT().NumActiveL1Validators().Return(0).Times(1)
 
 	d, err := NewDiffOn(state)
@@ -0,0 +1,62 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/codectest"
+)
+
+// TestGoldenValidatorMetadataSerialization ensures that the serialization of
+// validator metadata doesn't change unexpectedly with any of the codec
+// versions. Metadata written by previous versions must keep being parsed.
+//
+// To regenerate the golden files after an intentional change, run:
+//
+//	UPDATE_GOLDENS=1 go test ./vms/platformvm/state -run TestGoldenValidatorMetadataSerialization
+func TestGoldenValidatorMetadataSerialization(t *testing.T) {
+	tests := []struct {
+		codecVersion uint16
+		tagNames     []string
+	}{
+		{
+			codecVersion: CodecVersion0,
+			tagNames:     []string{CodecVersion0Tag},
+		},
+		{
+			codecVersion: CodecVersion1,
+			tagNames:     []string{CodecVersion0Tag, CodecVersion1Tag},
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("v%d", test.codecVersion), func(t *testing.T) {
+			require := require.New(t)
+
+			// Only the fields serialized by the codec version are populated,
+			// so that the metadata is unchanged after being parsed.
+			populator := codectest.NewPopulatorWithTags(test.tagNames, nil)
+			metadata := &validatorMetadata{}
+			require.NoError(populator.Populate(metadata))
+
+			bytes, err := MetadataCodec.Marshal(test.codecVersion, metadata)
+			require.NoError(err)
+			codectest.RequireGolden(
+				t,
+				filepath.Join("testdata", fmt.Sprintf("v%d", test.codecVersion), "validatorMetadata.hex"),
+				bytes,
+			)
+
+			parsedMetadata := &validatorMetadata{}
+			codecVersion, err := MetadataCodec.Unmarshal(bytes, parsedMetadata)
+			require.NoError(err)
+			require.Equal(test.codecVersion, codecVersion)
+			require.Equal(metadata, parsedMetadata)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// continuousValidatorMetadata records the fields of a continuous validator
+// that may diverge from its AddContinuousValidatorTx after it has been renewed
+// or stopped.
+type continuousValidatorMetadata struct {
+	Weight             uint64 `v0:"true"`
+	EndTime            uint64 `v0:"true"` // Unix time in seconds
+	ContinuationPeriod uint64 `v0:"true"` // Duration in seconds
+}
+
+func getContinuousValidatorMetadata(db database.KeyValueReader, txID ids.ID) (*continuousValidatorMetadata, error) {
+	metadataBytes, err := db.Get(txID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &continuousValidatorMetadata{}
+	if _, err := MetadataCodec.Unmarshal(metadataBytes, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func putContinuousValidatorMetadata(db database.KeyValueWriter, staker *Staker) error {
+	metadata := &continuousValidatorMetadata{
+		Weight:             staker.Weight,
+		EndTime:            uint64(staker.EndTime.Unix()),
+		ContinuationPeriod: uint64(staker.ContinuationPeriod / time.Second),
+	}
+	metadataBytes, err := MetadataCodec.Marshal(CodecVersion0, metadata)
+	if err != nil {
+		return err
+	}
+	return db.Put(staker.TxID[:], metadataBytes)
+}
@@ -121,6 +121,18 @@ type validatorState interface {
 		amount uint64,
 	) error
 
+	// SetStakingPeriod starts a new staking period of [vdrID] on [subnetID]
+	// at [startTime] with [potentialReward]. The uptime measurements are reset
+	// to the start of the new period. If the staking period already started at
+	// [startTime], this is a noop. Unless these measurements are deleted first,
+	// the next call to WriteUptimes will write this update to disk.
+	SetStakingPeriod(
+		vdrID ids.NodeID,
+		subnetID ids.ID,
+		startTime time.Time,
+		potentialReward uint64,
+	) error
+
 	// DeleteValidatorMetadata removes in-memory references to the metadata of
 	// [vdrID] on [subnetID]. If there were staged updates from a prior call to
 	// SetUptime or SetDelegateeReward, the updates will be dropped. This call
@@ -216,6 +228,29 @@ func (m *metadata) SetDelegateeReward(
 	return nil
 }
 
+func (m *metadata) SetStakingPeriod(
+	vdrID ids.NodeID,
+	subnetID ids.ID,
+	startTime time.Time,
+	potentialReward uint64,
+) error {
+	metadata, exists := m.metadata[vdrID][subnetID]
+	if !exists {
+		return database.ErrNotFound
+	}
+	startTimeUnix := uint64(startTime.Unix())
+	if metadata.StakerStartTime == startTimeUnix {
+		return nil
+	}
+	metadata.UpDuration = 0
+	metadata.lastUpdated = startTime
+	metadata.StakerStartTime = startTimeUnix
+	metadata.PotentialReward = potentialReward
+
+	m.addUpdatedMetadata(vdrID, subnetID)
+	return nil
+}
+
 func (m *metadata) DeleteValidatorMetadata(vdrID ids.NodeID, subnetID ids.ID) {
 	subnetMetadata := m.metadata[vdrID]
 	delete(subnetMetadata, subnetID)
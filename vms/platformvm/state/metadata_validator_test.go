@@ -171,6 +171,50 @@ func TestValidatorDelegateeRewards(t *testing.T) {
 	require.ErrorIs(err, database.ErrNotFound)
 }
 
+func TestValidatorStakingPeriod(t *testing.T) {
+	require := require.New(t)
+	state := newValidatorState()
+
+	// set non-existent staking period
+	nodeID := ids.GenerateTestNodeID()
+	subnetID := ids.GenerateTestID()
+	startTime := time.Unix(1000, 0)
+	err := state.SetStakingPeriod(nodeID, subnetID, startTime, 1)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	testMetadata := &validatorMetadata{
+		UpDuration:               time.Hour,
+		lastUpdated:              startTime.Add(time.Hour),
+		StakerStartTime:          uint64(startTime.Unix()),
+		PotentialReward:          1,
+		PotentialDelegateeReward: 100000,
+	}
+	state.LoadValidatorMetadata(nodeID, subnetID, testMetadata)
+
+	// setting the current staking period is a noop
+	require.NoError(state.SetStakingPeriod(nodeID, subnetID, startTime, 2))
+	upDuration, lastUpdated, err := state.GetUptime(nodeID, subnetID)
+	require.NoError(err)
+	require.Equal(time.Hour, upDuration)
+	require.Equal(startTime.Add(time.Hour), lastUpdated)
+	require.Equal(uint64(1), testMetadata.PotentialReward)
+
+	// set a new staking period
+	newStartTime := startTime.Add(24 * time.Hour)
+	require.NoError(state.SetStakingPeriod(nodeID, subnetID, newStartTime, 2))
+	upDuration, lastUpdated, err = state.GetUptime(nodeID, subnetID)
+	require.NoError(err)
+	require.Zero(upDuration)
+	require.Equal(newStartTime, lastUpdated)
+	require.Equal(uint64(newStartTime.Unix()), testMetadata.StakerStartTime)
+	require.Equal(uint64(2), testMetadata.PotentialReward)
+
+	// the accrued delegatee reward is kept
+	delegateeReward, err := state.GetDelegateeReward(subnetID, nodeID)
+	require.NoError(err)
+	require.Equal(uint64(100000), delegateeReward)
+}
+
 func TestParseValidatorMetadata(t *testing.T) {
 	type test struct {
 		name        string
@@ -398,6 +398,64 @@ func (mr *MockChainMockRecorder) GetPendingValidator(subnetID, nodeID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingValidator", reflect.TypeOf((*MockChain)(nil).GetPendingValidator), subnetID, nodeID)
 }
 
+// GetRewardUTXOs mocks base method.
+func (m *MockChain) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardUTXOs", txID)
+	ret0, _ := ret[0].([]*avax.UTXO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardUTXOs indicates an expected call of GetRewardUTXOs.
+func (mr *MockChainMockRecorder) GetRewardUTXOs(txID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardUTXOs", reflect.TypeOf((*MockChain)(nil).GetRewardUTXOs), txID)
+}
+
+// GetStakerTx mocks base method.
+func (m *MockChain) GetStakerTx(stakerID ids.ID) (txs.Staker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakerTx", stakerID)
+	ret0, _ := ret[0].(txs.Staker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakerTx indicates an expected call of GetStakerTx.
+func (mr *MockChainMockRecorder) GetStakerTx(stakerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerTx", reflect.TypeOf((*MockChain)(nil).GetStakerTx), stakerID)
+}
+
+// GetStakingConfig mocks base method.
+func (m *MockChain) GetStakingConfig() StakingConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakingConfig")
+	ret0, _ := ret[0].(StakingConfig)
+	return ret0
+}
+
+// GetStakingConfig indicates an expected call of GetStakingConfig.
+func (mr *MockChainMockRecorder) GetStakingConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingConfig", reflect.TypeOf((*MockChain)(nil).GetStakingConfig))
+}
+
+// GetStakingParams mocks base method.
+func (m *MockChain) GetStakingParams() StakingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakingParams")
+	ret0, _ := ret[0].(StakingParams)
+	return ret0
+}
+
+// GetStakingParams indicates an expected call of GetStakingParams.
+func (mr *MockChainMockRecorder) GetStakingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingParams", reflect.TypeOf((*MockChain)(nil).GetStakingParams))
+}
+
 // GetSubnetOwner mocks base method.
 func (m *MockChain) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
 	m.ctrl.T.Helper()
@@ -488,6 +546,21 @@ func (mr *MockChainMockRecorder) GetUTXO(utxoID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockChain)(nil).GetUTXO), utxoID)
 }
 
+// GetWithdrawalOwner mocks base method.
+func (m *MockChain) GetWithdrawalOwner(txID ids.ID) (fx.Owner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalOwner", txID)
+	ret0, _ := ret[0].(fx.Owner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalOwner indicates an expected call of GetWithdrawalOwner.
+func (mr *MockChainMockRecorder) GetWithdrawalOwner(txID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalOwner", reflect.TypeOf((*MockChain)(nil).GetWithdrawalOwner), txID)
+}
+
 // HasExpiry mocks base method.
 func (m *MockChain) HasExpiry(arg0 ExpiryEntry) (bool, error) {
 	m.ctrl.T.Helper()
@@ -518,6 +591,21 @@ func (mr *MockChainMockRecorder) HasL1Validator(subnetID, nodeID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasL1Validator", reflect.TypeOf((*MockChain)(nil).HasL1Validator), subnetID, nodeID)
 }
 
+// IsChainRetired mocks base method.
+func (m *MockChain) IsChainRetired(chainID ids.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsChainRetired", chainID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsChainRetired indicates an expected call of IsChainRetired.
+func (mr *MockChainMockRecorder) IsChainRetired(chainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChainRetired", reflect.TypeOf((*MockChain)(nil).IsChainRetired), chainID)
+}
+
 // NumActiveL1Validators mocks base method.
 func (m *MockChain) NumActiveL1Validators() int {
 	m.ctrl.T.Helper()
@@ -610,6 +698,18 @@ func (mr *MockChainMockRecorder) PutPendingValidator(staker any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPendingValidator", reflect.TypeOf((*MockChain)(nil).PutPendingValidator), staker)
 }
 
+// RetireChain mocks base method.
+func (m *MockChain) RetireChain(chainID ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RetireChain", chainID)
+}
+
+// RetireChain indicates an expected call of RetireChain.
+func (mr *MockChainMockRecorder) RetireChain(chainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireChain", reflect.TypeOf((*MockChain)(nil).RetireChain), chainID)
+}
+
 // SetAccruedFees mocks base method.
 func (m *MockChain) SetAccruedFees(f uint64) {
 	m.ctrl.T.Helper()
@@ -708,6 +808,32 @@ func (mr *MockChainMockRecorder) SetTimestamp(tm any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimestamp", reflect.TypeOf((*MockChain)(nil).SetTimestamp), tm)
 }
 
+// SetWithdrawalOwner mocks base method.
+func (m *MockChain) SetWithdrawalOwner(txID ids.ID, owner fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWithdrawalOwner", txID, owner)
+}
+
+// SetWithdrawalOwner indicates an expected call of SetWithdrawalOwner.
+func (mr *MockChainMockRecorder) SetWithdrawalOwner(txID, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithdrawalOwner", reflect.TypeOf((*MockChain)(nil).SetWithdrawalOwner), txID, owner)
+}
+
+// UpdateCurrentValidator mocks base method.
+func (m *MockChain) UpdateCurrentValidator(staker *Staker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCurrentValidator", staker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCurrentValidator indicates an expected call of UpdateCurrentValidator.
+func (mr *MockChainMockRecorder) UpdateCurrentValidator(staker any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentValidator", reflect.TypeOf((*MockChain)(nil).UpdateCurrentValidator), staker)
+}
+
 // WeightOfL1Validators mocks base method.
 func (m *MockChain) WeightOfL1Validators(subnetID ids.ID) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +412,64 @@ func (mr *MockDiffMockRecorder) GetPendingValidator(subnetID, nodeID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingValidator", reflect.TypeOf((*MockDiff)(nil).GetPendingValidator), subnetID, nodeID)
 }
 
+// GetRewardUTXOs mocks base method.
+func (m *MockDiff) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardUTXOs", txID)
+	ret0, _ := ret[0].([]*avax.UTXO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardUTXOs indicates an expected call of GetRewardUTXOs.
+func (mr *MockDiffMockRecorder) GetRewardUTXOs(txID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardUTXOs", reflect.TypeOf((*MockDiff)(nil).GetRewardUTXOs), txID)
+}
+
+// GetStakerTx mocks base method.
+func (m *MockDiff) GetStakerTx(stakerID ids.ID) (txs.Staker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakerTx", stakerID)
+	ret0, _ := ret[0].(txs.Staker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakerTx indicates an expected call of GetStakerTx.
+func (mr *MockDiffMockRecorder) GetStakerTx(stakerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerTx", reflect.TypeOf((*MockDiff)(nil).GetStakerTx), stakerID)
+}
+
+// GetStakingConfig mocks base method.
+func (m *MockDiff) GetStakingConfig() StakingConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakingConfig")
+	ret0, _ := ret[0].(StakingConfig)
+	return ret0
+}
+
+// GetStakingConfig indicates an expected call of GetStakingConfig.
+func (mr *MockDiffMockRecorder) GetStakingConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingConfig", reflect.TypeOf((*MockDiff)(nil).GetStakingConfig))
+}
+
+// GetStakingParams mocks base method.
+func (m *MockDiff) GetStakingParams() StakingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakingParams")
+	ret0, _ := ret[0].(StakingParams)
+	return ret0
+}
+
+// GetStakingParams indicates an expected call of GetStakingParams.
+func (mr *MockDiffMockRecorder) GetStakingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingParams", reflect.TypeOf((*MockDiff)(nil).GetStakingParams))
+}
+
 // GetSubnetOwner mocks base method.
 func (m *MockDiff) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
 	m.ctrl.T.Helper()
@@ -502,6 +560,21 @@ func (mr *MockDiffMockRecorder) GetUTXO(utxoID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockDiff)(nil).GetUTXO), utxoID)
 }
 
+// GetWithdrawalOwner mocks base method.
+func (m *MockDiff) GetWithdrawalOwner(txID ids.ID) (fx.Owner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalOwner", txID)
+	ret0, _ := ret[0].(fx.Owner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalOwner indicates an expected call of GetWithdrawalOwner.
+func (mr *MockDiffMockRecorder) GetWithdrawalOwner(txID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalOwner", reflect.TypeOf((*MockDiff)(nil).GetWithdrawalOwner), txID)
+}
+
 // HasExpiry mocks base method.
 func (m *MockDiff) HasExpiry(arg0 ExpiryEntry) (bool, error) {
 	m.ctrl.T.Helper()
@@ -532,6 +605,21 @@ func (mr *MockDiffMockRecorder) HasL1Validator(subnetID, nodeID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasL1Validator", reflect.TypeOf((*MockDiff)(nil).HasL1Validator), subnetID, nodeID)
 }
 
+// IsChainRetired mocks base method.
+func (m *MockDiff) IsChainRetired(chainID ids.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsChainRetired", chainID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsChainRetired indicates an expected call of IsChainRetired.
+func (mr *MockDiffMockRecorder) IsChainRetired(chainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChainRetired", reflect.TypeOf((*MockDiff)(nil).IsChainRetired), chainID)
+}
+
 // NumActiveL1Validators mocks base method.
 func (m *MockDiff) NumActiveL1Validators() int {
 	m.ctrl.T.Helper()
@@ -624,6 +712,18 @@ func (mr *MockDiffMockRecorder) PutPendingValidator(staker any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPendingValidator", reflect.TypeOf((*MockDiff)(nil).PutPendingValidator), staker)
 }
 
+// RetireChain mocks base method.
+func (m *MockDiff) RetireChain(chainID ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RetireChain", chainID)
+}
+
+// RetireChain indicates an expected call of RetireChain.
+func (mr *MockDiffMockRecorder) RetireChain(chainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireChain", reflect.TypeOf((*MockDiff)(nil).RetireChain), chainID)
+}
+
 // SetAccruedFees mocks base method.
 func (m *MockDiff) SetAccruedFees(f uint64) {
 	m.ctrl.T.Helper()
@@ -722,6 +822,32 @@ func (mr *MockDiffMockRecorder) SetTimestamp(tm any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimestamp", reflect.TypeOf((*MockDiff)(nil).SetTimestamp), tm)
 }
 
+// SetWithdrawalOwner mocks base method.
+func (m *MockDiff) SetWithdrawalOwner(txID ids.ID, owner fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWithdrawalOwner", txID, owner)
+}
+
+// SetWithdrawalOwner indicates an expected call of SetWithdrawalOwner.
+func (mr *MockDiffMockRecorder) SetWithdrawalOwner(txID, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithdrawalOwner", reflect.TypeOf((*MockDiff)(nil).SetWithdrawalOwner), txID, owner)
+}
+
+// UpdateCurrentValidator mocks base method.
+func (m *MockDiff) UpdateCurrentValidator(staker *Staker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCurrentValidator", staker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCurrentValidator indicates an expected call of UpdateCurrentValidator.
+func (mr *MockDiffMockRecorder) UpdateCurrentValidator(staker any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentValidator", reflect.TypeOf((*MockDiff)(nil).UpdateCurrentValidator), staker)
+}
+
 // WeightOfL1Validators mocks base method.
 func (m *MockDiff) WeightOfL1Validators(subnetID ids.ID) (uint64, error) {
 	m.ctrl.T.Helper()
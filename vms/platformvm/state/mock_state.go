@@ -26,6 +26,7 @@ import (
 	fx "github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
 	txs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	merkledb "github.com/ava-labs/avalanchego/x/merkledb"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -574,6 +575,20 @@ func (mr *MockStateMockRecorder) GetPendingValidator(subnetID, nodeID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingValidator", reflect.TypeOf((*MockState)(nil).GetPendingValidator), subnetID, nodeID)
 }
 
+// GetPrunedValidatorDiffsHeight mocks base method.
+func (m *MockState) GetPrunedValidatorDiffsHeight() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrunedValidatorDiffsHeight")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetPrunedValidatorDiffsHeight indicates an expected call of GetPrunedValidatorDiffsHeight.
+func (mr *MockStateMockRecorder) GetPrunedValidatorDiffsHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrunedValidatorDiffsHeight", reflect.TypeOf((*MockState)(nil).GetPrunedValidatorDiffsHeight))
+}
+
 // GetRewardUTXOs mocks base method.
 func (m *MockState) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
 	m.ctrl.T.Helper()
@@ -589,6 +604,64 @@ func (mr *MockStateMockRecorder) GetRewardUTXOs(txID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardUTXOs", reflect.TypeOf((*MockState)(nil).GetRewardUTXOs), txID)
 }
 
+// GetRewardedStakers mocks base method.
+func (m *MockState) GetRewardedStakers(start, end time.Time) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardedStakers", start, end)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardedStakers indicates an expected call of GetRewardedStakers.
+func (mr *MockStateMockRecorder) GetRewardedStakers(start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardedStakers", reflect.TypeOf((*MockState)(nil).GetRewardedStakers), start, end)
+}
+
+// GetStakerTx mocks base method.
+func (m *MockState) GetStakerTx(stakerID ids.ID) (txs.Staker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakerTx", stakerID)
+	ret0, _ := ret[0].(txs.Staker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakerTx indicates an expected call of GetStakerTx.
+func (mr *MockStateMockRecorder) GetStakerTx(stakerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerTx", reflect.TypeOf((*MockState)(nil).GetStakerTx), stakerID)
+}
+
+// GetStakingConfig mocks base method.
+func (m *MockState) GetStakingConfig() StakingConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakingConfig")
+	ret0, _ := ret[0].(StakingConfig)
+	return ret0
+}
+
+// GetStakingConfig indicates an expected call of GetStakingConfig.
+func (mr *MockStateMockRecorder) GetStakingConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingConfig", reflect.TypeOf((*MockState)(nil).GetStakingConfig))
+}
+
+// GetStakingParams mocks base method.
+func (m *MockState) GetStakingParams() StakingParams {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakingParams")
+	ret0, _ := ret[0].(StakingParams)
+	return ret0
+}
+
+// GetStakingParams indicates an expected call of GetStakingParams.
+func (mr *MockStateMockRecorder) GetStakingParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingParams", reflect.TypeOf((*MockState)(nil).GetStakingParams))
+}
+
 // GetStartTime mocks base method.
 func (m *MockState) GetStartTime(nodeID ids.NodeID) (time.Time, error) {
 	m.ctrl.T.Helper()
@@ -693,6 +766,20 @@ func (mr *MockStateMockRecorder) GetTimestamp() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimestamp", reflect.TypeOf((*MockState)(nil).GetTimestamp))
 }
 
+// GetTrackingStoppedAt mocks base method.
+func (m *MockState) GetTrackingStoppedAt() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackingStoppedAt")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// GetTrackingStoppedAt indicates an expected call of GetTrackingStoppedAt.
+func (mr *MockStateMockRecorder) GetTrackingStoppedAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackingStoppedAt", reflect.TypeOf((*MockState)(nil).GetTrackingStoppedAt))
+}
+
 // GetTx mocks base method.
 func (m *MockState) GetTx(txID ids.ID) (*txs.Tx, status.Status, error) {
 	m.ctrl.T.Helper()
@@ -724,6 +811,52 @@ func (mr *MockStateMockRecorder) GetUTXO(utxoID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockState)(nil).GetUTXO), utxoID)
 }
 
+// GetUTXODiff mocks base method.
+func (m *MockState) GetUTXODiff(height uint64) (*avax.UTXODiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXODiff", height)
+	ret0, _ := ret[0].(*avax.UTXODiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXODiff indicates an expected call of GetUTXODiff.
+func (mr *MockStateMockRecorder) GetUTXODiff(height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXODiff", reflect.TypeOf((*MockState)(nil).GetUTXODiff), height)
+}
+
+// GetUTXOProof mocks base method.
+func (m *MockState) GetUTXOProof(ctx context.Context, utxoID ids.ID, height uint64) (*merkledb.RangeProof, ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOProof", ctx, utxoID, height)
+	ret0, _ := ret[0].(*merkledb.RangeProof)
+	ret1, _ := ret[1].(ids.ID)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUTXOProof indicates an expected call of GetUTXOProof.
+func (mr *MockStateMockRecorder) GetUTXOProof(ctx, utxoID, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOProof", reflect.TypeOf((*MockState)(nil).GetUTXOProof), ctx, utxoID, height)
+}
+
+// GetUTXOSnapshot mocks base method.
+func (m *MockState) GetUTXOSnapshot(height uint64, startUTXOID ids.ID, limit int) (*avax.UTXOSnapshotPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUTXOSnapshot", height, startUTXOID, limit)
+	ret0, _ := ret[0].(*avax.UTXOSnapshotPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUTXOSnapshot indicates an expected call of GetUTXOSnapshot.
+func (mr *MockStateMockRecorder) GetUTXOSnapshot(height, startUTXOID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOSnapshot", reflect.TypeOf((*MockState)(nil).GetUTXOSnapshot), height, startUTXOID, limit)
+}
+
 // GetUptime mocks base method.
 func (m *MockState) GetUptime(nodeID ids.NodeID) (time.Duration, time.Time, error) {
 	m.ctrl.T.Helper()
@@ -740,6 +873,37 @@ func (mr *MockStateMockRecorder) GetUptime(nodeID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptime", reflect.TypeOf((*MockState)(nil).GetUptime), nodeID)
 }
 
+// GetValidatorSetSnapshot mocks base method.
+func (m *MockState) GetValidatorSetSnapshot(subnetID ids.ID, height uint64) (uint64, map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorSetSnapshot", subnetID, height)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(map[ids.NodeID]*validators.GetValidatorOutput)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetValidatorSetSnapshot indicates an expected call of GetValidatorSetSnapshot.
+func (mr *MockStateMockRecorder) GetValidatorSetSnapshot(subnetID, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSetSnapshot", reflect.TypeOf((*MockState)(nil).GetValidatorSetSnapshot), subnetID, height)
+}
+
+// GetWithdrawalOwner mocks base method.
+func (m *MockState) GetWithdrawalOwner(txID ids.ID) (fx.Owner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalOwner", txID)
+	ret0, _ := ret[0].(fx.Owner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalOwner indicates an expected call of GetWithdrawalOwner.
+func (mr *MockStateMockRecorder) GetWithdrawalOwner(txID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalOwner", reflect.TypeOf((*MockState)(nil).GetWithdrawalOwner), txID)
+}
+
 // HasExpiry mocks base method.
 func (m *MockState) HasExpiry(arg0 ExpiryEntry) (bool, error) {
 	m.ctrl.T.Helper()
@@ -770,6 +934,21 @@ func (mr *MockStateMockRecorder) HasL1Validator(subnetID, nodeID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasL1Validator", reflect.TypeOf((*MockState)(nil).HasL1Validator), subnetID, nodeID)
 }
 
+// IsChainRetired mocks base method.
+func (m *MockState) IsChainRetired(chainID ids.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsChainRetired", chainID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsChainRetired indicates an expected call of IsChainRetired.
+func (mr *MockStateMockRecorder) IsChainRetired(chainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChainRetired", reflect.TypeOf((*MockState)(nil).IsChainRetired), chainID)
+}
+
 // NumActiveL1Validators mocks base method.
 func (m *MockState) NumActiveL1Validators() int {
 	m.ctrl.T.Helper()
@@ -876,6 +1055,18 @@ func (mr *MockStateMockRecorder) ReindexBlocks(lock, log any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexBlocks", reflect.TypeOf((*MockState)(nil).ReindexBlocks), lock, log)
 }
 
+// RetireChain mocks base method.
+func (m *MockState) RetireChain(chainID ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RetireChain", chainID)
+}
+
+// RetireChain indicates an expected call of RetireChain.
+func (mr *MockStateMockRecorder) RetireChain(chainID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireChain", reflect.TypeOf((*MockState)(nil).RetireChain), chainID)
+}
+
 // SetAccruedFees mocks base method.
 func (m *MockState) SetAccruedFees(f uint64) {
 	m.ctrl.T.Helper()
@@ -998,6 +1189,18 @@ func (mr *MockStateMockRecorder) SetTimestamp(tm any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTimestamp", reflect.TypeOf((*MockState)(nil).SetTimestamp), tm)
 }
 
+// SetTrackingStoppedAt mocks base method.
+func (m *MockState) SetTrackingStoppedAt(stoppedAt time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrackingStoppedAt", stoppedAt)
+}
+
+// SetTrackingStoppedAt indicates an expected call of SetTrackingStoppedAt.
+func (mr *MockStateMockRecorder) SetTrackingStoppedAt(stoppedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackingStoppedAt", reflect.TypeOf((*MockState)(nil).SetTrackingStoppedAt), stoppedAt)
+}
+
 // SetUptime mocks base method.
 func (m *MockState) SetUptime(nodeID ids.NodeID, upDuration time.Duration, lastUpdated time.Time) error {
 	m.ctrl.T.Helper()
@@ -1012,6 +1215,18 @@ func (mr *MockStateMockRecorder) SetUptime(nodeID, upDuration, lastUpdated any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUptime", reflect.TypeOf((*MockState)(nil).SetUptime), nodeID, upDuration, lastUpdated)
 }
 
+// SetWithdrawalOwner mocks base method.
+func (m *MockState) SetWithdrawalOwner(txID ids.ID, owner fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWithdrawalOwner", txID, owner)
+}
+
+// SetWithdrawalOwner indicates an expected call of SetWithdrawalOwner.
+func (mr *MockStateMockRecorder) SetWithdrawalOwner(txID, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithdrawalOwner", reflect.TypeOf((*MockState)(nil).SetWithdrawalOwner), txID, owner)
+}
+
 // UTXOIDs mocks base method.
 func (m *MockState) UTXOIDs(addr []byte, previous ids.ID, limit int) ([]ids.ID, error) {
 	m.ctrl.T.Helper()
@@ -1027,6 +1242,20 @@ func (mr *MockStateMockRecorder) UTXOIDs(addr, previous, limit any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UTXOIDs", reflect.TypeOf((*MockState)(nil).UTXOIDs), addr, previous, limit)
 }
 
+// UpdateCurrentValidator mocks base method.
+func (m *MockState) UpdateCurrentValidator(staker *Staker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCurrentValidator", staker)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCurrentValidator indicates an expected call of UpdateCurrentValidator.
+func (mr *MockStateMockRecorder) UpdateCurrentValidator(staker any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentValidator", reflect.TypeOf((*MockState)(nil).UpdateCurrentValidator), staker)
+}
+
 // WeightOfL1Validators mocks base method.
 func (m *MockState) WeightOfL1Validators(subnetID ids.ID) (uint64, error) {
 	m.ctrl.T.Helper()
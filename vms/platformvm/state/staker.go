@@ -41,6 +41,11 @@ type Staker struct {
 	// [priorities.go] and depends on if the stakers are in the pending or
 	// current validator set.
 	Priority txs.Priority
+
+	// ContinuationPeriod is the duration of the staking period that the staker
+	// is renewed for once its current staking period ends. If 0, the staker is
+	// removed at EndTime.
+	ContinuationPeriod time.Duration
 }
 
 // A *Staker is considered to be less than another *Staker when:
@@ -78,18 +83,23 @@ func NewCurrentStaker(
 	if err != nil {
 		return nil, err
 	}
+	var continuationPeriod time.Duration
+	if continuousStaker, ok := staker.(txs.ContinuousStaker); ok {
+		continuationPeriod = continuousStaker.ContinuationPeriod()
+	}
 	endTime := staker.EndTime()
 	return &Staker{
-		TxID:            txID,
-		NodeID:          staker.NodeID(),
-		PublicKey:       publicKey,
-		SubnetID:        staker.SubnetID(),
-		Weight:          staker.Weight(),
-		StartTime:       startTime,
-		EndTime:         endTime,
-		PotentialReward: potentialReward,
-		NextTime:        endTime,
-		Priority:        staker.CurrentPriority(),
+		TxID:               txID,
+		NodeID:             staker.NodeID(),
+		PublicKey:          publicKey,
+		SubnetID:           staker.SubnetID(),
+		Weight:             staker.Weight(),
+		StartTime:          startTime,
+		EndTime:            endTime,
+		PotentialReward:    potentialReward,
+		NextTime:           endTime,
+		Priority:           staker.CurrentPriority(),
+		ContinuationPeriod: continuationPeriod,
 	}, nil
 }
 
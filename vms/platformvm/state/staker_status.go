@@ -7,6 +7,8 @@ const (
 	unmodified diffValidatorStatus = iota
 	added
 	deleted
+	// modified is only used for current validators whose weight was changed
+	modified
 )
 
 type diffValidatorStatus uint8
@@ -14,7 +14,10 @@ import (
 	"github.com/ava-labs/avalanchego/utils/iterator"
 )
 
-var ErrAddingStakerAfterDeletion = errors.New("attempted to add a staker after deleting it")
+var (
+	ErrAddingStakerAfterDeletion   = errors.New("attempted to add a staker after deleting it")
+	ErrUpdatingStakerAfterDeletion = errors.New("attempted to update a staker after deleting it")
+)
 
 type Stakers interface {
 	CurrentStakers
@@ -39,6 +42,13 @@ type CurrentStakers interface {
 	// Invariant: [staker] is currently a CurrentValidator
 	DeleteCurrentValidator(staker *Staker)
 
+	// UpdateCurrentValidator replaces the [staker] describing a validator in
+	// the staker set. Only the weight, the staking period, and the
+	// continuation period of the validator may be modified.
+	//
+	// Invariant: [staker] is currently a CurrentValidator
+	UpdateCurrentValidator(staker *Staker) error
+
 	// SetDelegateeReward sets the accrued delegation rewards for [nodeID] on
 	// [subnetID] to [amount].
 	SetDelegateeReward(subnetID ids.ID, nodeID ids.NodeID, amount uint64) error
@@ -153,11 +163,34 @@ func (v *baseStakers) DeleteValidator(staker *Staker) {
 	validator.validator = nil
 	v.pruneValidator(staker.SubnetID, staker.NodeID)
 
+	v.stakers.Delete(staker)
+
 	validatorDiff := v.getOrCreateValidatorDiff(staker.SubnetID, staker.NodeID)
+	if validatorDiff.validatorStatus == modified {
+		// The validator being removed is the validator prior to being
+		// modified.
+		staker = validatorDiff.prevValidator
+	}
 	validatorDiff.validatorStatus = deleted
 	validatorDiff.validator = staker
+}
 
-	v.stakers.Delete(staker)
+func (v *baseStakers) UpdateValidator(staker *Staker) {
+	validator := v.getOrCreateValidator(staker.SubnetID, staker.NodeID)
+	prevStaker := validator.validator
+	validator.validator = staker
+
+	validatorDiff := v.getOrCreateValidatorDiff(staker.SubnetID, staker.NodeID)
+	if validatorDiff.validatorStatus == unmodified {
+		validatorDiff.validatorStatus = modified
+		validatorDiff.prevValidator = prevStaker
+	}
+	validatorDiff.updated = true
+	validatorDiff.validator = staker
+
+	// The staker may have been moved if its staking period was modified.
+	v.stakers.Delete(prevStaker)
+	v.stakers.ReplaceOrInsert(staker)
 }
 
 func (v *baseStakers) GetDelegatorIterator(subnetID ids.ID, nodeID ids.NodeID) iterator.Iterator[*Staker] {
@@ -260,15 +293,23 @@ type diffStakers struct {
 	validatorDiffs map[ids.ID]map[ids.NodeID]*diffValidator
 	addedStakers   *btree.BTreeG[*Staker]
 	deletedStakers map[ids.ID]*Staker
+	// txID --> staker that replaces the staker of the parent state
+	modifiedStakers map[ids.ID]*Staker
 }
 
 type diffValidator struct {
-	// validatorStatus describes whether a validator has been added or removed.
+	// validatorStatus describes whether a validator has been added, removed,
+	// or modified.
 	//
 	// validatorStatus is not affected by delegators ops so unmodified does not
 	// mean that diffValidator hasn't change, since delegators may have changed.
 	validatorStatus diffValidatorStatus
 	validator       *Staker
+	// updated is true if the validator was modified after it was added.
+	// prevValidator is the validator prior to being modified. It is only used
+	// if the validatorStatus is modified.
+	updated       bool
+	prevValidator *Staker
 
 	addedDelegators   *btree.BTreeG[*Staker]
 	deletedDelegators map[ids.ID]*Staker
@@ -278,8 +319,16 @@ func (d *diffValidator) WeightDiff() (ValidatorWeightDiff, error) {
 	weightDiff := ValidatorWeightDiff{
 		Decrease: d.validatorStatus == deleted,
 	}
-	if d.validatorStatus != unmodified {
+	switch d.validatorStatus {
+	case added, deleted:
 		weightDiff.Amount = d.validator.Weight
+	case modified:
+		if err := weightDiff.Sub(d.prevValidator.Weight); err != nil {
+			return ValidatorWeightDiff{}, fmt.Errorf("failed to decrease node weight diff: %w", err)
+		}
+		if err := weightDiff.Add(d.validator.Weight); err != nil {
+			return ValidatorWeightDiff{}, fmt.Errorf("failed to increase node weight diff: %w", err)
+		}
 	}
 
 	for _, staker := range d.deletedDelegators {
@@ -316,10 +365,12 @@ func (s *diffStakers) GetValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker,
 		return nil, unmodified
 	}
 
-	if validatorDiff.validatorStatus == added {
-		return validatorDiff.validator, added
+	switch validatorDiff.validatorStatus {
+	case added, modified:
+		return validatorDiff.validator, validatorDiff.validatorStatus
+	default:
+		return nil, validatorDiff.validatorStatus
 	}
-	return nil, validatorDiff.validatorStatus
 }
 
 func (s *diffStakers) PutValidator(staker *Staker) error {
@@ -342,6 +393,15 @@ func (s *diffStakers) PutValidator(staker *Staker) error {
 
 func (s *diffStakers) DeleteValidator(staker *Staker) {
 	validatorDiff := s.getOrCreateDiff(staker.SubnetID, staker.NodeID)
+	if validatorDiff.validatorStatus == modified {
+		// The modification no longer needs to be applied to the parent's
+		// staker. The staker being removed from the parent is the staker prior
+		// to being modified.
+		s.addedStakers.Delete(validatorDiff.validator)
+		delete(s.modifiedStakers, staker.TxID)
+
+		staker = validatorDiff.prevValidator
+	}
 	if validatorDiff.validatorStatus == added {
 		// This validator was added and immediately removed in this diff. We
 		// treat it as if it was never added.
@@ -358,6 +418,37 @@ func (s *diffStakers) DeleteValidator(staker *Staker) {
 	}
 }
 
+// UpdateValidator replaces [prevStaker] with [staker].
+func (s *diffStakers) UpdateValidator(prevStaker *Staker, staker *Staker) error {
+	validatorDiff := s.getOrCreateDiff(staker.SubnetID, staker.NodeID)
+	switch validatorDiff.validatorStatus {
+	case deleted:
+		return ErrUpdatingStakerAfterDeletion
+	case unmodified:
+		validatorDiff.validatorStatus = modified
+		validatorDiff.prevValidator = prevStaker
+	default:
+		// The staker may have been moved if its staking period was modified.
+		s.addedStakers.Delete(validatorDiff.validator)
+	}
+	validatorDiff.updated = true
+	if validatorDiff.validatorStatus == modified {
+		// The staker of the parent state must be replaced when iterating.
+		if s.modifiedStakers == nil {
+			s.modifiedStakers = make(map[ids.ID]*Staker)
+		}
+		s.modifiedStakers[staker.TxID] = staker
+	}
+
+	validatorDiff.validator = staker
+
+	if s.addedStakers == nil {
+		s.addedStakers = btree.NewG(defaultTreeDegree, (*Staker).Less)
+	}
+	s.addedStakers.ReplaceOrInsert(staker)
+	return nil
+}
+
 func (s *diffStakers) GetDelegatorIterator(
 	parentIterator iterator.Iterator[*Staker],
 	subnetID ids.ID,
@@ -421,8 +512,12 @@ func (s *diffStakers) GetStakerIterator(parentIterator iterator.Iterator[*Staker
 			iterator.FromTree(s.addedStakers),
 		),
 		func(staker *Staker) bool {
-			_, ok := s.deletedStakers[staker.TxID]
-			return ok
+			if _, ok := s.deletedStakers[staker.TxID]; ok {
+				return true
+			}
+			// Skip the stale version of modified stakers.
+			modifiedStaker, ok := s.modifiedStakers[staker.TxID]
+			return ok && modifiedStaker != staker
 		},
 	)
 }
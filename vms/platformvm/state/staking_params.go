@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+
+	platformvmgenesis "github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+)
+
+// StakingParams are the bounds that primary network stakers must satisfy.
+type StakingParams struct {
+	MinValidatorStake uint64
+	MaxValidatorStake uint64
+	MinDelegatorStake uint64
+	MinStakeDuration  time.Duration
+	MaxStakeDuration  time.Duration
+}
+
+// StakingConfig determines the staking params in effect at a given time.
+type StakingConfig struct {
+	// Node are the staking params configured on the node.
+	Node StakingParams
+	// Genesis are the staking params specified by the genesis, if any. They
+	// replace [Node] once the Fortuna upgrade is activated.
+	Genesis *StakingParams
+	// FortunaTime is the activation time of the Fortuna upgrade.
+	FortunaTime time.Time
+}
+
+// NewStakingConfig returns the staking config of a chain with [genesisBytes]
+// that is run with [cfg].
+func NewStakingConfig(genesisBytes []byte, cfg *config.Internal) (StakingConfig, error) {
+	g, err := platformvmgenesis.Parse(genesisBytes)
+	if err != nil {
+		return StakingConfig{}, err
+	}
+
+	stakingConfig := StakingConfig{
+		Node: StakingParams{
+			MinValidatorStake: cfg.MinValidatorStake,
+			MaxValidatorStake: cfg.MaxValidatorStake,
+			MinDelegatorStake: cfg.MinDelegatorStake,
+			MinStakeDuration:  cfg.MinStakeDuration,
+			MaxStakeDuration:  cfg.MaxStakeDuration,
+		},
+		FortunaTime: cfg.UpgradeConfig.FortunaTime,
+	}
+	if params := g.StakingParams; params != nil {
+		stakingConfig.Genesis = &StakingParams{
+			MinValidatorStake: params.MinValidatorStake,
+			MaxValidatorStake: params.MaxValidatorStake,
+			MinDelegatorStake: params.MinDelegatorStake,
+			MinStakeDuration:  time.Duration(params.MinStakeDuration) * time.Second,
+			MaxStakeDuration:  time.Duration(params.MaxStakeDuration) * time.Second,
+		}
+	}
+	return stakingConfig, nil
+}
+
+// At returns the staking params in effect at [timestamp].
+//
+// The staking params of the genesis only take effect with the Fortuna upgrade,
+// so that blocks accepted before it are verified against the same bounds
+// they were accepted with.
+func (c *StakingConfig) At(timestamp time.Time) StakingParams {
+	if c.Genesis == nil || timestamp.Before(c.FortunaTime) {
+		return c.Node
+	}
+	return *c.Genesis
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/iterator"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/maybe"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -47,6 +48,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/x/merkledb"
 
 	safemath "github.com/ava-labs/avalanchego/utils/math"
 )
@@ -66,6 +68,8 @@ var (
 	errIsNotSubnet                    = errors.New("is not a subnet")
 	errMissingPrimaryNetworkValidator = errors.New("missing primary network validator")
 
+	ErrNotStakerTx = errors.New("not a staker tx")
+
 	BlockIDPrefix                 = []byte("blockID")
 	BlockPrefix                   = []byte("block")
 	ValidatorsPrefix              = []byte("validators")
@@ -75,17 +79,28 @@ var (
 	DelegatorPrefix               = []byte("delegator")
 	SubnetValidatorPrefix         = []byte("subnetValidator")
 	SubnetDelegatorPrefix         = []byte("subnetDelegator")
+	SubnetValidatorWeightPrefix   = []byte("subnetValidatorWeight")
+	ContinuousValidatorPrefix     = []byte("continuousValidator")
 	ValidatorWeightDiffsPrefix    = []byte("flatValidatorDiffs")
 	ValidatorPublicKeyDiffsPrefix = []byte("flatPublicKeyDiffs")
+	ValidatorSetSnapshotsPrefix   = []byte("validatorSetSnapshots")
 	TxPrefix                      = []byte("tx")
+	MultiDelegationPrefix         = []byte("multiDelegation")
 	RewardUTXOsPrefix             = []byte("rewardUTXOs")
+	RewardedStakersPrefix         = []byte("rewardedStakers")
+	WithdrawalOwnerPrefix         = []byte("withdrawalOwner")
 	UTXOPrefix                    = []byte("utxo")
+	UTXOTriePrefix                = []byte("utxoTrie")
+	UTXODiffPrefix                = []byte("utxoDiff")
+	UTXOSnapshotsPrefix           = []byte("utxoSnapshots")
+	StateTriePrefix               = []byte("stateTrie")
 	SubnetPrefix                  = []byte("subnet")
 	SubnetOwnerPrefix             = []byte("subnetOwner")
 	SubnetToL1ConversionPrefix    = []byte("subnetToL1Conversion")
 	TransformedSubnetPrefix       = []byte("transformedSubnet")
 	SupplyPrefix                  = []byte("supply")
 	ChainPrefix                   = []byte("chain")
+	RetiredChainPrefix            = []byte("retiredChain")
 	ExpiryReplayProtectionPrefix  = []byte("expiryReplayProtection")
 	L1Prefix                      = []byte("l1")
 	WeightsPrefix                 = []byte("weights")
@@ -103,6 +118,11 @@ var (
 	HeightsIndexedKey    = []byte("heights indexed")
 	InitializedKey       = []byte("initialized")
 	BlocksReindexedKey   = []byte("blocks reindexed")
+	UptimesStoppedAtKey  = []byte("uptimes stopped at")
+
+	PrunedValidatorDiffsHeightKey = []byte("pruned validator diffs height")
+
+	ErrRewardedStakersDisabled = errors.New("rewarded stakers aren't indexed")
 
 	emptyL1ValidatorCache = &cache.Empty[ids.ID, maybe.Maybe[L1Validator]]{}
 )
@@ -129,11 +149,26 @@ type Chain interface {
 	GetAccruedFees() uint64
 	SetAccruedFees(f uint64)
 
+	// GetStakingParams returns the bounds that primary network stakers must
+	// satisfy at the chain's timestamp.
+	GetStakingParams() StakingParams
+	// GetStakingConfig returns the config that determines the staking params
+	// in effect over time.
+	GetStakingConfig() StakingConfig
+
 	GetCurrentSupply(subnetID ids.ID) (uint64, error)
 	SetCurrentSupply(subnetID ids.ID, cs uint64)
 
+	GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error)
 	AddRewardUTXO(txID ids.ID, utxo *avax.UTXO)
 
+	// GetWithdrawalOwner returns the owner that the rewards of the validator
+	// added by [txID] must be paid to, regardless of its rewards owners.
+	//
+	// Returns [database.ErrNotFound] if no withdrawal owner was set.
+	GetWithdrawalOwner(txID ids.ID) (fx.Owner, error)
+	SetWithdrawalOwner(txID ids.ID, owner fx.Owner)
+
 	AddSubnet(subnetID ids.ID)
 
 	GetSubnetOwner(subnetID ids.ID) (fx.Owner, error)
@@ -147,14 +182,23 @@ type Chain interface {
 
 	AddChain(createChainTx *txs.Tx)
 
+	// IsChainRetired returns true if the chain with [chainID] was retired.
+	IsChainRetired(chainID ids.ID) (bool, error)
+	RetireChain(chainID ids.ID)
+
 	GetTx(txID ids.ID) (*txs.Tx, status.Status, error)
 	AddTx(tx *txs.Tx, status status.Status)
+
+	// GetStakerTx returns the staker that was added with [stakerID].
+	GetStakerTx(stakerID ids.ID) (txs.Staker, error)
 }
 
 type State interface {
 	Chain
 	uptime.State
 	avax.UTXOReader
+	avax.UTXODiffGetter
+	avax.UTXOSnapshotGetter
 
 	GetLastAccepted() ids.ID
 	SetLastAccepted(blkID ids.ID)
@@ -166,7 +210,6 @@ type State interface {
 
 	GetBlockIDAtHeight(height uint64) (ids.ID, error)
 
-	GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error)
 	GetSubnetIDs() ([]ids.ID, error)
 	GetChains(subnetID ids.ID) ([]*txs.Tx, error)
 
@@ -208,6 +251,19 @@ type State interface {
 		subnetID ids.ID,
 	) error
 
+	// GetValidatorSetSnapshot returns the first snapshot of the validator set
+	// of [subnetID] taken at or after [height], along with the height the
+	// snapshot was taken at. If there is no such snapshot,
+	// [database.ErrNotFound] is returned.
+	GetValidatorSetSnapshot(
+		subnetID ids.ID,
+		height uint64,
+	) (uint64, map[ids.NodeID]*validators.GetValidatorOutput, error)
+
+	// GetPrunedValidatorDiffsHeight returns the height at and below which the
+	// validator diffs may have been pruned.
+	GetPrunedValidatorDiffsHeight() uint64
+
 	SetHeight(height uint64)
 
 	// GetCurrentValidators returns subnet and L1 validators for the given
@@ -238,6 +294,18 @@ type State interface {
 
 	Checksum() ids.ID
 
+	// GetUTXOProof returns a proof of whether [utxoID] was in the UTXO set at
+	// [height], along with the merkle root of the UTXO set at [height].
+	//
+	// Returns [ErrUTXOProofsDisabled] if UTXO proofs aren't enabled.
+	GetUTXOProof(ctx context.Context, utxoID ids.ID, height uint64) (*merkledb.RangeProof, ids.ID, error)
+
+	// GetRewardedStakers returns the txIDs of the stakers whose reward UTXOs
+	// were created with a chain time in [start, end), ordered by chain time.
+	//
+	// Returns [ErrRewardedStakersDisabled] if rewarded stakers aren't indexed.
+	GetRewardedStakers(start, end time.Time) ([]ids.ID, error)
+
 	Close() error
 }
 
@@ -291,8 +359,10 @@ type stateBlk struct {
  * | |   '-- validationID -> l1Validator
  * | |-. weight diffs
  * | | '-- subnet+height+nodeID -> weightChange
- * | '-. pub key diffs
- * |   '-- subnet+height+nodeID -> uncompressed public key or nil
+ * | |-. pub key diffs
+ * | | '-- subnet+height+nodeID -> uncompressed public key or nil
+ * | '-. validator set snapshots
+ * |   '-- subnet+height -> validator set
  * |-. blockIDs
  * | '-- height -> blockID
  * |-. blocks
@@ -303,8 +373,31 @@ type stateBlk struct {
  * | '-. txID
  * |   '-. list
  * |     '-- utxoID -> utxo bytes
+ * |-. rewardedStakers
+ * | '-- timestamp+txID -> nil
+ * |-. withdrawalOwners
+ * | '-- txID -> owner
  * |- utxos
  * | '-- utxoDB
+ * |-. utxoDiffs
+ * | '-- height -> UTXO diff
+ * |-. utxoSnapshots
+ * | |-. digest
+ * | | '-- height -> digest
+ * | '-. utxo
+ * |   '-- height + utxoID -> utxo bytes
+ * |-. utxoTrie
+ * | |-. trie
+ * | | '-- merkleDB of utxoID -> utxo bytes
+ * | |-. roots
+ * | | '-- height -> merkle root
+ * | '-. metadata
+ * |   '-- heightKey -> height
+ * |-. stateTrie
+ * | |-. trie
+ * | | '-- merkleDB of state trie key -> value
+ * | '-. metadata
+ * |   '-- heightKey -> height
  * |-. subnets
  * | '-. list
  * |   '-- txID -> nil
@@ -316,6 +409,8 @@ type stateBlk struct {
  * | '-. subnetID
  * |   '-. list
  * |     '-- txID -> nil
+ * |-. retiredChains
+ * | '-- chainID -> nil
  * |-. expiryReplayProtection
  * | '-- timestamp + validationID -> nil
  * '-. singletons
@@ -327,6 +422,7 @@ type stateBlk struct {
  *   |-- accruedFeesKey -> accruedFees
  *   |-- currentSupplyKey -> currentSupply
  *   |-- lastAcceptedKey -> lastAccepted
+ *   |-- prunedValidatorDiffsHeightKey -> prunedValidatorDiffsHeight
  *   '-- heightsIndexKey -> startIndexHeight + endIndexHeight
  */
 type state struct {
@@ -378,6 +474,12 @@ type state struct {
 	currentSubnetValidatorList   linkeddb.LinkedDB
 	currentSubnetDelegatorBaseDB database.Database
 	currentSubnetDelegatorList   linkeddb.LinkedDB
+	// txID -> weight of permissioned subnet validators whose weight differs
+	// from the weight specified in their transaction
+	currentSubnetValidatorWeightDB database.Database
+	// txID -> weight, end time, and continuation period of continuous
+	// validators that were renewed or stopped
+	currentContinuousValidatorDB database.Database
 	pendingValidatorsDB          database.Database
 	pendingValidatorBaseDB       database.Database
 	pendingValidatorList         linkeddb.LinkedDB
@@ -391,17 +493,44 @@ type state struct {
 	validatorWeightDiffsDB    database.Database
 	validatorPublicKeyDiffsDB database.Database
 
+	// 0 if validator set snapshots are disabled
+	validatorSetSnapshotInterval uint64
+	validatorSetSnapshotsDB      database.Database
+
+	// 0 if validator diffs are never pruned
+	validatorDiffsRetention uint64
+	// Validator diffs at heights less than or equal to
+	// [prunedValidatorDiffsHeight] may have been deleted.
+	prunedValidatorDiffsHeight uint64
+
 	addedTxs map[ids.ID]*txAndStatus            // map of txID -> {*txs.Tx, Status}
 	txCache  cache.Cacher[ids.ID, *txAndStatus] // txID -> {*txs.Tx, Status}; if the entry is nil, it is not in the database
 	txDB     database.Database
 
+	addedMultiDelegations map[ids.ID]ids.ID // map of delegationID -> txID
+	multiDelegationDB     database.Database
+
 	addedRewardUTXOs map[ids.ID][]*avax.UTXO            // map of txID -> []*UTXO
 	rewardUTXOsCache cache.Cacher[ids.ID, []*avax.UTXO] // txID -> []*UTXO
 	rewardUTXODB     database.Database
+	rewardedStakerDB database.Database // nil if rewarded stakers aren't indexed
+
+	withdrawalOwners  map[ids.ID]fx.Owner // map of validator txID -> withdrawal owner
+	withdrawalOwnerDB database.Database
 
 	modifiedUTXOs map[ids.ID]*avax.UTXO // map of modified UTXOID -> *UTXO; if the UTXO is nil, it has been removed
 	utxoDB        database.Database
 	utxoState     avax.UTXOState
+	utxoTrie      *utxoTrie         // nil if UTXO proofs are disabled
+	utxoDiffDB    database.Database // nil if UTXO diffs are disabled
+
+	// nil if UTXO snapshots are disabled
+	utxoSnapshots        *avax.UTXOSnapshots
+	utxoSnapshotInterval uint64
+	// 0 if UTXO snapshots are never pruned
+	utxoSnapshotRetention uint64
+
+	stateTrie *stateTrie
 
 	cachedSubnetIDs []ids.ID // nil if the subnets haven't been loaded
 	addedSubnetIDs  []ids.ID
@@ -429,12 +558,17 @@ type state struct {
 	chainDBCache cache.Cacher[ids.ID, linkeddb.LinkedDB] // cache of subnetID -> linkedDB
 	chainDB      database.Database
 
+	retiredChains  set.Set[ids.ID] // set of the newly retired chainIDs
+	retiredChainDB database.Database
+
 	// The persisted fields represent the current database value
 	timestamp, persistedTimestamp                 time.Time
 	feeState, persistedFeeState                   gas.State
 	l1ValidatorExcess, persistedL1ValidatorExcess gas.Gas
 	accruedFees, persistedAccruedFees             uint64
 	currentSupply, persistedCurrentSupply         uint64
+	uptimesStoppedAt, persistedUptimesStoppedAt   time.Time
+	stakingConfig                                 StakingConfig
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	// TODO: Remove indexedHeights once v1.11.3 has been released.
@@ -529,6 +663,7 @@ func New(
 	metricsReg prometheus.Registerer,
 	validators validators.Manager,
 	upgrades upgrade.Config,
+	stakingConfig StakingConfig,
 	execCfg *config.Config,
 	ctx *snow.Context,
 	metrics metrics.Metrics,
@@ -561,6 +696,8 @@ func New(
 	currentDelegatorBaseDB := prefixdb.New(DelegatorPrefix, currentValidatorsDB)
 	currentSubnetValidatorBaseDB := prefixdb.New(SubnetValidatorPrefix, currentValidatorsDB)
 	currentSubnetDelegatorBaseDB := prefixdb.New(SubnetDelegatorPrefix, currentValidatorsDB)
+	currentSubnetValidatorWeightDB := prefixdb.New(SubnetValidatorWeightPrefix, currentValidatorsDB)
+	currentContinuousValidatorDB := prefixdb.New(ContinuousValidatorPrefix, currentValidatorsDB)
 
 	pendingValidatorsDB := prefixdb.New(PendingPrefix, validatorsDB)
 	pendingValidatorBaseDB := prefixdb.New(ValidatorPrefix, pendingValidatorsDB)
@@ -638,12 +775,44 @@ func New(
 		return nil, err
 	}
 
+	var rewardedStakerDB database.Database
+	if execCfg.RewardReportEpochDuration > 0 {
+		rewardedStakerDB = prefixdb.New(RewardedStakersPrefix, baseDB)
+	}
+
 	utxoDB := prefixdb.New(UTXOPrefix, baseDB)
 	utxoState, err := avax.NewMeteredUTXOState(utxoDB, txs.GenesisCodec, metricsReg, execCfg.ChecksumsEnabled)
 	if err != nil {
 		return nil, err
 	}
 
+	var utxoTrie *utxoTrie
+	if execCfg.IndexUTXOProofs {
+		// The trie isn't written atomically with the rest of the state, so it
+		// is stored outside of [baseDB].
+		utxoTrie, err = newUTXOTrie(prefixdb.New(UTXOTriePrefix, db), metricsReg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var utxoDiffDB database.Database
+	if execCfg.IndexUTXODiffs {
+		utxoDiffDB = prefixdb.New(UTXODiffPrefix, baseDB)
+	}
+
+	var utxoSnapshots *avax.UTXOSnapshots
+	if execCfg.UTXOSnapshotInterval > 0 {
+		utxoSnapshots = avax.NewUTXOSnapshots(prefixdb.New(UTXOSnapshotsPrefix, baseDB))
+	}
+
+	// Like the UTXO trie, the state trie isn't written atomically with the
+	// rest of the state.
+	stateTrie, err := newStateTrie(prefixdb.New(StateTriePrefix, db), metricsReg)
+	if err != nil {
+		return nil, err
+	}
+
 	subnetBaseDB := prefixdb.New(SubnetPrefix, baseDB)
 
 	subnetOwnerDB := prefixdb.New(SubnetOwnerPrefix, baseDB)
@@ -716,6 +885,8 @@ func New(
 		rewards:    rewards,
 		baseDB:     baseDB,
 
+		stakingConfig: stakingConfig,
+
 		addedBlockIDs: make(map[uint64]ids.ID),
 		blockIDCache:  blockIDCache,
 		blockIDDB:     prefixdb.New(BlockIDPrefix, baseDB),
@@ -742,39 +913,58 @@ func New(
 		currentStakers: newBaseStakers(),
 		pendingStakers: newBaseStakers(),
 
-		validatorsDB:                 validatorsDB,
-		currentValidatorsDB:          currentValidatorsDB,
-		currentValidatorBaseDB:       currentValidatorBaseDB,
-		currentValidatorList:         linkeddb.NewDefault(currentValidatorBaseDB),
-		currentDelegatorBaseDB:       currentDelegatorBaseDB,
-		currentDelegatorList:         linkeddb.NewDefault(currentDelegatorBaseDB),
-		currentSubnetValidatorBaseDB: currentSubnetValidatorBaseDB,
-		currentSubnetValidatorList:   linkeddb.NewDefault(currentSubnetValidatorBaseDB),
-		currentSubnetDelegatorBaseDB: currentSubnetDelegatorBaseDB,
-		currentSubnetDelegatorList:   linkeddb.NewDefault(currentSubnetDelegatorBaseDB),
-		pendingValidatorsDB:          pendingValidatorsDB,
-		pendingValidatorBaseDB:       pendingValidatorBaseDB,
-		pendingValidatorList:         linkeddb.NewDefault(pendingValidatorBaseDB),
-		pendingDelegatorBaseDB:       pendingDelegatorBaseDB,
-		pendingDelegatorList:         linkeddb.NewDefault(pendingDelegatorBaseDB),
-		pendingSubnetValidatorBaseDB: pendingSubnetValidatorBaseDB,
-		pendingSubnetValidatorList:   linkeddb.NewDefault(pendingSubnetValidatorBaseDB),
-		pendingSubnetDelegatorBaseDB: pendingSubnetDelegatorBaseDB,
-		pendingSubnetDelegatorList:   linkeddb.NewDefault(pendingSubnetDelegatorBaseDB),
-		validatorWeightDiffsDB:       validatorWeightDiffsDB,
-		validatorPublicKeyDiffsDB:    validatorPublicKeyDiffsDB,
+		validatorsDB:                   validatorsDB,
+		currentValidatorsDB:            currentValidatorsDB,
+		currentValidatorBaseDB:         currentValidatorBaseDB,
+		currentValidatorList:           linkeddb.NewDefault(currentValidatorBaseDB),
+		currentDelegatorBaseDB:         currentDelegatorBaseDB,
+		currentDelegatorList:           linkeddb.NewDefault(currentDelegatorBaseDB),
+		currentSubnetValidatorBaseDB:   currentSubnetValidatorBaseDB,
+		currentSubnetValidatorList:     linkeddb.NewDefault(currentSubnetValidatorBaseDB),
+		currentSubnetDelegatorBaseDB:   currentSubnetDelegatorBaseDB,
+		currentSubnetDelegatorList:     linkeddb.NewDefault(currentSubnetDelegatorBaseDB),
+		currentSubnetValidatorWeightDB: currentSubnetValidatorWeightDB,
+		currentContinuousValidatorDB:   currentContinuousValidatorDB,
+		pendingValidatorsDB:            pendingValidatorsDB,
+		pendingValidatorBaseDB:         pendingValidatorBaseDB,
+		pendingValidatorList:           linkeddb.NewDefault(pendingValidatorBaseDB),
+		pendingDelegatorBaseDB:         pendingDelegatorBaseDB,
+		pendingDelegatorList:           linkeddb.NewDefault(pendingDelegatorBaseDB),
+		pendingSubnetValidatorBaseDB:   pendingSubnetValidatorBaseDB,
+		pendingSubnetValidatorList:     linkeddb.NewDefault(pendingSubnetValidatorBaseDB),
+		pendingSubnetDelegatorBaseDB:   pendingSubnetDelegatorBaseDB,
+		pendingSubnetDelegatorList:     linkeddb.NewDefault(pendingSubnetDelegatorBaseDB),
+		validatorWeightDiffsDB:         validatorWeightDiffsDB,
+		validatorPublicKeyDiffsDB:      validatorPublicKeyDiffsDB,
+		validatorSetSnapshotInterval:   execCfg.ValidatorSetSnapshotInterval,
+		validatorSetSnapshotsDB:        prefixdb.New(ValidatorSetSnapshotsPrefix, validatorsDB),
+		validatorDiffsRetention:        execCfg.ValidatorDiffsRetention,
 
 		addedTxs: make(map[ids.ID]*txAndStatus),
 		txDB:     prefixdb.New(TxPrefix, baseDB),
 		txCache:  txCache,
 
+		addedMultiDelegations: make(map[ids.ID]ids.ID),
+		multiDelegationDB:     prefixdb.New(MultiDelegationPrefix, baseDB),
+
 		addedRewardUTXOs: make(map[ids.ID][]*avax.UTXO),
 		rewardUTXODB:     rewardUTXODB,
 		rewardUTXOsCache: rewardUTXOsCache,
+		rewardedStakerDB: rewardedStakerDB,
+
+		withdrawalOwners:  make(map[ids.ID]fx.Owner),
+		withdrawalOwnerDB: prefixdb.New(WithdrawalOwnerPrefix, baseDB),
 
 		modifiedUTXOs: make(map[ids.ID]*avax.UTXO),
 		utxoDB:        utxoDB,
 		utxoState:     utxoState,
+		utxoTrie:      utxoTrie,
+		utxoDiffDB:    utxoDiffDB,
+		stateTrie:     stateTrie,
+
+		utxoSnapshots:         utxoSnapshots,
+		utxoSnapshotInterval:  execCfg.UTXOSnapshotInterval,
+		utxoSnapshotRetention: execCfg.UTXOSnapshotRetention,
 
 		subnetBaseDB: subnetBaseDB,
 		subnetDB:     linkeddb.NewDefault(subnetBaseDB),
@@ -800,6 +990,8 @@ func New(
 		chainCache:   chainCache,
 		chainDBCache: chainDBCache,
 
+		retiredChainDB: prefixdb.New(RetiredChainPrefix, baseDB),
+
 		singletonDB: prefixdb.New(SingletonPrefix, baseDB),
 	}
 
@@ -961,6 +1153,14 @@ func (s *state) DeleteCurrentValidator(staker *Staker) {
 	s.currentStakers.DeleteValidator(staker)
 }
 
+func (s *state) UpdateCurrentValidator(staker *Staker) error {
+	if _, err := s.currentStakers.GetValidator(staker.SubnetID, staker.NodeID); err != nil {
+		return err
+	}
+	s.currentStakers.UpdateValidator(staker)
+	return nil
+}
+
 func (s *state) GetCurrentDelegatorIterator(subnetID ids.ID, nodeID ids.NodeID) (iterator.Iterator[*Staker], error) {
 	return s.currentStakers.GetDelegatorIterator(subnetID, nodeID), nil
 }
@@ -1187,6 +1387,17 @@ func (s *state) AddChain(createChainTxIntf *txs.Tx) {
 	}
 }
 
+func (s *state) IsChainRetired(chainID ids.ID) (bool, error) {
+	if s.retiredChains.Contains(chainID) {
+		return true, nil
+	}
+	return s.retiredChainDB.Has(chainID[:])
+}
+
+func (s *state) RetireChain(chainID ids.ID) {
+	s.retiredChains.Add(chainID)
+}
+
 func (s *state) getChainDB(subnetID ids.ID) linkeddb.LinkedDB {
 	if chainDB, cached := s.chainDBCache.Get(subnetID); cached {
 		return chainDB
@@ -1235,10 +1446,58 @@ func (s *state) GetTx(txID ids.ID) (*txs.Tx, status.Status, error) {
 }
 
 func (s *state) AddTx(tx *txs.Tx, status status.Status) {
-	s.addedTxs[tx.ID()] = &txAndStatus{
+	txID := tx.ID()
+	s.addedTxs[txID] = &txAndStatus{
 		tx:     tx,
 		status: status,
 	}
+	if multiDelegatorTx, ok := txs.Unwrap(tx.Unsigned).(*txs.AddMultiDelegatorTx); ok {
+		for i := range multiDelegatorTx.Delegations {
+			s.addedMultiDelegations[txs.DelegationID(txID, i)] = txID
+		}
+	}
+}
+
+func (s *state) GetStakerTx(stakerID ids.ID) (txs.Staker, error) {
+	tx, _, err := s.GetTx(stakerID)
+	if err != database.ErrNotFound {
+		if err != nil {
+			return nil, err
+		}
+		return getStaker(tx, stakerID)
+	}
+
+	txID, ok := s.addedMultiDelegations[stakerID]
+	if !ok {
+		txID, err = database.GetID(s.multiDelegationDB, stakerID[:])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, _, err = s.GetTx(txID)
+	if err != nil {
+		return nil, err
+	}
+	return getStaker(tx, stakerID)
+}
+
+// getStaker returns the staker with [stakerID] that was added by [tx].
+func getStaker(tx *txs.Tx, stakerID ids.ID) (txs.Staker, error) {
+	switch stakerTx := txs.Unwrap(tx.Unsigned).(type) {
+	case *txs.AddMultiDelegatorTx:
+		txID := tx.ID()
+		for i, delegator := range stakerTx.Delegators() {
+			if txs.DelegationID(txID, i) == stakerID {
+				return delegator, nil
+			}
+		}
+		return nil, fmt.Errorf("%w: %s does not add %s", ErrNotStakerTx, txID, stakerID)
+	case txs.Staker:
+		return stakerTx, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrNotStakerTx, tx.Unsigned)
+	}
 }
 
 func (s *state) GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error) {
@@ -1274,6 +1533,26 @@ func (s *state) AddRewardUTXO(txID ids.ID, utxo *avax.UTXO) {
 	s.addedRewardUTXOs[txID] = append(s.addedRewardUTXOs[txID], utxo)
 }
 
+func (s *state) GetWithdrawalOwner(txID ids.ID) (fx.Owner, error) {
+	if owner, exists := s.withdrawalOwners[txID]; exists {
+		return owner, nil
+	}
+
+	ownerBytes, err := s.withdrawalOwnerDB.Get(txID[:])
+	if err != nil {
+		return nil, err
+	}
+	var owner fx.Owner
+	if _, err := block.GenesisCodec.Unmarshal(ownerBytes, &owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+func (s *state) SetWithdrawalOwner(txID ids.ID, owner fx.Owner) {
+	s.withdrawalOwners[txID] = owner
+}
+
 func (s *state) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
 	if utxo, exists := s.modifiedUTXOs[utxoID]; exists {
 		if utxo == nil {
@@ -1304,6 +1583,14 @@ func (s *state) GetStartTime(nodeID ids.NodeID) (time.Time, error) {
 	return staker.StartTime, nil
 }
 
+func (s *state) GetTrackingStoppedAt() time.Time {
+	return s.uptimesStoppedAt
+}
+
+func (s *state) SetTrackingStoppedAt(stoppedAt time.Time) {
+	s.uptimesStoppedAt = stoppedAt
+}
+
 // GetTimestamp allows for concurrent reads.
 func (s *state) GetTimestamp() time.Time {
 	return s.timestamp
@@ -1337,6 +1624,14 @@ func (s *state) SetAccruedFees(accruedFees uint64) {
 	s.accruedFees = accruedFees
 }
 
+func (s *state) GetStakingParams() StakingParams {
+	return s.stakingConfig.At(s.timestamp)
+}
+
+func (s *state) GetStakingConfig() StakingConfig {
+	return s.stakingConfig
+}
+
 func (s *state) GetLastAccepted() ids.ID {
 	return s.lastAccepted
 }
@@ -1613,7 +1908,61 @@ func (s *state) load() error {
 		s.loadCurrentValidators(),
 		s.loadPendingValidators(),
 		s.initValidatorSets(),
+		s.loadUTXOTrie(),
+		s.loadStateTrie(), // Must be called after loadCurrentValidators
+	)
+}
+
+func (s *state) loadUTXOTrie() error {
+	if s.utxoTrie == nil {
+		return nil
+	}
+
+	lastAccepted, err := s.GetStatelessBlock(s.lastAccepted)
+	if err != nil {
+		return err
+	}
+	height := lastAccepted.Height()
+	if s.utxoTrie.synced(height) {
+		return nil
+	}
+
+	s.ctx.Log.Info("rebuilding UTXO trie",
+		zap.Uint64("height", height),
+	)
+	return s.utxoTrie.rebuild(s.utxoState.NewUTXOIterator(), height)
+}
+
+// loadStateTrie rebuilds the state trie if it isn't in sync with the last
+// accepted block. The state trie is only maintained once Fortuna is activated,
+// so it isn't rebuilt before then.
+func (s *state) loadStateTrie() error {
+	if !s.upgrades.IsFortunaActivated(s.GetTimestamp()) {
+		return nil
+	}
+	return s.syncStateTrie()
+}
+
+// syncStateTrie rebuilds the state trie from the persisted state if it isn't
+// in sync with the last accepted block.
+func (s *state) syncStateTrie() error {
+	lastAccepted, err := s.GetStatelessBlock(s.lastAccepted)
+	if err != nil {
+		return err
+	}
+	height := lastAccepted.Height()
+	if s.stateTrie.synced(height) {
+		return nil
+	}
+
+	s.ctx.Log.Info("rebuilding state trie",
+		zap.Uint64("height", height),
 	)
+	ops, err := s.stateTrieRebuildOps()
+	if err != nil {
+		return fmt.Errorf("failed to read state trie contents: %w", err)
+	}
+	return s.stateTrie.rebuild(ops, height)
 }
 
 func (s *state) loadMetadata() error {
@@ -1659,6 +2008,19 @@ func (s *state) loadMetadata() error {
 	s.persistedLastAccepted = lastAccepted
 	s.lastAccepted = lastAccepted
 
+	uptimesStoppedAt, err := database.WithDefault(database.GetTimestamp, s.singletonDB, UptimesStoppedAtKey, time.Time{})
+	if err != nil {
+		return err
+	}
+	s.persistedUptimesStoppedAt = uptimesStoppedAt
+	s.uptimesStoppedAt = uptimesStoppedAt
+
+	prunedValidatorDiffsHeight, err := database.WithDefault(database.GetUInt64, s.singletonDB, PrunedValidatorDiffsHeightKey, 0)
+	if err != nil {
+		return err
+	}
+	s.prunedValidatorDiffsHeight = prunedValidatorDiffsHeight
+
 	// Lookup the most recently indexed range on disk. If we haven't started
 	// indexing the weights, then we keep the indexed heights as nil.
 	indexedHeightsBytes, err := s.singletonDB.Get(HeightsIndexedKey)
@@ -1747,7 +2109,7 @@ func (s *state) loadCurrentValidators() error {
 			return fmt.Errorf("failed loading validator transaction txID %s, %w", txID, err)
 		}
 
-		stakerTx, ok := tx.Unsigned.(txs.Staker)
+		stakerTx, ok := txs.Unwrap(tx.Unsigned).(txs.Staker)
 		if !ok {
 			return fmt.Errorf("expected tx type txs.Staker but got %T", tx.Unsigned)
 		}
@@ -1756,7 +2118,7 @@ func (s *state) loadCurrentValidators() error {
 		metadata := &validatorMetadata{
 			txID: txID,
 		}
-		if scheduledStakerTx, ok := tx.Unsigned.(txs.ScheduledStaker); ok {
+		if scheduledStakerTx, ok := txs.Unwrap(tx.Unsigned).(txs.ScheduledStaker); ok {
 			// Populate [StakerStartTime] using the tx as a default in the event
 			// it was added pre-durango and is not stored in the database.
 			//
@@ -1777,6 +2139,20 @@ func (s *state) loadCurrentValidators() error {
 			return err
 		}
 
+		// Continuous validators may have been renewed or stopped after they
+		// were added.
+		continuousMetadata, err := getContinuousValidatorMetadata(s.currentContinuousValidatorDB, txID)
+		switch err {
+		case nil:
+			staker.Weight = continuousMetadata.Weight
+			staker.EndTime = time.Unix(int64(continuousMetadata.EndTime), 0)
+			staker.NextTime = staker.EndTime
+			staker.ContinuationPeriod = time.Duration(continuousMetadata.ContinuationPeriod) * time.Second
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+
 		validator := s.currentStakers.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 		validator.validator = staker
 
@@ -1798,7 +2174,7 @@ func (s *state) loadCurrentValidators() error {
 			return err
 		}
 
-		stakerTx, ok := tx.Unsigned.(txs.Staker)
+		stakerTx, ok := txs.Unwrap(tx.Unsigned).(txs.Staker)
 		if !ok {
 			return fmt.Errorf("expected tx type txs.Staker but got %T", tx.Unsigned)
 		}
@@ -1807,7 +2183,7 @@ func (s *state) loadCurrentValidators() error {
 		metadata := &validatorMetadata{
 			txID: txID,
 		}
-		if scheduledStakerTx, ok := tx.Unsigned.(txs.ScheduledStaker); ok {
+		if scheduledStakerTx, ok := txs.Unwrap(tx.Unsigned).(txs.ScheduledStaker); ok {
 			// Populate [StakerStartTime] and [LastUpdated] using the tx as a
 			// default in the event they are not stored in the database.
 			startTime := uint64(scheduledStakerTx.StartTime().Unix())
@@ -1827,6 +2203,18 @@ func (s *state) loadCurrentValidators() error {
 		if err != nil {
 			return err
 		}
+
+		// Permissioned subnet validators may have had their weight modified
+		// after they were added.
+		weight, err := database.GetUInt64(s.currentSubnetValidatorWeightDB, txIDBytes)
+		switch err {
+		case nil:
+			staker.Weight = weight
+		case database.ErrNotFound:
+		default:
+			return err
+		}
+
 		validator := s.currentStakers.getOrCreateValidator(staker.SubnetID, staker.NodeID)
 		validator.validator = staker
 
@@ -1848,21 +2236,16 @@ func (s *state) loadCurrentValidators() error {
 			if err != nil {
 				return err
 			}
-			tx, _, err := s.GetTx(txID)
+			stakerTx, err := s.GetStakerTx(txID)
 			if err != nil {
 				return err
 			}
 
-			stakerTx, ok := tx.Unsigned.(txs.Staker)
-			if !ok {
-				return fmt.Errorf("expected tx type txs.Staker but got %T", tx.Unsigned)
-			}
-
 			metadataBytes := delegatorIt.Value()
 			metadata := &delegatorMetadata{
 				txID: txID,
 			}
-			if scheduledStakerTx, ok := tx.Unsigned.(txs.ScheduledStaker); ok {
+			if scheduledStakerTx, ok := stakerTx.(txs.ScheduledStaker); ok {
 				// Populate [StakerStartTime] using the tx as a default in the
 				// event it was added pre-durango and is not stored in the
 				// database.
@@ -2083,25 +2466,44 @@ func (s *state) write(updateValidators bool, height uint64) error {
 		codecVersion = CodecVersion0
 	}
 
+	// The state trie changes must be calculated prior to writing the state, as
+	// writing the state clears the changes.
+	var (
+		isFortuna    = s.upgrades.IsFortunaActivated(s.GetTimestamp())
+		stateTrieOps []database.BatchOp
+		err          error
+	)
+	if isFortuna {
+		stateTrieOps, err = s.stateTrieOps()
+		if err != nil {
+			return fmt.Errorf("failed to calculate state trie changes: %w", err)
+		}
+	}
+
 	return errors.Join(
 		s.writeBlocks(),
 		s.writeExpiry(),
 		s.updateValidatorManager(updateValidators),
 		s.writeValidatorDiffs(height),
+		s.writeValidatorSetSnapshots(updateValidators, height),
+		s.pruneValidatorDiffs(updateValidators, height),
 		s.writeCurrentStakers(codecVersion),
 		s.writePendingStakers(),
 		s.WriteValidatorMetadata(s.currentValidatorList, s.currentSubnetValidatorList, codecVersion), // Must be called after writeCurrentStakers
 		s.writeL1Validators(),
 		s.writeTXs(),
 		s.writeRewardUTXOs(),
-		s.writeUTXOs(),
+		s.writeWithdrawalOwners(),
+		s.writeUTXOs(height),
 		s.writeSubnets(),
 		s.writeSubnetOwners(),
 		s.writeSubnetToL1Conversions(),
 		s.writeTransformedSubnets(),
 		s.writeSubnetSupplies(),
 		s.writeChains(),
+		s.writeRetiredChains(),
 		s.writeMetadata(),
+		s.writeStateTrie(isFortuna, stateTrieOps, height), // Must be called last
 	)
 }
 
@@ -2125,19 +2527,107 @@ func (s *state) Close() error {
 		s.currentValidatorsDB.Close(),
 		s.validatorsDB.Close(),
 		s.txDB.Close(),
+		s.multiDelegationDB.Close(),
 		s.rewardUTXODB.Close(),
+		s.withdrawalOwnerDB.Close(),
 		s.utxoDB.Close(),
 		s.subnetBaseDB.Close(),
 		s.subnetToL1ConversionDB.Close(),
 		s.transformedSubnetDB.Close(),
 		s.supplyDB.Close(),
 		s.chainDB.Close(),
+		s.retiredChainDB.Close(),
 		s.singletonDB.Close(),
 		s.blockDB.Close(),
 		s.blockIDDB.Close(),
+		s.closeUTXOTrie(),
+		s.closeUTXODiffDB(),
+		s.closeUTXOSnapshots(),
+		s.closeRewardedStakerDB(),
+		s.stateTrie.close(),
 	)
 }
 
+func (s *state) closeUTXOTrie() error {
+	if s.utxoTrie == nil {
+		return nil
+	}
+	return s.utxoTrie.close()
+}
+
+func (s *state) closeUTXODiffDB() error {
+	if s.utxoDiffDB == nil {
+		return nil
+	}
+	return s.utxoDiffDB.Close()
+}
+
+func (s *state) closeUTXOSnapshots() error {
+	if s.utxoSnapshots == nil {
+		return nil
+	}
+	return s.utxoSnapshots.Close()
+}
+
+func (s *state) closeRewardedStakerDB() error {
+	if s.rewardedStakerDB == nil {
+		return nil
+	}
+	return s.rewardedStakerDB.Close()
+}
+
+func (s *state) GetRewardedStakers(start, end time.Time) ([]ids.ID, error) {
+	if s.rewardedStakerDB == nil {
+		return nil, ErrRewardedStakersDisabled
+	}
+
+	it := s.rewardedStakerDB.NewIteratorWithStart(database.PackUInt64(uint64(start.Unix())))
+	defer it.Release()
+
+	endTime := uint64(end.Unix())
+	var txIDs []ids.ID
+	for it.Next() {
+		key := it.Key()
+		if len(key) != database.Uint64Size+ids.IDLen {
+			return nil, fmt.Errorf("unexpected rewarded staker key length %d", len(key))
+		}
+		rewardTime, err := database.ParseUInt64(key[:database.Uint64Size])
+		if err != nil {
+			return nil, err
+		}
+		if rewardTime >= endTime {
+			break
+		}
+		txID, err := ids.ToID(key[database.Uint64Size:])
+		if err != nil {
+			return nil, err
+		}
+		txIDs = append(txIDs, txID)
+	}
+	return txIDs, it.Error()
+}
+
+func (s *state) GetUTXODiff(height uint64) (*avax.UTXODiff, error) {
+	if s.utxoDiffDB == nil {
+		return nil, avax.ErrUTXODiffsDisabled
+	}
+	return avax.GetUTXODiff(s.utxoDiffDB, height)
+}
+
+func (s *state) GetUTXOSnapshot(height uint64, startUTXOID ids.ID, limit int) (*avax.UTXOSnapshotPage, error) {
+	if s.utxoSnapshots == nil {
+		return nil, avax.ErrUTXOSnapshotsDisabled
+	}
+	return s.utxoSnapshots.Get(height, startUTXOID, limit)
+}
+
+func (s *state) GetUTXOProof(ctx context.Context, utxoID ids.ID, height uint64) (*merkledb.RangeProof, ids.ID, error) {
+	if s.utxoTrie == nil {
+		return nil, ids.Empty, ErrUTXOProofsDisabled
+	}
+	return s.utxoTrie.proof(ctx, utxoID, height)
+}
+
 func (s *state) sync(genesis []byte) error {
 	wasInitialized, err := isInitialized(s.singletonDB)
 	if err != nil {
@@ -2662,12 +3152,48 @@ func (s *state) writeCurrentStakers(codecVersion uint16) error {
 				}
 
 				s.validatorState.LoadValidatorMetadata(nodeID, subnetID, metadata)
+
+				// The validator may have been modified in the same block that
+				// it was added.
+				if !validatorDiff.updated {
+					break
+				}
+				if err := s.writeModifiedValidator(staker); err != nil {
+					return err
+				}
 			case deleted:
 				if err := validatorDB.Delete(validatorDiff.validator.TxID[:]); err != nil {
 					return fmt.Errorf("failed to delete current staker: %w", err)
 				}
 
 				s.validatorState.DeleteValidatorMetadata(nodeID, subnetID)
+
+				modifiedDB := s.currentSubnetValidatorWeightDB
+				if subnetID == constants.PrimaryNetworkID {
+					modifiedDB = s.currentContinuousValidatorDB
+				}
+				if err := modifiedDB.Delete(validatorDiff.validator.TxID[:]); err != nil {
+					return fmt.Errorf("failed to delete modified current validator: %w", err)
+				}
+			case modified:
+				staker := validatorDiff.validator
+				if err := s.writeModifiedValidator(staker); err != nil {
+					return err
+				}
+				if subnetID != constants.PrimaryNetworkID {
+					break
+				}
+
+				// A renewed validator starts a new staking period.
+				err := s.validatorState.SetStakingPeriod(
+					nodeID,
+					subnetID,
+					staker.StartTime,
+					staker.PotentialReward,
+				)
+				if err != nil {
+					return fmt.Errorf("failed to update staking period: %w", err)
+				}
 			}
 
 			err := writeCurrentDelegatorDiff(
@@ -2684,6 +3210,21 @@ func (s *state) writeCurrentStakers(codecVersion uint16) error {
 	return nil
 }
 
+// writeModifiedValidator persists the fields of [staker] that may have been
+// modified after it was added.
+func (s *state) writeModifiedValidator(staker *Staker) error {
+	if staker.SubnetID == constants.PrimaryNetworkID {
+		if err := putContinuousValidatorMetadata(s.currentContinuousValidatorDB, staker); err != nil {
+			return fmt.Errorf("failed to write current continuous validator: %w", err)
+		}
+		return nil
+	}
+	if err := database.PutUInt64(s.currentSubnetValidatorWeightDB, staker.TxID[:], staker.Weight); err != nil {
+		return fmt.Errorf("failed to write current validator weight: %w", err)
+	}
+	return nil
+}
+
 func writeCurrentDelegatorDiff(
 	currentDelegatorList linkeddb.LinkedDB,
 	validatorDiff *diffValidator,
@@ -2883,13 +3424,25 @@ func (s *state) writeTXs() error {
 			return fmt.Errorf("failed to add tx: %w", err)
 		}
 	}
+	for delegationID, txID := range s.addedMultiDelegations {
+		delete(s.addedMultiDelegations, delegationID)
+		if err := database.PutID(s.multiDelegationDB, delegationID[:], txID); err != nil {
+			return fmt.Errorf("failed to add multi delegation: %w", err)
+		}
+	}
 	return nil
 }
 
 func (s *state) writeRewardUTXOs() error {
+	rewardTime := database.PackUInt64(uint64(s.GetTimestamp().Unix()))
 	for txID, utxos := range s.addedRewardUTXOs {
 		delete(s.addedRewardUTXOs, txID)
 		s.rewardUTXOsCache.Put(txID, utxos)
+		if s.rewardedStakerDB != nil {
+			if err := s.rewardedStakerDB.Put(append(rewardTime, txID[:]...), nil); err != nil {
+				return fmt.Errorf("failed to index rewarded staker: %w", err)
+			}
+		}
 		rawTxDB := prefixdb.New(txID[:], s.rewardUTXODB)
 		txDB := linkeddb.NewDefault(rawTxDB)
 
@@ -2907,7 +3460,26 @@ func (s *state) writeRewardUTXOs() error {
 	return nil
 }
 
-func (s *state) writeUTXOs() error {
+func (s *state) writeWithdrawalOwners() error {
+	for txID, owner := range s.withdrawalOwners {
+		delete(s.withdrawalOwners, txID)
+
+		ownerBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, &owner)
+		if err != nil {
+			return fmt.Errorf("failed to marshal withdrawal owner: %w", err)
+		}
+		if err := s.withdrawalOwnerDB.Put(txID[:], ownerBytes); err != nil {
+			return fmt.Errorf("failed to write withdrawal owner: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) writeUTXOs(height uint64) error {
+	var (
+		trieOps []database.BatchOp
+		diff    avax.UTXODiff
+	)
 	for utxoID, utxo := range s.modifiedUTXOs {
 		delete(s.modifiedUTXOs, utxoID)
 
@@ -2915,15 +3487,85 @@ func (s *state) writeUTXOs() error {
 			if err := s.utxoState.DeleteUTXO(utxoID); err != nil {
 				return fmt.Errorf("failed to delete UTXO: %w", err)
 			}
+			diff.Removed = append(diff.Removed, utxoID)
+			if s.utxoTrie != nil {
+				trieOps = append(trieOps, database.BatchOp{
+					Key:    utxoID[:],
+					Delete: true,
+				})
+			}
 			continue
 		}
 		if err := s.utxoState.PutUTXO(utxo); err != nil {
 			return fmt.Errorf("failed to add UTXO: %w", err)
 		}
+		diff.Added = append(diff.Added, utxoID)
+		if s.utxoTrie != nil {
+			utxoBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, utxo)
+			if err != nil {
+				return fmt.Errorf("failed to serialize UTXO: %w", err)
+			}
+			trieOps = append(trieOps, database.BatchOp{
+				Key:   utxoID[:],
+				Value: utxoBytes,
+			})
+		}
+	}
+
+	if err := s.writeUTXODiff(height, &diff); err != nil {
+		return fmt.Errorf("failed to write UTXO diff: %w", err)
+	}
+	if err := s.writeUTXOSnapshot(height); err != nil {
+		return fmt.Errorf("failed to write UTXO snapshot: %w", err)
+	}
+
+	if s.utxoTrie == nil {
+		return nil
+	}
+	if err := s.utxoTrie.apply(trieOps, height); err != nil {
+		return fmt.Errorf("failed to update UTXO trie: %w", err)
 	}
 	return nil
 }
 
+func (s *state) writeUTXODiff(height uint64, diff *avax.UTXODiff) error {
+	if s.utxoDiffDB == nil {
+		return nil
+	}
+
+	// The genesis state is written before it is committed at height 0, so an
+	// empty diff must not replace the genesis UTXOs.
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 {
+		has, err := s.utxoDiffDB.Has(database.PackUInt64(height))
+		if err != nil || has {
+			return err
+		}
+	}
+	return avax.PutUTXODiff(s.utxoDiffDB, height, diff)
+}
+
+// writeUTXOSnapshot copies the UTXO set if [height] is a multiple of the
+// snapshot interval, and prunes the snapshot that is no longer retained.
+func (s *state) writeUTXOSnapshot(height uint64) error {
+	if s.utxoSnapshots == nil || height%s.utxoSnapshotInterval != 0 {
+		return nil
+	}
+
+	// The same height may be written more than once, as the genesis state is
+	// written before it is committed at height 0.
+	if err := s.utxoSnapshots.Delete(height); err != nil {
+		return err
+	}
+	if _, err := s.utxoSnapshots.Put(height, s.utxoState.NewUTXOIterator()); err != nil {
+		return err
+	}
+
+	if s.utxoSnapshotRetention == 0 || height/s.utxoSnapshotInterval < s.utxoSnapshotRetention {
+		return nil
+	}
+	return s.utxoSnapshots.Delete(height - s.utxoSnapshotRetention*s.utxoSnapshotInterval)
+}
+
 func (s *state) writeSubnets() error {
 	for _, subnetID := range s.addedSubnetIDs {
 		if err := s.subnetDB.Put(subnetID[:], nil); err != nil {
@@ -2934,6 +3576,34 @@ func (s *state) writeSubnets() error {
 	return nil
 }
 
+// writeStateTrie applies [ops] to the state trie once Fortuna is activated.
+// Before then, the trie is marked as not being in sync with any height.
+//
+// If the trie wasn't maintained, for example because this is the first height
+// after Fortuna was activated, it is rebuilt from the written state instead.
+func (s *state) writeStateTrie(isFortuna bool, ops []database.BatchOp, height uint64) error {
+	switch {
+	case !isFortuna && s.stateTrie.stale():
+		return nil
+	case !isFortuna:
+		return s.stateTrie.clearHeight()
+	}
+	if s.stateTrie.stale() {
+		s.ctx.Log.Info("rebuilding state trie",
+			zap.Uint64("height", height),
+		)
+		rebuildOps, err := s.stateTrieRebuildOps()
+		if err != nil {
+			return fmt.Errorf("failed to read state trie contents: %w", err)
+		}
+		return s.stateTrie.rebuild(rebuildOps, height)
+	}
+	if err := s.stateTrie.apply(ops, height); err != nil {
+		return fmt.Errorf("failed to update state trie: %w", err)
+	}
+	return nil
+}
+
 func (s *state) writeSubnetOwners() error {
 	for subnetID, owner := range s.subnetOwners {
 		delete(s.subnetOwners, subnetID)
@@ -3015,6 +3685,16 @@ func (s *state) writeChains() error {
 	return nil
 }
 
+func (s *state) writeRetiredChains() error {
+	for chainID := range s.retiredChains {
+		if err := s.retiredChainDB.Put(chainID[:], nil); err != nil {
+			return fmt.Errorf("failed to write retired chain: %w", err)
+		}
+	}
+	s.retiredChains.Clear()
+	return nil
+}
+
 func (s *state) writeMetadata() error {
 	if !s.persistedTimestamp.Equal(s.timestamp) {
 		if err := database.PutTimestamp(s.singletonDB, TimestampKey, s.timestamp); err != nil {
@@ -3052,6 +3732,12 @@ func (s *state) writeMetadata() error {
 		}
 		s.persistedLastAccepted = s.lastAccepted
 	}
+	if !s.persistedUptimesStoppedAt.Equal(s.uptimesStoppedAt) {
+		if err := database.PutTimestamp(s.singletonDB, UptimesStoppedAtKey, s.uptimesStoppedAt); err != nil {
+			return fmt.Errorf("failed to write uptimes stopped at: %w", err)
+		}
+		s.persistedUptimesStoppedAt = s.uptimesStoppedAt
+	}
 	if s.indexedHeights != nil {
 		indexedHeightsBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, s.indexedHeights)
 		if err != nil {
@@ -23,7 +23,9 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -49,11 +51,35 @@ import (
 	"github.com/ava-labs/avalanchego/vms/types"
 
 	safemath "github.com/ava-labs/avalanchego/utils/math"
+	platformvmgenesis "github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 )
 
 var defaultValidatorNodeID = ids.GenerateTestNodeID()
 
 func newTestState(t testing.TB, db database.Database) *state {
+	return newTestStateWithConfig(t, db, &config.Default)
+}
+
+func newTestStateWithConfig(t testing.TB, db database.Database, execCfg *config.Config) *state {
+	return newTestStateWithStakingConfig(t, db, StakingConfig{}, execCfg)
+}
+
+func newTestStateWithStakingConfig(
+	t testing.TB,
+	db database.Database,
+	stakingConfig StakingConfig,
+	execCfg *config.Config,
+) *state {
+	return newTestStateWithUpgrades(t, db, upgradetest.GetConfig(upgradetest.Latest), stakingConfig, execCfg)
+}
+
+func newTestStateWithUpgrades(
+	t testing.TB,
+	db database.Database,
+	upgrades upgrade.Config,
+	stakingConfig StakingConfig,
+	execCfg *config.Config,
+) *state {
 	s, err := New(
 		db,
 		genesistest.NewBytes(t, genesistest.Config{
@@ -61,8 +87,9 @@ func newTestState(t testing.TB, db database.Database) *state {
 		}),
 		prometheus.NewRegistry(),
 		validators.NewManager(),
-		upgradetest.GetConfig(upgradetest.Latest),
-		&config.Default,
+		upgrades,
+		stakingConfig,
+		execCfg,
 		&snow.Context{
 			NetworkID: constants.UnitTestID,
 			NodeID:    ids.GenerateTestNodeID(),
@@ -113,6 +140,99 @@ func TestStateSyncGenesis(t *testing.T) {
 	)
 }
 
+func TestUTXODiffs(t *testing.T) {
+	require := require.New(t)
+
+	execCfg := config.Default
+	execCfg.IndexUTXODiffs = true
+	state := newTestStateWithConfig(t, memdb.New(), &execCfg)
+
+	// The genesis UTXOs are added at height 0.
+	genesisUTXOID := avax.UTXOID{
+		TxID: snowtest.AVAXAssetID,
+	}
+	diff, err := state.GetUTXODiff(0)
+	require.NoError(err)
+	require.Contains(diff.Added, genesisUTXOID.InputID())
+	require.Empty(diff.Removed)
+
+	newUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: genesistest.AVAXAsset,
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+		},
+	}
+	state.AddUTXO(newUTXO)
+	state.DeleteUTXO(genesisUTXOID.InputID())
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	diff, err = state.GetUTXODiff(1)
+	require.NoError(err)
+	require.Equal(
+		&avax.UTXODiff{
+			Added:   []ids.ID{newUTXO.InputID()},
+			Removed: []ids.ID{genesisUTXOID.InputID()},
+		},
+		diff,
+	)
+
+	_, err = state.GetUTXODiff(2)
+	require.ErrorIs(err, avax.ErrUTXODiffUnavailable)
+
+	_, err = newTestState(t, memdb.New()).GetUTXODiff(0)
+	require.ErrorIs(err, avax.ErrUTXODiffsDisabled)
+}
+
+func TestRewardedStakers(t *testing.T) {
+	require := require.New(t)
+
+	execCfg := config.Default
+	execCfg.RewardReportEpochDuration = time.Hour
+	state := newTestStateWithConfig(t, memdb.New(), &execCfg)
+
+	var (
+		startTime     = state.GetTimestamp()
+		rewardedTxIDs = []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()}
+		newRewardUTXO = func(txID ids.ID) *avax.UTXO {
+			return &avax.UTXO{
+				UTXOID: avax.UTXOID{
+					TxID: txID,
+				},
+				Asset: genesistest.AVAXAsset,
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 1,
+				},
+			}
+		}
+	)
+	for i, txID := range rewardedTxIDs {
+		state.SetTimestamp(startTime.Add(time.Duration(i+1) * time.Second))
+		state.AddRewardUTXO(txID, newRewardUTXO(txID))
+		state.SetHeight(uint64(i + 1))
+		require.NoError(state.Commit())
+	}
+
+	txIDs, err := state.GetRewardedStakers(startTime, startTime.Add(time.Hour))
+	require.NoError(err)
+	require.Equal(rewardedTxIDs, txIDs)
+
+	// The start time is inclusive and the end time is exclusive.
+	txIDs, err = state.GetRewardedStakers(startTime.Add(time.Second), startTime.Add(2*time.Second))
+	require.NoError(err)
+	require.Equal(rewardedTxIDs[:1], txIDs)
+
+	txIDs, err = state.GetRewardedStakers(startTime.Add(time.Minute), startTime.Add(time.Hour))
+	require.NoError(err)
+	require.Empty(txIDs)
+
+	_, err = newTestState(t, memdb.New()).GetRewardedStakers(startTime, startTime)
+	require.ErrorIs(err, ErrRewardedStakersDisabled)
+}
+
 // Whenever we add or remove a staker, a number of on-disk data structures
 // should be updated.
 //
@@ -543,6 +663,141 @@ func TestState_writeStakers(t *testing.T) {
 	}
 }
 
+func TestStakingParams(t *testing.T) {
+	require := require.New(t)
+
+	g := genesistest.New(t, genesistest.Config{
+		StakingParams: &platformvmgenesis.StakingParams{
+			MinValidatorStake: 1,
+			MaxValidatorStake: 2,
+			MinDelegatorStake: 3,
+			MinStakeDuration:  60,
+			MaxStakeDuration:  120,
+		},
+	})
+	genesisBytes, err := g.Bytes()
+	require.NoError(err)
+
+	var (
+		fortunaTime = genesistest.DefaultValidatorStartTime.Add(time.Hour)
+		cfg         = &config.Internal{
+			MinValidatorStake: 4,
+			MaxValidatorStake: 5,
+			MinDelegatorStake: 6,
+			MinStakeDuration:  time.Hour,
+			MaxStakeDuration:  2 * time.Hour,
+			UpgradeConfig:     upgradetest.GetConfigWithUpgradeTime(upgradetest.Fortuna, fortunaTime),
+		}
+		nodeStakingParams = StakingParams{
+			MinValidatorStake: 4,
+			MaxValidatorStake: 5,
+			MinDelegatorStake: 6,
+			MinStakeDuration:  time.Hour,
+			MaxStakeDuration:  2 * time.Hour,
+		}
+		genesisStakingParams = StakingParams{
+			MinValidatorStake: 1,
+			MaxValidatorStake: 2,
+			MinDelegatorStake: 3,
+			MinStakeDuration:  time.Minute,
+			MaxStakeDuration:  2 * time.Minute,
+		}
+	)
+	stakingConfig, err := NewStakingConfig(genesisBytes, cfg)
+	require.NoError(err)
+	require.Equal(
+		StakingConfig{
+			Node:        nodeStakingParams,
+			Genesis:     &genesisStakingParams,
+			FortunaTime: fortunaTime,
+		},
+		stakingConfig,
+	)
+
+	// The staking params of the genesis only take effect once Fortuna is
+	// activated.
+	state := newTestStateWithStakingConfig(t, memdb.New(), stakingConfig, &config.Default)
+	state.SetTimestamp(fortunaTime.Add(-time.Second))
+	require.Equal(nodeStakingParams, state.GetStakingParams())
+
+	d, err := NewDiffOn(state)
+	require.NoError(err)
+	require.Equal(nodeStakingParams, d.GetStakingParams())
+
+	d.SetTimestamp(fortunaTime)
+	require.Equal(genesisStakingParams, d.GetStakingParams())
+
+	state.SetTimestamp(fortunaTime)
+	require.Equal(genesisStakingParams, state.GetStakingParams())
+
+	// A genesis without staking params uses the staking params configured on
+	// the node.
+	g.StakingParams = nil
+	genesisBytes, err = g.Bytes()
+	require.NoError(err)
+
+	stakingConfig, err = NewStakingConfig(genesisBytes, cfg)
+	require.NoError(err)
+	require.Nil(stakingConfig.Genesis)
+	require.Equal(nodeStakingParams, stakingConfig.At(fortunaTime))
+}
+
+func TestUTXOSnapshots(t *testing.T) {
+	require := require.New(t)
+
+	execCfg := config.Default
+	execCfg.UTXOSnapshotInterval = 2
+	execCfg.UTXOSnapshotRetention = 1
+	state := newTestStateWithConfig(t, memdb.New(), &execCfg)
+
+	// The genesis UTXOs are snapshotted at height 0.
+	genesisUTXOID := avax.UTXOID{
+		TxID: snowtest.AVAXAssetID,
+	}
+	page, err := state.GetUTXOSnapshot(0, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.NoError(err)
+	require.Contains(page.UTXOIDs, genesisUTXOID.InputID())
+	require.False(page.More)
+
+	newUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: genesistest.AVAXAsset,
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1,
+		},
+	}
+	state.AddUTXO(newUTXO)
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	_, err = state.GetUTXOSnapshot(1, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.ErrorIs(err, avax.ErrUTXOSnapshotUnavailable)
+
+	state.DeleteUTXO(genesisUTXOID.InputID())
+	state.SetHeight(2)
+	require.NoError(state.Commit())
+
+	page, err = state.GetUTXOSnapshot(2, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.NoError(err)
+	require.Contains(page.UTXOIDs, newUTXO.InputID())
+	require.NotContains(page.UTXOIDs, genesisUTXOID.InputID())
+
+	hasher := avax.NewUTXOSnapshotHasher()
+	for i, utxoID := range page.UTXOIDs {
+		require.NoError(hasher.Add(utxoID, page.UTXOs[i]))
+	}
+	require.Equal(page.Digest, hasher.Digest())
+
+	// Only the most recent snapshot is retained.
+	_, err = state.GetUTXOSnapshot(0, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.ErrorIs(err, avax.ErrUTXOSnapshotUnavailable)
+
+	_, err = newTestState(t, memdb.New()).GetUTXOSnapshot(0, ids.Empty, avax.MaxUTXOSnapshotPageSize)
+	require.ErrorIs(err, avax.ErrUTXOSnapshotsDisabled)
+}
+
 func createPermissionlessValidatorTx(t testing.TB, subnetID ids.ID, validatorsData txs.Validator) *txs.AddPermissionlessValidatorTx {
 	var sig signer.Signer = &signer.Empty{}
 	if subnetID == constants.PrimaryNetworkID {
@@ -1250,6 +1505,73 @@ func TestStateSubnetToL1Conversion(t *testing.T) {
 	}
 }
 
+func TestStateWithdrawalOwner(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		db    = memdb.New()
+		state = newTestState(t, db)
+		txID  = ids.GenerateTestID()
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				ids.GenerateTestShortID(),
+			},
+		}
+	)
+
+	_, err := state.GetWithdrawalOwner(txID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	state.SetWithdrawalOwner(txID, owner)
+	actualOwner, err := state.GetWithdrawalOwner(txID)
+	require.NoError(err)
+	require.Equal(owner, actualOwner)
+
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+	require.NoError(state.Close())
+
+	// The withdrawal owner is persisted.
+	state = newTestState(t, db)
+	actualOwner, err = state.GetWithdrawalOwner(txID)
+	require.NoError(err)
+	require.Equal(owner, actualOwner)
+}
+
+func TestStateRetiredChains(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		db      = memdb.New()
+		state   = newTestState(t, db)
+		chainID = ids.GenerateTestID()
+	)
+
+	retired, err := state.IsChainRetired(chainID)
+	require.NoError(err)
+	require.False(retired)
+
+	state.RetireChain(chainID)
+	retired, err = state.IsChainRetired(chainID)
+	require.NoError(err)
+	require.True(retired)
+
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+	require.NoError(state.Close())
+
+	// The retirement is persisted.
+	state = newTestState(t, db)
+	retired, err = state.IsChainRetired(chainID)
+	require.NoError(err)
+	require.True(retired)
+
+	retired, err = state.IsChainRetired(ids.GenerateTestID())
+	require.NoError(err)
+	require.False(retired)
+}
+
 func makeBlocks(require *require.Assertions) []block.Block {
 	var blks []block.Block
 	{
@@ -1390,6 +1712,23 @@ func TestStateAccruedFeesCommitAndLoad(t *testing.T) {
 	require.Equal(expectedAccruedFees, s.GetAccruedFees())
 }
 
+// Verify that committing the state writes the time uptime tracking was stopped
+// to the database and that loading the state fetches it from the database.
+func TestStateTrackingStoppedAtCommitAndLoad(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	s := newTestState(t, db)
+	require.Zero(s.GetTrackingStoppedAt())
+
+	expectedTrackingStoppedAt := time.Unix(1_000_000, 0)
+	s.SetTrackingStoppedAt(expectedTrackingStoppedAt)
+	require.NoError(s.Commit())
+
+	s = newTestState(t, db)
+	require.True(expectedTrackingStoppedAt.Equal(s.GetTrackingStoppedAt()))
+}
+
 func TestMarkAndIsInitialized(t *testing.T) {
 	require := require.New(t)
 
@@ -2091,6 +2430,186 @@ func TestL1ValidatorAfterLegacyRemoval(t *testing.T) {
 	require.NoError(state.Commit())
 }
 
+// TestUpdateCurrentSubnetValidatorWeight verifies that modifying the weight of
+// a subnet validator is persisted and can be reverted with the weight diffs.
+func TestUpdateCurrentSubnetValidatorWeight(t *testing.T) {
+	var (
+		require                = require.New(t)
+		db                     = memdb.New()
+		state                  = newTestState(t, db)
+		subnetID               = ids.GenerateTestID()
+		initialWeight   uint64 = 1
+		updatedWeight   uint64 = 5
+		subnetValidator        = createPermissionlessValidatorTx(
+			t,
+			subnetID,
+			txs.Validator{
+				NodeID: defaultValidatorNodeID,
+				End:    genesistest.DefaultValidatorEndTimeUnix,
+				Wght:   initialWeight,
+			},
+		)
+		addSubnetValidator = &txs.Tx{Unsigned: subnetValidator}
+	)
+	require.NoError(addSubnetValidator.Initialize(txs.Codec))
+	state.AddTx(addSubnetValidator, status.Committed)
+
+	staker := &Staker{
+		TxID:      addSubnetValidator.ID(),
+		NodeID:    defaultValidatorNodeID,
+		SubnetID:  subnetID,
+		Weight:    initialWeight,
+		StartTime: genesistest.DefaultValidatorStartTime,
+		EndTime:   genesistest.DefaultValidatorEndTime,
+	}
+	require.NoError(state.PutCurrentValidator(staker))
+
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	d, err := NewDiffOn(state)
+	require.NoError(err)
+
+	updatedStaker := *staker
+	updatedStaker.Weight = updatedWeight
+	require.NoError(d.UpdateCurrentValidator(&updatedStaker))
+
+	gotStaker, err := d.GetCurrentValidator(subnetID, defaultValidatorNodeID)
+	require.NoError(err)
+	require.Equal(&updatedStaker, gotStaker)
+
+	require.NoError(d.Apply(state))
+	state.SetHeight(2)
+	require.NoError(state.Commit())
+
+	validatorSet := state.validators.GetMap(subnetID)
+	require.Len(validatorSet, 1)
+	require.Equal(updatedWeight, validatorSet[defaultValidatorNodeID].Weight)
+
+	// The updated weight must be restored when reloading the state.
+	reloadedState := newTestState(t, db)
+	gotStaker, err = reloadedState.GetCurrentValidator(subnetID, defaultValidatorNodeID)
+	require.NoError(err)
+	require.Equal(updatedWeight, gotStaker.Weight)
+	require.Equal(validatorSet, reloadedState.validators.GetMap(subnetID))
+
+	// Reverting the diff at height 2 must restore the initial weight.
+	require.NoError(state.ApplyValidatorWeightDiffs(context.Background(), validatorSet, 2, 2, subnetID))
+	require.Equal(initialWeight, validatorSet[defaultValidatorNodeID].Weight)
+
+	// Removing the validator must remove the updated weight.
+	state.DeleteCurrentValidator(&updatedStaker)
+	state.SetHeight(3)
+	require.NoError(state.Commit())
+
+	validatorSet = state.validators.GetMap(subnetID)
+	require.Empty(validatorSet)
+
+	require.NoError(state.ApplyValidatorWeightDiffs(context.Background(), validatorSet, 3, 3, subnetID))
+	require.Equal(updatedWeight, validatorSet[defaultValidatorNodeID].Weight)
+
+	has, err := state.currentSubnetValidatorWeightDB.Has(staker.TxID[:])
+	require.NoError(err)
+	require.False(has)
+}
+
+func TestRenewCurrentContinuousValidator(t *testing.T) {
+	var (
+		require   = require.New(t)
+		db        = memdb.New()
+		state     = newTestState(t, db)
+		nodeID    = ids.GenerateTestNodeID()
+		startTime = genesistest.DefaultValidatorStartTime
+		period    = 24 * time.Hour
+		endTime   = startTime.Add(period)
+		validator = &txs.AddContinuousValidatorTx{
+			AddPermissionlessValidatorTx: *createPermissionlessValidatorTx(
+				t,
+				constants.PrimaryNetworkID,
+				txs.Validator{
+					NodeID: nodeID,
+					End:    uint64(endTime.Unix()),
+					Wght:   units.Avax,
+				},
+			),
+			Period: uint64(period / time.Second),
+		}
+		addValidator = &txs.Tx{Unsigned: validator}
+	)
+	require.NoError(addValidator.Initialize(txs.Codec))
+	state.AddTx(addValidator, status.Committed)
+
+	staker, err := NewCurrentStaker(addValidator.ID(), validator, startTime, 1)
+	require.NoError(err)
+	require.Equal(period, staker.ContinuationPeriod)
+	require.NoError(state.PutCurrentValidator(staker))
+
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	// Renew the validator for another staking period.
+	d, err := NewDiffOn(state)
+	require.NoError(err)
+
+	renewedStaker := *staker
+	renewedStaker.Weight += staker.PotentialReward
+	renewedStaker.StartTime = staker.EndTime
+	renewedStaker.EndTime = staker.EndTime.Add(period)
+	renewedStaker.NextTime = renewedStaker.EndTime
+	renewedStaker.PotentialReward = 2
+	require.NoError(d.UpdateCurrentValidator(&renewedStaker))
+
+	require.NoError(d.Apply(state))
+	state.SetHeight(2)
+	require.NoError(state.Commit())
+
+	upDuration, lastUpdated, err := state.GetUptime(nodeID)
+	require.NoError(err)
+	require.Zero(upDuration)
+	require.Equal(renewedStaker.StartTime, lastUpdated)
+
+	// The renewed staking period must be restored when reloading the state.
+	reloadedState := newTestState(t, db)
+	gotStaker, err := reloadedState.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+	require.Equal(&renewedStaker, gotStaker)
+	require.Equal(
+		state.validators.GetMap(constants.PrimaryNetworkID),
+		reloadedState.validators.GetMap(constants.PrimaryNetworkID),
+	)
+
+	upDuration, lastUpdated, err = reloadedState.GetUptime(nodeID)
+	require.NoError(err)
+	require.Zero(upDuration)
+	require.Equal(renewedStaker.StartTime, lastUpdated)
+
+	// Stopping the validator must not start a new staking period.
+	stoppedStaker := renewedStaker
+	stoppedStaker.ContinuationPeriod = 0
+	require.NoError(reloadedState.SetUptime(nodeID, time.Hour, renewedStaker.StartTime.Add(time.Hour)))
+	require.NoError(reloadedState.UpdateCurrentValidator(&stoppedStaker))
+	reloadedState.SetHeight(3)
+	require.NoError(reloadedState.Commit())
+
+	upDuration, _, err = reloadedState.GetUptime(nodeID)
+	require.NoError(err)
+	require.Equal(time.Hour, upDuration)
+
+	reloadedState = newTestState(t, db)
+	gotStaker, err = reloadedState.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+	require.Equal(&stoppedStaker, gotStaker)
+
+	// Removing the validator must remove its continuous validator metadata.
+	reloadedState.DeleteCurrentValidator(&stoppedStaker)
+	reloadedState.SetHeight(4)
+	require.NoError(reloadedState.Commit())
+
+	has, err := reloadedState.currentContinuousValidatorDB.Has(staker.TxID[:])
+	require.NoError(err)
+	require.False(has)
+}
+
 func TestGetCurrentValidators(t *testing.T) {
 	subnetID1 := ids.GenerateTestID()
 	subnetID2 := ids.GenerateTestID()
@@ -2334,3 +2853,102 @@ func TestGetCurrentValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiDelegatorStakers(t *testing.T) {
+	var (
+		require   = require.New(t)
+		db        = memdb.New()
+		state     = newTestState(t, db)
+		startTime = time.Unix(genesistest.DefaultValidatorStartTime.Unix(), 0)
+		endTime   = startTime.Add(24 * time.Hour)
+		nodeIDs   = []ids.NodeID{
+			ids.BuildTestNodeID([]byte{1}),
+			ids.BuildTestNodeID([]byte{2}),
+		}
+		multiDelegatorTx = &txs.AddMultiDelegatorTx{
+			DelegationRewardsOwner: &secp256k1fx.OutputOwners{},
+		}
+	)
+	for _, nodeID := range nodeIDs {
+		validatorTx := &txs.Tx{
+			Unsigned: createPermissionlessValidatorTx(
+				t,
+				constants.PrimaryNetworkID,
+				txs.Validator{
+					NodeID: nodeID,
+					End:    uint64(endTime.Unix()),
+					Wght:   units.Avax,
+				},
+			),
+		}
+		require.NoError(validatorTx.Initialize(txs.Codec))
+		state.AddTx(validatorTx, status.Committed)
+
+		validator, err := NewCurrentStaker(
+			validatorTx.ID(),
+			validatorTx.Unsigned.(*txs.AddPermissionlessValidatorTx),
+			startTime,
+			0,
+		)
+		require.NoError(err)
+		require.NoError(state.PutCurrentValidator(validator))
+
+		multiDelegatorTx.Delegations = append(multiDelegatorTx.Delegations, &txs.Delegation{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				End:    uint64(endTime.Unix()),
+				Wght:   units.Avax,
+			},
+			StakeOuts: []*avax.TransferableOutput{},
+		})
+	}
+
+	tx := &txs.Tx{Unsigned: multiDelegatorTx}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	// The delegators must be retrievable before the tx is accepted.
+	d, err := NewDiffOn(state)
+	require.NoError(err)
+	d.AddTx(tx, status.Committed)
+
+	delegators := make([]*Staker, len(multiDelegatorTx.Delegations))
+	for i, delegator := range multiDelegatorTx.Delegators() {
+		delegationID := txs.DelegationID(tx.ID(), i)
+		stakerTx, err := d.GetStakerTx(delegationID)
+		require.NoError(err)
+		require.Equal(delegator, stakerTx)
+
+		delegators[i], err = NewCurrentStaker(delegationID, delegator, startTime, 1)
+		require.NoError(err)
+		d.PutCurrentDelegator(delegators[i])
+	}
+
+	require.NoError(d.Apply(state))
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	// The delegators must be restored when reloading the state.
+	reloadedState := newTestState(t, db)
+	for i, delegator := range multiDelegatorTx.Delegators() {
+		delegationID := txs.DelegationID(tx.ID(), i)
+		stakerTx, err := reloadedState.GetStakerTx(delegationID)
+		require.NoError(err)
+		require.Equal(delegator.NodeID(), stakerTx.NodeID())
+		require.Equal(delegator.Weight(), stakerTx.Weight())
+
+		it, err := reloadedState.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, delegator.NodeID())
+		require.NoError(err)
+		require.Equal([]*Staker{delegators[i]}, iterator.ToSlice(it))
+	}
+
+	// Looking up an unknown staker must fail.
+	_, err = reloadedState.GetStakerTx(tx.ID().Prefix(uint64(len(delegators))))
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Looking up a tx that doesn't add a staker must fail.
+	baseTx := &txs.Tx{Unsigned: &txs.BaseTx{}}
+	require.NoError(baseTx.Initialize(txs.Codec))
+	reloadedState.AddTx(baseTx, status.Committed)
+	_, err = reloadedState.GetStakerTx(baseTx.ID())
+	require.ErrorIs(err, ErrNotStakerTx)
+}
@@ -0,0 +1,495 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/iterator"
+	"github.com/ava-labs/avalanchego/utils/maybe"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/x/merkledb"
+)
+
+// Keys in the state trie are prefixed by the kind of state they commit to.
+const (
+	stateTrieUTXOPrefix byte = iota
+	stateTrieValidatorPrefix
+	stateTrieDelegatorPrefix
+	stateTrieL1ValidatorPrefix
+	stateTrieSubnetOwnerPrefix
+	stateTrieSubnetToL1ConversionPrefix
+)
+
+// StateTrieBranchFactor is the branch factor of the trie that the state root is
+// calculated from.
+const StateTrieBranchFactor = merkledb.BranchFactor16
+
+var (
+	errUnexpectedStateRootChain = errors.New("unexpected chain when calculating state root")
+	errStateTrieNotSynced       = errors.New("state trie can't be rebuilt with uncommitted changes")
+
+	stateTrieDBPrefix       = []byte("trie")
+	stateTrieMetadataPrefix = []byte("metadata")
+	stateTrieHeightKey      = []byte("height")
+)
+
+// stateTrieValidator is the representation of a current validator that is
+// committed to by the state root.
+type stateTrieValidator struct {
+	TxID      ids.ID `serialize:"true"`
+	PublicKey []byte `serialize:"true"`
+	Weight    uint64 `serialize:"true"`
+	StartTime uint64 `serialize:"true"`
+	EndTime   uint64 `serialize:"true"`
+}
+
+// stateTrie commits to the UTXOs, the current validator sets and the subnets of
+// the P-chain. Its root is included in Fortuna blocks.
+//
+// Like the utxoTrie, the stateTrie is written independently of the rest of the
+// state and is rebuilt from the state on startup if it isn't in sync with the
+// last accepted block.
+type stateTrie struct {
+	trie       merkledb.MerkleDB
+	metadataDB database.Database
+
+	// height is the height of the last committed changes, or nil if the trie
+	// may not be in sync with any height.
+	height *uint64
+}
+
+func newStateTrie(db database.Database, metricsReg prometheus.Registerer) (*stateTrie, error) {
+	trie, err := merkledb.New(
+		context.Background(),
+		prefixdb.New(stateTrieDBPrefix, db),
+		merkledb.Config{
+			BranchFactor:                StateTrieBranchFactor,
+			Hasher:                      merkledb.DefaultHasher,
+			HistoryLength:               1,
+			ValueNodeCacheSize:          units.MiB,
+			IntermediateNodeCacheSize:   units.MiB,
+			IntermediateWriteBufferSize: units.MiB,
+			IntermediateWriteBatchSize:  256 * units.KiB,
+			Reg:                         metricsReg,
+			Namespace:                   "state_trie",
+			TraceLevel:                  merkledb.NoTrace,
+			Tracer:                      trace.Noop,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create state trie: %w", err)
+	}
+
+	t := &stateTrie{
+		trie:       trie,
+		metadataDB: prefixdb.New(stateTrieMetadataPrefix, db),
+	}
+	height, err := database.GetUInt64(t.metadataDB, stateTrieHeightKey)
+	switch err {
+	case nil:
+		t.height = &height
+	case database.ErrNotFound:
+	default:
+		return nil, err
+	}
+	return t, nil
+}
+
+// synced returns true if the trie has been updated up to [height].
+func (t *stateTrie) synced(height uint64) bool {
+	return t.height != nil && *t.height == height
+}
+
+// stale returns true if the trie may not be in sync with any height.
+func (t *stateTrie) stale() bool {
+	return t.height == nil
+}
+
+// rebuild replaces the contents of the trie with [ops] and records [height] as
+// the height of the trie.
+func (t *stateTrie) rebuild(ops []database.BatchOp, height uint64) error {
+	if err := t.clearHeight(); err != nil {
+		return err
+	}
+	if err := t.trie.Clear(); err != nil {
+		return fmt.Errorf("failed to clear state trie: %w", err)
+	}
+	if err := t.commit(ops); err != nil {
+		return err
+	}
+	return t.setHeight(height)
+}
+
+// apply writes [ops] to the trie and records [height] as the height of the
+// trie.
+//
+// If [height] isn't greater than the current height, the ops are recorded at
+// the current height.
+func (t *stateTrie) apply(ops []database.BatchOp, height uint64) error {
+	if t.height != nil && height <= *t.height {
+		if len(ops) == 0 {
+			return nil
+		}
+		height = *t.height
+	}
+
+	// If the ops are only partially written, the trie must be rebuilt.
+	if err := t.clearHeight(); err != nil {
+		return err
+	}
+	if err := t.commit(ops); err != nil {
+		return err
+	}
+	return t.setHeight(height)
+}
+
+func (t *stateTrie) commit(ops []database.BatchOp) error {
+	ctx := context.Background()
+	view, err := t.trie.NewView(ctx, merkledb.ViewChanges{
+		BatchOps:     ops,
+		ConsumeBytes: true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := view.CommitToDB(ctx); err != nil {
+		return fmt.Errorf("failed to commit state trie: %w", err)
+	}
+	return nil
+}
+
+func (t *stateTrie) clearHeight() error {
+	t.height = nil
+	return t.metadataDB.Delete(stateTrieHeightKey)
+}
+
+func (t *stateTrie) setHeight(height uint64) error {
+	if err := database.PutUInt64(t.metadataDB, stateTrieHeightKey, height); err != nil {
+		return err
+	}
+	t.height = &height
+	return nil
+}
+
+// root returns the merkle root of the trie after applying [changes].
+func (t *stateTrie) root(ctx context.Context, changes map[string]maybe.Maybe[[]byte]) (ids.ID, error) {
+	if len(changes) == 0 {
+		return t.trie.GetMerkleRoot(ctx)
+	}
+
+	view, err := t.trie.NewView(ctx, merkledb.ViewChanges{
+		MapOps:       changes,
+		ConsumeBytes: true,
+	})
+	if err != nil {
+		return ids.Empty, err
+	}
+	return view.GetMerkleRoot(ctx)
+}
+
+func (t *stateTrie) close() error {
+	return t.trie.Close()
+}
+
+// GetStateRoot returns the merkle root of the UTXOs, current validator sets and
+// subnets of [chain].
+//
+// [chain] must either be the base state or a diff that is built on top of it.
+func GetStateRoot(ctx context.Context, chain Chain) (ids.ID, error) {
+	var diffs []*diff
+	for {
+		switch c := chain.(type) {
+		case *diff:
+			diffs = append(diffs, c)
+
+			parentState, ok := c.stateVersions.GetState(c.parentID)
+			if !ok {
+				return ids.Empty, fmt.Errorf("%w: %s", ErrMissingParentState, c.parentID)
+			}
+			chain = parentState
+		case *state:
+			// Apply the changes starting from the base state.
+			changes := make(map[string]maybe.Maybe[[]byte])
+			if err := addStateTrieChanges(changes, c.stateTrieOps); err != nil {
+				return ids.Empty, err
+			}
+
+			// The state trie isn't maintained before Fortuna, so it is
+			// rebuilt from the persisted state when the first state root is
+			// calculated.
+			if c.stateTrie.stale() {
+				if len(changes) != 0 {
+					return ids.Empty, errStateTrieNotSynced
+				}
+				if err := c.syncStateTrie(); err != nil {
+					return ids.Empty, err
+				}
+			}
+			for _, d := range slices.Backward(diffs) {
+				if err := addStateTrieChanges(changes, d.stateTrieOps); err != nil {
+					return ids.Empty, err
+				}
+			}
+			return c.stateTrie.root(ctx, changes)
+		default:
+			return ids.Empty, fmt.Errorf("%w: %T", errUnexpectedStateRootChain, chain)
+		}
+	}
+}
+
+func addStateTrieChanges(
+	changes map[string]maybe.Maybe[[]byte],
+	getOps func() ([]database.BatchOp, error),
+) error {
+	ops, err := getOps()
+	if err != nil {
+		return err
+	}
+	for _, op := range ops {
+		if op.Delete {
+			changes[string(op.Key)] = maybe.Nothing[[]byte]()
+		} else {
+			changes[string(op.Key)] = maybe.Some(op.Value)
+		}
+	}
+	return nil
+}
+
+func (d *diff) stateTrieOps() ([]database.BatchOp, error) {
+	return stateTrieOps(
+		d.modifiedUTXOs,
+		d.currentStakerDiffs.validatorDiffs,
+		d.l1ValidatorsDiff.modified,
+		d.subnetOwners,
+		d.subnetToL1Conversions,
+	)
+}
+
+func (s *state) stateTrieOps() ([]database.BatchOp, error) {
+	return stateTrieOps(
+		s.modifiedUTXOs,
+		s.currentStakers.validatorDiffs,
+		s.l1ValidatorsDiff.modified,
+		s.subnetOwners,
+		s.subnetToL1Conversions,
+	)
+}
+
+// stateTrieOps returns the changes to the state trie that result from the
+// provided changes to the state.
+//
+// The values written to the trie must match the values that are written to the
+// database, so that the trie can be rebuilt from the database.
+func stateTrieOps(
+	modifiedUTXOs map[ids.ID]*avax.UTXO,
+	validatorDiffs map[ids.ID]map[ids.NodeID]*diffValidator,
+	l1Validators map[ids.ID]L1Validator,
+	subnetOwners map[ids.ID]fx.Owner,
+	subnetToL1Conversions map[ids.ID]SubnetToL1Conversion,
+) ([]database.BatchOp, error) {
+	var ops []database.BatchOp
+	for utxoID, utxo := range modifiedUTXOs {
+		key := stateTrieKey(stateTrieUTXOPrefix, utxoID[:])
+		if utxo == nil {
+			ops = append(ops, database.BatchOp{
+				Key:    key,
+				Delete: true,
+			})
+			continue
+		}
+
+		utxoBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize UTXO: %w", err)
+		}
+		ops = append(ops, database.BatchOp{
+			Key:   key,
+			Value: utxoBytes,
+		})
+	}
+
+	for subnetID, subnetValidatorDiffs := range validatorDiffs {
+		for nodeID, validatorDiff := range subnetValidatorDiffs {
+			key := stateTrieKey(stateTrieValidatorPrefix, subnetID[:], nodeID.Bytes())
+			switch validatorDiff.validatorStatus {
+			case added, modified:
+				validatorBytes, err := marshalStateTrieValidator(validatorDiff.validator)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, database.BatchOp{
+					Key:   key,
+					Value: validatorBytes,
+				})
+			case deleted:
+				ops = append(ops, database.BatchOp{
+					Key:    key,
+					Delete: true,
+				})
+			}
+
+			// Delegators that were added and then removed are included in both
+			// [addedDelegators] and [deletedDelegators], so the deletions must
+			// be applied last.
+			addedDelegators := iterator.FromTree(validatorDiff.addedDelegators)
+			for addedDelegators.Next() {
+				delegator := addedDelegators.Value()
+				ops = append(ops, database.BatchOp{
+					Key:   stateTrieKey(stateTrieDelegatorPrefix, subnetID[:], nodeID.Bytes(), delegator.TxID[:]),
+					Value: database.PackUInt64(delegator.Weight),
+				})
+			}
+			addedDelegators.Release()
+
+			for _, delegator := range validatorDiff.deletedDelegators {
+				ops = append(ops, database.BatchOp{
+					Key:    stateTrieKey(stateTrieDelegatorPrefix, subnetID[:], nodeID.Bytes(), delegator.TxID[:]),
+					Delete: true,
+				})
+			}
+		}
+	}
+
+	for validationID, l1Validator := range l1Validators {
+		key := stateTrieKey(stateTrieL1ValidatorPrefix, validationID[:])
+		if l1Validator.isDeleted() {
+			ops = append(ops, database.BatchOp{
+				Key:    key,
+				Delete: true,
+			})
+			continue
+		}
+
+		l1ValidatorBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, l1Validator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal L1 validator: %w", err)
+		}
+		ops = append(ops, database.BatchOp{
+			Key:   key,
+			Value: l1ValidatorBytes,
+		})
+	}
+
+	for subnetID, owner := range subnetOwners {
+		ownerBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, &owner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal subnet owner: %w", err)
+		}
+		ops = append(ops, database.BatchOp{
+			Key:   stateTrieKey(stateTrieSubnetOwnerPrefix, subnetID[:]),
+			Value: ownerBytes,
+		})
+	}
+
+	for subnetID, c := range subnetToL1Conversions {
+		conversionBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, &c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal subnet conversion: %w", err)
+		}
+		ops = append(ops, database.BatchOp{
+			Key:   stateTrieKey(stateTrieSubnetToL1ConversionPrefix, subnetID[:]),
+			Value: conversionBytes,
+		})
+	}
+	return ops, nil
+}
+
+// stateTrieRebuildOps returns the contents of the state trie as described by
+// the persisted state. Migrating to the state trie relies on this to build the
+// initial trie from the existing database.
+func (s *state) stateTrieRebuildOps() ([]database.BatchOp, error) {
+	var ops []database.BatchOp
+	addDB := func(prefix byte, it database.Iterator) error {
+		defer it.Release()
+
+		for it.Next() {
+			ops = append(ops, database.BatchOp{
+				Key:   stateTrieKey(prefix, it.Key()),
+				Value: slices.Clone(it.Value()),
+			})
+		}
+		return it.Error()
+	}
+	err := errors.Join(
+		addDB(stateTrieUTXOPrefix, s.utxoState.NewUTXOIterator()),
+		addDB(stateTrieL1ValidatorPrefix, s.activeDB.NewIterator()),
+		addDB(stateTrieL1ValidatorPrefix, s.inactiveDB.NewIterator()),
+		addDB(stateTrieSubnetOwnerPrefix, s.subnetOwnerDB.NewIterator()),
+		addDB(stateTrieSubnetToL1ConversionPrefix, s.subnetToL1ConversionDB.NewIterator()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for subnetID, subnetValidators := range s.currentStakers.validators {
+		for nodeID, validator := range subnetValidators {
+			if validator.validator != nil {
+				validatorBytes, err := marshalStateTrieValidator(validator.validator)
+				if err != nil {
+					return nil, err
+				}
+				ops = append(ops, database.BatchOp{
+					Key:   stateTrieKey(stateTrieValidatorPrefix, subnetID[:], nodeID.Bytes()),
+					Value: validatorBytes,
+				})
+			}
+
+			delegators := iterator.FromTree(validator.delegators)
+			for delegators.Next() {
+				delegator := delegators.Value()
+				ops = append(ops, database.BatchOp{
+					Key:   stateTrieKey(stateTrieDelegatorPrefix, subnetID[:], nodeID.Bytes(), delegator.TxID[:]),
+					Value: database.PackUInt64(delegator.Weight),
+				})
+			}
+			delegators.Release()
+		}
+	}
+	return ops, nil
+}
+
+func marshalStateTrieValidator(staker *Staker) ([]byte, error) {
+	validator := &stateTrieValidator{
+		TxID:      staker.TxID,
+		Weight:    staker.Weight,
+		StartTime: uint64(staker.StartTime.Unix()),
+		EndTime:   uint64(staker.EndTime.Unix()),
+	}
+	if staker.PublicKey != nil {
+		validator.PublicKey = bls.PublicKeyToCompressedBytes(staker.PublicKey)
+	}
+	validatorBytes, err := block.GenesisCodec.Marshal(block.CodecVersion, validator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal validator: %w", err)
+	}
+	return validatorBytes, nil
+}
+
+func stateTrieKey(prefix byte, parts ...[]byte) []byte {
+	size := 1
+	for _, part := range parts {
+		size += len(part)
+	}
+	key := make([]byte, 1, size)
+	key[0] = prefix
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+	return key
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestGetStateRoot(t *testing.T) {
+	var (
+		require     = require.New(t)
+		ctx         = context.Background()
+		db          = memdb.New()
+		state       = newTestState(t, db)
+		genesisUTXO = avax.UTXOID{
+			TxID: snowtest.AVAXAssetID,
+		}
+		newUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: genesistest.AVAXAsset,
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+		}
+		subnetID    = ids.GenerateTestID()
+		subnetOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+	)
+
+	genesisRoot, err := GetStateRoot(ctx, state)
+	require.NoError(err)
+
+	d, err := NewDiffOn(state)
+	require.NoError(err)
+
+	// An empty diff has the same root as its parent.
+	diffRoot, err := GetStateRoot(ctx, d)
+	require.NoError(err)
+	require.Equal(genesisRoot, diffRoot)
+
+	d.AddUTXO(newUTXO)
+	d.DeleteUTXO(genesisUTXO.InputID())
+	d.SetSubnetOwner(subnetID, subnetOwner)
+
+	diffRoot, err = GetStateRoot(ctx, d)
+	require.NoError(err)
+	require.NotEqual(genesisRoot, diffRoot)
+
+	// Diffs built on top of other diffs include the changes of their parents.
+	childDiff, err := NewDiffOn(d)
+	require.NoError(err)
+	childRoot, err := GetStateRoot(ctx, childDiff)
+	require.NoError(err)
+	require.Equal(diffRoot, childRoot)
+
+	// Computing the root doesn't modify the trie.
+	trieRoot, err := state.stateTrie.trie.GetMerkleRoot(ctx)
+	require.NoError(err)
+	require.Equal(genesisRoot, trieRoot)
+
+	// The root of the pending changes matches the committed root.
+	require.NoError(d.Apply(state))
+	pendingRoot, err := GetStateRoot(ctx, state)
+	require.NoError(err)
+	require.Equal(diffRoot, pendingRoot)
+
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	committedRoot, err := GetStateRoot(ctx, state)
+	require.NoError(err)
+	require.Equal(diffRoot, committedRoot)
+	require.True(state.stateTrie.synced(1))
+
+	// Rebuilding the trie from the flat state results in the same root.
+	require.NoError(state.stateTrie.clearHeight())
+	require.NoError(state.Close())
+
+	state = newTestState(t, db)
+	rebuiltRoot, err := GetStateRoot(ctx, state)
+	require.NoError(err)
+	require.Equal(diffRoot, rebuiltRoot)
+}
+
+func TestStateTrieNotMaintainedBeforeFortuna(t *testing.T) {
+	var (
+		require     = require.New(t)
+		ctx         = context.Background()
+		genesisUTXO = avax.UTXOID{
+			TxID: snowtest.AVAXAssetID,
+		}
+		newUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: genesistest.AVAXAsset,
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+		}
+		fortunaState    = newTestState(t, memdb.New())
+		preFortunaState = newTestStateWithUpgrades(
+			t,
+			memdb.New(),
+			upgradetest.GetConfig(upgradetest.Etna),
+			StakingConfig{},
+			&config.Default,
+		)
+	)
+
+	// The trie isn't written before Fortuna.
+	require.True(fortunaState.stateTrie.synced(0))
+	require.True(preFortunaState.stateTrie.stale())
+
+	for _, s := range []*state{fortunaState, preFortunaState} {
+		s.AddUTXO(newUTXO)
+		s.DeleteUTXO(genesisUTXO.InputID())
+		s.SetHeight(1)
+		require.NoError(s.Commit())
+	}
+	require.True(fortunaState.stateTrie.synced(1))
+	require.True(preFortunaState.stateTrie.stale())
+
+	// The trie can't be rebuilt while the state has uncommitted changes.
+	preFortunaState.DeleteUTXO(newUTXO.InputID())
+	_, err := GetStateRoot(ctx, preFortunaState)
+	require.ErrorIs(err, errStateTrieNotSynced)
+
+	fortunaState.DeleteUTXO(newUTXO.InputID())
+	for _, s := range []*state{fortunaState, preFortunaState} {
+		s.SetHeight(2)
+		require.NoError(s.Commit())
+	}
+
+	// The trie is rebuilt when the first state root is calculated.
+	expectedRoot, err := GetStateRoot(ctx, fortunaState)
+	require.NoError(err)
+	root, err := GetStateRoot(ctx, preFortunaState)
+	require.NoError(err)
+	require.Equal(expectedRoot, root)
+	require.False(preFortunaState.stateTrie.stale())
+}
@@ -27,7 +27,17 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 )
 
-var DefaultNodeID = ids.GenerateTestNodeID()
+var (
+	DefaultNodeID = ids.GenerateTestNodeID()
+
+	DefaultStakingParams = state.StakingParams{
+		MinValidatorStake: 5 * units.MilliAvax,
+		MaxValidatorStake: 500 * units.MilliAvax,
+		MinDelegatorStake: 1 * units.MilliAvax,
+		MinStakeDuration:  24 * time.Hour,
+		MaxStakeDuration:  365 * 24 * time.Hour,
+	}
+)
 
 type Config struct {
 	DB         database.Database
@@ -35,10 +45,12 @@ type Config struct {
 	Registerer prometheus.Registerer
 	Validators validators.Manager
 	Upgrades   upgrade.Config
-	Config     config.Config
-	Context    *snow.Context
-	Metrics    metrics.Metrics
-	Rewards    reward.Calculator
+	// StakingParams default to [DefaultStakingParams]
+	StakingParams state.StakingParams
+	Config        config.Config
+	Context       *snow.Context
+	Metrics       metrics.Metrics
+	Rewards       reward.Calculator
 }
 
 func New(t testing.TB, c Config) state.State {
@@ -66,6 +78,9 @@ func New(t testing.TB, c Config) state.State {
 	if c.Upgrades == (upgrade.Config{}) {
 		c.Upgrades = upgradetest.GetConfig(upgradetest.Latest)
 	}
+	if c.StakingParams == (state.StakingParams{}) {
+		c.StakingParams = DefaultStakingParams
+	}
 	if c.Config == (config.Config{}) {
 		c.Config = config.Default
 	}
@@ -87,6 +102,9 @@ func New(t testing.TB, c Config) state.State {
 		c.Registerer,
 		c.Validators,
 		c.Upgrades,
+		state.StakingConfig{
+			Node: c.StakingParams,
+		},
 		&c.Config,
 		c.Context,
 		c.Metrics,
@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/utils/maybe"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/x/merkledb"
+)
+
+const (
+	// UTXOTrieBranchFactor is the branch factor of the trie that UTXO proofs
+	// are generated from.
+	UTXOTrieBranchFactor = merkledb.BranchFactor16
+
+	// UTXOProofHistoryLength is the number of most recent heights that UTXO
+	// proofs can be generated at.
+	UTXOProofHistoryLength = 4096
+
+	// utxoTrieRebuildBatchSize is the number of UTXOs committed at once when
+	// the trie is rebuilt from the UTXO set.
+	utxoTrieRebuildBatchSize = 64 * units.KiB
+)
+
+var (
+	ErrUTXOProofsDisabled     = errors.New("UTXO proofs are disabled")
+	ErrUTXOProofHeightUnknown = errors.New("UTXO proofs are not available at height")
+	ErrInvalidUTXOProof       = errors.New("invalid UTXO proof")
+
+	utxoTrieDBPrefix       = []byte("trie")
+	utxoTrieRootsPrefix    = []byte("roots")
+	utxoTrieMetadataPrefix = []byte("metadata")
+	utxoTrieHeightKey      = []byte("height")
+)
+
+// utxoTrie authenticates the UTXO set with a merkle trie so that proofs of the
+// existence, or non-existence, of a UTXO can be generated at recent heights.
+//
+// The trie is written independently of the rest of the state. If the node
+// stops after the trie is written but before the state is, the trie is rebuilt
+// from the UTXO set on the next startup.
+type utxoTrie struct {
+	trie       merkledb.MerkleDB
+	rootsDB    database.Database // height -> merkle root
+	metadataDB database.Database
+
+	// height is the height of the last committed changes, or nil if the trie
+	// may not be in sync with any height.
+	height *uint64
+}
+
+func newUTXOTrie(db database.Database, metricsReg prometheus.Registerer) (*utxoTrie, error) {
+	trie, err := merkledb.New(
+		context.Background(),
+		prefixdb.New(utxoTrieDBPrefix, db),
+		merkledb.Config{
+			BranchFactor:                UTXOTrieBranchFactor,
+			Hasher:                      merkledb.DefaultHasher,
+			HistoryLength:               UTXOProofHistoryLength,
+			ValueNodeCacheSize:          units.MiB,
+			IntermediateNodeCacheSize:   units.MiB,
+			IntermediateWriteBufferSize: units.MiB,
+			IntermediateWriteBatchSize:  256 * units.KiB,
+			Reg:                         metricsReg,
+			Namespace:                   "utxo_trie",
+			TraceLevel:                  merkledb.NoTrace,
+			Tracer:                      trace.Noop,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create UTXO trie: %w", err)
+	}
+
+	t := &utxoTrie{
+		trie:       trie,
+		rootsDB:    prefixdb.New(utxoTrieRootsPrefix, db),
+		metadataDB: prefixdb.New(utxoTrieMetadataPrefix, db),
+	}
+	height, err := database.GetUInt64(t.metadataDB, utxoTrieHeightKey)
+	switch err {
+	case nil:
+		t.height = &height
+	case database.ErrNotFound:
+	default:
+		return nil, err
+	}
+	return t, nil
+}
+
+// synced returns true if the trie has been updated up to [height].
+func (t *utxoTrie) synced(height uint64) bool {
+	return t.height != nil && *t.height == height
+}
+
+// rebuild replaces the contents of the trie with [utxos] and records the
+// resulting root at [height].
+func (t *utxoTrie) rebuild(utxos database.Iterator, height uint64) error {
+	defer utxos.Release()
+
+	if err := t.clearHeight(); err != nil {
+		return err
+	}
+	if err := t.trie.Clear(); err != nil {
+		return fmt.Errorf("failed to clear UTXO trie: %w", err)
+	}
+	if err := database.Clear(t.rootsDB, units.MiB); err != nil {
+		return fmt.Errorf("failed to clear UTXO trie roots: %w", err)
+	}
+
+	var ops []database.BatchOp
+	for utxos.Next() {
+		ops = append(ops, database.BatchOp{
+			Key:   slices.Clone(utxos.Key()),
+			Value: slices.Clone(utxos.Value()),
+		})
+		if len(ops) < utxoTrieRebuildBatchSize {
+			continue
+		}
+		if err := t.commit(ops); err != nil {
+			return err
+		}
+		ops = ops[:0]
+	}
+	if err := utxos.Error(); err != nil {
+		return err
+	}
+	if err := t.commit(ops); err != nil {
+		return err
+	}
+	return t.setHeight(height)
+}
+
+// apply writes [ops] to the trie and records the resulting root at [height].
+//
+// If [height] isn't greater than the current height, the ops are recorded at
+// the current height.
+func (t *utxoTrie) apply(ops []database.BatchOp, height uint64) error {
+	if t.height != nil && height <= *t.height {
+		if len(ops) == 0 {
+			return nil
+		}
+		height = *t.height
+	}
+
+	// If the ops are only partially written, the trie must be rebuilt.
+	if err := t.clearHeight(); err != nil {
+		return err
+	}
+	if err := t.commit(ops); err != nil {
+		return err
+	}
+	if height >= UTXOProofHistoryLength {
+		if err := t.rootsDB.Delete(database.PackUInt64(height - UTXOProofHistoryLength)); err != nil {
+			return err
+		}
+	}
+	return t.setHeight(height)
+}
+
+func (t *utxoTrie) commit(ops []database.BatchOp) error {
+	ctx := context.Background()
+	view, err := t.trie.NewView(ctx, merkledb.ViewChanges{
+		BatchOps:     ops,
+		ConsumeBytes: true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := view.CommitToDB(ctx); err != nil {
+		return fmt.Errorf("failed to commit UTXO trie: %w", err)
+	}
+	return nil
+}
+
+func (t *utxoTrie) clearHeight() error {
+	t.height = nil
+	return t.metadataDB.Delete(utxoTrieHeightKey)
+}
+
+func (t *utxoTrie) setHeight(height uint64) error {
+	root, err := t.trie.GetMerkleRoot(context.Background())
+	if err != nil {
+		return err
+	}
+	if err := t.rootsDB.Put(database.PackUInt64(height), root[:]); err != nil {
+		return err
+	}
+	if err := database.PutUInt64(t.metadataDB, utxoTrieHeightKey, height); err != nil {
+		return err
+	}
+	t.height = &height
+	return nil
+}
+
+// proof returns a proof of whether [utxoID] existed at [height], along with
+// the root of the trie at [height].
+func (t *utxoTrie) proof(ctx context.Context, utxoID ids.ID, height uint64) (*merkledb.RangeProof, ids.ID, error) {
+	root, err := database.GetID(t.rootsDB, database.PackUInt64(height))
+	if err == database.ErrNotFound {
+		return nil, ids.Empty, fmt.Errorf("%w: %d", ErrUTXOProofHeightUnknown, height)
+	}
+	if err != nil {
+		return nil, ids.Empty, err
+	}
+
+	key := maybe.Some(utxoID[:])
+	proof, err := t.trie.GetRangeProofAtRoot(ctx, root, key, key, 1)
+	if err != nil {
+		return nil, ids.Empty, fmt.Errorf("failed to generate UTXO proof: %w", err)
+	}
+	return proof, root, nil
+}
+
+func (t *utxoTrie) close() error {
+	return t.trie.Close()
+}
+
+// VerifyUTXOProof verifies that [proof] proves whether [utxoID] is in the
+// P-chain UTXO set with merkle root [root]. If the UTXO exists, it is
+// returned. If the UTXO is proven to not exist, nil is returned.
+func VerifyUTXOProof(
+	ctx context.Context,
+	proof *merkledb.RangeProof,
+	utxoID ids.ID,
+	root ids.ID,
+) (*avax.UTXO, error) {
+	key := maybe.Some(utxoID[:])
+	err := proof.Verify(
+		ctx,
+		key,
+		key,
+		root,
+		merkledb.BranchFactorToTokenSize[UTXOTrieBranchFactor],
+		merkledb.DefaultHasher,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidUTXOProof, err)
+	}
+
+	switch len(proof.KeyValues) {
+	case 0:
+		return nil, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("%w: expected at most 1 UTXO but got %d", ErrInvalidUTXOProof, len(proof.KeyValues))
+	}
+
+	utxo := &avax.UTXO{}
+	if _, err := txs.GenesisCodec.Unmarshal(proof.KeyValues[0].Value, utxo); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse UTXO: %w", ErrInvalidUTXOProof, err)
+	}
+	return utxo, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func newTestStateWithUTXOProofs(t *testing.T, db database.Database) *state {
+	s := newTestState(t, db)
+	require.NoError(t, s.Close())
+
+	// Reopening the state with UTXO proofs enabled rebuilds the UTXO trie from
+	// the existing UTXO set.
+	execCfg := config.Default
+	execCfg.IndexUTXOProofs = true
+	return newTestStateWithConfig(t, db, &execCfg)
+}
+
+func TestUTXOProofs(t *testing.T) {
+	var (
+		require     = require.New(t)
+		ctx         = context.Background()
+		db          = memdb.New()
+		state       = newTestStateWithUTXOProofs(t, db)
+		genesisUTXO = avax.UTXOID{
+			TxID: snowtest.AVAXAssetID,
+		}
+		newUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: genesistest.AVAXAsset,
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+		}
+	)
+
+	verify := func(height uint64, utxoID ids.ID) *avax.UTXO {
+		t.Helper()
+
+		proof, root, err := state.GetUTXOProof(ctx, utxoID, height)
+		require.NoError(err)
+		utxo, err := VerifyUTXOProof(ctx, proof, utxoID, root)
+		require.NoError(err)
+		return utxo
+	}
+
+	// The genesis UTXOs are included after the trie is rebuilt.
+	expectedGenesisUTXO, err := state.GetUTXO(genesisUTXO.InputID())
+	require.NoError(err)
+	require.Equal(expectedGenesisUTXO, verify(0, genesisUTXO.InputID()))
+	require.Nil(verify(0, newUTXO.InputID()))
+
+	state.AddUTXO(newUTXO)
+	state.DeleteUTXO(genesisUTXO.InputID())
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	require.Nil(verify(1, genesisUTXO.InputID()))
+	// The proven UTXO is compared by its serialized form because parsing
+	// doesn't populate cached fields.
+	expectedUTXOBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, newUTXO)
+	require.NoError(err)
+	utxoBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, verify(1, newUTXO.InputID()))
+	require.NoError(err)
+	require.Equal(expectedUTXOBytes, utxoBytes)
+
+	// Proofs are still available at previous heights.
+	require.Equal(expectedGenesisUTXO, verify(0, genesisUTXO.InputID()))
+	require.Nil(verify(0, newUTXO.InputID()))
+
+	// Proofs are not available at heights that haven't been committed.
+	_, _, err = state.GetUTXOProof(ctx, newUTXO.InputID(), 2)
+	require.ErrorIs(err, ErrUTXOProofHeightUnknown)
+
+	// Proofs must not verify against a different root.
+	proof, _, err := state.GetUTXOProof(ctx, newUTXO.InputID(), 1)
+	require.NoError(err)
+	oldRoot, err := database.GetID(state.utxoTrie.rootsDB, database.PackUInt64(0))
+	require.NoError(err)
+	_, err = VerifyUTXOProof(ctx, proof, newUTXO.InputID(), oldRoot)
+	require.ErrorIs(err, ErrInvalidUTXOProof)
+}
+
+func TestUTXOProofsDisabled(t *testing.T) {
+	require := require.New(t)
+
+	state := newTestState(t, memdb.New())
+	_, _, err := state.GetUTXOProof(context.Background(), ids.GenerateTestID(), 0)
+	require.ErrorIs(err, ErrUTXOProofsDisabled)
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+)
+
+const (
+	// snapshotKey = [subnetID] + [height]
+	snapshotKeyLength = ids.IDLen + database.Uint64Size
+
+	// validatorDiffsPruneFrequency is the number of blocks between attempts
+	// to prune the validator diffs.
+	validatorDiffsPruneFrequency = 1024
+	// maxPrunedValidatorDiffs is the maximum number of validator diffs that
+	// are deleted while writing a single block. Any remaining diffs are
+	// deleted by later blocks.
+	maxPrunedValidatorDiffs = 64 * 1024
+)
+
+var errUnexpectedSnapshotKeyLength = fmt.Errorf("expected snapshot key length %d", snapshotKeyLength)
+
+type validatorSetSnapshot struct {
+	Validators []snapshotValidator `v0:"true"`
+}
+
+type snapshotValidator struct {
+	NodeID ids.NodeID `v0:"true"`
+	// PublicKey is the uncompressed public key, or nil if the validator
+	// doesn't have a public key.
+	PublicKey []byte `v0:"true"`
+	Weight    uint64 `v0:"true"`
+}
+
+// Note: [height] is encoded as a big endian number so that iterating
+// lexicographically results in iterating in increasing heights.
+func marshalSnapshotKey(subnetID ids.ID, height uint64) []byte {
+	key := make([]byte, snapshotKeyLength)
+	copy(key, subnetID[:])
+	binary.BigEndian.PutUint64(key[ids.IDLen:], height)
+	return key
+}
+
+func unmarshalSnapshotKey(key []byte) (ids.ID, uint64, error) {
+	if len(key) != snapshotKeyLength {
+		return ids.Empty, 0, errUnexpectedSnapshotKeyLength
+	}
+	var subnetID ids.ID
+	copy(subnetID[:], key)
+	return subnetID, binary.BigEndian.Uint64(key[ids.IDLen:]), nil
+}
+
+func marshalValidatorSet(vdrs map[ids.NodeID]*validators.GetValidatorOutput) ([]byte, error) {
+	snapshot := validatorSetSnapshot{
+		Validators: make([]snapshotValidator, 0, len(vdrs)),
+	}
+	for nodeID, vdr := range vdrs {
+		var pkBytes []byte
+		if vdr.PublicKey != nil {
+			pkBytes = bls.PublicKeyToUncompressedBytes(vdr.PublicKey)
+		}
+		snapshot.Validators = append(snapshot.Validators, snapshotValidator{
+			NodeID:    nodeID,
+			PublicKey: pkBytes,
+			Weight:    vdr.Weight,
+		})
+	}
+	slices.SortFunc(snapshot.Validators, func(a, b snapshotValidator) int {
+		return a.NodeID.Compare(b.NodeID)
+	})
+	return MetadataCodec.Marshal(CodecVersion0, &snapshot)
+}
+
+func unmarshalValidatorSet(b []byte) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	var snapshot validatorSetSnapshot
+	if _, err := MetadataCodec.Unmarshal(b, &snapshot); err != nil {
+		return nil, err
+	}
+
+	vdrs := make(map[ids.NodeID]*validators.GetValidatorOutput, len(snapshot.Validators))
+	for _, vdr := range snapshot.Validators {
+		var pk *bls.PublicKey
+		if len(vdr.PublicKey) != 0 {
+			pk = bls.PublicKeyFromValidUncompressedBytes(vdr.PublicKey)
+		}
+		vdrs[vdr.NodeID] = &validators.GetValidatorOutput{
+			NodeID:    vdr.NodeID,
+			PublicKey: pk,
+			Weight:    vdr.Weight,
+		}
+	}
+	return vdrs, nil
+}
+
+func (s *state) GetValidatorSetSnapshot(
+	subnetID ids.ID,
+	height uint64,
+) (uint64, map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	it := s.validatorSetSnapshotsDB.NewIteratorWithStartAndPrefix(
+		marshalSnapshotKey(subnetID, height),
+		subnetID[:],
+	)
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, nil, err
+		}
+		return 0, nil, database.ErrNotFound
+	}
+
+	_, snapshotHeight, err := unmarshalSnapshotKey(it.Key())
+	if err != nil {
+		return 0, nil, err
+	}
+	vdrs, err := unmarshalValidatorSet(it.Value())
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse validator set snapshot of %s at height %d: %w", subnetID, snapshotHeight, err)
+	}
+	return snapshotHeight, vdrs, nil
+}
+
+func (s *state) GetPrunedValidatorDiffsHeight() uint64 {
+	return s.prunedValidatorDiffsHeight
+}
+
+// writeValidatorSetSnapshots writes the validator sets of the Primary Network
+// and of every subnet if a snapshot should be taken at [height].
+//
+// The genesis validator sets aren't snapshotted, as the validator manager
+// isn't populated when the genesis state is committed.
+//
+// This function must be called after updateValidatorManager.
+func (s *state) writeValidatorSetSnapshots(updateValidators bool, height uint64) error {
+	if !updateValidators ||
+		s.validatorSetSnapshotInterval == 0 ||
+		height == 0 ||
+		height%s.validatorSetSnapshotInterval != 0 {
+		return nil
+	}
+
+	subnetIDs, err := s.GetSubnetIDs()
+	if err != nil {
+		return err
+	}
+	for _, subnetID := range append([]ids.ID{constants.PrimaryNetworkID}, subnetIDs...) {
+		vdrsBytes, err := marshalValidatorSet(s.validators.GetMap(subnetID))
+		if err != nil {
+			return fmt.Errorf("failed to marshal validator set of %s: %w", subnetID, err)
+		}
+		if err := s.validatorSetSnapshotsDB.Put(marshalSnapshotKey(subnetID, height), vdrsBytes); err != nil {
+			return fmt.Errorf("failed to write validator set snapshot of %s: %w", subnetID, err)
+		}
+	}
+	return nil
+}
+
+// pruneValidatorDiffs deletes the validator diffs that are older than the
+// configured retention.
+func (s *state) pruneValidatorDiffs(updateValidators bool, height uint64) error {
+	if !updateValidators ||
+		s.validatorDiffsRetention == 0 ||
+		height <= s.validatorDiffsRetention ||
+		height%validatorDiffsPruneFrequency != 0 {
+		return nil
+	}
+
+	prunedHeight := height - s.validatorDiffsRetention
+	remaining := maxPrunedValidatorDiffs
+	for _, db := range []database.Database{
+		s.validatorWeightDiffsDB,
+		s.validatorPublicKeyDiffsDB,
+	} {
+		numDeleted, err := deleteValidatorDiffs(db, prunedHeight, remaining)
+		if err != nil {
+			return fmt.Errorf("failed to prune validator diffs: %w", err)
+		}
+		remaining -= numDeleted
+	}
+
+	// Diffs that weren't deleted yet are no longer used, so the pruned height
+	// can be updated even if not all the diffs were deleted.
+	if prunedHeight <= s.prunedValidatorDiffsHeight {
+		return nil
+	}
+	if err := database.PutUInt64(s.singletonDB, PrunedValidatorDiffsHeightKey, prunedHeight); err != nil {
+		return fmt.Errorf("failed to write pruned validator diffs height: %w", err)
+	}
+	s.prunedValidatorDiffsHeight = prunedHeight
+	return nil
+}
+
+// deleteValidatorDiffs deletes up to [limit] diffs from [db] at heights less
+// than or equal to [height], for every subnet. It returns the number of deleted
+// diffs.
+func deleteValidatorDiffs(db database.Database, height uint64, limit int) (int, error) {
+	var (
+		numDeleted int
+		start      []byte
+	)
+	for numDeleted < limit {
+		// Find the next subnet that has diffs.
+		it := db.NewIteratorWithStart(start)
+		if !it.Next() {
+			err := it.Error()
+			it.Release()
+			return numDeleted, err
+		}
+		subnetID, _, _, err := unmarshalDiffKey(it.Key())
+		it.Release()
+		if err != nil {
+			return numDeleted, err
+		}
+
+		// Because heights are inverted, iterating from [height] only visits
+		// the diffs at or below [height].
+		it = db.NewIteratorWithStartAndPrefix(
+			marshalStartDiffKey(subnetID, height),
+			subnetID[:],
+		)
+		var keys [][]byte
+		for numDeleted+len(keys) < limit && it.Next() {
+			keys = append(keys, slices.Clone(it.Key()))
+		}
+		err = it.Error()
+		it.Release()
+		if err != nil {
+			return numDeleted, err
+		}
+
+		for _, key := range keys {
+			if err := db.Delete(key); err != nil {
+				return numDeleted, err
+			}
+			numDeleted++
+		}
+
+		nextSubnetID, ok := nextID(subnetID)
+		if !ok {
+			break
+		}
+		start = nextSubnetID[:]
+	}
+	return numDeleted, nil
+}
+
+// nextID returns the smallest ID that is larger than [id]. If [id] is the
+// largest ID, false is returned.
+func nextID(id ids.ID) (ids.ID, bool) {
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i]++
+		if id[i] != 0 {
+			return id, true
+		}
+	}
+	return id, false
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+)
+
+func TestValidatorSetSnapshots(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default
+	cfg.ValidatorSetSnapshotInterval = 2
+
+	db := memdb.New()
+	state := newTestStateWithConfig(t, db, &cfg)
+
+	expectedValidators := state.validators.GetMap(constants.PrimaryNetworkID)
+	require.NotEmpty(expectedValidators)
+
+	for height := uint64(1); height <= 3; height++ {
+		state.SetHeight(height)
+		require.NoError(state.Commit())
+	}
+
+	tests := []struct {
+		height                 uint64
+		expectedSnapshotHeight uint64
+		expectedErr            error
+	}{
+		{
+			height:                 0,
+			expectedSnapshotHeight: 2,
+		},
+		{
+			height:                 2,
+			expectedSnapshotHeight: 2,
+		},
+		{
+			height:      3,
+			expectedErr: database.ErrNotFound,
+		},
+	}
+	// The snapshots must be persisted.
+	reloadedState := newTestStateWithConfig(t, db, &cfg)
+	for _, s := range []State{state, reloadedState} {
+		for _, test := range tests {
+			snapshotHeight, validators, err := s.GetValidatorSetSnapshot(constants.PrimaryNetworkID, test.height)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				continue
+			}
+			require.Equal(test.expectedSnapshotHeight, snapshotHeight)
+			require.Equal(expectedValidators, validators)
+		}
+	}
+}
+
+func TestPruneValidatorDiffs(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		retention    = 10
+		prunedHeight = validatorDiffsPruneFrequency - retention
+	)
+	cfg := config.Default
+	cfg.ValidatorDiffsRetention = retention
+
+	db := memdb.New()
+	state := newTestStateWithConfig(t, db, &cfg)
+
+	var (
+		subnetIDs = []ids.ID{constants.PrimaryNetworkID, ids.GenerateTestID()}
+		nodeID    = ids.GenerateTestNodeID()
+		diffsDBs  = []database.Database{
+			state.validatorWeightDiffsDB,
+			state.validatorPublicKeyDiffsDB,
+		}
+	)
+	for _, subnetID := range subnetIDs {
+		for height := uint64(prunedHeight - 2); height <= prunedHeight+2; height++ {
+			for _, db := range diffsDBs {
+				require.NoError(db.Put(marshalDiffKey(subnetID, height, nodeID), nil))
+			}
+		}
+	}
+
+	// Diffs are only pruned periodically.
+	require.NoError(state.pruneValidatorDiffs(true, validatorDiffsPruneFrequency+1))
+	require.Zero(state.GetPrunedValidatorDiffsHeight())
+
+	require.NoError(state.pruneValidatorDiffs(true, validatorDiffsPruneFrequency))
+	require.Equal(uint64(prunedHeight), state.GetPrunedValidatorDiffsHeight())
+
+	for _, subnetID := range subnetIDs {
+		for height := uint64(prunedHeight - 2); height <= prunedHeight+2; height++ {
+			for _, db := range diffsDBs {
+				has, err := db.Has(marshalDiffKey(subnetID, height, nodeID))
+				require.NoError(err)
+				require.Equal(height > prunedHeight, has)
+			}
+		}
+	}
+
+	// The pruned height must be persisted.
+	require.NoError(state.Commit())
+	state = newTestStateWithConfig(t, db, &cfg)
+	require.Equal(uint64(prunedHeight), state.GetPrunedValidatorDiffsHeight())
+}
+
+func TestDeleteValidatorDiffsLimit(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	nodeID := ids.GenerateTestNodeID()
+	for height := uint64(1); height <= 3; height++ {
+		require.NoError(db.Put(marshalDiffKey(ids.Empty, height, nodeID), nil))
+	}
+
+	numDeleted, err := deleteValidatorDiffs(db, 3, 2)
+	require.NoError(err)
+	require.Equal(2, numDeleted)
+
+	// The most recent diffs are deleted first.
+	has, err := db.Has(marshalDiffKey(ids.Empty, 1, nodeID))
+	require.NoError(err)
+	require.True(has)
+}
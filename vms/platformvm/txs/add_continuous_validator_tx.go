@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+var (
+	_ ValidatorTx      = (*AddContinuousValidatorTx)(nil)
+	_ ContinuousStaker = (*AddContinuousValidatorTx)(nil)
+
+	ErrContinuousSubnetValidator = errors.New("continuous validators must validate the primary network")
+	ErrZeroContinuationPeriod    = errors.New("continuation period must be non-zero")
+)
+
+// AddContinuousValidatorTx adds a primary network validator whose staking
+// period is renewed when it ends. The rewards of every staking period are
+// restaked until the validator is stopped with a [StopContinuousValidatorTx].
+type AddContinuousValidatorTx struct {
+	// Describes the validator and its first staking period
+	AddPermissionlessValidatorTx `serialize:"true"`
+	// Duration, in seconds, of every staking period after the first one
+	Period uint64 `serialize:"true" json:"period"`
+}
+
+func (tx *AddContinuousValidatorTx) ContinuationPeriod() time.Duration {
+	return time.Duration(tx.Period) * time.Second
+}
+
+// SyntacticVerify returns nil iff [tx] is valid
+func (tx *AddContinuousValidatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.Subnet != constants.PrimaryNetworkID:
+		return ErrContinuousSubnetValidator
+	case tx.Period == 0:
+		return ErrZeroContinuationPeriod
+	}
+	return tx.AddPermissionlessValidatorTx.SyntacticVerify(ctx)
+}
+
+func (tx *AddContinuousValidatorTx) Visit(visitor Visitor) error {
+	return visitor.AddContinuousValidatorTx(tx)
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestAddContinuousValidatorTxSyntacticVerify(t *testing.T) {
+	ctx := snowtest.Context(t, ids.GenerateTestID())
+
+	blsSK, err := localsigner.New()
+	require.NoError(t, err)
+
+	blsPOP, err := signer.NewProofOfPossession(blsSK)
+	require.NoError(t, err)
+
+	newValidTx := func() *AddContinuousValidatorTx {
+		return &AddContinuousValidatorTx{
+			AddPermissionlessValidatorTx: AddPermissionlessValidatorTx{
+				BaseTx: BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+					},
+				},
+				Validator: Validator{
+					NodeID: ids.GenerateTestNodeID(),
+					Wght:   1,
+				},
+				Subnet: constants.PrimaryNetworkID,
+				Signer: blsPOP,
+				StakeOuts: []*avax.TransferableOutput{
+					{
+						Asset: avax.Asset{
+							ID: ctx.AVAXAssetID,
+						},
+						Out: &secp256k1fx.TransferOutput{
+							Amt: 1,
+						},
+					},
+				},
+				ValidatorRewardsOwner: &secp256k1fx.OutputOwners{},
+				DelegatorRewardsOwner: &secp256k1fx.OutputOwners{},
+				DelegationShares:      reward.PercentDenominator,
+			},
+			Period: 1,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		txFunc      func() *AddContinuousValidatorTx
+		expectedErr error
+	}{
+		{
+			name: "nil tx",
+			txFunc: func() *AddContinuousValidatorTx {
+				return nil
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			txFunc: func() *AddContinuousValidatorTx {
+				return &AddContinuousValidatorTx{
+					AddPermissionlessValidatorTx: AddPermissionlessValidatorTx{
+						BaseTx: BaseTx{
+							SyntacticallyVerified: true,
+						},
+					},
+				}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "subnet validator",
+			txFunc: func() *AddContinuousValidatorTx {
+				tx := newValidTx()
+				tx.Subnet = ids.GenerateTestID()
+				return tx
+			},
+			expectedErr: ErrContinuousSubnetValidator,
+		},
+		{
+			name: "zero period",
+			txFunc: func() *AddContinuousValidatorTx {
+				tx := newValidTx()
+				tx.Period = 0
+				return tx
+			},
+			expectedErr: ErrZeroContinuationPeriod,
+		},
+		{
+			name: "invalid validator tx",
+			txFunc: func() *AddContinuousValidatorTx {
+				tx := newValidTx()
+				tx.BaseTx = BaseTx{}
+				return tx
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name:        "passes verification",
+			txFunc:      newValidTx,
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.txFunc().SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
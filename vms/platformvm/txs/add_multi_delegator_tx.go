@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ UnsignedTx                  = (*AddMultiDelegatorTx)(nil)
+	_ DelegatorTx                 = (*MultiDelegator)(nil)
+	_ utils.Sortable[*Delegation] = (*Delegation)(nil)
+
+	ErrNoDelegations                 = errors.New("no delegations")
+	ErrDelegationsNotSortedAndUnique = errors.New("delegations not sorted and unique")
+)
+
+// AddMultiDelegatorTx delegates stake to multiple primary network validators
+// in a single transaction. Every delegation is added as an independent
+// delegator that is rewarded and removed on its own.
+type AddMultiDelegatorTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Describes the delegations, sorted by the nodeID of their validator
+	Delegations []*Delegation `serialize:"true" json:"delegations"`
+	// Where to send the staking rewards of every delegation
+	DelegationRewardsOwner fx.Owner `serialize:"true" json:"rewardsOwner"`
+}
+
+// Delegation describes a single delegation of an [AddMultiDelegatorTx].
+type Delegation struct {
+	// Describes the validator being delegated to
+	Validator `serialize:"true" json:"validator"`
+	// Where to send staked tokens when done delegating
+	StakeOuts []*avax.TransferableOutput `serialize:"true" json:"stake"`
+}
+
+func (d *Delegation) Compare(other *Delegation) int {
+	return d.Validator.NodeID.Compare(other.Validator.NodeID)
+}
+
+// DelegationID returns the ID of the delegator added by the delegation at
+// [index] of the AddMultiDelegatorTx [txID].
+func DelegationID(txID ids.ID, index int) ids.ID {
+	return txID.Prefix(uint64(index))
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [AddMultiDelegatorTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *AddMultiDelegatorTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	for _, delegation := range tx.Delegations {
+		for _, out := range delegation.StakeOuts {
+			out.FxID = secp256k1fx.ID
+			out.InitCtx(ctx)
+		}
+	}
+	tx.DelegationRewardsOwner.InitCtx(ctx)
+}
+
+// Delegators returns the delegators added by this tx, in the order of
+// [Delegations].
+func (tx *AddMultiDelegatorTx) Delegators() []*MultiDelegator {
+	delegators := make([]*MultiDelegator, len(tx.Delegations))
+	for i, delegation := range tx.Delegations {
+		delegators[i] = &MultiDelegator{
+			AddMultiDelegatorTx: tx,
+			Delegation:          delegation,
+		}
+	}
+	return delegators
+}
+
+// Stake returns the stake outputs of all the delegations.
+func (tx *AddMultiDelegatorTx) Stake() []*avax.TransferableOutput {
+	var stake []*avax.TransferableOutput
+	for _, delegation := range tx.Delegations {
+		stake = append(stake, delegation.StakeOuts...)
+	}
+	return stake
+}
+
+// SyntacticVerify returns nil iff [tx] is valid
+func (tx *AddMultiDelegatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.Delegations) == 0:
+		return ErrNoDelegations
+	case !utils.IsSortedAndUnique(tx.Delegations):
+		return ErrDelegationsNotSortedAndUnique
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+	if err := tx.DelegationRewardsOwner.Verify(); err != nil {
+		return fmt.Errorf("failed to verify rewards owner: %w", err)
+	}
+
+	for _, delegation := range tx.Delegations {
+		if err := delegation.verify(); err != nil {
+			return err
+		}
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *AddMultiDelegatorTx) Visit(visitor Visitor) error {
+	return visitor.AddMultiDelegatorTx(tx)
+}
+
+func (d *Delegation) verify() error {
+	if len(d.StakeOuts) == 0 { // Ensure there is provided stake
+		return errNoStake
+	}
+	if err := d.Validator.Verify(); err != nil {
+		return fmt.Errorf("failed to verify validator: %w", err)
+	}
+
+	for _, out := range d.StakeOuts {
+		if err := out.Verify(); err != nil {
+			return fmt.Errorf("failed to verify output: %w", err)
+		}
+	}
+
+	firstStakeOutput := d.StakeOuts[0]
+	stakedAssetID := firstStakeOutput.AssetID()
+	totalStakeWeight := firstStakeOutput.Output().Amount()
+	for _, out := range d.StakeOuts[1:] {
+		newWeight, err := math.Add(totalStakeWeight, out.Output().Amount())
+		if err != nil {
+			return err
+		}
+		totalStakeWeight = newWeight
+
+		assetID := out.AssetID()
+		if assetID != stakedAssetID {
+			return fmt.Errorf("%w: %q and %q", errMultipleStakedAssets, stakedAssetID, assetID)
+		}
+	}
+
+	switch {
+	case !avax.IsSortedTransferableOutputs(d.StakeOuts, Codec):
+		return errOutputsNotSorted
+	case totalStakeWeight != d.Wght:
+		return fmt.Errorf("%w, delegator weight %d total stake weight %d",
+			errDelegatorWeightMismatch,
+			d.Wght,
+			totalStakeWeight,
+		)
+	}
+	return nil
+}
+
+// MultiDelegator is a single delegator added by an [AddMultiDelegatorTx].
+type MultiDelegator struct {
+	*AddMultiDelegatorTx
+	Delegation *Delegation
+}
+
+func (*MultiDelegator) SubnetID() ids.ID {
+	return constants.PrimaryNetworkID
+}
+
+func (d *MultiDelegator) NodeID() ids.NodeID {
+	return d.Delegation.NodeID
+}
+
+func (*MultiDelegator) PublicKey() (*bls.PublicKey, bool, error) {
+	return nil, false, nil
+}
+
+func (d *MultiDelegator) EndTime() time.Time {
+	return d.Delegation.EndTime()
+}
+
+func (d *MultiDelegator) Weight() uint64 {
+	return d.Delegation.Weight()
+}
+
+func (*MultiDelegator) CurrentPriority() Priority {
+	return PrimaryNetworkDelegatorCurrentPriority
+}
+
+func (d *MultiDelegator) Stake() []*avax.TransferableOutput {
+	return d.Delegation.StakeOuts
+}
+
+func (d *MultiDelegator) RewardsOwner() fx.Owner {
+	return d.DelegationRewardsOwner
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestAddMultiDelegatorTxSyntacticVerify(t *testing.T) {
+	ctx := snowtest.Context(t, ids.GenerateTestID())
+
+	nodeID0 := ids.BuildTestNodeID([]byte{0})
+	nodeID1 := ids.BuildTestNodeID([]byte{1})
+
+	newDelegation := func(nodeID ids.NodeID, weight uint64) *Delegation {
+		return &Delegation{
+			Validator: Validator{
+				NodeID: nodeID,
+				Wght:   weight,
+			},
+			StakeOuts: []*avax.TransferableOutput{
+				{
+					Asset: avax.Asset{
+						ID: ctx.AVAXAssetID,
+					},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: weight,
+					},
+				},
+			},
+		}
+	}
+	newValidTx := func() *AddMultiDelegatorTx {
+		return &AddMultiDelegatorTx{
+			BaseTx: BaseTx{
+				BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+				},
+			},
+			Delegations: []*Delegation{
+				newDelegation(nodeID0, 1),
+				newDelegation(nodeID1, 2),
+			},
+			DelegationRewardsOwner: &secp256k1fx.OutputOwners{},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		txFunc      func() *AddMultiDelegatorTx
+		expectedErr error
+	}{
+		{
+			name: "nil tx",
+			txFunc: func() *AddMultiDelegatorTx {
+				return nil
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			txFunc: func() *AddMultiDelegatorTx {
+				return &AddMultiDelegatorTx{
+					BaseTx: BaseTx{
+						SyntacticallyVerified: true,
+					},
+				}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "no delegations",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.Delegations = nil
+				return tx
+			},
+			expectedErr: ErrNoDelegations,
+		},
+		{
+			name: "unsorted delegations",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.Delegations[0], tx.Delegations[1] = tx.Delegations[1], tx.Delegations[0]
+				return tx
+			},
+			expectedErr: ErrDelegationsNotSortedAndUnique,
+		},
+		{
+			name: "duplicate delegations",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.Delegations[1] = newDelegation(nodeID0, 2)
+				return tx
+			},
+			expectedErr: ErrDelegationsNotSortedAndUnique,
+		},
+		{
+			name: "invalid BaseTx",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.BaseTx = BaseTx{}
+				return tx
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid rewards owner",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.DelegationRewardsOwner = &secp256k1fx.OutputOwners{
+					Threshold: 1,
+				}
+				return tx
+			},
+			expectedErr: secp256k1fx.ErrOutputUnspendable,
+		},
+		{
+			name: "no stake",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.Delegations[0].StakeOuts = nil
+				return tx
+			},
+			expectedErr: errNoStake,
+		},
+		{
+			name: "zero weight",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.Delegations[0].Wght = 0
+				return tx
+			},
+			expectedErr: ErrWeightTooSmall,
+		},
+		{
+			name: "multiple staked assets",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				delegation := tx.Delegations[1]
+				delegation.Wght = 3
+				delegation.StakeOuts = append(delegation.StakeOuts, &avax.TransferableOutput{
+					Asset: avax.Asset{
+						ID: ids.GenerateTestID(),
+					},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: 1,
+					},
+				})
+				return tx
+			},
+			expectedErr: errMultipleStakedAssets,
+		},
+		{
+			name: "weight mismatch",
+			txFunc: func() *AddMultiDelegatorTx {
+				tx := newValidTx()
+				tx.Delegations[1].Wght = 3
+				return tx
+			},
+			expectedErr: errDelegatorWeightMismatch,
+		},
+		{
+			name:        "passes verification",
+			txFunc:      newValidTx,
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.txFunc().SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestAddMultiDelegatorTxDelegators(t *testing.T) {
+	require := require.New(t)
+
+	tx := &AddMultiDelegatorTx{
+		Delegations: []*Delegation{
+			{
+				Validator: Validator{
+					NodeID: ids.GenerateTestNodeID(),
+					End:    1,
+					Wght:   2,
+				},
+			},
+			{
+				Validator: Validator{
+					NodeID: ids.GenerateTestNodeID(),
+					End:    3,
+					Wght:   4,
+				},
+			},
+		},
+		DelegationRewardsOwner: &secp256k1fx.OutputOwners{},
+	}
+
+	delegators := tx.Delegators()
+	require.Len(delegators, len(tx.Delegations))
+	for i, delegator := range delegators {
+		delegation := tx.Delegations[i]
+		require.Equal(constants.PrimaryNetworkID, delegator.SubnetID())
+		require.Equal(delegation.NodeID, delegator.NodeID())
+		require.Equal(delegation.EndTime(), delegator.EndTime())
+		require.Equal(delegation.Wght, delegator.Weight())
+		require.Equal(PrimaryNetworkDelegatorCurrentPriority, delegator.CurrentPriority())
+		require.Equal(tx.DelegationRewardsOwner, delegator.RewardsOwner())
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	_ UnsignedTx = (*ClaimRewardsTx)(nil)
+
+	ErrNoRewardUTXOs                 = errors.New("tx has no reward UTXOs")
+	ErrRewardUTXOsNotSortedAndUnique = errors.New("reward UTXOs not sorted and unique")
+	ErrRewardUTXOConsumedByInput     = errors.New("reward UTXO is also consumed by an input")
+)
+
+// ClaimRewardsTx consumes reward UTXOs that were produced when staking
+// periods ended. The rewards are treated as if they were consumed by the
+// inputs of the tx, so they can be swept into any outputs of the tx and can
+// pay for its fee.
+//
+// Unlike inputs, reward UTXOs are only referenced by their IDs and are
+// authorized by a single [ClaimAuth] that must satisfy the owner of every
+// claimed UTXO.
+type ClaimRewardsTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Reward UTXOs to consume. Must be sorted and unique.
+	RewardUTXOs []*avax.UTXOID `serialize:"true" json:"rewardUTXOs"`
+	// Authorizes the reward UTXOs to be consumed
+	ClaimAuth verify.Verifiable `serialize:"true" json:"claimAuthorization"`
+}
+
+func (tx *ClaimRewardsTx) InputIDs() set.Set[ids.ID] {
+	inputs := tx.BaseTx.InputIDs()
+	for _, utxoID := range tx.RewardUTXOs {
+		inputs.Add(utxoID.InputID())
+	}
+	return inputs
+}
+
+func (tx *ClaimRewardsTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case len(tx.RewardUTXOs) == 0:
+		return ErrNoRewardUTXOs
+	case !utils.IsSortedAndUnique(tx.RewardUTXOs):
+		return ErrRewardUTXOsNotSortedAndUnique
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.ClaimAuth.Verify(); err != nil {
+		return err
+	}
+
+	inputIDs := tx.BaseTx.InputIDs()
+	for _, utxoID := range tx.RewardUTXOs {
+		if inputIDs.Contains(utxoID.InputID()) {
+			return ErrRewardUTXOConsumedByInput
+		}
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *ClaimRewardsTx) Visit(visitor Visitor) error {
+	return visitor.ClaimRewardsTx(tx)
+}
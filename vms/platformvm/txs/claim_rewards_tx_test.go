@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestClaimRewardsTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+		txID         = ids.GenerateTestID()
+		rewardUTXO0  = &avax.UTXOID{TxID: txID, OutputIndex: 0}
+		rewardUTXO1  = &avax.UTXOID{TxID: txID, OutputIndex: 1}
+		validAuth    = &secp256k1fx.Input{}
+		baseTxWithIn = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins: []*avax.TransferableInput{
+					{
+						UTXOID: *rewardUTXO0,
+						Asset:  avax.Asset{ID: ctx.AVAXAssetID},
+						In: &secp256k1fx.TransferInput{
+							Amt: units.Avax,
+						},
+					},
+				},
+			},
+		}
+	)
+	tests := []struct {
+		name        string
+		tx          *ClaimRewardsTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			tx: &ClaimRewardsTx{
+				BaseTx: BaseTx{
+					SyntacticallyVerified: true,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "no reward UTXOs",
+			tx: &ClaimRewardsTx{
+				BaseTx:    validBaseTx,
+				ClaimAuth: validAuth,
+			},
+			expectedErr: ErrNoRewardUTXOs,
+		},
+		{
+			name: "unsorted reward UTXOs",
+			tx: &ClaimRewardsTx{
+				BaseTx: validBaseTx,
+				RewardUTXOs: []*avax.UTXOID{
+					rewardUTXO1,
+					rewardUTXO0,
+				},
+				ClaimAuth: validAuth,
+			},
+			expectedErr: ErrRewardUTXOsNotSortedAndUnique,
+		},
+		{
+			name: "duplicate reward UTXOs",
+			tx: &ClaimRewardsTx{
+				BaseTx: validBaseTx,
+				RewardUTXOs: []*avax.UTXOID{
+					rewardUTXO0,
+					rewardUTXO0,
+				},
+				ClaimAuth: validAuth,
+			},
+			expectedErr: ErrRewardUTXOsNotSortedAndUnique,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &ClaimRewardsTx{
+				BaseTx: BaseTx{},
+				RewardUTXOs: []*avax.UTXOID{
+					rewardUTXO0,
+				},
+				ClaimAuth: validAuth,
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid claim auth",
+			tx: &ClaimRewardsTx{
+				BaseTx: validBaseTx,
+				RewardUTXOs: []*avax.UTXOID{
+					rewardUTXO0,
+				},
+				ClaimAuth: &secp256k1fx.Input{
+					SigIndices: []uint32{1, 0},
+				},
+			},
+			expectedErr: secp256k1fx.ErrInputIndicesNotSortedUnique,
+		},
+		{
+			name: "reward UTXO consumed by input",
+			tx: &ClaimRewardsTx{
+				BaseTx: baseTxWithIn,
+				RewardUTXOs: []*avax.UTXOID{
+					rewardUTXO0,
+				},
+				ClaimAuth: validAuth,
+			},
+			expectedErr: ErrRewardUTXOConsumedByInput,
+		},
+		{
+			name: "passes verification",
+			tx: &ClaimRewardsTx{
+				BaseTx: validBaseTx,
+				RewardUTXOs: []*avax.UTXOID{
+					rewardUTXO0,
+					rewardUTXO1,
+				},
+				ClaimAuth: validAuth,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
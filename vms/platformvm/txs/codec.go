@@ -48,6 +48,7 @@ func init() {
 		errs.Add(
 			RegisterDurangoTypes(c),
 			RegisterEtnaTypes(c),
+			RegisterFortunaTypes(c),
 		)
 	}
 
@@ -129,3 +130,21 @@ func RegisterEtnaTypes(targetCodec linearcodec.Codec) error {
 		targetCodec.RegisterType(&DisableL1ValidatorTx{}),
 	)
 }
+
+// RegisterFortunaTypes registers the type information for transactions that
+// were valid during the Fortuna series of upgrades.
+func RegisterFortunaTypes(targetCodec linearcodec.Codec) error {
+	return errors.Join(
+		targetCodec.RegisterType(&ExpiringTx{}),
+		targetCodec.RegisterType(&DependentTx{}),
+		targetCodec.RegisterType(&ClaimRewardsTx{}),
+		targetCodec.RegisterType(&SetSubnetValidatorWeightTx{}),
+		targetCodec.RegisterType(&AddContinuousValidatorTx{}),
+		targetCodec.RegisterType(&StopContinuousValidatorTx{}),
+		targetCodec.RegisterType(&AddMultiDelegatorTx{}),
+
+		targetCodec.RegisterType(&secp256k1fx.HeightLockedOutput{}),
+		targetCodec.RegisterType(&SetWithdrawalOwnerTx{}),
+		targetCodec.RegisterType(&RetireChainTx{}),
+	)
+}
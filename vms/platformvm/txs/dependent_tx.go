@@ -0,0 +1,82 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var (
+	_ UnsignedTx = (*DependentTx)(nil)
+
+	ErrNestedDependentTx      = errors.New("dependent tx can not wrap a dependent tx")
+	ErrUnsupportedDependentTx = errors.New("dependent tx can not wrap this tx type")
+	ErrEmptyDependency        = errors.New("dependency must be non-empty")
+)
+
+// DependentTx wraps a transaction so that it can only be accepted after the
+// transaction [DependsOn] has been accepted.
+//
+// This allows issuers to order their transactions without needing to chain
+// their UTXOs.
+//
+// As with the ExpiringTx, the credentials of the wrapped transaction sign the
+// bytes of the DependentTx.
+type DependentTx struct {
+	// ID of the transaction that must be accepted before this transaction
+	DependsOn ids.ID `serialize:"true" json:"dependsOn"`
+	// Transaction to execute
+	Tx UnsignedTx `serialize:"true" json:"tx"`
+
+	unsignedBytes []byte // Unsigned byte representation of this data
+}
+
+// SetBytes also sets the bytes of the wrapped transaction, as its credentials
+// are verified against the bytes of the DependentTx.
+func (tx *DependentTx) SetBytes(unsignedBytes []byte) {
+	tx.unsignedBytes = unsignedBytes
+	tx.Tx.SetBytes(unsignedBytes)
+}
+
+func (tx *DependentTx) Bytes() []byte {
+	return tx.unsignedBytes
+}
+
+func (tx *DependentTx) InitCtx(ctx *snow.Context) {
+	tx.Tx.InitCtx(ctx)
+}
+
+func (tx *DependentTx) InputIDs() set.Set[ids.ID] {
+	return tx.Tx.InputIDs()
+}
+
+func (tx *DependentTx) Outputs() []*avax.TransferableOutput {
+	return tx.Tx.Outputs()
+}
+
+// SyntacticVerify returns nil iff this tx is well formed
+func (tx *DependentTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil || tx.Tx == nil:
+		return ErrNilTx
+	case tx.DependsOn == ids.Empty:
+		return ErrEmptyDependency
+	}
+	if _, ok := tx.Tx.(*DependentTx); ok {
+		return ErrNestedDependentTx
+	}
+	if !isWrappable(Unwrap(tx.Tx)) {
+		return ErrUnsupportedDependentTx
+	}
+	return tx.Tx.SyntacticVerify(ctx)
+}
+
+func (tx *DependentTx) Visit(visitor Visitor) error {
+	return visitor.DependentTx(tx)
+}
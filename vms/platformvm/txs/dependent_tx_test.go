@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+func TestDependentTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		dependsOn   = ids.GenerateTestID()
+		validBaseTx = &BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+	)
+	tests := []struct {
+		name        string
+		tx          *DependentTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "nil wrapped tx",
+			tx: &DependentTx{
+				DependsOn: dependsOn,
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "empty dependency",
+			tx: &DependentTx{
+				Tx: validBaseTx,
+			},
+			expectedErr: ErrEmptyDependency,
+		},
+		{
+			name: "nested dependent tx",
+			tx: &DependentTx{
+				DependsOn: dependsOn,
+				Tx: &DependentTx{
+					DependsOn: dependsOn,
+					Tx:        validBaseTx,
+				},
+			},
+			expectedErr: ErrNestedDependentTx,
+		},
+		{
+			name: "wrapped create subnet tx",
+			tx: &DependentTx{
+				DependsOn: dependsOn,
+				Tx: &CreateSubnetTx{
+					BaseTx: *validBaseTx,
+				},
+			},
+			expectedErr: ErrUnsupportedDependentTx,
+		},
+		{
+			name: "expiring create subnet tx",
+			tx: &DependentTx{
+				DependsOn: dependsOn,
+				Tx: &ExpiringTx{
+					Expiry: 1,
+					Tx: &CreateSubnetTx{
+						BaseTx: *validBaseTx,
+					},
+				},
+			},
+			expectedErr: ErrUnsupportedDependentTx,
+		},
+		{
+			name: "invalid wrapped tx",
+			tx: &DependentTx{
+				DependsOn: dependsOn,
+				Tx:        &BaseTx{},
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "passes verification",
+			tx: &DependentTx{
+				DependsOn: dependsOn,
+				Tx:        validBaseTx,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "passes verification with expiry",
+			tx: &DependentTx{
+				DependsOn: dependsOn,
+				Tx: &ExpiringTx{
+					Expiry: 1,
+					Tx:     validBaseTx,
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
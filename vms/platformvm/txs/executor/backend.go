@@ -23,4 +23,14 @@ type Backend struct {
 	Uptimes      uptime.Calculator
 	Rewards      reward.Calculator
 	Bootstrapped *utils.Atomic[bool]
+
+	// Height is the height of the block that the txs are being executed in.
+	// Height locked UTXOs can only be spent once it reaches their lock height.
+	Height uint64
+
+	// ReportAllErrors causes the standard txs to be verified against all of
+	// their fee, authorization, timing and weight checks, with all the failed
+	// checks returned as a joined error. By default, verification stops at the
+	// first failed check.
+	ReportAllErrors bool
 }
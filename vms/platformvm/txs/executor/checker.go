@@ -0,0 +1,38 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import "errors"
+
+// checker collects the failed checks of a tx.
+//
+// The fee, authorization, timing and weight checks don't prevent the remaining
+// checks from being performed. If [Backend.ReportAllErrors] is set, their
+// failures are deferred so that all of them are reported at once. Otherwise,
+// verification is aborted at the first failure.
+type checker struct {
+	reportAll bool
+	errs      []error
+}
+
+func newChecker(backend *Backend) *checker {
+	return &checker{
+		reportAll: backend.ReportAllErrors,
+	}
+}
+
+// fail records the result of a deferrable check. It returns the error that
+// verification should be aborted with, if any.
+func (c *checker) fail(err error) error {
+	if err == nil || !c.reportAll {
+		return err
+	}
+	c.errs = append(c.errs, err)
+	return nil
+}
+
+// err returns the failures that were deferred, joined into a single error.
+func (c *checker) err() error {
+	return errors.Join(c.errs...)
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ secp256k1fx.HeightTx = (*heightTx)(nil)
+
+// verifyHeightLockedOutputs returns an error if [tx] creates height locked
+// outputs before the Fortuna upgrade was activated.
+//
+// Txs that wrap other txs were introduced by the Fortuna upgrade, so only the
+// outputs of [tx] itself need to be inspected.
+func verifyHeightLockedOutputs(backend *Backend, timestamp time.Time, tx txs.UnsignedTx) error {
+	if backend.Config.UpgradeConfig.IsFortunaActivated(timestamp) {
+		return nil
+	}
+
+	outs := tx.Outputs()
+	if staker, ok := tx.(interface {
+		Stake() []*avax.TransferableOutput
+	}); ok {
+		outs = append(outs[:len(outs):len(outs)], staker.Stake()...)
+	}
+	for _, out := range outs {
+		if isHeightLocked(out.Out) {
+			return fmt.Errorf("%w: height locked output", errFortunaUpgradeNotActive)
+		}
+	}
+	return nil
+}
+
+func isHeightLocked(out avax.TransferableOut) bool {
+	if lockOut, ok := out.(*stakeable.LockOut); ok {
+		out = lockOut.TransferableOut
+	}
+	_, ok := out.(*secp256k1fx.HeightLockedOutput)
+	return ok
+}
+
+// heightTx is a tx that is being executed in the block at [height].
+type heightTx struct {
+	txs.UnsignedTx
+	height uint64
+}
+
+func (tx *heightTx) Height() uint64 {
+	return tx.height
+}
+
+// withHeight annotates [tx] with the height of the block it is being executed
+// in, which the fxs require to verify spends of height locked UTXOs.
+func withHeight(backend *Backend, tx txs.UnsignedTx) txs.UnsignedTx {
+	return &heightTx{
+		UnsignedTx: tx,
+		height:     backend.Height,
+	}
+}
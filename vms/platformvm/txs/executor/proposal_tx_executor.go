@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -59,6 +60,11 @@ func ProposalTx(
 	onCommitState state.Diff,
 	onAbortState state.Diff,
 ) error {
+	if err := verifyHeightLockedOutputs(backend, onCommitState.GetTimestamp(), tx.Unsigned); err != nil {
+		txID := tx.ID()
+		return fmt.Errorf("proposal tx %s failed execution: %w", txID, err)
+	}
+
 	proposalExecutor := proposalTxExecutor{
 		backend:       backend,
 		feeCalculator: feeCalculator,
@@ -87,66 +93,6 @@ type proposalTxExecutor struct {
 	onAbortState state.Diff
 }
 
-func (*proposalTxExecutor) CreateChainTx(*txs.CreateChainTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) CreateSubnetTx(*txs.CreateSubnetTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) ImportTx(*txs.ImportTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) ExportTx(*txs.ExportTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) RemoveSubnetValidatorTx(*txs.RemoveSubnetValidatorTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) TransformSubnetTx(*txs.TransformSubnetTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) AddPermissionlessValidatorTx(*txs.AddPermissionlessValidatorTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDelegatorTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) BaseTx(*txs.BaseTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) ConvertSubnetToL1Tx(*txs.ConvertSubnetToL1Tx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) RegisterL1ValidatorTx(*txs.RegisterL1ValidatorTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) SetL1ValidatorWeightTx(*txs.SetL1ValidatorWeightTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) IncreaseL1ValidatorBalanceTx(*txs.IncreaseL1ValidatorBalanceTx) error {
-	return ErrWrongTxType
-}
-
-func (*proposalTxExecutor) DisableL1ValidatorTx(*txs.DisableL1ValidatorTx) error {
-	return ErrWrongTxType
-}
-
 func (e *proposalTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	// AddValidatorTx is a proposal transaction until the Banff fork
 	// activation. Following the activation, AddValidatorTxs must be issued into
@@ -369,22 +315,19 @@ func (e *proposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		)
 	}
 
-	stakerTx, _, err := e.onCommitState.GetTx(stakerToReward.TxID)
+	stakerTx, err := e.onCommitState.GetStakerTx(stakerToReward.TxID)
 	if err != nil {
 		return fmt.Errorf("failed to get next removed staker tx: %w", err)
 	}
 
 	// Invariant: A [txs.DelegatorTx] does not also implement the
 	//            [txs.ValidatorTx] interface.
-	switch uStakerTx := stakerTx.Unsigned.(type) {
+	switch uStakerTx := stakerTx.(type) {
 	case txs.ValidatorTx:
+		// Handle staker lifecycle.
 		if err := e.rewardValidatorTx(uStakerTx, stakerToReward); err != nil {
 			return err
 		}
-
-		// Handle staker lifecycle.
-		e.onCommitState.DeleteCurrentValidator(stakerToReward)
-		e.onAbortState.DeleteCurrentValidator(stakerToReward)
 	case txs.DelegatorTx:
 		if err := e.rewardDelegatorTx(uStakerTx, stakerToReward); err != nil {
 			return err
@@ -415,6 +358,99 @@ func (e *proposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 }
 
 func (e *proposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, validator *state.Staker) error {
+	// Rewards that were restaked during prior staking periods are returned
+	// with the stake, regardless of the outcome of this staking period.
+	restakedReward, err := math.Sub(validator.Weight, uValidatorTx.Weight())
+	if err != nil {
+		return err
+	}
+
+	renewed, err := e.renewValidator(validator)
+	if err != nil {
+		return err
+	}
+	if !renewed {
+		reward, err := math.Add(restakedReward, validator.PotentialReward)
+		if err != nil {
+			return err
+		}
+		if err := e.payValidator(e.onCommitState, uValidatorTx, validator, reward); err != nil {
+			return err
+		}
+		e.onCommitState.DeleteCurrentValidator(validator)
+	}
+
+	// A validator that doesn't receive its reward is never renewed.
+	if err := e.payValidator(e.onAbortState, uValidatorTx, validator, restakedReward); err != nil {
+		return err
+	}
+	e.onAbortState.DeleteCurrentValidator(validator)
+	return nil
+}
+
+// renewValidator starts a new staking period for [validator] on the commit
+// state if it is a continuous validator. The reward of the ending staking
+// period is restaked.
+//
+// Returns true if the validator was renewed.
+func (e *proposalTxExecutor) renewValidator(validator *state.Staker) (bool, error) {
+	if validator.ContinuationPeriod == 0 {
+		return false, nil
+	}
+
+	weight, err := math.Add(validator.Weight, validator.PotentialReward)
+	if err != nil {
+		return false, err
+	}
+
+	renewedValidator := *validator
+	renewedValidator.Weight = weight
+	renewedValidator.StartTime = validator.EndTime
+	renewedValidator.EndTime = validator.EndTime.Add(validator.ContinuationPeriod)
+	renewedValidator.NextTime = renewedValidator.EndTime
+
+	// The restaked rewards are paid out instead if the validator, along with
+	// its delegators, would exceed the maximum stake.
+	maxWeight, err := GetMaxWeight(
+		e.onCommitState,
+		&renewedValidator,
+		renewedValidator.StartTime,
+		renewedValidator.EndTime,
+	)
+	if err != nil {
+		return false, err
+	}
+	if maxWeight > e.onCommitState.GetStakingParams().MaxValidatorStake {
+		return false, nil
+	}
+
+	currentSupply, err := e.onCommitState.GetCurrentSupply(validator.SubnetID)
+	if err != nil {
+		return false, err
+	}
+	rewards, err := GetRewardsCalculator(e.backend, e.onCommitState, validator.SubnetID)
+	if err != nil {
+		return false, err
+	}
+	potentialReward := rewards.Calculate(
+		validator.ContinuationPeriod,
+		weight,
+		currentSupply,
+	)
+	e.onCommitState.SetCurrentSupply(validator.SubnetID, currentSupply+potentialReward)
+
+	renewedValidator.PotentialReward = potentialReward
+	return true, e.onCommitState.UpdateCurrentValidator(&renewedValidator)
+}
+
+// payValidator returns the stake of [validator] on [chainState] and pays out
+// [reward] along with the delegatee rewards accrued by the validator.
+func (e *proposalTxExecutor) payValidator(
+	chainState state.Diff,
+	uValidatorTx txs.ValidatorTx,
+	validator *state.Staker,
+	reward uint64,
+) error {
 	var (
 		txID    = validator.TxID
 		stake   = uValidatorTx.Stake()
@@ -434,16 +470,21 @@ func (e *proposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 			Asset: out.Asset,
 			Out:   out.Output(),
 		}
-		e.onCommitState.AddUTXO(utxo)
-		e.onAbortState.AddUTXO(utxo)
+		chainState.AddUTXO(utxo)
 	}
 
 	utxosOffset := 0
 
 	// Provide the reward here
-	reward := validator.PotentialReward
 	if reward > 0 {
-		validationRewardsOwner := uValidatorTx.ValidationRewardsOwner()
+		validationRewardsOwner, err := validatorRewardsOwner(
+			chainState,
+			txID,
+			uValidatorTx.ValidationRewardsOwner(),
+		)
+		if err != nil {
+			return err
+		}
 		outIntf, err := e.backend.Fx.CreateOutput(reward, validationRewardsOwner)
 		if err != nil {
 			return fmt.Errorf("failed to create output: %w", err)
@@ -461,14 +502,14 @@ func (e *proposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 			Asset: stakeAsset,
 			Out:   out,
 		}
-		e.onCommitState.AddUTXO(utxo)
-		e.onCommitState.AddRewardUTXO(txID, utxo)
+		chainState.AddUTXO(utxo)
+		chainState.AddRewardUTXO(txID, utxo)
 
 		utxosOffset++
 	}
 
 	// Provide the accrued delegatee rewards from successful delegations here.
-	delegateeReward, err := e.onCommitState.GetDelegateeReward(
+	delegateeReward, err := chainState.GetDelegateeReward(
 		validator.SubnetID,
 		validator.NodeID,
 	)
@@ -480,7 +521,14 @@ func (e *proposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 		return nil
 	}
 
-	delegationRewardsOwner := uValidatorTx.DelegationRewardsOwner()
+	delegationRewardsOwner, err := validatorRewardsOwner(
+		chainState,
+		txID,
+		uValidatorTx.DelegationRewardsOwner(),
+	)
+	if err != nil {
+		return err
+	}
 	outIntf, err := e.backend.Fx.CreateOutput(delegateeReward, delegationRewardsOwner)
 	if err != nil {
 		return fmt.Errorf("failed to create output: %w", err)
@@ -490,7 +538,8 @@ func (e *proposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 		return ErrInvalidState
 	}
 
-	onCommitUtxo := &avax.UTXO{
+	// Note: There is no [offset] if no reward was paid out.
+	utxo := &avax.UTXO{
 		UTXOID: avax.UTXOID{
 			TxID:        txID,
 			OutputIndex: uint32(len(outputs) + len(stake) + utxosOffset),
@@ -498,21 +547,8 @@ func (e *proposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 		Asset: stakeAsset,
 		Out:   out,
 	}
-	e.onCommitState.AddUTXO(onCommitUtxo)
-	e.onCommitState.AddRewardUTXO(txID, onCommitUtxo)
-
-	// Note: There is no [offset] if the RewardValidatorTx is
-	// aborted, because the validator reward is not awarded.
-	onAbortUtxo := &avax.UTXO{
-		UTXOID: avax.UTXOID{
-			TxID:        txID,
-			OutputIndex: uint32(len(outputs) + len(stake)),
-		},
-		Asset: stakeAsset,
-		Out:   out,
-	}
-	e.onAbortState.AddUTXO(onAbortUtxo)
-	e.onAbortState.AddRewardUTXO(txID, onAbortUtxo)
+	chainState.AddUTXO(utxo)
+	chainState.AddRewardUTXO(txID, utxo)
 	return nil
 }
 
@@ -556,7 +592,7 @@ func (e *proposalTxExecutor) rewardDelegatorTx(uDelegatorTx txs.DelegatorTx, del
 	//            transactions that implement [txs.ValidatorTx]. All
 	//            validator transactions implement this interface except the
 	//            AddSubnetValidatorTx.
-	vdrTx, ok := vdrTxIntf.Unsigned.(txs.ValidatorTx)
+	vdrTx, ok := txs.Unwrap(vdrTxIntf.Unsigned).(txs.ValidatorTx)
 	if !ok {
 		return ErrWrongTxType
 	}
@@ -625,7 +661,14 @@ func (e *proposalTxExecutor) rewardDelegatorTx(uDelegatorTx txs.DelegatorTx, del
 	} else {
 		// For any validators who started prior to [CortinaTime], we issue the
 		// [delegateeReward] immediately.
-		delegationRewardsOwner := vdrTx.DelegationRewardsOwner()
+		delegationRewardsOwner, err := validatorRewardsOwner(
+			e.onCommitState,
+			validator.TxID,
+			vdrTx.DelegationRewardsOwner(),
+		)
+		if err != nil {
+			return err
+		}
 		outIntf, err := e.backend.Fx.CreateOutput(delegateeReward, delegationRewardsOwner)
 		if err != nil {
 			return fmt.Errorf("failed to create output: %w", err)
@@ -648,3 +691,22 @@ func (e *proposalTxExecutor) rewardDelegatorTx(uDelegatorTx txs.DelegatorTx, del
 	}
 	return nil
 }
+
+// validatorRewardsOwner returns the owner that the rewards of the validator
+// added by [txID] are paid to. If the validator has a withdrawal owner, it is
+// returned instead of [rewardsOwner].
+func validatorRewardsOwner(
+	chainState state.Chain,
+	txID ids.ID,
+	rewardsOwner fx.Owner,
+) (fx.Owner, error) {
+	withdrawalOwner, err := chainState.GetWithdrawalOwner(txID)
+	switch err {
+	case nil:
+		return withdrawalOwner, nil
+	case database.ErrNotFound:
+		return rewardsOwner, nil
+	default:
+		return nil, fmt.Errorf("failed to get withdrawal owner of %s: %w", txID, err)
+	}
+}
@@ -14,11 +14,13 @@ import (
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -878,3 +880,422 @@ func TestRewardDelegatorTxExecuteOnAbort(t *testing.T) {
 	require.NoError(err)
 	require.Equal(initialSupply-expectedReward, newSupply, "should have removed un-rewarded tokens from the potential supply")
 }
+
+func TestRewardContinuousValidatorTx(t *testing.T) {
+	tests := []struct {
+		name          string
+		stop          bool
+		expectRenewed bool
+	}{
+		{
+			name:          "renewed",
+			expectRenewed: true,
+		},
+		{
+			name: "stopped",
+			stop: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			env := newEnvironment(t, upgradetest.Fortuna)
+			env.ctx.Lock.Lock()
+			defer env.ctx.Lock.Unlock()
+
+			sk, err := localsigner.New()
+			require.NoError(err)
+			pop, err := signer.NewProofOfPossession(sk)
+			require.NoError(err)
+
+			var (
+				nodeID  = ids.GenerateTestNodeID()
+				endTime = env.state.GetTimestamp().Add(defaultMinStakingDuration)
+				owner   = &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						ids.GenerateTestShortID(),
+					},
+				}
+				wallet = newWallet(t, env, walletConfig{})
+			)
+			addTx, err := wallet.IssueAddContinuousValidatorTx(
+				&txs.Validator{
+					NodeID: nodeID,
+					End:    uint64(endTime.Unix()),
+					Wght:   env.config.MinValidatorStake,
+				},
+				pop,
+				owner,
+				owner,
+				reward.PercentDenominator,
+				defaultMinStakingDuration,
+			)
+			require.NoError(err)
+
+			diff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			feeCalculator := state.PickFeeCalculator(env.config, diff)
+			_, _, _, err = StandardTx(
+				&env.backend,
+				feeCalculator,
+				addTx,
+				diff,
+			)
+			require.NoError(err)
+
+			vdr, err := diff.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.NoError(err)
+			if test.stop {
+				stoppedVdr := *vdr
+				stoppedVdr.ContinuationPeriod = 0
+				require.NoError(diff.UpdateCurrentValidator(&stoppedVdr))
+				vdr = &stoppedVdr
+			}
+
+			diff.AddTx(addTx, status.Committed)
+			require.NoError(diff.Apply(env.state))
+			env.state.SetHeight(1)
+			require.NoError(env.state.Commit())
+
+			env.state.SetTimestamp(endTime)
+
+			tx, err := newRewardValidatorTx(t, addTx.ID())
+			require.NoError(err)
+
+			onCommitState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			onAbortState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			require.NoError(ProposalTx(
+				&env.backend,
+				feeCalculator,
+				tx,
+				onCommitState,
+				onAbortState,
+			))
+
+			// The validator is always removed on abort.
+			_, err = onAbortState.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.ErrorIs(err, database.ErrNotFound)
+
+			rewardUTXOs, err := onCommitState.GetRewardUTXOs(addTx.ID())
+			require.NoError(err)
+
+			renewedVdr, err := onCommitState.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			if !test.expectRenewed {
+				require.ErrorIs(err, database.ErrNotFound)
+				require.Len(rewardUTXOs, 1)
+				require.Equal(vdr.PotentialReward, rewardUTXOs[0].Out.(*secp256k1fx.TransferOutput).Amt)
+				return
+			}
+			require.NoError(err)
+			require.Empty(rewardUTXOs)
+
+			// The reward of the first staking period is restaked.
+			expectedWeight := vdr.Weight + vdr.PotentialReward
+			require.Equal(expectedWeight, renewedVdr.Weight)
+			require.Equal(vdr.EndTime, renewedVdr.StartTime)
+			require.Equal(vdr.EndTime.Add(defaultMinStakingDuration), renewedVdr.EndTime)
+			require.Equal(renewedVdr.EndTime, renewedVdr.NextTime)
+			require.Equal(vdr.ContinuationPeriod, renewedVdr.ContinuationPeriod)
+			require.Positive(renewedVdr.PotentialReward)
+
+			require.NoError(onCommitState.Apply(env.state))
+			env.state.SetHeight(2)
+			require.NoError(env.state.Commit())
+
+			// The uptime of the new staking period is measured from its start.
+			upDuration, lastUpdated, err := env.state.GetUptime(nodeID)
+			require.NoError(err)
+			require.Zero(upDuration)
+			require.Equal(renewedVdr.StartTime.Unix(), lastUpdated.Unix())
+
+			// Rewards that were restaked are paid out at the end of the final
+			// staking period, even if the final reward is forfeited.
+			env.state.SetTimestamp(renewedVdr.EndTime)
+
+			onCommitState, err = state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			onAbortState, err = state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			stoppedVdr := *renewedVdr
+			stoppedVdr.ContinuationPeriod = 0
+			require.NoError(onCommitState.UpdateCurrentValidator(&stoppedVdr))
+			require.NoError(onAbortState.UpdateCurrentValidator(&stoppedVdr))
+
+			require.NoError(ProposalTx(
+				&env.backend,
+				feeCalculator,
+				tx,
+				onCommitState,
+				onAbortState,
+			))
+
+			onCommitRewardUTXOs, err := onCommitState.GetRewardUTXOs(addTx.ID())
+			require.NoError(err)
+			require.Len(onCommitRewardUTXOs, 1)
+			require.Equal(
+				vdr.PotentialReward+renewedVdr.PotentialReward,
+				onCommitRewardUTXOs[0].Out.(*secp256k1fx.TransferOutput).Amt,
+			)
+
+			onAbortRewardUTXOs, err := onAbortState.GetRewardUTXOs(addTx.ID())
+			require.NoError(err)
+			require.Len(onAbortRewardUTXOs, 1)
+			require.Equal(
+				vdr.PotentialReward,
+				onAbortRewardUTXOs[0].Out.(*secp256k1fx.TransferOutput).Amt,
+			)
+		})
+	}
+}
+
+func TestRenewContinuousValidatorWithDelegators(t *testing.T) {
+	tests := []struct {
+		name            string
+		delegatorWeight uint64
+		expectRenewed   bool
+	}{
+		{
+			name:            "renewed",
+			delegatorWeight: defaultMinValidatorStake,
+			expectRenewed:   true,
+		},
+		{
+			name:            "delegators exceed max stake",
+			delegatorWeight: 100 * defaultMinValidatorStake, // the max stake
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			env := newEnvironment(t, upgradetest.Fortuna)
+			env.ctx.Lock.Lock()
+			defer env.ctx.Lock.Unlock()
+
+			sk, err := localsigner.New()
+			require.NoError(err)
+			pop, err := signer.NewProofOfPossession(sk)
+			require.NoError(err)
+
+			var (
+				nodeID  = ids.GenerateTestNodeID()
+				endTime = env.state.GetTimestamp().Add(defaultMinStakingDuration)
+				owner   = &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						ids.GenerateTestShortID(),
+					},
+				}
+				wallet = newWallet(t, env, walletConfig{})
+			)
+			addTx, err := wallet.IssueAddContinuousValidatorTx(
+				&txs.Validator{
+					NodeID: nodeID,
+					End:    uint64(endTime.Unix()),
+					Wght:   env.config.MinValidatorStake,
+				},
+				pop,
+				owner,
+				owner,
+				reward.PercentDenominator,
+				defaultMinStakingDuration,
+			)
+			require.NoError(err)
+
+			diff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			feeCalculator := state.PickFeeCalculator(env.config, diff)
+			_, _, _, err = StandardTx(
+				&env.backend,
+				feeCalculator,
+				addTx,
+				diff,
+			)
+			require.NoError(err)
+
+			vdr, err := diff.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.NoError(err)
+
+			// The delegation spans the validator's whole staking period, as
+			// delegator verification doesn't allow delegating past it.
+			diff.PutCurrentDelegator(&state.Staker{
+				TxID:      ids.GenerateTestID(),
+				NodeID:    nodeID,
+				SubnetID:  constants.PrimaryNetworkID,
+				Weight:    test.delegatorWeight,
+				StartTime: vdr.StartTime,
+				EndTime:   vdr.EndTime,
+				NextTime:  vdr.EndTime,
+				Priority:  txs.PrimaryNetworkDelegatorCurrentPriority,
+			})
+
+			diff.AddTx(addTx, status.Committed)
+			require.NoError(diff.Apply(env.state))
+			env.state.SetHeight(1)
+			require.NoError(env.state.Commit())
+
+			env.state.SetTimestamp(endTime)
+
+			onCommitState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			onAbortState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			// The renewal is checked directly, as the delegator ends with the
+			// validator and would otherwise be removed first.
+			executor := &proposalTxExecutor{
+				backend:       &env.backend,
+				feeCalculator: feeCalculator,
+				onCommitState: onCommitState,
+				onAbortState:  onAbortState,
+			}
+			renewed, err := executor.renewValidator(vdr)
+			require.NoError(err)
+			require.Equal(test.expectRenewed, renewed)
+
+			renewedVdr, err := onCommitState.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.NoError(err)
+			if !test.expectRenewed {
+				require.Equal(vdr, renewedVdr)
+				return
+			}
+
+			// The weight of the renewed validator, including the delegator's
+			// stake, is bounded by the maximum stake.
+			expectedWeight := vdr.Weight + vdr.PotentialReward
+			require.Equal(expectedWeight, renewedVdr.Weight)
+
+			maxWeight, err := GetMaxWeight(
+				onCommitState,
+				renewedVdr,
+				renewedVdr.StartTime,
+				renewedVdr.EndTime,
+			)
+			require.NoError(err)
+			require.Equal(expectedWeight+test.delegatorWeight, maxWeight)
+			require.LessOrEqual(maxWeight, env.config.MaxValidatorStake)
+		})
+	}
+}
+
+func TestRewardValidatorTxWithdrawalOwner(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, upgradetest.Fortuna)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(err)
+
+	var (
+		nodeID       = ids.GenerateTestNodeID()
+		endTime      = env.state.GetTimestamp().Add(defaultMinStakingDuration)
+		rewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				ids.GenerateTestShortID(),
+			},
+		}
+		withdrawalOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				ids.GenerateTestShortID(),
+			},
+		}
+		wallet = newWallet(t, env, walletConfig{})
+	)
+	addTx, err := wallet.IssueAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				End:    uint64(endTime.Unix()),
+				Wght:   env.config.MinValidatorStake,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		pop,
+		env.ctx.AVAXAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.NoError(err)
+
+	diff, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	feeCalculator := state.PickFeeCalculator(env.config, diff)
+	_, _, _, err = StandardTx(
+		&env.backend,
+		feeCalculator,
+		addTx,
+		diff,
+	)
+	require.NoError(err)
+
+	diff.AddTx(addTx, status.Committed)
+	require.NoError(diff.Apply(env.state))
+	env.state.SetHeight(1)
+	require.NoError(env.state.Commit())
+
+	const delegateeReward = 1_000
+	require.NoError(env.state.SetDelegateeReward(constants.PrimaryNetworkID, nodeID, delegateeReward))
+	env.state.SetWithdrawalOwner(addTx.ID(), withdrawalOwner)
+	env.state.SetHeight(2)
+	require.NoError(env.state.Commit())
+
+	vdr, err := env.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+	require.Positive(vdr.PotentialReward)
+
+	env.state.SetTimestamp(endTime)
+
+	tx, err := newRewardValidatorTx(t, addTx.ID())
+	require.NoError(err)
+
+	onCommitState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	onAbortState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	require.NoError(ProposalTx(
+		&env.backend,
+		feeCalculator,
+		tx,
+		onCommitState,
+		onAbortState,
+	))
+
+	// Both the validation reward and the delegatee reward are paid to the
+	// withdrawal owner.
+	rewardUTXOs, err := onCommitState.GetRewardUTXOs(addTx.ID())
+	require.NoError(err)
+	require.Len(rewardUTXOs, 2)
+	for i, expectedAmount := range []uint64{vdr.PotentialReward, delegateeReward} {
+		out := rewardUTXOs[i].Out.(*secp256k1fx.TransferOutput)
+		require.Equal(expectedAmount, out.Amt)
+		require.Equal(*withdrawalOwner, out.OutputOwners)
+	}
+
+	rewardUTXOs, err = onAbortState.GetRewardUTXOs(addTx.ID())
+	require.NoError(err)
+	require.Len(rewardUTXOs, 1)
+	out := rewardUTXOs[0].Out.(*secp256k1fx.TransferOutput)
+	require.Equal(uint64(delegateeReward), out.Amt)
+	require.Equal(*withdrawalOwner, out.OutputOwners)
+}
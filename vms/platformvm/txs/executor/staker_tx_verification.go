@@ -112,28 +112,44 @@ func verifyAddValidatorTx(
 		return nil, err
 	}
 
+	c := newChecker(backend)
+	stakingParams := chainState.GetStakingParams()
+
 	startTime := tx.StartTime()
 	duration := tx.EndTime().Sub(startTime)
-	switch {
-	case tx.Validator.Wght < backend.Config.MinValidatorStake:
-		// Ensure validator is staking at least the minimum amount
-		return nil, ErrWeightTooSmall
+	// Ensure validator is staking at least the minimum amount
+	if tx.Validator.Wght < stakingParams.MinValidatorStake {
+		if err := c.fail(ErrWeightTooSmall); err != nil {
+			return nil, err
+		}
+	}
 
-	case tx.Validator.Wght > backend.Config.MaxValidatorStake:
-		// Ensure validator isn't staking too much
-		return nil, ErrWeightTooLarge
+	// Ensure validator isn't staking too much
+	if tx.Validator.Wght > stakingParams.MaxValidatorStake {
+		if err := c.fail(ErrWeightTooLarge); err != nil {
+			return nil, err
+		}
+	}
 
-	case tx.DelegationShares < backend.Config.MinDelegationFee:
-		// Ensure the validator fee is at least the minimum amount
-		return nil, ErrInsufficientDelegationFee
+	// Ensure the validator fee is at least the minimum amount
+	if tx.DelegationShares < backend.Config.MinDelegationFee {
+		if err := c.fail(ErrInsufficientDelegationFee); err != nil {
+			return nil, err
+		}
+	}
 
-	case duration < backend.Config.MinStakeDuration:
-		// Ensure staking length is not too short
-		return nil, ErrStakeTooShort
+	// Ensure staking length is not too short
+	if duration < stakingParams.MinStakeDuration {
+		if err := c.fail(ErrStakeTooShort); err != nil {
+			return nil, err
+		}
+	}
 
-	case duration > backend.Config.MaxStakeDuration:
-		// Ensure staking length is not too long
-		return nil, ErrStakeTooLong
+	// Ensure staking length is not too long
+	if duration > stakingParams.MaxStakeDuration {
+		if err := c.fail(ErrStakeTooLong); err != nil {
+			return nil, err
+		}
 	}
 
 	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
@@ -144,7 +160,7 @@ func verifyAddValidatorTx(
 		return outs, nil
 	}
 
-	if err := verifyStakerStartTime(false /*=isDurangoActive*/, currentTimestamp, startTime); err != nil {
+	if err := c.fail(verifyStakerStartTime(false /*=isDurangoActive*/, currentTimestamp, startTime)); err != nil {
 		return nil, err
 	}
 
@@ -170,7 +186,7 @@ func verifyAddValidatorTx(
 		return nil, err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(backend, tx),
 		chainState,
 		tx.Ins,
 		outs,
@@ -179,9 +195,14 @@ func verifyAddValidatorTx(
 			backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return nil, err
+		}
 	}
 
+	if err := c.err(); err != nil {
+		return nil, err
+	}
 	return outs, nil
 }
 
@@ -207,27 +228,34 @@ func verifyAddSubnetValidatorTx(
 		return err
 	}
 
+	c := newChecker(backend)
+	stakingParams := chainState.GetStakingParams()
+
 	startTime := currentTimestamp
 	if !isDurangoActive {
 		startTime = tx.StartTime()
 	}
 	duration := tx.EndTime().Sub(startTime)
 
-	switch {
-	case duration < backend.Config.MinStakeDuration:
-		// Ensure staking length is not too short
-		return ErrStakeTooShort
+	// Ensure staking length is not too short
+	if duration < stakingParams.MinStakeDuration {
+		if err := c.fail(ErrStakeTooShort); err != nil {
+			return err
+		}
+	}
 
-	case duration > backend.Config.MaxStakeDuration:
-		// Ensure staking length is not too long
-		return ErrStakeTooLong
+	// Ensure staking length is not too long
+	if duration > stakingParams.MaxStakeDuration {
+		if err := c.fail(ErrStakeTooLong); err != nil {
+			return err
+		}
 	}
 
 	if !backend.Bootstrapped.Get() {
 		return nil
 	}
 
-	if err := verifyStakerStartTime(isDurangoActive, currentTimestamp, startTime); err != nil {
+	if err := c.fail(verifyStakerStartTime(isDurangoActive, currentTimestamp, startTime)); err != nil {
 		return err
 	}
 
@@ -252,7 +280,7 @@ func verifyAddSubnetValidatorTx(
 		return err
 	}
 
-	baseTxCreds, err := verifyPoASubnetAuthorization(backend.Fx, chainState, sTx, tx.SubnetValidator.Subnet, tx.SubnetAuth)
+	baseTxCreds, err := verifyPoASubnetAuthorization(c, backend.Fx, chainState, sTx, tx.SubnetValidator.Subnet, tx.SubnetAuth)
 	if err != nil {
 		return err
 	}
@@ -263,7 +291,7 @@ func verifyAddSubnetValidatorTx(
 		return err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(backend, tx),
 		chainState,
 		tx.Ins,
 		tx.Outs,
@@ -272,10 +300,12 @@ func verifyAddSubnetValidatorTx(
 			backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
-		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return c.err()
 }
 
 // Returns the representation of [tx.NodeID] validating [tx.Subnet].
@@ -306,6 +336,8 @@ func verifyRemoveSubnetValidatorTx(
 		return nil, false, err
 	}
 
+	c := newChecker(backend)
+
 	isCurrentValidator := true
 	vdr, err := chainState.GetCurrentValidator(tx.Subnet, tx.NodeID)
 	if err == database.ErrNotFound {
@@ -332,7 +364,7 @@ func verifyRemoveSubnetValidatorTx(
 		return vdr, isCurrentValidator, nil
 	}
 
-	baseTxCreds, err := verifySubnetAuthorization(backend.Fx, chainState, sTx, tx.Subnet, tx.SubnetAuth)
+	baseTxCreds, err := verifySubnetAuthorization(c, backend.Fx, chainState, sTx, tx.Subnet, tx.SubnetAuth)
 	if err != nil {
 		return nil, false, err
 	}
@@ -343,7 +375,7 @@ func verifyRemoveSubnetValidatorTx(
 		return nil, false, err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(backend, tx),
 		chainState,
 		tx.Ins,
 		tx.Outs,
@@ -352,9 +384,14 @@ func verifyRemoveSubnetValidatorTx(
 			backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
-		return nil, false, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return nil, false, err
+		}
 	}
 
+	if err := c.err(); err != nil {
+		return nil, false, err
+	}
 	return vdr, isCurrentValidator, nil
 }
 
@@ -385,23 +422,33 @@ func verifyAddDelegatorTx(
 		return nil, err
 	}
 
+	c := newChecker(backend)
+	stakingParams := chainState.GetStakingParams()
+
 	var (
 		endTime   = tx.EndTime()
 		startTime = tx.StartTime()
 		duration  = endTime.Sub(startTime)
 	)
-	switch {
-	case duration < backend.Config.MinStakeDuration:
-		// Ensure staking length is not too short
-		return nil, ErrStakeTooShort
+	// Ensure staking length is not too short
+	if duration < stakingParams.MinStakeDuration {
+		if err := c.fail(ErrStakeTooShort); err != nil {
+			return nil, err
+		}
+	}
 
-	case duration > backend.Config.MaxStakeDuration:
-		// Ensure staking length is not too long
-		return nil, ErrStakeTooLong
+	// Ensure staking length is not too long
+	if duration > stakingParams.MaxStakeDuration {
+		if err := c.fail(ErrStakeTooLong); err != nil {
+			return nil, err
+		}
+	}
 
-	case tx.Validator.Wght < backend.Config.MinDelegatorStake:
-		// Ensure validator is staking at least the minimum amount
-		return nil, ErrWeightTooSmall
+	// Ensure validator is staking at least the minimum amount
+	if tx.Validator.Wght < stakingParams.MinDelegatorStake {
+		if err := c.fail(ErrWeightTooSmall); err != nil {
+			return nil, err
+		}
 	}
 
 	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
@@ -412,7 +459,7 @@ func verifyAddDelegatorTx(
 		return outs, nil
 	}
 
-	if err := verifyStakerStartTime(false /*=isDurangoActive*/, currentTimestamp, startTime); err != nil {
+	if err := c.fail(verifyStakerStartTime(false /*=isDurangoActive*/, currentTimestamp, startTime)); err != nil {
 		return nil, err
 	}
 
@@ -431,7 +478,7 @@ func verifyAddDelegatorTx(
 	}
 
 	if backend.Config.UpgradeConfig.IsApricotPhase3Activated(currentTimestamp) {
-		maximumWeight = min(maximumWeight, backend.Config.MaxValidatorStake)
+		maximumWeight = min(maximumWeight, stakingParams.MaxValidatorStake)
 	}
 
 	if !txs.BoundedBy(
@@ -440,7 +487,9 @@ func verifyAddDelegatorTx(
 		primaryNetworkValidator.StartTime,
 		primaryNetworkValidator.EndTime,
 	) {
-		return nil, ErrPeriodMismatch
+		if err := c.fail(ErrPeriodMismatch); err != nil {
+			return nil, err
+		}
 	}
 	overDelegated, err := overDelegated(
 		chainState,
@@ -454,7 +503,9 @@ func verifyAddDelegatorTx(
 		return nil, err
 	}
 	if overDelegated {
-		return nil, ErrOverDelegated
+		if err := c.fail(ErrOverDelegated); err != nil {
+			return nil, err
+		}
 	}
 
 	// Verify the flowcheck
@@ -463,7 +514,7 @@ func verifyAddDelegatorTx(
 		return nil, err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(backend, tx),
 		chainState,
 		tx.Ins,
 		outs,
@@ -472,9 +523,14 @@ func verifyAddDelegatorTx(
 			backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return nil, err
+		}
 	}
 
+	if err := c.err(); err != nil {
+		return nil, err
+	}
 	return outs, nil
 }
 
@@ -500,6 +556,8 @@ func verifyAddPermissionlessValidatorTx(
 		return err
 	}
 
+	c := newChecker(backend)
+
 	if !backend.Bootstrapped.Get() {
 		return nil
 	}
@@ -510,7 +568,7 @@ func verifyAddPermissionlessValidatorTx(
 	}
 	duration := tx.EndTime().Sub(startTime)
 
-	if err := verifyStakerStartTime(isDurangoActive, currentTimestamp, startTime); err != nil {
+	if err := c.fail(verifyStakerStartTime(isDurangoActive, currentTimestamp, startTime)); err != nil {
 		return err
 	}
 
@@ -520,29 +578,43 @@ func verifyAddPermissionlessValidatorTx(
 	}
 
 	stakedAssetID := tx.StakeOuts[0].AssetID()
-	switch {
-	case tx.Validator.Wght < validatorRules.minValidatorStake:
-		// Ensure validator is staking at least the minimum amount
-		return ErrWeightTooSmall
+	// Ensure validator is staking at least the minimum amount
+	if tx.Validator.Wght < validatorRules.minValidatorStake {
+		if err := c.fail(ErrWeightTooSmall); err != nil {
+			return err
+		}
+	}
 
-	case tx.Validator.Wght > validatorRules.maxValidatorStake:
-		// Ensure validator isn't staking too much
-		return ErrWeightTooLarge
+	// Ensure validator isn't staking too much
+	if tx.Validator.Wght > validatorRules.maxValidatorStake {
+		if err := c.fail(ErrWeightTooLarge); err != nil {
+			return err
+		}
+	}
 
-	case tx.DelegationShares < validatorRules.minDelegationFee:
-		// Ensure the validator fee is at least the minimum amount
-		return ErrInsufficientDelegationFee
+	// Ensure the validator fee is at least the minimum amount
+	if tx.DelegationShares < validatorRules.minDelegationFee {
+		if err := c.fail(ErrInsufficientDelegationFee); err != nil {
+			return err
+		}
+	}
 
-	case duration < validatorRules.minStakeDuration:
-		// Ensure staking length is not too short
-		return ErrStakeTooShort
+	// Ensure staking length is not too short
+	if duration < validatorRules.minStakeDuration {
+		if err := c.fail(ErrStakeTooShort); err != nil {
+			return err
+		}
+	}
 
-	case duration > validatorRules.maxStakeDuration:
-		// Ensure staking length is not too long
-		return ErrStakeTooLong
+	// Ensure staking length is not too long
+	if duration > validatorRules.maxStakeDuration {
+		if err := c.fail(ErrStakeTooLong); err != nil {
+			return err
+		}
+	}
 
-	case stakedAssetID != validatorRules.assetID:
-		// Wrong assetID used
+	// Ensure the right asset is staked
+	if stakedAssetID != validatorRules.assetID {
 		return fmt.Errorf(
 			"%w: %s != %s",
 			ErrWrongStakedAssetID,
@@ -585,7 +657,7 @@ func verifyAddPermissionlessValidatorTx(
 		return err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(backend, tx),
 		chainState,
 		tx.Ins,
 		outs,
@@ -594,10 +666,12 @@ func verifyAddPermissionlessValidatorTx(
 			backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
-		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return c.err()
 }
 
 // verifyAddPermissionlessDelegatorTx carries out the validation for an
@@ -622,6 +696,8 @@ func verifyAddPermissionlessDelegatorTx(
 		return err
 	}
 
+	c := newChecker(backend)
+
 	if !backend.Bootstrapped.Get() {
 		return nil
 	}
@@ -633,9 +709,7 @@ func verifyAddPermissionlessDelegatorTx(
 	if !isDurangoActive {
 		startTime = tx.StartTime()
 	}
-	duration := endTime.Sub(startTime)
-
-	if err := verifyStakerStartTime(isDurangoActive, currentTimestamp, startTime); err != nil {
+	if err := c.fail(verifyStakerStartTime(isDurangoActive, currentTimestamp, startTime)); err != nil {
 		return err
 	}
 
@@ -644,22 +718,84 @@ func verifyAddPermissionlessDelegatorTx(
 		return err
 	}
 
-	stakedAssetID := tx.StakeOuts[0].AssetID()
-	switch {
-	case tx.Validator.Wght < delegatorRules.minDelegatorStake:
-		// Ensure delegator is staking at least the minimum amount
-		return ErrWeightTooSmall
+	err = verifyDelegation(
+		c,
+		chainState,
+		delegatorRules,
+		tx.Subnet,
+		&tx.Validator,
+		tx.StakeOuts[0].AssetID(),
+		startTime,
+		endTime,
+	)
+	if err != nil {
+		return err
+	}
 
-	case duration < delegatorRules.minStakeDuration:
-		// Ensure staking length is not too short
-		return ErrStakeTooShort
+	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
+	copy(outs, tx.Outs)
+	copy(outs[len(tx.Outs):], tx.StakeOuts)
 
-	case duration > delegatorRules.maxStakeDuration:
-		// Ensure staking length is not too long
-		return ErrStakeTooLong
+	// Verify the flowcheck
+	fee, err := feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+	if err := backend.FlowChecker.VerifySpend(
+		withHeight(backend, tx),
+		chainState,
+		tx.Ins,
+		outs,
+		sTx.Creds,
+		map[ids.ID]uint64{
+			backend.Ctx.AVAXAssetID: fee,
+		},
+	); err != nil {
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
+	}
+
+	return c.err()
+}
+
+// verifyDelegation verifies that [vdr], staked with [stakedAssetID], may
+// delegate to its validator on [subnetID] from [startTime] until [endTime].
+// The failed deferrable checks are reported to [c].
+func verifyDelegation(
+	c *checker,
+	chainState state.Chain,
+	delegatorRules *addDelegatorRules,
+	subnetID ids.ID,
+	vdr *txs.Validator,
+	stakedAssetID ids.ID,
+	startTime time.Time,
+	endTime time.Time,
+) error {
+	duration := endTime.Sub(startTime)
+	// Ensure delegator is staking at least the minimum amount
+	if vdr.Wght < delegatorRules.minDelegatorStake {
+		if err := c.fail(ErrWeightTooSmall); err != nil {
+			return err
+		}
+	}
+
+	// Ensure staking length is not too short
+	if duration < delegatorRules.minStakeDuration {
+		if err := c.fail(ErrStakeTooShort); err != nil {
+			return err
+		}
+	}
+
+	// Ensure staking length is not too long
+	if duration > delegatorRules.maxStakeDuration {
+		if err := c.fail(ErrStakeTooLong); err != nil {
+			return err
+		}
+	}
 
-	case stakedAssetID != delegatorRules.assetID:
-		// Wrong assetID used
+	// Ensure the right asset is staked
+	if stakedAssetID != delegatorRules.assetID {
 		return fmt.Errorf(
 			"%w: %s != %s",
 			ErrWrongStakedAssetID,
@@ -668,12 +804,12 @@ func verifyAddPermissionlessDelegatorTx(
 		)
 	}
 
-	validator, err := GetValidator(chainState, tx.Subnet, tx.Validator.NodeID)
+	validator, err := GetValidator(chainState, subnetID, vdr.NodeID)
 	if err != nil {
 		return fmt.Errorf(
 			"failed to fetch the validator for %s on %s: %w",
-			tx.Validator.NodeID,
-			tx.Subnet,
+			vdr.NodeID,
+			subnetID,
 			err,
 		)
 	}
@@ -693,13 +829,15 @@ func verifyAddPermissionlessDelegatorTx(
 		validator.StartTime,
 		validator.EndTime,
 	) {
-		return ErrPeriodMismatch
+		if err := c.fail(ErrPeriodMismatch); err != nil {
+			return err
+		}
 	}
 	overDelegated, err := overDelegated(
 		chainState,
 		validator,
 		maximumWeight,
-		tx.Validator.Wght,
+		vdr.Wght,
 		startTime,
 		endTime,
 	)
@@ -707,14 +845,12 @@ func verifyAddPermissionlessDelegatorTx(
 		return err
 	}
 	if overDelegated {
-		return ErrOverDelegated
+		if err := c.fail(ErrOverDelegated); err != nil {
+			return err
+		}
 	}
 
-	outs := make([]*avax.TransferableOutput, len(tx.Outs)+len(tx.StakeOuts))
-	copy(outs, tx.Outs)
-	copy(outs[len(tx.Outs):], tx.StakeOuts)
-
-	if tx.Subnet != constants.PrimaryNetworkID {
+	if subnetID != constants.PrimaryNetworkID {
 		// Invariant: Delegators must only be able to reference validator
 		//            transactions that implement [txs.ValidatorTx]. All
 		//            validator transactions implement this interface except the
@@ -725,6 +861,69 @@ func verifyAddPermissionlessDelegatorTx(
 			return ErrDelegateToPermissionedValidator
 		}
 	}
+	return nil
+}
+
+// Returns an error if the given tx is invalid.
+// The transaction is valid if:
+// * [sTx]'s creds authorize it to spend the stated inputs.
+// * Every delegation satisfies the primary network delegation rules.
+// * The flow checker passes.
+func verifyAddMultiDelegatorTx(
+	backend *Backend,
+	feeCalculator fee.Calculator,
+	chainState state.Chain,
+	sTx *txs.Tx,
+	tx *txs.AddMultiDelegatorTx,
+) error {
+	currentTimestamp := chainState.GetTimestamp()
+	if !backend.Config.UpgradeConfig.IsFortunaActivated(currentTimestamp) {
+		return errFortunaUpgradeNotActive
+	}
+
+	// Verify the tx is well-formed
+	if err := sTx.SyntacticVerify(backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	c := newChecker(backend)
+
+	if !backend.Bootstrapped.Get() {
+		return nil
+	}
+
+	delegatorRules, err := getDelegatorRules(backend, chainState, constants.PrimaryNetworkID)
+	if err != nil {
+		return err
+	}
+
+	outs := tx.Outs
+	for _, delegation := range tx.Delegations {
+		delegationChecker := newChecker(backend)
+		err := verifyDelegation(
+			delegationChecker,
+			chainState,
+			delegatorRules,
+			constants.PrimaryNetworkID,
+			&delegation.Validator,
+			delegation.StakeOuts[0].AssetID(),
+			currentTimestamp,
+			delegation.EndTime(),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid delegation to %s: %w", delegation.NodeID, err)
+		}
+		if err := delegationChecker.err(); err != nil {
+			if err := c.fail(fmt.Errorf("invalid delegation to %s: %w", delegation.NodeID, err)); err != nil {
+				return err
+			}
+		}
+		outs = append(outs, delegation.StakeOuts...)
+	}
 
 	// Verify the flowcheck
 	fee, err := feeCalculator.CalculateFee(tx)
@@ -732,7 +931,7 @@ func verifyAddPermissionlessDelegatorTx(
 		return err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(backend, tx),
 		chainState,
 		tx.Ins,
 		outs,
@@ -741,10 +940,12 @@ func verifyAddPermissionlessDelegatorTx(
 			backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
-		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return c.err()
 }
 
 // Returns an error if the given tx is invalid.
@@ -776,12 +977,14 @@ func verifyTransferSubnetOwnershipTx(
 		return err
 	}
 
+	c := newChecker(backend)
+
 	if !backend.Bootstrapped.Get() {
 		// Not bootstrapped yet -- don't need to do full verification.
 		return nil
 	}
 
-	baseTxCreds, err := verifySubnetAuthorization(backend.Fx, chainState, sTx, tx.Subnet, tx.SubnetAuth)
+	baseTxCreds, err := verifySubnetAuthorization(c, backend.Fx, chainState, sTx, tx.Subnet, tx.SubnetAuth)
 	if err != nil {
 		return err
 	}
@@ -792,7 +995,7 @@ func verifyTransferSubnetOwnershipTx(
 		return err
 	}
 	if err := backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(backend, tx),
 		chainState,
 		tx.Ins,
 		tx.Outs,
@@ -801,10 +1004,12 @@ func verifyTransferSubnetOwnershipTx(
 			backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
-		return fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return c.err()
 }
 
 // Ensure the proposed validator starts after the current time
@@ -29,12 +29,13 @@ func getValidatorRules(
 	subnetID ids.ID,
 ) (*addValidatorRules, error) {
 	if subnetID == constants.PrimaryNetworkID {
+		stakingParams := chainState.GetStakingParams()
 		return &addValidatorRules{
 			assetID:           backend.Ctx.AVAXAssetID,
-			minValidatorStake: backend.Config.MinValidatorStake,
-			maxValidatorStake: backend.Config.MaxValidatorStake,
-			minStakeDuration:  backend.Config.MinStakeDuration,
-			maxStakeDuration:  backend.Config.MaxStakeDuration,
+			minValidatorStake: stakingParams.MinValidatorStake,
+			maxValidatorStake: stakingParams.MaxValidatorStake,
+			minStakeDuration:  stakingParams.MinStakeDuration,
+			maxStakeDuration:  stakingParams.MaxStakeDuration,
 			minDelegationFee:  backend.Config.MinDelegationFee,
 		}, nil
 	}
@@ -69,12 +70,13 @@ func getDelegatorRules(
 	subnetID ids.ID,
 ) (*addDelegatorRules, error) {
 	if subnetID == constants.PrimaryNetworkID {
+		stakingParams := chainState.GetStakingParams()
 		return &addDelegatorRules{
 			assetID:                  backend.Ctx.AVAXAssetID,
-			minDelegatorStake:        backend.Config.MinDelegatorStake,
-			maxValidatorStake:        backend.Config.MaxValidatorStake,
-			minStakeDuration:         backend.Config.MinStakeDuration,
-			maxStakeDuration:         backend.Config.MaxStakeDuration,
+			minDelegatorStake:        stakingParams.MinDelegatorStake,
+			maxValidatorStake:        stakingParams.MaxValidatorStake,
+			minStakeDuration:         stakingParams.MinStakeDuration,
+			maxStakeDuration:         stakingParams.MaxStakeDuration,
 			maxValidatorWeightFactor: MaxValidatorWeightFactor,
 		}, nil
 	}
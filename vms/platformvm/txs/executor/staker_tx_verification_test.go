@@ -542,6 +542,135 @@ func TestVerifyAddPermissionlessValidatorTx(t *testing.T) {
 	}
 }
 
+func TestVerifyAddPermissionlessValidatorTxReportAllErrors(t *testing.T) {
+	ctx := snowtest.Context(t, snowtest.PChainID)
+
+	var (
+		now           = time.Now().Truncate(time.Second)
+		subnetID      = ids.GenerateTestID()
+		customAssetID = ids.GenerateTestID()
+		transformTx   = txs.Tx{
+			Unsigned: &txs.TransformSubnetTx{
+				AssetID:           customAssetID,
+				MinValidatorStake: 2,
+				MaxValidatorStake: 3,
+				MinStakeDuration:  1,
+				MaxStakeDuration:  2,
+				MinDelegationFee:  5,
+			},
+			Creds: []verify.Verifiable{},
+		}
+		// The weight and the delegation fee are too low and the staking
+		// period is too long.
+		tx = &txs.AddPermissionlessValidatorTx{
+			BaseTx: txs.BaseTx{
+				SyntacticallyVerified: true,
+				BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+				},
+			},
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    uint64(now.Add(time.Minute).Unix()),
+				Wght:   1,
+			},
+			Subnet: subnetID,
+			StakeOuts: []*avax.TransferableOutput{
+				{
+					Asset: avax.Asset{
+						ID: customAssetID,
+					},
+				},
+			},
+			DelegationShares: 4,
+		}
+		sTx = &txs.Tx{
+			Unsigned: tx,
+			Creds:    []verify.Verifiable{},
+		}
+	)
+	sTx.SetBytes([]byte{1}, []byte{2})
+
+	tests := []struct {
+		name            string
+		reportAllErrors bool
+		expectedErrs    []error
+		unexpectedErrs  []error
+	}{
+		{
+			name:            "fail fast",
+			reportAllErrors: false,
+			expectedErrs: []error{
+				ErrWeightTooSmall,
+			},
+			unexpectedErrs: []error{
+				ErrInsufficientDelegationFee,
+				ErrStakeTooLong,
+				ErrFlowCheckFailed,
+			},
+		},
+		{
+			name:            "report all errors",
+			reportAllErrors: true,
+			expectedErrs: []error{
+				ErrWeightTooSmall,
+				ErrInsufficientDelegationFee,
+				ErrStakeTooLong,
+				ErrFlowCheckFailed,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			bootstrapped := &utils.Atomic[bool]{}
+			bootstrapped.Set(true)
+
+			flowChecker := utxomock.NewVerifier(ctrl)
+			backend := &Backend{
+				FlowChecker: flowChecker,
+				Config: &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Durango),
+				},
+				Ctx:             ctx,
+				Bootstrapped:    bootstrapped,
+				ReportAllErrors: test.reportAllErrors,
+			}
+
+			chain := state.NewMockChain(ctrl)
+			chain.EXPECT().GetTimestamp().Return(now).AnyTimes()
+			chain.EXPECT().GetSubnetTransformation(subnetID).Return(&transformTx, nil)
+			if test.reportAllErrors {
+				chain.EXPECT().GetCurrentValidator(subnetID, tx.NodeID()).Return(nil, database.ErrNotFound)
+				chain.EXPECT().GetPendingValidator(subnetID, tx.NodeID()).Return(nil, database.ErrNotFound)
+				chain.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, tx.NodeID()).Return(&state.Staker{
+					EndTime: mockable.MaxTime,
+				}, nil)
+				flowChecker.EXPECT().VerifySpend(
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+					gomock.Any(),
+				).Return(errTest)
+			}
+
+			feeCalculator := state.PickFeeCalculator(backend.Config, chain)
+			err := verifyAddPermissionlessValidatorTx(backend, feeCalculator, chain, sTx, tx)
+			for _, expectedErr := range test.expectedErrs {
+				require.ErrorIs(err, expectedErr)
+			}
+			for _, unexpectedErr := range test.unexpectedErrs {
+				require.NotErrorIs(err, unexpectedErr)
+			}
+		})
+	}
+}
+
 func TestGetValidatorRules(t *testing.T) {
 	type test struct {
 		name          string
@@ -575,8 +704,15 @@ func TestGetValidatorRules(t *testing.T) {
 					AVAXAssetID: avaxAssetID,
 				},
 			},
-			chainStateF: func(*gomock.Controller) state.Chain {
-				return nil
+			chainStateF: func(ctrl *gomock.Controller) state.Chain {
+				chainState := state.NewMockChain(ctrl)
+				chainState.EXPECT().GetStakingParams().Return(state.StakingParams{
+					MinValidatorStake: config.MinValidatorStake,
+					MaxValidatorStake: config.MaxValidatorStake,
+					MinStakeDuration:  config.MinStakeDuration,
+					MaxStakeDuration:  config.MaxStakeDuration,
+				})
+				return chainState
 			},
 			expectedRules: &addValidatorRules{
 				assetID:           avaxAssetID,
@@ -692,8 +828,15 @@ func TestGetDelegatorRules(t *testing.T) {
 					AVAXAssetID: avaxAssetID,
 				},
 			},
-			chainStateF: func(*gomock.Controller) state.Chain {
-				return nil
+			chainStateF: func(ctrl *gomock.Controller) state.Chain {
+				chainState := state.NewMockChain(ctrl)
+				chainState.EXPECT().GetStakingParams().Return(state.StakingParams{
+					MaxValidatorStake: config.MaxValidatorStake,
+					MinDelegatorStake: config.MinDelegatorStake,
+					MinStakeDuration:  config.MinStakeDuration,
+					MaxStakeDuration:  config.MaxStakeDuration,
+				})
+				return chainState
 			},
 			expectedRules: &addDelegatorRules{
 				assetID:                  avaxAssetID,
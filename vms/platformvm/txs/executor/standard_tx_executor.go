@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
@@ -23,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
@@ -48,6 +50,7 @@ var (
 	errMaxStakeDurationTooLarge         = errors.New("max stake duration must be less than or equal to the global max stake duration")
 	errMissingStartTimePreDurango       = errors.New("staker transactions must have a StartTime pre-Durango")
 	errEtnaUpgradeNotActive             = errors.New("attempting to use an Etna-upgrade feature prior to activation")
+	errFortunaUpgradeNotActive          = errors.New("attempting to use a Fortuna-upgrade feature prior to activation")
 	errTransformSubnetTxPostEtna        = errors.New("TransformSubnetTx is not permitted post-Etna")
 	errMaxNumActiveValidators           = errors.New("already at the max number of active validators")
 	errCouldNotLoadSubnetToL1Conversion = errors.New("could not load subnet conversion")
@@ -60,6 +63,18 @@ var (
 	errWarpMessageContainsStaleNonce    = errors.New("warp message contains stale nonce")
 	errRemovingLastValidator            = errors.New("attempting to remove the last L1 validator from a converted subnet")
 	errStateCorruption                  = errors.New("state corruption")
+
+	ErrTxExpired         = errors.New("tx expired")
+	ErrMissingDependency = errors.New("missing dependency")
+	ErrNotRewardUTXO     = errors.New("not a reward UTXO")
+	ErrInvalidClaimAuth  = errors.New("invalid claim authorization")
+
+	ErrSetPermissionlessValidatorWeight = errors.New("attempting to set the weight of a permissionless validator")
+	ErrNotContinuousValidator           = errors.New("not a continuous validator")
+	ErrContinuousValidatorStopped       = errors.New("continuous validator was already stopped")
+	ErrNotRewardedValidator             = errors.New("not a rewarded validator")
+	ErrNotChain                         = errors.New("not a chain")
+	ErrChainRetired                     = errors.New("chain was already retired")
 )
 
 // StandardTx executes the standard transaction [tx].
@@ -79,6 +94,11 @@ func StandardTx(
 	tx *txs.Tx,
 	state state.Diff,
 ) (set.Set[ids.ID], map[ids.ID]*atomic.Requests, func(), error) {
+	if err := verifyHeightLockedOutputs(backend, state.GetTimestamp(), tx.Unsigned); err != nil {
+		txID := tx.ID()
+		return nil, nil, nil, fmt.Errorf("standard tx %s failed execution: %w", txID, err)
+	}
+
 	standardExecutor := standardTxExecutor{
 		backend:       backend,
 		feeCalculator: feeCalculator,
@@ -105,14 +125,6 @@ type standardTxExecutor struct {
 	atomicRequests map[ids.ID]*atomic.Requests // may be nil
 }
 
-func (*standardTxExecutor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
-	return ErrWrongTxType
-}
-
-func (*standardTxExecutor) RewardValidatorTx(*txs.RewardValidatorTx) error {
-	return ErrWrongTxType
-}
-
 func (e *standardTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	if tx.Validator.NodeID == ids.EmptyNodeID {
 		return errEmptyNodeID
@@ -128,7 +140,7 @@ func (e *standardTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 		return err
 	}
 
-	if err := e.putStaker(tx); err != nil {
+	if err := e.putStaker(e.tx.ID(), tx); err != nil {
 		return err
 	}
 
@@ -158,7 +170,7 @@ func (e *standardTxExecutor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx)
 		return err
 	}
 
-	if err := e.putStaker(tx); err != nil {
+	if err := e.putStaker(e.tx.ID(), tx); err != nil {
 		return err
 	}
 
@@ -179,7 +191,7 @@ func (e *standardTxExecutor) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
 		return err
 	}
 
-	if err := e.putStaker(tx); err != nil {
+	if err := e.putStaker(e.tx.ID(), tx); err != nil {
 		return err
 	}
 
@@ -202,7 +214,8 @@ func (e *standardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 		return err
 	}
 
-	baseTxCreds, err := verifyPoASubnetAuthorization(e.backend.Fx, e.state, e.tx, tx.SubnetID, tx.SubnetAuth)
+	c := newChecker(e.backend)
+	baseTxCreds, err := verifyPoASubnetAuthorization(c, e.backend.Fx, e.state, e.tx, tx.SubnetID, tx.SubnetAuth)
 	if err != nil {
 		return err
 	}
@@ -213,7 +226,7 @@ func (e *standardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 		return err
 	}
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -222,6 +235,11 @@ func (e *standardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 			e.backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
+		if err := c.fail(err); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
 		return err
 	}
 
@@ -262,7 +280,7 @@ func (e *standardTxExecutor) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
 		return err
 	}
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -346,7 +364,7 @@ func (e *standardTxExecutor) ImportTx(tx *txs.ImportTx) error {
 			return err
 		}
 		if err := e.backend.FlowChecker.VerifySpendUTXOs(
-			tx,
+			withHeight(e.backend, tx),
 			utxos,
 			ins,
 			tx.Outs,
@@ -406,7 +424,7 @@ func (e *standardTxExecutor) ExportTx(tx *txs.ExportTx) error {
 		return err
 	}
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		outs,
@@ -511,11 +529,12 @@ func (e *standardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 
 	// Note: math.MaxInt32 * time.Second < math.MaxInt64 - so this can never
 	// overflow.
-	if time.Duration(tx.MaxStakeDuration)*time.Second > e.backend.Config.MaxStakeDuration {
+	if time.Duration(tx.MaxStakeDuration)*time.Second > e.state.GetStakingParams().MaxStakeDuration {
 		return errMaxStakeDurationTooLarge
 	}
 
-	baseTxCreds, err := verifyPoASubnetAuthorization(e.backend.Fx, e.state, e.tx, tx.Subnet, tx.SubnetAuth)
+	c := newChecker(e.backend)
+	baseTxCreds, err := verifyPoASubnetAuthorization(c, e.backend.Fx, e.state, e.tx, tx.Subnet, tx.SubnetAuth)
 	if err != nil {
 		return err
 	}
@@ -527,7 +546,7 @@ func (e *standardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 	}
 	totalRewardAmount := tx.MaximumSupply - tx.InitialSupply
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -540,6 +559,11 @@ func (e *standardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 			tx.AssetID:                totalRewardAmount,
 		},
 	); err != nil {
+		if err := c.fail(err); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
 		return err
 	}
 
@@ -566,7 +590,7 @@ func (e *standardTxExecutor) AddPermissionlessValidatorTx(tx *txs.AddPermissionl
 		return err
 	}
 
-	if err := e.putStaker(tx); err != nil {
+	if err := e.putStaker(e.tx.ID(), tx); err != nil {
 		return err
 	}
 
@@ -599,7 +623,7 @@ func (e *standardTxExecutor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionl
 		return err
 	}
 
-	if err := e.putStaker(tx); err != nil {
+	if err := e.putStaker(e.tx.ID(), tx); err != nil {
 		return err
 	}
 
@@ -657,7 +681,7 @@ func (e *standardTxExecutor) BaseTx(tx *txs.BaseTx) error {
 		return err
 	}
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -694,7 +718,8 @@ func (e *standardTxExecutor) ConvertSubnetToL1Tx(tx *txs.ConvertSubnetToL1Tx) er
 		return err
 	}
 
-	baseTxCreds, err := verifyPoASubnetAuthorization(e.backend.Fx, e.state, e.tx, tx.Subnet, tx.SubnetAuth)
+	c := newChecker(e.backend)
+	baseTxCreds, err := verifyPoASubnetAuthorization(c, e.backend.Fx, e.state, e.tx, tx.Subnet, tx.SubnetAuth)
 	if err != nil {
 		return err
 	}
@@ -770,7 +795,7 @@ func (e *standardTxExecutor) ConvertSubnetToL1Tx(tx *txs.ConvertSubnetToL1Tx) er
 		}
 	}
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -779,6 +804,11 @@ func (e *standardTxExecutor) ConvertSubnetToL1Tx(tx *txs.ConvertSubnetToL1Tx) er
 			e.backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
+		if err := c.fail(err); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
 		return err
 	}
 
@@ -833,7 +863,7 @@ func (e *standardTxExecutor) RegisterL1ValidatorTx(tx *txs.RegisterL1ValidatorTx
 	}
 
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -981,7 +1011,7 @@ func (e *standardTxExecutor) SetL1ValidatorWeightTx(tx *txs.SetL1ValidatorWeight
 	}
 
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -1122,7 +1152,7 @@ func (e *standardTxExecutor) IncreaseL1ValidatorBalanceTx(tx *txs.IncreaseL1Vali
 	}
 
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -1192,7 +1222,9 @@ func (e *standardTxExecutor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx)
 		return err
 	}
 
+	c := newChecker(e.backend)
 	baseTxCreds, err := verifyAuthorization(
+		c,
 		e.backend.Fx,
 		e.tx,
 		&secp256k1fx.OutputOwners{
@@ -1212,7 +1244,7 @@ func (e *standardTxExecutor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx)
 	}
 
 	if err := e.backend.FlowChecker.VerifySpend(
-		tx,
+		withHeight(e.backend, tx),
 		e.state,
 		tx.Ins,
 		tx.Outs,
@@ -1221,6 +1253,11 @@ func (e *standardTxExecutor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx)
 			e.backend.Ctx.AVAXAssetID: fee,
 		},
 	); err != nil {
+		if err := c.fail(err); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
 		return err
 	}
 
@@ -1273,11 +1310,411 @@ func (e *standardTxExecutor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx)
 	return e.state.PutL1Validator(l1Validator)
 }
 
-// Creates the staker as defined in [stakerTx] and adds it to [e.State].
-func (e *standardTxExecutor) putStaker(stakerTx txs.Staker) error {
+func (e *standardTxExecutor) ExpiringTx(tx *txs.ExpiringTx) error {
+	currentTimestamp := e.state.GetTimestamp()
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(currentTimestamp) {
+		return errFortunaUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if expiry := tx.ExpiryTime(); currentTimestamp.After(expiry) {
+		return fmt.Errorf(
+			"%w: chain time %s is after expiry %s",
+			ErrTxExpired,
+			currentTimestamp,
+			expiry,
+		)
+	}
+
+	// The wrapped tx must pay the fee of the ExpiringTx, as the ExpiringTx is
+	// what is included into the block.
+	feeCalculator := e.feeCalculator
+	e.feeCalculator = &wrapperFeeCalculator{
+		calculator: feeCalculator,
+		tx:         tx,
+	}
+	defer func() {
+		e.feeCalculator = feeCalculator
+	}()
+	return tx.Tx.Visit(e)
+}
+
+func (e *standardTxExecutor) DependentTx(tx *txs.DependentTx) error {
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(e.state.GetTimestamp()) {
+		return errFortunaUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	_, txStatus, err := e.state.GetTx(tx.DependsOn)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%w: %s", ErrMissingDependency, tx.DependsOn)
+	}
+	if err != nil {
+		return err
+	}
+	if txStatus != status.Committed {
+		return fmt.Errorf(
+			"%w: %s has status %s",
+			ErrMissingDependency,
+			tx.DependsOn,
+			txStatus,
+		)
+	}
+
+	// The wrapped tx must pay the fee of the DependentTx, as the DependentTx
+	// is what is included into the block.
+	feeCalculator := e.feeCalculator
+	e.feeCalculator = &wrapperFeeCalculator{
+		calculator: feeCalculator,
+		tx:         tx,
+	}
+	defer func() {
+		e.feeCalculator = feeCalculator
+	}()
+	return tx.Tx.Visit(e)
+}
+
+func (e *standardTxExecutor) ClaimRewardsTx(tx *txs.ClaimRewardsTx) error {
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(e.state.GetTimestamp()) {
+		return errFortunaUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	claimAuth, ok := tx.ClaimAuth.(*secp256k1fx.Input)
+	if !ok {
+		return ErrInvalidClaimAuth
+	}
+
+	// The last credential authorizes the claim of every reward UTXO.
+	if len(e.tx.Creds) == 0 {
+		return errWrongNumberOfCredentials
+	}
+	var (
+		baseTxCredsLen = len(e.tx.Creds) - 1
+		baseTxCreds    = e.tx.Creds[:baseTxCredsLen]
+		claimCred      = e.tx.Creds[baseTxCredsLen]
+
+		numUTXOs = len(tx.Ins) + len(tx.RewardUTXOs)
+		utxos    = make([]*avax.UTXO, 0, numUTXOs)
+		ins      = make([]*avax.TransferableInput, 0, numUTXOs)
+		creds    = make([]verify.Verifiable, 0, numUTXOs)
+	)
+	for _, in := range tx.Ins {
+		utxo, err := e.state.GetUTXO(in.InputID())
+		if err != nil {
+			return fmt.Errorf(
+				"failed to read consumed UTXO %s due to: %w",
+				&in.UTXOID,
+				err,
+			)
+		}
+		utxos = append(utxos, utxo)
+	}
+	ins = append(ins, tx.Ins...)
+	creds = append(creds, baseTxCreds...)
+
+	// Reward UTXOs are verified as if they were consumed by inputs that are
+	// authorized by the claim authorization.
+	for _, utxoID := range tx.RewardUTXOs {
+		utxo, err := e.getRewardUTXO(utxoID)
+		if err != nil {
+			return err
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return fmt.Errorf("%w: %s has unexpected output type", ErrNotRewardUTXO, utxoID)
+		}
+
+		utxos = append(utxos, utxo)
+		ins = append(ins, &avax.TransferableInput{
+			UTXOID: *utxoID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt:   out.Amt,
+				Input: *claimAuth,
+			},
+		})
+		creds = append(creds, claimCred)
+	}
+
+	// Verify the flowcheck
+	fee, err := e.feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := e.backend.FlowChecker.VerifySpendUTXOs(
+		withHeight(e.backend, tx),
+		utxos,
+		ins,
+		tx.Outs,
+		creds,
+		map[ids.ID]uint64{
+			e.backend.Ctx.AVAXAssetID: fee,
+		},
+	); err != nil {
+		return err
+	}
+
+	// Consume the UTXOS
+	avax.Consume(e.state, ins)
+	// Produce the UTXOS
+	avax.Produce(e.state, e.tx.ID(), tx.Outs)
+	return nil
+}
+
+func (e *standardTxExecutor) SetSubnetValidatorWeightTx(tx *txs.SetSubnetValidatorWeightTx) error {
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(e.state.GetTimestamp()) {
+		return errFortunaUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	// Only the weight of current validators can be modified.
+	vdr, err := e.state.GetCurrentValidator(tx.Subnet, tx.NodeID)
+	if err != nil {
+		return fmt.Errorf(
+			"%s %w of %s: %w",
+			tx.NodeID,
+			ErrNotValidator,
+			tx.Subnet,
+			err,
+		)
+	}
+
+	if !vdr.Priority.IsPermissionedValidator() {
+		return ErrSetPermissionlessValidatorWeight
+	}
+
+	c := newChecker(e.backend)
+	baseTxCreds, err := verifyPoASubnetAuthorization(
+		c,
+		e.backend.Fx,
+		e.state,
+		e.tx,
+		tx.Subnet,
+		tx.SubnetAuth,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	fee, err := e.feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := e.backend.FlowChecker.VerifySpend(
+		withHeight(e.backend, tx),
+		e.state,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.backend.Ctx.AVAXAssetID: fee,
+		},
+	); err != nil {
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
+		return err
+	}
+
+	// Invariant: There are no permissioned subnet delegators whose weight
+	// would need to be considered.
+	newVdr := *vdr
+	newVdr.Weight = tx.Weight
+	if err := e.state.UpdateCurrentValidator(&newVdr); err != nil {
+		return err
+	}
+
+	txID := e.tx.ID()
+	avax.Consume(e.state, tx.Ins)
+	avax.Produce(e.state, txID, tx.Outs)
+	return nil
+}
+
+func (e *standardTxExecutor) AddContinuousValidatorTx(tx *txs.AddContinuousValidatorTx) error {
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(e.state.GetTimestamp()) {
+		return errFortunaUpgradeNotActive
+	}
+
+	// The fee must be paid for the AddContinuousValidatorTx rather than for
+	// the validator it describes. If this tx is wrapped, the fee is already
+	// calculated for the wrapping tx.
+	feeCalculator := e.feeCalculator
+	if _, ok := feeCalculator.(*wrapperFeeCalculator); !ok {
+		feeCalculator = &wrapperFeeCalculator{
+			calculator: feeCalculator,
+			tx:         tx,
+		}
+	}
+	if err := verifyAddPermissionlessValidatorTx(
+		e.backend,
+		feeCalculator,
+		e.state,
+		e.tx,
+		&tx.AddPermissionlessValidatorTx,
+	); err != nil {
+		return err
+	}
+
+	// Every renewed staking period must satisfy the same bounds as the first.
+	stakingParams := e.state.GetStakingParams()
+	switch {
+	case tx.Period < uint64(stakingParams.MinStakeDuration/time.Second):
+		return ErrStakeTooShort
+	case tx.Period > uint64(stakingParams.MaxStakeDuration/time.Second):
+		return ErrStakeTooLong
+	}
+
+	if err := e.putStaker(e.tx.ID(), tx); err != nil {
+		return err
+	}
+
+	txID := e.tx.ID()
+	avax.Consume(e.state, tx.Ins)
+	avax.Produce(e.state, txID, tx.Outs)
+	return nil
+}
+
+func (e *standardTxExecutor) StopContinuousValidatorTx(tx *txs.StopContinuousValidatorTx) error {
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(e.state.GetTimestamp()) {
+		return errFortunaUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	validatorTx, _, err := e.state.GetTx(tx.TxID)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrNotContinuousValidator, tx.TxID, err)
+	}
+	continuousValidatorTx, ok := txs.Unwrap(validatorTx.Unsigned).(*txs.AddContinuousValidatorTx)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotContinuousValidator, tx.TxID)
+	}
+
+	nodeID := continuousValidatorTx.NodeID()
+	vdr, err := e.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return fmt.Errorf("%s %w: %w", nodeID, ErrNotValidator, err)
+	}
+	if vdr.TxID != tx.TxID {
+		return fmt.Errorf("%s %w: added by %s", nodeID, ErrNotValidator, vdr.TxID)
+	}
+	if vdr.ContinuationPeriod == 0 {
+		return ErrContinuousValidatorStopped
+	}
+
+	c := newChecker(e.backend)
+	baseTxCreds, err := verifyAuthorization(
+		c,
+		e.backend.Fx,
+		e.tx,
+		continuousValidatorTx.ValidationRewardsOwner(),
+		tx.StopAuth,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	fee, err := e.feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := e.backend.FlowChecker.VerifySpend(
+		withHeight(e.backend, tx),
+		e.state,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.backend.Ctx.AVAXAssetID: fee,
+		},
+	); err != nil {
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
+		return err
+	}
+
+	// The validator leaves the validator set at the end of its current
+	// staking period.
+	stoppedVdr := *vdr
+	stoppedVdr.ContinuationPeriod = 0
+	if err := e.state.UpdateCurrentValidator(&stoppedVdr); err != nil {
+		return err
+	}
+
+	txID := e.tx.ID()
+	avax.Consume(e.state, tx.Ins)
+	avax.Produce(e.state, txID, tx.Outs)
+	return nil
+}
+
+// getRewardUTXO returns the UTXO referenced by [utxoID] if it is an unspent
+// reward UTXO.
+func (e *standardTxExecutor) getRewardUTXO(utxoID *avax.UTXOID) (*avax.UTXO, error) {
+	inputID := utxoID.InputID()
+	utxo, err := e.state.GetUTXO(inputID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to read reward UTXO %s due to: %w",
+			utxoID,
+			err,
+		)
+	}
+
+	rewardUTXOs, err := e.state.GetRewardUTXOs(utxoID.TxID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rewardUTXO := range rewardUTXOs {
+		if rewardUTXO.InputID() == inputID {
+			return utxo, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNotRewardUTXO, utxoID)
+}
+
+// Creates the staker [stakerID] as defined in [stakerTx] and adds it to
+// [e.State].
+func (e *standardTxExecutor) putStaker(stakerID ids.ID, stakerTx txs.Staker) error {
 	var (
 		chainTime = e.state.GetTimestamp()
-		txID      = e.tx.ID()
 		staker    *state.Staker
 		err       error
 	)
@@ -1290,7 +1727,7 @@ func (e *standardTxExecutor) putStaker(stakerTx txs.Staker) error {
 		if !ok {
 			return fmt.Errorf("%w: %T", errMissingStartTimePreDurango, stakerTx)
 		}
-		staker, err = state.NewPendingStaker(txID, scheduledStakerTx)
+		staker, err = state.NewPendingStaker(stakerID, scheduledStakerTx)
 	} else {
 		// Only calculate the potentialReward for permissionless stakers.
 		// Recall that we only need to check if this is a permissioned
@@ -1320,7 +1757,7 @@ func (e *standardTxExecutor) putStaker(stakerTx txs.Staker) error {
 			e.state.SetCurrentSupply(subnetID, currentSupply+potentialReward)
 		}
 
-		staker, err = state.NewCurrentStaker(txID, stakerTx, chainTime, potentialReward)
+		staker, err = state.NewCurrentStaker(stakerID, stakerTx, chainTime, potentialReward)
 	}
 	if err != nil {
 		return err
@@ -1365,3 +1802,194 @@ func verifyL1Conversion(
 	}
 	return nil
 }
+
+// wrapperFeeCalculator charges the fee of [tx] regardless of the transaction
+// that the fee is requested for.
+type wrapperFeeCalculator struct {
+	calculator fee.Calculator
+	tx         txs.UnsignedTx
+}
+
+func (c *wrapperFeeCalculator) CalculateFee(txs.UnsignedTx) (uint64, error) {
+	return c.calculator.CalculateFee(c.tx)
+}
+
+// Verifies a [*txs.AddMultiDelegatorTx] and, if it passes, executes it on
+// [e.State]. For verification rules, see [verifyAddMultiDelegatorTx]. Each
+// delegation is added as an independent delegator, identified by
+// [txs.DelegationID].
+func (e *standardTxExecutor) AddMultiDelegatorTx(tx *txs.AddMultiDelegatorTx) error {
+	if err := verifyAddMultiDelegatorTx(
+		e.backend,
+		e.feeCalculator,
+		e.state,
+		e.tx,
+		tx,
+	); err != nil {
+		return err
+	}
+
+	txID := e.tx.ID()
+	for i, delegator := range tx.Delegators() {
+		if err := e.putStaker(txs.DelegationID(txID, i), delegator); err != nil {
+			return err
+		}
+	}
+
+	avax.Consume(e.state, tx.Ins)
+	avax.Produce(e.state, txID, tx.Outs)
+	return nil
+}
+
+func (e *standardTxExecutor) SetWithdrawalOwnerTx(tx *txs.SetWithdrawalOwnerTx) error {
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(e.state.GetTimestamp()) {
+		return errFortunaUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	validatorTx, _, err := e.state.GetTx(tx.TxID)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrNotRewardedValidator, tx.TxID, err)
+	}
+	uValidatorTx, ok := txs.Unwrap(validatorTx.Unsigned).(txs.ValidatorTx)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotRewardedValidator, tx.TxID)
+	}
+
+	// Only current validators can bind a withdrawal owner, so the owner can't
+	// be changed after the validator has been paid out.
+	nodeID := uValidatorTx.NodeID()
+	vdr, err := e.state.GetCurrentValidator(uValidatorTx.SubnetID(), nodeID)
+	if err != nil {
+		return fmt.Errorf("%s %w: %w", nodeID, ErrNotValidator, err)
+	}
+	if vdr.TxID != tx.TxID {
+		return fmt.Errorf("%s %w: added by %s", nodeID, ErrNotValidator, vdr.TxID)
+	}
+
+	c := newChecker(e.backend)
+	baseTxCreds, err := verifyAuthorization(
+		c,
+		e.backend.Fx,
+		e.tx,
+		uValidatorTx.ValidationRewardsOwner(),
+		tx.OwnerAuth,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	fee, err := e.feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := e.backend.FlowChecker.VerifySpend(
+		withHeight(e.backend, tx),
+		e.state,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.backend.Ctx.AVAXAssetID: fee,
+		},
+	); err != nil {
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
+		return err
+	}
+
+	e.state.SetWithdrawalOwner(tx.TxID, tx.WithdrawalOwner)
+
+	txID := e.tx.ID()
+	avax.Consume(e.state, tx.Ins)
+	avax.Produce(e.state, txID, tx.Outs)
+	return nil
+}
+
+func (e *standardTxExecutor) RetireChainTx(tx *txs.RetireChainTx) error {
+	if !e.backend.Config.UpgradeConfig.IsFortunaActivated(e.state.GetTimestamp()) {
+		return errFortunaUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	chainTx, _, err := e.state.GetTx(tx.ChainID)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrNotChain, tx.ChainID, err)
+	}
+	createChainTx, ok := chainTx.Unsigned.(*txs.CreateChainTx)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotChain, tx.ChainID)
+	}
+	if createChainTx.SubnetID == constants.PrimaryNetworkID {
+		return txs.ErrRetirePrimaryNetworkChain
+	}
+
+	isRetired, err := e.state.IsChainRetired(tx.ChainID)
+	if err != nil {
+		return err
+	}
+	if isRetired {
+		return fmt.Errorf("%w: %s", ErrChainRetired, tx.ChainID)
+	}
+
+	c := newChecker(e.backend)
+	baseTxCreds, err := verifyPoASubnetAuthorization(c, e.backend.Fx, e.state, e.tx, createChainTx.SubnetID, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	fee, err := e.feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+	if err := e.backend.FlowChecker.VerifySpend(
+		withHeight(e.backend, tx),
+		e.state,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.backend.Ctx.AVAXAssetID: fee,
+		},
+	); err != nil {
+		if err := c.fail(fmt.Errorf("%w: %w", ErrFlowCheckFailed, err)); err != nil {
+			return err
+		}
+	}
+	if err := c.err(); err != nil {
+		return err
+	}
+
+	e.state.RetireChain(tx.ChainID)
+
+	txID := e.tx.ID()
+	avax.Consume(e.state, tx.Ins)
+	avax.Produce(e.state, txID, tx.Outs)
+
+	// If this tx is accepted and this node is a member of the subnet that
+	// validates the blockchain, stop the blockchain
+	e.onAccept = func() {
+		e.backend.Config.RetireChain(tx.ChainID, createChainTx)
+	}
+	return nil
+}
@@ -1747,7 +1747,7 @@ func TestStandardExecutorRemoveSubnetValidatorTx(t *testing.T) {
 				env.state.EXPECT().GetSubnetOwner(env.unsignedTx.Subnet).Return(subnetOwner, nil).Times(1)
 				env.fx.EXPECT().VerifyPermission(env.unsignedTx, env.unsignedTx.SubnetAuth, env.tx.Creds[len(env.tx.Creds)-1], subnetOwner).Return(nil).Times(1)
 				env.flowChecker.EXPECT().VerifySpend(
-					env.unsignedTx, env.state, env.unsignedTx.Ins, env.unsignedTx.Outs, env.tx.Creds[:len(env.tx.Creds)-1], gomock.Any(),
+					&heightTx{UnsignedTx: env.unsignedTx}, env.state, env.unsignedTx.Ins, env.unsignedTx.Outs, env.tx.Creds[:len(env.tx.Creds)-1], gomock.Any(),
 				).Return(nil).Times(1)
 				env.state.EXPECT().DeleteCurrentValidator(env.staker)
 				env.state.EXPECT().DeleteUTXO(gomock.Any()).Times(len(env.unsignedTx.Ins))
@@ -2167,6 +2167,7 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				env.unsignedTx.MaxStakeDuration = math.MaxUint32
 				env.state = state.NewMockDiff(ctrl)
 				env.state.EXPECT().GetTimestamp().Return(env.latestForkTime).AnyTimes()
+				env.state.EXPECT().GetStakingParams().Return(state.StakingParams{}).AnyTimes()
 
 				cfg := &config.Internal{
 					UpgradeConfig: upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
@@ -2197,11 +2198,13 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				env.state = state.NewMockDiff(ctrl)
 				subnetOwner := fxmock.NewOwner(ctrl)
 				env.state.EXPECT().GetTimestamp().Return(env.latestForkTime).AnyTimes()
+				env.state.EXPECT().GetStakingParams().Return(state.StakingParams{
+					MaxStakeDuration: math.MaxInt64,
+				}).AnyTimes()
 				env.state.EXPECT().GetSubnetOwner(env.unsignedTx.Subnet).Return(subnetOwner, nil).AnyTimes()
 
 				cfg := &config.Internal{
-					UpgradeConfig:    upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
-					MaxStakeDuration: math.MaxInt64,
+					UpgradeConfig: upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
 				}
 
 				feeCalculator := state.PickFeeCalculator(cfg, env.state)
@@ -2228,6 +2231,9 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				env.state = state.NewMockDiff(ctrl)
 				subnetOwner := fxmock.NewOwner(ctrl)
 				env.state.EXPECT().GetTimestamp().Return(env.latestForkTime).AnyTimes()
+				env.state.EXPECT().GetStakingParams().Return(state.StakingParams{
+					MaxStakeDuration: math.MaxInt64,
+				}).AnyTimes()
 				env.state.EXPECT().GetSubnetOwner(env.unsignedTx.Subnet).Return(subnetOwner, nil)
 				env.state.EXPECT().GetSubnetToL1Conversion(env.unsignedTx.Subnet).Return(
 					state.SubnetToL1Conversion{},
@@ -2240,8 +2246,7 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				).Return(ErrFlowCheckFailed)
 
 				cfg := &config.Internal{
-					UpgradeConfig:    upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
-					MaxStakeDuration: math.MaxInt64,
+					UpgradeConfig: upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
 				}
 
 				feeCalculator := state.PickFeeCalculator(cfg, env.state)
@@ -2269,6 +2274,9 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				// Set dependency expectations.
 				subnetOwner := fxmock.NewOwner(ctrl)
 				env.state.EXPECT().GetTimestamp().Return(env.latestForkTime).AnyTimes()
+				env.state.EXPECT().GetStakingParams().Return(state.StakingParams{
+					MaxStakeDuration: math.MaxInt64,
+				}).AnyTimes()
 				env.state.EXPECT().GetSubnetOwner(env.unsignedTx.Subnet).Return(subnetOwner, nil).Times(1)
 				env.state.EXPECT().GetSubnetToL1Conversion(env.unsignedTx.Subnet).Return(
 					state.SubnetToL1Conversion{
@@ -2282,8 +2290,7 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				env.fx.EXPECT().VerifyPermission(env.unsignedTx, env.unsignedTx.SubnetAuth, env.tx.Creds[len(env.tx.Creds)-1], subnetOwner).Return(nil).Times(1)
 
 				cfg := &config.Internal{
-					UpgradeConfig:    upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
-					MaxStakeDuration: math.MaxInt64,
+					UpgradeConfig: upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
 				}
 				feeCalculator := state.PickFeeCalculator(cfg, env.state)
 				e := &standardTxExecutor{
@@ -2310,6 +2317,9 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				// Set dependency expectations.
 				subnetOwner := fxmock.NewOwner(ctrl)
 				env.state.EXPECT().GetTimestamp().Return(env.latestForkTime).AnyTimes()
+				env.state.EXPECT().GetStakingParams().Return(state.StakingParams{
+					MaxStakeDuration: math.MaxInt64,
+				}).AnyTimes()
 				env.state.EXPECT().GetSubnetOwner(env.unsignedTx.Subnet).Return(subnetOwner, nil).Times(1)
 				env.state.EXPECT().GetSubnetToL1Conversion(env.unsignedTx.Subnet).Return(
 					state.SubnetToL1Conversion{},
@@ -2318,7 +2328,7 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				env.state.EXPECT().GetSubnetTransformation(env.unsignedTx.Subnet).Return(nil, database.ErrNotFound).Times(1)
 				env.fx.EXPECT().VerifyPermission(env.unsignedTx, env.unsignedTx.SubnetAuth, env.tx.Creds[len(env.tx.Creds)-1], subnetOwner).Return(nil).Times(1)
 				env.flowChecker.EXPECT().VerifySpend(
-					env.unsignedTx, env.state, env.unsignedTx.Ins, env.unsignedTx.Outs, env.tx.Creds[:len(env.tx.Creds)-1], gomock.Any(),
+					&heightTx{UnsignedTx: env.unsignedTx}, env.state, env.unsignedTx.Ins, env.unsignedTx.Outs, env.tx.Creds[:len(env.tx.Creds)-1], gomock.Any(),
 				).Return(nil).Times(1)
 				env.state.EXPECT().AddSubnetTransformation(env.tx)
 				env.state.EXPECT().SetCurrentSupply(env.unsignedTx.Subnet, env.unsignedTx.InitialSupply)
@@ -2326,8 +2336,7 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				env.state.EXPECT().AddUTXO(gomock.Any()).Times(len(env.unsignedTx.Outs))
 
 				cfg := &config.Internal{
-					UpgradeConfig:    upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
-					MaxStakeDuration: math.MaxInt64,
+					UpgradeConfig: upgradetest.GetConfigWithUpgradeTime(upgradetest.Durango, env.latestForkTime),
 				}
 
 				feeCalculator := state.PickFeeCalculator(cfg, env.state)
@@ -4300,3 +4309,1563 @@ func must[T any](t require.TestingT) func(T, error) T {
 		return val
 	}
 }
+
+func TestStandardExecutorExpiringTx(t *testing.T) {
+	var (
+		fx = &secp256k1fx.Fx{}
+		vm = &secp256k1fx.TestVM{
+			Log: logging.NoLog{},
+		}
+	)
+	require.NoError(t, fx.InitializeVM(vm))
+	require.NoError(t, fx.Bootstrapped())
+
+	tests := []struct {
+		name        string
+		fork        upgradetest.Fork
+		expiry      time.Duration // relative to the chain time
+		expectedErr error
+	}{
+		{
+			name:        "pre-Fortuna",
+			fork:        upgradetest.Etna,
+			expiry:      time.Minute,
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name:        "expired",
+			fork:        upgradetest.Fortuna,
+			expiry:      -time.Second,
+			expectedErr: ErrTxExpired,
+		},
+		{
+			name:   "expires at chain time",
+			fork:   upgradetest.Fortuna,
+			expiry: 0,
+		},
+		{
+			name:   "not expired",
+			fork:   upgradetest.Fortuna,
+			expiry: time.Minute,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var (
+				ctx    = snowtest.Context(t, constants.PlatformChainID)
+				config = &config.Internal{
+					DynamicFeeConfig:   genesis.LocalParams.DynamicFeeConfig,
+					ValidatorFeeConfig: genesis.LocalParams.ValidatorFeeConfig,
+					UpgradeConfig:      upgradetest.GetConfig(test.fork),
+				}
+				baseState = statetest.New(t, statetest.Config{
+					Upgrades: config.UpgradeConfig,
+					Context:  ctx,
+				})
+				wallet = txstest.NewWallet(
+					t,
+					ctx,
+					config,
+					baseState,
+					secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+					nil, // subnetIDs
+					nil, // validationIDs
+					nil, // chainIDs
+				)
+				backend = &Backend{
+					Config:       config,
+					Bootstrapped: utils.NewAtomic(true),
+					Fx:           fx,
+					FlowChecker:  utxo.NewVerifier(ctx, &vm.Clk, fx),
+					Ctx:          ctx,
+				}
+				feeCalculator = state.PickFeeCalculator(config, baseState)
+				expiry        = baseState.GetTimestamp().Add(test.expiry)
+			)
+
+			tx, err := wallet.IssueBaseTx(
+				nil, // outputs
+				common.WithExpiry(expiry),
+			)
+			require.NoError(err)
+
+			expiringTx, ok := tx.Unsigned.(*txs.ExpiringTx)
+			require.True(ok)
+			require.Equal(uint64(expiry.Unix()), expiringTx.Expiry)
+
+			diff, err := state.NewDiffOn(baseState)
+			require.NoError(err)
+
+			_, _, _, err = StandardTx(
+				backend,
+				feeCalculator,
+				tx,
+				diff,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			// The fee of the wrapper must have been burned.
+			baseTx, ok := expiringTx.Tx.(*txs.BaseTx)
+			require.True(ok)
+			fee, err := feeCalculator.CalculateFee(expiringTx)
+			require.NoError(err)
+
+			var consumed, produced uint64
+			for _, in := range baseTx.Ins {
+				consumed += in.Input().Amount()
+			}
+			for _, out := range baseTx.Outs {
+				produced += out.Output().Amount()
+			}
+			require.Equal(fee, consumed-produced)
+		})
+	}
+}
+
+func TestStandardExecutorDependentTx(t *testing.T) {
+	var (
+		fx = &secp256k1fx.Fx{}
+		vm = &secp256k1fx.TestVM{
+			Log: logging.NoLog{},
+		}
+	)
+	require.NoError(t, fx.InitializeVM(vm))
+	require.NoError(t, fx.Bootstrapped())
+
+	tests := []struct {
+		name           string
+		fork           upgradetest.Fork
+		dependencySeen bool
+		expectedErr    error
+	}{
+		{
+			name:           "pre-Fortuna",
+			fork:           upgradetest.Etna,
+			dependencySeen: true,
+			expectedErr:    errFortunaUpgradeNotActive,
+		},
+		{
+			name:           "missing dependency",
+			fork:           upgradetest.Fortuna,
+			dependencySeen: false,
+			expectedErr:    ErrMissingDependency,
+		},
+		{
+			name:           "accepted dependency",
+			fork:           upgradetest.Fortuna,
+			dependencySeen: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var (
+				ctx    = snowtest.Context(t, constants.PlatformChainID)
+				config = &config.Internal{
+					DynamicFeeConfig:   genesis.LocalParams.DynamicFeeConfig,
+					ValidatorFeeConfig: genesis.LocalParams.ValidatorFeeConfig,
+					UpgradeConfig:      upgradetest.GetConfig(test.fork),
+				}
+				baseState = statetest.New(t, statetest.Config{
+					Upgrades: config.UpgradeConfig,
+					Context:  ctx,
+				})
+				wallet = txstest.NewWallet(
+					t,
+					ctx,
+					config,
+					baseState,
+					secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+					nil, // subnetIDs
+					nil, // validationIDs
+					nil, // chainIDs
+				)
+				backend = &Backend{
+					Config:       config,
+					Bootstrapped: utils.NewAtomic(true),
+					Fx:           fx,
+					FlowChecker:  utxo.NewVerifier(ctx, &vm.Clk, fx),
+					Ctx:          ctx,
+				}
+				feeCalculator = state.PickFeeCalculator(config, baseState)
+			)
+
+			// The dependency is issued with a different key so that the two
+			// txs do not conflict.
+			dependency, err := wallet.IssueBaseTx(
+				nil, // outputs
+				common.WithCustomAddresses(set.Of(
+					genesistest.DefaultFundedKeys[0].Address(),
+				)),
+			)
+			require.NoError(err)
+
+			tx, err := wallet.IssueBaseTx(
+				nil, // outputs
+				common.WithCustomAddresses(set.Of(
+					genesistest.DefaultFundedKeys[1].Address(),
+				)),
+				common.WithDependency(dependency.ID()),
+			)
+			require.NoError(err)
+
+			dependentTx, ok := tx.Unsigned.(*txs.DependentTx)
+			require.True(ok)
+			require.Equal(dependency.ID(), dependentTx.DependsOn)
+
+			diff, err := state.NewDiffOn(baseState)
+			require.NoError(err)
+			if test.dependencySeen {
+				diff.AddTx(dependency, status.Committed)
+			}
+
+			_, _, _, err = StandardTx(
+				backend,
+				feeCalculator,
+				tx,
+				diff,
+			)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestStandardExecutorClaimRewardsTx(t *testing.T) {
+	var (
+		fx = &secp256k1fx.Fx{}
+		vm = &secp256k1fx.TestVM{
+			Log: logging.NoLog{},
+		}
+	)
+	require.NoError(t, fx.InitializeVM(vm))
+	require.NoError(t, fx.Bootstrapped())
+
+	tests := []struct {
+		name         string
+		fork         upgradetest.Fork
+		isReward     bool
+		alreadySpent bool
+		expectedErr  error
+	}{
+		{
+			name:        "pre-Fortuna",
+			fork:        upgradetest.Etna,
+			isReward:    true,
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name:        "not a reward UTXO",
+			fork:        upgradetest.Fortuna,
+			isReward:    false,
+			expectedErr: ErrNotRewardUTXO,
+		},
+		{
+			name:         "reward UTXO already spent",
+			fork:         upgradetest.Fortuna,
+			isReward:     true,
+			alreadySpent: true,
+			expectedErr:  database.ErrNotFound,
+		},
+		{
+			name:     "valid claim",
+			fork:     upgradetest.Fortuna,
+			isReward: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var (
+				ctx    = snowtest.Context(t, constants.PlatformChainID)
+				config = &config.Internal{
+					DynamicFeeConfig:   genesis.LocalParams.DynamicFeeConfig,
+					ValidatorFeeConfig: genesis.LocalParams.ValidatorFeeConfig,
+					UpgradeConfig:      upgradetest.GetConfig(test.fork),
+				}
+				baseState = statetest.New(t, statetest.Config{
+					Upgrades: config.UpgradeConfig,
+					Context:  ctx,
+				})
+				key   = genesistest.DefaultFundedKeys[0]
+				owner = secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						key.Address(),
+					},
+				}
+				stakerTxID = ids.GenerateTestID()
+				rewardUTXO = &avax.UTXO{
+					UTXOID: avax.UTXOID{
+						TxID:        stakerTxID,
+						OutputIndex: 1,
+					},
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          units.Avax,
+						OutputOwners: owner,
+					},
+				}
+			)
+
+			baseState.AddUTXO(rewardUTXO)
+			if test.isReward {
+				baseState.AddRewardUTXO(stakerTxID, rewardUTXO)
+			}
+			require.NoError(baseState.Commit())
+
+			var (
+				wallet = txstest.NewWallet(
+					t,
+					ctx,
+					config,
+					baseState,
+					secp256k1fx.NewKeychain(key),
+					nil, // subnetIDs
+					nil, // validationIDs
+					nil, // chainIDs
+				)
+				backend = &Backend{
+					Config:       config,
+					Bootstrapped: utils.NewAtomic(true),
+					Fx:           fx,
+					FlowChecker:  utxo.NewVerifier(ctx, &vm.Clk, fx),
+					Ctx:          ctx,
+				}
+				feeCalculator = state.PickFeeCalculator(config, baseState)
+			)
+
+			tx, err := wallet.IssueClaimRewardsTx(
+				[]ids.ID{rewardUTXO.InputID()},
+				&owner,
+			)
+			require.NoError(err)
+
+			claimRewardsTx, ok := tx.Unsigned.(*txs.ClaimRewardsTx)
+			require.True(ok)
+			require.Equal([]*avax.UTXOID{&rewardUTXO.UTXOID}, claimRewardsTx.RewardUTXOs)
+
+			diff, err := state.NewDiffOn(baseState)
+			require.NoError(err)
+			if test.alreadySpent {
+				diff.DeleteUTXO(rewardUTXO.InputID())
+			}
+
+			_, _, _, err = StandardTx(
+				backend,
+				feeCalculator,
+				tx,
+				diff,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			_, err = diff.GetUTXO(rewardUTXO.InputID())
+			require.ErrorIs(err, database.ErrNotFound)
+
+			for _, utxo := range tx.UTXOs() {
+				_, err := diff.GetUTXO(utxo.InputID())
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestStandardExecutorSetSubnetValidatorWeightTx(t *testing.T) {
+	var (
+		fx = &secp256k1fx.Fx{}
+		vm = &secp256k1fx.TestVM{
+			Log: logging.NoLog{},
+		}
+	)
+	require.NoError(t, fx.InitializeVM(vm))
+
+	var (
+		ctx           = snowtest.Context(t, constants.PlatformChainID)
+		defaultConfig = &config.Internal{
+			DynamicFeeConfig:   genesis.LocalParams.DynamicFeeConfig,
+			ValidatorFeeConfig: genesis.LocalParams.ValidatorFeeConfig,
+			UpgradeConfig:      upgradetest.GetConfig(upgradetest.Latest),
+		}
+		baseState = statetest.New(t, statetest.Config{
+			Upgrades: defaultConfig.UpgradeConfig,
+			Context:  ctx,
+		})
+		wallet = txstest.NewWallet(
+			t,
+			ctx,
+			defaultConfig,
+			baseState,
+			secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+			nil, // subnetIDs
+			nil, // validationIDs
+			nil, // chainIDs
+		)
+		flowChecker = utxo.NewVerifier(
+			ctx,
+			&vm.Clk,
+			fx,
+		)
+	)
+
+	// Create the subnet
+	createSubnetTx, err := wallet.IssueCreateSubnetTx(
+		&secp256k1fx.OutputOwners{},
+	)
+	require.NoError(t, err)
+
+	diff, err := state.NewDiffOn(baseState)
+	require.NoError(t, err)
+
+	_, _, _, err = StandardTx(
+		&Backend{
+			Config:       defaultConfig,
+			Bootstrapped: utils.NewAtomic(true),
+			Fx:           fx,
+			FlowChecker:  flowChecker,
+			Ctx:          ctx,
+		},
+		state.PickFeeCalculator(defaultConfig, baseState),
+		createSubnetTx,
+		diff,
+	)
+	require.NoError(t, err)
+	require.NoError(t, diff.Apply(baseState))
+
+	// Add a permissioned validator to the subnet
+	subnetID := createSubnetTx.ID()
+	staker := &state.Staker{
+		TxID:      ids.GenerateTestID(),
+		NodeID:    genesistest.DefaultNodeIDs[0],
+		SubnetID:  subnetID,
+		Weight:    1,
+		StartTime: genesistest.DefaultValidatorStartTime,
+		EndTime:   genesistest.DefaultValidatorEndTime,
+		NextTime:  genesistest.DefaultValidatorEndTime,
+		Priority:  txs.SubnetPermissionedValidatorCurrentPriority,
+	}
+	require.NoError(t, baseState.PutCurrentValidator(staker))
+
+	permissionlessStaker := *staker
+	permissionlessStaker.TxID = ids.GenerateTestID()
+	permissionlessStaker.NodeID = genesistest.DefaultNodeIDs[1]
+	permissionlessStaker.Priority = txs.SubnetPermissionlessValidatorCurrentPriority
+	require.NoError(t, baseState.PutCurrentValidator(&permissionlessStaker))
+	require.NoError(t, baseState.Commit())
+
+	const newWeight = 5
+	tests := []struct {
+		name           string
+		nodeID         ids.NodeID
+		builderOptions []common.Option
+		updateExecutor func(executor *standardTxExecutor) error
+		expectedErr    error
+	}{
+		{
+			name: "invalid prior to Fortuna",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Etna),
+				}
+				return nil
+			},
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name: "tx fails syntactic verification",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Ctx = snowtest.Context(t, ids.GenerateTestID())
+				return nil
+			},
+			expectedErr: avax.ErrWrongChainID,
+		},
+		{
+			name: "invalid memo length",
+			builderOptions: []common.Option{
+				common.WithMemo([]byte("memo!")),
+			},
+			expectedErr: avax.ErrMemoTooLarge,
+		},
+		{
+			name: "not a validator",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.state.DeleteCurrentValidator(staker)
+				return nil
+			},
+			expectedErr: ErrNotValidator,
+		},
+		{
+			name:        "permissionless validator",
+			nodeID:      permissionlessStaker.NodeID,
+			expectedErr: ErrSetPermissionlessValidatorWeight,
+		},
+		{
+			name: "fail subnet authorization",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.state.SetSubnetOwner(subnetID, &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						ids.GenerateTestShortID(),
+					},
+				})
+				return nil
+			},
+			expectedErr: errUnauthorizedModification,
+		},
+		{
+			name: "insufficient fee",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.feeCalculator = txfee.NewDynamicCalculator(
+					e.backend.Config.DynamicFeeConfig.Weights,
+					100*genesis.LocalParams.DynamicFeeConfig.MinPrice,
+				)
+				return nil
+			},
+			expectedErr: utxo.ErrInsufficientUnlockedFunds,
+		},
+		{
+			name: "valid tx",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			nodeID := staker.NodeID
+			if test.nodeID != ids.EmptyNodeID {
+				nodeID = test.nodeID
+			}
+
+			wallet := txstest.NewWallet(
+				t,
+				ctx,
+				defaultConfig,
+				baseState,
+				secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+				[]ids.ID{subnetID},
+				nil, // validationIDs
+				nil, // chainIDs
+			)
+			setWeightTx, err := wallet.IssueSetSubnetValidatorWeightTx(
+				nodeID,
+				subnetID,
+				newWeight,
+				test.builderOptions...,
+			)
+			require.NoError(err)
+
+			diff, err := state.NewDiffOn(baseState)
+			require.NoError(err)
+
+			executor := &standardTxExecutor{
+				backend: &Backend{
+					Config:       defaultConfig,
+					Bootstrapped: utils.NewAtomic(true),
+					Fx:           fx,
+					FlowChecker:  flowChecker,
+					Ctx:          ctx,
+				},
+				feeCalculator: state.PickFeeCalculator(defaultConfig, baseState),
+				tx:            setWeightTx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = setWeightTx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			for utxoID := range setWeightTx.InputIDs() {
+				_, err := diff.GetUTXO(utxoID)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			for _, expectedUTXO := range setWeightTx.UTXOs() {
+				utxoID := expectedUTXO.InputID()
+				utxo, err := diff.GetUTXO(utxoID)
+				require.NoError(err)
+				require.Equal(expectedUTXO, utxo)
+			}
+
+			expectedStaker := *staker
+			expectedStaker.Weight = newWeight
+
+			updatedStaker, err := diff.GetCurrentValidator(subnetID, staker.NodeID)
+			require.NoError(err)
+			require.Equal(&expectedStaker, updatedStaker)
+		})
+	}
+}
+
+func TestStandardExecutorAddContinuousValidatorTx(t *testing.T) {
+	tests := []struct {
+		name           string
+		period         time.Duration
+		updateExecutor func(executor *standardTxExecutor) error
+		expectedErr    error
+	}{
+		{
+			name:   "invalid prior to Fortuna",
+			period: defaultMinStakingDuration,
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Etna),
+				}
+				return nil
+			},
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name:        "period too short",
+			period:      defaultMinStakingDuration - time.Second,
+			expectedErr: ErrStakeTooShort,
+		},
+		{
+			name:        "period too long",
+			period:      defaultMaxStakingDuration + time.Second,
+			expectedErr: ErrStakeTooLong,
+		},
+		{
+			name:   "valid tx",
+			period: defaultMinStakingDuration,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			env := newEnvironment(t, upgradetest.Fortuna)
+			env.ctx.Lock.Lock()
+			defer env.ctx.Lock.Unlock()
+
+			sk, err := localsigner.New()
+			require.NoError(err)
+			pop, err := signer.NewProofOfPossession(sk)
+			require.NoError(err)
+
+			var (
+				nodeID  = ids.GenerateTestNodeID()
+				endTime = env.state.GetTimestamp().Add(defaultMinStakingDuration)
+				owner   = &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs: []ids.ShortID{
+						genesistest.DefaultFundedKeys[0].Address(),
+					},
+				}
+				wallet = newWallet(t, env, walletConfig{})
+			)
+			tx, err := wallet.IssueAddContinuousValidatorTx(
+				&txs.Validator{
+					NodeID: nodeID,
+					End:    uint64(endTime.Unix()),
+					Wght:   env.config.MinValidatorStake,
+				},
+				pop,
+				owner,
+				owner,
+				reward.PercentDenominator,
+				test.period,
+			)
+			require.NoError(err)
+
+			diff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := &standardTxExecutor{
+				backend:       &env.backend,
+				feeCalculator: state.PickFeeCalculator(env.config, diff),
+				tx:            tx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = tx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			staker, err := diff.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.NoError(err)
+			require.Equal(tx.ID(), staker.TxID)
+			require.Equal(endTime.Unix(), staker.EndTime.Unix())
+			require.Equal(test.period, staker.ContinuationPeriod)
+		})
+	}
+}
+
+func TestStandardExecutorStopContinuousValidatorTx(t *testing.T) {
+	env := newEnvironment(t, upgradetest.Fortuna)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(t, err)
+
+	var (
+		nodeID  = ids.GenerateTestNodeID()
+		endTime = env.state.GetTimestamp().Add(defaultMinStakingDuration)
+		owner   = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				genesistest.DefaultFundedKeys[0].Address(),
+			},
+		}
+		wallet = newWallet(t, env, walletConfig{})
+	)
+	addTx, err := wallet.IssueAddContinuousValidatorTx(
+		&txs.Validator{
+			NodeID: nodeID,
+			End:    uint64(endTime.Unix()),
+			Wght:   env.config.MinValidatorStake,
+		},
+		pop,
+		owner,
+		owner,
+		reward.PercentDenominator,
+		defaultMinStakingDuration,
+	)
+	require.NoError(t, err)
+
+	diff, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(t, err)
+
+	_, _, _, err = StandardTx(
+		&env.backend,
+		state.PickFeeCalculator(env.config, diff),
+		addTx,
+		diff,
+	)
+	require.NoError(t, err)
+
+	diff.AddTx(addTx, status.Committed)
+	require.NoError(t, diff.Apply(env.state))
+	env.state.SetHeight(1)
+	require.NoError(t, env.state.Commit())
+
+	staker, err := env.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(t, err)
+
+	// Find a validator that was not added by an AddContinuousValidatorTx.
+	var genesisValidator *state.Staker
+	it, err := env.state.GetCurrentStakerIterator()
+	require.NoError(t, err)
+	for it.Next() {
+		staker := it.Value()
+		if staker.Priority == txs.PrimaryNetworkValidatorCurrentPriority && staker.NodeID != nodeID {
+			genesisValidator = staker
+			break
+		}
+	}
+	it.Release()
+	require.NotNil(t, genesisValidator)
+
+	tests := []struct {
+		name           string
+		txID           ids.ID
+		builderOptions []common.Option
+		updateExecutor func(executor *standardTxExecutor) error
+		expectedErr    error
+	}{
+		{
+			name: "invalid prior to Fortuna",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Etna),
+				}
+				return nil
+			},
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name: "tx fails syntactic verification",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Ctx = snowtest.Context(t, ids.GenerateTestID())
+				return nil
+			},
+			expectedErr: avax.ErrWrongChainID,
+		},
+		{
+			name: "invalid memo length",
+			builderOptions: []common.Option{
+				common.WithMemo([]byte("memo!")),
+			},
+			expectedErr: avax.ErrMemoTooLarge,
+		},
+		{
+			name:        "not a continuous validator",
+			txID:        genesisValidator.TxID,
+			expectedErr: ErrNotContinuousValidator,
+		},
+		{
+			name: "not a validator",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.state.DeleteCurrentValidator(staker)
+				return nil
+			},
+			expectedErr: ErrNotValidator,
+		},
+		{
+			name: "already stopped",
+			updateExecutor: func(e *standardTxExecutor) error {
+				stoppedStaker := *staker
+				stoppedStaker.ContinuationPeriod = 0
+				return e.state.UpdateCurrentValidator(&stoppedStaker)
+			},
+			expectedErr: ErrContinuousValidatorStopped,
+		},
+		{
+			name: "insufficient fee",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.feeCalculator = txfee.NewDynamicCalculator(
+					genesis.LocalParams.DynamicFeeConfig.Weights,
+					100*genesis.LocalParams.DynamicFeeConfig.MinPrice,
+				)
+				return nil
+			},
+			expectedErr: utxo.ErrInsufficientUnlockedFunds,
+		},
+		{
+			name: "valid tx",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var stopTx *txs.Tx
+			if test.txID == ids.Empty {
+				stopTx, err = wallet.IssueStopContinuousValidatorTx(
+					addTx.ID(),
+					test.builderOptions...,
+				)
+				require.NoError(err)
+			} else {
+				// The wallet is unable to authorize stopping validators that
+				// were not added by an AddContinuousValidatorTx.
+				stopTx, err = txs.NewSigned(
+					&txs.StopContinuousValidatorTx{
+						BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+							NetworkID:    env.ctx.NetworkID,
+							BlockchainID: env.ctx.ChainID,
+						}},
+						TxID:     test.txID,
+						StopAuth: &secp256k1fx.Input{},
+					},
+					txs.Codec,
+					[][]*secp256k1.PrivateKey{{}},
+				)
+				require.NoError(err)
+			}
+
+			diff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			backend := env.backend
+			executor := &standardTxExecutor{
+				backend:       &backend,
+				feeCalculator: state.PickFeeCalculator(env.config, diff),
+				tx:            stopTx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = stopTx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			for utxoID := range stopTx.InputIDs() {
+				_, err := diff.GetUTXO(utxoID)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			expectedStaker := *staker
+			expectedStaker.ContinuationPeriod = 0
+
+			stoppedStaker, err := diff.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			require.NoError(err)
+			require.Equal(&expectedStaker, stoppedStaker)
+		})
+	}
+}
+
+func TestStandardExecutorAddMultiDelegatorTx(t *testing.T) {
+	env := newEnvironment(t, upgradetest.Fortuna)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	var (
+		chainTime        = env.state.GetTimestamp()
+		validatorEndTime = chainTime.Add(defaultMaxStakingDuration)
+		delegatorEndTime = uint64(chainTime.Add(defaultMinStakingDuration).Unix())
+		nodeIDs          = []ids.NodeID{
+			ids.GenerateTestNodeID(),
+			ids.GenerateTestNodeID(),
+		}
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				genesistest.DefaultFundedKeys[0].Address(),
+			},
+		}
+		wallet = newWallet(t, env, walletConfig{})
+	)
+
+	diff, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(t, err)
+	for _, nodeID := range nodeIDs {
+		sk, err := localsigner.New()
+		require.NoError(t, err)
+		pop, err := signer.NewProofOfPossession(sk)
+		require.NoError(t, err)
+
+		addValidatorTx, err := wallet.IssueAddPermissionlessValidatorTx(
+			&txs.SubnetValidator{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+					End:    uint64(validatorEndTime.Unix()),
+					Wght:   env.config.MinValidatorStake,
+				},
+				Subnet: constants.PrimaryNetworkID,
+			},
+			pop,
+			env.ctx.AVAXAssetID,
+			owner,
+			owner,
+			reward.PercentDenominator,
+		)
+		require.NoError(t, err)
+
+		_, _, _, err = StandardTx(
+			&env.backend,
+			state.PickFeeCalculator(env.config, diff),
+			addValidatorTx,
+			diff,
+		)
+		require.NoError(t, err)
+		diff.AddTx(addValidatorTx, status.Committed)
+	}
+	require.NoError(t, diff.Apply(env.state))
+	env.state.SetHeight(1)
+	require.NoError(t, env.state.Commit())
+
+	newDelegations := func() []*txs.Validator {
+		delegations := make([]*txs.Validator, len(nodeIDs))
+		for i, nodeID := range nodeIDs {
+			delegations[i] = &txs.Validator{
+				NodeID: nodeID,
+				End:    delegatorEndTime,
+				Wght:   env.config.MinDelegatorStake,
+			}
+		}
+		return delegations
+	}
+
+	tests := []struct {
+		name              string
+		updateDelegations func(delegations []*txs.Validator)
+		updateExecutor    func(executor *standardTxExecutor) error
+		expectedErr       error
+	}{
+		{
+			name: "invalid prior to Fortuna",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Etna),
+				}
+				return nil
+			},
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name: "delegation below minimum stake",
+			updateDelegations: func(delegations []*txs.Validator) {
+				delegations[1].Wght = env.config.MinDelegatorStake - 1
+			},
+			expectedErr: ErrWeightTooSmall,
+		},
+		{
+			name: "delegation too short",
+			updateDelegations: func(delegations []*txs.Validator) {
+				delegations[0].End--
+			},
+			expectedErr: ErrStakeTooShort,
+		},
+		{
+			name: "not a validator",
+			updateDelegations: func(delegations []*txs.Validator) {
+				delegations[0].NodeID = ids.GenerateTestNodeID()
+			},
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			name: "validator over delegated",
+			updateDelegations: func(delegations []*txs.Validator) {
+				delegations[1].Wght = MaxValidatorWeightFactor * env.config.MinValidatorStake
+			},
+			expectedErr: ErrOverDelegated,
+		},
+		{
+			name: "insufficient fee",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.feeCalculator = txfee.NewDynamicCalculator(
+					genesis.LocalParams.DynamicFeeConfig.Weights,
+					100*genesis.LocalParams.DynamicFeeConfig.MinPrice,
+				)
+				return nil
+			},
+			expectedErr: utxo.ErrInsufficientUnlockedFunds,
+		},
+		{
+			name: "valid tx",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			delegations := newDelegations()
+			if test.updateDelegations != nil {
+				test.updateDelegations(delegations)
+			}
+			// The wallet must not spend the UTXOs consumed by previously
+			// issued txs.
+			wallet := newWallet(t, env, walletConfig{})
+			tx, err := wallet.IssueAddMultiDelegatorTx(delegations, owner)
+			require.NoError(err)
+
+			diff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			backend := env.backend
+			executor := &standardTxExecutor{
+				backend:       &backend,
+				feeCalculator: state.PickFeeCalculator(env.config, diff),
+				tx:            tx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = tx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			for utxoID := range tx.InputIDs() {
+				_, err := diff.GetUTXO(utxoID)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			diff.AddTx(tx, status.Committed)
+			multiDelegatorTx := tx.Unsigned.(*txs.AddMultiDelegatorTx)
+			for i, delegation := range multiDelegatorTx.Delegations {
+				delegationID := txs.DelegationID(tx.ID(), i)
+
+				it, err := diff.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, delegation.NodeID)
+				require.NoError(err)
+				require.True(it.Next())
+				delegator := it.Value()
+				require.False(it.Next())
+				it.Release()
+
+				require.Equal(delegationID, delegator.TxID)
+				require.Equal(delegation.Wght, delegator.Weight)
+				require.Equal(delegation.EndTime(), delegator.EndTime)
+
+				stakerTx, err := diff.GetStakerTx(delegationID)
+				require.NoError(err)
+				require.Equal(delegation.NodeID, stakerTx.NodeID())
+			}
+		})
+	}
+}
+
+func TestStandardExecutorSetWithdrawalOwnerTx(t *testing.T) {
+	env := newEnvironment(t, upgradetest.Fortuna)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(t, err)
+
+	var (
+		nodeID  = ids.GenerateTestNodeID()
+		endTime = env.state.GetTimestamp().Add(defaultMinStakingDuration)
+		owner   = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				genesistest.DefaultFundedKeys[0].Address(),
+			},
+		}
+		withdrawalOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				ids.GenerateTestShortID(),
+			},
+		}
+		wallet = newWallet(t, env, walletConfig{})
+	)
+	addTx, err := wallet.IssueAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				End:    uint64(endTime.Unix()),
+				Wght:   env.config.MinValidatorStake,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		pop,
+		env.ctx.AVAXAssetID,
+		owner,
+		owner,
+		reward.PercentDenominator,
+	)
+	require.NoError(t, err)
+
+	diff, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(t, err)
+
+	_, _, _, err = StandardTx(
+		&env.backend,
+		state.PickFeeCalculator(env.config, diff),
+		addTx,
+		diff,
+	)
+	require.NoError(t, err)
+
+	diff.AddTx(addTx, status.Committed)
+	require.NoError(t, diff.Apply(env.state))
+	env.state.SetHeight(1)
+	require.NoError(t, env.state.Commit())
+
+	staker, err := env.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		txID           ids.ID
+		builderOptions []common.Option
+		updateExecutor func(executor *standardTxExecutor) error
+		expectedErr    error
+	}{
+		{
+			name: "invalid prior to Fortuna",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Etna),
+				}
+				return nil
+			},
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name: "tx fails syntactic verification",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Ctx = snowtest.Context(t, ids.GenerateTestID())
+				return nil
+			},
+			expectedErr: avax.ErrWrongChainID,
+		},
+		{
+			name: "invalid memo length",
+			builderOptions: []common.Option{
+				common.WithMemo([]byte("memo!")),
+			},
+			expectedErr: avax.ErrMemoTooLarge,
+		},
+		{
+			name:        "unknown validator tx",
+			txID:        ids.GenerateTestID(),
+			expectedErr: ErrNotRewardedValidator,
+		},
+		{
+			name:        "not a validator tx",
+			txID:        testSubnet1.ID(),
+			expectedErr: ErrNotRewardedValidator,
+		},
+		{
+			name: "not a validator",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.state.DeleteCurrentValidator(staker)
+				return nil
+			},
+			expectedErr: ErrNotValidator,
+		},
+		{
+			name: "insufficient fee",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.feeCalculator = txfee.NewDynamicCalculator(
+					genesis.LocalParams.DynamicFeeConfig.Weights,
+					100*genesis.LocalParams.DynamicFeeConfig.MinPrice,
+				)
+				return nil
+			},
+			expectedErr: utxo.ErrInsufficientUnlockedFunds,
+		},
+		{
+			name: "valid tx",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var setTx *txs.Tx
+			if test.txID == ids.Empty {
+				setTx, err = wallet.IssueSetWithdrawalOwnerTx(
+					addTx.ID(),
+					withdrawalOwner,
+					test.builderOptions...,
+				)
+				require.NoError(err)
+			} else {
+				// The wallet is unable to authorize txs that didn't add a
+				// validator.
+				setTx, err = txs.NewSigned(
+					&txs.SetWithdrawalOwnerTx{
+						BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+							NetworkID:    env.ctx.NetworkID,
+							BlockchainID: env.ctx.ChainID,
+						}},
+						TxID:            test.txID,
+						WithdrawalOwner: withdrawalOwner,
+						OwnerAuth:       &secp256k1fx.Input{},
+					},
+					txs.Codec,
+					[][]*secp256k1.PrivateKey{{}},
+				)
+				require.NoError(err)
+			}
+
+			diff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			backend := env.backend
+			executor := &standardTxExecutor{
+				backend:       &backend,
+				feeCalculator: state.PickFeeCalculator(env.config, diff),
+				tx:            setTx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = setTx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			for utxoID := range setTx.InputIDs() {
+				_, err := diff.GetUTXO(utxoID)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			owner, err := diff.GetWithdrawalOwner(addTx.ID())
+			require.NoError(err)
+			require.Equal(withdrawalOwner, owner)
+		})
+	}
+}
+
+func TestStandardExecutorRetireChainTx(t *testing.T) {
+	env := newEnvironment(t, upgradetest.Fortuna)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	subnetID := testSubnet1.ID()
+	wallet := newWallet(t, env, walletConfig{
+		subnetIDs: []ids.ID{subnetID},
+	})
+	createChainTx, err := wallet.IssueCreateChainTx(
+		subnetID,
+		[]byte{},
+		ids.GenerateTestID(),
+		[]ids.ID{},
+		"chain",
+	)
+	require.NoError(t, err)
+
+	diff, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(t, err)
+
+	_, _, _, err = StandardTx(
+		&env.backend,
+		state.PickFeeCalculator(env.config, diff),
+		createChainTx,
+		diff,
+	)
+	require.NoError(t, err)
+
+	diff.AddTx(createChainTx, status.Committed)
+	require.NoError(t, diff.Apply(env.state))
+	env.state.SetHeight(1)
+	require.NoError(t, env.state.Commit())
+
+	// The wallet is recreated so that it knows the owner of the new chain.
+	chainID := createChainTx.ID()
+	wallet = newWallet(t, env, walletConfig{
+		subnetIDs: []ids.ID{subnetID},
+	})
+
+	tests := []struct {
+		name           string
+		chainID        ids.ID
+		builderOptions []common.Option
+		updateExecutor func(executor *standardTxExecutor) error
+		expectedErr    error
+	}{
+		{
+			name: "invalid prior to Fortuna",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Etna),
+				}
+				return nil
+			},
+			expectedErr: errFortunaUpgradeNotActive,
+		},
+		{
+			name: "tx fails syntactic verification",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Ctx = snowtest.Context(t, ids.GenerateTestID())
+				return nil
+			},
+			expectedErr: avax.ErrWrongChainID,
+		},
+		{
+			name: "invalid memo length",
+			builderOptions: []common.Option{
+				common.WithMemo([]byte("memo!")),
+			},
+			expectedErr: avax.ErrMemoTooLarge,
+		},
+		{
+			name:        "unknown chain",
+			chainID:     ids.GenerateTestID(),
+			expectedErr: ErrNotChain,
+		},
+		{
+			name:        "not a chain",
+			chainID:     subnetID,
+			expectedErr: ErrNotChain,
+		},
+		{
+			name: "already retired",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.state.RetireChain(chainID)
+				return nil
+			},
+			expectedErr: ErrChainRetired,
+		},
+		{
+			name: "insufficient fee",
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.feeCalculator = txfee.NewDynamicCalculator(
+					genesis.LocalParams.DynamicFeeConfig.Weights,
+					100*genesis.LocalParams.DynamicFeeConfig.MinPrice,
+				)
+				return nil
+			},
+			expectedErr: utxo.ErrInsufficientUnlockedFunds,
+		},
+		{
+			name: "valid tx",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var retireTx *txs.Tx
+			if test.chainID == ids.Empty {
+				retireTx, err = wallet.IssueRetireChainTx(
+					chainID,
+					test.builderOptions...,
+				)
+				require.NoError(err)
+			} else {
+				// The wallet is unable to authorize txs that don't retire a
+				// known chain.
+				retireTx, err = txs.NewSigned(
+					&txs.RetireChainTx{
+						BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+							NetworkID:    env.ctx.NetworkID,
+							BlockchainID: env.ctx.ChainID,
+						}},
+						ChainID:    test.chainID,
+						SubnetAuth: &secp256k1fx.Input{},
+					},
+					txs.Codec,
+					[][]*secp256k1.PrivateKey{{}},
+				)
+				require.NoError(err)
+			}
+
+			diff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			backend := env.backend
+			executor := &standardTxExecutor{
+				backend:       &backend,
+				feeCalculator: state.PickFeeCalculator(env.config, diff),
+				tx:            retireTx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = retireTx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			for utxoID := range retireTx.InputIDs() {
+				_, err := diff.GetUTXO(utxoID)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			isRetired, err := diff.IsChainRetired(chainID)
+			require.NoError(err)
+			require.True(isRetired)
+			require.NotNil(executor.onAccept)
+		})
+	}
+}
+
+func TestStandardExecutorHeightLockedSpend(t *testing.T) {
+	var (
+		fx = &secp256k1fx.Fx{}
+		vm = &secp256k1fx.TestVM{
+			Log: logging.NoLog{},
+		}
+	)
+	require.NoError(t, fx.InitializeVM(vm))
+	require.NoError(t, fx.Bootstrapped())
+
+	const lockHeight = 5
+	tests := []struct {
+		name        string
+		height      uint64
+		expectedErr error
+	}{
+		{
+			name:        "spent before lock height",
+			height:      lockHeight - 1,
+			expectedErr: secp256k1fx.ErrHeightLocked,
+		},
+		{
+			name:   "spent at lock height",
+			height: lockHeight,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			key, err := secp256k1.NewPrivateKey()
+			require.NoError(err)
+
+			var (
+				ctx    = snowtest.Context(t, constants.PlatformChainID)
+				config = &config.Internal{
+					DynamicFeeConfig:   genesis.LocalParams.DynamicFeeConfig,
+					ValidatorFeeConfig: genesis.LocalParams.ValidatorFeeConfig,
+					UpgradeConfig:      upgradetest.GetConfig(upgradetest.Fortuna),
+				}
+				baseState = statetest.New(t, statetest.Config{
+					Upgrades: config.UpgradeConfig,
+					Context:  ctx,
+				})
+				lockedUTXO = &avax.UTXO{
+					UTXOID: avax.UTXOID{
+						TxID: ids.GenerateTestID(),
+					},
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &secp256k1fx.HeightLockedOutput{
+						LockHeight: lockHeight,
+						TransferOutput: secp256k1fx.TransferOutput{
+							Amt: units.Avax,
+							OutputOwners: secp256k1fx.OutputOwners{
+								Threshold: 1,
+								Addrs: []ids.ShortID{
+									key.Address(),
+								},
+							},
+						},
+					},
+				}
+			)
+
+			baseState.AddUTXO(lockedUTXO)
+			require.NoError(baseState.Commit())
+
+			var (
+				wallet = txstest.NewWallet(
+					t,
+					ctx,
+					config,
+					baseState,
+					secp256k1fx.NewKeychain(key),
+					nil, // subnetIDs
+					nil, // validationIDs
+					nil, // chainIDs
+				)
+				backend = &Backend{
+					Config:       config,
+					Bootstrapped: utils.NewAtomic(true),
+					Fx:           fx,
+					FlowChecker:  utxo.NewVerifier(ctx, &vm.Clk, fx),
+					Ctx:          ctx,
+					Height:       test.height,
+				}
+				feeCalculator = state.PickFeeCalculator(config, baseState)
+			)
+
+			tx, err := wallet.IssueBaseTx(
+				nil,
+				common.WithMinIssuanceHeight(lockHeight),
+			)
+			require.NoError(err)
+
+			diff, err := state.NewDiffOn(baseState)
+			require.NoError(err)
+
+			_, _, _, err = StandardTx(
+				backend,
+				feeCalculator,
+				tx,
+				diff,
+			)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
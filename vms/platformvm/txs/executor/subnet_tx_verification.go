@@ -25,13 +25,14 @@ var (
 // subnet. This is an extension of [verifySubnetAuthorization] that additionally
 // verifies that the subnet being modified is currently a PoA subnet.
 func verifyPoASubnetAuthorization(
+	c *checker,
 	fx fx.Fx,
 	chainState state.Chain,
 	sTx *txs.Tx,
 	subnetID ids.ID,
 	subnetAuth verify.Verifiable,
 ) ([]verify.Verifiable, error) {
-	creds, err := verifySubnetAuthorization(fx, chainState, sTx, subnetID, subnetAuth)
+	creds, err := verifySubnetAuthorization(c, fx, chainState, sTx, subnetID, subnetAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +61,7 @@ func verifyPoASubnetAuthorization(
 // Returns the remaining tx credentials that should be used to authorize the
 // other operations in the tx.
 func verifySubnetAuthorization(
+	c *checker,
 	fx fx.Fx,
 	chainState state.Chain,
 	tx *txs.Tx,
@@ -71,14 +73,17 @@ func verifySubnetAuthorization(
 		return nil, err
 	}
 
-	return verifyAuthorization(fx, tx, subnetOwner, subnetAuth)
+	return verifyAuthorization(c, fx, tx, subnetOwner, subnetAuth)
 }
 
 // verifyAuthorization carries out the validation of an auth. The last
 // credential in [tx.Creds] is used as the authorization.
 // Returns the remaining tx credentials that should be used to authorize the
 // other operations in the tx.
+//
+// If the authorization is invalid, the failure is reported to [c].
 func verifyAuthorization(
+	c *checker,
 	fx fx.Fx,
 	tx *txs.Tx,
 	owner fx.Owner,
@@ -93,7 +98,9 @@ func verifyAuthorization(
 	authCred := tx.Creds[baseTxCredsLen]
 
 	if err := fx.VerifyPermission(tx.Unsigned, auth, authCred, owner); err != nil {
-		return nil, fmt.Errorf("%w: %w", errUnauthorizedModification, err)
+		if err := c.fail(fmt.Errorf("%w: %w", errUnauthorizedModification, err)); err != nil {
+			return nil, err
+		}
 	}
 
 	return tx.Creds[:baseTxCredsLen], nil
@@ -42,76 +42,12 @@ type warpVerifier struct {
 	pChainHeight   uint64
 }
 
-func (*warpVerifier) AddValidatorTx(*txs.AddValidatorTx) error {
-	return nil
+func (w *warpVerifier) ExpiringTx(tx *txs.ExpiringTx) error {
+	return tx.Tx.Visit(w)
 }
 
-func (*warpVerifier) AddSubnetValidatorTx(*txs.AddSubnetValidatorTx) error {
-	return nil
-}
-
-func (*warpVerifier) AddDelegatorTx(*txs.AddDelegatorTx) error {
-	return nil
-}
-
-func (*warpVerifier) CreateChainTx(*txs.CreateChainTx) error {
-	return nil
-}
-
-func (*warpVerifier) CreateSubnetTx(*txs.CreateSubnetTx) error {
-	return nil
-}
-
-func (*warpVerifier) ImportTx(*txs.ImportTx) error {
-	return nil
-}
-
-func (*warpVerifier) ExportTx(*txs.ExportTx) error {
-	return nil
-}
-
-func (*warpVerifier) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
-	return nil
-}
-
-func (*warpVerifier) RewardValidatorTx(*txs.RewardValidatorTx) error {
-	return nil
-}
-
-func (*warpVerifier) RemoveSubnetValidatorTx(*txs.RemoveSubnetValidatorTx) error {
-	return nil
-}
-
-func (*warpVerifier) TransformSubnetTx(*txs.TransformSubnetTx) error {
-	return nil
-}
-
-func (*warpVerifier) AddPermissionlessValidatorTx(*txs.AddPermissionlessValidatorTx) error {
-	return nil
-}
-
-func (*warpVerifier) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDelegatorTx) error {
-	return nil
-}
-
-func (*warpVerifier) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
-	return nil
-}
-
-func (*warpVerifier) BaseTx(*txs.BaseTx) error {
-	return nil
-}
-
-func (*warpVerifier) ConvertSubnetToL1Tx(*txs.ConvertSubnetToL1Tx) error {
-	return nil
-}
-
-func (*warpVerifier) IncreaseL1ValidatorBalanceTx(*txs.IncreaseL1ValidatorBalanceTx) error {
-	return nil
-}
-
-func (*warpVerifier) DisableL1ValidatorTx(*txs.DisableL1ValidatorTx) error {
-	return nil
+func (w *warpVerifier) DependentTx(tx *txs.DependentTx) error {
+	return tx.Tx.Visit(w)
 }
 
 func (w *warpVerifier) RegisterL1ValidatorTx(tx *txs.RegisterL1ValidatorTx) error {
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var (
+	_ UnsignedTx = (*ExpiringTx)(nil)
+
+	ErrNestedExpiringTx      = errors.New("expiring tx can not wrap an expiring tx")
+	ErrUnsupportedExpiringTx = errors.New("expiring tx can not wrap this tx type")
+	ErrZeroExpiry            = errors.New("expiry must be non-zero")
+)
+
+// ExpiringTx wraps a transaction so that it can only be accepted until the
+// chain time reaches [Expiry].
+//
+// The wrapped transaction is executed as if it was issued directly. However,
+// its credentials sign the bytes of the ExpiringTx, so the expiry can not be
+// removed or modified without invalidating them.
+type ExpiringTx struct {
+	// Unix time, in seconds, after which this transaction can no longer be
+	// accepted
+	Expiry uint64 `serialize:"true" json:"expiry"`
+	// Transaction to execute
+	Tx UnsignedTx `serialize:"true" json:"tx"`
+
+	unsignedBytes []byte // Unsigned byte representation of this data
+}
+
+// SetBytes also sets the bytes of the wrapped transaction, as its credentials
+// are verified against the bytes of the ExpiringTx.
+func (tx *ExpiringTx) SetBytes(unsignedBytes []byte) {
+	tx.unsignedBytes = unsignedBytes
+	tx.Tx.SetBytes(unsignedBytes)
+}
+
+func (tx *ExpiringTx) Bytes() []byte {
+	return tx.unsignedBytes
+}
+
+func (tx *ExpiringTx) InitCtx(ctx *snow.Context) {
+	tx.Tx.InitCtx(ctx)
+}
+
+func (tx *ExpiringTx) InputIDs() set.Set[ids.ID] {
+	return tx.Tx.InputIDs()
+}
+
+func (tx *ExpiringTx) Outputs() []*avax.TransferableOutput {
+	return tx.Tx.Outputs()
+}
+
+// ExpiryTime returns the time after which this transaction can no longer be
+// accepted.
+func (tx *ExpiringTx) ExpiryTime() time.Time {
+	return time.Unix(int64(tx.Expiry), 0)
+}
+
+// SyntacticVerify returns nil iff this tx is well formed
+func (tx *ExpiringTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil || tx.Tx == nil:
+		return ErrNilTx
+	case tx.Expiry == 0:
+		return ErrZeroExpiry
+	}
+	switch tx.Tx.(type) {
+	case *ExpiringTx:
+		return ErrNestedExpiringTx
+	// A DependentTx is expected to wrap the ExpiringTx, so that the wrappers
+	// are always applied in the same order.
+	case *DependentTx:
+		return ErrUnsupportedExpiringTx
+	}
+	if !isWrappable(tx.Tx) {
+		return ErrUnsupportedExpiringTx
+	}
+	return tx.Tx.SyntacticVerify(ctx)
+}
+
+func (tx *ExpiringTx) Visit(visitor Visitor) error {
+	return visitor.ExpiringTx(tx)
+}
+
+// Unwrap returns the transaction wrapped by [utx] if it is an ExpiringTx or a
+// DependentTx. Otherwise, [utx] is returned.
+func Unwrap(utx UnsignedTx) UnsignedTx {
+	for {
+		switch tx := utx.(type) {
+		case *ExpiringTx:
+			utx = tx.Tx
+		case *DependentTx:
+			utx = tx.Tx
+		default:
+			return utx
+		}
+	}
+}
+
+// GetExpiringTx returns the ExpiringTx that is, or is wrapped by, [utx]. If
+// [utx] doesn't expire, false is returned.
+func GetExpiringTx(utx UnsignedTx) (*ExpiringTx, bool) {
+	for {
+		switch tx := utx.(type) {
+		case *ExpiringTx:
+			return tx, true
+		case *DependentTx:
+			utx = tx.Tx
+		default:
+			return nil, false
+		}
+	}
+}
+
+// isWrappable returns true if [utx] can be wrapped by an ExpiringTx or a
+// DependentTx.
+func isWrappable(utx UnsignedTx) bool {
+	switch utx.(type) {
+	// Subnets and chains are loaded from the state by type, so their creation
+	// can not be wrapped.
+	case *CreateSubnetTx, *CreateChainTx, *TransformSubnetTx,
+		*AdvanceTimeTx, *RewardValidatorTx:
+		return false
+	default:
+		return true
+	}
+}
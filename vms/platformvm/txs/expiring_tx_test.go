@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+func TestExpiringTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = &BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+	)
+	tests := []struct {
+		name        string
+		tx          *ExpiringTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "nil wrapped tx",
+			tx: &ExpiringTx{
+				Expiry: 1,
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "zero expiry",
+			tx: &ExpiringTx{
+				Tx: validBaseTx,
+			},
+			expectedErr: ErrZeroExpiry,
+		},
+		{
+			name: "nested expiring tx",
+			tx: &ExpiringTx{
+				Expiry: 1,
+				Tx: &ExpiringTx{
+					Expiry: 1,
+					Tx:     validBaseTx,
+				},
+			},
+			expectedErr: ErrNestedExpiringTx,
+		},
+		{
+			name: "wrapped dependent tx",
+			tx: &ExpiringTx{
+				Expiry: 1,
+				Tx: &DependentTx{
+					DependsOn: ids.GenerateTestID(),
+					Tx:        validBaseTx,
+				},
+			},
+			expectedErr: ErrUnsupportedExpiringTx,
+		},
+		{
+			name: "wrapped create subnet tx",
+			tx: &ExpiringTx{
+				Expiry: 1,
+				Tx: &CreateSubnetTx{
+					BaseTx: *validBaseTx,
+				},
+			},
+			expectedErr: ErrUnsupportedExpiringTx,
+		},
+		{
+			name: "invalid wrapped tx",
+			tx: &ExpiringTx{
+				Expiry: 1,
+				Tx:     &BaseTx{},
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "passes verification",
+			tx: &ExpiringTx{
+				Expiry: 1,
+				Tx:     validBaseTx,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestExpiringTxSetBytes(t *testing.T) {
+	require := require.New(t)
+
+	baseTx := &BaseTx{}
+	tx := &ExpiringTx{
+		Expiry: 1,
+		Tx:     baseTx,
+	}
+	signedTx := &Tx{
+		Unsigned: tx,
+	}
+	require.NoError(signedTx.Initialize(Codec))
+
+	// The wrapped tx must be signed over the bytes of the ExpiringTx.
+	require.Equal(tx.Bytes(), baseTx.Bytes())
+	require.Equal(baseTx, Unwrap(tx))
+	require.Equal(baseTx, Unwrap(baseTx))
+	require.Equal(baseTx, Unwrap(&DependentTx{Tx: tx}))
+}
+
+func TestGetExpiringTx(t *testing.T) {
+	require := require.New(t)
+
+	baseTx := &BaseTx{}
+	expiringTx := &ExpiringTx{
+		Expiry: 1,
+		Tx:     baseTx,
+	}
+
+	_, ok := GetExpiringTx(baseTx)
+	require.False(ok)
+	_, ok = GetExpiringTx(&DependentTx{Tx: baseTx})
+	require.False(ok)
+
+	tx, ok := GetExpiringTx(expiringTx)
+	require.True(ok)
+	require.Equal(expiringTx, tx)
+
+	// A DependentTx may wrap an ExpiringTx.
+	tx, ok = GetExpiringTx(&DependentTx{Tx: expiringTx})
+	require.True(ok)
+	require.Equal(expiringTx, tx)
+}
@@ -17,8 +17,9 @@ import (
 var (
 	_ UnsignedTx = (*ExportTx)(nil)
 
-	ErrWrongLocktime   = errors.New("wrong locktime reported")
-	errNoExportOutputs = errors.New("no export outputs")
+	ErrWrongLocktime      = errors.New("wrong locktime reported")
+	ErrHeightLockedExport = errors.New("height locked outputs can't be exported")
+	errNoExportOutputs    = errors.New("no export outputs")
 )
 
 // ExportTx is an unsigned exportTx
@@ -62,8 +63,13 @@ func (tx *ExportTx) SyntacticVerify(ctx *snow.Context) error {
 		if err := out.Verify(); err != nil {
 			return fmt.Errorf("output failed verification: %w", err)
 		}
-		if _, ok := out.Output().(*stakeable.LockOut); ok {
+		switch out.Output().(type) {
+		case *stakeable.LockOut:
 			return ErrWrongLocktime
+		case *secp256k1fx.HeightLockedOutput:
+			// The lock height of an output is only meaningful on the chain it
+			// was created on.
+			return ErrHeightLockedExport
 		}
 	}
 	if !avax.IsSortedTransferableOutputs(tx.ExportedOutputs, Codec) {
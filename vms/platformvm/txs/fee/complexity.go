@@ -42,6 +42,8 @@ const (
 	intrinsicStakeableLockedOutputBandwidth = wrappers.LongLen + // locktime
 		wrappers.IntLen // output typeID
 
+	intrinsicHeightLockedOutputBandwidth = wrappers.LongLen // lock height
+
 	intrinsicSECP256k1FxOutputOwnersBandwidth = wrappers.LongLen + // locktime
 		wrappers.IntLen + // threshold
 		wrappers.IntLen // num addresses
@@ -77,6 +79,9 @@ const (
 		wrappers.IntLen + // deactivation owner threshold
 		wrappers.IntLen // deactivation owner num addresses
 
+	intrinsicDelegationBandwidth = intrinsicValidatorBandwidth + // validator
+		wrappers.IntLen // num stake outs
+
 	intrinsicBLSAggregateCompute           = 5     // BLS public key aggregation time is around 5us
 	intrinsicBLSVerifyCompute              = 1_000 // BLS verification time is around 1000us
 	intrinsicBLSPublicKeyValidationCompute = 50    // BLS public key validation time is around 50us
@@ -89,9 +94,38 @@ const (
 
 	intrinsicInputDBRead = 1
 
+	intrinsicRewardUTXOBandwidth = ids.IDLen + // txID
+		wrappers.IntLen // output index
+
+	intrinsicRewardUTXODBRead = 2 // read utxo + read reward utxos of the staker
+
 	intrinsicInputDBWrite                      = 1
 	intrinsicOutputDBWrite                     = 1
 	intrinsicConvertSubnetToL1ValidatorDBWrite = 4 // weight diff + pub key diff + subnetID/nodeID + validationID
+
+	intrinsicDelegationDBRead  = 1 // get validator
+	intrinsicDelegationDBWrite = 2 // put current staker + write weight diff
+
+	// The state growth of an output is its bandwidth plus the fields that are
+	// only persisted with the UTXO.
+	intrinsicOutputStateGrowth = codec.VersionSize + // codecVersion
+		ids.IDLen + // txID
+		wrappers.IntLen // output index
+
+	// The state growth of an L1 validator doesn't include the addresses of its
+	// owners.
+	intrinsicL1ValidatorStateGrowth = ids.IDLen + // validationID
+		codec.VersionSize + // codecVersion
+		ids.IDLen + // subnetID
+		ids.NodeIDLen + // nodeID
+		wrappers.IntLen + // public key length
+		bls.PublicKeyLen + // public key
+		wrappers.IntLen + // remaining balance owner length
+		wrappers.IntLen + // deactivation owner length
+		wrappers.LongLen + // start time
+		wrappers.LongLen + // weight
+		wrappers.LongLen + // min nonce
+		wrappers.LongLen // end accumulated fee
 )
 
 var (
@@ -197,9 +231,10 @@ var (
 			wrappers.LongLen + // balance
 			bls.SignatureLen + // proof of possession
 			wrappers.IntLen, // message length
-		gas.DBRead:  5, // conversion owner + expiry lookup + sov lookup + subnetID/nodeID lookup + weight lookup
-		gas.DBWrite: 6, // write current staker + expiry + write weight diff + write pk diff + subnetID/nodeID lookup + weight lookup
-		gas.Compute: intrinsicBLSPoPVerifyCompute,
+		gas.DBRead:      5, // conversion owner + expiry lookup + sov lookup + subnetID/nodeID lookup + weight lookup
+		gas.DBWrite:     6, // write current staker + expiry + write weight diff + write pk diff + subnetID/nodeID lookup + weight lookup
+		gas.Compute:     intrinsicBLSPoPVerifyCompute,
+		gas.StateGrowth: intrinsicL1ValidatorStateGrowth,
 	}
 	IntrinsicSetL1ValidatorWeightTxComplexities = gas.Dimensions{
 		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
@@ -222,6 +257,72 @@ var (
 		gas.DBRead:  1, // read staker
 		gas.DBWrite: 6, // write remaining balance utxo + weight diff + deactivated weight diff + public key diff + delete staker + write staker
 	}
+	IntrinsicSetSubnetValidatorWeightTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			ids.NodeIDLen + // nodeID
+			ids.IDLen + // subnetID
+			wrappers.LongLen + // weight
+			wrappers.IntLen + // subnetAuth typeID
+			wrappers.IntLen, // subnetAuthCredential typeID
+		gas.DBRead:  4, // read validator + get subnet auth + check for subnet transformation + check for subnet conversion
+		gas.DBWrite: 2, // write weight + write weight diff
+	}
+	IntrinsicAddContinuousValidatorTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicAddPermissionlessValidatorTxComplexities[gas.Bandwidth] +
+			wrappers.LongLen, // period
+		gas.DBRead:  IntrinsicAddPermissionlessValidatorTxComplexities[gas.DBRead],
+		gas.DBWrite: IntrinsicAddPermissionlessValidatorTxComplexities[gas.DBWrite],
+	}
+	IntrinsicStopContinuousValidatorTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			ids.IDLen + // txID
+			wrappers.IntLen + // stopAuth typeID
+			wrappers.IntLen, // stopAuthCredential typeID
+		gas.DBRead:  2, // read validator tx + read validator
+		gas.DBWrite: 1, // write validator
+	}
+	IntrinsicAddMultiDelegatorTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			wrappers.IntLen + // num delegations
+			wrappers.IntLen, // delegator rewards typeID
+		gas.DBRead: 1, // get staking config
+	}
+	IntrinsicSetWithdrawalOwnerTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			ids.IDLen + // txID
+			wrappers.IntLen + // withdrawal owner typeID
+			wrappers.IntLen + // ownerAuth typeID
+			wrappers.IntLen, // ownerAuthCredential typeID
+		gas.DBRead:  2, // read validator tx + read validator
+		gas.DBWrite: 1, // set withdrawal owner
+	}
+	IntrinsicRetireChainTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			ids.IDLen + // chainID
+			wrappers.IntLen + // subnetAuth typeID
+			wrappers.IntLen, // subnetAuthCredential typeID
+		gas.DBRead:  3, // read chain tx + read retirement + read subnet auth
+		gas.DBWrite: 1, // retire chain
+	}
+	IntrinsicClaimRewardsTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			wrappers.IntLen + // num reward utxos
+			wrappers.IntLen + // claimAuth typeID
+			wrappers.IntLen, // claimAuthCredential typeID
+	}
+	// IntrinsicExpiringTxComplexities is the complexity of an ExpiringTx in
+	// addition to the complexity of the transaction it wraps.
+	IntrinsicExpiringTxComplexities = gas.Dimensions{
+		gas.Bandwidth: wrappers.IntLen + // wrapped tx typeID
+			wrappers.LongLen, // expiry
+	}
+	// IntrinsicDependentTxComplexities is the complexity of a DependentTx in
+	// addition to the complexity of the transaction it wraps.
+	IntrinsicDependentTxComplexities = gas.Dimensions{
+		gas.Bandwidth: wrappers.IntLen + // wrapped tx typeID
+			ids.IDLen, // dependsOn
+		gas.DBRead: 1, // read dependency
+	}
 
 	errUnsupportedOutput = errors.New("unsupported output type")
 	errUnsupportedInput  = errors.New("unsupported input type")
@@ -279,8 +380,14 @@ func outputComplexity(out *avax.TransferableOutput) (gas.Dimensions, error) {
 		outIntf = stakeableOut.TransferableOut
 	}
 
-	secp256k1Out, ok := outIntf.(*secp256k1fx.TransferOutput)
-	if !ok {
+	var secp256k1Out *secp256k1fx.TransferOutput
+	switch out := outIntf.(type) {
+	case *secp256k1fx.TransferOutput:
+		secp256k1Out = out
+	case *secp256k1fx.HeightLockedOutput:
+		complexity[gas.Bandwidth] += intrinsicHeightLockedOutputBandwidth
+		secp256k1Out = &out.TransferOutput
+	default:
 		return gas.Dimensions{}, errUnsupportedOutput
 	}
 
@@ -290,6 +397,12 @@ func outputComplexity(out *avax.TransferableOutput) (gas.Dimensions, error) {
 		return gas.Dimensions{}, err
 	}
 	complexity[gas.Bandwidth], err = math.Add(complexity[gas.Bandwidth], addressBandwidth)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+
+	// Outputs are persisted as UTXOs
+	complexity[gas.StateGrowth], err = math.Add(complexity[gas.Bandwidth], intrinsicOutputStateGrowth)
 	return complexity, err
 }
 
@@ -348,6 +461,30 @@ func inputComplexity(in *avax.TransferableInput) (gas.Dimensions, error) {
 	return complexity, err
 }
 
+// RewardUTXOComplexity returns the complexity claiming reward UTXOs adds to a
+// transaction.
+// It does not include the complexity of the claim authorization.
+func RewardUTXOComplexity(utxoIDs ...*avax.UTXOID) (gas.Dimensions, error) {
+	numUTXOs := uint64(len(utxoIDs))
+	bandwidth, err := math.Mul(numUTXOs, intrinsicRewardUTXOBandwidth)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+	dbRead, err := math.Mul(numUTXOs, intrinsicRewardUTXODBRead)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+	dbWrite, err := math.Mul(numUTXOs, intrinsicInputDBWrite)
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+	return gas.Dimensions{
+		gas.Bandwidth: bandwidth,
+		gas.DBRead:    dbRead,
+		gas.DBWrite:   dbWrite,
+	}, nil
+}
+
 // ConvertSubnetToL1ValidatorComplexity returns the complexity the validators
 // add to a transaction.
 func ConvertSubnetToL1ValidatorComplexity(l1Validators ...*txs.ConvertSubnetToL1Validator) (gas.Dimensions, error) {
@@ -368,8 +505,9 @@ func ConvertSubnetToL1ValidatorComplexity(l1Validators ...*txs.ConvertSubnetToL1
 
 func convertSubnetToL1ValidatorComplexity(l1Validator *txs.ConvertSubnetToL1Validator) (gas.Dimensions, error) {
 	complexity := gas.Dimensions{
-		gas.Bandwidth: intrinsicConvertSubnetToL1ValidatorBandwidth,
-		gas.DBWrite:   intrinsicConvertSubnetToL1ValidatorDBWrite,
+		gas.Bandwidth:   intrinsicConvertSubnetToL1ValidatorBandwidth,
+		gas.DBWrite:     intrinsicConvertSubnetToL1ValidatorDBWrite,
+		gas.StateGrowth: intrinsicL1ValidatorStateGrowth,
 	}
 
 	signerComplexity, err := SignerComplexity(&l1Validator.Signer)
@@ -393,6 +531,31 @@ func convertSubnetToL1ValidatorComplexity(l1Validator *txs.ConvertSubnetToL1Vali
 	)
 }
 
+// DelegationComplexity returns the complexity the delegations add to a
+// transaction.
+func DelegationComplexity(delegations ...*txs.Delegation) (gas.Dimensions, error) {
+	var complexity gas.Dimensions
+	for _, delegation := range delegations {
+		outputsComplexity, err := OutputComplexity(delegation.StakeOuts...)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+
+		complexity, err = complexity.Add(
+			&gas.Dimensions{
+				gas.Bandwidth: intrinsicDelegationBandwidth,
+				gas.DBRead:    intrinsicDelegationDBRead,
+				gas.DBWrite:   intrinsicDelegationDBWrite,
+			},
+			&outputsComplexity,
+		)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	}
+	return complexity, nil
+}
+
 // OwnerComplexity returns the complexity an owner adds to a transaction.
 // It does not include the typeID of the owner.
 func OwnerComplexity(ownerIntf fx.Owner) (gas.Dimensions, error) {
@@ -497,26 +660,6 @@ type complexityVisitor struct {
 	output gas.Dimensions
 }
 
-func (*complexityVisitor) AddValidatorTx(*txs.AddValidatorTx) error {
-	return ErrUnsupportedTx
-}
-
-func (*complexityVisitor) AddDelegatorTx(*txs.AddDelegatorTx) error {
-	return ErrUnsupportedTx
-}
-
-func (*complexityVisitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
-	return ErrUnsupportedTx
-}
-
-func (*complexityVisitor) RewardValidatorTx(*txs.RewardValidatorTx) error {
-	return ErrUnsupportedTx
-}
-
-func (*complexityVisitor) TransformSubnetTx(*txs.TransformSubnetTx) error {
-	return ErrUnsupportedTx
-}
-
 func (c *complexityVisitor) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
 	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
 	if err != nil {
@@ -795,6 +938,166 @@ func (c *complexityVisitor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) e
 	return err
 }
 
+func (c *complexityVisitor) ClaimRewardsTx(tx *txs.ClaimRewardsTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	rewardUTXOComplexity, err := RewardUTXOComplexity(tx.RewardUTXOs...)
+	if err != nil {
+		return err
+	}
+	authComplexity, err := AuthComplexity(tx.ClaimAuth)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicClaimRewardsTxComplexities.Add(
+		&baseTxComplexity,
+		&rewardUTXOComplexity,
+		&authComplexity,
+	)
+	return err
+}
+
+func (c *complexityVisitor) SetSubnetValidatorWeightTx(tx *txs.SetSubnetValidatorWeightTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	authComplexity, err := AuthComplexity(tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicSetSubnetValidatorWeightTxComplexities.Add(
+		&baseTxComplexity,
+		&authComplexity,
+	)
+	return err
+}
+
+func (c *complexityVisitor) AddContinuousValidatorTx(tx *txs.AddContinuousValidatorTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	signerComplexity, err := SignerComplexity(tx.Signer)
+	if err != nil {
+		return err
+	}
+	outputsComplexity, err := OutputComplexity(tx.StakeOuts...)
+	if err != nil {
+		return err
+	}
+	validatorOwnerComplexity, err := OwnerComplexity(tx.ValidatorRewardsOwner)
+	if err != nil {
+		return err
+	}
+	delegatorOwnerComplexity, err := OwnerComplexity(tx.DelegatorRewardsOwner)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicAddContinuousValidatorTxComplexities.Add(
+		&baseTxComplexity,
+		&signerComplexity,
+		&outputsComplexity,
+		&validatorOwnerComplexity,
+		&delegatorOwnerComplexity,
+	)
+	return err
+}
+
+func (c *complexityVisitor) StopContinuousValidatorTx(tx *txs.StopContinuousValidatorTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	authComplexity, err := AuthComplexity(tx.StopAuth)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicStopContinuousValidatorTxComplexities.Add(
+		&baseTxComplexity,
+		&authComplexity,
+	)
+	return err
+}
+
+func (c *complexityVisitor) AddMultiDelegatorTx(tx *txs.AddMultiDelegatorTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	delegationComplexity, err := DelegationComplexity(tx.Delegations...)
+	if err != nil {
+		return err
+	}
+	ownerComplexity, err := OwnerComplexity(tx.DelegationRewardsOwner)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicAddMultiDelegatorTxComplexities.Add(
+		&baseTxComplexity,
+		&delegationComplexity,
+		&ownerComplexity,
+	)
+	return err
+}
+
+func (c *complexityVisitor) SetWithdrawalOwnerTx(tx *txs.SetWithdrawalOwnerTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	authComplexity, err := AuthComplexity(tx.OwnerAuth)
+	if err != nil {
+		return err
+	}
+	ownerComplexity, err := OwnerComplexity(tx.WithdrawalOwner)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicSetWithdrawalOwnerTxComplexities.Add(
+		&baseTxComplexity,
+		&authComplexity,
+		&ownerComplexity,
+	)
+	return err
+}
+
+func (c *complexityVisitor) RetireChainTx(tx *txs.RetireChainTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	authComplexity, err := AuthComplexity(tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicRetireChainTxComplexities.Add(
+		&baseTxComplexity,
+		&authComplexity,
+	)
+	return err
+}
+
+func (c *complexityVisitor) ExpiringTx(tx *txs.ExpiringTx) error {
+	wrappedTxComplexity, err := TxComplexity(tx.Tx)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicExpiringTxComplexities.Add(&wrappedTxComplexity)
+	return err
+}
+
+func (c *complexityVisitor) DependentTx(tx *txs.DependentTx) error {
+	wrappedTxComplexity, err := TxComplexity(tx.Tx)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicDependentTxComplexities.Add(&wrappedTxComplexity)
+	return err
+}
+
 func baseTxComplexity(tx *txs.BaseTx) (gas.Dimensions, error) {
 	outputsComplexity, err := OutputComplexity(tx.Outs...)
 	if err != nil {
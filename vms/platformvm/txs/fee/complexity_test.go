@@ -4,7 +4,6 @@
 package fee
 
 import (
-	"encoding/hex"
 	"encoding/json"
 	"testing"
 
@@ -20,19 +19,17 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee/feetest"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 func TestTxComplexity_Individual(t *testing.T) {
-	for _, test := range txTests {
-		t.Run(test.name, func(t *testing.T) {
+	for _, test := range feetest.Vectors {
+		t.Run(test.Name, func(t *testing.T) {
 			require := require.New(t)
 
-			txBytes, err := hex.DecodeString(test.tx)
-			require.NoError(err)
-
-			tx, err := txs.Parse(txs.Codec, txBytes)
+			tx, err := txs.Parse(txs.Codec, test.Tx)
 			require.NoError(err)
 
 			// If the test fails, logging the transaction can be helpful for
@@ -42,13 +39,13 @@ func TestTxComplexity_Individual(t *testing.T) {
 			t.Log(string(txJSON))
 
 			actual, err := TxComplexity(tx.Unsigned)
-			require.Equal(test.expectedComplexity, actual)
-			require.ErrorIs(err, test.expectedComplexityErr)
-			if err != nil {
+			if test.Unsupported {
+				require.ErrorIs(err, ErrUnsupportedTx)
 				return
 			}
-
-			require.Len(txBytes, int(actual[gas.Bandwidth]))
+			require.NoError(err)
+			require.Equal(test.Complexity, actual)
+			require.Len(test.Tx, int(actual[gas.Bandwidth]))
 		})
 	}
 }
@@ -57,22 +54,19 @@ func TestTxComplexity_Batch(t *testing.T) {
 	require := require.New(t)
 
 	var (
-		unsignedTxs        = make([]txs.UnsignedTx, 0, len(txTests))
+		unsignedTxs        = make([]txs.UnsignedTx, 0, len(feetest.Vectors))
 		expectedComplexity gas.Dimensions
 	)
-	for _, test := range txTests {
-		if test.expectedComplexityErr != nil {
+	for _, test := range feetest.Vectors {
+		if test.Unsupported {
 			continue
 		}
 
 		var err error
-		expectedComplexity, err = test.expectedComplexity.Add(&expectedComplexity)
-		require.NoError(err)
-
-		txBytes, err := hex.DecodeString(test.tx)
+		expectedComplexity, err = test.Complexity.Add(&expectedComplexity)
 		require.NoError(err)
 
-		tx, err := txs.Parse(txs.Codec, txBytes)
+		tx, err := txs.Parse(txs.Codec, test.Tx)
 		require.NoError(err)
 
 		unsignedTxs = append(unsignedTxs, tx.Unsigned)
@@ -84,15 +78,10 @@ func TestTxComplexity_Batch(t *testing.T) {
 }
 
 func BenchmarkTxComplexity_Individual(b *testing.B) {
-	for _, test := range txTests {
-		b.Run(test.name, func(b *testing.B) {
-			require := require.New(b)
-
-			txBytes, err := hex.DecodeString(test.tx)
-			require.NoError(err)
-
-			tx, err := txs.Parse(txs.Codec, txBytes)
-			require.NoError(err)
+	for _, test := range feetest.Vectors {
+		b.Run(test.Name, func(b *testing.B) {
+			tx, err := txs.Parse(txs.Codec, test.Tx)
+			require.NoError(b, err)
 
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
@@ -105,16 +94,13 @@ func BenchmarkTxComplexity_Individual(b *testing.B) {
 func BenchmarkTxComplexity_Batch(b *testing.B) {
 	require := require.New(b)
 
-	unsignedTxs := make([]txs.UnsignedTx, 0, len(txTests))
-	for _, test := range txTests {
-		if test.expectedComplexityErr != nil {
+	unsignedTxs := make([]txs.UnsignedTx, 0, len(feetest.Vectors))
+	for _, test := range feetest.Vectors {
+		if test.Unsupported {
 			continue
 		}
 
-		txBytes, err := hex.DecodeString(test.tx)
-		require.NoError(err)
-
-		tx, err := txs.Parse(txs.Codec, txBytes)
+		tx, err := txs.Parse(txs.Codec, test.Tx)
 		require.NoError(err)
 
 		unsignedTxs = append(unsignedTxs, tx.Unsigned)
@@ -143,8 +129,9 @@ func TestOutputComplexity(t *testing.T) {
 				},
 			},
 			expected: gas.Dimensions{
-				gas.Bandwidth: 60,
-				gas.DBWrite:   1,
+				gas.Bandwidth:   60,
+				gas.DBWrite:     1,
+				gas.StateGrowth: 98,
 			},
 			expectedErr: nil,
 		},
@@ -158,8 +145,9 @@ func TestOutputComplexity(t *testing.T) {
 				},
 			},
 			expected: gas.Dimensions{
-				gas.Bandwidth: 80,
-				gas.DBWrite:   1,
+				gas.Bandwidth:   80,
+				gas.DBWrite:     1,
+				gas.StateGrowth: 118,
 			},
 			expectedErr: nil,
 		},
@@ -173,8 +161,9 @@ func TestOutputComplexity(t *testing.T) {
 				},
 			},
 			expected: gas.Dimensions{
-				gas.Bandwidth: 120,
-				gas.DBWrite:   1,
+				gas.Bandwidth:   120,
+				gas.DBWrite:     1,
+				gas.StateGrowth: 158,
 			},
 			expectedErr: nil,
 		},
@@ -190,8 +179,27 @@ func TestOutputComplexity(t *testing.T) {
 				},
 			},
 			expected: gas.Dimensions{
-				gas.Bandwidth: 132,
-				gas.DBWrite:   1,
+				gas.Bandwidth:   132,
+				gas.DBWrite:     1,
+				gas.StateGrowth: 170,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "height locked",
+			out: &avax.TransferableOutput{
+				Out: &secp256k1fx.HeightLockedOutput{
+					TransferOutput: secp256k1fx.TransferOutput{
+						OutputOwners: secp256k1fx.OutputOwners{
+							Addrs: make([]ids.ShortID, 3),
+						},
+					},
+				},
+			},
+			expected: gas.Dimensions{
+				gas.Bandwidth:   128,
+				gas.DBWrite:     1,
+				gas.StateGrowth: 166,
 			},
 			expectedErr: nil,
 		},
@@ -364,9 +372,10 @@ func TestConvertSubnetToL1ValidatorComplexity(t *testing.T) {
 				DeactivationOwner:     message.PChainOwner{},
 			},
 			expected: gas.Dimensions{
-				gas.Bandwidth: 200,
-				gas.DBWrite:   4,
-				gas.Compute:   1050,
+				gas.Bandwidth:   200,
+				gas.DBWrite:     4,
+				gas.Compute:     1050,
+				gas.StateGrowth: 178,
 			},
 		},
 		{
@@ -383,9 +392,10 @@ func TestConvertSubnetToL1ValidatorComplexity(t *testing.T) {
 				DeactivationOwner: message.PChainOwner{},
 			},
 			expected: gas.Dimensions{
-				gas.Bandwidth: 220,
-				gas.DBWrite:   4,
-				gas.Compute:   1050,
+				gas.Bandwidth:   220,
+				gas.DBWrite:     4,
+				gas.Compute:     1050,
+				gas.StateGrowth: 178,
 			},
 		},
 		{
@@ -402,9 +412,10 @@ func TestConvertSubnetToL1ValidatorComplexity(t *testing.T) {
 				},
 			},
 			expected: gas.Dimensions{
-				gas.Bandwidth: 220,
-				gas.DBWrite:   4,
-				gas.Compute:   1050,
+				gas.Bandwidth:   220,
+				gas.DBWrite:     4,
+				gas.Compute:     1050,
+				gas.StateGrowth: 178,
 			},
 		},
 	}
@@ -4,29 +4,87 @@
 package fee
 
 import (
-	"encoding/hex"
+	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee/feetest"
 )
 
 func TestDynamicCalculator(t *testing.T) {
-	calculator := NewDynamicCalculator(testDynamicWeights, testDynamicPrice)
-	for _, test := range txTests {
-		t.Run(test.name, func(t *testing.T) {
+	calculator := NewDynamicCalculator(feetest.Weights, feetest.Price)
+	for _, test := range feetest.Vectors {
+		t.Run(test.Name, func(t *testing.T) {
 			require := require.New(t)
 
-			txBytes, err := hex.DecodeString(test.tx)
-			require.NoError(err)
-
-			tx, err := txs.Parse(txs.Codec, txBytes)
+			tx, err := txs.Parse(txs.Codec, test.Tx)
 			require.NoError(err)
 
 			fee, err := calculator.CalculateFee(tx.Unsigned)
-			require.Equal(int(test.expectedDynamicFee), int(fee))
-			require.ErrorIs(err, test.expectedDynamicFeeErr)
+			if test.Unsupported {
+				require.ErrorIs(err, ErrUnsupportedTx)
+				return
+			}
+			require.NoError(err)
+			require.Equal(test.Fee, fee)
 		})
 	}
 }
+
+func FuzzDynamicCalculator(f *testing.F) {
+	for _, test := range feetest.Vectors {
+		f.Add(test.Tx)
+	}
+	calculator := NewDynamicCalculator(feetest.Weights, feetest.Price)
+	f.Fuzz(func(t *testing.T, txBytes []byte) {
+		require := require.New(t)
+
+		tx, err := txs.Parse(txs.Codec, txBytes)
+		if err != nil {
+			return
+		}
+
+		// Calculating the fee must not panic and must be deterministic.
+		fee, err := calculator.CalculateFee(tx.Unsigned)
+		reFee, reErr := calculator.CalculateFee(tx.Unsigned)
+		require.Equal(err, reErr)
+		require.Equal(fee, reFee)
+	})
+}
+
+func FuzzDynamicCalculatorMonotonicity(f *testing.F) {
+	for i := range feetest.Vectors {
+		f.Add(uint(i), []byte{0})
+	}
+	calculator := NewDynamicCalculator(feetest.Weights, feetest.Price)
+	f.Fuzz(func(t *testing.T, vectorIndex uint, extraMemo []byte) {
+		require := require.New(t)
+
+		vector := feetest.Vectors[vectorIndex%uint(len(feetest.Vectors))]
+		if vector.Unsupported {
+			return
+		}
+		tx, err := txs.Parse(txs.Codec, vector.Tx)
+		require.NoError(err)
+
+		fee, err := calculator.CalculateFee(tx.Unsigned)
+		require.NoError(err)
+
+		// Increasing the size of the tx must not decrease its fee.
+		memo := reflect.ValueOf(tx.Unsigned).Elem().FieldByName("Memo")
+		require.True(memo.IsValid())
+		// The parsed memo may alias the vector, so it isn't modified in place.
+		memo.SetBytes(slices.Concat(memo.Bytes(), extraMemo))
+
+		largerFee, err := calculator.CalculateFee(tx.Unsigned)
+		require.NoError(err)
+		require.GreaterOrEqual(largerFee, fee)
+		if len(extraMemo) > 0 && feetest.Weights[gas.Bandwidth] > 0 {
+			require.Greater(largerFee, fee)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package feetest provides conformance vectors for the P-chain dynamic fee
+// calculation.
+//
+// The vectors are shared by the node and the wallet so that their fee
+// calculations can't drift apart. Any change to the complexity of a tx must
+// update vectors.json.
+package feetest
+
+import (
+	_ "embed"
+
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+)
+
+var (
+	//go:embed vectors.json
+	vectorsJSON []byte
+
+	// Weights are the complexity weights the vectors' fees are calculated
+	// with.
+	Weights gas.Dimensions
+	// Price is the gas price the vectors' fees are calculated with.
+	Price gas.Price
+	// Vectors are signed P-chain txs along with their expected complexity and
+	// fee.
+	Vectors []Vector
+)
+
+func init() {
+	if err := parse(vectorsJSON); err != nil {
+		panic(err)
+	}
+}
+
+type Vector struct {
+	Name string
+	// Tx is the signed tx bytes.
+	Tx []byte
+	// Unsupported is true if the dynamic fee of the tx can't be calculated.
+	Unsupported bool
+	// Complexity is the expected complexity of the tx.
+	Complexity gas.Dimensions
+	// Fee is the expected fee of the tx, calculated with Weights and Price.
+	Fee uint64
+}
+
+type dimensions struct {
+	Bandwidth   uint64 `json:"bandwidth"`
+	DBRead      uint64 `json:"dbRead"`
+	DBWrite     uint64 `json:"dbWrite"`
+	Compute     uint64 `json:"compute"`
+	StateGrowth uint64 `json:"stateGrowth"`
+}
+
+func (d dimensions) toGas() gas.Dimensions {
+	return gas.Dimensions{
+		gas.Bandwidth:   d.Bandwidth,
+		gas.DBRead:      d.DBRead,
+		gas.DBWrite:     d.DBWrite,
+		gas.Compute:     d.Compute,
+		gas.StateGrowth: d.StateGrowth,
+	}
+}
+
+type vectorsFile struct {
+	Weights dimensions `json:"weights"`
+	Price   gas.Price  `json:"price"`
+	Vectors []struct {
+		Name        string     `json:"name"`
+		Tx          string     `json:"tx"`
+		Unsupported bool       `json:"unsupported"`
+		Complexity  dimensions `json:"complexity"`
+		Fee         uint64     `json:"fee"`
+	} `json:"vectors"`
+}
+
+func parse(b []byte) error {
+	var f vectorsFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return fmt.Errorf("failed to parse fee vectors: %w", err)
+	}
+
+	Weights = f.Weights.toGas()
+	Price = f.Price
+	Vectors = make([]Vector, len(f.Vectors))
+	for i, v := range f.Vectors {
+		txBytes, err := hex.DecodeString(v.Tx)
+		if err != nil {
+			return fmt.Errorf("failed to decode tx of fee vector %q: %w", v.Name, err)
+		}
+		Vectors[i] = Vector{
+			Name:        v.Name,
+			Tx:          txBytes,
+			Unsupported: v.Unsupported,
+			Complexity:  v.Complexity.toGas(),
+			Fee:         v.Fee,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+var _ Calculator = (*subnetAuthCalculator)(nil)
+
+// NewSubnetAuthCalculator returns a Calculator that calculates the fee of txs
+// whose only stateful effect is subnet governance with [subnetAuth], and the
+// fee of all other txs with [calculator].
+func NewSubnetAuthCalculator(
+	calculator Calculator,
+	subnetAuth Calculator,
+) Calculator {
+	return &subnetAuthCalculator{
+		calculator: calculator,
+		subnetAuth: subnetAuth,
+	}
+}
+
+type subnetAuthCalculator struct {
+	calculator Calculator
+	subnetAuth Calculator
+}
+
+func (c *subnetAuthCalculator) CalculateFee(tx txs.UnsignedTx) (uint64, error) {
+	if IsSubnetAuthOnly(tx) {
+		return c.subnetAuth.CalculateFee(tx)
+	}
+	return c.calculator.CalculateFee(tx)
+}
+
+// IsSubnetAuthOnly returns true if the only stateful effect of [tx], other
+// than paying its fee, is the governance of a subnet by its owner. Wrapped
+// transactions are classified by the transaction they wrap.
+func IsSubnetAuthOnly(tx txs.UnsignedTx) bool {
+	switch txs.Unwrap(tx).(type) {
+	case *txs.RemoveSubnetValidatorTx, *txs.TransferSubnetOwnershipTx,
+		*txs.SetSubnetValidatorWeightTx, *txs.RetireChainTx:
+		return true
+	default:
+		return false
+	}
+}
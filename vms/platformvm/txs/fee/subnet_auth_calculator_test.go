@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func TestSubnetAuthCalculator(t *testing.T) {
+	const (
+		fee           = 100
+		subnetAuthFee = 10
+	)
+	calculator := NewSubnetAuthCalculator(
+		NewSimpleCalculator(fee),
+		NewSimpleCalculator(subnetAuthFee),
+	)
+
+	tests := []struct {
+		name        string
+		tx          txs.UnsignedTx
+		expectedFee uint64
+	}{
+		{
+			name:        "RemoveSubnetValidatorTx",
+			tx:          &txs.RemoveSubnetValidatorTx{},
+			expectedFee: subnetAuthFee,
+		},
+		{
+			name:        "TransferSubnetOwnershipTx",
+			tx:          &txs.TransferSubnetOwnershipTx{},
+			expectedFee: subnetAuthFee,
+		},
+		{
+			name:        "SetSubnetValidatorWeightTx",
+			tx:          &txs.SetSubnetValidatorWeightTx{},
+			expectedFee: subnetAuthFee,
+		},
+		{
+			name:        "RetireChainTx",
+			tx:          &txs.RetireChainTx{},
+			expectedFee: subnetAuthFee,
+		},
+		{
+			name: "ExpiringTx wrapping RemoveSubnetValidatorTx",
+			tx: &txs.ExpiringTx{
+				Tx: &txs.RemoveSubnetValidatorTx{},
+			},
+			expectedFee: subnetAuthFee,
+		},
+		{
+			name: "DependentTx wrapping ExpiringTx wrapping RetireChainTx",
+			tx: &txs.DependentTx{
+				Tx: &txs.ExpiringTx{
+					Tx: &txs.RetireChainTx{},
+				},
+			},
+			expectedFee: subnetAuthFee,
+		},
+		{
+			name: "ExpiringTx wrapping AddSubnetValidatorTx",
+			tx: &txs.ExpiringTx{
+				Tx: &txs.AddSubnetValidatorTx{},
+			},
+			expectedFee: fee,
+		},
+		{
+			name:        "AddSubnetValidatorTx",
+			tx:          &txs.AddSubnetValidatorTx{},
+			expectedFee: fee,
+		},
+		{
+			name:        "BaseTx",
+			tx:          &txs.BaseTx{},
+			expectedFee: fee,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			fee, err := calculator.CalculateFee(test.tx)
+			require.NoError(err)
+			require.Equal(test.expectedFee, fee)
+		})
+	}
+}
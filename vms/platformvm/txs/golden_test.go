@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/codectest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// TestGoldenTxSerialization ensures that the serialization of every tx type
+// doesn't change unexpectedly. The fee calculator relies on the serialized
+// size of txs, so any change to the serialization must be intentional.
+//
+// To regenerate the golden files after an intentional change, run:
+//
+//	UPDATE_GOLDENS=1 go test ./vms/platformvm/txs -run TestGoldenTxSerialization
+func TestGoldenTxSerialization(t *testing.T) {
+	populator := codectest.NewPopulator(map[interface{}]interface{}{
+		(*avax.TransferableIn)(nil):  &secp256k1fx.TransferInput{},
+		(*avax.TransferableOut)(nil): &secp256k1fx.TransferOutput{},
+		(*fx.Owner)(nil):             &secp256k1fx.OutputOwners{},
+		(*verify.Verifiable)(nil):    &secp256k1fx.Input{},
+		(*signer.Signer)(nil):        &signer.ProofOfPossession{},
+		(*UnsignedTx)(nil):           &BaseTx{},
+	})
+
+	// Every tx type has a corresponding method on the Visitor, so iterating
+	// over the Visitor's methods guarantees that new tx types are covered.
+	visitorType := reflect.TypeOf((*Visitor)(nil)).Elem()
+	for i := 0; i < visitorType.NumMethod(); i++ {
+		method := visitorType.Method(i)
+		t.Run(method.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			utx, ok := reflect.New(method.Type.In(0).Elem()).Interface().(UnsignedTx)
+			require.True(ok)
+			require.NoError(populator.Populate(utx))
+
+			bytes, err := Codec.Marshal(CodecVersion, &utx)
+			require.NoError(err)
+			codectest.RequireGolden(
+				t,
+				filepath.Join("testdata", fmt.Sprintf("v%d", CodecVersion), method.Name+".hex"),
+				bytes,
+			)
+
+			var parsedUTX UnsignedTx
+			_, err = Codec.Unmarshal(bytes, &parsedUTX)
+			require.NoError(err)
+			require.Equal(utx, parsedUTX)
+		})
+	}
+}
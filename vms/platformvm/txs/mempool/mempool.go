@@ -10,6 +10,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 
 	txmempool "github.com/ava-labs/avalanchego/vms/txs/mempool"
 )
@@ -49,6 +50,7 @@ func New(
 	}
 	pool := txmempool.New[*txs.Tx](
 		metrics,
+		limits.Default,
 	)
 	return &mempool{
 		Mempool:  pool,
@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	_ UnsignedTx = (*RetireChainTx)(nil)
+
+	ErrRetirePrimaryNetworkChain = errors.New("can't retire a primary network chain")
+)
+
+// RetireChainTx marks a blockchain of a permissioned subnet as retired. Once
+// retired, nodes stop running the chain, it is no longer created when nodes
+// restart, and its data may be pruned. Retirement can't be undone.
+type RetireChainTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the chain to retire
+	ChainID ids.ID `serialize:"true" json:"blockchainID"`
+	// Proves that the issuer has the right to retire the chain. Must satisfy
+	// the owner of the subnet that validates the chain.
+	SubnetAuth verify.Verifiable `serialize:"true" json:"subnetAuthorization"`
+}
+
+func (tx *RetireChainTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.ChainID == constants.PlatformChainID:
+		return ErrRetirePrimaryNetworkChain
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.SubnetAuth.Verify(); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *RetireChainTx) Visit(visitor Visitor) error {
+	return visitor.RetireChainTx(tx)
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestRetireChainTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+		validSubnetAuth = &secp256k1fx.Input{}
+		chainID         = ids.GenerateTestID()
+	)
+	tests := []struct {
+		name        string
+		tx          *RetireChainTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			tx: &RetireChainTx{
+				BaseTx: BaseTx{
+					SyntacticallyVerified: true,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "P-chain",
+			tx: &RetireChainTx{
+				BaseTx:     validBaseTx,
+				ChainID:    constants.PlatformChainID,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: ErrRetirePrimaryNetworkChain,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &RetireChainTx{
+				BaseTx:     BaseTx{},
+				ChainID:    chainID,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid subnetAuth",
+			tx: &RetireChainTx{
+				BaseTx:  validBaseTx,
+				ChainID: chainID,
+				SubnetAuth: &secp256k1fx.Input{
+					SigIndices: []uint32{1, 0},
+				},
+			},
+			expectedErr: secp256k1fx.ErrInputIndicesNotSortedUnique,
+		},
+		{
+			name: "passes verification",
+			tx: &RetireChainTx{
+				BaseTx:     validBaseTx,
+				ChainID:    chainID,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestSetSubnetValidatorWeightTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+		validSubnetAuth = &secp256k1fx.Input{}
+		subnetID        = ids.GenerateTestID()
+		nodeID          = ids.GenerateTestNodeID()
+	)
+	tests := []struct {
+		name        string
+		tx          *SetSubnetValidatorWeightTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			tx: &SetSubnetValidatorWeightTx{
+				BaseTx: BaseTx{
+					SyntacticallyVerified: true,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "primary network",
+			tx: &SetSubnetValidatorWeightTx{
+				BaseTx:     validBaseTx,
+				NodeID:     nodeID,
+				Subnet:     constants.PrimaryNetworkID,
+				Weight:     1,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: ErrSetPrimaryNetworkValidatorWeight,
+		},
+		{
+			name: "zero weight",
+			tx: &SetSubnetValidatorWeightTx{
+				BaseTx:     validBaseTx,
+				NodeID:     nodeID,
+				Subnet:     subnetID,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: ErrWeightTooSmall,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &SetSubnetValidatorWeightTx{
+				BaseTx:     BaseTx{},
+				NodeID:     nodeID,
+				Subnet:     subnetID,
+				Weight:     1,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid subnetAuth",
+			tx: &SetSubnetValidatorWeightTx{
+				BaseTx: validBaseTx,
+				NodeID: nodeID,
+				Subnet: subnetID,
+				Weight: 1,
+				SubnetAuth: &secp256k1fx.Input{
+					SigIndices: []uint32{1, 0},
+				},
+			},
+			expectedErr: secp256k1fx.ErrInputIndicesNotSortedUnique,
+		},
+		{
+			name: "passes verification",
+			tx: &SetSubnetValidatorWeightTx{
+				BaseTx:     validBaseTx,
+				NodeID:     nodeID,
+				Subnet:     subnetID,
+				Weight:     1,
+				SubnetAuth: validSubnetAuth,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+)
+
+var _ UnsignedTx = (*SetWithdrawalOwnerTx)(nil)
+
+// SetWithdrawalOwnerTx binds a withdrawal owner to a validator. Once bound, the
+// rewards paid out to the validator, including the delegatee rewards, are sent
+// to the withdrawal owner rather than to the rewards owners specified when the
+// validator was added. The stake is still returned to the stake outputs.
+//
+// This allows the keys that manage the validator to be separated from the keys
+// that receive its rewards.
+type SetWithdrawalOwnerTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the tx that added the validator
+	TxID ids.ID `serialize:"true" json:"txID"`
+	// Who the rewards of the validator are paid to
+	WithdrawalOwner fx.Owner `serialize:"true" json:"withdrawalOwner"`
+	// Authorizes the withdrawal owner to be set. Must satisfy the validation
+	// rewards owner of the validator.
+	OwnerAuth verify.Verifiable `serialize:"true" json:"ownerAuthorization"`
+}
+
+func (tx *SetWithdrawalOwnerTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.TxID == ids.Empty:
+		return ErrEmptyValidatorTxID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := verify.All(tx.WithdrawalOwner, tx.OwnerAuth); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *SetWithdrawalOwnerTx) Visit(visitor Visitor) error {
+	return visitor.SetWithdrawalOwnerTx(tx)
+}
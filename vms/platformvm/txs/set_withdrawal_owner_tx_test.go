@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestSetWithdrawalOwnerTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+		validOwner     = &secp256k1fx.OutputOwners{}
+		validOwnerAuth = &secp256k1fx.Input{}
+		txID           = ids.GenerateTestID()
+	)
+	tests := []struct {
+		name        string
+		tx          *SetWithdrawalOwnerTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			tx: &SetWithdrawalOwnerTx{
+				BaseTx: BaseTx{
+					SyntacticallyVerified: true,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "empty txID",
+			tx: &SetWithdrawalOwnerTx{
+				BaseTx:          validBaseTx,
+				WithdrawalOwner: validOwner,
+				OwnerAuth:       validOwnerAuth,
+			},
+			expectedErr: ErrEmptyValidatorTxID,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &SetWithdrawalOwnerTx{
+				BaseTx:          BaseTx{},
+				TxID:            txID,
+				WithdrawalOwner: validOwner,
+				OwnerAuth:       validOwnerAuth,
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid withdrawalOwner",
+			tx: &SetWithdrawalOwnerTx{
+				BaseTx: validBaseTx,
+				TxID:   txID,
+				WithdrawalOwner: &secp256k1fx.OutputOwners{
+					Threshold: 1,
+				},
+				OwnerAuth: validOwnerAuth,
+			},
+			expectedErr: secp256k1fx.ErrOutputUnspendable,
+		},
+		{
+			name: "invalid ownerAuth",
+			tx: &SetWithdrawalOwnerTx{
+				BaseTx:          validBaseTx,
+				TxID:            txID,
+				WithdrawalOwner: validOwner,
+				OwnerAuth: &secp256k1fx.Input{
+					SigIndices: []uint32{1, 0},
+				},
+			},
+			expectedErr: secp256k1fx.ErrInputIndicesNotSortedUnique,
+		},
+		{
+			name: "passes verification",
+			tx: &SetWithdrawalOwnerTx{
+				BaseTx:          validBaseTx,
+				TxID:            txID,
+				WithdrawalOwner: validOwner,
+				OwnerAuth:       validOwnerAuth,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -53,6 +53,12 @@ type Staker interface {
 	CurrentPriority() Priority
 }
 
+// ContinuousStaker is a staker whose staking period is renewed when it ends.
+type ContinuousStaker interface {
+	// ContinuationPeriod is the duration of every renewed staking period.
+	ContinuationPeriod() time.Duration
+}
+
 type ScheduledStaker interface {
 	Staker
 	StartTime() time.Time
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	_ UnsignedTx = (*StopContinuousValidatorTx)(nil)
+
+	ErrEmptyValidatorTxID = errors.New("validator tx ID must be non-empty")
+)
+
+// StopContinuousValidatorTx stops a validator added by an
+// [AddContinuousValidatorTx] from being renewed. The validator leaves the
+// validator set at the end of its current staking period.
+type StopContinuousValidatorTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the tx that added the validator
+	TxID ids.ID `serialize:"true" json:"txID"`
+	// Authorizes this validator to be stopped. Must satisfy the validation
+	// rewards owner of the validator.
+	StopAuth verify.Verifiable `serialize:"true" json:"stopAuthorization"`
+}
+
+func (tx *StopContinuousValidatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case tx.TxID == ids.Empty:
+		return ErrEmptyValidatorTxID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	if err := tx.StopAuth.Verify(); err != nil {
+		return err
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *StopContinuousValidatorTx) Visit(visitor Visitor) error {
+	return visitor.StopContinuousValidatorTx(tx)
+}
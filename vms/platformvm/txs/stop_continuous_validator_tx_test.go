@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestStopContinuousValidatorTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+		validStopAuth = &secp256k1fx.Input{}
+		txID          = ids.GenerateTestID()
+	)
+	tests := []struct {
+		name        string
+		tx          *StopContinuousValidatorTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			tx: &StopContinuousValidatorTx{
+				BaseTx: BaseTx{
+					SyntacticallyVerified: true,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "empty txID",
+			tx: &StopContinuousValidatorTx{
+				BaseTx:   validBaseTx,
+				StopAuth: validStopAuth,
+			},
+			expectedErr: ErrEmptyValidatorTxID,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &StopContinuousValidatorTx{
+				BaseTx:   BaseTx{},
+				TxID:     txID,
+				StopAuth: validStopAuth,
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid stopAuth",
+			tx: &StopContinuousValidatorTx{
+				BaseTx: validBaseTx,
+				TxID:   txID,
+				StopAuth: &secp256k1fx.Input{
+					SigIndices: []uint32{1, 0},
+				},
+			},
+			expectedErr: secp256k1fx.ErrInputIndicesNotSortedUnique,
+		},
+		{
+			name: "passes verification",
+			tx: &StopContinuousValidatorTx{
+				BaseTx:   validBaseTx,
+				TxID:     txID,
+				StopAuth: validStopAuth,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee/feetest"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, vector := range feetest.Vectors {
+		f.Add(vector.Tx)
+	}
+	ctx := snowtest.Context(f, snowtest.PChainID)
+	f.Fuzz(func(t *testing.T, txBytes []byte) {
+		require := require.New(t)
+
+		tx, err := Parse(Codec, txBytes)
+		if err != nil {
+			return
+		}
+		require.Equal(txBytes, tx.Bytes())
+
+		// Parsing is deterministic.
+		reparsedTx, err := Parse(Codec, tx.Bytes())
+		require.NoError(err)
+		require.Equal(tx.ID(), reparsedTx.ID())
+
+		// The stateless checks of the executors must not panic on any tx that
+		// can be parsed.
+		_ = tx.SyntacticVerify(ctx)
+	})
+}
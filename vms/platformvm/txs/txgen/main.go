@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// txgen generates the P-chain tx Visitor interface, along with the visit funcs
+// that every implementation of the Visitor must provide but that don't perform
+// any tx specific logic.
+//
+// The transactions and the visitors are declared in txs.json:
+//
+//   - upgrades lists, in order, the transactions introduced by each network
+//     upgrade. The Visitor interface has a visit func for each of them.
+//   - visitors lists the Visitor implementations that reject, or ignore, most
+//     transactions. A transaction whose stubbedBy includes the id of a visitor
+//     is visited by returning the visitor's return expression.
+//   - metricLabel is the label the accepted transaction is reported with. If
+//     it is empty, the metrics visit func must be written by hand.
+//
+// Any visit func that isn't generated must be implemented by hand, so adding a
+// transaction to txs.json without implementing it everywhere fails to compile.
+// Codec registration, builders and the wallet logic are still written by hand.
+//
+// Usage:
+//
+//	go generate ./vms/platformvm/txs
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+const (
+	header = `// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Code generated by txgen. DO NOT EDIT.
+// source: txs.json
+
+`
+	visitorFile = "visitor.go"
+	txsImport   = "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var (
+	errDuplicateTx          = errors.New("duplicate tx")
+	errDuplicateVisitor     = errors.New("duplicate visitor")
+	errDuplicateMetricLabel = errors.New("duplicate metric label")
+	errUnknownVisitor       = errors.New("unknown visitor")
+)
+
+type Spec struct {
+	// MetricsFile is the file, relative to the spec, that the metrics visit
+	// funcs are written to.
+	MetricsFile string    `json:"metricsFile"`
+	Visitors    []Visitor `json:"visitors"`
+	Upgrades    []Upgrade `json:"upgrades"`
+}
+
+type Visitor struct {
+	ID string `json:"id"`
+	// File is the file, relative to the spec, that the visit funcs are
+	// written to.
+	File     string `json:"file"`
+	Package  string `json:"package"`
+	Receiver string `json:"receiver"`
+	Return   string `json:"return"`
+}
+
+type Upgrade struct {
+	Name string `json:"name"`
+	Txs  []Tx   `json:"txs"`
+}
+
+type Tx struct {
+	Name        string   `json:"name"`
+	MetricLabel string   `json:"metricLabel,omitempty"`
+	StubbedBy   []string `json:"stubbedBy,omitempty"`
+}
+
+func main() {
+	specPath := flag.String("spec", "txs.json", "path to the tx spec")
+	flag.Parse()
+
+	if err := run(*specPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(specPath string) error {
+	spec, err := loadSpec(specPath)
+	if err != nil {
+		return err
+	}
+
+	files, err := generate(spec)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(specPath)
+	for path, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, path), content, 0o600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func loadSpec(path string) (*Spec, error) {
+	specBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec: %w", err)
+	}
+
+	spec := &Spec{}
+	if err := json.Unmarshal(specBytes, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+	return spec, spec.verify()
+}
+
+func (s *Spec) verify() error {
+	visitorIDs := set.NewSet[string](len(s.Visitors))
+	for _, v := range s.Visitors {
+		if visitorIDs.Contains(v.ID) {
+			return fmt.Errorf("%w: %s", errDuplicateVisitor, v.ID)
+		}
+		visitorIDs.Add(v.ID)
+	}
+
+	var (
+		txNames      set.Set[string]
+		metricLabels set.Set[string]
+	)
+	for _, u := range s.Upgrades {
+		for _, tx := range u.Txs {
+			if txNames.Contains(tx.Name) {
+				return fmt.Errorf("%w: %s", errDuplicateTx, tx.Name)
+			}
+			txNames.Add(tx.Name)
+
+			if tx.MetricLabel != "" {
+				if metricLabels.Contains(tx.MetricLabel) {
+					return fmt.Errorf("%w: %s", errDuplicateMetricLabel, tx.MetricLabel)
+				}
+				metricLabels.Add(tx.MetricLabel)
+			}
+
+			for _, id := range tx.StubbedBy {
+				if !visitorIDs.Contains(id) {
+					return fmt.Errorf("%w %q stubbing %s", errUnknownVisitor, id, tx.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// generate returns the content of the generated files, keyed by their path
+// relative to the spec.
+func generate(spec *Spec) (map[string][]byte, error) {
+	files := map[string]*bytes.Buffer{
+		visitorFile:      writeVisitor(spec),
+		spec.MetricsFile: writeMetrics(spec),
+	}
+	for _, v := range spec.Visitors {
+		files[v.File] = writeStubs(spec, v)
+	}
+
+	formatted := make(map[string][]byte, len(files))
+	for path, b := range files {
+		content, err := format.Source(b.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to format %s: %w", path, err)
+		}
+		formatted[path] = content
+	}
+	return formatted, nil
+}
+
+func writeVisitor(spec *Spec) *bytes.Buffer {
+	b := &bytes.Buffer{}
+	b.WriteString(header)
+	b.WriteString("package txs\n\n")
+	b.WriteString("//go:generate go run ./txgen\n\n")
+	b.WriteString("// Allow vm to execute custom logic against the underlying transaction types.\n")
+	b.WriteString("type Visitor interface {\n")
+	for i, u := range spec.Upgrades {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "// %s Transactions:\n", u.Name)
+		for _, tx := range u.Txs {
+			fmt.Fprintf(b, "%s(*%s) error\n", tx.Name, tx.Name)
+		}
+	}
+	b.WriteString("}\n")
+	return b
+}
+
+func writeMetrics(spec *Spec) *bytes.Buffer {
+	b := &bytes.Buffer{}
+	b.WriteString(header)
+	b.WriteString("package metrics\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\"github.com/prometheus/client_golang/prometheus\"\n\n")
+	fmt.Fprintf(b, "%q\n", txsImport)
+	b.WriteString(")\n")
+	for _, u := range spec.Upgrades {
+		for _, tx := range u.Txs {
+			if tx.MetricLabel == "" {
+				continue
+			}
+			fmt.Fprintf(b, "\nfunc (m *txMetrics) %s(*txs.%s) error {\n", tx.Name, tx.Name)
+			b.WriteString("m.numTxs.With(prometheus.Labels{\n")
+			fmt.Fprintf(b, "txLabel: %q,\n", tx.MetricLabel)
+			b.WriteString("}).Inc()\n")
+			b.WriteString("return nil\n")
+			b.WriteString("}\n")
+		}
+	}
+	return b
+}
+
+func writeStubs(spec *Spec, v Visitor) *bytes.Buffer {
+	var txNames []string
+	for _, u := range spec.Upgrades {
+		for _, tx := range u.Txs {
+			if slices.Contains(tx.StubbedBy, v.ID) {
+				txNames = append(txNames, tx.Name)
+			}
+		}
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString(header)
+	fmt.Fprintf(b, "package %s\n", v.Package)
+	if len(txNames) == 0 {
+		return b
+	}
+
+	fmt.Fprintf(b, "\nimport %q\n", txsImport)
+	for _, txName := range txNames {
+		fmt.Fprintf(b, "\nfunc (*%s) %s(*txs.%s) error {\n", v.Receiver, txName, txName)
+		fmt.Fprintf(b, "return %s\n", v.Return)
+		b.WriteString("}\n")
+	}
+	return b
+}
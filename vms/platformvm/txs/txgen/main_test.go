@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const specPath = "../txs.json"
+
+// TestGeneratedFilesUpToDate ensures that the generated files weren't edited by
+// hand and that txs.json wasn't modified without regenerating them.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	require := require.New(t)
+
+	spec, err := loadSpec(specPath)
+	require.NoError(err)
+
+	files, err := generate(spec)
+	require.NoError(err)
+
+	dir := filepath.Dir(specPath)
+	for path, expected := range files {
+		actual, err := os.ReadFile(filepath.Join(dir, path))
+		require.NoError(err)
+		require.Equal(string(expected), string(actual), "%s is out of date, run go generate ./vms/platformvm/txs", path)
+	}
+}
+
+func TestSpecVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        *Spec
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			spec: &Spec{
+				Visitors: []Visitor{{ID: "a"}, {ID: "b"}},
+				Upgrades: []Upgrade{
+					{Txs: []Tx{{Name: "ATx", MetricLabel: "a", StubbedBy: []string{"a"}}}},
+					{Txs: []Tx{{Name: "BTx", StubbedBy: []string{"a", "b"}}}},
+				},
+			},
+		},
+		{
+			name: "duplicate visitor",
+			spec: &Spec{
+				Visitors: []Visitor{{ID: "a"}, {ID: "a"}},
+			},
+			expectedErr: errDuplicateVisitor,
+		},
+		{
+			name: "duplicate tx",
+			spec: &Spec{
+				Upgrades: []Upgrade{
+					{Txs: []Tx{{Name: "ATx"}}},
+					{Txs: []Tx{{Name: "ATx"}}},
+				},
+			},
+			expectedErr: errDuplicateTx,
+		},
+		{
+			name: "duplicate metric label",
+			spec: &Spec{
+				Upgrades: []Upgrade{
+					{Txs: []Tx{{Name: "ATx", MetricLabel: "a"}, {Name: "BTx", MetricLabel: "a"}}},
+				},
+			},
+			expectedErr: errDuplicateMetricLabel,
+		},
+		{
+			name: "unknown visitor",
+			spec: &Spec{
+				Visitors: []Visitor{{ID: "a"}},
+				Upgrades: []Upgrade{
+					{Txs: []Tx{{Name: "ATx", StubbedBy: []string{"b"}}}},
+				},
+			},
+			expectedErr: errUnknownVisitor,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.spec.verify()
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
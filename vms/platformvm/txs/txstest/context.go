@@ -21,7 +21,7 @@ func newContext(
 		AVAXAssetID: ctx.AVAXAssetID,
 	}
 
-	builderContext.ComplexityWeights = config.DynamicFeeConfig.Weights
+	builderContext.ComplexityWeights = config.DynamicFeeWeights(state.GetTimestamp())
 	builderContext.GasPrice = gas.CalculatePrice(
 		config.DynamicFeeConfig.MinPrice,
 		state.GetFeeState().Excess,
@@ -81,6 +81,13 @@ func NewWallet(
 		owner, err := state.GetSubnetOwner(subnetID)
 		require.NoError(err)
 		owners[subnetID] = owner
+
+		// The chains of the subnet are authorized by the subnet owner.
+		chains, err := state.GetChains(subnetID)
+		require.NoError(err)
+		for _, chain := range chains {
+			owners[chain.ID()] = owner
+		}
 	}
 	for _, validationID := range validationIDs {
 		l1Validator, err := state.GetL1Validator(validationID)
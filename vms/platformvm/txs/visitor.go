@@ -1,8 +1,13 @@
 // Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
+// Code generated by txgen. DO NOT EDIT.
+// source: txs.json
+
 package txs
 
+//go:generate go run ./txgen
+
 // Allow vm to execute custom logic against the underlying transaction types.
 type Visitor interface {
 	// Apricot Transactions:
@@ -32,4 +37,15 @@ type Visitor interface {
 	SetL1ValidatorWeightTx(*SetL1ValidatorWeightTx) error
 	IncreaseL1ValidatorBalanceTx(*IncreaseL1ValidatorBalanceTx) error
 	DisableL1ValidatorTx(*DisableL1ValidatorTx) error
+
+	// Fortuna Transactions:
+	ExpiringTx(*ExpiringTx) error
+	DependentTx(*DependentTx) error
+	ClaimRewardsTx(*ClaimRewardsTx) error
+	SetSubnetValidatorWeightTx(*SetSubnetValidatorWeightTx) error
+	AddContinuousValidatorTx(*AddContinuousValidatorTx) error
+	StopContinuousValidatorTx(*StopContinuousValidatorTx) error
+	AddMultiDelegatorTx(*AddMultiDelegatorTx) error
+	SetWithdrawalOwnerTx(*SetWithdrawalOwnerTx) error
+	RetireChainTx(*RetireChainTx) error
 }
@@ -0,0 +1,413 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capacity
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/iterator"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+const (
+	SortByRemainingCapacity SortBy = "remainingCapacity"
+	SortByDelegationFee     SortBy = "delegationFee"
+	SortByEndTime           SortBy = "endTime"
+	SortByUptime            SortBy = "uptime"
+)
+
+var (
+	_ Index = (*index)(nil)
+	_ Index = (*noIndex)(nil)
+
+	ErrIndexDisabled = errors.New("validator capacity index is disabled")
+	ErrUnknownSortBy = errors.New("unknown sort order")
+
+	errNotValidatorTx = errors.New("staker tx is not a validator tx")
+
+	// compare defines the order of each sorted view. Ties are broken by
+	// NodeID so that pagination is stable.
+	compare = map[SortBy]func(a, b *Validator) int{
+		// Most remaining capacity first
+		SortByRemainingCapacity: func(a, b *Validator) int {
+			return cmp.Compare(b.RemainingCapacity, a.RemainingCapacity)
+		},
+		// Cheapest delegation fee first
+		SortByDelegationFee: func(a, b *Validator) int {
+			return cmp.Compare(a.DelegationFee, b.DelegationFee)
+		},
+		// Latest end time first
+		SortByEndTime: func(a, b *Validator) int {
+			return b.EndTime.Compare(a.EndTime)
+		},
+		// Highest uptime first
+		SortByUptime: func(a, b *Validator) int {
+			return cmp.Compare(b.Uptime, a.Uptime)
+		},
+	}
+)
+
+// SortBy is the order in which validators are returned by the index.
+type SortBy string
+
+// Validator describes how much more stake can be delegated to a Primary
+// Network validator.
+type Validator struct {
+	TxID      ids.ID
+	NodeID    ids.NodeID
+	StartTime time.Time
+	EndTime   time.Time
+	// Weight staked by the validator itself
+	Weight uint64
+	// Weight currently delegated to the validator
+	DelegatorWeight uint64
+	// Weight that can still be delegated to the validator until its end time
+	RemainingCapacity uint64
+	// Delegation fee, in shares of [reward.PercentDenominator]
+	DelegationFee uint32
+	// Uptime percentage, in [0, 1], since StartTime as of the last accepted
+	// block
+	Uptime float64
+}
+
+// Index maintains the Primary Network validators ordered by their delegation
+// capacity, delegation fee, end time and uptime.
+//
+// The index is updated as blocks are accepted so that reads don't need to
+// iterate over the staker set.
+type Index interface {
+	// Accept updates the validators touched by [blk].
+	//
+	// Invariant: The changes of [blk] must have already been applied to the
+	// state the index was created with.
+	Accept(blk block.Block) error
+
+	// Validators returns up to [limit] validators, skipping the first
+	// [offset], ordered by [sortBy]. Only validators with at least
+	// [minRemainingDuration] left until their end time are included.
+	Validators(
+		sortBy SortBy,
+		minRemainingDuration time.Duration,
+		offset int,
+		limit int,
+	) ([]Validator, error)
+}
+
+type index struct {
+	state             state.Chain
+	uptimes           uptime.Calculator
+	bootstrapped      *utils.Atomic[bool]
+	maxValidatorStake uint64
+
+	validators map[ids.NodeID]*Validator
+	// nodeIDs of validators that have pending stakers, which will be promoted
+	// as chain time advances.
+	pending set.Set[ids.NodeID]
+	// sorted is only recalculated once bootstrapped. If [stale] is true, it
+	// must be recalculated before being read.
+	sorted map[SortBy][]*Validator
+	stale  bool
+}
+
+// NewIndex returns an index of the Primary Network validators in [chainState].
+func NewIndex(
+	chainState state.Chain,
+	uptimes uptime.Calculator,
+	bootstrapped *utils.Atomic[bool],
+	maxValidatorStake uint64,
+) (Index, error) {
+	i := &index{
+		state:             chainState,
+		uptimes:           uptimes,
+		bootstrapped:      bootstrapped,
+		maxValidatorStake: maxValidatorStake,
+		validators:        make(map[ids.NodeID]*Validator),
+		sorted:            make(map[SortBy][]*Validator),
+		stale:             true,
+	}
+
+	nodeIDs := set.Set[ids.NodeID]{}
+	for _, getIterator := range []func() (iterator.Iterator[*state.Staker], error){
+		i.state.GetCurrentStakerIterator,
+		i.state.GetPendingStakerIterator,
+	} {
+		stakerIterator, err := getIterator()
+		if err != nil {
+			return nil, err
+		}
+		for stakerIterator.Next() {
+			staker := stakerIterator.Value()
+			if staker.SubnetID == constants.PrimaryNetworkID {
+				nodeIDs.Add(staker.NodeID)
+			}
+		}
+		stakerIterator.Release()
+	}
+
+	if err := i.update(nodeIDs); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (i *index) Accept(blk block.Block) error {
+	nodeIDs := set.Set[ids.NodeID]{}
+	nodeIDs.Union(i.pending)
+	for _, tx := range blk.Txs() {
+		if err := i.touchedNodeIDs(tx.Unsigned, nodeIDs); err != nil {
+			return err
+		}
+	}
+
+	if err := i.update(nodeIDs); err != nil {
+		return err
+	}
+
+	if !i.bootstrapped.Get() {
+		return nil
+	}
+	return i.refresh()
+}
+
+func (i *index) Validators(
+	sortBy SortBy,
+	minRemainingDuration time.Duration,
+	offset int,
+	limit int,
+) ([]Validator, error) {
+	if _, ok := compare[sortBy]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSortBy, sortBy)
+	}
+	if i.stale {
+		if err := i.refresh(); err != nil {
+			return nil, err
+		}
+	}
+
+	minEndTime := i.state.GetTimestamp().Add(minRemainingDuration)
+	vdrs := []Validator{}
+	for _, vdr := range i.sorted[sortBy] {
+		if len(vdrs) >= limit {
+			break
+		}
+		if vdr.EndTime.Before(minEndTime) {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		vdrs = append(vdrs, *vdr)
+	}
+	return vdrs, nil
+}
+
+// touchedNodeIDs adds the Primary Network validators whose stake may have been
+// modified by [tx] into [nodeIDs].
+func (i *index) touchedNodeIDs(tx txs.UnsignedTx, nodeIDs set.Set[ids.NodeID]) error {
+	var stakerTxID ids.ID
+	switch tx := tx.(type) {
+	case txs.Staker:
+		if tx.SubnetID() == constants.PrimaryNetworkID {
+			nodeIDs.Add(tx.NodeID())
+		}
+		return nil
+	case *txs.AddMultiDelegatorTx:
+		for _, delegation := range tx.Delegations {
+			nodeIDs.Add(delegation.NodeID)
+		}
+		return nil
+	case *txs.RewardValidatorTx:
+		stakerTxID = tx.TxID
+	case *txs.StopContinuousValidatorTx:
+		stakerTxID = tx.TxID
+	default:
+		return nil
+	}
+
+	staker, err := i.state.GetStakerTx(stakerTxID)
+	if err != nil {
+		return fmt.Errorf("failed to get staker %s: %w", stakerTxID, err)
+	}
+	if staker.SubnetID() == constants.PrimaryNetworkID {
+		nodeIDs.Add(staker.NodeID())
+	}
+	return nil
+}
+
+// update recalculates the stake of the validators in [nodeIDs].
+func (i *index) update(nodeIDs set.Set[ids.NodeID]) error {
+	for nodeID := range nodeIDs {
+		hasPending, err := i.hasPendingStakers(nodeID)
+		if err != nil {
+			return err
+		}
+		if hasPending {
+			i.pending.Add(nodeID)
+		} else {
+			i.pending.Remove(nodeID)
+		}
+
+		vdr, err := i.getValidator(nodeID)
+		if err != nil {
+			return err
+		}
+		if vdr == nil {
+			delete(i.validators, nodeID)
+		} else {
+			i.validators[nodeID] = vdr
+		}
+	}
+	i.stale = i.stale || nodeIDs.Len() > 0
+	return nil
+}
+
+func (i *index) hasPendingStakers(nodeID ids.NodeID) (bool, error) {
+	_, err := i.state.GetPendingValidator(constants.PrimaryNetworkID, nodeID)
+	switch {
+	case err == nil:
+		return true, nil
+	case err != database.ErrNotFound:
+		return false, err
+	}
+
+	delegatorIterator, err := i.state.GetPendingDelegatorIterator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return false, err
+	}
+	defer delegatorIterator.Release()
+
+	return delegatorIterator.Next(), nil
+}
+
+// getValidator returns the current capacity of the Primary Network validator
+// [nodeID], or nil if [nodeID] isn't currently validating.
+func (i *index) getValidator(nodeID ids.NodeID) (*Validator, error) {
+	staker, err := i.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stakerTx, err := i.state.GetStakerTx(staker.TxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staker %s: %w", staker.TxID, err)
+	}
+	validatorTx, ok := stakerTx.(txs.ValidatorTx)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errNotValidatorTx, staker.TxID)
+	}
+
+	delegatorIterator, err := i.state.GetCurrentDelegatorIterator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	var delegatorWeight uint64
+	for delegatorIterator.Next() {
+		delegatorWeight, err = safemath.Add(delegatorWeight, delegatorIterator.Value().Weight)
+		if err != nil {
+			delegatorIterator.Release()
+			return nil, err
+		}
+	}
+	delegatorIterator.Release()
+
+	vdr := &Validator{
+		TxID:            staker.TxID,
+		NodeID:          nodeID,
+		StartTime:       staker.StartTime,
+		EndTime:         staker.EndTime,
+		Weight:          staker.Weight,
+		DelegatorWeight: delegatorWeight,
+		DelegationFee:   validatorTx.Shares(),
+	}
+	if existing, ok := i.validators[nodeID]; ok && existing.TxID == staker.TxID {
+		vdr.Uptime = existing.Uptime
+	}
+
+	currentTime := i.state.GetTimestamp()
+	if !currentTime.Before(staker.EndTime) {
+		// The validator is waiting to be rewarded, so no more stake can be
+		// delegated to it.
+		return vdr, nil
+	}
+
+	maxWeight, err := safemath.Mul(executor.MaxValidatorWeightFactor, staker.Weight)
+	if err != nil {
+		maxWeight = math.MaxUint64
+	}
+	maxWeight = min(maxWeight, i.maxValidatorStake)
+
+	// Delegations made now must last at most until the validator's end time,
+	// so this is the maximum weight the validator could reach while still
+	// accepting a new delegation.
+	stakedWeight, err := executor.GetMaxWeight(i.state, staker, currentTime, staker.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if maxWeight > stakedWeight {
+		vdr.RemainingCapacity = maxWeight - stakedWeight
+	}
+	return vdr, nil
+}
+
+// refresh recalculates the uptimes of all the validators and the sorted views.
+func (i *index) refresh() error {
+	vdrs := make([]*Validator, 0, len(i.validators))
+	for nodeID, vdr := range i.validators {
+		uptimePercent, err := i.uptimes.CalculateUptimePercentFrom(nodeID, vdr.StartTime)
+		if err != nil {
+			return fmt.Errorf("failed to calculate uptime of %s: %w", nodeID, err)
+		}
+		vdr.Uptime = uptimePercent
+		vdrs = append(vdrs, vdr)
+	}
+
+	for sortBy, compare := range compare {
+		sorted := slices.Clone(vdrs)
+		slices.SortFunc(sorted, func(a, b *Validator) int {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+			return a.NodeID.Compare(b.NodeID)
+		})
+		i.sorted[sortBy] = sorted
+	}
+	i.stale = false
+	return nil
+}
+
+// NewNoIndex returns an index that fails all reads. It is used when the
+// validator capacity index is disabled.
+func NewNoIndex() Index {
+	return noIndex{}
+}
+
+type noIndex struct{}
+
+func (noIndex) Accept(block.Block) error {
+	return nil
+}
+
+func (noIndex) Validators(SortBy, time.Duration, int, int) ([]Validator, error) {
+	return nil, ErrIndexDisabled
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package capacity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state/statetest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const maxLimit = 1024
+
+func TestIndex(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		nodeIDs = []ids.NodeID{
+			ids.BuildTestNodeID([]byte{0}),
+			ids.BuildTestNodeID([]byte{1}),
+			ids.BuildTestNodeID([]byte{2}),
+		}
+		weight    = genesistest.DefaultValidatorWeight
+		startTime = genesistest.DefaultValidatorStartTime
+		endTime   = genesistest.DefaultValidatorEndTime
+	)
+	s := statetest.New(t, statetest.Config{
+		Genesis: genesistest.NewBytes(t, genesistest.Config{
+			NodeIDs: nodeIDs,
+		}),
+	})
+
+	clk := &mockable.Clock{}
+	clk.Set(startTime.Add(10 * time.Second))
+	uptimes := uptime.NewManager(s, clk)
+	require.NoError(uptimes.StartTracking(nodeIDs))
+	require.NoError(uptimes.Connect(nodeIDs[2]))
+	clk.Set(startTime.Add(20 * time.Second))
+
+	bootstrapped := &utils.Atomic[bool]{}
+	bootstrapped.Set(true)
+	index, err := NewIndex(s, uptimes, bootstrapped, units.KiloAvax)
+	require.NoError(err)
+
+	validators := make(map[ids.NodeID]ids.ID)
+	for _, nodeID := range nodeIDs {
+		vdr, err := s.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+		require.NoError(err)
+		validators[nodeID] = vdr.TxID
+	}
+	expectedValidator := func(nodeID ids.NodeID, delegatorWeight uint64, uptime float64) Validator {
+		return Validator{
+			TxID:              validators[nodeID],
+			NodeID:            nodeID,
+			StartTime:         startTime,
+			EndTime:           endTime,
+			Weight:            weight,
+			DelegatorWeight:   delegatorWeight,
+			RemainingCapacity: 4*weight - delegatorWeight,
+			DelegationFee:     genesistest.ValidatorDelegationShares,
+			Uptime:            uptime,
+		}
+	}
+	checkValidators := func(
+		sortBy SortBy,
+		minRemainingDuration time.Duration,
+		offset int,
+		limit int,
+		expected []Validator,
+	) {
+		t.Helper()
+
+		vdrs, err := index.Validators(sortBy, minRemainingDuration, offset, limit)
+		require.NoError(err)
+		require.Len(vdrs, len(expected))
+		for i, vdr := range vdrs {
+			// Make sure the times are compared by value.
+			vdr.StartTime = time.Unix(vdr.StartTime.Unix(), 0)
+			vdr.EndTime = time.Unix(vdr.EndTime.Unix(), 0)
+			expected[i].StartTime = time.Unix(expected[i].StartTime.Unix(), 0)
+			expected[i].EndTime = time.Unix(expected[i].EndTime.Unix(), 0)
+			require.Equal(expected[i], vdr)
+		}
+	}
+
+	// Only nodeIDs[2] was connected after tracking started.
+	checkValidators(SortByUptime, 0, 0, maxLimit, []Validator{
+		expectedValidator(nodeIDs[2], 0, 1),
+		expectedValidator(nodeIDs[0], 0, .5),
+		expectedValidator(nodeIDs[1], 0, .5),
+	})
+
+	// Delegate to nodeIDs[0].
+	delegatorTx := &txs.Tx{
+		Unsigned: &txs.AddDelegatorTx{
+			Validator: txs.Validator{
+				NodeID: nodeIDs[0],
+				Start:  uint64(startTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   weight,
+			},
+			DelegationRewardsOwner: &secp256k1fx.OutputOwners{},
+		},
+	}
+	require.NoError(delegatorTx.Initialize(txs.Codec))
+	delegator, err := state.NewCurrentStaker(
+		delegatorTx.ID(),
+		delegatorTx.Unsigned.(txs.Staker),
+		startTime,
+		0,
+	)
+	require.NoError(err)
+	s.PutCurrentDelegator(delegator)
+	s.AddTx(delegatorTx, 0)
+
+	blk, err := block.NewBanffStandardBlock(startTime, ids.GenerateTestID(), 1, []*txs.Tx{delegatorTx})
+	require.NoError(err)
+	require.NoError(index.Accept(blk))
+
+	checkValidators(SortByRemainingCapacity, 0, 0, maxLimit, []Validator{
+		expectedValidator(nodeIDs[1], 0, .5),
+		expectedValidator(nodeIDs[2], 0, 1),
+		expectedValidator(nodeIDs[0], weight, .5),
+	})
+
+	// Pagination
+	checkValidators(SortByRemainingCapacity, 0, 1, 1, []Validator{
+		expectedValidator(nodeIDs[2], 0, 1),
+	})
+	checkValidators(SortByRemainingCapacity, 0, 3, maxLimit, nil)
+
+	// Filtering by remaining duration
+	remainingDuration := endTime.Sub(s.GetTimestamp())
+	checkValidators(SortByEndTime, remainingDuration, 0, maxLimit, []Validator{
+		expectedValidator(nodeIDs[0], weight, .5),
+		expectedValidator(nodeIDs[1], 0, .5),
+		expectedValidator(nodeIDs[2], 0, 1),
+	})
+	checkValidators(SortByEndTime, remainingDuration+time.Second, 0, maxLimit, nil)
+
+	// Remove nodeIDs[1].
+	vdr, err := s.GetCurrentValidator(constants.PrimaryNetworkID, nodeIDs[1])
+	require.NoError(err)
+	s.DeleteCurrentValidator(vdr)
+
+	blk, err = block.NewBanffStandardBlock(startTime, blk.ID(), 2, []*txs.Tx{
+		{
+			Unsigned: &txs.RewardValidatorTx{
+				TxID: vdr.TxID,
+			},
+		},
+	})
+	require.NoError(err)
+	require.NoError(index.Accept(blk))
+
+	checkValidators(SortByDelegationFee, 0, 0, maxLimit, []Validator{
+		expectedValidator(nodeIDs[0], weight, .5),
+		expectedValidator(nodeIDs[2], 0, 1),
+	})
+
+	_, err = index.Validators("unknown", 0, 0, maxLimit)
+	require.ErrorIs(err, ErrUnknownSortBy)
+}
+
+func TestNoIndex(t *testing.T) {
+	require := require.New(t)
+
+	index := NewNoIndex()
+	require.NoError(index.Accept(nil))
+
+	_, err := index.Validators(SortByRemainingCapacity, 0, 0, maxLimit)
+	require.ErrorIs(err, ErrIndexDisabled)
+}
@@ -8,9 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
 	"time"
 
 	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -44,6 +46,10 @@ const (
 var (
 	_ validators.State = (*manager)(nil)
 
+	// ErrPrunedHeight is returned when the validator set at a height can't be
+	// generated because the validator diffs of that height were pruned.
+	ErrPrunedHeight = errors.New("failed to fetch validator set at pruned height")
+
 	errUnfinalizedHeight = errors.New("failed to fetch validator set at unfinalized height")
 )
 
@@ -52,9 +58,24 @@ var (
 type Manager interface {
 	validators.State
 
+	// GetValidatorSets returns the validator sets of [subnetID] at each of
+	// [heights]. The validator diffs are iterated over once from the highest
+	// height to the lowest, which is cheaper than generating each validator
+	// set independently.
+	GetValidatorSets(
+		ctx context.Context,
+		heights []uint64,
+		subnetID ids.ID,
+	) (map[uint64]map[ids.NodeID]*validators.GetValidatorOutput, error)
+
 	// OnAcceptedBlockID registers the ID of the latest accepted block.
 	// It is used to update the [recentlyAccepted] sliding window.
 	OnAcceptedBlockID(blkID ids.ID)
+
+	// GetDeepestQueries returns at most [limit] of the recently created
+	// validator sets that required the validator diffs of the most heights to
+	// be applied, deepest first.
+	GetDeepestQueries(limit int) []Query
 }
 
 type State interface {
@@ -99,6 +120,19 @@ type State interface {
 		subnetID ids.ID,
 	) error
 
+	// GetValidatorSetSnapshot returns the first snapshot of the validator set
+	// of [subnetID] taken at or after [height], along with the height the
+	// snapshot was taken at. If there is no such snapshot,
+	// [database.ErrNotFound] is returned.
+	GetValidatorSetSnapshot(
+		subnetID ids.ID,
+		height uint64,
+	) (uint64, map[ids.NodeID]*validators.GetValidatorOutput, error)
+
+	// GetPrunedValidatorDiffsHeight returns the height at and below which the
+	// validator diffs may have been pruned.
+	GetPrunedValidatorDiffsHeight() uint64
+
 	GetCurrentValidators(ctx context.Context, subnetID ids.ID) ([]*state.Staker, []state.L1Validator, uint64, error)
 }
 
@@ -143,6 +177,9 @@ type manager struct {
 
 	// sliding window of blocks that were recently accepted
 	recentlyAccepted window.Window[ids.ID]
+
+	// validator sets that were recently created
+	recentQueries recentQueries
 }
 
 // GetMinimumHeight returns the height of the most recent block beyond the
@@ -214,7 +251,7 @@ func (m *manager) GetValidatorSet(
 	// get the start time to track metrics
 	startTime := m.clk.Time()
 
-	validatorSet, currentHeight, err := m.makeValidatorSet(ctx, targetHeight, subnetID)
+	validatorSet, startHeight, currentHeight, err := m.makeValidatorSet(ctx, targetHeight, subnetID)
 	if err != nil {
 		return nil, err
 	}
@@ -226,9 +263,100 @@ func (m *manager) GetValidatorSet(
 	m.metrics.IncValidatorSetsCreated()
 	m.metrics.AddValidatorSetsDuration(duration)
 	m.metrics.AddValidatorSetsHeightDiff(currentHeight - targetHeight)
+	m.recordQuery(ctx, Query{
+		SubnetID:      subnetID,
+		Height:        targetHeight,
+		CurrentHeight: currentHeight,
+		StartHeight:   startHeight,
+		Timestamp:     startTime,
+		Duration:      duration,
+	})
 	return maps.Clone(validatorSet), nil
 }
 
+func (m *manager) GetValidatorSets(
+	ctx context.Context,
+	heights []uint64,
+	subnetID ids.ID,
+) (map[uint64]map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	var (
+		validatorSetsCache = m.getValidatorSetCache(subnetID)
+		validatorSets      = make(map[uint64]map[ids.NodeID]*validators.GetValidatorOutput, len(heights))
+		missingHeights     []uint64
+	)
+	for _, height := range heights {
+		if validatorSet, ok := validatorSetsCache.Get(height); ok {
+			m.metrics.IncValidatorSetsCached()
+			validatorSets[height] = maps.Clone(validatorSet)
+			continue
+		}
+		missingHeights = append(missingHeights, height)
+	}
+	if len(missingHeights) == 0 {
+		return validatorSets, nil
+	}
+
+	// The validator sets are generated from the highest height to the lowest
+	// so that each diff is applied at most once.
+	slices.Sort(missingHeights)
+	slices.Reverse(missingHeights)
+	missingHeights = slices.Compact(missingHeights)
+
+	// get the start time to track metrics
+	startTime := m.clk.Time()
+
+	validatorSet, startHeight, currentHeight, err := m.getStartingValidatorSet(ctx, missingHeights[0], subnetID)
+	if err != nil {
+		return nil, err
+	}
+	heightStartTime := startTime
+	for _, height := range missingHeights {
+		snapshot, snapshotHeight, ok, err := m.getSnapshotBefore(subnetID, height, startHeight)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			validatorSet = snapshot
+			startHeight = snapshotHeight
+		}
+
+		if err := m.applyValidatorDiffs(ctx, validatorSet, startHeight, height, subnetID); err != nil {
+			return nil, err
+		}
+
+		now := m.clk.Time()
+		m.recordQuery(ctx, Query{
+			SubnetID:      subnetID,
+			Height:        height,
+			CurrentHeight: currentHeight,
+			StartHeight:   startHeight,
+			Timestamp:     heightStartTime,
+			Duration:      now.Sub(heightStartTime),
+		})
+		heightStartTime = now
+		startHeight = height
+
+		// Applying the diffs of the next height modifies the validators, so
+		// the validator set at this height must be copied.
+		heightValidatorSet := make(map[ids.NodeID]*validators.GetValidatorOutput, len(validatorSet))
+		for nodeID, vdr := range validatorSet {
+			vdrCopy := *vdr
+			heightValidatorSet[nodeID] = &vdrCopy
+		}
+
+		// cache the validator set
+		validatorSetsCache.Put(height, heightValidatorSet)
+		validatorSets[height] = maps.Clone(heightValidatorSet)
+
+		m.metrics.IncValidatorSetsCreated()
+		m.metrics.AddValidatorSetsHeightDiff(currentHeight - height)
+	}
+
+	duration := m.clk.Time().Sub(startTime)
+	m.metrics.AddValidatorSetsDuration(duration)
+	return validatorSets, nil
+}
+
 func (m *manager) getValidatorSetCache(subnetID ids.ID) cache.Cacher[uint64, map[ids.NodeID]*validators.GetValidatorOutput] {
 	// Only cache tracked subnets
 	if subnetID != constants.PrimaryNetworkID && !m.cfg.TrackedSubnets.Contains(subnetID) {
@@ -247,17 +375,44 @@ func (m *manager) getValidatorSetCache(subnetID ids.ID) cache.Cacher[uint64, map
 	return validatorSetsCache
 }
 
+// makeValidatorSet returns the validator set at [targetHeight], along with the
+// height of the validator set that it was created from and the current height.
 func (m *manager) makeValidatorSet(
 	ctx context.Context,
 	targetHeight uint64,
 	subnetID ids.ID,
-) (map[ids.NodeID]*validators.GetValidatorOutput, uint64, error) {
+) (map[ids.NodeID]*validators.GetValidatorOutput, uint64, uint64, error) {
+	validatorSet, startHeight, currentHeight, err := m.getStartingValidatorSet(ctx, targetHeight, subnetID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	err = m.applyValidatorDiffs(ctx, validatorSet, startHeight, targetHeight, subnetID)
+	return validatorSet, startHeight, currentHeight, err
+}
+
+// recordQuery remembers the creation of the validator set described by [q],
+// attributed to the caller of [ctx].
+func (m *manager) recordQuery(ctx context.Context, q Query) {
+	q.Caller = validators.GetCaller(ctx)
+	m.metrics.ObserveValidatorSetCreated(q.HeightsWalked(), q.Duration)
+	m.recentQueries.add(q)
+}
+
+// getStartingValidatorSet returns the validator set that is the closest to
+// [targetHeight] without being below it, along with its height and the current
+// height.
+func (m *manager) getStartingValidatorSet(
+	ctx context.Context,
+	targetHeight uint64,
+	subnetID ids.ID,
+) (map[ids.NodeID]*validators.GetValidatorOutput, uint64, uint64, error) {
 	validatorSet, currentHeight, err := m.getCurrentValidatorSet(ctx, subnetID)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, 0, err
 	}
 	if currentHeight < targetHeight {
-		return nil, 0, fmt.Errorf("%w with SubnetID = %s: current P-chain height (%d) < requested P-Chain height (%d)",
+		return nil, 0, 0, fmt.Errorf("%w with SubnetID = %s: current P-chain height (%d) < requested P-Chain height (%d)",
 			errUnfinalizedHeight,
 			subnetID,
 			currentHeight,
@@ -265,32 +420,83 @@ func (m *manager) makeValidatorSet(
 		)
 	}
 
-	// Rebuild subnet validators at [targetHeight]
-	//
+	// If there is a snapshot between [targetHeight] and [currentHeight], fewer
+	// diffs need to be applied by starting from the snapshot.
+	snapshot, snapshotHeight, ok, err := m.getSnapshotBefore(subnetID, targetHeight, currentHeight)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if ok {
+		return snapshot, snapshotHeight, currentHeight, nil
+	}
+	return validatorSet, currentHeight, currentHeight, nil
+}
+
+// getSnapshotBefore returns the first snapshot of the validator set of
+// [subnetID] taken at or after [targetHeight], if it was taken before
+// [maxHeight].
+func (m *manager) getSnapshotBefore(
+	subnetID ids.ID,
+	targetHeight uint64,
+	maxHeight uint64,
+) (map[ids.NodeID]*validators.GetValidatorOutput, uint64, bool, error) {
+	snapshotHeight, snapshot, err := m.state.GetValidatorSetSnapshot(subnetID, targetHeight)
+	switch {
+	case err == database.ErrNotFound:
+		return nil, 0, false, nil
+	case err != nil:
+		return nil, 0, false, err
+	default:
+		return snapshot, snapshotHeight, snapshotHeight < maxHeight, nil
+	}
+}
+
+// applyValidatorDiffs rebuilds [validatorSet] at [targetHeight] from the
+// validator set at [startHeight].
+func (m *manager) applyValidatorDiffs(
+	ctx context.Context,
+	validatorSet map[ids.NodeID]*validators.GetValidatorOutput,
+	startHeight uint64,
+	targetHeight uint64,
+	subnetID ids.ID,
+) error {
+	if startHeight == targetHeight {
+		return nil
+	}
+
+	prunedHeight := m.state.GetPrunedValidatorDiffsHeight()
+	if targetHeight < prunedHeight {
+		return fmt.Errorf("%w with SubnetID = %s: requested P-Chain height (%d) < pruned P-Chain height (%d)",
+			ErrPrunedHeight,
+			subnetID,
+			targetHeight,
+			prunedHeight,
+		)
+	}
+
 	// Note: Since we are attempting to generate the validator set at
 	// [targetHeight], we want to apply the diffs from
-	// (targetHeight, currentHeight]. Because the state interface is implemented
-	// to be inclusive, we apply diffs in [targetHeight + 1, currentHeight].
+	// (targetHeight, startHeight]. Because the state interface is implemented
+	// to be inclusive, we apply diffs in [targetHeight + 1, startHeight].
 	lastDiffHeight := targetHeight + 1
-	err = m.state.ApplyValidatorWeightDiffs(
+	err := m.state.ApplyValidatorWeightDiffs(
 		ctx,
 		validatorSet,
-		currentHeight,
+		startHeight,
 		lastDiffHeight,
 		subnetID,
 	)
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
 
-	err = m.state.ApplyValidatorPublicKeyDiffs(
+	return m.state.ApplyValidatorPublicKeyDiffs(
 		ctx,
 		validatorSet,
-		currentHeight,
+		startHeight,
 		lastDiffHeight,
 		subnetID,
 	)
-	return validatorSet, currentHeight, err
 }
 
 func (m *manager) getCurrentValidatorSet(
@@ -326,6 +532,10 @@ func (m *manager) OnAcceptedBlockID(blkID ids.ID) {
 	m.recentlyAccepted.Add(blkID)
 }
 
+func (m *manager) GetDeepestQueries(limit int) []Query {
+	return m.recentQueries.deepest(limit)
+}
+
 func (m *manager) GetCurrentValidatorSet(ctx context.Context, subnetID ids.ID) (map[ids.ID]*validators.GetCurrentValidatorOutput, uint64, error) {
 	result := make(map[ids.ID]*validators.GetCurrentValidatorOutput)
 	baseStakers, l1Validators, height, err := m.state.GetCurrentValidators(ctx, subnetID)
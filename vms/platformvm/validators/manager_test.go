@@ -5,11 +5,14 @@ package validators_test
 
 import (
 	"context"
+	"maps"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
@@ -125,3 +128,261 @@ func TestGetValidatorSet_AfterEtna(t *testing.T) {
 		require.Equal(expected, actual)
 	}
 }
+
+func TestGetValidatorSet_Snapshots(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := validators.NewManager()
+	cfg := config.Default
+	cfg.ValidatorSetSnapshotInterval = 2
+	s := statetest.New(t, statetest.Config{
+		Validators: vdrs,
+		Config:     cfg,
+	})
+
+	var (
+		subnetID  = ids.GenerateTestID()
+		startTime = genesistest.DefaultValidatorStartTime
+		stakers   = make([]*state.Staker, 3)
+	)
+	for i := range stakers {
+		stakers[i] = &state.Staker{
+			TxID:      ids.GenerateTestID(),
+			NodeID:    genesistest.DefaultNodeIDs[i],
+			SubnetID:  subnetID,
+			Weight:    uint64(i + 1),
+			StartTime: startTime,
+			EndTime:   startTime.Add(24 * time.Hour),
+		}
+	}
+
+	s.AddSubnet(subnetID)
+
+	// Add a subnet staker at every height
+	for i, staker := range stakers {
+		blk, err := block.NewBanffStandardBlock(s.GetTimestamp(), s.GetLastAccepted(), uint64(i+1), nil)
+		require.NoError(err)
+
+		s.SetHeight(blk.Height())
+		s.AddStatelessBlock(blk)
+		s.SetLastAccepted(blk.ID())
+
+		require.NoError(s.PutCurrentValidator(staker))
+		require.NoError(s.Commit())
+	}
+
+	snapshotHeight, _, err := s.GetValidatorSetSnapshot(subnetID, 1)
+	require.NoError(err)
+	require.Equal(uint64(2), snapshotHeight)
+
+	m := NewManager(
+		logging.NoLog{},
+		config.Internal{
+			Validators: vdrs,
+		},
+		s,
+		metrics.Noop,
+		new(mockable.Clock),
+	)
+
+	var (
+		expected     = map[ids.NodeID]*validators.GetValidatorOutput{}
+		expectedSets = make(map[uint64]map[ids.NodeID]*validators.GetValidatorOutput)
+		heights      []uint64
+	)
+	for height := range len(stakers) + 1 {
+		actual, err := m.GetValidatorSet(context.Background(), uint64(height), subnetID)
+		require.NoError(err)
+		require.Equal(expected, actual)
+
+		expectedSets[uint64(height)] = maps.Clone(expected)
+		heights = append(heights, uint64(height))
+		if height < len(stakers) {
+			staker := stakers[height]
+			expected[staker.NodeID] = &validators.GetValidatorOutput{
+				NodeID: staker.NodeID,
+				Weight: staker.Weight,
+			}
+		}
+	}
+
+	// Generating the validator sets at once must return the same validator
+	// sets, regardless of the order of the heights.
+	m = NewManager(
+		logging.NoLog{},
+		config.Internal{
+			Validators: vdrs,
+		},
+		s,
+		metrics.Noop,
+		new(mockable.Clock),
+	)
+	heights = append(heights, 1, 0)
+	actualSets, err := m.GetValidatorSets(context.Background(), heights, subnetID)
+	require.NoError(err)
+	require.Equal(expectedSets, actualSets)
+
+	// The validator sets must be cached.
+	for height, expected := range expectedSets {
+		actual, err := m.GetValidatorSet(context.Background(), height, subnetID)
+		require.NoError(err)
+		require.Equal(expected, actual)
+	}
+}
+
+func TestGetValidatorSet_PrunedHeight(t *testing.T) {
+	const (
+		currentHeight = 10
+		prunedHeight  = 5
+	)
+	var (
+		subnetID = ids.GenerateTestID()
+		snapshot = map[ids.NodeID]*validators.GetValidatorOutput{
+			ids.GenerateTestNodeID(): {
+				Weight: 1,
+			},
+		}
+	)
+	tests := []struct {
+		name                string
+		targetHeight        uint64
+		snapshotHeight      uint64
+		snapshotErr         error
+		expectedStartHeight uint64
+		expectedErr         error
+	}{
+		{
+			name:                "not pruned",
+			targetHeight:        prunedHeight,
+			snapshotErr:         database.ErrNotFound,
+			expectedStartHeight: currentHeight,
+		},
+		{
+			name:         "pruned",
+			targetHeight: prunedHeight - 1,
+			snapshotErr:  database.ErrNotFound,
+			expectedErr:  ErrPrunedHeight,
+		},
+		{
+			name:                "snapshot at pruned height",
+			targetHeight:        prunedHeight - 1,
+			snapshotHeight:      prunedHeight - 1,
+			expectedStartHeight: prunedHeight - 1,
+		},
+		{
+			name:           "snapshot after pruned height",
+			targetHeight:   prunedHeight - 1,
+			snapshotHeight: prunedHeight + 1,
+			expectedErr:    ErrPrunedHeight,
+		},
+		{
+			name:                "snapshot after target height",
+			targetHeight:        prunedHeight + 1,
+			snapshotHeight:      prunedHeight + 2,
+			expectedStartHeight: prunedHeight + 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			blk, err := block.NewBanffStandardBlock(time.Time{}, ids.Empty, currentHeight, nil)
+			require.NoError(err)
+
+			s := state.NewMockState(ctrl)
+			s.EXPECT().GetLastAccepted().Return(blk.ID())
+			s.EXPECT().GetStatelessBlock(blk.ID()).Return(blk, nil)
+			s.EXPECT().GetValidatorSetSnapshot(subnetID, test.targetHeight).Return(test.snapshotHeight, snapshot, test.snapshotErr)
+			s.EXPECT().GetPrunedValidatorDiffsHeight().Return(uint64(prunedHeight)).AnyTimes()
+			if test.expectedErr == nil && test.expectedStartHeight != test.targetHeight {
+				s.EXPECT().ApplyValidatorWeightDiffs(gomock.Any(), gomock.Any(), test.expectedStartHeight, test.targetHeight+1, subnetID)
+				s.EXPECT().ApplyValidatorPublicKeyDiffs(gomock.Any(), gomock.Any(), test.expectedStartHeight, test.targetHeight+1, subnetID)
+			}
+
+			m := NewManager(
+				logging.NoLog{},
+				config.Internal{
+					Validators: validators.NewManager(),
+				},
+				s,
+				metrics.Noop,
+				new(mockable.Clock),
+			)
+			_, err = m.GetValidatorSet(context.Background(), test.targetHeight, subnetID)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestGetDeepestQueries(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	const currentHeight = 10
+	blk, err := block.NewBanffStandardBlock(time.Time{}, ids.Empty, currentHeight, nil)
+	require.NoError(err)
+
+	subnetID := ids.GenerateTestID()
+	s := state.NewMockState(ctrl)
+	s.EXPECT().GetLastAccepted().Return(blk.ID()).AnyTimes()
+	s.EXPECT().GetStatelessBlock(blk.ID()).Return(blk, nil).AnyTimes()
+	s.EXPECT().GetValidatorSetSnapshot(subnetID, gomock.Any()).Return(uint64(0), nil, database.ErrNotFound).AnyTimes()
+	s.EXPECT().GetPrunedValidatorDiffsHeight().Return(uint64(0)).AnyTimes()
+	s.EXPECT().ApplyValidatorWeightDiffs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), subnetID).AnyTimes()
+	s.EXPECT().ApplyValidatorPublicKeyDiffs(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), subnetID).AnyTimes()
+
+	now := time.Unix(1, 0)
+	clk := &mockable.Clock{}
+	clk.Set(now)
+	m := NewManager(
+		logging.NoLog{},
+		config.Internal{
+			Validators: validators.NewManager(),
+		},
+		s,
+		metrics.Noop,
+		clk,
+	)
+
+	ctx := validators.WithCaller(context.Background(), "C")
+	for _, height := range []uint64{8, 2, 5} {
+		_, err := m.GetValidatorSet(ctx, height, subnetID)
+		require.NoError(err)
+	}
+
+	// Validator sets created together are created from the previously created
+	// validator set.
+	_, err = m.GetValidatorSets(context.Background(), []uint64{9, 3}, subnetID)
+	require.NoError(err)
+
+	require.Equal(
+		[]Query{
+			{
+				Caller:        "C",
+				SubnetID:      subnetID,
+				Height:        2,
+				CurrentHeight: currentHeight,
+				StartHeight:   currentHeight,
+				Timestamp:     now,
+			},
+			{
+				SubnetID:      subnetID,
+				Height:        3,
+				CurrentHeight: currentHeight,
+				StartHeight:   9,
+				Timestamp:     now,
+			},
+			{
+				Caller:        "C",
+				SubnetID:      subnetID,
+				Height:        5,
+				CurrentHeight: currentHeight,
+				StartHeight:   currentHeight,
+				Timestamp:     now,
+			},
+		},
+		m.GetDeepestQueries(3),
+	)
+	require.Len(m.GetDeepestQueries(10), 5)
+}
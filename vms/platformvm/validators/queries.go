@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validators
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+// Number of validator set creations that are remembered
+const recentQueriesSize = 1024
+
+// Query describes the creation of a validator set that wasn't cached.
+type Query struct {
+	// Who requested the validator set, or the empty string if unknown
+	Caller   string
+	SubnetID ids.ID
+	// Height of the created validator set
+	Height uint64
+	// Height of the P-chain when the validator set was created
+	CurrentHeight uint64
+	// Height of the validator set, either the current validator set, a
+	// snapshot or a previously created validator set, that the validator diffs
+	// were applied to
+	StartHeight uint64
+	// When the validator set was created
+	Timestamp time.Time
+	// Time taken to create the validator set
+	Duration time.Duration
+}
+
+// HeightsWalked returns the number of heights whose validator diffs were
+// applied to create the validator set.
+func (q Query) HeightsWalked() uint64 {
+	return q.StartHeight - q.Height
+}
+
+// recentQueries remembers the last [recentQueriesSize] queries.
+type recentQueries struct {
+	queries []Query
+	// Index in [queries] of the oldest query, once [queries] is full
+	oldest int
+}
+
+func (r *recentQueries) add(q Query) {
+	if len(r.queries) < recentQueriesSize {
+		r.queries = append(r.queries, q)
+		return
+	}
+	r.queries[r.oldest] = q
+	r.oldest = (r.oldest + 1) % recentQueriesSize
+}
+
+// deepest returns at most [limit] of the queries, sorted by the number of
+// heights they walked and then by their duration, in descending order.
+func (r *recentQueries) deepest(limit int) []Query {
+	queries := slices.Clone(r.queries)
+	slices.SortFunc(queries, func(a, b Query) int {
+		if c := cmp.Compare(b.HeightsWalked(), a.HeightsWalked()); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Duration, a.Duration)
+	})
+	return queries[:min(limit, len(queries))]
+}
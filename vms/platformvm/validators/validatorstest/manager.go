@@ -32,8 +32,16 @@ func (manager) GetValidatorSet(context.Context, uint64, ids.ID) (map[ids.NodeID]
 	return nil, nil
 }
 
+func (manager) GetValidatorSets(context.Context, []uint64, ids.ID) (map[uint64]map[ids.NodeID]*snowvalidators.GetValidatorOutput, error) {
+	return nil, nil
+}
+
 func (manager) OnAcceptedBlockID(ids.ID) {}
 
+func (manager) GetDeepestQueries(int) []vmvalidators.Query {
+	return nil
+}
+
 func (manager) GetCurrentValidatorSet(context.Context, ids.ID) (map[ids.ID]*snowvalidators.GetCurrentValidatorOutput, uint64, error) {
 	return nil, 0, nil
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/rpc/v2"
@@ -15,9 +16,11 @@ import (
 
 	"github.com/ava-labs/avalanchego/api/metrics"
 	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -28,6 +31,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -36,10 +40,12 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/network"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward/report"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 	"github.com/ava-labs/avalanchego/vms/txs/mempool"
 
 	snowmanblock "github.com/ava-labs/avalanchego/snow/engine/snowman/block"
@@ -49,6 +55,7 @@ import (
 	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
 	pmempool "github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
 	pvalidators "github.com/ava-labs/avalanchego/vms/platformvm/validators"
+	vdrcapacity "github.com/ava-labs/avalanchego/vms/platformvm/validators/capacity"
 )
 
 var (
@@ -56,8 +63,20 @@ var (
 	_ snowmanblock.BuildBlockWithContextChainVM = (*VM)(nil)
 	_ secp256k1fx.VM                            = (*VM)(nil)
 	_ validators.State                          = (*VM)(nil)
+	_ chains.SubnetTracker                      = (*VM)(nil)
+	_ limits.Provider                           = (*VM)(nil)
+	_ common.ConfigUpdater                      = (*VM)(nil)
+
+	rewardReportsPrefix = []byte("rewardReports")
+
+	errInvalidMempoolPruneFrequency = errors.New("mempool prune frequency must be positive")
+	errUnsupportedConfigUpdate      = errors.New("only the mempool prune frequency can be updated while the chain is running")
 )
 
+// rewardReportsDir is the directory, in the chain data directory, that reward
+// reports are written to.
+const rewardReportsDir = "reward-reports"
+
 type VM struct {
 	config.Internal
 	blockbuilder.Builder
@@ -85,6 +104,20 @@ type VM struct {
 
 	manager blockexecutor.Manager
 
+	validatorManager pvalidators.Manager
+
+	validatorCapacities vdrcapacity.Index
+
+	// Subnets whose validator set changes are logged
+	validatorLoggers set.Set[ids.ID]
+
+	rewardReports report.Reporter
+
+	// The user-provided config that the VM is currently running with.
+	execConfig *config.Config
+	// Used to notify [periodicallyPruneMempool] of a new prune frequency.
+	mempoolPruneFrequency chan time.Duration
+
 	// Cancelled on shutdown
 	onShutdownCtx context.Context
 	// Call [onShutdownCtxCancel] to cancel [onShutdownCtx] during Shutdown()
@@ -111,6 +144,7 @@ func (vm *VM) Initialize(
 		return err
 	}
 	chainCtx.Log.Info("using VM execution config", zap.Reflect("config", execConfig))
+	vm.execConfig = execConfig
 
 	registerer, err := metrics.MakeAndRegister(chainCtx.Metrics, "")
 	if err != nil {
@@ -135,12 +169,18 @@ func (vm *VM) Initialize(
 
 	rewards := reward.NewCalculator(vm.RewardConfig)
 
+	stakingConfig, err := state.NewStakingConfig(genesisBytes, &vm.Internal)
+	if err != nil {
+		return fmt.Errorf("failed to read the staking config: %w", err)
+	}
+
 	vm.state, err = state.New(
 		vm.db,
 		genesisBytes,
 		registerer,
 		vm.Internal.Validators,
 		vm.Internal.UpgradeConfig,
+		stakingConfig,
 		execConfig,
 		vm.ctx,
 		vm.metrics,
@@ -150,8 +190,13 @@ func (vm *VM) Initialize(
 		return err
 	}
 
-	validatorManager := pvalidators.NewManager(chainCtx.Log, vm.Internal, vm.state, vm.metrics, &vm.clock)
-	vm.State = validatorManager
+	// The tracked subnets are modified when the node starts or stops tracking
+	// a subnet, so the validator manager must share the same set.
+	if vm.TrackedSubnets == nil {
+		vm.TrackedSubnets = set.Set[ids.ID]{}
+	}
+	vm.validatorManager = pvalidators.NewManager(chainCtx.Log, vm.Internal, vm.state, vm.metrics, &vm.clock)
+	vm.State = vm.validatorManager
 	utxoVerifier := utxo.NewVerifier(vm.ctx, &vm.clock, vm.fx)
 	vm.uptimeManager = uptime.NewManager(vm.state, &vm.clock)
 	vm.UptimeLockedCalculator.SetCalculator(&vm.bootstrapped, &chainCtx.Lock, vm.uptimeManager)
@@ -167,6 +212,43 @@ func (vm *VM) Initialize(
 		Bootstrapped: &vm.bootstrapped,
 	}
 
+	if execConfig.IndexValidatorCapacities {
+		chainCtx.Log.Info("validator capacity indexing is enabled")
+		vm.validatorCapacities, err = vdrcapacity.NewIndex(
+			vm.state,
+			vm.uptimeManager,
+			&vm.bootstrapped,
+			vm.state.GetStakingParams().MaxValidatorStake,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to initialize validator capacity index: %w", err)
+		}
+	} else {
+		vm.validatorCapacities = vdrcapacity.NewNoIndex()
+	}
+
+	if execConfig.RewardReportEpochDuration > 0 {
+		chainCtx.Log.Info("reward reports are enabled",
+			zap.Duration("epochDuration", execConfig.RewardReportEpochDuration),
+		)
+		var dir string
+		if chainCtx.ChainDataDir != "" {
+			dir = filepath.Join(chainCtx.ChainDataDir, rewardReportsDir)
+		}
+		vm.rewardReports, err = report.NewReporter(
+			chainCtx,
+			vm.state,
+			prefixdb.New(rewardReportsPrefix, vm.db),
+			dir,
+			execConfig.RewardReportEpochDuration,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to initialize reward reports: %w", err)
+		}
+	} else {
+		vm.rewardReports = report.NewNoReporter()
+	}
+
 	mempool, err := pmempool.New("mempool", registerer, toEngine)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
@@ -177,7 +259,9 @@ func (vm *VM) Initialize(
 		vm.metrics,
 		vm.state,
 		txExecutorBackend,
-		validatorManager,
+		vm.validatorManager,
+		vm.validatorCapacities,
+		vm.rewardReports,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&txExecutorBackend.Ctx.Lock, vm.manager)
@@ -187,7 +271,7 @@ func (vm *VM) Initialize(
 		chainCtx.SubnetID,
 		validators.NewLockedState(
 			&chainCtx.Lock,
-			validatorManager,
+			vm.validatorManager,
 		),
 		txVerifier,
 		mempool,
@@ -196,6 +280,8 @@ func (vm *VM) Initialize(
 		chainCtx.Lock.RLocker(),
 		vm.state,
 		chainCtx.WarpSigner,
+		network.NewLockedMaintenanceTracker(&chainCtx.Lock, vm.uptimeManager),
+		vm.Internal.MaintenanceWindow,
 		registerer,
 		execConfig.Network,
 	)
@@ -208,6 +294,7 @@ func (vm *VM) Initialize(
 	// has better control of the context lock.
 	go vm.Network.PushGossip(vm.onShutdownCtx)
 	go vm.Network.PullGossip(vm.onShutdownCtx)
+	go vm.Network.MaintenanceGossip(vm.onShutdownCtx)
 
 	vm.Builder = blockbuilder.New(
 		mempool,
@@ -215,6 +302,8 @@ func (vm *VM) Initialize(
 		vm.manager,
 	)
 
+	vm.Chains.AddSubnetTracker(vm)
+
 	// Create all of the chains that the database says exist
 	if err := vm.initBlockchains(); err != nil {
 		return fmt.Errorf(
@@ -233,6 +322,7 @@ func (vm *VM) Initialize(
 
 	// Incrementing [awaitShutdown] would cause a deadlock since
 	// [periodicallyPruneMempool] grabs the context lock.
+	vm.mempoolPruneFrequency = make(chan time.Duration, 1)
 	go vm.periodicallyPruneMempool(execConfig.MempoolPruneFrequency)
 
 	go func() {
@@ -255,6 +345,8 @@ func (vm *VM) periodicallyPruneMempool(frequency time.Duration) {
 		select {
 		case <-vm.onShutdownCtx.Done():
 			return
+		case frequency := <-vm.mempoolPruneFrequency:
+			ticker.Reset(frequency)
 		case <-ticker.C:
 			if err := vm.pruneMempool(); err != nil {
 				vm.ctx.Log.Debug("pruning mempool failed",
@@ -265,6 +357,38 @@ func (vm *VM) periodicallyPruneMempool(frequency time.Duration) {
 	}
 }
 
+// OnConfigUpdated applies the new config of the P-chain. Only the mempool prune
+// frequency can be changed without restarting the node.
+func (vm *VM) OnConfigUpdated(_ context.Context, configBytes []byte) error {
+	execConfig, err := config.GetConfig(configBytes)
+	if err != nil {
+		return err
+	}
+	if execConfig.MempoolPruneFrequency <= 0 {
+		return fmt.Errorf("%w: %s", errInvalidMempoolPruneFrequency, execConfig.MempoolPruneFrequency)
+	}
+
+	updatedConfig := *vm.execConfig
+	updatedConfig.MempoolPruneFrequency = execConfig.MempoolPruneFrequency
+	if *execConfig != updatedConfig {
+		return errUnsupportedConfigUpdate
+	}
+
+	// The context lock is held, so there is no other writer. Replacing any
+	// frequency that hasn't been applied yet ensures the send doesn't block.
+	select {
+	case <-vm.mempoolPruneFrequency:
+	default:
+	}
+	vm.mempoolPruneFrequency <- execConfig.MempoolPruneFrequency
+	vm.execConfig = execConfig
+
+	vm.ctx.Log.Info("updated VM execution config",
+		zap.Duration("mempoolPruneFrequency", execConfig.MempoolPruneFrequency),
+	)
+	return nil
+}
+
 func (vm *VM) pruneMempool() error {
 	vm.ctx.Lock.Lock()
 	defer vm.ctx.Lock.Unlock()
@@ -290,7 +414,8 @@ func (vm *VM) pruneMempool() error {
 	return nil
 }
 
-// Create all chains that exist that this node validates.
+// Create all chains that exist that this node validates. The chains of the
+// other subnets are only registered.
 func (vm *VM) initBlockchains() error {
 	if vm.Internal.PartialSyncPrimaryNetwork {
 		vm.ctx.Log.Info("skipping primary network chain creation")
@@ -298,22 +423,14 @@ func (vm *VM) initBlockchains() error {
 		return err
 	}
 
-	if vm.SybilProtectionEnabled {
-		for subnetID := range vm.TrackedSubnets {
-			if err := vm.createSubnet(subnetID); err != nil {
-				return err
-			}
-		}
-	} else {
-		subnetIDs, err := vm.state.GetSubnetIDs()
-		if err != nil {
+	subnetIDs, err := vm.state.GetSubnetIDs()
+	if err != nil {
+		return err
+	}
+	for _, subnetID := range subnetIDs {
+		if err := vm.createSubnet(subnetID); err != nil {
 			return err
 		}
-		for _, subnetID := range subnetIDs {
-			if err := vm.createSubnet(subnetID); err != nil {
-				return err
-			}
-		}
 	}
 	return nil
 }
@@ -329,7 +446,19 @@ func (vm *VM) createSubnet(subnetID ids.ID) error {
 		if !ok {
 			return fmt.Errorf("expected tx type *txs.CreateChainTx but got %T", chain.Unsigned)
 		}
-		vm.Internal.CreateChain(chain.ID(), tx)
+
+		chainID := chain.ID()
+		isRetired, err := vm.state.IsChainRetired(chainID)
+		if err != nil {
+			return err
+		}
+		if isRetired {
+			// Retiring the chain, rather than skipping it, allows its data to
+			// be pruned if it wasn't yet.
+			vm.Internal.RetireChain(chainID, tx)
+			continue
+		}
+		vm.Internal.CreateChain(chainID, tx)
 	}
 	return nil
 }
@@ -358,12 +487,9 @@ func (vm *VM) onNormalOperationsStarted() error {
 		}
 	}
 
-	vl := validators.NewLogger(vm.ctx.Log, constants.PrimaryNetworkID, vm.ctx.NodeID)
-	vm.Validators.RegisterSetCallbackListener(constants.PrimaryNetworkID, vl)
-
+	vm.registerValidatorLogger(constants.PrimaryNetworkID)
 	for subnetID := range vm.TrackedSubnets {
-		vl := validators.NewLogger(vm.ctx.Log, subnetID, vm.ctx.NodeID)
-		vm.Validators.RegisterSetCallbackListener(subnetID, vl)
+		vm.registerValidatorLogger(subnetID)
 	}
 
 	if err := vm.state.Commit(); err != nil {
@@ -375,6 +501,40 @@ func (vm *VM) onNormalOperationsStarted() error {
 	return nil
 }
 
+// registerValidatorLogger logs the validator set changes of the subnet, unless
+// they are already logged.
+func (vm *VM) registerValidatorLogger(subnetID ids.ID) {
+	if vm.validatorLoggers.Contains(subnetID) {
+		return
+	}
+	vm.validatorLoggers.Add(subnetID)
+
+	vl := validators.NewLogger(vm.ctx.Log, subnetID, vm.ctx.NodeID)
+	vm.Validators.RegisterSetCallbackListener(subnetID, vl)
+}
+
+// TrackSubnet is called by the chain manager when the node starts tracking the
+// subnet, so that the subnet's validator sets are cached and the time until
+// this node stops validating the subnet is reported.
+func (vm *VM) TrackSubnet(subnetID ids.ID) {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	vm.TrackedSubnets.Add(subnetID)
+	if vm.bootstrapped.Get() {
+		vm.registerValidatorLogger(subnetID)
+	}
+}
+
+// UntrackSubnet is called by the chain manager when the node stops tracking
+// the subnet.
+func (vm *VM) UntrackSubnet(subnetID ids.ID) {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	vm.TrackedSubnets.Remove(subnetID)
+}
+
 func (vm *VM) SetState(_ context.Context, state snow.State) error {
 	switch state {
 	case snow.Bootstrapping:
@@ -498,6 +658,18 @@ func (vm *VM) GetBlockIDAtHeight(_ context.Context, height uint64) (ids.ID, erro
 	return vm.state.GetBlockIDAtHeight(height)
 }
 
+// TxLimits returns the limits enforced on the txs of the P-chain. Once Etna is
+// activated, the complexity of a block is also limited by the gas capacity.
+func (vm *VM) TxLimits() limits.Limits {
+	now := vm.clock.Time()
+	if !vm.Internal.UpgradeConfig.IsEtnaActivated(now) {
+		return limits.Default
+	}
+	gasConfig := vm.Internal.DynamicFeeConfig
+	gasConfig.Weights = vm.Internal.DynamicFeeWeights(now)
+	return limits.Default.WithGasConfig(gasConfig)
+}
+
 func (vm *VM) issueTxFromRPC(tx *txs.Tx) error {
 	err := vm.Network.IssueTxFromRPC(tx)
 	if err != nil && !errors.Is(err, mempool.ErrDuplicateTx) {
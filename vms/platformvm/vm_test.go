@@ -1360,6 +1360,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		msgChan,
 		time.Hour,
 		2,
+		0,
 		cpuTracker,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		tracker.NewPeers(),
@@ -1982,9 +1983,12 @@ func TestRemovePermissionedValidatorDuringAddPending(t *testing.T) {
 	lastAcceptedID := vm.state.GetLastAccepted()
 	lastAcceptedHeight, err := vm.GetCurrentHeight(context.Background())
 	require.NoError(err)
-	statelessBlock, err := block.NewBanffStandardBlock(
+	lastAcceptedStateRoot, err := state.GetStateRoot(context.Background(), vm.state)
+	require.NoError(err)
+	statelessBlock, err := block.NewFortunaStandardBlock(
 		vm.state.GetTimestamp(),
 		lastAcceptedID,
+		lastAcceptedStateRoot,
 		lastAcceptedHeight+1,
 		[]*txs.Tx{
 			addSubnetValidatorTx,
@@ -2187,3 +2191,42 @@ func TestPruneMempool(t *testing.T) {
 	_, ok = vm.Builder.Get(baseTxID)
 	require.True(ok)
 }
+
+func TestOnConfigUpdated(t *testing.T) {
+	tests := []struct {
+		name                          string
+		configBytes                   []byte
+		expectedErr                   error
+		expectedMempoolPruneFrequency time.Duration
+	}{
+		{
+			name:                          "update mempool prune frequency",
+			configBytes:                   []byte(`{"network":{"max-validator-set-staleness":0},"mempool-prune-frequency":60000000000}`),
+			expectedMempoolPruneFrequency: time.Minute,
+		},
+		{
+			name:                          "non-positive mempool prune frequency",
+			configBytes:                   []byte(`{"network":{"max-validator-set-staleness":0},"mempool-prune-frequency":0}`),
+			expectedErr:                   errInvalidMempoolPruneFrequency,
+			expectedMempoolPruneFrequency: config.Default.MempoolPruneFrequency,
+		},
+		{
+			name:                          "unsupported update",
+			configBytes:                   []byte(`{"network":{"max-validator-set-staleness":0},"checksums-enabled":true,"mempool-prune-frequency":60000000000}`),
+			expectedErr:                   errUnsupportedConfigUpdate,
+			expectedMempoolPruneFrequency: config.Default.MempoolPruneFrequency,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			vm, _, _ := defaultVM(t, upgradetest.Latest)
+			vm.ctx.Lock.Lock()
+			defer vm.ctx.Lock.Unlock()
+
+			err := vm.OnConfigUpdated(context.Background(), test.configBytes)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedMempoolPruneFrequency, vm.execConfig.MempoolPruneFrequency)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+// ValidatorSet is sent by the P-chain to report the Primary Network validator
+// set after the block at [Height] was accepted.
+//
+// This allows light clients to follow the Primary Network validator set
+// starting from a trusted validator set.
+type ValidatorSet struct {
+	payload
+
+	Height uint64 `serialize:"true" json:"height"`
+	// BlockID is the ID of the P-chain block that was accepted at [Height].
+	BlockID ids.ID `serialize:"true" json:"blockID"`
+	// ValidatorsHash is the hash of the canonical validator set, as calculated
+	// by [HashValidatorSet].
+	ValidatorsHash ids.ID `serialize:"true" json:"validatorsHash"`
+}
+
+// NewValidatorSet creates a new initialized ValidatorSet.
+func NewValidatorSet(
+	height uint64,
+	blockID ids.ID,
+	validatorsHash ids.ID,
+) (*ValidatorSet, error) {
+	msg := &ValidatorSet{
+		Height:         height,
+		BlockID:        blockID,
+		ValidatorsHash: validatorsHash,
+	}
+	return msg, Initialize(msg)
+}
+
+// ParseValidatorSet parses bytes into an initialized ValidatorSet.
+func ParseValidatorSet(b []byte) (*ValidatorSet, error) {
+	payloadIntf, err := Parse(b)
+	if err != nil {
+		return nil, err
+	}
+	payload, ok := payloadIntf.(*ValidatorSet)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrWrongType, payloadIntf)
+	}
+	return payload, nil
+}
+
+type hashedValidator struct {
+	PublicKey []byte       `serialize:"true"`
+	Weight    uint64       `serialize:"true"`
+	NodeIDs   []ids.NodeID `serialize:"true"`
+}
+
+type hashedValidatorSet struct {
+	Validators  []hashedValidator `serialize:"true"`
+	TotalWeight uint64            `serialize:"true"`
+}
+
+// HashValidatorSet returns the hash of [vdrs] that is reported in ValidatorSet
+// messages.
+func HashValidatorSet(vdrs warp.CanonicalValidatorSet) (ids.ID, error) {
+	hashedVdrs := hashedValidatorSet{
+		Validators:  make([]hashedValidator, len(vdrs.Validators)),
+		TotalWeight: vdrs.TotalWeight,
+	}
+	for i, vdr := range vdrs.Validators {
+		// The order of the nodeIDs in the canonical validator set is not
+		// deterministic.
+		nodeIDs := slices.Clone(vdr.NodeIDs)
+		utils.Sort(nodeIDs)
+
+		hashedVdrs.Validators[i] = hashedValidator{
+			PublicKey: vdr.PublicKeyBytes,
+			Weight:    vdr.Weight,
+			NodeIDs:   nodeIDs,
+		}
+	}
+
+	bytes, err := Codec.Marshal(CodecVersion, &hashedVdrs)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("failed to marshal validator set: %w", err)
+	}
+	return hashing.ComputeHash256Array(bytes), nil
+}
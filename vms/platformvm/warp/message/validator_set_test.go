@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+func TestValidatorSet(t *testing.T) {
+	require := require.New(t)
+
+	msg, err := NewValidatorSet(
+		rand.Uint64(), //#nosec G404
+		ids.GenerateTestID(),
+		ids.GenerateTestID(),
+	)
+	require.NoError(err)
+
+	parsed, err := ParseValidatorSet(msg.Bytes())
+	require.NoError(err)
+	require.Equal(msg, parsed)
+}
+
+func TestHashValidatorSet(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+
+	var (
+		pk      = sk.PublicKey()
+		nodeID0 = ids.GenerateTestNodeID()
+		nodeID1 = ids.GenerateTestNodeID()
+		newSet  = func(weight uint64, nodeIDs ...ids.NodeID) warp.CanonicalValidatorSet {
+			return warp.CanonicalValidatorSet{
+				Validators: []*warp.Validator{
+					{
+						PublicKey:      pk,
+						PublicKeyBytes: bls.PublicKeyToUncompressedBytes(pk),
+						Weight:         weight,
+						NodeIDs:        nodeIDs,
+					},
+				},
+				TotalWeight: weight,
+			}
+		}
+	)
+
+	hash, err := HashValidatorSet(newSet(1, nodeID0, nodeID1))
+	require.NoError(err)
+
+	// The order of the nodeIDs doesn't impact the hash.
+	reorderedHash, err := HashValidatorSet(newSet(1, nodeID1, nodeID0))
+	require.NoError(err)
+	require.Equal(hash, reorderedHash)
+
+	differentWeightHash, err := HashValidatorSet(newSet(2, nodeID0, nodeID1))
+	require.NoError(err)
+	require.NotEqual(hash, differentWeightHash)
+
+	differentNodesHash, err := HashValidatorSet(newSet(1, nodeID0))
+	require.NoError(err)
+	require.NotEqual(hash, differentNodesHash)
+}
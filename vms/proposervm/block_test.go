@@ -402,6 +402,7 @@ func TestPostDurangoBuildChildResetScheduler(t *testing.T) {
 		Windower:               windower,
 		Scheduler:              scheduler,
 		proposerBuildSlotGauge: prometheus.NewGauge(prometheus.GaugeOpts{}),
+		minBlockDelayGauge:     prometheus.NewGauge(prometheus.GaugeOpts{}),
 	}
 	vm.Clock.Set(now)
 
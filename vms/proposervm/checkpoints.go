@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"math"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/checkpoint"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+var (
+	checkpointPrefix = p2p.ProtocolPrefix(p2p.CheckpointHandlerID)
+
+	allPeers = common.SendConfig{
+		Peers: math.MaxInt32,
+	}
+)
+
+// AppGossip records the checkpoints published by validators and passes all
+// other messages to the inner VM.
+//
+// Checkpoints are handled by the proposervm, rather than by the inner VM, so
+// that every chain can be bootstrapped from checkpoints.
+func (vm *VM) AppGossip(ctx context.Context, nodeID ids.NodeID, msg []byte) error {
+	handlerID, checkpointsBytes, ok := p2p.ParseMessage(msg)
+	if !ok || handlerID != p2p.CheckpointHandlerID {
+		return vm.ChainVM.AppGossip(ctx, nodeID, msg)
+	}
+	if vm.Checkpoints == nil {
+		return nil
+	}
+
+	if err := vm.Checkpoints.Add(nodeID, checkpointsBytes); err != nil {
+		vm.ctx.Log.Debug("dropping checkpoints",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
+
+// Connected publishes this node's checkpoints to the newly connected peer, in
+// case the peer is bootstrapping.
+func (vm *VM) Connected(ctx context.Context, nodeID ids.NodeID, nodeVersion *version.Application) error {
+	if err := vm.ChainVM.Connected(ctx, nodeID, nodeVersion); err != nil {
+		return err
+	}
+	if vm.consensusState == snow.NormalOp {
+		vm.publishCheckpoints(ctx, common.SendConfig{
+			NodeIDs: set.Of(nodeID),
+		})
+	}
+	return nil
+}
+
+// publishCheckpoints sends this node's checkpoints to the peers selected by
+// [config], if this node is a validator.
+//
+// Failing to publish checkpoints is logged, but otherwise ignored, as
+// bootstrapping nodes can also use the checkpoints of other validators.
+//
+// vm.ctx.Lock should be held
+func (vm *VM) publishCheckpoints(ctx context.Context, config common.SendConfig) {
+	if vm.Checkpoints == nil || !vm.Checkpoints.IsValidator(vm.ctx.NodeID) {
+		return
+	}
+
+	msg, err := vm.getCheckpointsMessage(ctx)
+	if err != nil {
+		vm.ctx.Log.Warn("failed to create checkpoints",
+			zap.Error(err),
+		)
+		return
+	}
+	if err := vm.appSender.SendAppGossip(ctx, config, p2p.PrefixMessage(checkpointPrefix, msg)); err != nil {
+		vm.ctx.Log.Warn("failed to publish checkpoints",
+			zap.Error(err),
+		)
+	}
+}
+
+// getCheckpointsMessage returns the signed message that publishes the
+// checkpoints of the last accepted block.
+//
+// The message only changes once a block is accepted at the next checkpoint
+// height, so it is cached until then.
+//
+// vm.ctx.Lock should be held
+func (vm *VM) getCheckpointsMessage(ctx context.Context) ([]byte, error) {
+	lastAcceptedID, err := vm.LastAccepted(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastAccepted, err := vm.getBlock(ctx, lastAcceptedID)
+	if err != nil {
+		return nil, err
+	}
+
+	heights := checkpoint.Heights(lastAccepted.Height())
+	var highestHeight uint64
+	if len(heights) > 0 {
+		highestHeight = heights[0]
+	}
+	if vm.checkpointsMsg != nil && vm.checkpointsHeight == highestHeight {
+		return vm.checkpointsMsg, nil
+	}
+
+	checkpoints := make([]checkpoint.Checkpoint, 0, len(heights))
+	for _, height := range heights {
+		blkID, err := vm.GetBlockIDAtHeight(ctx, height)
+		if err != nil {
+			// Pruned blocks, and the blocks of inner VMs that don't index
+			// their blocks by height, aren't published.
+			vm.ctx.Log.Debug("stopped collecting checkpoints",
+				zap.Uint64("height", height),
+				zap.Error(err),
+			)
+			break
+		}
+		checkpoints = append(checkpoints, checkpoint.Checkpoint{
+			Height:  height,
+			BlockID: blkID,
+		})
+	}
+
+	msg, err := checkpoint.Sign(vm.ctx.WarpSigner, vm.ctx.NetworkID, vm.ctx.ChainID, checkpoints)
+	if err != nil {
+		return nil, err
+	}
+	vm.checkpointsHeight = highestHeight
+	vm.checkpointsMsg = msg
+	return msg, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/checkpoint"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+func TestPublishAndTrackCheckpoints(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+	)
+	coreVM, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+	proVM.ctx.WarpSigner = warp.NewSigner(sk, proVM.ctx.NetworkID, proVM.ctx.ChainID)
+
+	vdrs := validators.NewManager()
+	require.NoError(vdrs.AddStaker(proVM.ctx.SubnetID, proVM.ctx.NodeID, sk.PublicKey(), ids.Empty, 1))
+	proVM.Checkpoints = checkpoint.NewTracker(
+		proVM.ctx.NetworkID,
+		proVM.ctx.SubnetID,
+		proVM.ctx.ChainID,
+		vdrs,
+	)
+
+	var gossiped [][]byte
+	proVM.appSender = &enginetest.Sender{
+		T: t,
+		SendAppGossipF: func(_ context.Context, config common.SendConfig, msg []byte) error {
+			require.Equal(common.SendConfig{NodeIDs: set.Of(proVM.ctx.NodeID)}, config)
+			gossiped = append(gossiped, msg)
+			return nil
+		},
+	}
+
+	// Checkpoints are published to newly connected peers.
+	coreVM.CantConnected = false
+	require.NoError(proVM.Connected(context.Background(), proVM.ctx.NodeID, version.CurrentApp))
+	require.Len(gossiped, 1)
+
+	handlerID, _, ok := p2p.ParseMessage(gossiped[0])
+	require.True(ok)
+	require.Equal(uint64(p2p.CheckpointHandlerID), handlerID)
+
+	// Published checkpoints are recorded rather than passed to the inner VM.
+	coreVM.CantAppGossip = true
+	require.NoError(proVM.AppGossip(context.Background(), proVM.ctx.NodeID, gossiped[0]))
+	checkpoints, published, err := proVM.Checkpoints.Quorum()
+	require.NoError(err)
+	require.Empty(checkpoints)
+	require.True(published)
+
+	// Other messages are passed to the inner VM.
+	otherMsg := p2p.PrefixMessage(p2p.ProtocolPrefix(p2p.TxGossipHandlerID), []byte("tx"))
+	var forwarded []byte
+	coreVM.AppGossipF = func(_ context.Context, _ ids.NodeID, msg []byte) error {
+		forwarded = msg
+		return nil
+	}
+	require.NoError(proVM.AppGossip(context.Background(), proVM.ctx.NodeID, otherMsg))
+	require.Equal(otherMsg, forwarded)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/checkpoint"
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/upgrade"
 )
@@ -19,6 +20,11 @@ type Config struct {
 	// Configurable minimal delay among blocks issued consecutively
 	MinBlkDelay time.Duration
 
+	// Maximal delay among blocks issued consecutively. If larger than
+	// MinBlkDelay, the delay is increased towards MaxBlkDelay while the
+	// recently accepted blocks are underutilized.
+	MaxBlkDelay time.Duration
+
 	// Maximal number of block indexed.
 	// Zero signals all blocks are indexed.
 	NumHistoricalBlocks uint64
@@ -31,4 +37,8 @@ type Config struct {
 
 	// Registerer for prometheus metrics
 	Registerer prometheus.Registerer
+
+	// Checkpoints records the checkpoints published by the validators of the
+	// chain. If nil, checkpoints are neither published nor recorded.
+	Checkpoints *checkpoint.Tracker
 }
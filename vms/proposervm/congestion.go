@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+// utilizationWindowSize is the number of recently accepted blocks whose
+// utilization is considered when calculating the minimum block delay.
+const utilizationWindowSize = 16
+
+// innerBlockUtilization returns the utilization reported by [innerBlk], or nil
+// if it doesn't report its utilization.
+//
+// VMs may discard the results of verifying a block once it is decided, so this
+// must be called before the inner block is accepted.
+func innerBlockUtilization(innerBlk snowman.Block) []float64 {
+	blkWithUtilization, ok := innerBlk.(block.WithUtilization)
+	if !ok {
+		return nil
+	}
+	return blkWithUtilization.Utilization()
+}
+
+// recordUtilization tracks the utilization of an accepted inner block. Blocks
+// that didn't report their utilization are ignored.
+func (vm *VM) recordUtilization(dimensions []float64) {
+	if len(dimensions) == 0 {
+		return
+	}
+
+	// The most utilized dimension is the one that limits the block.
+	var utilization float64
+	for _, u := range dimensions {
+		utilization = max(utilization, u)
+	}
+	utilization = min(utilization, 1)
+
+	vm.recentUtilization.Push(utilization)
+	vm.recentUtilizationSum += utilization
+	vm.blockUtilizationGauge.Set(vm.averageUtilization())
+}
+
+// averageUtilization returns the average utilization of the recently accepted
+// blocks that reported their utilization.
+func (vm *VM) averageUtilization() float64 {
+	numBlocks := vm.recentUtilization.Len()
+	if numBlocks == 0 {
+		return 0
+	}
+	return vm.recentUtilizationSum / float64(numBlocks)
+}
+
+// minBlockDelay returns the minimum delay this node will wait after the parent
+// block's timestamp before building a child.
+//
+// If MaxBlkDelay is larger than MinBlkDelay, the delay is interpolated between
+// them based on the utilization of the recently accepted blocks. Idle chains
+// wait MaxBlkDelay while saturated chains only wait MinBlkDelay. If no recent
+// block reported its utilization, MinBlkDelay is used.
+func (vm *VM) minBlockDelay() time.Duration {
+	delay := vm.MinBlkDelay
+	if vm.MaxBlkDelay > vm.MinBlkDelay && vm.recentUtilization.Len() > 0 {
+		spread := float64(vm.MaxBlkDelay - vm.MinBlkDelay)
+		delay = vm.MaxBlkDelay - time.Duration(spread*vm.averageUtilization())
+	}
+	vm.minBlockDelayGauge.Set(delay.Seconds())
+	return delay
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/utils/buffer"
+)
+
+type utilizationBlock struct {
+	*snowmantest.Block
+
+	utilization []float64
+}
+
+func (b *utilizationBlock) Utilization() []float64 {
+	return b.utilization
+}
+
+func TestMinBlockDelay(t *testing.T) {
+	const (
+		minDelay = time.Second
+		maxDelay = 5 * time.Second
+	)
+
+	tests := []struct {
+		name          string
+		maxDelay      time.Duration
+		utilizations  [][]float64
+		expectedDelay time.Duration
+	}{
+		{
+			name:          "no reported utilization",
+			maxDelay:      maxDelay,
+			utilizations:  [][]float64{nil},
+			expectedDelay: minDelay,
+		},
+		{
+			name:          "idle",
+			maxDelay:      maxDelay,
+			utilizations:  [][]float64{{0, 0}},
+			expectedDelay: maxDelay,
+		},
+		{
+			name:          "saturated",
+			maxDelay:      maxDelay,
+			utilizations:  [][]float64{{0, 1}},
+			expectedDelay: minDelay,
+		},
+		{
+			name:          "over utilized",
+			maxDelay:      maxDelay,
+			utilizations:  [][]float64{{2}},
+			expectedDelay: minDelay,
+		},
+		{
+			name:          "most utilized dimension is used",
+			maxDelay:      maxDelay,
+			utilizations:  [][]float64{{.25, .5}},
+			expectedDelay: 3 * time.Second,
+		},
+		{
+			name:          "recent blocks are averaged",
+			maxDelay:      maxDelay,
+			utilizations:  [][]float64{{1}, {0}, nil},
+			expectedDelay: 3 * time.Second,
+		},
+		{
+			name:          "old blocks are forgotten",
+			maxDelay:      maxDelay,
+			utilizations:  append([][]float64{{0}}, repeatUtilization(utilizationWindowSize, 1)...),
+			expectedDelay: minDelay,
+		},
+		{
+			name:          "disabled",
+			maxDelay:      0,
+			utilizations:  [][]float64{{0}},
+			expectedDelay: minDelay,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			vm := &VM{
+				Config: Config{
+					MinBlkDelay: minDelay,
+					MaxBlkDelay: test.maxDelay,
+				},
+				blockUtilizationGauge: prometheus.NewGauge(prometheus.GaugeOpts{}),
+				minBlockDelayGauge:    prometheus.NewGauge(prometheus.GaugeOpts{}),
+			}
+			var err error
+			vm.recentUtilization, err = buffer.NewBoundedQueue(utilizationWindowSize, func(utilization float64) {
+				vm.recentUtilizationSum -= utilization
+			})
+			require.NoError(err)
+
+			for _, utilization := range test.utilizations {
+				vm.recordUtilization(innerBlockUtilization(&utilizationBlock{
+					Block:       snowmantest.BuildChild(snowmantest.Genesis),
+					utilization: utilization,
+				}))
+			}
+			require.Equal(test.expectedDelay, vm.minBlockDelay())
+		})
+	}
+}
+
+func repeatUtilization(n int, utilization float64) [][]float64 {
+	utilizations := make([][]float64, n)
+	for i := range utilizations {
+		utilizations[i] = []float64{utilization}
+	}
+	return utilizations
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/database"
+)
+
+// GetLocalBlocks returns the post fork blocks that are still indexed by height
+// starting at [height]+1. If the proposervm was rolled back to the inner VM's
+// last accepted block, these are the blocks that were previously accepted
+// beyond the current last accepted block.
+//
+// vm.ctx.Lock should be held
+func (vm *VM) GetLocalBlocks(
+	_ context.Context,
+	height uint64,
+	maxBlocksNum int,
+) ([][]byte, error) {
+	forkHeight, err := vm.State.GetForkHeight()
+	if err == database.ErrNotFound {
+		// The fork hasn't been reached, so there are no post fork blocks.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks [][]byte
+	for nextHeight := height + 1; len(blocks) < maxBlocksNum; nextHeight++ {
+		// Blocks prior to the fork are only known by the inner VM.
+		if nextHeight < forkHeight {
+			break
+		}
+
+		blkID, err := vm.State.GetBlockIDAtHeight(nextHeight)
+		if err == database.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		blk, err := vm.State.GetBlock(blkID)
+		if err == database.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, blk.Bytes())
+	}
+	return blocks, nil
+}
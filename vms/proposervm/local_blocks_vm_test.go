@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+
+	statelessblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+func TestGetLocalBlocks(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = activationTime
+	)
+	_, _, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	// Prior to the fork, there are no post fork blocks.
+	blocks, err := proVM.GetLocalBlocks(context.Background(), 0, 10)
+	require.NoError(err)
+	require.Empty(blocks)
+
+	const forkHeight = 2
+	require.NoError(proVM.State.SetForkHeight(forkHeight))
+
+	// Index blocks at heights [2, 4] and 6, leaving a gap at height 5.
+	blocksBytes := make(map[uint64][]byte)
+	parentID := ids.GenerateTestID()
+	for _, height := range []uint64{2, 3, 4, 6} {
+		blk, err := statelessblock.BuildUnsigned(
+			parentID,
+			time.Time{},
+			0,
+			[]byte{byte(height)},
+		)
+		require.NoError(err)
+		require.NoError(proVM.State.PutBlock(blk))
+		require.NoError(proVM.State.SetBlockIDAtHeight(height, blk.ID()))
+
+		blocksBytes[height] = blk.Bytes()
+		parentID = blk.ID()
+	}
+
+	tests := []struct {
+		height          uint64
+		maxBlocksNum    int
+		expectedHeights []uint64
+	}{
+		// The next block is prior to the fork.
+		{height: 0, maxBlocksNum: 10, expectedHeights: nil},
+		// Blocks are returned until the first gap.
+		{height: 1, maxBlocksNum: 10, expectedHeights: []uint64{2, 3, 4}},
+		// The number of blocks is limited.
+		{height: 2, maxBlocksNum: 1, expectedHeights: []uint64{3}},
+		// There is no next block.
+		{height: 4, maxBlocksNum: 10, expectedHeights: nil},
+	}
+	for _, test := range tests {
+		blocks, err := proVM.GetLocalBlocks(context.Background(), test.height, test.maxBlocksNum)
+		require.NoError(err)
+
+		var expectedBlocks [][]byte
+		for _, height := range test.expectedHeights {
+			expectedBlocks = append(expectedBlocks, blocksBytes[height])
+		}
+		require.Equal(expectedBlocks, blocks)
+	}
+}
@@ -29,12 +29,14 @@ type postForkBlock struct {
 // 2) Persists this block in storage
 // 3) Calls Reject() on siblings of this block and their descendants.
 func (b *postForkBlock) Accept(ctx context.Context) error {
+	utilization := innerBlockUtilization(b.innerBlk)
 	if err := b.acceptOuterBlk(); err != nil {
 		return err
 	}
 	if err := b.acceptInnerBlk(ctx); err != nil {
 		return err
 	}
+	b.vm.recordUtilization(utilization)
 	if b.slot != nil {
 		b.vm.acceptedBlocksSlotHistogram.Observe(float64(*b.slot))
 	}
@@ -31,10 +31,15 @@ func (b *postForkOption) Timestamp() time.Time {
 }
 
 func (b *postForkOption) Accept(ctx context.Context) error {
+	utilization := innerBlockUtilization(b.innerBlk)
 	if err := b.acceptOuterBlk(); err != nil {
 		return err
 	}
-	return b.acceptInnerBlk(ctx)
+	if err := b.acceptInnerBlk(ctx); err != nil {
+		return err
+	}
+	b.vm.recordUtilization(utilization)
+	return nil
 }
 
 func (b *postForkOption) acceptOuterBlk() error {
@@ -20,7 +20,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
 )
 
-const blockCacheSize = 64 * units.MiB
+const (
+	blockCacheSize      = 64 * units.MiB
+	innerBlockCacheSize = 2048
+)
 
 var (
 	errBlockWrongVersion = errors.New("wrong version")
@@ -32,12 +35,21 @@ type BlockState interface {
 	GetBlock(blkID ids.ID) (block.Block, error)
 	PutBlock(blk block.Block) error
 	DeleteBlock(blkID ids.ID) error
+
+	// GetBlockByInnerBlockID returns the recently cached block that wraps the
+	// inner block [innerBlkID]. If no such block is cached,
+	// database.ErrNotFound is returned.
+	GetBlockByInnerBlockID(innerBlkID ids.ID) (block.Block, error)
+	// CacheInnerBlockID caches that [blk] wraps the inner block [innerBlkID].
+	CacheInnerBlockID(innerBlkID ids.ID, blk block.Block)
 }
 
 type blockState struct {
 	// Caches BlockID -> Block. If the Block is nil, that means the block is not
 	// in storage.
 	blkCache cache.Cacher[ids.ID, *blockWrapper]
+	// Caches inner BlockID -> Block
+	innerBlkCache cache.Cacher[ids.ID, block.Block]
 
 	db database.Database
 }
@@ -62,7 +74,8 @@ func NewBlockState(db database.Database) BlockState {
 			blockCacheSize,
 			cachedBlockSize,
 		),
-		db: db,
+		innerBlkCache: &cache.LRU[ids.ID, block.Block]{Size: innerBlockCacheSize},
+		db:            db,
 	}
 }
 
@@ -75,10 +88,20 @@ func NewMeteredBlockState(db database.Database, namespace string, metrics promet
 			cachedBlockSize,
 		),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	innerBlkCache, err := metercacher.New[ids.ID, block.Block](
+		metric.AppendNamespace(namespace, "inner_block_id_cache"),
+		metrics,
+		&cache.LRU[ids.ID, block.Block]{Size: innerBlockCacheSize},
+	)
 
 	return &blockState{
-		blkCache: blkCache,
-		db:       db,
+		blkCache:      blkCache,
+		innerBlkCache: innerBlkCache,
+		db:            db,
 	}, err
 }
 
@@ -140,3 +163,15 @@ func (s *blockState) DeleteBlock(blkID ids.ID) error {
 	s.blkCache.Evict(blkID)
 	return s.db.Delete(blkID[:])
 }
+
+func (s *blockState) GetBlockByInnerBlockID(innerBlkID ids.ID) (block.Block, error) {
+	blk, found := s.innerBlkCache.Get(innerBlkID)
+	if !found {
+		return nil, database.ErrNotFound
+	}
+	return blk, nil
+}
+
+func (s *blockState) CacheInnerBlockID(innerBlkID ids.ID, blk block.Block) {
+	s.innerBlkCache.Put(innerBlkID, blk)
+}
@@ -58,6 +58,16 @@ func testBlockState(require *require.Assertions, bs BlockState) {
 	fetchedBlock, err = bs.GetBlock(b.ID())
 	require.NoError(err)
 	require.Equal(b.Bytes(), fetchedBlock.Bytes())
+
+	innerBlkID := ids.ID{5}
+	_, err = bs.GetBlockByInnerBlockID(innerBlkID)
+	require.Equal(database.ErrNotFound, err)
+
+	bs.CacheInnerBlockID(innerBlkID, b)
+
+	fetchedBlock, err = bs.GetBlockByInnerBlockID(innerBlkID)
+	require.NoError(err)
+	require.Equal(b.Bytes(), fetchedBlock.Bytes())
 }
 
 func TestBlockState(t *testing.T) {
@@ -22,6 +22,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils/buffer"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
@@ -50,8 +51,11 @@ var (
 	_ block.ChainVM         = (*VM)(nil)
 	_ block.BatchedChainVM  = (*VM)(nil)
 	_ block.StateSyncableVM = (*VM)(nil)
+	_ block.LocalBlocksVM   = (*VM)(nil)
 
-	dbPrefix = []byte("proposervm")
+	// DBPrefix is the prefix of the proposervm's database in the database of
+	// the chain.
+	DBPrefix = []byte("proposervm")
 )
 
 func cachedBlockSize(_ ids.ID, blk snowman.Block) int {
@@ -109,6 +113,25 @@ type VM struct {
 	// lastAcceptedTimestampGaugeVec reports timestamps for the last-accepted
 	// [postForkBlock] and its inner block.
 	lastAcceptedTimestampGaugeVec *prometheus.GaugeVec
+
+	// recentUtilization contains the utilization of the most recently accepted
+	// blocks that reported their utilization.
+	recentUtilization    buffer.Queue[float64]
+	recentUtilizationSum float64
+
+	// blockUtilizationGauge reports the average utilization of the recently
+	// accepted blocks.
+	blockUtilizationGauge prometheus.Gauge
+
+	// minBlockDelayGauge reports the last minimum delay, in seconds, that was
+	// applied when scheduling block building.
+	minBlockDelayGauge prometheus.Gauge
+
+	appSender common.AppSender
+	// checkpointsMsg is the last created checkpoints message, which publishes
+	// the checkpoints up to checkpointsHeight.
+	checkpointsHeight uint64
+	checkpointsMsg    []byte
 }
 
 // New performs best when [minBlkDelay] is whole seconds. This is because block
@@ -141,7 +164,8 @@ func (vm *VM) Initialize(
 	appSender common.AppSender,
 ) error {
 	vm.ctx = chainCtx
-	vm.db = versiondb.New(prefixdb.New(dbPrefix, db))
+	vm.appSender = appSender
+	vm.db = versiondb.New(prefixdb.New(DBPrefix, db))
 	baseState, err := state.NewMetered(vm.db, "state", vm.Config.Registerer)
 	if err != nil {
 		return err
@@ -171,6 +195,12 @@ func (vm *VM) Initialize(
 	})
 
 	vm.verifiedBlocks = make(map[ids.ID]PostForkBlock)
+	vm.recentUtilization, err = buffer.NewBoundedQueue(utilizationWindowSize, func(utilization float64) {
+		vm.recentUtilizationSum -= utilization
+	})
+	if err != nil {
+		return err
+	}
 	detachedCtx := context.WithoutCancel(ctx)
 	context, cancel := context.WithCancel(detachedCtx)
 	vm.context = context
@@ -242,11 +272,21 @@ func (vm *VM) Initialize(
 		},
 		[]string{"block_type"},
 	)
+	vm.blockUtilizationGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "block_utilization",
+		Help: "average utilization of the recently accepted blocks",
+	})
+	vm.minBlockDelayGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "min_block_delay",
+		Help: "minimum delay (in seconds) applied after the preferred block before building a block",
+	})
 
 	return errors.Join(
 		vm.Config.Registerer.Register(vm.proposerBuildSlotGauge),
 		vm.Config.Registerer.Register(vm.acceptedBlocksSlotHistogram),
 		vm.Config.Registerer.Register(vm.lastAcceptedTimestampGaugeVec),
+		vm.Config.Registerer.Register(vm.blockUtilizationGauge),
+		vm.Config.Registerer.Register(vm.minBlockDelayGauge),
 	)
 }
 
@@ -269,6 +309,11 @@ func (vm *VM) SetState(ctx context.Context, newState snow.State) error {
 
 	oldState := vm.consensusState
 	vm.consensusState = newState
+	if newState == snow.NormalOp && oldState != snow.NormalOp {
+		// Peers that connected while this node was bootstrapping haven't
+		// received its checkpoints.
+		vm.publishCheckpoints(ctx, allPeers)
+	}
 	if oldState != snow.StateSyncing {
 		return nil
 	}
@@ -315,6 +360,38 @@ func (vm *VM) GetBlock(ctx context.Context, id ids.ID) (snowman.Block, error) {
 	return vm.getBlock(ctx, id)
 }
 
+// GetBlockByInnerBlockID returns the accepted block that wraps the inner block
+// [innerBlkID].
+//
+// vm.ctx.Lock should be held
+func (vm *VM) GetBlockByInnerBlockID(ctx context.Context, innerBlkID ids.ID) (snowman.Block, error) {
+	if statelessBlk, err := vm.State.GetBlockByInnerBlockID(innerBlkID); err == nil {
+		// The block may have been deleted since it was cached, so it is
+		// fetched through the block state rather than wrapped directly.
+		if blk, err := vm.getPostForkBlock(ctx, statelessBlk.ID()); err == nil {
+			return blk, nil
+		}
+	}
+
+	innerBlk, err := vm.ChainVM.GetBlock(ctx, innerBlkID)
+	if err != nil {
+		return nil, err
+	}
+	blkID, err := vm.GetBlockIDAtHeight(ctx, innerBlk.Height())
+	if err != nil {
+		return nil, err
+	}
+	blk, err := vm.getBlock(ctx, blkID)
+	if err != nil {
+		return nil, err
+	}
+	if blk.getInnerBlk().ID() != innerBlkID {
+		// The inner block isn't accepted.
+		return nil, database.ErrNotFound
+	}
+	return blk, nil
+}
+
 func (vm *VM) SetPreference(ctx context.Context, preferred ids.ID) error {
 	if vm.preferred == preferred {
 		return nil
@@ -396,8 +473,10 @@ func (vm *VM) getPreDurangoSlotTime(
 	// avoid fast runs of blocks there is an additional minimum delay that
 	// validators can specify. This delay may be an issue for high performance,
 	// custom VMs. Until the P-chain is modified to target a specific block
-	// time, ProposerMinBlockDelay can be configured in the subnet config.
-	delay = max(delay, vm.MinBlkDelay)
+	// time, ProposerMinBlockDelay can be configured in the subnet config. If
+	// ProposerMaxBlockDelay is also configured, the delay is increased while
+	// recently accepted blocks are underutilized.
+	delay = max(delay, vm.minBlockDelay())
 	return parentTimestamp.Add(delay), nil
 }
 
@@ -420,13 +499,15 @@ func (vm *VM) getPostDurangoSlotTime(
 	// avoid fast runs of blocks there is an additional minimum delay that
 	// validators can specify. This delay may be an issue for high performance,
 	// custom VMs. Until the P-chain is modified to target a specific block
-	// time, ProposerMinBlockDelay can be configured in the subnet config.
+	// time, ProposerMinBlockDelay can be configured in the subnet config. If
+	// ProposerMaxBlockDelay is also configured, the delay is increased while
+	// recently accepted blocks are underutilized.
 	switch {
 	case err == nil:
-		delay = max(delay, vm.MinBlkDelay)
+		delay = max(delay, vm.minBlockDelay())
 		return parentTimestamp.Add(delay), nil
 	case errors.Is(err, proposer.ErrAnyoneCanPropose):
-		return parentTimestamp.Add(vm.MinBlkDelay), nil
+		return parentTimestamp.Add(vm.minBlockDelay()), nil
 	default:
 		return time.Time{}, err
 	}
@@ -615,6 +696,7 @@ func (vm *VM) getPostForkBlock(ctx context.Context, blkID ids.ID) (PostForkBlock
 	if err != nil {
 		return nil, err
 	}
+	vm.State.CacheInnerBlockID(innerBlk.ID(), statelessBlock)
 
 	if statelessSignedBlock, ok := statelessBlock.(statelessblock.SignedBlock); ok {
 		return &postForkBlock{
@@ -656,6 +738,7 @@ func (vm *VM) acceptPostForkBlock(blk PostForkBlock) error {
 	if err := vm.State.PutBlock(blk.getStatelessBlk()); err != nil {
 		return err
 	}
+	vm.State.CacheInnerBlockID(blk.getInnerBlk().ID(), blk.getStatelessBlk())
 	if err := vm.updateHeightIndex(height, blkID); err != nil {
 		return err
 	}
@@ -41,6 +41,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
+	"github.com/ava-labs/avalanchego/vms/proposervm/state"
 
 	statelessblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
 )
@@ -2660,3 +2661,63 @@ func TestSelectChildPChainHeight(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBlockByInnerBlockID(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	coreVM, _, proVM, _ := initTestProposerVM(t, time.Unix(0, 0), mockable.MaxTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(ctx))
+	}()
+
+	var (
+		acceptedInnerBlk = snowmantest.BuildChild(snowmantest.Genesis)
+		rejectedInnerBlk = snowmantest.BuildChild(snowmantest.Genesis)
+	)
+	coreVM.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case snowmantest.GenesisID:
+			return snowmantest.Genesis, nil
+		case acceptedInnerBlk.ID():
+			return acceptedInnerBlk, nil
+		case rejectedInnerBlk.ID():
+			return rejectedInnerBlk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	coreVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		switch {
+		case bytes.Equal(b, acceptedInnerBlk.Bytes()):
+			return acceptedInnerBlk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return acceptedInnerBlk, nil
+	}
+	outerBlk, err := proVM.BuildBlock(ctx)
+	require.NoError(err)
+	require.NoError(outerBlk.Verify(ctx))
+	require.NoError(outerBlk.Accept(ctx))
+
+	// The accepted block is cached.
+	blk, err := proVM.GetBlockByInnerBlockID(ctx, acceptedInnerBlk.ID())
+	require.NoError(err)
+	require.Equal(outerBlk.ID(), blk.ID())
+
+	// Drop the caches so that the block is resolved through the height index.
+	proVM.State = state.New(proVM.db)
+
+	blk, err = proVM.GetBlockByInnerBlockID(ctx, acceptedInnerBlk.ID())
+	require.NoError(err)
+	require.Equal(outerBlk.ID(), blk.ID())
+
+	_, err = proVM.GetBlockByInnerBlockID(ctx, rejectedInnerBlk.ID())
+	require.ErrorIs(err, database.ErrNotFound)
+
+	_, err = proVM.GetBlockByInnerBlockID(ctx, ids.GenerateTestID())
+	require.ErrorIs(err, errUnknownBlock)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/rpcchainvm"
 	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime/subprocess"
 )
 
 var (
@@ -42,6 +43,8 @@ type VMGetterConfig struct {
 	CPUTracker      resource.ProcessTracker
 	RuntimeTracker  runtime.Tracker
 	MetricsGatherer metrics.MultiGatherer
+	// Resource limits of each plugin VM process
+	Limits subprocess.Limits
 }
 
 type vmGetter struct {
@@ -106,6 +109,7 @@ func (getter *vmGetter) Get() (map[ids.ID]vms.Factory, map[ids.ID]vms.Factory, e
 			getter.config.CPUTracker,
 			getter.config.RuntimeTracker,
 			getter.config.MetricsGatherer,
+			getter.config.Limits,
 		)
 	}
 	return registeredVMs, unregisteredVMs, nil
@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blockmock"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+)
+
+var (
+	_ common.ConfigUpdater = (*configUpdaterVM)(nil)
+
+	validConfigBytes   = []byte(`{"valid":true}`)
+	invalidConfigBytes = []byte(`{"valid":false}`)
+
+	errInvalidConfig = errors.New("invalid config")
+)
+
+type configUpdaterVM struct {
+	*blockmock.ChainVM
+}
+
+func (*configUpdaterVM) OnConfigUpdated(_ context.Context, configBytes []byte) error {
+	if string(configBytes) != string(validConfigBytes) {
+		return errInvalidConfig
+	}
+	return nil
+}
+
+func configUpdaterTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "configUpdaterTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := blockmock.NewChainVM(ctrl)
+
+	if loadExpectations {
+		gomock.InOrder(
+			// Initialize
+			vm.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+		)
+	}
+
+	return &configUpdaterVM{
+		ChainVM: vm,
+	}
+}
+
+func configUpdaterUnsupportedTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "configUpdaterUnsupportedTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := blockmock.NewChainVM(ctrl)
+
+	if loadExpectations {
+		gomock.InOrder(
+			// Initialize
+			vm.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+		)
+	}
+
+	return vm
+}
+
+func TestConfigUpdater(t *testing.T) {
+	require := require.New(t)
+	testKey := configUpdaterTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	require.NoError(vm.OnConfigUpdated(context.Background(), validConfigBytes))
+
+	err := vm.OnConfigUpdated(context.Background(), invalidConfigBytes)
+	require.ErrorContains(err, errInvalidConfig.Error()) //nolint:forbidigo // currently returns grpc errors
+}
+
+func TestConfigUpdaterUnsupported(t *testing.T) {
+	require := require.New(t)
+	testKey := configUpdaterUnsupportedTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	err := vm.OnConfigUpdated(context.Background(), validConfigBytes)
+	require.ErrorIs(err, common.ErrConfigUpdaterNotImplemented)
+}
+
+func TestConfigUpdaterOldProtocolVersion(t *testing.T) {
+	require := require.New(t)
+
+	// Plugins implementing an older protocol version don't serve config
+	// updates, so the request shouldn't be sent.
+	vm := &VMClient{
+		protocolVersion: configUpdaterProtocolVersion - 1,
+	}
+	err := vm.OnConfigUpdated(context.Background(), validConfigBytes)
+	require.ErrorIs(err, common.ErrConfigUpdaterNotImplemented)
+}
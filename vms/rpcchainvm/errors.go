@@ -4,6 +4,12 @@
 package rpcchainvm
 
 import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 
@@ -29,3 +35,17 @@ func errorToRPCError(err error) error {
 	}
 	return err
 }
+
+// contextErrorFromRPCError returns an error wrapping the matching context error
+// if [err] was caused by the context of the RPC expiring or being canceled, so
+// that callers can detect it with errors.Is. Otherwise, [err] is returned.
+func contextErrorFromRPCError(err error) error {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+	case codes.Canceled:
+		return fmt.Errorf("%w: %w", context.Canceled, err)
+	default:
+		return err
+	}
+}
@@ -5,7 +5,9 @@ package rpcchainvm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/ava-labs/avalanchego/api/metrics"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -16,13 +18,17 @@ import (
 	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime/subprocess"
 )
 
-var _ vms.Factory = (*factory)(nil)
+var _ PluginFactory = (*factory)(nil)
 
 type factory struct {
 	path            string
 	processTracker  resource.ProcessTracker
 	runtimeTracker  runtime.Tracker
 	metricsGatherer metrics.MultiGatherer
+	limits          subprocess.Limits
+
+	pluginLock sync.Mutex
+	plugin     Plugin
 }
 
 func NewFactory(
@@ -30,12 +36,17 @@ func NewFactory(
 	processTracker resource.ProcessTracker,
 	runtimeTracker runtime.Tracker,
 	metricsGatherer metrics.MultiGatherer,
+	limits subprocess.Limits,
 ) vms.Factory {
 	return &factory{
 		path:            path,
 		processTracker:  processTracker,
 		runtimeTracker:  runtimeTracker,
 		metricsGatherer: metricsGatherer,
+		limits:          limits,
+		plugin: Plugin{
+			Path: path,
+		},
 	}
 }
 
@@ -44,6 +55,7 @@ func (f *factory) New(log logging.Logger) (interface{}, error) {
 		Stderr:           log,
 		Stdout:           log,
 		HandshakeTimeout: runtime.DefaultHandshakeTimeout,
+		Limits:           f.limits,
 		Log:              log,
 	}
 
@@ -58,6 +70,7 @@ func (f *factory) New(log logging.Logger) (interface{}, error) {
 		subprocess.NewCmd(f.path),
 		config,
 	)
+	f.recordHandshake(status, err)
 	if err != nil {
 		return nil, err
 	}
@@ -69,5 +82,31 @@ func (f *factory) New(log logging.Logger) (interface{}, error) {
 
 	f.processTracker.TrackProcess(status.Pid)
 	f.runtimeTracker.TrackRuntime(stopper)
-	return NewClient(clientConn, stopper, status.Pid, f.processTracker, f.metricsGatherer), nil
+	return NewClient(clientConn, stopper, status.Pid, status.ProtocolVersion, f.processTracker, f.metricsGatherer), nil
+}
+
+func (f *factory) Plugin() Plugin {
+	f.pluginLock.Lock()
+	defer f.pluginLock.Unlock()
+
+	return f.plugin
+}
+
+// recordHandshake records the protocol version negotiated with the plugin, or
+// the reason the handshake failed. Failures that aren't caused by the plugin's
+// protocol version don't change the recorded protocol version.
+func (f *factory) recordHandshake(status *subprocess.Status, err error) {
+	f.pluginLock.Lock()
+	defer f.pluginLock.Unlock()
+
+	f.plugin.Err = err
+	if err == nil {
+		f.plugin.ProtocolVersion = status.ProtocolVersion
+		return
+	}
+
+	var protocolVersionErr *runtime.ProtocolVersionError
+	if errors.As(err, &protocolVersionErr) {
+		f.plugin.ProtocolVersion = protocolVersionErr.ProtocolVersion
+	}
 }
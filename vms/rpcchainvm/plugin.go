@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/api/health"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
+)
+
+var errIncompatiblePlugins = errors.New("plugin VMs implement an unsupported RPCChainVM protocol version")
+
+// Plugin describes the binary of a VM that runs as a plugin.
+type Plugin struct {
+	// Path of the plugin binary
+	Path string
+	// RPCChainVM protocol version implemented by the plugin, as reported by
+	// its last handshake. 0 if the plugin never completed a handshake.
+	ProtocolVersion uint
+	// Why the last handshake with the plugin failed, if it did
+	Err error
+}
+
+// Compatible returns false if the plugin implements an RPCChainVM protocol
+// version that this node doesn't support.
+func (p Plugin) Compatible() bool {
+	return !errors.Is(p.Err, runtime.ErrProtocolVersionMismatch)
+}
+
+// PluginFactory is a factory of VMs that run as plugins.
+type PluginFactory interface {
+	vms.Factory
+
+	// Plugin returns the plugin the VMs are run with.
+	Plugin() Plugin
+}
+
+// GetPlugins returns the plugin of each VM registered in [manager] that runs as
+// a plugin.
+func GetPlugins(manager vms.Manager) (map[ids.ID]Plugin, error) {
+	vmIDs, err := manager.ListFactories()
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := make(map[ids.ID]Plugin)
+	for _, vmID := range vmIDs {
+		factory, err := manager.GetFactory(vmID)
+		if err != nil {
+			return nil, err
+		}
+		if factory, ok := factory.(PluginFactory); ok {
+			plugins[vmID] = factory.Plugin()
+		}
+	}
+	return plugins, nil
+}
+
+// NewHealthChecker reports the plugin VMs registered in [manager] that
+// implement an RPCChainVM protocol version that this node doesn't support.
+func NewHealthChecker(manager vms.Manager) health.Checker {
+	return health.CheckerFunc(func(context.Context) (interface{}, error) {
+		plugins, err := GetPlugins(manager)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			details      = make(map[string]uint, len(plugins))
+			incompatible []string
+		)
+		for vmID, plugin := range plugins {
+			alias, err := manager.PrimaryAlias(vmID)
+			if err != nil {
+				return nil, err
+			}
+			details[alias] = plugin.ProtocolVersion
+			if !plugin.Compatible() {
+				incompatible = append(incompatible, fmt.Sprintf("%s (%s) implements version %d", alias, plugin.Path, plugin.ProtocolVersion))
+			}
+		}
+		if len(incompatible) != 0 {
+			slices.Sort(incompatible)
+			return details, fmt.Errorf("%w: %s", errIncompatiblePlugins, strings.Join(incompatible, ", "))
+		}
+		return details, nil
+	})
+}
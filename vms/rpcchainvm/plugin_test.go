@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms"
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
+)
+
+type testPluginFactory struct {
+	plugin Plugin
+}
+
+func (*testPluginFactory) New(logging.Logger) (interface{}, error) {
+	return nil, nil
+}
+
+func (f *testPluginFactory) Plugin() Plugin {
+	return f.plugin
+}
+
+func TestPluginHealthChecker(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		ctx          = context.Background()
+		manager      = vms.NewManager(logging.NoLog{}, ids.NewAliaser())
+		compatibleID = ids.GenerateTestID()
+		compatible   = Plugin{
+			Path:            "compatible",
+			ProtocolVersion: version.RPCChainVMProtocol,
+		}
+	)
+	require.NoError(manager.RegisterFactory(ctx, compatibleID, &testPluginFactory{plugin: compatible}))
+
+	checker := NewHealthChecker(manager)
+	details, err := checker.HealthCheck(ctx)
+	require.NoError(err)
+	require.Equal(
+		map[string]uint{
+			compatibleID.String(): version.RPCChainVMProtocol,
+		},
+		details,
+	)
+
+	plugins, err := GetPlugins(manager)
+	require.NoError(err)
+	require.Equal(map[ids.ID]Plugin{compatibleID: compatible}, plugins)
+
+	incompatibleID := ids.GenerateTestID()
+	incompatible := Plugin{
+		Path:            "incompatible",
+		ProtocolVersion: version.RPCChainVMProtocol - 1,
+		Err: &runtime.ProtocolVersionError{
+			Path:            "incompatible",
+			ProtocolVersion: version.RPCChainVMProtocol - 1,
+		},
+	}
+	require.False(incompatible.Compatible())
+	require.NoError(manager.RegisterFactory(ctx, incompatibleID, &testPluginFactory{plugin: incompatible}))
+
+	details, err = checker.HealthCheck(ctx)
+	require.ErrorIs(err, errIncompatiblePlugins)
+	require.Equal(
+		map[string]uint{
+			compatibleID.String():   version.RPCChainVMProtocol,
+			incompatibleID.String(): version.RPCChainVMProtocol - 1,
+		},
+		details,
+	)
+}
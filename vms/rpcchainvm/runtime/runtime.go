@@ -6,7 +6,10 @@ package runtime
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
+
+	"github.com/ava-labs/avalanchego/version"
 )
 
 const (
@@ -25,8 +28,33 @@ var (
 	ErrHandshakeFailed         = errors.New("handshake failed")
 	ErrInvalidConfig           = errors.New("invalid config")
 	ErrProcessNotFound         = errors.New("vm process not found")
+	ErrProcessExited           = errors.New("vm process exited")
+	ErrMaxRSSExceeded          = errors.New("vm process exceeded its max RSS")
 )
 
+// ProtocolVersionError is returned when a VM implements an RPCChainVM protocol
+// version that this node doesn't support.
+type ProtocolVersionError struct {
+	// Path of the VM
+	Path string
+	// RPCChainVM protocol version implemented by the VM
+	ProtocolVersion uint
+}
+
+func (e *ProtocolVersionError) Error() string {
+	return fmt.Sprintf("%s. AvalancheGo version %s supports RPCChainVM protocol versions %v. The VM located at %s implements RPCChainVM protocol version %d. This can be resolved by updating your VM or running an older/newer version of AvalancheGo. Please be advised that some virtual machines may not yet support the latest RPCChainVM protocol version",
+		ErrProtocolVersionMismatch,
+		version.Current,
+		version.SupportedRPCChainVMProtocols,
+		e.Path,
+		e.ProtocolVersion,
+	)
+}
+
+func (*ProtocolVersionError) Unwrap() error {
+	return ErrProtocolVersionMismatch
+}
+
 type Initializer interface {
 	// Initialize provides AvalancheGo with compatibility, networking and
 	// process information of a VM.
@@ -39,6 +67,16 @@ type Stopper interface {
 	Stop(ctx context.Context)
 }
 
+type Monitor interface {
+	// Exited returns a channel that is closed once the VM has exited, whether
+	// or not it was stopped.
+	Exited() <-chan struct{}
+	// ExitErr returns why the VM exited without being stopped, or nil if it
+	// was stopped. Must only be called once the channel returned by Exited is
+	// closed.
+	ExitErr() error
+}
+
 type Tracker interface {
 	// TrackRuntime adds a VM stopper to the manager.
 	TrackRuntime(runtime Stopper)
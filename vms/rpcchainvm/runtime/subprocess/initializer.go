@@ -5,7 +5,7 @@ package subprocess
 
 import (
 	"context"
-	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/ava-labs/avalanchego/version"
@@ -19,6 +19,8 @@ type initializer struct {
 	path string
 
 	once sync.Once
+	// RPCChainVM protocol version implemented by the VM
+	protocolVersion uint
 	// Address of the RPC Chain VM server
 	vmAddr string
 	// Error, if one occurred, during Initialization
@@ -34,16 +36,16 @@ func newInitializer(path string) *initializer {
 	}
 }
 
+// Initialize accepts the VM if this node supports the protocol version it
+// implements.
 func (i *initializer) Initialize(_ context.Context, protocolVersion uint, vmAddr string) error {
 	i.once.Do(func() {
-		if version.RPCChainVMProtocol != protocolVersion {
-			i.err = fmt.Errorf("%w. AvalancheGo version %s implements RPCChainVM protocol version %d. The VM located at %s implements RPCChainVM protocol version %d. Please make sure that there is an exact match of the protocol versions. This can be achieved by updating your VM or running an older/newer version of AvalancheGo. Please be advised that some virtual machines may not yet support the latest RPCChainVM protocol version",
-				runtime.ErrProtocolVersionMismatch,
-				version.Current,
-				version.RPCChainVMProtocol,
-				i.path,
-				protocolVersion,
-			)
+		i.protocolVersion = protocolVersion
+		if !slices.Contains(version.SupportedRPCChainVMProtocols, protocolVersion) {
+			i.err = &runtime.ProtocolVersionError{
+				Path:            i.path,
+				ProtocolVersion: protocolVersion,
+			}
 		}
 		i.vmAddr = vmAddr
 		close(i.initialized)
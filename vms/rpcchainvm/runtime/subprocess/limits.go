@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package subprocess
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shirou/gopsutil/process"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
+)
+
+const (
+	// DefaultCPUShares is the CPU weight of the node's own process.
+	DefaultCPUShares = 1024
+
+	// Frequency the RSS of a VM process is compared against its limit.
+	rssCheckFrequency = time.Second
+
+	// Each nice value is weighted ~1.25x more than the next one.
+	niceWeightRatio = 1.25
+	minNice         = -20
+	maxNice         = 19
+)
+
+type Limits struct {
+	// Max resident set size, in bytes, of the VM process. The process is
+	// killed if it exceeds it. If 0, the RSS isn't limited.
+	MaxRSS uint64
+	// CPU weight of the VM process relative to [DefaultCPUShares], which is
+	// the weight of the node. If 0, the weight isn't changed.
+	CPUShares uint64
+}
+
+// cpuSharesToNice returns the nice value whose scheduling weight is closest to
+// [shares], where [DefaultCPUShares] is the weight of nice value 0.
+func cpuSharesToNice(shares uint64) int {
+	nice := math.Round(-math.Log(float64(shares)/DefaultCPUShares) / math.Log(niceWeightRatio))
+	return int(max(minNice, min(maxNice, nice)))
+}
+
+// applyLimits sets the CPU weight of the process and, if its RSS is limited,
+// starts killing the process once its RSS exceeds the limit.
+func applyLimits(s *stopper, limits Limits) {
+	pid := s.cmd.Process.Pid
+	if limits.CPUShares != 0 {
+		nice := cpuSharesToNice(limits.CPUShares)
+		if err := setNice(pid, nice); err != nil {
+			s.logger.Warn("failed to set the CPU shares of the subprocess",
+				zap.Uint64("cpuShares", limits.CPUShares),
+				zap.Int("nice", nice),
+				zap.Error(err),
+			)
+		}
+	}
+
+	if limits.MaxRSS != 0 {
+		go enforceMaxRSS(s, limits.MaxRSS)
+	}
+}
+
+// enforceMaxRSS kills the process if its RSS exceeds [maxRSS], until it exits.
+func enforceMaxRSS(s *stopper, maxRSS uint64) {
+	p, err := process.NewProcess(int32(s.cmd.Process.Pid))
+	if err != nil {
+		s.logger.Warn("failed to monitor the RSS of the subprocess",
+			zap.Error(err),
+		)
+		return
+	}
+
+	ticker := time.NewTicker(rssCheckFrequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.exited:
+			return
+		}
+
+		memory, err := p.MemoryInfo()
+		if err != nil {
+			// The process may have already exited.
+			continue
+		}
+		if memory.RSS <= maxRSS {
+			continue
+		}
+
+		s.logger.Error("killing subprocess",
+			zap.String("reason", "max RSS exceeded"),
+			zap.Uint64("rss", memory.RSS),
+			zap.Uint64("maxRSS", maxRSS),
+		)
+		s.kill(fmt.Errorf("%w: %d > %d", runtime.ErrMaxRSSExceeded, memory.RSS, maxRSS))
+		return
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package subprocess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUSharesToNice(t *testing.T) {
+	tests := []struct {
+		shares       uint64
+		expectedNice int
+	}{
+		{shares: 1, expectedNice: maxNice},
+		{shares: 256, expectedNice: 6},
+		{shares: 820, expectedNice: 1},
+		{shares: DefaultCPUShares, expectedNice: 0},
+		{shares: 1277, expectedNice: -1},
+		{shares: 4096, expectedNice: -6},
+		{shares: 1 << 30, expectedNice: minNice},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expectedNice, cpuSharesToNice(test.shares), "shares: %d", test.shares)
+	}
+}
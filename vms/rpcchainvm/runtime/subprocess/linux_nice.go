@@ -0,0 +1,15 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build linux
+// +build linux
+
+package subprocess
+
+import "syscall"
+
+// setNice sets the nice value of the process with the given pid. Lowering it
+// below 0 requires CAP_SYS_NICE.
+func setNice(pid int, nice int) error {
+	return syscall.Setpriority(syscall.PRIO_PROCESS, pid, nice)
+}
@@ -0,0 +1,15 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build !linux
+// +build !linux
+
+package subprocess
+
+import "errors"
+
+var errNiceUnsupported = errors.New("setting the nice value of a process is only supported on linux")
+
+func setNice(int, int) error {
+	return errNiceUnsupported
+}
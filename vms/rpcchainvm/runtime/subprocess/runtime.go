@@ -30,7 +30,9 @@ type Config struct {
 	Stdout io.Writer
 	// Duration engine server will wait for handshake success.
 	HandshakeTimeout time.Duration
-	Log              logging.Logger
+	// Resource limits of the VM process.
+	Limits Limits
+	Log    logging.Logger
 }
 
 type Status struct {
@@ -38,6 +40,8 @@ type Status struct {
 	Pid int
 	// Address of the VM gRPC service.
 	Addr string
+	// RPCChainVM protocol version negotiated with the VM.
+	ProtocolVersion uint
 }
 
 // Bootstrap starts a VM as a subprocess after initialization completes and
@@ -96,7 +100,8 @@ func Bootstrap(
 	}
 
 	log := config.Log
-	stopper := NewStopper(log, cmd)
+	stopper := newStopper(log, cmd)
+	applyLimits(stopper, config.Limits)
 
 	// start stdout collector
 	go func() {
@@ -106,7 +111,7 @@ func Bootstrap(
 				zap.Error(err),
 			)
 		}
-		stopper.Stop(context.TODO())
+		stopper.exit()
 
 		log.Info("stdout collector shutdown")
 	}()
@@ -119,7 +124,7 @@ func Bootstrap(
 				zap.Error(err),
 			)
 		}
-		stopper.Stop(context.TODO())
+		stopper.exit()
 
 		log.Info("stderr collector shutdown")
 	}()
@@ -145,8 +150,9 @@ func Bootstrap(
 	)
 
 	status := &Status{
-		Pid:  cmd.Process.Pid,
-		Addr: intitializer.vmAddr,
+		Pid:             cmd.Process.Pid,
+		Addr:            intitializer.vmAddr,
+		ProtocolVersion: intitializer.protocolVersion,
 	}
 	return status, stopper, nil
 }
@@ -7,15 +7,28 @@ import (
 	"context"
 	"os/exec"
 	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
 )
 
+var (
+	_ runtime.Stopper = (*stopper)(nil)
+	_ runtime.Monitor = (*stopper)(nil)
+)
+
 func NewStopper(logger logging.Logger, cmd *exec.Cmd) runtime.Stopper {
+	return newStopper(logger, cmd)
+}
+
+func newStopper(logger logging.Logger, cmd *exec.Cmd) *stopper {
 	return &stopper{
 		cmd:    cmd,
 		logger: logger,
+		exited: make(chan struct{}),
 	}
 }
 
@@ -23,10 +36,71 @@ type stopper struct {
 	once   sync.Once
 	cmd    *exec.Cmd
 	logger logging.Logger
+
+	// stopped is set once Stop is called
+	stopped atomic.Bool
+
+	exitOnce sync.Once
+	// exited is closed once the process has exited
+	exited chan struct{}
+	// killErr is why the process was killed by the node, if it was
+	killErr error
+	// exitErr is why the process exited without being stopped, if it did
+	exitErr error
+	lock    sync.Mutex
 }
 
 func (s *stopper) Stop(ctx context.Context) {
+	s.stopped.Store(true)
+	s.stop(ctx)
+}
+
+func (s *stopper) stop(ctx context.Context) {
 	s.once.Do(func() {
 		stop(ctx, s.logger, s.cmd)
 	})
 }
+
+// kill terminates the process because of [err], which is reported by ExitErr
+// unless the process was stopped.
+func (s *stopper) kill(err error) {
+	s.lock.Lock()
+	s.killErr = err
+	s.lock.Unlock()
+
+	if err := s.cmd.Process.Kill(); err != nil {
+		s.logger.Debug("failed to kill subprocess",
+			zap.Error(err),
+		)
+	}
+}
+
+// exit is called once the process has closed its output, which is assumed to
+// mean that it exited.
+func (s *stopper) exit() {
+	s.exitOnce.Do(func() {
+		s.lock.Lock()
+		if !s.stopped.Load() {
+			s.exitErr = s.killErr
+			if s.exitErr == nil {
+				s.exitErr = runtime.ErrProcessExited
+			}
+		}
+		s.lock.Unlock()
+
+		// Reap the process
+		s.stop(context.TODO())
+		close(s.exited)
+	})
+}
+
+func (s *stopper) Exited() <-chan struct{} {
+	return s.exited
+}
+
+func (s *stopper) ExitErr() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.exitErr
+}
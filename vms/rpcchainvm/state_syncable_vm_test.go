@@ -294,7 +294,7 @@ func buildClientHelper(require *require.Assertions, testKey string) *VMClient {
 	clientConn, err := grpcutils.Dial(status.Addr)
 	require.NoError(err)
 
-	return NewClient(clientConn, stopper, status.Pid, nil, metrics.NewPrefixGatherer())
+	return NewClient(clientConn, stopper, status.Pid, status.ProtocolVersion, nil, metrics.NewPrefixGatherer())
 }
 
 func TestStateSyncEnabled(t *testing.T) {
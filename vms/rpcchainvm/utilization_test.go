@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmanmock"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blockmock"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+
+	vmpb "github.com/ava-labs/avalanchego/proto/pb/vm"
+)
+
+var blkUtilization = []float64{.25, .5}
+
+type utilizationBlock struct {
+	*snowmanmock.Block
+}
+
+func (*utilizationBlock) Utilization() []float64 {
+	return blkUtilization
+}
+
+func utilizationTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "utilizationTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := blockmock.NewChainVM(ctrl)
+
+	if loadExpectations {
+		blk := snowmanmock.NewBlock(ctrl)
+		blk.EXPECT().ID().Return(blkID).AnyTimes()
+		blk.EXPECT().Parent().Return(parentID).AnyTimes()
+		blk.EXPECT().Bytes().Return(blkBytes).AnyTimes()
+		blk.EXPECT().Height().Return(uint64(1)).AnyTimes()
+		blk.EXPECT().Timestamp().Return(time.Now()).AnyTimes()
+		utilizationBlk := &utilizationBlock{
+			Block: blk,
+		}
+		gomock.InOrder(
+			// Initialize
+			vm.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+
+			// ParseBlock
+			vm.EXPECT().ParseBlock(gomock.Any(), blkBytes).Return(utilizationBlk, nil).Times(1),
+
+			// Verify
+			vm.EXPECT().ParseBlock(gomock.Any(), blkBytes).Return(utilizationBlk, nil).Times(1),
+			blk.EXPECT().Verify(gomock.Any()).Return(nil).Times(1),
+		)
+	}
+
+	return vm
+}
+
+func TestUtilization(t *testing.T) {
+	require := require.New(t)
+	testKey := utilizationTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	blk, err := vm.ParseBlock(context.Background(), blkBytes)
+	require.NoError(err)
+
+	blkWithUtilization, ok := blk.(block.WithUtilization)
+	require.True(ok)
+
+	// The utilization is reported by the server once the block is verified.
+	require.Empty(blkWithUtilization.Utilization())
+	require.NoError(blk.Verify(context.Background()))
+	require.Equal(blkUtilization, blkWithUtilization.Utilization())
+}
+
+func TestUtilizationOldProtocolVersion(t *testing.T) {
+	require := require.New(t)
+
+	resp := &vmpb.BlockVerifyResponse{
+		Utilization: blkUtilization,
+	}
+
+	vm := &VMClient{
+		protocolVersion: utilizationProtocolVersion,
+	}
+	require.Equal(blkUtilization, vm.blockUtilization(resp))
+
+	// Plugins implementing an older protocol version don't report the
+	// utilization of their blocks.
+	vm.protocolVersion = utilizationProtocolVersion - 1
+	require.Nil(vm.blockUtilization(resp))
+}
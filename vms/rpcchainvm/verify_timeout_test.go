@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmanmock"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blockmock"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+)
+
+func verifyTimeoutTestPlugin(t *testing.T, loadExpectations bool) block.ChainVM {
+	// test key is "verifyTimeoutTest"
+
+	// create mock
+	ctrl := gomock.NewController(t)
+	vm := blockmock.NewChainVM(ctrl)
+
+	if loadExpectations {
+		blk := snowmanmock.NewBlock(ctrl)
+		blk.EXPECT().ID().Return(blkID).AnyTimes()
+		blk.EXPECT().Parent().Return(parentID).AnyTimes()
+		blk.EXPECT().Bytes().Return(blkBytes).AnyTimes()
+		blk.EXPECT().Height().Return(uint64(1)).AnyTimes()
+		blk.EXPECT().Timestamp().Return(time.Now()).AnyTimes()
+		gomock.InOrder(
+			// Initialize
+			vm.EXPECT().Initialize(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(),
+			).Return(nil).Times(1),
+			vm.EXPECT().LastAccepted(gomock.Any()).Return(preSummaryBlk.ID(), nil).Times(1),
+			vm.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(preSummaryBlk, nil).Times(1),
+
+			// ParseBlock
+			vm.EXPECT().ParseBlock(gomock.Any(), blkBytes).Return(blk, nil).Times(1),
+
+			// Verify
+			vm.EXPECT().ParseBlock(gomock.Any(), blkBytes).Return(blk, nil).Times(1),
+			blk.EXPECT().Verify(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}).Times(1),
+		)
+	}
+
+	return vm
+}
+
+func TestVerifyTimeout(t *testing.T) {
+	require := require.New(t)
+	testKey := verifyTimeoutTestKey
+
+	// Create and start the plugin
+	vm := buildClientHelper(require, testKey)
+	defer vm.runtime.Stop(context.Background())
+
+	ctx := snowtest.Context(t, snowtest.CChainID)
+
+	require.NoError(vm.Initialize(context.Background(), ctx, memdb.New(), nil, nil, nil, nil, nil, nil))
+
+	blk, err := vm.ParseBlock(context.Background(), blkBytes)
+	require.NoError(err)
+
+	// The verification is interrupted by the timeout, which must be reported
+	// as a context error after crossing the gRPC boundary.
+	err = block.Verify(context.Background(), blk, 10*time.Millisecond)
+	require.ErrorIs(err, context.DeadlineExceeded)
+
+	var timeoutErr *block.VerifyTimeoutError
+	require.ErrorAs(err, &timeoutErr)
+	require.Equal(blkID, timeoutErr.BlkID)
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ava-labs/avalanchego/api/metrics"
@@ -61,6 +63,13 @@ const (
 	missingCacheSize    = 2048
 	unverifiedCacheSize = 64 * units.MiB
 	bytesToIDCacheSize  = 64 * units.MiB
+
+	// configUpdaterProtocolVersion is the first RPCChainVM protocol version
+	// that supports forwarding config updates.
+	configUpdaterProtocolVersion = 40
+	// utilizationProtocolVersion is the first RPCChainVM protocol version in
+	// which the utilization of verified blocks is reported.
+	utilizationProtocolVersion = 40
 )
 
 var (
@@ -71,10 +80,12 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*VMClient)(nil)
 	_ block.BatchedChainVM               = (*VMClient)(nil)
 	_ block.StateSyncableVM              = (*VMClient)(nil)
+	_ common.ConfigUpdater               = (*VMClient)(nil)
 	_ prometheus.Gatherer                = (*VMClient)(nil)
 
 	_ snowman.Block           = (*blockClient)(nil)
 	_ block.WithVerifyContext = (*blockClient)(nil)
+	_ block.WithUtilization   = (*blockClient)(nil)
 
 	_ block.StateSummary = (*summaryClient)(nil)
 )
@@ -85,8 +96,11 @@ type VMClient struct {
 	client          vmpb.VMClient
 	runtime         runtime.Stopper
 	pid             int
+	protocolVersion uint
 	processTracker  resource.ProcessTracker
 	metricsGatherer metrics.MultiGatherer
+	// Name the VM's metrics are registered with in [metricsGatherer]
+	metricsName string
 
 	messenger            *messenger.Server
 	sharedMemory         *gsharedmemory.Server
@@ -106,6 +120,7 @@ func NewClient(
 	clientConn *grpc.ClientConn,
 	runtime runtime.Stopper,
 	pid int,
+	protocolVersion uint,
 	processTracker resource.ProcessTracker,
 	metricsGatherer metrics.MultiGatherer,
 ) *VMClient {
@@ -113,6 +128,7 @@ func NewClient(
 		client:          vmpb.NewVMClient(clientConn),
 		runtime:         runtime,
 		pid:             pid,
+		protocolVersion: protocolVersion,
 		processTracker:  processTracker,
 		metricsGatherer: metricsGatherer,
 		conns:           []*grpc.ClientConn{clientConn},
@@ -148,6 +164,7 @@ func (vm *VMClient) Initialize(
 	if err != nil {
 		return err
 	}
+	vm.metricsName = primaryAlias
 	vm.grpcServerMetrics = grpc_prometheus.NewServerMetrics()
 	if err := serverReg.Register(vm.grpcServerMetrics); err != nil {
 		return err
@@ -366,9 +383,32 @@ func (vm *VMClient) Shutdown(ctx context.Context) error {
 	vm.runtime.Stop(ctx)
 
 	vm.processTracker.UntrackProcess(vm.pid)
+	// Allows the metrics to be registered again if the chain is restarted
+	vm.metricsGatherer.Deregister(vm.metricsName)
 	return errs.Err
 }
 
+// Exited returns a channel that is closed once the VM's process has exited. If
+// the VM's process isn't monitored, the returned channel is never closed.
+func (vm *VMClient) Exited() <-chan struct{} {
+	monitor, ok := vm.runtime.(runtime.Monitor)
+	if !ok {
+		return nil
+	}
+	return monitor.Exited()
+}
+
+// ExitErr returns why the VM's process exited without being shut down, or nil
+// if it was shut down. Must only be called once the channel returned by Exited
+// is closed.
+func (vm *VMClient) ExitErr() error {
+	monitor, ok := vm.runtime.(runtime.Monitor)
+	if !ok {
+		return nil
+	}
+	return monitor.ExitErr()
+}
+
 func (vm *VMClient) CreateHandlers(ctx context.Context) (map[string]http.Handler, error) {
 	resp, err := vm.client.CreateHandlers(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -738,6 +778,23 @@ func (vm *VMClient) GetStateSummary(ctx context.Context, summaryHeight uint64) (
 	}, err
 }
 
+func (vm *VMClient) OnConfigUpdated(ctx context.Context, configBytes []byte) error {
+	if vm.protocolVersion < configUpdaterProtocolVersion {
+		return common.ErrConfigUpdaterNotImplemented
+	}
+
+	_, err := vm.client.OnConfigUpdated(
+		ctx,
+		&vmpb.OnConfigUpdatedRequest{
+			ConfigBytes: configBytes,
+		},
+	)
+	if status.Code(err) == codes.Unimplemented {
+		return fmt.Errorf("%w: %w", common.ErrConfigUpdaterNotImplemented, err)
+	}
+	return err
+}
+
 func (vm *VMClient) newBlockFromBuildBlock(resp *vmpb.BuildBlockResponse) (*blockClient, error) {
 	id, err := ids.ToID(resp.Id)
 	if err != nil {
@@ -770,6 +827,7 @@ type blockClient struct {
 	height              uint64
 	time                time.Time
 	shouldVerifyWithCtx bool
+	utilization         []float64
 }
 
 func (b *blockClient) ID() ids.ID {
@@ -799,13 +857,23 @@ func (b *blockClient) Verify(ctx context.Context) error {
 		Bytes: b.bytes,
 	})
 	if err != nil {
-		return err
+		return contextErrorFromRPCError(err)
 	}
 
+	b.utilization = b.vm.blockUtilization(resp)
 	b.time, err = grpcutils.TimestampAsTime(resp.Timestamp)
 	return err
 }
 
+// blockUtilization returns the utilization reported in [resp], or nil if the
+// plugin doesn't report the utilization of its blocks.
+func (vm *VMClient) blockUtilization(resp *vmpb.BlockVerifyResponse) []float64 {
+	if vm.protocolVersion < utilizationProtocolVersion {
+		return nil
+	}
+	return resp.Utilization
+}
+
 func (b *blockClient) Bytes() []byte {
 	return b.bytes
 }
@@ -818,6 +886,12 @@ func (b *blockClient) Timestamp() time.Time {
 	return b.time
 }
 
+// Utilization returns the utilization reported by the server when this block
+// was verified.
+func (b *blockClient) Utilization() []float64 {
+	return b.utilization
+}
+
 func (b *blockClient) ShouldVerifyWithContext(context.Context) (bool, error) {
 	return b.shouldVerifyWithCtx, nil
 }
@@ -828,9 +902,10 @@ func (b *blockClient) VerifyWithContext(ctx context.Context, blockCtx *block.Con
 		PChainHeight: &blockCtx.PChainHeight,
 	})
 	if err != nil {
-		return err
+		return contextErrorFromRPCError(err)
 	}
 
+	b.utilization = b.vm.blockUtilization(resp)
 	b.time, err = grpcutils.TimestampAsTime(resp.Timestamp)
 	return err
 }
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ava-labs/avalanchego/api/metrics"
@@ -56,6 +58,7 @@ var (
 	originalStderr = os.Stderr
 
 	errExpectedBlockWithVerifyContext = errors.New("expected block.WithVerifyContext")
+	errExpectedConfigUpdater          = errors.New("expected common.ConfigUpdater")
 	errNilNetworkUpgradesPB           = errors.New("network upgrades protobuf is nil")
 )
 
@@ -755,9 +758,13 @@ func (vm *VMServer) BlockVerify(ctx context.Context, req *vmpb.BlockVerifyReques
 		return nil, err
 	}
 
-	return &vmpb.BlockVerifyResponse{
+	resp := &vmpb.BlockVerifyResponse{
 		Timestamp: grpcutils.TimestampFromTime(blk.Timestamp()),
-	}, nil
+	}
+	if blkWithUtilization, ok := blk.(block.WithUtilization); ok {
+		resp.Utilization = blkWithUtilization.Utilization()
+	}
+	return resp, nil
 }
 
 func (vm *VMServer) BlockAccept(ctx context.Context, req *vmpb.BlockAcceptRequest) (*emptypb.Empty, error) {
@@ -814,6 +821,14 @@ func (vm *VMServer) StateSummaryAccept(
 	}, errorToRPCError(err)
 }
 
+func (vm *VMServer) OnConfigUpdated(ctx context.Context, req *vmpb.OnConfigUpdatedRequest) (*emptypb.Empty, error) {
+	configUpdater, ok := vm.vm.(common.ConfigUpdater)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%s but got %T", errExpectedConfigUpdater, vm.vm)
+	}
+	return &emptypb.Empty{}, configUpdater.OnConfigUpdated(ctx, req.ConfigBytes)
+}
+
 func convertNetworkUpgrades(pbUpgrades *vmpb.NetworkUpgrades) (upgrade.Config, error) {
 	if pbUpgrades == nil {
 		return upgrade.Config{}, errNilNetworkUpgradesPB
@@ -37,6 +37,10 @@ const (
 	lastAcceptedBlockPostStateSummaryAcceptTestKey = "lastAcceptedBlockPostStateSummaryAcceptTest"
 	contextTestKey                                 = "contextTest"
 	batchedParseBlockCachingTestKey                = "batchedParseBlockCachingTest"
+	verifyTimeoutTestKey                           = "verifyTimeoutTest"
+	utilizationTestKey                             = "utilizationTest"
+	configUpdaterTestKey                           = "configUpdaterTest"
+	configUpdaterUnsupportedTestKey                = "configUpdaterUnsupportedTest"
 )
 
 var TestServerPluginMap = map[string]func(*testing.T, bool) block.ChainVM{
@@ -49,6 +53,10 @@ var TestServerPluginMap = map[string]func(*testing.T, bool) block.ChainVM{
 	lastAcceptedBlockPostStateSummaryAcceptTestKey: lastAcceptedBlockPostStateSummaryAcceptTestPlugin,
 	contextTestKey:                                 contextEnabledTestPlugin,
 	batchedParseBlockCachingTestKey:                batchedParseBlockCachingTestPlugin,
+	verifyTimeoutTestKey:                           verifyTimeoutTestPlugin,
+	utilizationTestKey:                             utilizationTestPlugin,
+	configUpdaterTestKey:                           configUpdaterTestPlugin,
+	configUpdaterUnsupportedTestKey:                configUpdaterUnsupportedTestPlugin,
 }
 
 // helperProcess helps with creating the subnet binary for testing.
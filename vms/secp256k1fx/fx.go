@@ -30,6 +30,7 @@ var (
 	ErrWrongNumberOfUTXOs             = errors.New("wrong number of utxos for the operation")
 	ErrWrongMintCreated               = errors.New("wrong mint output created from the operation")
 	ErrTimelocked                     = errors.New("output is time locked")
+	ErrHeightLocked                   = errors.New("output is height locked")
 	ErrTooManySigners                 = errors.New("input has more signers than expected")
 	ErrTooFewSigners                  = errors.New("input has less signers than expected")
 	ErrInputOutputIndexOutOfBounds    = errors.New("input referenced a nonexistent address in the output")
@@ -156,11 +157,36 @@ func (fx *Fx) VerifyTransfer(txIntf, inIntf, credIntf, utxoIntf interface{}) err
 	if !ok {
 		return ErrWrongCredentialType
 	}
-	out, ok := utxoIntf.(*TransferOutput)
-	if !ok {
+	switch out := utxoIntf.(type) {
+	case *TransferOutput:
+		return fx.VerifySpend(tx, in, cred, out)
+	case *HeightLockedOutput:
+		return fx.VerifyHeightLockedSpend(tx, in, cred, out)
+	default:
 		return ErrWrongUTXOType
 	}
-	return fx.VerifySpend(tx, in, cred, out)
+}
+
+// VerifyHeightLockedSpend ensures that the utxo is no longer height locked at
+// the height of the block that [utx] is being verified in and that it can be
+// sent to any address
+func (fx *Fx) VerifyHeightLockedSpend(utx UnsignedTx, in *TransferInput, cred *Credential, utxo *HeightLockedOutput) error {
+	if err := utxo.Verify(); err != nil {
+		return err
+	}
+
+	tx, ok := utx.(HeightTx)
+	if !ok {
+		return ErrWrongTxType
+	}
+	if height := tx.Height(); height < utxo.LockHeight {
+		return fmt.Errorf("%w: block height %d < lock height %d",
+			ErrHeightLocked,
+			height,
+			utxo.LockHeight,
+		)
+	}
+	return fx.VerifySpend(utx, in, cred, &utxo.TransferOutput)
 }
 
 // VerifySpend ensures that the utxo can be sent to any address
@@ -341,6 +341,83 @@ func TestFxVerifyTransferTimelocked(t *testing.T) {
 	require.ErrorIs(err, ErrTimelocked)
 }
 
+type testHeightTx struct {
+	TestTx
+	height uint64
+}
+
+func (tx *testHeightTx) Height() uint64 {
+	return tx.height
+}
+
+func TestFxVerifyTransferHeightLocked(t *testing.T) {
+	tests := []struct {
+		name        string
+		tx          UnsignedTx
+		expectedErr error
+	}{
+		{
+			name:        "unknown height",
+			tx:          &TestTx{UnsignedBytes: txBytes},
+			expectedErr: ErrWrongTxType,
+		},
+		{
+			name: "locked",
+			tx: &testHeightTx{
+				TestTx: TestTx{UnsignedBytes: txBytes},
+				height: 4,
+			},
+			expectedErr: ErrHeightLocked,
+		},
+		{
+			name: "unlocked",
+			tx: &testHeightTx{
+				TestTx: TestTx{UnsignedBytes: txBytes},
+				height: 5,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			vm := TestVM{
+				Codec: linearcodec.NewDefault(),
+				Log:   logging.NoLog{},
+			}
+			fx := Fx{}
+			require.NoError(fx.Initialize(&vm))
+			require.NoError(fx.Bootstrapping())
+			require.NoError(fx.Bootstrapped())
+			out := &HeightLockedOutput{
+				LockHeight: 5,
+				TransferOutput: TransferOutput{
+					Amt: 1,
+					OutputOwners: OutputOwners{
+						Threshold: 1,
+						Addrs: []ids.ShortID{
+							addr,
+						},
+					},
+				},
+			}
+			in := &TransferInput{
+				Amt: 1,
+				Input: Input{
+					SigIndices: []uint32{0},
+				},
+			}
+			cred := &Credential{
+				Sigs: [][secp256k1.SignatureLen]byte{
+					sigBytes,
+				},
+			}
+
+			err := fx.VerifyTransfer(test.tx, in, cred, out)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
 func TestFxVerifyTransferTooManySigners(t *testing.T) {
 	require := require.New(t)
 	vm := TestVM{
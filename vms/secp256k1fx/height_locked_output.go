@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	_ verify.State = (*HeightLockedOutput)(nil)
+
+	ErrNoLockHeight = errors.New("output has no lock height")
+)
+
+// HeightLockedOutput is a TransferOutput that can only be spent in blocks at or
+// above LockHeight on the chain it is consumed on.
+//
+// Unlike the wall-clock Locktime of the OutputOwners, the lock is expressed in
+// blocks, which makes it usable for vesting schedules driven by the chain's
+// progress.
+type HeightLockedOutput struct {
+	LockHeight uint64 `serialize:"true" json:"lockHeight"`
+
+	TransferOutput `serialize:"true"`
+}
+
+// MarshalJSON marshals LockHeight, Amt and the embedded OutputOwners struct
+// into a JSON readable format
+// If OutputOwners cannot be serialized then this will return error
+func (out *HeightLockedOutput) MarshalJSON() ([]byte, error) {
+	result, err := out.OutputOwners.Fields()
+	if err != nil {
+		return nil, err
+	}
+
+	result["amount"] = out.Amt
+	result["lockHeight"] = out.LockHeight
+	return json.Marshal(result)
+}
+
+func (out *HeightLockedOutput) Verify() error {
+	switch {
+	case out == nil:
+		return ErrNilOutput
+	case out.LockHeight == 0:
+		return ErrNoLockHeight
+	default:
+		return out.TransferOutput.Verify()
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestHeightLockedOutputVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		out         *HeightLockedOutput
+		expectedErr error
+	}{
+		{
+			name:        "nil",
+			out:         nil,
+			expectedErr: ErrNilOutput,
+		},
+		{
+			name: "no lock height",
+			out: &HeightLockedOutput{
+				TransferOutput: TransferOutput{
+					Amt: 1,
+					OutputOwners: OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ids.ShortEmpty},
+					},
+				},
+			},
+			expectedErr: ErrNoLockHeight,
+		},
+		{
+			name: "no value",
+			out: &HeightLockedOutput{
+				LockHeight: 1,
+				TransferOutput: TransferOutput{
+					OutputOwners: OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ids.ShortEmpty},
+					},
+				},
+			},
+			expectedErr: ErrNoValueOutput,
+		},
+		{
+			name: "valid",
+			out: &HeightLockedOutput{
+				LockHeight: 1,
+				TransferOutput: TransferOutput{
+					Amt: 1,
+					OutputOwners: OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{ids.ShortEmpty},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.out.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestHeightLockedOutputSerialize(t *testing.T) {
+	require := require.New(t)
+	c := linearcodec.NewDefault()
+	m := codec.NewDefaultManager()
+	require.NoError(c.RegisterType(&HeightLockedOutput{}))
+	require.NoError(m.RegisterCodec(0, c))
+
+	expected := &HeightLockedOutput{
+		LockHeight: 100,
+		TransferOutput: TransferOutput{
+			Amt: 1,
+			OutputOwners: OutputOwners{
+				Locktime:  2,
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ids.ShortEmpty},
+			},
+		},
+	}
+	b, err := m.Marshal(0, expected)
+	require.NoError(err)
+
+	parsed := &HeightLockedOutput{}
+	_, err = m.Unmarshal(b, parsed)
+	require.NoError(err)
+	require.Equal(expected, parsed)
+}
@@ -8,6 +8,15 @@ type UnsignedTx interface {
 	Bytes() []byte
 }
 
+// HeightTx is an UnsignedTx that is being verified for inclusion in a block.
+// Only HeightTxs are allowed to spend HeightLockedOutputs.
+type HeightTx interface {
+	UnsignedTx
+	// Height returns the height of the block that the tx is being verified
+	// for inclusion in.
+	Height() uint64
+}
+
 var _ UnsignedTx = (*TestTx)(nil)
 
 // TestTx is a minimal implementation of a Tx
@@ -20,6 +20,7 @@ var (
 	_ snowman.Block           = (*tracedBlock)(nil)
 	_ snowman.OracleBlock     = (*tracedBlock)(nil)
 	_ block.WithVerifyContext = (*tracedBlock)(nil)
+	_ block.WithUtilization   = (*tracedBlock)(nil)
 
 	errExpectedBlockWithVerifyContext = errors.New("expected block.WithVerifyContext")
 )
@@ -118,3 +119,11 @@ func (b *tracedBlock) VerifyWithContext(ctx context.Context, blockCtx *block.Con
 
 	return blkWithCtx.VerifyWithContext(ctx, blockCtx)
 }
+
+func (b *tracedBlock) Utilization() []float64 {
+	blkWithUtilization, ok := b.Block.(block.WithUtilization)
+	if !ok {
+		return nil
+	}
+	return blkWithUtilization.Utilization()
+}
@@ -0,0 +1,32 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tracedvm
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func (vm *blockVM) GetLocalBlocks(
+	ctx context.Context,
+	height uint64,
+	maxBlocksNum int,
+) ([][]byte, error) {
+	if vm.localVM == nil {
+		return nil, block.ErrLocalBlocksNotImplemented
+	}
+
+	ctx, span := vm.tracer.Start(ctx, vm.getLocalBlocksTag, oteltrace.WithAttributes(
+		attribute.Int64("height", int64(height)),
+		attribute.Int("maxBlocksNum", maxBlocksNum),
+	))
+	defer span.End()
+
+	return vm.localVM.GetLocalBlocks(ctx, height, maxBlocksNum)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package limits defines the size limits of the transactions and blocks of a
+// chain, so that the mempool, the block builder and block verification enforce
+// the same limits and wallets can discover them.
+package limits
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+)
+
+var (
+	// Default are the limits of the X-chain and P-chain.
+	//
+	// Invariant: MaxTxSize < MaxBlockSize. This guarantees that a block
+	// builder only stops adding txs to a block once there are no txs left or
+	// the block is at least MaxBlockSize - MaxTxSize bytes full.
+	Default = Limits{
+		MaxTxSize:    64 * units.KiB,
+		MaxBlockSize: 128 * units.KiB,
+	}
+
+	ErrTxTooLarge    = errors.New("tx too large")
+	ErrBlockTooLarge = errors.New("block too large")
+)
+
+// Provider is an optional interface that a VM can implement to report the
+// limits of its chain.
+type Provider interface {
+	TxLimits() Limits
+}
+
+type Tx interface {
+	Size() int
+}
+
+type Limits struct {
+	// Maximum size, in bytes, of a tx
+	MaxTxSize int `json:"maxTxSize"`
+	// Maximum size, in bytes, of the txs of a block
+	MaxBlockSize int `json:"maxBlockSize"`
+	// Maximum complexity of the txs of a block, in each fee dimension. Nil if
+	// the chain doesn't charge dynamic fees.
+	MaxBlockComplexity *gas.Dimensions `json:"maxBlockComplexity,omitempty"`
+}
+
+// WithGasConfig returns [l] with the maximum complexity of a block in each
+// dimension, if the block only consumed gas in that dimension, according to
+// [config].
+func (l Limits) WithGasConfig(config gas.Config) Limits {
+	var maxComplexity gas.Dimensions
+	for i, weight := range config.Weights {
+		if weight == 0 {
+			maxComplexity[i] = math.MaxUint64
+			continue
+		}
+		maxComplexity[i] = uint64(config.MaxCapacity) / weight
+	}
+	l.MaxBlockComplexity = &maxComplexity
+	return l
+}
+
+// VerifyTx returns an error if [tx] exceeds the limits of [l].
+func (l Limits) VerifyTx(tx Tx) error {
+	if txSize := tx.Size(); txSize > l.MaxTxSize {
+		return fmt.Errorf("%w: size (%d) > max size (%d)", ErrTxTooLarge, txSize, l.MaxTxSize)
+	}
+	return nil
+}
+
+// VerifyBlockTxs returns an error if any of [txs], or all of them together,
+// exceed the limits of [l].
+func VerifyBlockTxs[T Tx](l Limits, txs []T) error {
+	var blockSize int
+	for _, tx := range txs {
+		if err := l.VerifyTx(tx); err != nil {
+			return err
+		}
+		blockSize += tx.Size()
+	}
+	if blockSize > l.MaxBlockSize {
+		return fmt.Errorf("%w: size (%d) > max size (%d)", ErrBlockTooLarge, blockSize, l.MaxBlockSize)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package limits
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+)
+
+type testTx int
+
+func (t testTx) Size() int {
+	return int(t)
+}
+
+func TestDefaultInvariant(t *testing.T) {
+	require.Less(t, Default.MaxTxSize, Default.MaxBlockSize)
+}
+
+func TestWithGasConfig(t *testing.T) {
+	l := Default.WithGasConfig(gas.Config{
+		Weights: gas.Dimensions{
+			gas.Bandwidth:   1,
+			gas.DBRead:      1_000,
+			gas.DBWrite:     0,
+			gas.Compute:     4,
+			gas.StateGrowth: 4,
+		},
+		MaxCapacity: 1_000_000,
+	})
+	require.Equal(t, Limits{
+		MaxTxSize:    Default.MaxTxSize,
+		MaxBlockSize: Default.MaxBlockSize,
+		MaxBlockComplexity: &gas.Dimensions{
+			gas.Bandwidth:   1_000_000,
+			gas.DBRead:      1_000,
+			gas.DBWrite:     math.MaxUint64,
+			gas.Compute:     250_000,
+			gas.StateGrowth: 250_000,
+		},
+	}, l)
+	require.Nil(t, Default.MaxBlockComplexity)
+}
+
+func TestVerifyBlockTxs(t *testing.T) {
+	l := Limits{
+		MaxTxSize:    10,
+		MaxBlockSize: 25,
+	}
+	tests := []struct {
+		name        string
+		txs         []testTx
+		expectedErr error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "full",
+			txs:  []testTx{10, 10, 5},
+		},
+		{
+			name:        "tx too large",
+			txs:         []testTx{5, 11},
+			expectedErr: ErrTxTooLarge,
+		},
+		{
+			name:        "block too large",
+			txs:         []testTx{10, 10, 6},
+			expectedErr: ErrBlockTooLarge,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := VerifyBlockTxs(l, test.txs)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
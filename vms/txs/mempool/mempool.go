@@ -7,22 +7,24 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/linked"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/setmap"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 )
 
 const (
-	// MaxTxSize is the maximum number of bytes a transaction can use to be
-	// allowed into the mempool.
-	MaxTxSize = 64 * units.KiB
-
 	// droppedTxIDsCacheSize is the maximum number of dropped txIDs to cache
-	droppedTxIDsCacheSize = 64
+	droppedTxIDsCacheSize = 1024
+
+	// droppedTxRetention is how long the reason a tx was dropped is reported
+	droppedTxRetention = 30 * time.Minute
 
 	// maxMempoolSize is the maximum number of bytes allowed in the mempool
 	maxMempoolSize = 64 * units.MiB
@@ -30,7 +32,6 @@ const (
 
 var (
 	ErrDuplicateTx          = errors.New("duplicate tx")
-	ErrTxTooLarge           = errors.New("tx too large")
 	ErrMempoolFull          = errors.New("mempool is full")
 	ErrConflictsWithOtherTx = errors.New("tx conflicts with other tx")
 )
@@ -41,6 +42,16 @@ type Tx interface {
 	Size() int
 }
 
+// DroppedTx describes why a tx was dropped from the mempool.
+type DroppedTx struct {
+	Reason error
+	// ConflictingTxID is the ID of the tx that consumed an input of the
+	// dropped tx. Only non-empty if the tx was dropped because of a conflict.
+	ConflictingTxID ids.ID
+	// Time the tx was dropped.
+	Time time.Time
+}
+
 type Metrics interface {
 	Update(numTxs, bytesAvailable int)
 }
@@ -62,6 +73,9 @@ type Mempool[T Tx] interface {
 	// possibly reissued.
 	MarkDropped(txID ids.ID, reason error)
 	GetDropReason(txID ids.ID) error
+	// GetDroppedTx returns why [txID] was dropped, if it was dropped within
+	// the last [droppedTxRetention].
+	GetDroppedTx(txID ids.ID) (DroppedTx, bool)
 
 	// Len returns the number of txs in the mempool.
 	Len() int
@@ -72,19 +86,24 @@ type mempool[T Tx] struct {
 	unissuedTxs    *linked.Hashmap[ids.ID, T]
 	consumedUTXOs  *setmap.SetMap[ids.ID, ids.ID] // TxID -> Consumed UTXOs
 	bytesAvailable int
-	droppedTxIDs   *cache.LRU[ids.ID, error] // TxID -> Verification error
+	droppedTxIDs   *cache.LRU[ids.ID, DroppedTx] // TxID -> Drop reason
 
+	limits  limits.Limits
 	metrics Metrics
+	clock   mockable.Clock
 }
 
+// New returns a mempool that only accepts txs within [limits].
 func New[T Tx](
 	metrics Metrics,
+	limits limits.Limits,
 ) *mempool[T] {
 	m := &mempool[T]{
+		limits:         limits,
 		unissuedTxs:    linked.NewHashmap[ids.ID, T](),
 		consumedUTXOs:  setmap.New[ids.ID, ids.ID](),
 		bytesAvailable: maxMempoolSize,
-		droppedTxIDs:   &cache.LRU[ids.ID, error]{Size: droppedTxIDsCacheSize},
+		droppedTxIDs:   &cache.LRU[ids.ID, DroppedTx]{Size: droppedTxIDsCacheSize},
 		metrics:        metrics,
 	}
 	m.updateMetrics()
@@ -106,15 +125,10 @@ func (m *mempool[T]) Add(tx T) error {
 		return fmt.Errorf("%w: %s", ErrDuplicateTx, txID)
 	}
 
-	txSize := tx.Size()
-	if txSize > MaxTxSize {
-		return fmt.Errorf("%w: %s size (%d) > max size (%d)",
-			ErrTxTooLarge,
-			txID,
-			txSize,
-			MaxTxSize,
-		)
+	if err := m.limits.VerifyTx(tx); err != nil {
+		return fmt.Errorf("%s: %w", txID, err)
 	}
+	txSize := tx.Size()
 	if txSize > m.bytesAvailable {
 		return fmt.Errorf("%w: %s size (%d) > available space (%d)",
 			ErrMempoolFull,
@@ -164,9 +178,14 @@ func (m *mempool[T]) Remove(txs ...T) {
 		// If the transaction isn't in the mempool, remove any conflicts it has.
 		inputs := tx.InputIDs()
 		for _, removed := range m.consumedUTXOs.DeleteOverlapping(inputs) {
-			tx, _ := m.unissuedTxs.Get(removed.Key)
+			conflict, _ := m.unissuedTxs.Get(removed.Key)
 			m.unissuedTxs.Delete(removed.Key)
-			m.bytesAvailable += tx.Size()
+			m.bytesAvailable += conflict.Size()
+			m.droppedTxIDs.Put(removed.Key, DroppedTx{
+				Reason:          ErrConflictsWithOtherTx,
+				ConflictingTxID: txID,
+				Time:            m.clock.Time(),
+			})
 		}
 	}
 	m.updateMetrics()
@@ -204,12 +223,23 @@ func (m *mempool[_]) MarkDropped(txID ids.ID, reason error) {
 		return
 	}
 
-	m.droppedTxIDs.Put(txID, reason)
+	m.droppedTxIDs.Put(txID, DroppedTx{
+		Reason: reason,
+		Time:   m.clock.Time(),
+	})
 }
 
 func (m *mempool[_]) GetDropReason(txID ids.ID) error {
-	err, _ := m.droppedTxIDs.Get(txID)
-	return err
+	dropped, _ := m.GetDroppedTx(txID)
+	return dropped.Reason
+}
+
+func (m *mempool[_]) GetDroppedTx(txID ids.ID) (DroppedTx, bool) {
+	dropped, ok := m.droppedTxIDs.Get(txID)
+	if !ok || m.clock.Time().Sub(dropped.Time) > droppedTxRetention {
+		return DroppedTx{}, false
+	}
+	return dropped, true
 }
 
 func (m *mempool[_]) Len() int {
@@ -6,11 +6,13 @@ package mempool
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/txs/limits"
 )
 
 var _ Tx = (*dummyTx)(nil)
@@ -38,7 +40,7 @@ type noMetrics struct{}
 func (*noMetrics) Update(int, int) {}
 
 func newMempool() *mempool[*dummyTx] {
-	return New[*dummyTx](&noMetrics{})
+	return New[*dummyTx](&noMetrics{}, limits.Default)
 }
 
 func TestAdd(t *testing.T) {
@@ -68,14 +70,14 @@ func TestAdd(t *testing.T) {
 		{
 			name:       "attempt adding too large tx",
 			initialTxs: nil,
-			tx:         newTx(0, MaxTxSize+1),
-			err:        ErrTxTooLarge,
-			dropReason: ErrTxTooLarge,
+			tx:         newTx(0, limits.Default.MaxTxSize+1),
+			err:        limits.ErrTxTooLarge,
+			dropReason: limits.ErrTxTooLarge,
 		},
 		{
 			name:       "attempt adding tx when full",
-			initialTxs: newTxs(maxMempoolSize/MaxTxSize, MaxTxSize),
-			tx:         newTx(maxMempoolSize/MaxTxSize, MaxTxSize),
+			initialTxs: newTxs(maxMempoolSize/limits.Default.MaxTxSize, limits.Default.MaxTxSize),
+			tx:         newTx(uint64(maxMempoolSize/limits.Default.MaxTxSize), limits.Default.MaxTxSize),
 			err:        ErrMempoolFull,
 			dropReason: nil,
 		},
@@ -255,6 +257,40 @@ func TestDropped(t *testing.T) {
 	require.NoError(mempool.GetDropReason(txID))
 }
 
+func TestDroppedConflict(t *testing.T) {
+	require := require.New(t)
+
+	mempool := newMempool()
+	now := time.Now()
+	mempool.clock.Set(now)
+
+	tx := newTx(0, 32)
+	require.NoError(mempool.Add(tx))
+
+	// Removing a tx that isn't in the mempool drops the txs that consume the
+	// same inputs.
+	conflict := newTx(0, 32)
+	mempool.Remove(conflict)
+
+	dropped, ok := mempool.GetDroppedTx(tx.ID())
+	require.True(ok)
+	require.Equal(
+		DroppedTx{
+			Reason:          ErrConflictsWithOtherTx,
+			ConflictingTxID: conflict.ID(),
+			Time:            now,
+		},
+		dropped,
+	)
+	require.ErrorIs(mempool.GetDropReason(tx.ID()), ErrConflictsWithOtherTx)
+
+	// The reason is only reported for [droppedTxRetention].
+	mempool.clock.Set(now.Add(droppedTxRetention + time.Second))
+	_, ok = mempool.GetDroppedTx(tx.ID())
+	require.False(ok)
+	require.NoError(mempool.GetDropReason(tx.ID()))
+}
+
 func newTxs(num int, size int) []*dummyTx {
 	txs := make([]*dummyTx, num)
 	for i := range txs {
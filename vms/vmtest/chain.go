@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vmtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+// Chain is a consensus shim that drives a single chain as if the local node
+// was its only validator: blocks are accepted as soon as they are built.
+type Chain struct {
+	vm   block.ChainVM
+	lock sync.Locker
+
+	// errNoPendingBlocks is returned by the VM when it has nothing to build.
+	errNoPendingBlocks error
+}
+
+// NewChain returns a consensus shim for [vm], which is called with [lock]
+// held. [errNoPendingBlocks] is the error returned by [vm] when it doesn't
+// have a block to build.
+func NewChain(vm block.ChainVM, lock sync.Locker, errNoPendingBlocks error) *Chain {
+	return &Chain{
+		vm:                 vm,
+		lock:               lock,
+		errNoPendingBlocks: errNoPendingBlocks,
+	}
+}
+
+// Accept builds and accepts blocks until the VM doesn't have any more blocks
+// to build. The accepted blocks are returned in the order they were accepted.
+//
+// The proposals of oracle blocks are decided with the option preferred by the
+// VM.
+//
+// Invariant: The lock of the chain must not be held.
+func (c *Chain) Accept(ctx context.Context) ([]snowman.Block, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var accepted []snowman.Block
+	for {
+		blk, err := c.vm.BuildBlock(ctx)
+		if errors.Is(err, c.errNoPendingBlocks) {
+			return accepted, nil
+		}
+		if err != nil {
+			return accepted, fmt.Errorf("failed to build block: %w", err)
+		}
+
+		decided, err := c.decide(ctx, blk)
+		if err != nil {
+			return accepted, err
+		}
+		accepted = append(accepted, decided...)
+	}
+}
+
+// decide verifies and accepts [blk], along with its preferred option if [blk]
+// is an oracle block.
+func (c *Chain) decide(ctx context.Context, blk snowman.Block) ([]snowman.Block, error) {
+	decided := []snowman.Block{blk}
+	if err := blk.Verify(ctx); err != nil {
+		return nil, fmt.Errorf("failed to verify block %s: %w", blk.ID(), err)
+	}
+
+	if oracleBlk, ok := blk.(snowman.OracleBlock); ok {
+		options, err := oracleBlk.Options(ctx)
+		switch {
+		case err == nil:
+			// The first option is the one preferred by the VM.
+			option := options[0]
+			if err := option.Verify(ctx); err != nil {
+				return nil, fmt.Errorf("failed to verify option %s of block %s: %w", option.ID(), blk.ID(), err)
+			}
+			decided = append(decided, option)
+		case !errors.Is(err, snowman.ErrNotOracle):
+			return nil, fmt.Errorf("failed to get options of block %s: %w", blk.ID(), err)
+		}
+	}
+
+	preferred := decided[len(decided)-1]
+	if err := c.vm.SetPreference(ctx, preferred.ID()); err != nil {
+		return nil, fmt.Errorf("failed to set preference to %s: %w", preferred.ID(), err)
+	}
+	for _, blk := range decided {
+		if err := blk.Accept(ctx); err != nil {
+			return nil, fmt.Errorf("failed to accept block %s: %w", blk.ID(), err)
+		}
+	}
+	return decided, nil
+}
@@ -0,0 +1,369 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vmtest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/uptime"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	avmblockbuilder "github.com/ava-labs/avalanchego/vms/avm/block/builder"
+	avmconfig "github.com/ava-labs/avalanchego/vms/avm/config"
+	platformvmblockbuilder "github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
+	platformvmconfig "github.com/ava-labs/avalanchego/vms/platformvm/config"
+	platformvmgenesis "github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+)
+
+const DefaultInitialBalance = 100 * units.KiloAvax
+
+var (
+	// GenesisTime is the timestamp of the genesis of the network.
+	GenesisTime = genesistest.DefaultValidatorStartTime
+
+	// StartTime is the time the clocks of the VMs are initially set to. It is
+	// after [GenesisTime] so that the P-chain fee capacity is fully refilled
+	// by the time the first block is built.
+	StartTime = GenesisTime.Add(time.Minute)
+)
+
+type Config struct {
+	// Upgrades is the network upgrade schedule. If nil, all the network
+	// upgrades are activated at genesis.
+	Upgrades *upgrade.Config
+
+	// FundedKeys are allocated [InitialBalance] AVAX on both the P-chain and
+	// the X-chain. Defaults to [genesistest.DefaultFundedKeys].
+	FundedKeys []*secp256k1.PrivateKey
+	// InitialBalance defaults to [DefaultInitialBalance].
+	InitialBalance uint64
+}
+
+// Network is an in-memory primary network, without the C-chain, that is run
+// in process. It allows applications built on top of the VMs, such as wallets
+// and indexers, to be tested against the real VM logic.
+//
+// The VMs are staked and paid for using the local network parameters. The
+// network is shutdown when the test finishes.
+type Network struct {
+	PChainVM *platformvm.VM
+	XChainVM *avm.VM
+
+	// PChain and XChain accept the blocks built by their VM. Transactions
+	// issued to a VM are only accepted once its chain is told to accept
+	// blocks.
+	PChain *Chain
+	XChain *Chain
+
+	// SharedMemory is the atomic memory used to import and export UTXOs
+	// between the chains.
+	SharedMemory *atomic.Memory
+
+	// URI serves the APIs of the VMs, so the P-chain and X-chain clients can
+	// be created from it.
+	URI string
+
+	Upgrades    upgrade.Config
+	AVAXAssetID ids.ID
+}
+
+// New starts a network that is shutdown at the end of the test.
+func New(tb testing.TB, c Config) *Network {
+	require := require.New(tb)
+
+	upgrades := upgradetest.GetConfig(upgradetest.Latest)
+	if c.Upgrades != nil {
+		upgrades = *c.Upgrades
+	}
+	if len(c.FundedKeys) == 0 {
+		c.FundedKeys = genesistest.DefaultFundedKeys
+	}
+	if c.InitialBalance == 0 {
+		c.InitialBalance = DefaultInitialBalance
+	}
+
+	xChainGenesisBytes := newXChainGenesisBytes(tb, c.FundedKeys, c.InitialBalance)
+	avaxAssetID, err := genesis.AVAXAssetID(xChainGenesisBytes)
+	require.NoError(err)
+
+	var (
+		db           = memdb.New()
+		sharedMemory = atomic.NewMemory(prefixdb.New([]byte{0}, db))
+		pChainCtx    = newContext(tb, snowtest.PChainID, upgrades, avaxAssetID, sharedMemory)
+		xChainCtx    = newContext(tb, snowtest.XChainID, upgrades, avaxAssetID, sharedMemory)
+	)
+
+	n := &Network{
+		PChainVM:     newPChainVM(tb, pChainCtx, prefixdb.New([]byte{1}, db), c, upgrades, avaxAssetID),
+		XChainVM:     newXChainVM(tb, xChainCtx, prefixdb.New([]byte{2}, db), xChainGenesisBytes, upgrades),
+		SharedMemory: sharedMemory,
+		Upgrades:     upgrades,
+		AVAXAssetID:  avaxAssetID,
+	}
+	n.PChain = NewChain(n.PChainVM, &pChainCtx.Lock, platformvmblockbuilder.ErrNoPendingBlocks)
+	n.XChain = NewChain(n.XChainVM, &xChainCtx.Lock, avmblockbuilder.ErrNoTransactions)
+
+	// The APIs are served at the same paths as they are by a node, including
+	// the /ext/P alias of the P-chain.
+	mux := http.NewServeMux()
+	for vm, paths := range map[common.VM][]string{
+		n.PChainVM: {"/ext/P", "/ext/bc/P"},
+		n.XChainVM: {"/ext/bc/X"},
+	} {
+		handlers, err := vm.CreateHandlers(context.Background())
+		require.NoError(err)
+		for extension, handler := range handlers {
+			for _, path := range paths {
+				mux.Handle(path+extension, handler)
+			}
+		}
+	}
+	server := httptest.NewServer(mux)
+	tb.Cleanup(server.Close)
+	n.URI = server.URL
+
+	return n
+}
+
+// Accept builds and accepts blocks on all the chains until none of them has
+// any more blocks to build.
+func (n *Network) Accept(ctx context.Context) ([]snowman.Block, error) {
+	pChainBlks, err := n.PChain.Accept(ctx)
+	if err != nil {
+		return nil, err
+	}
+	xChainBlks, err := n.XChain.Accept(ctx)
+	return append(pChainBlks, xChainBlks...), err
+}
+
+// AdvanceTime sets the clocks of all the VMs to [t] and accepts the blocks
+// that the VMs build as a result, such as the P-chain blocks that reward the
+// stakers whose staking period ended by [t].
+//
+// The chain times are only advanced by the next blocks that are built, so
+// network upgrades scheduled by [t] are activated starting from those blocks.
+func (n *Network) AdvanceTime(ctx context.Context, t time.Time) ([]snowman.Block, error) {
+	n.PChainVM.Clock().Set(t)
+	n.XChainVM.Clock().Set(t)
+	return n.Accept(ctx)
+}
+
+// ActivateFork advances the time of the network to the time [fork] is
+// scheduled to be activated at.
+func (n *Network) ActivateFork(ctx context.Context, fork upgradetest.Fork) ([]snowman.Block, error) {
+	return n.AdvanceTime(ctx, upgradetest.GetActivationTime(n.Upgrades, fork))
+}
+
+func newContext(
+	tb testing.TB,
+	chainID ids.ID,
+	upgrades upgrade.Config,
+	avaxAssetID ids.ID,
+	sharedMemory *atomic.Memory,
+) *snow.Context {
+	ctx := snowtest.Context(tb, chainID)
+	ctx.NetworkUpgrades = upgrades
+	ctx.AVAXAssetID = avaxAssetID
+	ctx.SharedMemory = sharedMemory.NewSharedMemory(chainID)
+	return ctx
+}
+
+func newPChainVM(
+	tb testing.TB,
+	ctx *snow.Context,
+	db *prefixdb.Database,
+	c Config,
+	upgrades upgrade.Config,
+	avaxAssetID ids.ID,
+) *platformvm.VM {
+	require := require.New(tb)
+
+	params := genesis.LocalParams
+	vm := &platformvm.VM{Internal: platformvmconfig.Internal{
+		Chains:                 chains.TestManager,
+		Validators:             validators.NewManager(),
+		UptimeLockedCalculator: uptime.NewLockedCalculator(),
+		SybilProtectionEnabled: true,
+		DynamicFeeConfig:       params.DynamicFeeConfig,
+		ValidatorFeeConfig:     params.ValidatorFeeConfig,
+		UptimePercentage:       params.UptimeRequirement,
+		MinValidatorStake:      params.MinValidatorStake,
+		MaxValidatorStake:      params.MaxValidatorStake,
+		MinDelegatorStake:      params.MinDelegatorStake,
+		MinDelegationFee:       params.MinDelegationFee,
+		MinStakeDuration:       params.MinStakeDuration,
+		MaxStakeDuration:       params.MaxStakeDuration,
+		RewardConfig:           params.RewardConfig,
+		UpgradeConfig:          upgrades,
+	}}
+	vm.Clock().Set(StartTime)
+
+	genesisBytes := genesistest.NewBytes(tb, genesistest.Config{
+		AVAXAssetID:    avaxAssetID,
+		FundedKeys:     c.FundedKeys,
+		InitialBalance: c.InitialBalance,
+		StakingParams: &platformvmgenesis.StakingParams{
+			MinValidatorStake: params.MinValidatorStake,
+			MaxValidatorStake: params.MaxValidatorStake,
+			MinDelegatorStake: params.MinDelegatorStake,
+			MinStakeDuration:  uint64(params.MinStakeDuration / time.Second),
+			MaxStakeDuration:  uint64(params.MaxStakeDuration / time.Second),
+		},
+	})
+
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	require.NoError(vm.Initialize(
+		context.Background(),
+		ctx,
+		db,
+		genesisBytes,
+		nil,
+		nil,
+		make(chan common.Message, 1),
+		nil,
+		&enginetest.Sender{},
+	))
+	require.NoError(vm.SetState(context.Background(), snow.Bootstrapping))
+	require.NoError(vm.SetState(context.Background(), snow.NormalOp))
+
+	tb.Cleanup(func() {
+		ctx.Lock.Lock()
+		defer ctx.Lock.Unlock()
+
+		require.NoError(vm.Shutdown(context.Background()))
+	})
+	return vm
+}
+
+func newXChainVM(
+	tb testing.TB,
+	ctx *snow.Context,
+	db *prefixdb.Database,
+	genesisBytes []byte,
+	upgrades upgrade.Config,
+) *avm.VM {
+	require := require.New(tb)
+
+	params := genesis.LocalParams
+	vm := &avm.VM{Config: avmconfig.Config{
+		Upgrades:         upgrades,
+		TxFee:            params.TxFee,
+		CreateAssetTxFee: params.CreateAssetTxFee,
+	}}
+	vm.Clock().Set(StartTime)
+
+	configBytes, err := json.Marshal(avm.DefaultConfig)
+	require.NoError(err)
+
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	require.NoError(vm.Initialize(
+		context.Background(),
+		ctx,
+		db,
+		genesisBytes,
+		nil,
+		configBytes,
+		nil,
+		[]*common.Fx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: &secp256k1fx.Fx{},
+			},
+			{
+				ID: nftfx.ID,
+				Fx: &nftfx.Fx{},
+			},
+			{
+				ID: propertyfx.ID,
+				Fx: &propertyfx.Fx{},
+			},
+		},
+		&enginetest.Sender{},
+	))
+	require.NoError(vm.SetState(context.Background(), snow.Bootstrapping))
+	require.NoError(vm.Linearize(context.Background(), ids.Empty, make(chan common.Message, 1)))
+	require.NoError(vm.SetState(context.Background(), snow.NormalOp))
+
+	tb.Cleanup(func() {
+		ctx.Lock.Lock()
+		defer ctx.Lock.Unlock()
+
+		require.NoError(vm.Shutdown(context.Background()))
+	})
+	return vm
+}
+
+func newXChainGenesisBytes(tb testing.TB, keys []*secp256k1.PrivateKey, balance uint64) []byte {
+	require := require.New(tb)
+
+	holders := make([]interface{}, len(keys))
+	for i, key := range keys {
+		addr, err := address.FormatBech32(constants.UnitTestHRP, key.Address().Bytes())
+		require.NoError(err)
+		holders[i] = avm.Holder{
+			Amount:  avajson.Uint64(balance),
+			Address: addr,
+		}
+	}
+
+	reply := avm.BuildGenesisReply{}
+	require.NoError(avm.CreateStaticService().BuildGenesis(
+		nil,
+		&avm.BuildGenesisArgs{
+			NetworkID: avajson.Uint32(constants.UnitTestID),
+			GenesisData: map[string]avm.AssetDefinition{
+				"AVAX": {
+					Name:         "Avalanche",
+					Symbol:       "AVAX",
+					Denomination: 9,
+					InitialState: map[string][]interface{}{
+						"fixedCap": holders,
+					},
+				},
+			},
+			Encoding: formatting.Hex,
+		},
+		&reply,
+	))
+
+	genesisBytes, err := formatting.Decode(reply.Encoding, reply.Bytes)
+	require.NoError(err)
+	return genesisBytes
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vmtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	xbuilder "github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+	xsigner "github.com/ava-labs/avalanchego/wallet/chain/x/signer"
+)
+
+func TestNetwork(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	fortunaTime := GenesisTime.Add(time.Hour)
+	upgrades := upgradetest.GetConfig(upgradetest.Etna)
+	upgradetest.SetTimesTo(&upgrades, upgradetest.Fortuna, fortunaTime)
+	n := New(t, Config{
+		Upgrades: &upgrades,
+	})
+
+	var (
+		pClient = platformvm.NewClient(n.URI)
+		xClient = avm.NewClient(n.URI, xbuilder.Alias)
+
+		key       = genesistest.DefaultFundedKeys[0]
+		addr      = key.Address()
+		recipient = ids.GenerateTestShortID()
+	)
+
+	pChainTime, err := pClient.GetTimestamp(ctx)
+	require.NoError(err)
+	require.Equal(GenesisTime.Unix(), pChainTime.Unix())
+
+	// Nothing needs to be built to activate the fork.
+	blks, err := n.ActivateFork(ctx, upgradetest.Fortuna)
+	require.NoError(err)
+	require.Empty(blks)
+
+	// Send AVAX on the X-chain using the wallet.
+	utxos := common.NewUTXOs()
+	require.NoError(primary.AddAllUTXOs(
+		ctx,
+		utxos,
+		xClient,
+		xbuilder.Parser.Codec(),
+		snowtest.XChainID,
+		snowtest.XChainID,
+		[]ids.ShortID{addr},
+	))
+	xContext := &xbuilder.Context{
+		NetworkID:        constants.UnitTestID,
+		BlockchainID:     snowtest.XChainID,
+		AVAXAssetID:      n.AVAXAssetID,
+		BaseTxFee:        genesis.LocalParams.TxFee,
+		CreateAssetTxFee: genesis.LocalParams.CreateAssetTxFee,
+	}
+	xBackend := x.NewBackend(xContext, common.NewChainUTXOs(snowtest.XChainID, utxos))
+	utx, err := xbuilder.New(set.Of(addr), xContext, xBackend).NewBaseTx(
+		[]*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: n.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{recipient},
+				},
+			},
+		}},
+	)
+	require.NoError(err)
+	tx, err := xsigner.SignUnsigned(ctx, xsigner.New(secp256k1fx.NewKeychain(key), xBackend), utx)
+	require.NoError(err)
+
+	// The tx is only accepted once the chain is told to accept blocks.
+	_, err = n.XChainVM.IssueTxFromRPC(tx)
+	require.NoError(err)
+	blks, err = n.XChain.Accept(ctx)
+	require.NoError(err)
+	require.Len(blks, 1)
+	require.Equal(fortunaTime.Unix(), blks[0].Timestamp().Unix())
+
+	balance, err := xClient.GetBalance(ctx, recipient, n.AVAXAssetID.String(), false)
+	require.NoError(err)
+	require.Equal(units.Avax, uint64(balance.Balance))
+
+	// Advancing time past the end of the genesis validators rewards them.
+	blks, err = n.AdvanceTime(ctx, genesistest.DefaultValidatorEndTime)
+	require.NoError(err)
+	// Each validator is rewarded by a proposal block and its commit block.
+	require.Len(blks, 2*len(genesistest.DefaultNodeIDs))
+
+	validators, err := pClient.GetCurrentValidators(ctx, constants.PrimaryNetworkID, nil)
+	require.NoError(err)
+	require.Empty(validators)
+
+	pChainTime, err = pClient.GetTimestamp(ctx)
+	require.NoError(err)
+	require.Equal(genesistest.DefaultValidatorEndTime.Unix(), pChainTime.Unix())
+}
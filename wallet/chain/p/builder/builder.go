@@ -33,8 +33,11 @@ var (
 	ErrUnknownOwnerType          = errors.New("unknown owner type")
 	ErrInsufficientAuthorization = errors.New("insufficient authorization")
 	ErrInsufficientFunds         = errors.New("insufficient funds")
+	ErrUnknownRewardUTXO         = errors.New("unknown reward UTXO")
+	ErrRewardOwnerMismatch       = errors.New("reward UTXOs have different owners")
 
 	_ Builder = (*builder)(nil)
+	_ error   = (*InsufficientFundsError)(nil)
 )
 
 // Builder provides a convenient interface for building unsigned P-chain
@@ -101,6 +104,15 @@ type Builder interface {
 		options ...common.Option,
 	) (*txs.RemoveSubnetValidatorTx, error)
 
+	// NewSetSubnetValidatorWeightTx sets the weight of [nodeID] in the
+	// validator set [subnetID] to [weight].
+	NewSetSubnetValidatorWeightTx(
+		nodeID ids.NodeID,
+		subnetID ids.ID,
+		weight uint64,
+		options ...common.Option,
+	) (*txs.SetSubnetValidatorWeightTx, error)
+
 	// NewAddDelegatorTx creates a new delegator to a validator on the primary
 	// network.
 	//
@@ -210,6 +222,18 @@ type Builder interface {
 		options ...common.Option,
 	) (*txs.DisableL1ValidatorTx, error)
 
+	// NewClaimRewardsTx creates a transaction that consumes the provided
+	// reward UTXOs and sends the claimed funds, minus the fee, to [to].
+	//
+	// - [rewardUTXOIDs] specifies the IDs of the reward UTXOs to claim. All of
+	//   the reward UTXOs must have the same owner.
+	// - [to] specifies where to send the claimed funds to.
+	NewClaimRewardsTx(
+		rewardUTXOIDs []ids.ID,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.ClaimRewardsTx, error)
+
 	// NewImportTx creates an import transaction that attempts to consume all
 	// the available UTXOs and import the funds to [to].
 	//
@@ -319,6 +343,78 @@ type Builder interface {
 		rewardsOwner *secp256k1fx.OutputOwners,
 		options ...common.Option,
 	) (*txs.AddPermissionlessDelegatorTx, error)
+
+	// NewAddContinuousValidatorTx creates a new validator of the primary
+	// network that is automatically restaked at the end of every staking
+	// period until it is stopped.
+	//
+	// - [vdr] specifies all the details of the first validation period such as
+	//   the startTime, endTime, stake weight, and nodeID.
+	// - [signer] is the BLS key for this validator.
+	// - [validationRewardsOwner] specifies the owner of all the rewards this
+	//   validator earns for its validation periods. This owner is also allowed
+	//   to stop the validator.
+	// - [delegationRewardsOwner] specifies the owner of all the rewards this
+	//   validator earns during its validation periods from delegators.
+	// - [shares] specifies the fraction (out of 1,000,000) that this validator
+	//   will take from delegation rewards.
+	// - [period] specifies the duration of every subsequent staking period.
+	NewAddContinuousValidatorTx(
+		vdr *txs.Validator,
+		signer signer.Signer,
+		validationRewardsOwner *secp256k1fx.OutputOwners,
+		delegationRewardsOwner *secp256k1fx.OutputOwners,
+		shares uint32,
+		period time.Duration,
+		options ...common.Option,
+	) (*txs.AddContinuousValidatorTx, error)
+
+	// NewStopContinuousValidatorTx stops the continuous validator that was
+	// added by [txID] from being restaked. The validator will be removed, and
+	// its stake and rewards returned, at the end of its current staking period.
+	//
+	// - [txID] specifies the AddContinuousValidatorTx that added the validator.
+	NewStopContinuousValidatorTx(
+		txID ids.ID,
+		options ...common.Option,
+	) (*txs.StopContinuousValidatorTx, error)
+
+	// NewAddMultiDelegatorTx creates new delegators of the primary network on
+	// each of the specified nodeIDs. The total stake is spent atomically, with
+	// a single fee and change output.
+	//
+	// - [vdrs] specifies all the details of each delegation period such as the
+	//   endTime, stake weight, and nodeID. Each nodeID may only be specified
+	//   once.
+	// - [rewardsOwner] specifies the owner of all the rewards these delegators
+	//   earn during their delegation periods.
+	NewAddMultiDelegatorTx(
+		vdrs []*txs.Validator,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.AddMultiDelegatorTx, error)
+
+	// NewSetWithdrawalOwnerTx binds a withdrawal owner to the validator that
+	// was added by [txID]. The future rewards of the validator are paid to the
+	// withdrawal owner rather than to its rewards owners.
+	//
+	// - [txID] specifies the tx that added the validator.
+	// - [withdrawalOwner] specifies who the rewards of the validator are paid
+	//   to.
+	NewSetWithdrawalOwnerTx(
+		txID ids.ID,
+		withdrawalOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.SetWithdrawalOwnerTx, error)
+
+	// NewRetireChainTx retires a chain of a permissioned subnet. The owner of
+	// the chain must be known to the backend by the chain's ID.
+	//
+	// - [chainID] specifies the chain to retire.
+	NewRetireChainTx(
+		chainID ids.ID,
+		options ...common.Option,
+	) (*txs.RetireChainTx, error)
 }
 
 type Backend interface {
@@ -578,6 +674,65 @@ func (b *builder) NewRemoveSubnetValidatorTx(
 	return tx, b.initCtx(tx)
 }
 
+func (b *builder) NewSetSubnetValidatorWeightTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	weight uint64,
+	options ...common.Option,
+) (*txs.SetSubnetValidatorWeightTx, error) {
+	toBurn := map[ids.ID]uint64{}
+	toStake := map[ids.ID]uint64{}
+
+	ops := common.NewOptions(options)
+	subnetAuth, err := b.authorize(subnetID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	authComplexity, err := fee.AuthComplexity(subnetAuth)
+	if err != nil {
+		return nil, err
+	}
+	complexity, err := fee.IntrinsicSetSubnetValidatorWeightTxComplexities.Add(
+		&memoComplexity,
+		&authComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, outputs, _, err := b.spend(
+		toBurn,
+		toStake,
+		0,
+		complexity,
+		nil,
+		ops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &txs.SetSubnetValidatorWeightTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         memo,
+		}},
+		NodeID:     nodeID,
+		Subnet:     subnetID,
+		Weight:     weight,
+		SubnetAuth: subnetAuth,
+	}
+	return tx, b.initCtx(tx)
+}
+
 func (b *builder) NewAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -1106,13 +1261,140 @@ func (b *builder) NewDisableL1ValidatorTx(
 	return tx, b.initCtx(tx)
 }
 
+func (b *builder) NewClaimRewardsTx(
+	rewardUTXOIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ClaimRewardsTx, error) {
+	ops := common.NewOptions(options)
+	utxos, err := b.spendableUTXOs(constants.PlatformChainID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utxosByID := make(map[ids.ID]*avax.UTXO, len(utxos))
+	for _, utxo := range utxos {
+		utxosByID[utxo.InputID()] = utxo
+	}
+
+	var (
+		avaxAssetID = b.context.AVAXAssetID
+
+		rewardUTXOs   = make([]*avax.UTXOID, 0, len(rewardUTXOIDs))
+		rewardOwner   *secp256k1fx.OutputOwners
+		claimedAmount uint64
+	)
+	for _, utxoID := range rewardUTXOIDs {
+		utxo, ok := utxosByID[utxoID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownRewardUTXO, utxoID)
+		}
+		if utxo.AssetID() != avaxAssetID {
+			return nil, fmt.Errorf("%w: %s is not an AVAX UTXO", ErrUnknownRewardUTXO, utxoID)
+		}
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, ErrUnknownOutputType
+		}
+
+		if rewardOwner == nil {
+			rewardOwner = &out.OutputOwners
+		} else if !rewardOwner.Equals(&out.OutputOwners) {
+			return nil, ErrRewardOwnerMismatch
+		}
+
+		claimedAmount, err = math.Add(claimedAmount, out.Amt)
+		if err != nil {
+			return nil, err
+		}
+		rewardUTXOs = append(rewardUTXOs, &utxo.UTXOID)
+	}
+	if len(rewardUTXOs) == 0 {
+		return nil, fmt.Errorf(
+			"%w: no reward UTXOs to claim",
+			ErrInsufficientFunds,
+		)
+	}
+	utils.Sort(rewardUTXOs)
+
+	addrs := ops.Addresses(b.addrs)
+	minIssuanceTime := ops.MinIssuanceTime()
+	inputSigIndices, ok := common.MatchOwners(rewardOwner, addrs, minIssuanceTime)
+	if !ok {
+		// We can't authorize the claim
+		return nil, ErrInsufficientAuthorization
+	}
+	claimAuth := &secp256k1fx.Input{
+		SigIndices: inputSigIndices,
+	}
+
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	rewardUTXOComplexity, err := fee.RewardUTXOComplexity(rewardUTXOs...)
+	if err != nil {
+		return nil, err
+	}
+	authComplexity, err := fee.AuthComplexity(claimAuth)
+	if err != nil {
+		return nil, err
+	}
+	complexity, err := fee.IntrinsicClaimRewardsTxComplexities.Add(
+		&memoComplexity,
+		&rewardUTXOComplexity,
+		&authComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		toBurn  = map[ids.ID]uint64{}
+		toStake = map[ids.ID]uint64{}
+	)
+	// The reward UTXOs must not also be consumed as inputs of the tx.
+	spender := &builder{
+		addrs:   b.addrs,
+		context: b.context,
+		backend: &excludeUTXOsBackend{
+			Backend:  b.backend,
+			excluded: set.Of(rewardUTXOIDs...),
+		},
+	}
+	inputs, outputs, _, err := spender.spend(
+		toBurn,
+		toStake,
+		claimedAmount,
+		complexity,
+		to,
+		ops,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	tx := &txs.ClaimRewardsTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         memo,
+		}},
+		RewardUTXOs: rewardUTXOs,
+		ClaimAuth:   claimAuth,
+	}
+	return tx, b.initCtx(tx)
+}
+
 func (b *builder) NewImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.ImportTx, error) {
 	ops := common.NewOptions(options)
-	utxos, err := b.backend.UTXOs(ops.Context(), sourceChainID)
+	utxos, err := b.spendableUTXOs(sourceChainID, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -1493,52 +1775,526 @@ func (b *builder) NewAddPermissionlessDelegatorTx(
 	return tx, b.initCtx(tx)
 }
 
-func (b *builder) getBalance(
-	chainID ids.ID,
-	options *common.Options,
-) (
-	balance map[ids.ID]uint64,
-	err error,
-) {
-	utxos, err := b.backend.UTXOs(options.Context(), chainID)
+func (b *builder) NewAddContinuousValidatorTx(
+	vdr *txs.Validator,
+	signer signer.Signer,
+	validationRewardsOwner *secp256k1fx.OutputOwners,
+	delegationRewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	period time.Duration,
+	options ...common.Option,
+) (*txs.AddContinuousValidatorTx, error) {
+	avaxAssetID := b.context.AVAXAssetID
+	toBurn := map[ids.ID]uint64{}
+	toStake := map[ids.ID]uint64{
+		avaxAssetID: vdr.Wght,
+	}
+
+	ops := common.NewOptions(options)
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	signerComplexity, err := fee.SignerComplexity(signer)
+	if err != nil {
+		return nil, err
+	}
+	validatorOwnerComplexity, err := fee.OwnerComplexity(validationRewardsOwner)
+	if err != nil {
+		return nil, err
+	}
+	delegatorOwnerComplexity, err := fee.OwnerComplexity(delegationRewardsOwner)
+	if err != nil {
+		return nil, err
+	}
+	complexity, err := fee.IntrinsicAddContinuousValidatorTxComplexities.Add(
+		&memoComplexity,
+		&signerComplexity,
+		&validatorOwnerComplexity,
+		&delegatorOwnerComplexity,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	addrs := options.Addresses(b.addrs)
-	minIssuanceTime := options.MinIssuanceTime()
-	balance = make(map[ids.ID]uint64)
-
-	// Iterate over the UTXOs
-	for _, utxo := range utxos {
-		outIntf := utxo.Out
-		if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
-			if !options.AllowStakeableLocked() && lockedOut.Locktime > minIssuanceTime {
-				// This output is currently locked, so this output can't be
-				// burned.
-				continue
-			}
-			outIntf = lockedOut.TransferableOut
-		}
-
-		out, ok := outIntf.(*secp256k1fx.TransferOutput)
-		if !ok {
-			return nil, ErrUnknownOutputType
-		}
+	inputs, baseOutputs, stakeOutputs, err := b.spend(
+		toBurn,
+		toStake,
+		0,
+		complexity,
+		nil,
+		ops,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		_, ok = common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
-		if !ok {
-			// We couldn't spend this UTXO, so we skip to the next one
-			continue
-		}
+	utils.Sort(validationRewardsOwner.Addrs)
+	utils.Sort(delegationRewardsOwner.Addrs)
+	tx := &txs.AddContinuousValidatorTx{
+		AddPermissionlessValidatorTx: txs.AddPermissionlessValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    b.context.NetworkID,
+				BlockchainID: constants.PlatformChainID,
+				Ins:          inputs,
+				Outs:         baseOutputs,
+				Memo:         memo,
+			}},
+			Validator:             *vdr,
+			Subnet:                constants.PrimaryNetworkID,
+			Signer:                signer,
+			StakeOuts:             stakeOutputs,
+			ValidatorRewardsOwner: validationRewardsOwner,
+			DelegatorRewardsOwner: delegationRewardsOwner,
+			DelegationShares:      shares,
+		},
+		Period: uint64(period / time.Second),
+	}
+	return tx, b.initCtx(tx)
+}
 
-		assetID := utxo.AssetID()
-		balance[assetID], err = math.Add(balance[assetID], out.Amt)
+func (b *builder) NewAddMultiDelegatorTx(
+	vdrs []*txs.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.AddMultiDelegatorTx, error) {
+	var (
+		totalWeight uint64
+		err         error
+		delegations = make([]*txs.Delegation, len(vdrs))
+	)
+	for i, vdr := range vdrs {
+		totalWeight, err = math.Add(totalWeight, vdr.Wght)
 		if err != nil {
 			return nil, err
 		}
+		delegations[i] = &txs.Delegation{
+			Validator: *vdr,
+		}
+	}
+	utils.Sort(delegations)
+
+	ops := common.NewOptions(options)
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	ownerComplexity, err := fee.OwnerComplexity(rewardsOwner)
+	if err != nil {
+		return nil, err
+	}
+	delegationComplexity, err := fee.DelegationComplexity(delegations...)
+	if err != nil {
+		return nil, err
+	}
+	complexity, err := fee.IntrinsicAddMultiDelegatorTxComplexities.Add(
+		&memoComplexity,
+		&ownerComplexity,
+		&delegationComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Splitting the stake across the delegations may introduce additional
+	// stake outputs, which must also be paid for. The complexity of the split
+	// outputs is only known after spending, so the spend is retried until it
+	// accounts for them.
+	var (
+		splitComplexity gas.Dimensions
+		inputs          []*avax.TransferableInput
+		baseOutputs     []*avax.TransferableOutput
+	)
+	for {
+		spendComplexity, err := complexity.Add(&splitComplexity)
+		if err != nil {
+			return nil, err
+		}
+
+		toBurn := map[ids.ID]uint64{}
+		toStake := map[ids.ID]uint64{
+			b.context.AVAXAssetID: totalWeight,
+		}
+
+		var stakeOutputs []*avax.TransferableOutput
+		inputs, baseOutputs, stakeOutputs, err = b.spend(
+			toBurn,
+			toStake,
+			0,
+			spendComplexity,
+			nil,
+			ops,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		requiredSplitComplexity, err := splitStake(stakeOutputs, delegations)
+		if err != nil {
+			return nil, err
+		}
+
+		covered := true
+		for i, required := range requiredSplitComplexity {
+			if required > splitComplexity[i] {
+				splitComplexity[i] = required
+				covered = false
+			}
+		}
+		if covered {
+			break
+		}
+	}
+
+	utils.Sort(rewardsOwner.Addrs)
+	tx := &txs.AddMultiDelegatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         baseOutputs,
+			Memo:         memo,
+		}},
+		Delegations:            delegations,
+		DelegationRewardsOwner: rewardsOwner,
+	}
+	return tx, b.initCtx(tx)
+}
+
+func (b *builder) NewStopContinuousValidatorTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.StopContinuousValidatorTx, error) {
+	var (
+		toBurn  = map[ids.ID]uint64{}
+		toStake = map[ids.ID]uint64{}
+		ops     = common.NewOptions(options)
+	)
+	stopAuth, err := b.authorize(txID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	authComplexity, err := fee.AuthComplexity(stopAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	complexity, err := fee.IntrinsicStopContinuousValidatorTxComplexities.Add(
+		&memoComplexity,
+		&authComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, outputs, _, err := b.spend(
+		toBurn,
+		toStake,
+		0,
+		complexity,
+		nil,
+		ops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &txs.StopContinuousValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         memo,
+		}},
+		TxID:     txID,
+		StopAuth: stopAuth,
+	}
+	return tx, b.initCtx(tx)
+}
+
+func (b *builder) NewSetWithdrawalOwnerTx(
+	txID ids.ID,
+	withdrawalOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.SetWithdrawalOwnerTx, error) {
+	var (
+		toBurn  = map[ids.ID]uint64{}
+		toStake = map[ids.ID]uint64{}
+		ops     = common.NewOptions(options)
+	)
+	ownerAuth, err := b.authorize(txID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	authComplexity, err := fee.AuthComplexity(ownerAuth)
+	if err != nil {
+		return nil, err
+	}
+	ownerComplexity, err := fee.OwnerComplexity(withdrawalOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	complexity, err := fee.IntrinsicSetWithdrawalOwnerTxComplexities.Add(
+		&memoComplexity,
+		&authComplexity,
+		&ownerComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, outputs, _, err := b.spend(
+		toBurn,
+		toStake,
+		0,
+		complexity,
+		nil,
+		ops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(withdrawalOwner.Addrs)
+	tx := &txs.SetWithdrawalOwnerTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         memo,
+		}},
+		TxID:            txID,
+		WithdrawalOwner: withdrawalOwner,
+		OwnerAuth:       ownerAuth,
+	}
+	return tx, b.initCtx(tx)
+}
+
+func (b *builder) NewRetireChainTx(
+	chainID ids.ID,
+	options ...common.Option,
+) (*txs.RetireChainTx, error) {
+	var (
+		toBurn  = map[ids.ID]uint64{}
+		toStake = map[ids.ID]uint64{}
+		ops     = common.NewOptions(options)
+	)
+	subnetAuth, err := b.authorize(chainID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	memo := ops.Memo()
+	memoComplexity := gas.Dimensions{
+		gas.Bandwidth: uint64(len(memo)),
+	}
+	authComplexity, err := fee.AuthComplexity(subnetAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	complexity, err := fee.IntrinsicRetireChainTxComplexities.Add(
+		&memoComplexity,
+		&authComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, outputs, _, err := b.spend(
+		toBurn,
+		toStake,
+		0,
+		complexity,
+		nil,
+		ops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &txs.RetireChainTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         memo,
+		}},
+		ChainID:    chainID,
+		SubnetAuth: subnetAuth,
+	}
+	return tx, b.initCtx(tx)
+}
+
+// spendableUTXOs verifies [options] and returns the UTXOs of [sourceChainID]
+// that they allow to be spent.
+func (b *builder) spendableUTXOs(
+	sourceChainID ids.ID,
+	options *common.Options,
+) ([]*avax.UTXO, error) {
+	if err := options.Verify(); err != nil {
+		return nil, err
+	}
+	utxos, err := b.backend.UTXOs(options.Context(), sourceChainID)
+	if err != nil {
+		return nil, err
+	}
+	confirmations, _ := b.backend.(common.ConfirmationTracker)
+	return options.FilterUTXOs(sourceChainID, utxos, confirmations)
+}
+
+func (b *builder) getBalance(
+	chainID ids.ID,
+	options *common.Options,
+) (
+	balance map[ids.ID]uint64,
+	err error,
+) {
+	utxos, err := b.spendableUTXOs(chainID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	balance = make(map[ids.ID]uint64)
+
+	// Iterate over the UTXOs
+	for _, utxo := range removeHeightLocked(utxos, options.MinIssuanceHeight()) {
+		outIntf := utxo.Out
+		if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
+			if !options.AllowStakeableLocked() && lockedOut.Locktime > minIssuanceTime {
+				// This output is currently locked, so this output can't be
+				// burned.
+				continue
+			}
+		}
+
+		out, _, err := unwrapOutput(outIntf)
+		if err != nil {
+			return nil, err
+		}
+
+		_, ok := common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		assetID := utxo.AssetID()
+		balance[assetID], err = math.Add(balance[assetID], out.Amt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return balance, nil
+}
+
+// splitStake assigns the [stake] outputs to the [delegations], splitting
+// outputs as needed so that every delegation is staked with exactly its
+// weight. It returns the complexity of the outputs that were added by
+// splitting.
+func splitStake(
+	stake []*avax.TransferableOutput,
+	delegations []*txs.Delegation,
+) (gas.Dimensions, error) {
+	var (
+		complexity gas.Dimensions
+		stakeIndex int
+		// remaining is the amount of stake[stakeIndex] that has not been
+		// assigned to a delegation yet.
+		remaining uint64
+	)
+	if len(stake) > 0 {
+		remaining = stake[0].Out.Amount()
+	}
+	for _, delegation := range delegations {
+		delegation.StakeOuts = nil
+		for needed := delegation.Wght; needed > 0; {
+			if stakeIndex >= len(stake) {
+				return gas.Dimensions{}, ErrInsufficientFunds
+			}
+
+			out := stake[stakeIndex]
+			amount := min(needed, remaining)
+			if amount != out.Out.Amount() {
+				splitOut, err := withAmount(out, amount)
+				if err != nil {
+					return gas.Dimensions{}, err
+				}
+				if amount != remaining {
+					// This output is also used by the next delegation, so an
+					// additional output is introduced.
+					outComplexity, err := fee.OutputComplexity(splitOut)
+					if err != nil {
+						return gas.Dimensions{}, err
+					}
+					complexity, err = complexity.Add(&outComplexity)
+					if err != nil {
+						return gas.Dimensions{}, err
+					}
+				}
+				out = splitOut
+			}
+			delegation.StakeOuts = append(delegation.StakeOuts, out)
+
+			needed -= amount
+			remaining -= amount
+			if remaining == 0 {
+				stakeIndex++
+				if stakeIndex < len(stake) {
+					remaining = stake[stakeIndex].Out.Amount()
+				}
+			}
+		}
+		avax.SortTransferableOutputs(delegation.StakeOuts, txs.Codec)
+	}
+	return complexity, nil
+}
+
+// withAmount returns a copy of the stake output [out] with [amount].
+func withAmount(out *avax.TransferableOutput, amount uint64) (*avax.TransferableOutput, error) {
+	switch o := out.Out.(type) {
+	case *stakeable.LockOut:
+		innerOut, ok := o.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, ErrUnknownOutputType
+		}
+		return &avax.TransferableOutput{
+			Asset: out.Asset,
+			Out: &stakeable.LockOut{
+				Locktime: o.Locktime,
+				TransferableOut: &secp256k1fx.TransferOutput{
+					Amt:          amount,
+					OutputOwners: innerOut.OutputOwners,
+				},
+			},
+		}, nil
+	case *secp256k1fx.TransferOutput:
+		return &avax.TransferableOutput{
+			Asset: out.Asset,
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: o.OutputOwners,
+			},
+		}, nil
+	default:
+		return nil, ErrUnknownOutputType
 	}
-	return balance, nil
 }
 
 // spend takes in the requested burn amounts and the requested stake amounts.
@@ -1572,7 +2328,7 @@ func (b *builder) spend(
 	stakeOutputs []*avax.TransferableOutput,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), constants.PlatformChainID)
+	utxos, err := b.spendableUTXOs(constants.PlatformChainID, options)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -1592,6 +2348,35 @@ func (b *builder) spend(
 		ownerOverride = changeOwner
 	}
 
+	// If fee addresses were provided, the fee is paid only by their UTXOs.
+	feeAddrs, sponsored := options.FeeAddresses()
+	var feeChangeOwner *secp256k1fx.OutputOwners
+	if sponsored {
+		feeAddr, ok := feeAddrs.Peek()
+		if !ok {
+			return nil, nil, nil, ErrNoChangeAddress
+		}
+		feeChangeOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{feeAddr},
+		}
+	}
+
+	// If the tx will be wrapped into an ExpiringTx or a DependentTx, the
+	// wrappers must also be paid for.
+	if options.Expiry() != 0 {
+		complexity, err = complexity.Add(&fee.IntrinsicExpiringTxComplexities)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if options.Dependency() != ids.Empty {
+		complexity, err = complexity.Add(&fee.IntrinsicDependentTxComplexities)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	s := spendHelper{
 		weights:  b.context.ComplexityWeights,
 		gasPrice: b.context.GasPrice,
@@ -1608,6 +2393,7 @@ func (b *builder) spend(
 		stakeOutputs:  make([]*avax.TransferableOutput, 0),
 	}
 
+	utxos = removeHeightLocked(utxos, options.MinIssuanceHeight())
 	utxosByLocktime := splitByLocktime(utxos, minIssuanceTime)
 	for _, utxo := range utxosByLocktime.locked {
 		assetID := utxo.AssetID()
@@ -1757,8 +2543,8 @@ func (b *builder) spend(
 
 		// If we don't need to burn or stake additional AVAX and we have
 		// consumed enough AVAX to pay the required fee, we should stop
-		// consuming UTXOs.
-		if !s.shouldConsumeAsset(b.context.AVAXAssetID) && excessAVAX >= requiredFee {
+		// consuming UTXOs. If the fee is sponsored, it is paid below.
+		if !s.shouldConsumeAsset(b.context.AVAXAssetID) && (sponsored || excessAVAX >= requiredFee) {
 			break
 		}
 
@@ -1798,21 +2584,37 @@ func (b *builder) spend(
 		ownerOverride = changeOwner
 	}
 
-	if err := s.verifyAssetsConsumed(); err != nil {
-		return nil, nil, nil, err
+	if sponsored {
+		// The excess AVAX isn't used to pay the fee, so it is returned in
+		// full.
+		if excessAVAX > 0 {
+			err := s.addChangeOutput(&avax.TransferableOutput{
+				Asset: avax.Asset{
+					ID: b.context.AVAXAssetID,
+				},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          excessAVAX,
+					OutputOwners: *ownerOverride,
+				},
+			})
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+
+		excessAVAX, err = s.spendFee(utxosByAVAXAssetID.requested, feeAddrs, minIssuanceTime)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		ownerOverride = feeChangeOwner
 	}
 
 	requiredFee, err := s.calculateFee()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	if excessAVAX < requiredFee {
-		return nil, nil, nil, fmt.Errorf(
-			"%w: provided UTXOs needed %d more nAVAX (%q)",
-			ErrInsufficientFunds,
-			requiredFee-excessAVAX,
-			b.context.AVAXAssetID,
-		)
+	if err := s.verifyFunded(b.context.AVAXAssetID, excessAVAX, requiredFee); err != nil {
+		return nil, nil, nil, err
 	}
 
 	secpExcessAVAXOutput := &secp256k1fx.TransferOutput{
@@ -1881,6 +2683,57 @@ func (b *builder) initCtx(tx txs.UnsignedTx) error {
 	return nil
 }
 
+// excludeUTXOsBackend prevents the [excluded] UTXOs from being spent.
+type excludeUTXOsBackend struct {
+	Backend
+	excluded set.Set[ids.ID]
+}
+
+func (b *excludeUTXOsBackend) UTXOs(ctx context.Context, sourceChainID ids.ID) ([]*avax.UTXO, error) {
+	utxos, err := b.Backend.UTXOs(ctx, sourceChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	filteredUTXOs := make([]*avax.UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !b.excluded.Contains(utxo.InputID()) {
+			filteredUTXOs = append(filteredUTXOs, utxo)
+		}
+	}
+	return filteredUTXOs, nil
+}
+
+// InsufficientFundsError is returned when the provided UTXOs don't hold enough
+// of an asset to fund a transaction. The missing amount is split by what the
+// asset is needed for. The error matches ErrInsufficientFunds.
+type InsufficientFundsError struct {
+	AssetID ids.ID
+	// Stake is the additional amount of the asset needed to be staked.
+	Stake uint64
+	// Transfer is the additional amount of the asset needed to be burned,
+	// excluding the fee.
+	Transfer uint64
+	// Fee is the additional amount of the asset needed to pay the fee. It is
+	// only non-zero for AVAX.
+	Fee uint64
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf(
+		"%s: provided UTXOs need more units of asset %q: %d to stake, %d to transfer and %d to pay the fee",
+		ErrInsufficientFunds,
+		e.AssetID,
+		e.Stake,
+		e.Transfer,
+		e.Fee,
+	)
+}
+
+func (*InsufficientFundsError) Unwrap() error {
+	return ErrInsufficientFunds
+}
+
 type spendHelper struct {
 	weights  gas.Dimensions
 	gasPrice gas.Price
@@ -1965,32 +2818,97 @@ func (s *spendHelper) calculateFee() (uint64, error) {
 	return gas.Cost(s.gasPrice)
 }
 
-func (s *spendHelper) verifyAssetsConsumed() error {
-	for assetID, amount := range s.toStake {
-		if amount == 0 {
-			continue
+// verifyFunded returns an *InsufficientFundsError if the consumed UTXOs didn't
+// provide all the assets to stake and burn, or if [excessAVAX] doesn't cover
+// [requiredFee].
+func (s *spendHelper) verifyFunded(avaxAssetID ids.ID, excessAVAX uint64, requiredFee uint64) error {
+	var missingFee uint64
+	if excessAVAX < requiredFee {
+		missingFee = requiredFee - excessAVAX
+	}
+
+	// AVAX is reported first, as it is the only asset that can be missing to
+	// pay the fee.
+	if s.toStake[avaxAssetID] != 0 || s.toBurn[avaxAssetID] != 0 || missingFee != 0 {
+		return &InsufficientFundsError{
+			AssetID:  avaxAssetID,
+			Stake:    s.toStake[avaxAssetID],
+			Transfer: s.toBurn[avaxAssetID],
+			Fee:      missingFee,
 		}
+	}
+	for _, amounts := range []map[ids.ID]uint64{s.toStake, s.toBurn} {
+		for assetID, amount := range amounts {
+			if amount == 0 {
+				continue
+			}
 
-		return fmt.Errorf(
-			"%w: provided UTXOs need %d more units of asset %q to stake",
-			ErrInsufficientFunds,
-			amount,
-			assetID,
-		)
+			return &InsufficientFundsError{
+				AssetID:  assetID,
+				Stake:    s.toStake[assetID],
+				Transfer: s.toBurn[assetID],
+			}
+		}
 	}
-	for assetID, amount := range s.toBurn {
-		if amount == 0 {
+	return nil
+}
+
+// spendFee consumes the unconsumed [utxos] owned by [feeAddrs] until they cover
+// the fee. It returns the consumed amount.
+func (s *spendHelper) spendFee(
+	utxos []*avax.UTXO,
+	feeAddrs set.Set[ids.ShortID],
+	minIssuanceTime uint64,
+) (uint64, error) {
+	consumed := set.NewSet[ids.ID](len(s.inputs))
+	for _, input := range s.inputs {
+		consumed.Add(input.InputID())
+	}
+
+	var amount uint64
+	for _, utxo := range utxos {
+		requiredFee, err := s.calculateFee()
+		if err != nil {
+			return 0, err
+		}
+		if amount >= requiredFee {
+			break
+		}
+		if consumed.Contains(utxo.InputID()) {
 			continue
 		}
 
-		return fmt.Errorf(
-			"%w: provided UTXOs need %d more units of asset %q",
-			ErrInsufficientFunds,
-			amount,
-			assetID,
-		)
+		out, _, err := unwrapOutput(utxo.Out)
+		if err != nil {
+			return 0, err
+		}
+
+		inputSigIndices, ok := common.MatchOwners(&out.OutputOwners, feeAddrs, minIssuanceTime)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		err = s.addInput(&avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt: out.Amt,
+				Input: secp256k1fx.Input{
+					SigIndices: inputSigIndices,
+				},
+			},
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		amount, err = math.Add(amount, out.Amt)
+		if err != nil {
+			return 0, err
+		}
 	}
-	return nil
+	return amount, nil
 }
 
 type utxosByLocktime struct {
@@ -2016,6 +2934,23 @@ func splitByLocktime(utxos []*avax.UTXO, minIssuanceTime uint64) utxosByLocktime
 	return split
 }
 
+// removeHeightLocked returns the provided UTXOs, excluding the UTXOs that are
+// height locked with the provided issuance height.
+func removeHeightLocked(utxos []*avax.UTXO, minIssuanceHeight uint64) []*avax.UTXO {
+	unlocked := make([]*avax.UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		out := utxo.Out
+		if lockedOut, ok := out.(*stakeable.LockOut); ok {
+			out = lockedOut.TransferableOut
+		}
+		if heightLockedOut, ok := out.(*secp256k1fx.HeightLockedOutput); ok && minIssuanceHeight < heightLockedOut.LockHeight {
+			continue
+		}
+		unlocked = append(unlocked, utxo)
+	}
+	return unlocked
+}
+
 type utxosByAssetID struct {
 	requested []*avax.UTXO
 	other     []*avax.UTXO
@@ -2040,7 +2975,7 @@ func splitByAssetID(utxos []*avax.UTXO, assetID ids.ID) utxosByAssetID {
 }
 
 // unwrapOutput returns the *secp256k1fx.TransferOutput that was, potentially,
-// wrapped by a *stakeable.LockOut.
+// wrapped by a *stakeable.LockOut and by a *secp256k1fx.HeightLockedOutput.
 //
 // If the output was stakeable and locked, the locktime is returned. Otherwise,
 // the locktime returned will be 0.
@@ -2053,6 +2988,9 @@ func unwrapOutput(output verify.State) (*secp256k1fx.TransferOutput, uint64, err
 		output = lockedOut.TransferableOut
 		locktime = lockedOut.Locktime
 	}
+	if heightLockedOut, ok := output.(*secp256k1fx.HeightLockedOutput); ok {
+		output = &heightLockedOut.TransferOutput
+	}
 
 	unwrappedOutput, ok := output.(*secp256k1fx.TransferOutput)
 	if !ok {
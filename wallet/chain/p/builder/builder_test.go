@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee/feetest"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
@@ -106,6 +107,45 @@ func TestByAssetID(t *testing.T) {
 	require.ElementsMatch(expectedOther, utxosByAssetID.other)
 }
 
+func TestRemoveHeightLocked(t *testing.T) {
+	require := require.New(t)
+
+	newUTXO := func(out verify.State) *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Out: out,
+		}
+	}
+
+	const unlockedHeight = 100
+	var (
+		unlocked = []*avax.UTXO{
+			newUTXO(&secp256k1fx.TransferOutput{}),
+			newUTXO(&secp256k1fx.HeightLockedOutput{
+				LockHeight: unlockedHeight,
+			}),
+			newUTXO(&stakeable.LockOut{
+				TransferableOut: &secp256k1fx.HeightLockedOutput{
+					LockHeight: unlockedHeight - 1,
+				},
+			}),
+		}
+		locked = []*avax.UTXO{
+			newUTXO(&secp256k1fx.HeightLockedOutput{
+				LockHeight: unlockedHeight + 1,
+			}),
+			newUTXO(&stakeable.LockOut{
+				TransferableOut: &secp256k1fx.HeightLockedOutput{
+					LockHeight: unlockedHeight + 1,
+				},
+			}),
+		}
+	)
+	require.Equal(unlocked, removeHeightLocked(slices.Concat(unlocked, locked), unlockedHeight))
+}
+
 func TestUnwrapOutput(t *testing.T) {
 	normalOutput := &secp256k1fx.TransferOutput{
 		Amt: 123,
@@ -150,6 +190,29 @@ func TestUnwrapOutput(t *testing.T) {
 			expectedLocktime: 0,
 			expectedErr:      nil,
 		},
+		{
+			name: "height locked output",
+			output: &secp256k1fx.HeightLockedOutput{
+				LockHeight:     10,
+				TransferOutput: *normalOutput,
+			},
+			expectedOutput:   normalOutput,
+			expectedLocktime: 0,
+			expectedErr:      nil,
+		},
+		{
+			name: "locked height locked output",
+			output: &stakeable.LockOut{
+				Locktime: 789,
+				TransferableOut: &secp256k1fx.HeightLockedOutput{
+					LockHeight:     10,
+					TransferOutput: *normalOutput,
+				},
+			},
+			expectedOutput:   normalOutput,
+			expectedLocktime: 789,
+			expectedErr:      nil,
+		},
 		{
 			name:             "invalid output",
 			output:           nil,
@@ -170,3 +233,24 @@ func TestUnwrapOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestSpendHelperCalculateFee(t *testing.T) {
+	for _, test := range feetest.Vectors {
+		if test.Unsupported {
+			continue
+		}
+
+		t.Run(test.Name, func(t *testing.T) {
+			require := require.New(t)
+
+			s := spendHelper{
+				weights:    feetest.Weights,
+				gasPrice:   feetest.Price,
+				complexity: test.Complexity,
+			}
+			fee, err := s.calculateFee()
+			require.NoError(err)
+			require.Equal(test.Fee, fee)
+		})
+	}
+}
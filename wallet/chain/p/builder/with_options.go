@@ -103,6 +103,20 @@ func (w *withOptions) NewRemoveSubnetValidatorTx(
 	)
 }
 
+func (w *withOptions) NewSetSubnetValidatorWeightTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	weight uint64,
+	options ...common.Option,
+) (*txs.SetSubnetValidatorWeightTx, error) {
+	return w.builder.NewSetSubnetValidatorWeightTx(
+		nodeID,
+		subnetID,
+		weight,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) NewAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -217,6 +231,18 @@ func (w *withOptions) NewDisableL1ValidatorTx(
 	)
 }
 
+func (w *withOptions) NewClaimRewardsTx(
+	rewardUTXOIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ClaimRewardsTx, error) {
+	return w.builder.NewClaimRewardsTx(
+		rewardUTXOIDs,
+		to,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) NewImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
@@ -310,3 +336,67 @@ func (w *withOptions) NewAddPermissionlessDelegatorTx(
 		common.UnionOptions(w.options, options)...,
 	)
 }
+
+func (w *withOptions) NewAddContinuousValidatorTx(
+	vdr *txs.Validator,
+	signer signer.Signer,
+	validationRewardsOwner *secp256k1fx.OutputOwners,
+	delegationRewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	period time.Duration,
+	options ...common.Option,
+) (*txs.AddContinuousValidatorTx, error) {
+	return w.builder.NewAddContinuousValidatorTx(
+		vdr,
+		signer,
+		validationRewardsOwner,
+		delegationRewardsOwner,
+		shares,
+		period,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) NewStopContinuousValidatorTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.StopContinuousValidatorTx, error) {
+	return w.builder.NewStopContinuousValidatorTx(
+		txID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) NewAddMultiDelegatorTx(
+	vdrs []*txs.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.AddMultiDelegatorTx, error) {
+	return w.builder.NewAddMultiDelegatorTx(
+		vdrs,
+		rewardsOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) NewSetWithdrawalOwnerTx(
+	txID ids.ID,
+	withdrawalOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.SetWithdrawalOwnerTx, error) {
+	return w.builder.NewSetWithdrawalOwnerTx(
+		txID,
+		withdrawalOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) NewRetireChainTx(
+	chainID ids.ID,
+	options ...common.Option,
+) (*txs.RetireChainTx, error) {
+	return w.builder.NewRetireChainTx(
+		chainID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
@@ -178,6 +178,300 @@ func TestBaseTx(t *testing.T) {
 	}
 }
 
+func TestBaseTxInsufficientFunds(t *testing.T) {
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{
+			TxID: ids.Empty.Prefix(2025),
+		},
+		Asset: avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          units.Avax,
+			OutputOwners: utxoOwner,
+		},
+	}
+
+	tests := []struct {
+		name             string
+		assetID          ids.ID
+		amount           uint64
+		expectedAssetID  ids.ID
+		expectedTransfer uint64
+		expectedFee      bool
+	}{
+		{
+			name:             "missing transfer and fee",
+			assetID:          avaxAssetID,
+			amount:           3 * units.Avax,
+			expectedAssetID:  avaxAssetID,
+			expectedTransfer: 2 * units.Avax,
+			expectedFee:      true,
+		},
+		{
+			name:            "missing fee",
+			assetID:         avaxAssetID,
+			amount:          units.Avax,
+			expectedAssetID: avaxAssetID,
+			expectedFee:     true,
+		},
+		{
+			name:             "missing other asset",
+			assetID:          subnetAssetID,
+			amount:           5,
+			expectedAssetID:  subnetAssetID,
+			expectedTransfer: 5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				require    = require.New(t)
+				chainUTXOs = utxotest.NewDeterministicChainUTXOs(t, map[ids.ID][]*avax.UTXO{
+					constants.PlatformChainID: {utxo},
+				})
+				backend = wallet.NewBackend(testContextPostEtna, chainUTXOs, nil)
+				b       = builder.New(set.Of(utxoAddr), testContextPostEtna, backend)
+			)
+
+			_, err := b.NewBaseTx(
+				[]*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: test.assetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          test.amount,
+						OutputOwners: utxoOwner,
+					},
+				}},
+			)
+			require.ErrorIs(err, builder.ErrInsufficientFunds)
+
+			var insufficientFundsErr *builder.InsufficientFundsError
+			require.ErrorAs(err, &insufficientFundsErr)
+			require.Equal(test.expectedAssetID, insufficientFundsErr.AssetID)
+			require.Zero(insufficientFundsErr.Stake)
+			require.Equal(test.expectedTransfer, insufficientFundsErr.Transfer)
+			require.Equal(test.expectedFee, insufficientFundsErr.Fee != 0)
+		})
+	}
+}
+
+func TestBaseTxWithFeeAddresses(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		feeKey   = testKeys[3]
+		feeAddr  = feeKey.Address()
+		feeOwner = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{feeAddr},
+		}
+		userUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.Empty.Prefix(2025),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          units.Avax,
+				OutputOwners: utxoOwner,
+			},
+		}
+		feeUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.Empty.Prefix(2026),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          units.Avax,
+				OutputOwners: feeOwner,
+			},
+		}
+		chainUTXOs = utxotest.NewDeterministicChainUTXOs(t, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: {userUTXO, feeUTXO},
+		})
+		backend = wallet.NewBackend(testContextPostEtna, chainUTXOs, nil)
+		b       = builder.New(set.Of(utxoAddr), testContextPostEtna, backend)
+
+		output = &avax.TransferableOutput{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          units.Avax,
+				OutputOwners: *rewardsOwner,
+			},
+		}
+	)
+
+	// The user's UTXO can't pay for both the transfer and the fee.
+	_, err := b.NewBaseTx([]*avax.TransferableOutput{output})
+	require.ErrorIs(err, builder.ErrInsufficientFunds)
+
+	utx, err := b.NewBaseTx(
+		[]*avax.TransferableOutput{output},
+		common.WithFeeAddresses(set.Of(feeAddr)),
+	)
+	require.NoError(err)
+	require.Len(utx.Ins, 2)
+	require.Len(utx.Outs, 2)
+	require.Contains(utx.Outs, output)
+	requireFeeIsCorrect(
+		require,
+		dynamicFeeCalculator,
+		utx,
+		&utx.BaseTx,
+		nil,
+		nil,
+		nil,
+	)
+
+	// The fee change is returned to the fee address.
+	for _, out := range utx.Outs {
+		if out == output {
+			continue
+		}
+		changeOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+		require.True(ok)
+		require.Equal(feeOwner.Addrs, changeOut.Addrs)
+	}
+}
+
+func TestBaseTxOptions(t *testing.T) {
+	var (
+		newUTXO = func(txID ids.ID) *avax.UTXO {
+			return &avax.UTXO{
+				UTXOID: avax.UTXOID{
+					TxID: txID,
+				},
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          units.Avax,
+					OutputOwners: utxoOwner,
+				},
+			}
+		}
+		firstUTXO  = newUTXO(ids.Empty.Prefix(2025))
+		secondUTXO = newUTXO(ids.Empty.Prefix(2026))
+		changeAddr = testKeys[3].Address()
+
+		output = &avax.TransferableOutput{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          units.Avax / 2,
+				OutputOwners: *rewardsOwner,
+			},
+		}
+	)
+
+	tests := []struct {
+		name               string
+		options            []common.Option
+		expectedErr        error
+		expectedInputs     []ids.ID
+		expectedChangeAddr ids.ShortID
+	}{
+		{
+			name: "included UTXOs",
+			options: []common.Option{
+				common.WithIncludedUTXOs(set.Of(secondUTXO.InputID())),
+			},
+			expectedInputs:     []ids.ID{secondUTXO.InputID()},
+			expectedChangeAddr: utxoAddr,
+		},
+		{
+			name: "excluded UTXOs",
+			options: []common.Option{
+				common.WithExcludedUTXOs(set.Of(firstUTXO.InputID())),
+			},
+			expectedInputs:     []ids.ID{secondUTXO.InputID()},
+			expectedChangeAddr: utxoAddr,
+		},
+		{
+			name: "change owner",
+			options: []common.Option{
+				common.WithExcludedUTXOs(set.Of(firstUTXO.InputID())),
+				common.WithChangeOwner(&secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{changeAddr},
+				}),
+			},
+			expectedInputs:     []ids.ID{secondUTXO.InputID()},
+			expectedChangeAddr: changeAddr,
+		},
+		{
+			name: "all UTXOs excluded",
+			options: []common.Option{
+				common.WithExcludedUTXOs(set.Of(firstUTXO.InputID(), secondUTXO.InputID())),
+			},
+			expectedErr: builder.ErrInsufficientFunds,
+		},
+		{
+			name: "UTXO included and excluded",
+			options: []common.Option{
+				common.WithIncludedUTXOs(set.Of(firstUTXO.InputID())),
+				common.WithExcludedUTXOs(set.Of(firstUTXO.InputID())),
+			},
+			expectedErr: common.ErrConflictingUTXOs,
+		},
+		{
+			name: "memo too large",
+			options: []common.Option{
+				common.WithMemo(make([]byte, avax.MaxMemoSize+1)),
+			},
+			expectedErr: common.ErrMemoTooLarge,
+		},
+		{
+			name: "invalid change owner",
+			options: []common.Option{
+				common.WithChangeOwner(&secp256k1fx.OutputOwners{
+					Threshold: 2,
+					Addrs:     []ids.ShortID{changeAddr},
+				}),
+			},
+			expectedErr: common.ErrInvalidChangeOwner,
+		},
+		{
+			name: "confirmations not tracked",
+			options: []common.Option{
+				common.WithMinConfirmations(1),
+			},
+			expectedErr: common.ErrConfirmationsNotTracked,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				require    = require.New(t)
+				chainUTXOs = utxotest.NewDeterministicChainUTXOs(t, map[ids.ID][]*avax.UTXO{
+					constants.PlatformChainID: {firstUTXO, secondUTXO},
+				})
+				backend = wallet.NewBackend(testContextPostEtna, chainUTXOs, nil)
+				b       = builder.New(set.Of(utxoAddr), testContextPostEtna, backend)
+			)
+
+			utx, err := b.NewBaseTx(
+				[]*avax.TransferableOutput{output},
+				test.options...,
+			)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+
+			inputs := make([]ids.ID, len(utx.Ins))
+			for i, in := range utx.Ins {
+				inputs[i] = in.InputID()
+			}
+			require.Equal(test.expectedInputs, inputs)
+
+			require.Len(utx.Outs, 2)
+			for _, out := range utx.Outs {
+				if out == output {
+					continue
+				}
+				changeOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+				require.True(ok)
+				require.Equal([]ids.ShortID{test.expectedChangeAddr}, changeOut.Addrs)
+			}
+		})
+	}
+}
+
 func TestAddSubnetValidatorTx(t *testing.T) {
 	subnetValidator := &txs.SubnetValidator{
 		Validator: txs.Validator{
@@ -571,6 +865,63 @@ func TestAddPermissionlessDelegatorTx(t *testing.T) {
 	}
 }
 
+func TestAddMultiDelegatorTx(t *testing.T) {
+	vdrs := []*txs.Validator{
+		{
+			NodeID: ids.GenerateTestNodeID(),
+			End:    uint64(time.Now().Add(time.Hour).Unix()),
+			Wght:   3 * units.Avax,
+		},
+		{
+			NodeID: ids.GenerateTestNodeID(),
+			End:    uint64(time.Now().Add(2 * time.Hour).Unix()),
+			Wght:   2 * units.Avax,
+		},
+	}
+	for _, e := range testEnvironment {
+		t.Run(e.name, func(t *testing.T) {
+			var (
+				require    = require.New(t)
+				chainUTXOs = utxotest.NewDeterministicChainUTXOs(t, map[ids.ID][]*avax.UTXO{
+					constants.PlatformChainID: utxos,
+				})
+				backend = wallet.NewBackend(e.context, chainUTXOs, nil)
+				builder = builder.New(set.Of(utxoAddr, rewardAddr), e.context, backend)
+			)
+
+			utx, err := builder.NewAddMultiDelegatorTx(
+				vdrs,
+				rewardsOwner,
+				common.WithMemo(e.memo),
+			)
+			require.NoError(err)
+			require.Len(utx.Delegations, len(vdrs))
+			require.True(utils.IsSortedAndUnique(utx.Delegations))
+			for _, delegation := range utx.Delegations {
+				// check stake amount
+				require.Equal(
+					map[ids.ID]uint64{
+						avaxAssetID: delegation.Wght,
+					},
+					addOutputAmounts(delegation.StakeOuts),
+				)
+				require.True(avax.IsSortedTransferableOutputs(delegation.StakeOuts, txs.Codec))
+			}
+			require.Equal(rewardsOwner, utx.DelegationRewardsOwner)
+			require.Equal(types.JSONByteSlice(e.memo), utx.Memo)
+			requireFeeIsCorrect(
+				require,
+				e.feeCalculator,
+				utx,
+				&utx.BaseTx.BaseTx,
+				nil,
+				utx.Stake(),
+				nil,
+			)
+		})
+	}
+}
+
 func TestConvertSubnetToL1Tx(t *testing.T) {
 	sk0, err := localsigner.New()
 	require.NoError(t, err)
@@ -4,16 +4,55 @@
 package p
 
 import (
+	"context"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
 
-var _ wallet.Client = (*Client)(nil)
+var (
+	_ wallet.Client = (*Client)(nil)
+	_ TxIssuer      = platformvm.Client(nil)
+	_ ChainReader   = platformvm.Client(nil)
+)
+
+// TxIssuer issues transactions to the P-chain and reports their status.
+//
+// It is implemented by platformvm.Client, and can be implemented by other
+// transports, such as an embedded node, to issue transactions without the
+// JSON-RPC API.
+type TxIssuer interface {
+	platformvm.TxStatusGetter
+
+	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
+}
+
+// ChainReader reads the P-chain parameters needed to build transactions.
+//
+// It is implemented by platformvm.Client, and can be implemented by other
+// transports, such as an embedded node, to build transactions without the
+// JSON-RPC API.
+type ChainReader interface {
+	GetStakingAssetID(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (ids.ID, error)
+	GetFeeConfig(ctx context.Context, options ...rpc.Option) (*gas.Config, error)
+	GetFeeState(ctx context.Context, options ...rpc.Option) (
+		gas.State,
+		gas.Price,
+		time.Time,
+		error,
+	)
+}
 
+// NewClient returns a wallet.Client that issues transactions with [c] and
+// marks them as accepted in [b].
 func NewClient(
-	c platformvm.Client,
+	c TxIssuer,
 	b wallet.Backend,
 ) *Client {
 	return &Client{
@@ -23,7 +62,7 @@ func NewClient(
 }
 
 type Client struct {
-	client  platformvm.Client
+	client  TxIssuer
 	backend wallet.Backend
 }
 
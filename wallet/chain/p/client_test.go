@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var _ TxIssuer = (*testIssuer)(nil)
+
+// testIssuer is a TxIssuer that decides transactions after they have been
+// polled [pollsUntilCommitted] times.
+type testIssuer struct {
+	pollsUntilCommitted int
+
+	issued []ids.ID
+	polls  int
+}
+
+func (i *testIssuer) IssueTx(_ context.Context, txBytes []byte, _ ...rpc.Option) (ids.ID, error) {
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	i.issued = append(i.issued, tx.ID())
+	return tx.ID(), nil
+}
+
+func (i *testIssuer) GetTxStatus(context.Context, ids.ID, ...rpc.Option) (*platformvm.GetTxStatusResponse, error) {
+	i.polls++
+	if i.polls < i.pollsUntilCommitted {
+		return &platformvm.GetTxStatusResponse{Status: status.Processing}, nil
+	}
+	return &platformvm.GetTxStatusResponse{Status: status.Committed}, nil
+}
+
+func TestClientIssueTx(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		avaxAssetID = ids.GenerateTestID()
+		addr        = ids.GenerateTestShortID()
+		pContext    = &builder.Context{
+			NetworkID:   constants.UnitTestID,
+			AVAXAssetID: avaxAssetID,
+		}
+		utxos   = common.NewChainUTXOs(constants.PlatformChainID, common.NewUTXOs())
+		backend = wallet.NewBackend(pContext, utxos, nil)
+		issuer  = &testIssuer{
+			pollsUntilCommitted: 3,
+		}
+		client = NewClient(issuer, backend)
+	)
+
+	tx := &txs.Tx{
+		Unsigned: &txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: constants.PlatformChainID,
+				Outs: []*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: avaxAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt: 1,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{addr},
+						},
+					},
+				}},
+			},
+		},
+	}
+	require.NoError(tx.Initialize(txs.Codec))
+
+	var postIssuanceTxID ids.ID
+	require.NoError(client.IssueTx(
+		tx,
+		common.WithPollFrequency(time.Millisecond),
+		common.WithPostIssuanceFunc(func(txID ids.ID) {
+			postIssuanceTxID = txID
+		}),
+	))
+	require.Equal([]ids.ID{tx.ID()}, issuer.issued)
+	require.Equal(tx.ID(), postIssuanceTxID)
+	require.Equal(issuer.pollsUntilCommitted, issuer.polls)
+
+	// The output of the accepted tx is tracked by the backend.
+	acceptedUTXOs, err := backend.UTXOs(context.Background(), constants.PlatformChainID)
+	require.NoError(err)
+	require.Len(acceptedUTXOs, 1)
+	require.Equal(tx.ID(), acceptedUTXOs[0].TxID)
+}
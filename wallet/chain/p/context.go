@@ -27,32 +27,52 @@ func NewContextFromURI(ctx context.Context, uri string) (*builder.Context, error
 func NewContextFromClients(
 	ctx context.Context,
 	infoClient info.Client,
-	chainClient platformvm.Client,
+	chainClient ChainReader,
 ) (*builder.Context, error) {
 	networkID, err := infoClient.GetNetworkID(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return NewContext(ctx, networkID, chainClient)
+}
 
+// NewContext returns the context of [networkID]'s P-chain, reading its
+// parameters from [chainClient].
+func NewContext(
+	ctx context.Context,
+	networkID uint32,
+	chainClient ChainReader,
+) (*builder.Context, error) {
 	avaxAssetID, err := chainClient.GetStakingAssetID(ctx, constants.PrimaryNetworkID)
 	if err != nil {
 		return nil, err
 	}
 
+	context := &builder.Context{
+		NetworkID:   networkID,
+		AVAXAssetID: avaxAssetID,
+	}
+	return context, RefreshContext(ctx, chainClient, context)
+}
+
+// RefreshContext updates the fee parameters of [context] to match the current
+// dynamic fee config and gas price of the chain.
+func RefreshContext(
+	ctx context.Context,
+	chainClient ChainReader,
+	context *builder.Context,
+) error {
 	dynamicFeeConfig, err := chainClient.GetFeeConfig(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	_, gasPrice, _, err := chainClient.GetFeeState(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &builder.Context{
-		NetworkID:         networkID,
-		AVAXAssetID:       avaxAssetID,
-		ComplexityWeights: dynamicFeeConfig.Weights,
-		GasPrice:          gasPriceMultiplier * gasPrice,
-	}, nil
+	context.ComplexityWeights = dynamicFeeConfig.Weights
+	context.GasPrice = gasPriceMultiplier * gasPrice
+	return nil
 }
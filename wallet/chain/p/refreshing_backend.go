@@ -0,0 +1,62 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+)
+
+var _ wallet.Backend = (*refreshingBackend)(nil)
+
+type refreshingBackend struct {
+	wallet.Backend
+
+	client   ChainReader
+	context  *builder.Context
+	interval time.Duration
+
+	clock       mockable.Clock
+	lastRefresh time.Time
+}
+
+// NewRefreshingBackend returns a Backend that refreshes the fee parameters of
+// [context] from [client] if they were last refreshed more than [interval]
+// ago. The builder fetches the UTXOs before calculating the fee of every
+// transaction, so the fee parameters are refreshed before the UTXOs are
+// returned.
+//
+// [context] is assumed to have just been fetched from [client]. Like the
+// builder, the returned Backend isn't safe for concurrent use.
+func NewRefreshingBackend(
+	backend wallet.Backend,
+	client ChainReader,
+	context *builder.Context,
+	interval time.Duration,
+) wallet.Backend {
+	b := &refreshingBackend{
+		Backend:  backend,
+		client:   client,
+		context:  context,
+		interval: interval,
+	}
+	b.lastRefresh = b.clock.Time()
+	return b
+}
+
+func (b *refreshingBackend) UTXOs(ctx context.Context, sourceChainID ids.ID) ([]*avax.UTXO, error) {
+	if now := b.clock.Time(); now.Sub(b.lastRefresh) >= b.interval {
+		if err := RefreshContext(ctx, b.client, b.context); err != nil {
+			return nil, err
+		}
+		b.lastRefresh = now
+	}
+	return b.Backend.UTXOs(ctx, sourceChainID)
+}
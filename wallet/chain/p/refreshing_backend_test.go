@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+// feeClient is a platformvm.Client that only reports the fee config and the
+// gas price.
+type feeClient struct {
+	platformvm.Client
+
+	weights  gas.Dimensions
+	gasPrice gas.Price
+}
+
+func (c *feeClient) GetFeeConfig(context.Context, ...rpc.Option) (*gas.Config, error) {
+	return &gas.Config{
+		Weights: c.weights,
+	}, nil
+}
+
+func (c *feeClient) GetFeeState(context.Context, ...rpc.Option) (gas.State, gas.Price, time.Time, error) {
+	return gas.State{}, c.gasPrice, time.Time{}, nil
+}
+
+func TestRefreshingBackend(t *testing.T) {
+	require := require.New(t)
+
+	initialWeights := gas.NewDimensions(
+		gas.WithBandwidth(1),
+		gas.WithDBRead(2),
+		gas.WithDBWrite(3),
+		gas.WithCompute(4),
+	)
+	client := &feeClient{
+		weights:  initialWeights,
+		gasPrice: 5,
+	}
+	pContext := &builder.Context{
+		ComplexityWeights: client.weights,
+		GasPrice:          gasPriceMultiplier * client.gasPrice,
+	}
+	utxos := common.NewChainUTXOs(constants.PlatformChainID, common.NewUTXOs())
+
+	const interval = time.Minute
+	backend := NewRefreshingBackend(
+		wallet.NewBackend(pContext, utxos, nil),
+		client,
+		pContext,
+		interval,
+	).(*refreshingBackend)
+	now := time.Now()
+	backend.clock.Set(now)
+	backend.lastRefresh = now
+
+	// The fees are retuned by the node.
+	client.weights = gas.NewDimensions(
+		gas.WithBandwidth(5),
+		gas.WithDBRead(6),
+		gas.WithDBWrite(7),
+		gas.WithCompute(8),
+	)
+	client.gasPrice = 10
+
+	// The context isn't refreshed before the interval has passed.
+	backend.clock.Set(now.Add(interval - time.Second))
+	_, err := backend.UTXOs(context.Background(), constants.PlatformChainID)
+	require.NoError(err)
+	require.Equal(initialWeights, pContext.ComplexityWeights)
+	require.Equal(gasPriceMultiplier*gas.Price(5), pContext.GasPrice)
+
+	// The context is refreshed once the interval has passed.
+	backend.clock.Set(now.Add(interval))
+	_, err = backend.UTXOs(context.Background(), constants.PlatformChainID)
+	require.NoError(err)
+	require.Equal(client.weights, pContext.ComplexityWeights)
+	require.Equal(gasPriceMultiplier*client.gasPrice, pContext.GasPrice)
+}
@@ -43,14 +43,6 @@ type visitor struct {
 	tx      *txs.Tx
 }
 
-func (*visitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
-	return ErrUnsupportedTxType
-}
-
-func (*visitor) RewardValidatorTx(*txs.RewardValidatorTx) error {
-	return ErrUnsupportedTxType
-}
-
 func (s *visitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
 	if err != nil {
@@ -235,6 +227,95 @@ func (s *visitor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) error {
 	return sign(s.tx, true, txSigners)
 }
 
+func (s *visitor) ClaimRewardsTx(tx *txs.ClaimRewardsTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	claimAuthSigners, err := s.getClaimAuthSigners(tx.RewardUTXOs, tx.ClaimAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, claimAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *visitor) SetSubnetValidatorWeightTx(tx *txs.SetSubnetValidatorWeightTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	subnetAuthSigners, err := s.getAuthSigners(tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, subnetAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *visitor) AddContinuousValidatorTx(tx *txs.AddContinuousValidatorTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *visitor) StopContinuousValidatorTx(tx *txs.StopContinuousValidatorTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	stopAuthSigners, err := s.getAuthSigners(tx.TxID, tx.StopAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, stopAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *visitor) AddMultiDelegatorTx(tx *txs.AddMultiDelegatorTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *visitor) SetWithdrawalOwnerTx(tx *txs.SetWithdrawalOwnerTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	ownerAuthSigners, err := s.getAuthSigners(tx.TxID, tx.OwnerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, ownerAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *visitor) RetireChainTx(tx *txs.RetireChainTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	subnetAuthSigners, err := s.getAuthSigners(tx.ChainID, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, subnetAuthSigners)
+	return sign(s.tx, true, txSigners)
+}
+
+func (s *visitor) ExpiringTx(tx *txs.ExpiringTx) error {
+	return tx.Tx.Visit(s)
+}
+
+func (s *visitor) DependentTx(tx *txs.DependentTx) error {
+	return tx.Tx.Visit(s)
+}
+
 func (s *visitor) getSigners(sourceChainID ids.ID, ins []*avax.TransferableInput) ([][]keychain.Signer, error) {
 	txSigners := make([][]keychain.Signer, len(ins))
 	for credIndex, transferInput := range ins {
@@ -266,6 +347,9 @@ func (s *visitor) getSigners(sourceChainID ids.ID, ins []*avax.TransferableInput
 		if stakeableOut, ok := outIntf.(*stakeable.LockOut); ok {
 			outIntf = stakeableOut.TransferableOut
 		}
+		if heightLockedOut, ok := outIntf.(*secp256k1fx.HeightLockedOutput); ok {
+			outIntf = &heightLockedOut.TransferOutput
+		}
 
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
@@ -327,6 +411,51 @@ func (s *visitor) getAuthSigners(ownerID ids.ID, auth verify.Verifiable) ([]keyc
 	return authSigners, nil
 }
 
+// getClaimAuthSigners returns the signers of [auth], which must authorize the
+// consumption of every UTXO in [utxoIDs]. The signers are derived from the
+// owner of the first UTXO that is known to the backend.
+func (s *visitor) getClaimAuthSigners(utxoIDs []*avax.UTXOID, auth verify.Verifiable) ([]keychain.Signer, error) {
+	input, ok := auth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, ErrUnknownAuthType
+	}
+
+	authSigners := make([]keychain.Signer, len(input.SigIndices))
+	for _, utxoID := range utxoIDs {
+		utxo, err := s.backend.GetUTXO(s.ctx, constants.PlatformChainID, utxoID.InputID())
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, ErrUnknownOutputType
+		}
+
+		for sigIndex, addrIndex := range input.SigIndices {
+			if addrIndex >= uint32(len(out.Addrs)) {
+				return nil, ErrInvalidUTXOSigIndex
+			}
+
+			addr := out.Addrs[addrIndex]
+			key, ok := s.kc.Get(addr)
+			if !ok {
+				// If we don't have access to the key, then we can't sign this
+				// transaction. However, we can attempt to partially sign it.
+				continue
+			}
+			authSigners[sigIndex] = key
+		}
+		return authSigners, nil
+	}
+	// If we don't have access to any of the UTXOs, then we can't sign this
+	// transaction. However, we can attempt to partially sign it.
+	return authSigners, nil
+}
+
 // TODO: remove [signHash] after the ledger supports signing all transactions.
 func sign(tx *txs.Tx, signHash bool, txSigners [][]keychain.Signer) error {
 	unsignedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &tx.Unsigned)
@@ -19,7 +19,10 @@ import (
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
 
-var _ Backend = (*backend)(nil)
+var (
+	_ Backend                    = (*backend)(nil)
+	_ common.ConfirmationTracker = (*backend)(nil)
+)
 
 // Backend defines the full interface required to support a P-chain wallet.
 type Backend interface {
@@ -35,7 +38,7 @@ type backend struct {
 	context *builder.Context
 
 	ownersLock sync.RWMutex
-	owners     map[ids.ID]fx.Owner // subnetID or validationID -> owner
+	owners     map[ids.ID]fx.Owner // subnetID, validationID, or chainID -> owner
 }
 
 func NewBackend(context *builder.Context, utxos common.ChainUTXOs, owners map[ids.ID]fx.Owner) Backend {
@@ -61,6 +64,16 @@ func (b *backend) AcceptTx(ctx context.Context, tx *txs.Tx) error {
 	return b.addUTXOs(ctx, constants.PlatformChainID, producedUTXOSlice)
 }
 
+// UTXOConfirmations reports the confirmations tracked by the UTXOs of the
+// backend, if they are tracked.
+func (b *backend) UTXOConfirmations(sourceChainID, utxoID ids.ID) (uint64, bool) {
+	tracker, ok := b.ChainUTXOs.(common.ConfirmationTracker)
+	if !ok {
+		return 0, false
+	}
+	return tracker.UTXOConfirmations(sourceChainID, utxoID)
+}
+
 func (b *backend) addUTXOs(ctx context.Context, destinationChainID ids.ID, utxos []*avax.UTXO) error {
 	for _, utxo := range utxos {
 		if err := b.AddUTXO(ctx, destinationChainID, utxo); err != nil {
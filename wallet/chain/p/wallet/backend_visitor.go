@@ -30,14 +30,6 @@ type backendVisitor struct {
 	txID ids.ID
 }
 
-func (*backendVisitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
-	return ErrUnsupportedTxType
-}
-
-func (*backendVisitor) RewardValidatorTx(*txs.RewardValidatorTx) error {
-	return ErrUnsupportedTxType
-}
-
 func (b *backendVisitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	return b.baseTx(&tx.BaseTx)
 }
@@ -104,6 +96,12 @@ func (b *backendVisitor) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
 }
 
 func (b *backendVisitor) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	// The validation rewards owner is allowed to set the withdrawal owner of
+	// the validator.
+	b.b.setOwner(
+		b.txID,
+		tx.ValidatorRewardsOwner,
+	)
 	return b.baseTx(&tx.BaseTx)
 }
 
@@ -172,6 +170,52 @@ func (b *backendVisitor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) erro
 	return b.baseTx(&tx.BaseTx)
 }
 
+func (b *backendVisitor) ClaimRewardsTx(tx *txs.ClaimRewardsTx) error {
+	return b.b.removeUTXOs(
+		b.ctx,
+		constants.PlatformChainID,
+		tx.InputIDs(),
+	)
+}
+
+func (b *backendVisitor) SetSubnetValidatorWeightTx(tx *txs.SetSubnetValidatorWeightTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) AddContinuousValidatorTx(tx *txs.AddContinuousValidatorTx) error {
+	// The validation rewards owner is allowed to stop the validator and to set
+	// its withdrawal owner.
+	b.b.setOwner(
+		b.txID,
+		tx.ValidatorRewardsOwner,
+	)
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) StopContinuousValidatorTx(tx *txs.StopContinuousValidatorTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) AddMultiDelegatorTx(tx *txs.AddMultiDelegatorTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) SetWithdrawalOwnerTx(tx *txs.SetWithdrawalOwnerTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) RetireChainTx(tx *txs.RetireChainTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) ExpiringTx(tx *txs.ExpiringTx) error {
+	return tx.Tx.Visit(b)
+}
+
+func (b *backendVisitor) DependentTx(tx *txs.DependentTx) error {
+	return tx.Tx.Visit(b)
+}
+
 func (b *backendVisitor) baseTx(tx *txs.BaseTx) error {
 	return b.b.removeUTXOs(
 		b.ctx,
@@ -83,6 +83,18 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueSetSubnetValidatorWeightTx creates, signs, and issues a
+	// transaction that sets the weight of a validator of a subnet.
+	//
+	// - [nodeID] is the validator of [subnetID] being modified.
+	// - [weight] is the new weight of the validator.
+	IssueSetSubnetValidatorWeightTx(
+		nodeID ids.NodeID,
+		subnetID ids.ID,
+		weight uint64,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueAddDelegatorTx creates, signs, and issues a new delegator to a
 	// validator on the primary network.
 	//
@@ -198,6 +210,19 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueClaimRewardsTx creates, signs, and issues a transaction that
+	// consumes the provided reward UTXOs and sends the claimed funds, minus
+	// the fee, to [to].
+	//
+	// - [rewardUTXOIDs] specifies the IDs of the reward UTXOs to claim. All of
+	//   the reward UTXOs must have the same owner.
+	// - [to] specifies where to send the claimed funds to.
+	IssueClaimRewardsTx(
+		rewardUTXOIDs []ids.ID,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueImportTx creates, signs, and issues an import transaction that
 	// attempts to consume all the available UTXOs and import the funds to [to].
 	//
@@ -308,7 +333,78 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
-	// IssueUnsignedTx signs and issues the unsigned tx.
+	// IssueAddContinuousValidatorTx creates, signs, and issues a new validator
+	// of the primary network that is automatically restaked at the end of
+	// every staking period until it is stopped.
+	//
+	// - [vdr] specifies all the details of the first validation period such as
+	//   the startTime, endTime, stake weight, and nodeID.
+	// - [signer] is the BLS key for this validator.
+	// - [validationRewardsOwner] specifies the owner of all the rewards this
+	//   validator earns for its validation periods. This owner is also allowed
+	//   to stop the validator.
+	// - [delegationRewardsOwner] specifies the owner of all the rewards this
+	//   validator earns during its validation periods from delegators.
+	// - [shares] specifies the fraction (out of 1,000,000) that this validator
+	//   will take from delegation rewards.
+	// - [period] specifies the duration of every subsequent staking period.
+	IssueAddContinuousValidatorTx(
+		vdr *txs.Validator,
+		signer vmsigner.Signer,
+		validationRewardsOwner *secp256k1fx.OutputOwners,
+		delegationRewardsOwner *secp256k1fx.OutputOwners,
+		shares uint32,
+		period time.Duration,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueStopContinuousValidatorTx creates, signs, and issues a transaction
+	// that stops the continuous validator added by [txID] from being restaked.
+	//
+	// - [txID] specifies the AddContinuousValidatorTx that added the validator.
+	IssueStopContinuousValidatorTx(
+		txID ids.ID,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueAddMultiDelegatorTx creates, signs, and issues new delegators of
+	// the primary network on each of the specified nodeIDs.
+	//
+	// - [vdrs] specifies all the details of each delegation period such as the
+	//   endTime, stake weight, and nodeID. Each nodeID may only be specified
+	//   once.
+	// - [rewardsOwner] specifies the owner of all the rewards these delegators
+	//   earn during their delegation periods.
+	IssueAddMultiDelegatorTx(
+		vdrs []*txs.Validator,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueSetWithdrawalOwnerTx creates, signs, and issues a transaction that
+	// binds a withdrawal owner to the validator added by [txID].
+	//
+	// - [txID] specifies the tx that added the validator.
+	// - [withdrawalOwner] specifies who the rewards of the validator are paid
+	//   to.
+	IssueSetWithdrawalOwnerTx(
+		txID ids.ID,
+		withdrawalOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueRetireChainTx creates, signs, and issues a transaction that retires
+	// a chain of a permissioned subnet.
+	//
+	// - [chainID] specifies the chain to retire.
+	IssueRetireChainTx(
+		chainID ids.ID,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// IssueUnsignedTx signs and issues the unsigned tx. If an expiry or a
+	// dependency is provided, the tx is wrapped into an ExpiringTx or a
+	// DependentTx before being signed.
 	IssueUnsignedTx(
 		utx txs.UnsignedTx,
 		options ...common.Option,
@@ -388,6 +484,19 @@ func (w *wallet) IssueRemoveSubnetValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueSetSubnetValidatorWeightTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	weight uint64,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewSetSubnetValidatorWeightTx(nodeID, subnetID, weight, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -499,6 +608,18 @@ func (w *wallet) IssueDisableL1ValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueClaimRewardsTx(
+	rewardUTXOIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewClaimRewardsTx(rewardUTXOIDs, to, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
@@ -605,11 +726,98 @@ func (w *wallet) IssueAddPermissionlessDelegatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueAddContinuousValidatorTx(
+	vdr *txs.Validator,
+	signer vmsigner.Signer,
+	validationRewardsOwner *secp256k1fx.OutputOwners,
+	delegationRewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	period time.Duration,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewAddContinuousValidatorTx(
+		vdr,
+		signer,
+		validationRewardsOwner,
+		delegationRewardsOwner,
+		shares,
+		period,
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueStopContinuousValidatorTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewStopContinuousValidatorTx(txID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueAddMultiDelegatorTx(
+	vdrs []*txs.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewAddMultiDelegatorTx(vdrs, rewardsOwner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueSetWithdrawalOwnerTx(
+	txID ids.ID,
+	withdrawalOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewSetWithdrawalOwnerTx(txID, withdrawalOwner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *wallet) IssueRetireChainTx(
+	chainID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewRetireChainTx(chainID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueUnsignedTx(
 	utx txs.UnsignedTx,
 	options ...common.Option,
 ) (*txs.Tx, error) {
 	ops := common.NewOptions(options)
+	if expiry := ops.Expiry(); expiry != 0 {
+		if _, ok := utx.(*txs.ExpiringTx); !ok {
+			utx = &txs.ExpiringTx{
+				Expiry: expiry,
+				Tx:     utx,
+			}
+		}
+	}
+	if dependency := ops.Dependency(); dependency != ids.Empty {
+		if _, ok := utx.(*txs.DependentTx); !ok {
+			utx = &txs.DependentTx{
+				DependsOn: dependency,
+				Tx:        utx,
+			}
+		}
+	}
+
 	ctx := ops.Context()
 	tx, err := walletsigner.SignUnsigned(ctx, w.signer, utx)
 	if err != nil {
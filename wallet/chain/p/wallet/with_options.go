@@ -92,6 +92,20 @@ func (w *withOptions) IssueRemoveSubnetValidatorTx(
 	)
 }
 
+func (w *withOptions) IssueSetSubnetValidatorWeightTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	weight uint64,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueSetSubnetValidatorWeightTx(
+		nodeID,
+		subnetID,
+		weight,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) IssueAddDelegatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -206,6 +220,18 @@ func (w *withOptions) IssueDisableL1ValidatorTx(
 	)
 }
 
+func (w *withOptions) IssueClaimRewardsTx(
+	rewardUTXOIDs []ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueClaimRewardsTx(
+		rewardUTXOIDs,
+		to,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) IssueImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
@@ -300,6 +326,70 @@ func (w *withOptions) IssueAddPermissionlessDelegatorTx(
 	)
 }
 
+func (w *withOptions) IssueAddContinuousValidatorTx(
+	vdr *txs.Validator,
+	signer vmsigner.Signer,
+	validationRewardsOwner *secp256k1fx.OutputOwners,
+	delegationRewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	period time.Duration,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueAddContinuousValidatorTx(
+		vdr,
+		signer,
+		validationRewardsOwner,
+		delegationRewardsOwner,
+		shares,
+		period,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) IssueStopContinuousValidatorTx(
+	txID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueStopContinuousValidatorTx(
+		txID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) IssueAddMultiDelegatorTx(
+	vdrs []*txs.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueAddMultiDelegatorTx(
+		vdrs,
+		rewardsOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) IssueSetWithdrawalOwnerTx(
+	txID ids.ID,
+	withdrawalOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueSetWithdrawalOwnerTx(
+		txID,
+		withdrawalOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *withOptions) IssueRetireChainTx(
+	chainID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueRetireChainTx(
+		chainID,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) IssueUnsignedTx(
 	utx txs.UnsignedTx,
 	options ...common.Option,
@@ -6,13 +6,17 @@ package x
 import (
 	"context"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
 	"github.com/ava-labs/avalanchego/wallet/chain/x/signer"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
 
-var _ Backend = (*backend)(nil)
+var (
+	_ Backend                    = (*backend)(nil)
+	_ common.ConfirmationTracker = (*backend)(nil)
+)
 
 // Backend defines the full interface required to support an X-chain wallet.
 type Backend interface {
@@ -65,3 +69,13 @@ func (b *backend) AcceptTx(ctx context.Context, tx *txs.Tx) error {
 	}
 	return nil
 }
+
+// UTXOConfirmations reports the confirmations tracked by the UTXOs of the
+// backend, if they are tracked.
+func (b *backend) UTXOConfirmations(sourceChainID, utxoID ids.ID) (uint64, bool) {
+	tracker, ok := b.ChainUTXOs.(common.ConfirmationTracker)
+	if !ok {
+		return 0, false
+	}
+	return tracker.UTXOConfirmations(sourceChainID, utxoID)
+}
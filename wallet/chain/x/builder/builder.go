@@ -22,8 +22,9 @@ import (
 )
 
 var (
-	errNoChangeAddress   = errors.New("no possible change address")
-	errInsufficientFunds = errors.New("insufficient funds")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+
+	errNoChangeAddress = errors.New("no possible change address")
 
 	fxIndexToID = map[uint32]ids.ID{
 		SECP256K1FxIndex: secp256k1fx.ID,
@@ -208,9 +209,7 @@ func (b *builder) NewBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.BaseTxFee,
-	}
+	toBurn := map[ids.ID]uint64{}
 	for _, out := range outputs {
 		assetID := out.AssetID()
 		amountToBurn, err := math.Add(toBurn[assetID], out.Out.Amount())
@@ -221,7 +220,7 @@ func (b *builder) NewBaseTx(
 	}
 
 	ops := common.NewOptions(options)
-	inputs, changeOutputs, err := b.spend(toBurn, ops)
+	inputs, changeOutputs, err := b.spend(toBurn, b.context.BaseTxFee, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -245,11 +244,8 @@ func (b *builder) NewCreateAssetTx(
 	initialState map[uint32][]verify.State,
 	options ...common.Option,
 ) (*txs.CreateAssetTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.CreateAssetTxFee,
-	}
 	ops := common.NewOptions(options)
-	inputs, outputs, err := b.spend(toBurn, ops)
+	inputs, outputs, err := b.spend(map[ids.ID]uint64{}, b.context.CreateAssetTxFee, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -287,11 +283,8 @@ func (b *builder) NewOperationTx(
 	operations []*txs.Operation,
 	options ...common.Option,
 ) (*txs.OperationTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.BaseTxFee,
-	}
 	ops := common.NewOptions(options)
-	inputs, outputs, err := b.spend(toBurn, ops)
+	inputs, outputs, err := b.spend(map[ids.ID]uint64{}, b.context.BaseTxFee, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +360,7 @@ func (b *builder) NewImportTx(
 	options ...common.Option,
 ) (*txs.ImportTx, error) {
 	ops := common.NewOptions(options)
-	utxos, err := b.backend.UTXOs(ops.Context(), chainID)
+	utxos, err := b.spendableUTXOs(chainID, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -419,7 +412,7 @@ func (b *builder) NewImportTx(
 	if len(importedAmounts) == 0 {
 		return nil, fmt.Errorf(
 			"%w: no UTXOs available to import",
-			errInsufficientFunds,
+			ErrInsufficientFunds,
 		)
 	}
 
@@ -428,15 +421,20 @@ func (b *builder) NewImportTx(
 		outputs      = make([]*avax.TransferableOutput, 0, len(importedAmounts))
 		importedAVAX = importedAmounts[avaxAssetID]
 	)
-	if importedAVAX > txFee {
+	if _, sponsored := ops.FeeAddresses(); sponsored {
+		// The imported amount is returned in full, as the fee is paid by the
+		// fee addresses.
+		var err error
+		inputs, outputs, err = b.spend(map[ids.ID]uint64{}, txFee, ops)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+		}
+	} else if importedAVAX > txFee {
 		importedAmounts[avaxAssetID] -= txFee
 	} else {
 		if importedAVAX < txFee { // imported amount goes toward paying tx fee
-			toBurn := map[ids.ID]uint64{
-				avaxAssetID: txFee - importedAVAX,
-			}
 			var err error
-			inputs, outputs, err = b.spend(toBurn, ops)
+			inputs, outputs, err = b.spend(map[ids.ID]uint64{}, txFee-importedAVAX, ops)
 			if err != nil {
 				return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 			}
@@ -475,9 +473,7 @@ func (b *builder) NewExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
-	toBurn := map[ids.ID]uint64{
-		b.context.AVAXAssetID: b.context.BaseTxFee,
-	}
+	toBurn := map[ids.ID]uint64{}
 	for _, out := range outputs {
 		assetID := out.AssetID()
 		amountToBurn, err := math.Add(toBurn[assetID], out.Out.Amount())
@@ -488,7 +484,7 @@ func (b *builder) NewExportTx(
 	}
 
 	ops := common.NewOptions(options)
-	inputs, changeOutputs, err := b.spend(toBurn, ops)
+	inputs, changeOutputs, err := b.spend(toBurn, b.context.BaseTxFee, ops)
 	if err != nil {
 		return nil, err
 	}
@@ -508,6 +504,23 @@ func (b *builder) NewExportTx(
 	return tx, b.initCtx(tx)
 }
 
+// spendableUTXOs verifies [options] and returns the UTXOs of [sourceChainID]
+// that they allow to be spent.
+func (b *builder) spendableUTXOs(
+	sourceChainID ids.ID,
+	options *common.Options,
+) ([]*avax.UTXO, error) {
+	if err := options.Verify(); err != nil {
+		return nil, err
+	}
+	utxos, err := b.backend.UTXOs(options.Context(), sourceChainID)
+	if err != nil {
+		return nil, err
+	}
+	confirmations, _ := b.backend.(common.ConfirmationTracker)
+	return options.FilterUTXOs(sourceChainID, utxos, confirmations)
+}
+
 func (b *builder) getBalance(
 	chainID ids.ID,
 	options *common.Options,
@@ -515,18 +528,28 @@ func (b *builder) getBalance(
 	balance map[ids.ID]uint64,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), chainID)
+	utxos, err := b.spendableUTXOs(chainID, options)
 	if err != nil {
 		return nil, err
 	}
 
 	addrs := options.Addresses(b.addrs)
 	minIssuanceTime := options.MinIssuanceTime()
+	minIssuanceHeight := options.MinIssuanceHeight()
 	balance = make(map[ids.ID]uint64)
 
 	// Iterate over the UTXOs
 	for _, utxo := range utxos {
 		outIntf := utxo.Out
+		if heightLockedOut, ok := outIntf.(*secp256k1fx.HeightLockedOutput); ok {
+			if heightLockedOut.LockHeight > minIssuanceHeight {
+				// This output is currently locked, so this output can't be
+				// spent.
+				continue
+			}
+			outIntf = &heightLockedOut.TransferOutput
+		}
+
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
 			// We only support [secp256k1fx.TransferOutput]s.
@@ -548,22 +571,23 @@ func (b *builder) getBalance(
 	return balance, nil
 }
 
+// spend consumes UTXOs to burn [amountsToBurn] and to pay [fee] in AVAX. If fee
+// addresses were provided, the fee is paid only by their UTXOs.
 func (b *builder) spend(
 	amountsToBurn map[ids.ID]uint64,
+	fee uint64,
 	options *common.Options,
 ) (
 	inputs []*avax.TransferableInput,
 	outputs []*avax.TransferableOutput,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
+	utxos, err := b.spendableUTXOs(b.context.BlockchainID, options)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	addrs := options.Addresses(b.addrs)
-	minIssuanceTime := options.MinIssuanceTime()
-
 	addr, ok := addrs.Peek()
 	if !ok {
 		return nil, nil, errNoChangeAddress
@@ -573,6 +597,75 @@ func (b *builder) spend(
 		Addrs:     []ids.ShortID{addr},
 	})
 
+	feeAddrs, sponsored := options.FeeAddresses()
+	if !sponsored {
+		amountsToBurn[b.context.AVAXAssetID], err = math.Add(amountsToBurn[b.context.AVAXAssetID], fee)
+		if err != nil {
+			return nil, nil, err
+		}
+		return spendUTXOs(utxos, amountsToBurn, addrs, changeOwner, options)
+	}
+
+	feeAddr, ok := feeAddrs.Peek()
+	if !ok {
+		return nil, nil, errNoChangeAddress
+	}
+	inputs, outputs, err = spendUTXOs(utxos, amountsToBurn, addrs, changeOwner, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The UTXOs that were consumed can't also pay the fee.
+	consumed := set.NewSet[ids.ID](len(inputs))
+	for _, input := range inputs {
+		consumed.Add(input.InputID())
+	}
+	unconsumedUTXOs := make([]*avax.UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !consumed.Contains(utxo.InputID()) {
+			unconsumedUTXOs = append(unconsumedUTXOs, utxo)
+		}
+	}
+
+	feeInputs, feeOutputs, err := spendUTXOs(
+		unconsumedUTXOs,
+		map[ids.ID]uint64{
+			b.context.AVAXAssetID: fee,
+		},
+		feeAddrs,
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{feeAddr},
+		},
+		options,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't pay the fee: %w", err)
+	}
+
+	inputs = append(inputs, feeInputs...)
+	outputs = append(outputs, feeOutputs...)
+	utils.Sort(inputs)                                    // sort inputs
+	avax.SortTransferableOutputs(outputs, Parser.Codec()) // sort the change outputs
+	return inputs, outputs, nil
+}
+
+// spendUTXOs consumes [utxos] owned by [addrs] to burn [amountsToBurn],
+// returning any excess to [changeOwner].
+func spendUTXOs(
+	utxos []*avax.UTXO,
+	amountsToBurn map[ids.ID]uint64,
+	addrs set.Set[ids.ShortID],
+	changeOwner *secp256k1fx.OutputOwners,
+	options *common.Options,
+) (
+	inputs []*avax.TransferableInput,
+	outputs []*avax.TransferableOutput,
+	err error,
+) {
+	minIssuanceTime := options.MinIssuanceTime()
+	minIssuanceHeight := options.MinIssuanceHeight()
+
 	// Iterate over the UTXOs
 	for _, utxo := range utxos {
 		assetID := utxo.AssetID()
@@ -585,6 +678,15 @@ func (b *builder) spend(
 		}
 
 		outIntf := utxo.Out
+		if heightLockedOut, ok := outIntf.(*secp256k1fx.HeightLockedOutput); ok {
+			if heightLockedOut.LockHeight > minIssuanceHeight {
+				// This output is currently locked, so this output can't be
+				// spent.
+				continue
+			}
+			outIntf = &heightLockedOut.TransferOutput
+		}
+
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
 			// We only support burning [secp256k1fx.TransferOutput]s.
@@ -632,7 +734,7 @@ func (b *builder) spend(
 		if amount != 0 {
 			return nil, nil, fmt.Errorf(
 				"%w: provided UTXOs need %d more units of asset %q",
-				errInsufficientFunds,
+				ErrInsufficientFunds,
 				amount,
 				assetID,
 			)
@@ -651,7 +753,7 @@ func (b *builder) mintFTs(
 	operations []*txs.Operation,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
+	utxos, err := b.spendableUTXOs(b.context.BlockchainID, options)
 	if err != nil {
 		return nil, err
 	}
@@ -697,7 +799,7 @@ func (b *builder) mintFTs(
 	for assetID := range outputs {
 		return nil, fmt.Errorf(
 			"%w: provided UTXOs not able to mint asset %q",
-			errInsufficientFunds,
+			ErrInsufficientFunds,
 			assetID,
 		)
 	}
@@ -714,7 +816,7 @@ func (b *builder) mintNFTs(
 	operations []*txs.Operation,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
+	utxos, err := b.spendableUTXOs(b.context.BlockchainID, options)
 	if err != nil {
 		return nil, err
 	}
@@ -758,7 +860,7 @@ func (b *builder) mintNFTs(
 	}
 	return nil, fmt.Errorf(
 		"%w: provided UTXOs not able to mint NFT %q",
-		errInsufficientFunds,
+		ErrInsufficientFunds,
 		assetID,
 	)
 }
@@ -771,7 +873,7 @@ func (b *builder) mintProperty(
 	operations []*txs.Operation,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
+	utxos, err := b.spendableUTXOs(b.context.BlockchainID, options)
 	if err != nil {
 		return nil, err
 	}
@@ -816,7 +918,7 @@ func (b *builder) mintProperty(
 	}
 	return nil, fmt.Errorf(
 		"%w: provided UTXOs not able to mint property %q",
-		errInsufficientFunds,
+		ErrInsufficientFunds,
 		assetID,
 	)
 }
@@ -828,7 +930,7 @@ func (b *builder) burnProperty(
 	operations []*txs.Operation,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), b.context.BlockchainID)
+	utxos, err := b.spendableUTXOs(b.context.BlockchainID, options)
 	if err != nil {
 		return nil, err
 	}
@@ -869,7 +971,7 @@ func (b *builder) burnProperty(
 	if len(operations) == 0 {
 		return nil, fmt.Errorf(
 			"%w: provided UTXOs not able to burn property %q",
-			errInsufficientFunds,
+			ErrInsufficientFunds,
 			assetID,
 		)
 	}
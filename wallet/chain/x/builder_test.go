@@ -19,6 +19,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common/utxotest"
 )
 
@@ -93,6 +94,84 @@ func TestBaseTx(t *testing.T) {
 	require.Equal(outputsToMove[0], outs[1])
 }
 
+func TestBaseTxWithFeeAddresses(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		userAddr = testKeys[1].Address()
+		feeAddr  = testKeys[2].Address()
+		userUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.Empty.Prefix(2025),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{userAddr},
+				},
+			},
+		}
+		feeUTXO = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.Empty.Prefix(2026),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{feeAddr},
+				},
+			},
+		}
+		genericBackend = utxotest.NewDeterministicChainUTXOs(
+			t,
+			map[ids.ID][]*avax.UTXO{
+				xChainID: {userUTXO, feeUTXO},
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+		b       = builder.New(set.Of(userAddr), testContext, backend)
+
+		output = &avax.TransferableOutput{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.Avax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{testKeys[3].Address()},
+				},
+			},
+		}
+	)
+
+	// The user's UTXO can't pay for both the transfer and the fee.
+	_, err := b.NewBaseTx([]*avax.TransferableOutput{output})
+	require.ErrorIs(err, builder.ErrInsufficientFunds)
+
+	utx, err := b.NewBaseTx(
+		[]*avax.TransferableOutput{output},
+		common.WithFeeAddresses(set.Of(feeAddr)),
+	)
+	require.NoError(err)
+	require.Len(utx.Ins, 2)
+	require.Len(utx.Outs, 2)
+	require.Contains(utx.Outs, output)
+
+	// The fee is paid by the fee address, which receives the change.
+	for _, out := range utx.Outs {
+		if out == output {
+			continue
+		}
+		changeOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+		require.True(ok)
+		require.Equal([]ids.ShortID{feeAddr}, changeOut.Addrs)
+		require.Equal(units.Avax-testContext.BaseTxFee, changeOut.Amt)
+	}
+}
+
 func TestCreateAssetTx(t *testing.T) {
 	require := require.New(t)
 
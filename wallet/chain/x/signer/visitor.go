@@ -119,7 +119,12 @@ func (s *visitor) getSigners(ctx context.Context, sourceChainID ids.ID, ins []*a
 			return nil, nil, err
 		}
 
-		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		outIntf := utxo.Out
+		if heightLockedOut, ok := outIntf.(*secp256k1fx.HeightLockedOutput); ok {
+			outIntf = &heightLockedOut.TransferOutput
+		}
+
+		out, ok := outIntf.(*secp256k1fx.TransferOutput)
 		if !ok {
 			return nil, nil, ErrUnknownOutputType
 		}
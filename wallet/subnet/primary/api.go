@@ -44,6 +44,9 @@ const (
 var (
 	_ UTXOClient = platformvm.Client(nil)
 	_ UTXOClient = avm.Client(nil)
+
+	_ UTXOSnapshotClient = platformvm.Client(nil)
+	_ UTXOSnapshotClient = avm.Client(nil)
 )
 
 type UTXOClient interface {
@@ -58,6 +61,16 @@ type UTXOClient interface {
 	) ([][]byte, ids.ShortID, ids.ID, error)
 }
 
+type UTXOSnapshotClient interface {
+	GetUTXOSnapshot(
+		ctx context.Context,
+		height uint64,
+		startUTXOID ids.ID,
+		limit uint32,
+		options ...rpc.Option,
+	) (*avax.UTXOSnapshotPage, error)
+}
+
 type AVAXState struct {
 	PClient platformvm.Client
 	PCTX    *pbuilder.Context
@@ -75,10 +88,21 @@ func FetchState(
 ) (
 	*AVAXState,
 	error,
+) {
+	return fetchState(ctx, uri, platformvm.NewClient(uri), avm.NewClient(uri, "X"), addrs)
+}
+
+func fetchState(
+	ctx context.Context,
+	uri string,
+	pClient platformvm.Client,
+	xClient avm.Client,
+	addrs set.Set[ids.ShortID],
+) (
+	*AVAXState,
+	error,
 ) {
 	infoClient := info.NewClient(uri)
-	pClient := platformvm.NewClient(uri)
-	xClient := avm.NewClient(uri, "X")
 	cClient := client.NewCChainClient(uri)
 
 	pCTX, err := p.NewContextFromClients(ctx, infoClient, pClient)
@@ -155,9 +179,22 @@ func FetchPState(
 	*pbuilder.Context,
 	walletcommon.UTXOs,
 	error,
+) {
+	return fetchPState(ctx, uri, platformvm.NewClient(uri), addrs)
+}
+
+func fetchPState(
+	ctx context.Context,
+	uri string,
+	chainClient platformvm.Client,
+	addrs set.Set[ids.ShortID],
+) (
+	platformvm.Client,
+	*pbuilder.Context,
+	walletcommon.UTXOs,
+	error,
 ) {
 	infoClient := info.NewClient(uri)
-	chainClient := platformvm.NewClient(uri)
 
 	context, err := p.NewContextFromClients(ctx, infoClient, chainClient)
 	if err != nil {
@@ -219,6 +256,45 @@ func FetchEthState(
 	}, nil
 }
 
+// GetUTXOSnapshot fetches every UTXO of [chainID] at [height] from the node at
+// [uri] and passes them to [onUTXO], in increasing order of UTXO ID. The
+// returned digest has been verified against the fetched UTXOs.
+//
+// [chainID] must be the P-chain or an X-chain, and the node must have taken a
+// UTXO snapshot of the chain at [height].
+func GetUTXOSnapshot(
+	ctx context.Context,
+	uri string,
+	chainID ids.ID,
+	height uint64,
+	onUTXO func(utxoID ids.ID, utxoBytes []byte) error,
+) (ids.ID, error) {
+	var client UTXOSnapshotClient
+	if chainID == constants.PlatformChainID {
+		client = platformvm.NewClient(uri)
+	} else {
+		client = avm.NewClient(uri, chainID.String())
+	}
+	return FetchUTXOSnapshot(ctx, client, height, onUTXO)
+}
+
+// FetchUTXOSnapshot fetches every UTXO at [height] from [client] and passes
+// them to [onUTXO], in increasing order of UTXO ID. The returned digest has
+// been verified against the fetched UTXOs.
+func FetchUTXOSnapshot(
+	ctx context.Context,
+	client UTXOSnapshotClient,
+	height uint64,
+	onUTXO func(utxoID ids.ID, utxoBytes []byte) error,
+) (ids.ID, error) {
+	return avax.FetchUTXOSnapshot(
+		func(startUTXOID ids.ID) (*avax.UTXOSnapshotPage, error) {
+			return client.GetUTXOSnapshot(ctx, height, startUTXOID, fetchLimit)
+		},
+		onUTXO,
+	)
+}
+
 // AddAllUTXOs fetches all the UTXOs referenced by [addresses] that were sent
 // from [sourceChainID] to [destinationChainID] from the [client]. It then uses
 // [codec] to parse the returned UTXOs and it adds them into [utxos]. If [ctx]
@@ -5,11 +5,14 @@ package common
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -17,6 +20,13 @@ import (
 
 const defaultPollFrequency = 100 * time.Millisecond
 
+var (
+	ErrMemoTooLarge            = errors.New("memo too large")
+	ErrInvalidChangeOwner      = errors.New("invalid change owner")
+	ErrConflictingUTXOs        = errors.New("UTXO is both included and excluded")
+	ErrConfirmationsNotTracked = errors.New("UTXO confirmations aren't tracked")
+)
+
 // Signature of the function that will be called after a transaction
 // has been issued with the ID of the issued transaction.
 type PostIssuanceFunc func(ids.ID)
@@ -32,17 +42,46 @@ type Options struct {
 	customEthAddressesSet bool
 	customEthAddresses    set.Set[ethcommon.Address]
 
+	// feeAddresses, if set, are the addresses whose UTXOs pay the fee of the
+	// transaction, rather than the addresses that fund the transaction.
+	feeAddressesSet bool
+	feeAddresses    set.Set[ids.ShortID]
+
 	baseFee *big.Int
 
 	minIssuanceTimeSet bool
 	minIssuanceTime    uint64
 
+	// minIssuanceHeight is the last accepted height that the transaction is
+	// assumed to be verified against. Height locked outputs are only spent if
+	// they are unlocked at this height.
+	minIssuanceHeight uint64
+
 	allowStakeableLocked bool
 
 	changeOwner *secp256k1fx.OutputOwners
 
 	memo []byte
 
+	// minConfirmations is the number of accepted blocks, including the block
+	// that produced a UTXO, required for the UTXO to be spent.
+	minConfirmations uint64
+
+	// includedUTXOs, if set, are the only UTXOs that may be spent.
+	includedUTXOsSet bool
+	includedUTXOs    set.Set[ids.ID]
+
+	// excludedUTXOs are never spent.
+	excludedUTXOs set.Set[ids.ID]
+
+	// expiry is the unix time, in seconds, after which the transaction can no
+	// longer be accepted. If 0, the transaction does not expire.
+	expiry uint64
+
+	// dependency is the ID of the transaction that must be accepted before
+	// the transaction can be accepted. If empty, there is no dependency.
+	dependency ids.ID
+
 	assumeDecided bool
 
 	pollFrequencySet bool
@@ -92,6 +131,10 @@ func (o *Options) EthAddresses(defaultAddresses set.Set[ethcommon.Address]) set.
 	return defaultAddresses
 }
 
+func (o *Options) FeeAddresses() (set.Set[ids.ShortID], bool) {
+	return o.feeAddresses, o.feeAddressesSet
+}
+
 func (o *Options) BaseFee(defaultBaseFee *big.Int) *big.Int {
 	if o.baseFee != nil {
 		return o.baseFee
@@ -106,6 +149,10 @@ func (o *Options) MinIssuanceTime() uint64 {
 	return uint64(time.Now().Unix())
 }
 
+func (o *Options) MinIssuanceHeight() uint64 {
+	return o.minIssuanceHeight
+}
+
 func (o *Options) AllowStakeableLocked() bool {
 	return o.allowStakeableLocked
 }
@@ -121,6 +168,26 @@ func (o *Options) Memo() []byte {
 	return o.memo
 }
 
+func (o *Options) MinConfirmations() uint64 {
+	return o.minConfirmations
+}
+
+func (o *Options) IncludedUTXOs() (set.Set[ids.ID], bool) {
+	return o.includedUTXOs, o.includedUTXOsSet
+}
+
+func (o *Options) ExcludedUTXOs() set.Set[ids.ID] {
+	return o.excludedUTXOs
+}
+
+func (o *Options) Expiry() uint64 {
+	return o.expiry
+}
+
+func (o *Options) Dependency() ids.ID {
+	return o.dependency
+}
+
 func (o *Options) AssumeDecided() bool {
 	return o.assumeDecided
 }
@@ -136,6 +203,60 @@ func (o *Options) PostIssuanceFunc() PostIssuanceFunc {
 	return o.postIssuanceFunc
 }
 
+// Verify returns an error if the options can't be honored by the builders.
+func (o *Options) Verify() error {
+	if len(o.memo) > avax.MaxMemoSize {
+		return fmt.Errorf("%w: %d > %d", ErrMemoTooLarge, len(o.memo), avax.MaxMemoSize)
+	}
+	if o.changeOwner != nil {
+		if err := o.changeOwner.Verify(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidChangeOwner, err)
+		}
+	}
+	for utxoID := range o.excludedUTXOs {
+		if o.includedUTXOs.Contains(utxoID) {
+			return fmt.Errorf("%w: %s", ErrConflictingUTXOs, utxoID)
+		}
+	}
+	return nil
+}
+
+// FilterUTXOs returns the UTXOs in [utxos], produced by [sourceChainID], that
+// the options allow to be spent.
+//
+// If a minimum number of confirmations is required, [confirmations] must
+// track the confirmations of the UTXOs of [sourceChainID].
+func (o *Options) FilterUTXOs(
+	sourceChainID ids.ID,
+	utxos []*avax.UTXO,
+	confirmations ConfirmationTracker,
+) ([]*avax.UTXO, error) {
+	filtered := make([]*avax.UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		utxoID := utxo.InputID()
+		if o.includedUTXOsSet && !o.includedUTXOs.Contains(utxoID) {
+			continue
+		}
+		if o.excludedUTXOs.Contains(utxoID) {
+			continue
+		}
+		if o.minConfirmations > 0 {
+			if confirmations == nil {
+				return nil, fmt.Errorf("%w: of %s", ErrConfirmationsNotTracked, sourceChainID)
+			}
+			numConfirmations, ok := confirmations.UTXOConfirmations(sourceChainID, utxoID)
+			if !ok {
+				return nil, fmt.Errorf("%w: of %s", ErrConfirmationsNotTracked, sourceChainID)
+			}
+			if numConfirmations < o.minConfirmations {
+				continue
+			}
+		}
+		filtered = append(filtered, utxo)
+	}
+	return filtered, nil
+}
+
 func WithContext(ctx context.Context) Option {
 	return func(o *Options) {
 		o.ctx = ctx
@@ -156,6 +277,17 @@ func WithCustomEthAddresses(addrs set.Set[ethcommon.Address]) Option {
 	}
 }
 
+// WithFeeAddresses pays the fee of the transaction with UTXOs owned by [addrs].
+// The UTXOs must be available to the wallet's backend and the keys of [addrs]
+// must be used to sign the transaction. Any excess is returned to one of
+// [addrs].
+func WithFeeAddresses(addrs set.Set[ids.ShortID]) Option {
+	return func(o *Options) {
+		o.feeAddressesSet = true
+		o.feeAddresses = addrs
+	}
+}
+
 func WithBaseFee(baseFee *big.Int) Option {
 	return func(o *Options) {
 		o.baseFee = baseFee
@@ -169,6 +301,12 @@ func WithMinIssuanceTime(minIssuanceTime uint64) Option {
 	}
 }
 
+func WithMinIssuanceHeight(minIssuanceHeight uint64) Option {
+	return func(o *Options) {
+		o.minIssuanceHeight = minIssuanceHeight
+	}
+}
+
 func WithStakeableLocked() Option {
 	return func(o *Options) {
 		o.allowStakeableLocked = true
@@ -187,6 +325,46 @@ func WithMemo(memo []byte) Option {
 	}
 }
 
+// WithMinConfirmations only spends UTXOs that were produced at least
+// [minConfirmations] accepted blocks ago, counting the block that produced
+// them. The wallet's backend must track the confirmations of its UTXOs.
+func WithMinConfirmations(minConfirmations uint64) Option {
+	return func(o *Options) {
+		o.minConfirmations = minConfirmations
+	}
+}
+
+// WithIncludedUTXOs only spends the UTXOs in [utxoIDs].
+func WithIncludedUTXOs(utxoIDs set.Set[ids.ID]) Option {
+	return func(o *Options) {
+		o.includedUTXOsSet = true
+		o.includedUTXOs = utxoIDs
+	}
+}
+
+// WithExcludedUTXOs never spends the UTXOs in [utxoIDs].
+func WithExcludedUTXOs(utxoIDs set.Set[ids.ID]) Option {
+	return func(o *Options) {
+		o.excludedUTXOs = utxoIDs
+	}
+}
+
+// WithExpiry makes the transaction invalid if it has not been accepted by the
+// time the chain time passes [expiry].
+func WithExpiry(expiry time.Time) Option {
+	return func(o *Options) {
+		o.expiry = uint64(expiry.Unix())
+	}
+}
+
+// WithDependency makes the transaction invalid unless [txID] has already been
+// accepted.
+func WithDependency(txID ids.ID) Option {
+	return func(o *Options) {
+		o.dependency = txID
+	}
+}
+
 func WithAssumeDecided() Option {
 	return func(o *Options) {
 		o.assumeDecided = true
@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+var (
+	_ ChainUTXOs          = (*syncingChainUTXOs)(nil)
+	_ ConfirmationTracker = (*syncingChainUTXOs)(nil)
+)
+
+// UTXODiffClient returns the changes made to the UTXOs of addresses after a
+// height. It is implemented by the P-chain and X-chain clients.
+type UTXODiffClient interface {
+	GetUTXODiff(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		height uint64,
+		options ...rpc.Option,
+	) ([][]byte, []ids.ID, uint64, error)
+}
+
+type syncingChainUTXOs struct {
+	ChainUTXOs
+
+	chainID  ids.ID
+	client   UTXODiffClient
+	codec    codec.Manager
+	addrs    []ids.ShortID
+	interval time.Duration
+
+	clock    mockable.Clock
+	lastSync time.Time
+	height   uint64
+
+	// startHeight is the height that the initial UTXOs were known as of.
+	startHeight uint64
+	// heights of the UTXOs that were added after the initial UTXOs. A UTXO
+	// that was added by the wallet, and that hasn't been reported by a sync
+	// yet, is at [math.MaxUint64].
+	heights map[ids.ID]uint64
+}
+
+// NewSyncingChainUTXOs returns ChainUTXOs that apply the changes made to the
+// UTXOs of [addrs] on [chainID], as reported by [client], if they were last
+// synced more than [interval] ago. The changes are applied before the UTXOs
+// of [chainID] are returned, so only the changes since the last sync are
+// fetched rather than all of the UTXOs.
+//
+// [utxos] are assumed to include all the UTXOs of [addrs] on [chainID] as of
+// [height]. Like the builder, the returned ChainUTXOs isn't safe for
+// concurrent use.
+//
+// The returned ChainUTXOs also implements [ConfirmationTracker] for the UTXOs
+// of [chainID]. As the height a UTXO was produced at is only known up to the
+// heights covered by a sync, the reported confirmations are a lower bound.
+func NewSyncingChainUTXOs(
+	utxos ChainUTXOs,
+	chainID ids.ID,
+	client UTXODiffClient,
+	codec codec.Manager,
+	addrs []ids.ShortID,
+	height uint64,
+	interval time.Duration,
+) ChainUTXOs {
+	u := &syncingChainUTXOs{
+		ChainUTXOs: utxos,
+		chainID:    chainID,
+		client:     client,
+		codec:      codec,
+		addrs:      addrs,
+		interval:   interval,
+		height:     height,
+
+		startHeight: height,
+		heights:     make(map[ids.ID]uint64),
+	}
+	u.lastSync = u.clock.Time()
+	return u
+}
+
+func (u *syncingChainUTXOs) AddUTXO(ctx context.Context, destinationChainID ids.ID, utxo *avax.UTXO) error {
+	// The UTXO may have already been reported by a sync.
+	utxoID := utxo.InputID()
+	if _, ok := u.heights[utxoID]; !ok && destinationChainID == u.chainID {
+		u.heights[utxoID] = math.MaxUint64
+	}
+	return u.ChainUTXOs.AddUTXO(ctx, destinationChainID, utxo)
+}
+
+func (u *syncingChainUTXOs) RemoveUTXO(ctx context.Context, sourceChainID, utxoID ids.ID) error {
+	if sourceChainID == u.chainID {
+		delete(u.heights, utxoID)
+	}
+	return u.ChainUTXOs.RemoveUTXO(ctx, sourceChainID, utxoID)
+}
+
+func (u *syncingChainUTXOs) UTXOConfirmations(sourceChainID, utxoID ids.ID) (uint64, bool) {
+	if sourceChainID != u.chainID {
+		return 0, false
+	}
+
+	height, ok := u.heights[utxoID]
+	if !ok {
+		height = u.startHeight
+	}
+	if height > u.height {
+		return 0, true
+	}
+	return u.height - height + 1, true
+}
+
+func (u *syncingChainUTXOs) UTXOs(ctx context.Context, sourceChainID ids.ID) ([]*avax.UTXO, error) {
+	if now := u.clock.Time(); sourceChainID == u.chainID && now.Sub(u.lastSync) >= u.interval {
+		if err := u.sync(ctx); err != nil {
+			return nil, err
+		}
+		u.lastSync = now
+	}
+	return u.ChainUTXOs.UTXOs(ctx, sourceChainID)
+}
+
+// sync applies the changes made to the UTXOs since [u.height] until the
+// changes up to the last accepted height have been applied.
+func (u *syncingChainUTXOs) sync(ctx context.Context) error {
+	for {
+		added, removed, height, err := u.client.GetUTXODiff(ctx, u.addrs, u.height)
+		if err != nil {
+			return err
+		}
+
+		for _, utxoID := range removed {
+			if err := u.RemoveUTXO(ctx, u.chainID, utxoID); err != nil {
+				return err
+			}
+		}
+		for _, utxoBytes := range added {
+			var utxo avax.UTXO
+			if _, err := u.codec.Unmarshal(utxoBytes, &utxo); err != nil {
+				return err
+			}
+			if err := u.ChainUTXOs.AddUTXO(ctx, u.chainID, &utxo); err != nil {
+				return err
+			}
+			// The UTXO was produced at some height up to [height].
+			u.heights[utxo.InputID()] = height
+		}
+
+		// The changes of at most [avax.MaxUTXODiffHeights] heights are
+		// returned at once, so fewer heights means the last accepted height
+		// was reached.
+		caughtUp := height-u.height < avax.MaxUTXODiffHeights
+		u.height = height
+		if caughtUp {
+			return nil
+		}
+	}
+}
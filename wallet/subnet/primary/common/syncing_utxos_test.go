@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const testCodecVersion = 0
+
+var _ UTXODiffClient = (*diffClient)(nil)
+
+// diffClient reports the changes made at each height, where the changes made
+// at height i are diffs[i-1].
+type diffClient struct {
+	codec codec.Manager
+	diffs []*avax.UTXODiff
+	utxos map[ids.ID]*avax.UTXO
+	calls int
+}
+
+func (c *diffClient) GetUTXODiff(_ context.Context, _ []ids.ShortID, height uint64, _ ...rpc.Option) ([][]byte, []ids.ID, uint64, error) {
+	c.calls++
+
+	endHeight := min(uint64(len(c.diffs)), height+avax.MaxUTXODiffHeights)
+	var (
+		added   [][]byte
+		removed []ids.ID
+	)
+	for _, diff := range c.diffs[height:endHeight] {
+		for _, utxoID := range diff.Added {
+			utxoBytes, err := c.codec.Marshal(testCodecVersion, c.utxos[utxoID])
+			if err != nil {
+				return nil, nil, 0, err
+			}
+			added = append(added, utxoBytes)
+		}
+		removed = append(removed, diff.Removed...)
+	}
+	return added, removed, endHeight, nil
+}
+
+func TestSyncingChainUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	c := linearcodec.NewDefault()
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	manager := codec.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(testCodecVersion, c))
+
+	newUTXO := func() *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: ids.GenerateTestID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+		}
+	}
+
+	var (
+		ctx       = context.Background()
+		chainID   = ids.GenerateTestID()
+		spentUTXO = newUTXO()
+		newUTXOs  = []*avax.UTXO{newUTXO(), newUTXO()}
+		client    = &diffClient{
+			codec: manager,
+			utxos: map[ids.ID]*avax.UTXO{},
+		}
+		chainUTXOs = NewChainUTXOs(chainID, NewUTXOs())
+	)
+	require.NoError(chainUTXOs.AddUTXO(ctx, chainID, spentUTXO))
+
+	const interval = time.Minute
+	utxos := NewSyncingChainUTXOs(
+		chainUTXOs,
+		chainID,
+		client,
+		manager,
+		nil,
+		0,
+		interval,
+	).(*syncingChainUTXOs)
+	now := time.Now()
+	utxos.clock.Set(now)
+	utxos.lastSync = now
+
+	// The UTXOs are changed across more heights than are returned at once.
+	for _, utxo := range newUTXOs {
+		client.utxos[utxo.InputID()] = utxo
+	}
+	client.diffs = make([]*avax.UTXODiff, avax.MaxUTXODiffHeights+1)
+	for i := range client.diffs {
+		client.diffs[i] = &avax.UTXODiff{}
+	}
+	client.diffs[0].Removed = []ids.ID{spentUTXO.InputID()}
+	client.diffs[0].Added = []ids.ID{newUTXOs[0].InputID()}
+	client.diffs[avax.MaxUTXODiffHeights].Added = []ids.ID{newUTXOs[1].InputID()}
+
+	// The UTXOs aren't synced before the interval has passed.
+	utxos.clock.Set(now.Add(interval - time.Second))
+	fetched, err := utxos.UTXOs(ctx, chainID)
+	require.NoError(err)
+	require.Equal([]*avax.UTXO{spentUTXO}, fetched)
+	require.Zero(client.calls)
+
+	// The UTXOs of other chains don't cause a sync.
+	utxos.clock.Set(now.Add(interval))
+	_, err = utxos.UTXOs(ctx, ids.GenerateTestID())
+	require.NoError(err)
+	require.Zero(client.calls)
+
+	// All the changes are applied once the interval has passed.
+	fetched, err = utxos.UTXOs(ctx, chainID)
+	require.NoError(err)
+	// The UTXOs are compared by their IDs because parsing doesn't populate
+	// cached fields.
+	fetchedIDs := make([]ids.ID, len(fetched))
+	for i, utxo := range fetched {
+		fetchedIDs[i] = utxo.InputID()
+	}
+	require.ElementsMatch([]ids.ID{newUTXOs[0].InputID(), newUTXOs[1].InputID()}, fetchedIDs)
+	require.Equal(2, client.calls)
+	require.Equal(uint64(avax.MaxUTXODiffHeights+1), utxos.height)
+}
+
+func TestSyncingChainUTXOsConfirmations(t *testing.T) {
+	require := require.New(t)
+
+	c := linearcodec.NewDefault()
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	manager := codec.NewDefaultManager()
+	require.NoError(manager.RegisterCodec(testCodecVersion, c))
+
+	newUTXO := func() *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.GenerateTestID(),
+			},
+			Asset: avax.Asset{ID: ids.GenerateTestID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+			},
+		}
+	}
+
+	var (
+		ctx         = context.Background()
+		chainID     = ids.GenerateTestID()
+		initialUTXO = newUTXO()
+		syncedUTXO  = newUTXO()
+		walletUTXO  = newUTXO()
+		client      = &diffClient{
+			codec: manager,
+			utxos: map[ids.ID]*avax.UTXO{
+				syncedUTXO.InputID(): syncedUTXO,
+			},
+			diffs: []*avax.UTXODiff{
+				{},
+				{Added: []ids.ID{syncedUTXO.InputID()}},
+				{},
+			},
+		}
+		chainUTXOs = NewChainUTXOs(chainID, NewUTXOs())
+	)
+	require.NoError(chainUTXOs.AddUTXO(ctx, chainID, initialUTXO))
+
+	utxos := NewSyncingChainUTXOs(
+		chainUTXOs,
+		chainID,
+		client,
+		manager,
+		nil,
+		0,
+		0,
+	).(*syncingChainUTXOs)
+
+	// The UTXOs added by the wallet aren't confirmed until they are synced.
+	require.NoError(utxos.AddUTXO(ctx, chainID, walletUTXO))
+
+	_, err := utxos.UTXOs(ctx, chainID)
+	require.NoError(err)
+	require.Equal(uint64(3), utxos.height)
+
+	tests := []struct {
+		name                  string
+		sourceChainID         ids.ID
+		utxoID                ids.ID
+		expectedConfirmations uint64
+		expectedTracked       bool
+	}{
+		{
+			name:                  "initial UTXO",
+			sourceChainID:         chainID,
+			utxoID:                initialUTXO.InputID(),
+			expectedConfirmations: 4,
+			expectedTracked:       true,
+		},
+		{
+			name:          "synced UTXO",
+			sourceChainID: chainID,
+			utxoID:        syncedUTXO.InputID(),
+			// The UTXO is only known to be produced by the last synced height.
+			expectedConfirmations: 1,
+			expectedTracked:       true,
+		},
+		{
+			name:                  "wallet UTXO",
+			sourceChainID:         chainID,
+			utxoID:                walletUTXO.InputID(),
+			expectedConfirmations: 0,
+			expectedTracked:       true,
+		},
+		{
+			name:            "other chain",
+			sourceChainID:   ids.GenerateTestID(),
+			utxoID:          initialUTXO.InputID(),
+			expectedTracked: false,
+		},
+	}
+	for _, test := range tests {
+		confirmations, tracked := utxos.UTXOConfirmations(test.sourceChainID, test.utxoID)
+		require.Equal(test.expectedConfirmations, confirmations, test.name)
+		require.Equal(test.expectedTracked, tracked, test.name)
+	}
+}
@@ -35,6 +35,15 @@ type ChainUTXOs interface {
 	GetUTXO(ctx context.Context, sourceChainID, utxoID ids.ID) (*avax.UTXO, error)
 }
 
+// ConfirmationTracker tracks the number of accepted blocks, including the
+// block that produced a UTXO, since each UTXO was produced.
+type ConfirmationTracker interface {
+	// UTXOConfirmations returns the number of confirmations of [utxoID],
+	// produced by [sourceChainID]. Returns false if the confirmations of the
+	// UTXOs of [sourceChainID] aren't tracked.
+	UTXOConfirmations(sourceChainID, utxoID ids.ID) (uint64, bool)
+}
+
 func NewUTXOs() UTXOs {
 	return &utxos{
 		sourceToDestToUTXOIDToUTXO: make(map[ids.ID]map[ids.ID]map[ids.ID]*avax.UTXO),
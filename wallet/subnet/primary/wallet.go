@@ -5,11 +5,14 @@ package primary
 
 import (
 	"context"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/vms/avm"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/wallet/chain/c"
 	"github.com/ava-labs/avalanchego/wallet/chain/p"
 	"github.com/ava-labs/avalanchego/wallet/chain/x"
@@ -66,6 +69,32 @@ type WalletConfig struct {
 	// Validation IDs that the wallet should know about to be able to generate
 	// transactions.
 	ValidationIDs []ids.ID // optional
+	// Chain IDs that the wallet should know about to be able to generate
+	// transactions that require the authorization of the chain's subnet owner.
+	ChainIDs []ids.ID // optional
+	// FeeRefreshInterval is how often the wallet refreshes the P-chain fee
+	// parameters from the node. If zero, the fee parameters fetched on
+	// creation are used for the lifetime of the wallet.
+	FeeRefreshInterval time.Duration // optional
+	// FailoverURIs are the URIs of additional nodes the P-chain wallet sends
+	// requests to if the primary node is unavailable. Transactions are issued
+	// to the primary node while it is available.
+	FailoverURIs []string // optional
+	// UTXORefreshInterval is how often the P-chain and X-chain wallets fetch
+	// the changes made to their UTXOs since they were last fetched. This
+	// requires the node to index UTXO diffs. If zero, the UTXOs fetched on
+	// creation are only modified by the transactions issued by the wallet.
+	UTXORefreshInterval time.Duration // optional
+}
+
+// newPChainClient returns the P-chain client the wallet uses to reach [uri]
+// and the configured failover nodes.
+func newPChainClient(uri string, config WalletConfig) platformvm.Client {
+	if len(config.FailoverURIs) == 0 {
+		return platformvm.NewClient(uri)
+	}
+	uris := append([]string{uri}, config.FailoverURIs...)
+	return platformvm.NewFailoverClient(uris)
 }
 
 // MakeWallet returns a wallet that supports issuing transactions to the chains
@@ -74,8 +103,8 @@ type WalletConfig struct {
 // On creation, the wallet attaches to the provided uri and fetches all UTXOs
 // that reference any of the provided keys. If the UTXOs are modified through an
 // external issuance process, such as another instance of the wallet, the UTXOs
-// may become out of sync. The wallet will also fetch all requested P-chain
-// owners.
+// may become out of sync unless [WalletConfig.UTXORefreshInterval] is set. The
+// wallet will also fetch all requested P-chain owners.
 //
 // The wallet manages all state locally, and performs all tx signing locally.
 func MakeWallet(
@@ -85,8 +114,28 @@ func MakeWallet(
 	ethKeychain c.EthKeychain,
 	config WalletConfig,
 ) (*Wallet, error) {
-	avaxAddrs := avaxKeychain.Addresses()
-	avaxState, err := FetchState(ctx, uri, avaxAddrs)
+	var (
+		avaxAddrs    = avaxKeychain.Addresses()
+		pChainClient = newPChainClient(uri, config)
+		xChainClient = avm.NewClient(uri, "X")
+		pHeight      uint64
+		xHeight      uint64
+		err          error
+	)
+	if config.UTXORefreshInterval > 0 {
+		// The heights are fetched before the UTXOs so that no changes are
+		// missed.
+		pHeight, err = pChainClient.GetHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+		xHeight, err = xChainClient.GetHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	avaxState, err := fetchState(ctx, uri, pChainClient, xChainClient, avaxAddrs)
 	if err != nil {
 		return nil, err
 	}
@@ -97,19 +146,45 @@ func MakeWallet(
 		return nil, err
 	}
 
-	owners, err := platformvm.GetOwners(avaxState.PClient, ctx, config.SubnetIDs, config.ValidationIDs)
+	owners, err := platformvm.GetOwners(avaxState.PClient, ctx, config.SubnetIDs, config.ValidationIDs, config.ChainIDs)
 	if err != nil {
 		return nil, err
 	}
 
+	avaxAddrList := avaxAddrs.List()
 	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, avaxState.UTXOs)
+	if config.UTXORefreshInterval > 0 {
+		pUTXOs = common.NewSyncingChainUTXOs(
+			pUTXOs,
+			constants.PlatformChainID,
+			avaxState.PClient,
+			txs.Codec,
+			avaxAddrList,
+			pHeight,
+			config.UTXORefreshInterval,
+		)
+	}
 	pBackend := pwallet.NewBackend(avaxState.PCTX, pUTXOs, owners)
+	if config.FeeRefreshInterval > 0 {
+		pBackend = p.NewRefreshingBackend(pBackend, avaxState.PClient, avaxState.PCTX, config.FeeRefreshInterval)
+	}
 	pClient := p.NewClient(avaxState.PClient, pBackend)
 	pBuilder := pbuilder.New(avaxAddrs, avaxState.PCTX, pBackend)
 	pSigner := psigner.New(avaxKeychain, pBackend)
 
 	xChainID := avaxState.XCTX.BlockchainID
 	xUTXOs := common.NewChainUTXOs(xChainID, avaxState.UTXOs)
+	if config.UTXORefreshInterval > 0 {
+		xUTXOs = common.NewSyncingChainUTXOs(
+			xUTXOs,
+			xChainID,
+			avaxState.XClient,
+			xbuilder.Parser.Codec(),
+			avaxAddrList,
+			xHeight,
+			config.UTXORefreshInterval,
+		)
+	}
 	xBackend := x.NewBackend(avaxState.XCTX, xUTXOs)
 	xBuilder := xbuilder.New(avaxAddrs, avaxState.XCTX, xBackend)
 	xSigner := xsigner.New(avaxKeychain, xBackend)
@@ -132,8 +207,8 @@ func MakeWallet(
 // On creation, the wallet attaches to the provided uri and fetches all UTXOs
 // that reference any of the provided keys. If the UTXOs are modified through an
 // external issuance process, such as another instance of the wallet, the UTXOs
-// may become out of sync. The wallet will also fetch all requested P-chain
-// owners.
+// may become out of sync unless [WalletConfig.UTXORefreshInterval] is set. The
+// wallet will also fetch all requested P-chain owners.
 //
 // The wallet manages all state locally, and performs all tx signing locally.
 func MakePWallet(
@@ -142,19 +217,47 @@ func MakePWallet(
 	keychain keychain.Keychain,
 	config WalletConfig,
 ) (pwallet.Wallet, error) {
-	addrs := keychain.Addresses()
-	client, context, utxos, err := FetchPState(ctx, uri, addrs)
+	var (
+		addrs        = keychain.Addresses()
+		pChainClient = newPChainClient(uri, config)
+		height       uint64
+		err          error
+	)
+	if config.UTXORefreshInterval > 0 {
+		// The height is fetched before the UTXOs so that no changes are
+		// missed.
+		height, err = pChainClient.GetHeight(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client, context, utxos, err := fetchPState(ctx, uri, pChainClient, addrs)
 	if err != nil {
 		return nil, err
 	}
 
-	owners, err := platformvm.GetOwners(client, ctx, config.SubnetIDs, config.ValidationIDs)
+	owners, err := platformvm.GetOwners(client, ctx, config.SubnetIDs, config.ValidationIDs, config.ChainIDs)
 	if err != nil {
 		return nil, err
 	}
 
 	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, utxos)
+	if config.UTXORefreshInterval > 0 {
+		pUTXOs = common.NewSyncingChainUTXOs(
+			pUTXOs,
+			constants.PlatformChainID,
+			client,
+			txs.Codec,
+			addrs.List(),
+			height,
+			config.UTXORefreshInterval,
+		)
+	}
 	pBackend := pwallet.NewBackend(context, pUTXOs, owners)
+	if config.FeeRefreshInterval > 0 {
+		pBackend = p.NewRefreshingBackend(pBackend, client, context, config.FeeRefreshInterval)
+	}
 	pClient := p.NewClient(client, pBackend)
 	pBuilder := pbuilder.New(addrs, context, pBackend)
 	pSigner := psigner.New(keychain, pBackend)
@@ -136,6 +136,9 @@ type Manager struct {
 	closeOnce sync.Once
 	tokenSize int
 
+	rangeProofClient  *p2p.ProtoClient[*pb.SyncGetRangeProofRequest, pb.RangeProof, *pb.RangeProof]
+	changeProofClient *p2p.ProtoClient[*pb.SyncGetChangeProofRequest, pb.SyncGetChangeProofResponse, *pb.SyncGetChangeProofResponse]
+
 	stateSyncNodeIdx uint32
 	metrics          SyncMetrics
 }
@@ -187,6 +190,9 @@ func NewManager(config ManagerConfig, registerer prometheus.Registerer) (*Manage
 		processedWork:   newWorkHeap(),
 		tokenSize:       merkledb.BranchFactorToTokenSize[config.BranchFactor],
 		metrics:         metrics,
+
+		rangeProofClient:  p2p.NewProtoClient[*pb.SyncGetRangeProofRequest, pb.RangeProof](config.RangeProofClient),
+		changeProofClient: p2p.NewProtoClient[*pb.SyncGetChangeProofRequest, pb.SyncGetChangeProofResponse](config.ChangeProofClient),
 	}
 	m.unprocessedWorkCond.L = &m.workLock
 
@@ -361,17 +367,10 @@ func (m *Manager) requestChangeProof(ctx context.Context, work *workItem) {
 		BytesLimit: defaultRequestByteSizeLimit,
 	}
 
-	requestBytes, err := proto.Marshal(request)
-	if err != nil {
-		m.finishWorkItem()
-		m.setError(err)
-		return
-	}
-
-	onResponse := func(ctx context.Context, _ ids.NodeID, responseBytes []byte, err error) {
+	onResponse := func(ctx context.Context, _ ids.NodeID, response *pb.SyncGetChangeProofResponse, err error) {
 		defer m.finishWorkItem()
 
-		if err := m.handleChangeProofResponse(ctx, targetRootID, work, request, responseBytes, err); err != nil {
+		if err := m.handleChangeProofResponse(ctx, targetRootID, work, request, response, err); err != nil {
 			// TODO log responses
 			m.config.Log.Debug("dropping response", zap.Error(err), zap.Stringer("request", request))
 			m.retryWork(work)
@@ -379,7 +378,7 @@ func (m *Manager) requestChangeProof(ctx context.Context, work *workItem) {
 		}
 	}
 
-	if err := m.sendRequest(ctx, m.config.ChangeProofClient, requestBytes, onResponse); err != nil {
+	if err := sendRequest(ctx, m, m.changeProofClient, request, onResponse); err != nil {
 		m.finishWorkItem()
 		m.setError(err)
 		return
@@ -419,17 +418,10 @@ func (m *Manager) requestRangeProof(ctx context.Context, work *workItem) {
 		BytesLimit: defaultRequestByteSizeLimit,
 	}
 
-	requestBytes, err := proto.Marshal(request)
-	if err != nil {
-		m.finishWorkItem()
-		m.setError(err)
-		return
-	}
-
-	onResponse := func(ctx context.Context, _ ids.NodeID, responseBytes []byte, appErr error) {
+	onResponse := func(ctx context.Context, _ ids.NodeID, response *pb.RangeProof, appErr error) {
 		defer m.finishWorkItem()
 
-		if err := m.handleRangeProofResponse(ctx, targetRootID, work, request, responseBytes, appErr); err != nil {
+		if err := m.handleRangeProofResponse(ctx, targetRootID, work, request, response, appErr); err != nil {
 			// TODO log responses
 			m.config.Log.Debug("dropping response", zap.Error(err), zap.Stringer("request", request))
 			m.retryWork(work)
@@ -437,7 +429,7 @@ func (m *Manager) requestRangeProof(ctx context.Context, work *workItem) {
 		}
 	}
 
-	if err := m.sendRequest(ctx, m.config.RangeProofClient, requestBytes, onResponse); err != nil {
+	if err := sendRequest(ctx, m, m.rangeProofClient, request, onResponse); err != nil {
 		m.finishWorkItem()
 		m.setError(err)
 		return
@@ -446,9 +438,17 @@ func (m *Manager) requestRangeProof(ctx context.Context, work *workItem) {
 	m.metrics.RequestMade()
 }
 
-func (m *Manager) sendRequest(ctx context.Context, client *p2p.Client, requestBytes []byte, onResponse p2p.AppResponseCallback) error {
+// sendRequest sends [request] to the next state sync node. If no state sync
+// nodes were specified, [request] is sent to an arbitrary node.
+func sendRequest[Request proto.Message, Response any, PResponse p2p.ProtoMessage[Response]](
+	ctx context.Context,
+	m *Manager,
+	client *p2p.ProtoClient[Request, Response, PResponse],
+	request Request,
+	onResponse p2p.ProtoResponseCallback[PResponse],
+) error {
 	if len(m.config.StateSyncNodes) == 0 {
-		return client.AppRequestAny(ctx, requestBytes, onResponse)
+		return client.AppRequestAny(ctx, request, onResponse)
 	}
 
 	// Get the next nodeID to query using the [nodeIdx] offset.
@@ -456,7 +456,7 @@ func (m *Manager) sendRequest(ctx context.Context, client *p2p.Client, requestBy
 	// We do this try to query a different node each time if possible.
 	nodeIdx := atomic.AddUint32(&m.stateSyncNodeIdx, 1)
 	nodeID := m.config.StateSyncNodes[nodeIdx%uint32(len(m.config.StateSyncNodes))]
-	return client.AppRequest(ctx, set.Of(nodeID), requestBytes, onResponse)
+	return client.AppRequest(ctx, set.Of(nodeID), request, onResponse)
 }
 
 func (m *Manager) retryWork(work *workItem) {
@@ -473,7 +473,7 @@ func (m *Manager) retryWork(work *workItem) {
 // Returns an error if we should drop the response
 func (m *Manager) shouldHandleResponse(
 	bytesLimit uint32,
-	responseBytes []byte,
+	response proto.Message,
 	err error,
 ) error {
 	if err != nil {
@@ -491,8 +491,8 @@ func (m *Manager) shouldHandleResponse(
 	default:
 	}
 
-	if len(responseBytes) > int(bytesLimit) {
-		return fmt.Errorf("%w: (%d) > %d)", errTooManyBytes, len(responseBytes), bytesLimit)
+	if size := proto.Size(response); size > int(bytesLimit) {
+		return fmt.Errorf("%w: (%d) > %d)", errTooManyBytes, size, bytesLimit)
 	}
 
 	return nil
@@ -503,20 +503,15 @@ func (m *Manager) handleRangeProofResponse(
 	targetRootID ids.ID,
 	work *workItem,
 	request *pb.SyncGetRangeProofRequest,
-	responseBytes []byte,
+	response *pb.RangeProof,
 	err error,
 ) error {
-	if err := m.shouldHandleResponse(request.BytesLimit, responseBytes, err); err != nil {
-		return err
-	}
-
-	var rangeProofProto pb.RangeProof
-	if err := proto.Unmarshal(responseBytes, &rangeProofProto); err != nil {
+	if err := m.shouldHandleResponse(request.BytesLimit, response, err); err != nil {
 		return err
 	}
 
 	var rangeProof merkledb.RangeProof
-	if err := rangeProof.UnmarshalProto(&rangeProofProto); err != nil {
+	if err := rangeProof.UnmarshalProto(response); err != nil {
 		return err
 	}
 
@@ -554,22 +549,17 @@ func (m *Manager) handleChangeProofResponse(
 	targetRootID ids.ID,
 	work *workItem,
 	request *pb.SyncGetChangeProofRequest,
-	responseBytes []byte,
+	response *pb.SyncGetChangeProofResponse,
 	err error,
 ) error {
-	if err := m.shouldHandleResponse(request.BytesLimit, responseBytes, err); err != nil {
-		return err
-	}
-
-	var changeProofResp pb.SyncGetChangeProofResponse
-	if err := proto.Unmarshal(responseBytes, &changeProofResp); err != nil {
+	if err := m.shouldHandleResponse(request.BytesLimit, response, err); err != nil {
 		return err
 	}
 
 	startKey := maybeBytesToMaybe(request.StartKey)
 	endKey := maybeBytesToMaybe(request.EndKey)
 
-	switch changeProofResp := changeProofResp.Response.(type) {
+	switch changeProofResp := response.Response.(type) {
 	case *pb.SyncGetChangeProofResponse_ChangeProof:
 		// The server had enough history to send us a change proof
 		var changeProof merkledb.ChangeProof
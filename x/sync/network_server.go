@@ -61,28 +61,27 @@ func maybeBytesToMaybe(mb *pb.MaybeBytes) maybe.Maybe[[]byte] {
 }
 
 func NewGetChangeProofHandler(log logging.Logger, db DB) *GetChangeProofHandler {
-	return &GetChangeProofHandler{
+	g := &GetChangeProofHandler{
 		log: log,
 		db:  db,
 	}
+	g.Handler = p2p.NewProtoHandler(g.getChangeProof)
+	return g
 }
 
 type GetChangeProofHandler struct {
+	p2p.Handler
+
 	log logging.Logger
 	db  DB
 }
 
-func (*GetChangeProofHandler) AppGossip(context.Context, ids.NodeID, []byte) {}
-
-func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ time.Time, requestBytes []byte) ([]byte, *common.AppError) {
-	req := &pb.SyncGetChangeProofRequest{}
-	if err := proto.Unmarshal(requestBytes, req); err != nil {
-		return nil, &common.AppError{
-			Code:    p2p.ErrUnexpected.Code,
-			Message: fmt.Sprintf("failed to unmarshal request: %s", err),
-		}
-	}
-
+func (g *GetChangeProofHandler) getChangeProof(
+	ctx context.Context,
+	_ ids.NodeID,
+	_ time.Time,
+	req *pb.SyncGetChangeProofRequest,
+) (*pb.SyncGetChangeProofResponse, *common.AppError) {
 	if err := validateChangeProofRequest(req); err != nil {
 		return nil, &common.AppError{
 			Code:    p2p.ErrUnexpected.Code,
@@ -137,7 +136,7 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 
 			// [s.db] doesn't have sufficient history to generate change proof.
 			// Generate a range proof for the end root ID instead.
-			proofBytes, err := getRangeProof(
+			response, err := getRangeProof(
 				ctx,
 				g.db,
 				&pb.SyncGetRangeProofRequest{
@@ -147,12 +146,12 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 					KeyLimit:   req.KeyLimit,
 					BytesLimit: req.BytesLimit,
 				},
-				func(rangeProof *merkledb.RangeProof) ([]byte, error) {
-					return proto.Marshal(&pb.SyncGetChangeProofResponse{
+				func(rangeProof *merkledb.RangeProof) *pb.SyncGetChangeProofResponse {
+					return &pb.SyncGetChangeProofResponse{
 						Response: &pb.SyncGetChangeProofResponse_RangeProof{
 							RangeProof: rangeProof.ToProto(),
 						},
-					})
+					}
 				},
 			)
 			if err != nil {
@@ -162,24 +161,17 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 				}
 			}
 
-			return proofBytes, nil
+			return response, nil
 		}
 
 		// We generated a change proof. See if it's small enough.
-		proofBytes, err := proto.Marshal(&pb.SyncGetChangeProofResponse{
+		response := &pb.SyncGetChangeProofResponse{
 			Response: &pb.SyncGetChangeProofResponse_ChangeProof{
 				ChangeProof: changeProof.ToProto(),
 			},
-		})
-		if err != nil {
-			return nil, &common.AppError{
-				Code:    p2p.ErrUnexpected.Code,
-				Message: fmt.Sprintf("failed to marshal change proof: %s", err),
-			}
 		}
-
-		if len(proofBytes) < bytesLimit {
-			return proofBytes, nil
+		if proto.Size(response) < bytesLimit {
+			return response, nil
 		}
 
 		// The proof was too large. Try to shrink it.
@@ -193,28 +185,27 @@ func (g *GetChangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _
 }
 
 func NewGetRangeProofHandler(log logging.Logger, db DB) *GetRangeProofHandler {
-	return &GetRangeProofHandler{
+	g := &GetRangeProofHandler{
 		log: log,
 		db:  db,
 	}
+	g.Handler = p2p.NewProtoHandler(g.getRangeProof)
+	return g
 }
 
 type GetRangeProofHandler struct {
+	p2p.Handler
+
 	log logging.Logger
 	db  DB
 }
 
-func (*GetRangeProofHandler) AppGossip(context.Context, ids.NodeID, []byte) {}
-
-func (g *GetRangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ time.Time, requestBytes []byte) ([]byte, *common.AppError) {
-	req := &pb.SyncGetRangeProofRequest{}
-	if err := proto.Unmarshal(requestBytes, req); err != nil {
-		return nil, &common.AppError{
-			Code:    p2p.ErrUnexpected.Code,
-			Message: fmt.Sprintf("failed to unmarshal request: %s", err),
-		}
-	}
-
+func (g *GetRangeProofHandler) getRangeProof(
+	ctx context.Context,
+	_ ids.NodeID,
+	_ time.Time,
+	req *pb.SyncGetRangeProofRequest,
+) (*pb.RangeProof, *common.AppError) {
 	if err := validateRangeProofRequest(req); err != nil {
 		return nil, &common.AppError{
 			Code:    p2p.ErrUnexpected.Code,
@@ -226,13 +217,11 @@ func (g *GetRangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ t
 	req.KeyLimit = min(req.KeyLimit, maxKeyValuesLimit)
 	req.BytesLimit = min(req.BytesLimit, maxByteSizeLimit)
 
-	proofBytes, err := getRangeProof(
+	response, err := getRangeProof(
 		ctx,
 		g.db,
 		req,
-		func(rangeProof *merkledb.RangeProof) ([]byte, error) {
-			return proto.Marshal(rangeProof.ToProto())
-		},
+		(*merkledb.RangeProof).ToProto,
 	)
 	if err != nil {
 		return nil, &common.AppError{
@@ -241,7 +230,7 @@ func (g *GetRangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ t
 		}
 	}
 
-	return proofBytes, nil
+	return response, nil
 }
 
 // Get the range proof specified by [req].
@@ -252,15 +241,16 @@ func (g *GetRangeProofHandler) AppRequest(ctx context.Context, _ ids.NodeID, _ t
 // If no sufficiently small proof can be generated, returns [ErrMinProofSizeIsTooLarge].
 // TODO improve range proof generation so we don't need to iteratively
 // reduce the key limit.
-func getRangeProof(
+func getRangeProof[T proto.Message](
 	ctx context.Context,
 	db DB,
 	req *pb.SyncGetRangeProofRequest,
-	marshalFunc func(*merkledb.RangeProof) ([]byte, error),
-) ([]byte, error) {
+	toResponse func(*merkledb.RangeProof) T,
+) (T, error) {
+	var zero T
 	root, err := ids.ToID(req.RootHash)
 	if err != nil {
-		return nil, err
+		return zero, err
 	}
 
 	keyLimit := int(req.KeyLimit)
@@ -275,24 +265,20 @@ func getRangeProof(
 		)
 		if err != nil {
 			if errors.Is(err, merkledb.ErrInsufficientHistory) {
-				return nil, nil // drop request
+				return zero, nil // drop request
 			}
-			return nil, err
-		}
-
-		proofBytes, err := marshalFunc(rangeProof)
-		if err != nil {
-			return nil, err
+			return zero, err
 		}
 
-		if len(proofBytes) < int(req.BytesLimit) {
-			return proofBytes, nil
+		response := toResponse(rangeProof)
+		if proto.Size(response) < int(req.BytesLimit) {
+			return response, nil
 		}
 
 		// The proof was too large. Try to shrink it.
 		keyLimit = len(rangeProof.KeyValues) / 2
 	}
-	return nil, ErrMinProofSizeIsTooLarge
+	return zero, ErrMinProofSizeIsTooLarge
 }
 
 // Returns nil iff [req] is well-formed.